@@ -23,6 +23,10 @@ import (
 	"github.com/fluid-cloudnative/fluid/pkg/common"
 )
 
+const (
+	AlluxioRuntimeKind = "AlluxioRuntime"
+)
+
 type AlluxioRuntimeRole common.RuntimeRole
 
 const (
@@ -240,6 +244,10 @@ type AlluxioRuntimeSpec struct {
 	// ImagePullSecrets that will be used to pull images
 	// +optional
 	ImagePullSecrets []corev1.LocalObjectReference `json:"imagePullSecrets,omitempty"`
+
+	// DataOperationPolicy defines the policy of running the data operations on the runtime
+	// +optional
+	DataOperationPolicy DataOperationPolicy `json:"dataOperationPolicy,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -0,0 +1,189 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// CacheAutoscalerMetricType is the type of the signal used to calculate the worker replicas
+// +kubebuilder:validation:Enum=CachedPercentage;CacheCapacityUsed;MountingPods
+type CacheAutoscalerMetricType string
+
+const (
+	// CachedPercentageMetric scales the workers so that the cached percentage of the data in the underlying
+	// file system reaches the target value. The workers are scaled up only if their cache is full, and they're never
+	// scaled down by this metric, since the cache evicted lowers the cached percentage.
+	CachedPercentageMetric CacheAutoscalerMetricType = "CachedPercentage"
+
+	// CacheCapacityUsedMetric scales the workers so that the percentage of the used cache capacity
+	// is around the target value.
+	CacheCapacityUsedMetric CacheAutoscalerMetricType = "CacheCapacityUsed"
+
+	// MountingPodsMetric scales the workers so that each worker serves around the target number of pods
+	// mounting the Dataset's PVC.
+	MountingPodsMetric CacheAutoscalerMetricType = "MountingPods"
+)
+
+// CacheAutoscalerTargetRef refers to the runtime to scale in the same namespace
+type CacheAutoscalerTargetRef struct {
+	// Kind of the runtime
+	// +kubebuilder:validation:Enum=AlluxioRuntime;JindoRuntime;JuiceFSRuntime;VineyardRuntime;EFCRuntime;ThinRuntime;CacheRuntime
+	// +required
+	Kind string `json:"kind"`
+
+	// Name of the runtime, which is the same as the name of the Dataset bound to it
+	// +required
+	Name string `json:"name"`
+}
+
+// CacheAutoscalerMetric defines a signal and its target value
+type CacheAutoscalerMetric struct {
+	// Type of the metric
+	// +required
+	Type CacheAutoscalerMetricType `json:"type"`
+
+	// Target is the target value of the metric. It's a percentage from 1 to 100 for CachedPercentage
+	// and CacheCapacityUsed, or the number of pods per worker for MountingPods.
+	// +kubebuilder:validation:Minimum=1
+	// +required
+	Target int32 `json:"target"`
+}
+
+// CacheAutoscalerSpec defines the desired state of CacheAutoscaler
+type CacheAutoscalerSpec struct {
+	// ScaleTargetRef refers to the runtime whose workers are scaled
+	// +required
+	ScaleTargetRef CacheAutoscalerTargetRef `json:"scaleTargetRef"`
+
+	// MinReplicas is the lower limit of the worker replicas, defaults to 1
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:default=1
+	// +optional
+	MinReplicas *int32 `json:"minReplicas,omitempty"`
+
+	// MaxReplicas is the upper limit of the worker replicas
+	// +kubebuilder:validation:Minimum=1
+	// +required
+	MaxReplicas int32 `json:"maxReplicas"`
+
+	// Metrics are the signals used to calculate the worker replicas. The largest replicas recommended
+	// by the metrics is used.
+	// +kubebuilder:validation:MinItems=1
+	// +required
+	Metrics []CacheAutoscalerMetric `json:"metrics"`
+
+	// ScaleUpCooldownSeconds is the minimum time between the last scaling and a scale up, defaults to 60
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:default=60
+	// +optional
+	ScaleUpCooldownSeconds *int32 `json:"scaleUpCooldownSeconds,omitempty"`
+
+	// ScaleDownCooldownSeconds is the minimum time between the last scaling and a scale down, defaults to 300
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:default=300
+	// +optional
+	ScaleDownCooldownSeconds *int32 `json:"scaleDownCooldownSeconds,omitempty"`
+}
+
+// CacheAutoscalerMetricStatus is the observed value of a metric and the replicas it recommends
+type CacheAutoscalerMetricStatus struct {
+	// Type of the metric
+	Type CacheAutoscalerMetricType `json:"type"`
+
+	// Current is the observed value of the metric, e.g. 85.5% or 12
+	// +optional
+	Current string `json:"current,omitempty"`
+
+	// RecommendedReplicas is the worker replicas recommended by the metric
+	// +optional
+	RecommendedReplicas int32 `json:"recommendedReplicas,omitempty"`
+}
+
+// CacheAutoscalerDecision records a scaling of the runtime workers
+type CacheAutoscalerDecision struct {
+	// Time when the runtime was scaled
+	Time metav1.Time `json:"time"`
+
+	// From is the worker replicas before scaling
+	From int32 `json:"from"`
+
+	// To is the worker replicas after scaling
+	To int32 `json:"to"`
+
+	// Reason explains the decision
+	// +optional
+	Reason string `json:"reason,omitempty"`
+}
+
+// CacheAutoscalerStatus defines the observed state of CacheAutoscaler
+type CacheAutoscalerStatus struct {
+	// CurrentReplicas is the worker replicas of the runtime observed last time
+	// +optional
+	CurrentReplicas int32 `json:"currentReplicas,omitempty"`
+
+	// DesiredReplicas is the worker replicas calculated last time
+	// +optional
+	DesiredReplicas int32 `json:"desiredReplicas,omitempty"`
+
+	// CurrentMetrics are the metrics observed last time
+	// +optional
+	CurrentMetrics []CacheAutoscalerMetricStatus `json:"currentMetrics,omitempty"`
+
+	// LastScaleTime is the last time the runtime was scaled by the autoscaler
+	// +optional
+	LastScaleTime *metav1.Time `json:"lastScaleTime,omitempty"`
+
+	// Decisions are the recent scalings made by the autoscaler, the latest one comes first
+	// +optional
+	Decisions []CacheAutoscalerDecision `json:"decisions,omitempty"`
+}
+
+// +kubebuilder:printcolumn:name="Kind",type="string",JSONPath=`.spec.scaleTargetRef.kind`
+// +kubebuilder:printcolumn:name="Target",type="string",JSONPath=`.spec.scaleTargetRef.name`
+// +kubebuilder:printcolumn:name="Min",type="integer",JSONPath=`.spec.minReplicas`
+// +kubebuilder:printcolumn:name="Max",type="integer",JSONPath=`.spec.maxReplicas`
+// +kubebuilder:printcolumn:name="Current",type="integer",JSONPath=`.status.currentReplicas`
+// +kubebuilder:printcolumn:name="Desired",type="integer",JSONPath=`.status.desiredReplicas`
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced
+// +kubebuilder:resource:categories={fluid},shortName=cas
+
+// CacheAutoscaler is the Schema for the cacheautoscalers API
+type CacheAutoscaler struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   CacheAutoscalerSpec   `json:"spec,omitempty"`
+	Status CacheAutoscalerStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Namespaced
+
+// CacheAutoscalerList contains a list of CacheAutoscaler
+type CacheAutoscalerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CacheAutoscaler `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&CacheAutoscaler{}, &CacheAutoscalerList{})
+}
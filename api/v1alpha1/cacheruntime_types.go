@@ -175,10 +175,15 @@ type CacheRuntimeSpec struct {
 	// +patchMergeKey=name
 	// +patchStrategy=merge,retainKeys
 	Volumes []corev1.Volume `json:"volumes,omitempty" patchStrategy:"merge,retainKeys" patchMergeKey:"name"`
+
+	// DataOperationPolicy defines the policy of running the data operations on the runtime
+	// +optional
+	DataOperationPolicy DataOperationPolicy `json:"dataOperationPolicy,omitempty"`
 }
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
+// +kubebuilder:subresource:scale:specpath=.spec.worker.replicas,statuspath=.status.worker.currentReplicas,selectorpath=.status.selector
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",priority=0
 // +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.phase",priority=0
 // +kubebuilder:resource:scope=Namespaced
@@ -98,6 +98,15 @@ type RuntimeManagement struct {
 	MetadataSyncPolicy MetadataSyncPolicy `json:"metadataSyncPolicy,omitempty"`
 }
 
+// DataOperationPolicy defines the policy of running the data operations on a runtime
+type DataOperationPolicy struct {
+	// MaxConcurrency is the maximum number of data operations running on the runtime at the same time, the others
+	// wait in the operation queue of the runtime. Defaults to 0, which means no limit
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxConcurrency int32 `json:"maxConcurrency,omitempty"`
+}
+
 // InitUsersSpec is a description of the initialize the users for runtime
 type InitUsersSpec struct {
 
@@ -230,6 +239,103 @@ const (
 	OnEvent Policy = "OnEvent"
 )
 
+// EventSourceType is the type of event which triggers a data operation with OnEvent policy
+type EventSourceType string
+
+const (
+	// DatasetMountsChangedEvent is fired when the mounts of the target dataset are changed
+	DatasetMountsChangedEvent EventSourceType = "DatasetMountsChanged"
+
+	// OperationCompletedEvent is fired when the referenced data operation completes
+	OperationCompletedEvent EventSourceType = "OperationCompleted"
+
+	// UFSChangedEvent is fired when a UFS change notification is delivered through the event receiver
+	UFSChangedEvent EventSourceType = "UFSChanged"
+)
+
+// EventSource defines an event which triggers a data operation with OnEvent policy
+type EventSource struct {
+	// Type of the event, one of `DatasetMountsChanged`, `OperationCompleted` or `UFSChanged`
+	// +kubebuilder:validation:Enum=DatasetMountsChanged;OperationCompleted;UFSChanged
+	// +required
+	Type EventSourceType `json:"type"`
+
+	// Operation specifies the data operation to watch, only used when type is OperationCompleted
+	// +optional
+	Operation *ObjectRef `json:"operation,omitempty"`
+
+	// PathPrefixes filters UFS change notifications by path, only used when type is UFSChanged.
+	// A notification matches if its path is one of the prefixes or under one of them. Empty means all paths match.
+	// +optional
+	PathPrefixes []string `json:"pathPrefixes,omitempty"`
+}
+
+// EventRun records a run of a data operation triggered by events
+type EventRun struct {
+	// Events are the types of the events which triggered the run
+	Events []EventSourceType `json:"events"`
+	// Message is a human-readable message indicating details about the events
+	Message string `json:"message,omitempty"`
+	// TriggerTime is the time the run was triggered
+	TriggerTime metav1.Time `json:"triggerTime"`
+	// Phase is the phase of the run
+	Phase common.Phase `json:"phase,omitempty"`
+	// Duration tells user how much time was spent on the run
+	Duration string `json:"duration,omitempty"`
+}
+
+// EventTriggerStatus records the observed events and the runs triggered by them
+type EventTriggerStatus struct {
+	// ObservedRevisions records the last observed revision of each event source
+	ObservedRevisions map[string]string `json:"observedRevisions,omitempty"`
+	// Runs is the history of the runs triggered by events, the latest run is the last one
+	Runs []EventRun `json:"runs,omitempty"`
+}
+
+// RetryPolicy defines how a failed data operation is retried, only used when policy is Once
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts to run the data operation, including the first one
+	// +kubebuilder:validation:Minimum=1
+	// +required
+	MaxAttempts int32 `json:"maxAttempts"`
+
+	// Backoff defines the delay before retrying a failed attempt
+	// +optional
+	Backoff *RetryBackoff `json:"backoff,omitempty"`
+
+	// RetryOnExitCodes retries a failed attempt only if a container of the attempt exited with one of the exit codes.
+	// A failed attempt is retried if it matches either retryOnExitCodes or retryOnReasons, and all the failed
+	// attempts are retried if neither of them is set.
+	// +optional
+	RetryOnExitCodes []int32 `json:"retryOnExitCodes,omitempty"`
+
+	// RetryOnReasons retries a failed attempt only if the reason of the failed job condition (e.g. `BackoffLimitExceeded`,
+	// `DeadlineExceeded`) or the reason of a terminated container (e.g. `Error`, `OOMKilled`) is one of the reasons.
+	// +optional
+	RetryOnReasons []string `json:"retryOnReasons,omitempty"`
+}
+
+// RetryBackoff defines the exponential backoff between the attempts of a data operation
+type RetryBackoff struct {
+	// InitialDelaySeconds is the delay before the first retry
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:default=10
+	// +optional
+	InitialDelaySeconds *int32 `json:"initialDelaySeconds,omitempty"`
+
+	// Factor multiplies the delay for each following retry
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:default=2
+	// +optional
+	Factor *int32 `json:"factor,omitempty"`
+
+	// MaxDelaySeconds is the upper limit of the delay
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:default=300
+	// +optional
+	MaxDelaySeconds *int32 `json:"maxDelaySeconds,omitempty"`
+}
+
 // Condition explains the transitions on phase
 type Condition struct {
 	// Type of condition, either `Complete` or `Failed`
@@ -308,6 +414,11 @@ type OperationRef struct {
 type WaitingStatus struct {
 	// OperationComplete indicates if the preceding operation is complete
 	OperationComplete *bool `json:"operationComplete,omitempty"`
+
+	// QueuePosition is the position of the operation in the operation queue of the runtime, starting from 1.
+	// It's only set when the operation waits for the runtime to run fewer data operations
+	// +optional
+	QueuePosition *int32 `json:"queuePosition,omitempty"`
 }
 
 type ClientMetrics struct {
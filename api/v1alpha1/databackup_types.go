@@ -37,6 +37,10 @@ type DataBackupSpec struct {
 	// TTLSecondsAfterFinished is the time second to clean up data operations after finished or failed
 	// +optional
 	TTLSecondsAfterFinished *int32 `json:"ttlSecondsAfterFinished,omitempty"`
+	// Priority of the DataBackup in the operation queue of the runtime, the DataBackup with a higher priority runs first
+	// when the runtime limits the number of concurrent data operations. Defaults to 0
+	// +optional
+	Priority int32 `json:"priority,omitempty"`
 }
 
 // +kubebuilder:printcolumn:name="Dataset",type="string",JSONPath=`.spec.dataset`
@@ -49,6 +53,7 @@ type DataBackupSpec struct {
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Namespaced
 // +kubebuilder:resource:categories={fluid},shortName=backup
+// +kubebuilder:storageversion
 
 // DataBackup is the Schema for the backup API
 type DataBackup struct {
@@ -0,0 +1,208 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+)
+
+// DataFlowFailurePolicy decides how a DataFlow goes on after one of its steps fails
+type DataFlowFailurePolicy string
+
+const (
+	// FailFastFailurePolicy starts no more steps once a step fails, the running steps are left to finish
+	FailFastFailurePolicy DataFlowFailurePolicy = "FailFast"
+
+	// ContinueFailurePolicy keeps running the steps not depending on the failed steps
+	ContinueFailurePolicy DataFlowFailurePolicy = "Continue"
+)
+
+// DataFlowStepSkipped is the phase of a step not run because a step it depends on failed or the flow failed fast
+const DataFlowStepSkipped common.Phase = "Skipped"
+
+// DataFlowParameter is a parameter substituted into the templates of the steps
+type DataFlowParameter struct {
+	// Name of the parameter, referred to as $(params.<name>) in the templates
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Name string `json:"name"`
+
+	// Value of the parameter
+	// +optional
+	Value string `json:"value,omitempty"`
+}
+
+// DataFlowStepAffinity specifies the pod affinity of a step with one of the steps it depends on
+type DataFlowStepAffinity struct {
+	// Step is the name of the step to be affine to, defaults to the first step in DependsOn
+	// +optional
+	Step string `json:"step,omitempty"`
+
+	// Policy one of: "", "Require", "Prefer"
+	// +optional
+	Policy AffinityPolicy `json:"policy,omitempty"`
+
+	Prefers  []Prefer  `json:"prefers,omitempty"`
+	Requires []Require `json:"requires,omitempty"`
+}
+
+// DataFlowStep is a data operation in the DataFlow, exactly one of the templates is set. A failed step is retried by
+// the retryPolicy in its template.
+type DataFlowStep struct {
+	// Name of the step, unique in the DataFlow
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	// +required
+	Name string `json:"name"`
+
+	// DependsOn are the names of the steps to complete before the step starts
+	// +optional
+	DependsOn []string `json:"dependsOn,omitempty"`
+
+	// Affinity specifies the pod affinity of the step with one of the steps it depends on
+	// +optional
+	Affinity *DataFlowStepAffinity `json:"affinity,omitempty"`
+
+	// DataLoad is the template of the DataLoad run by the step
+	// +optional
+	DataLoad *DataLoadSpec `json:"dataLoad,omitempty"`
+
+	// DataProcess is the template of the DataProcess run by the step
+	// +optional
+	DataProcess *DataProcessSpec `json:"dataProcess,omitempty"`
+
+	// DataMigrate is the template of the DataMigrate run by the step
+	// +optional
+	DataMigrate *DataMigrateSpec `json:"dataMigrate,omitempty"`
+
+	// DataBackup is the template of the DataBackup run by the step
+	// +optional
+	DataBackup *DataBackupSpec `json:"dataBackup,omitempty"`
+}
+
+// DataFlowSpec defines the desired state of DataFlow
+type DataFlowSpec struct {
+	// Steps of the DataFlow, which form a directed acyclic graph by their dependencies
+	// +kubebuilder:validation:MinItems=1
+	// +required
+	Steps []DataFlowStep `json:"steps"`
+
+	// Parameters substituted into the templates of the steps
+	// +optional
+	Parameters []DataFlowParameter `json:"parameters,omitempty"`
+
+	// FailurePolicy decides how the DataFlow goes on after a step fails, defaults to FailFast
+	// +kubebuilder:validation:Enum=FailFast;Continue
+	// +optional
+	FailurePolicy DataFlowFailurePolicy `json:"failurePolicy,omitempty"`
+
+	// Schedule is the cron schedule to re-run the whole DataFlow, which runs once if not set.
+	// A scheduled run is skipped if the previous run is not finished.
+	// +optional
+	Schedule string `json:"schedule,omitempty"`
+}
+
+// DataFlowStepStatus is the observed state of a step in the current run
+type DataFlowStepStatus struct {
+	// Name of the step
+	Name string `json:"name"`
+
+	// Phase of the step
+	// +optional
+	Phase common.Phase `json:"phase,omitempty"`
+
+	// Operation is the data operation of the step
+	// +optional
+	Operation *ObjectRef `json:"operation,omitempty"`
+
+	// Message is the reason why the step failed or was skipped
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// StartTime is the time when the operation of the step was created
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// CompletionTime is the time when the step finished
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+}
+
+// DataFlowStatus defines the observed state of DataFlow
+type DataFlowStatus struct {
+	// Phase of the current run
+	// +optional
+	Phase common.Phase `json:"phase,omitempty"`
+
+	// Run is the sequence number of the current run, starting from 1
+	// +optional
+	Run int32 `json:"run,omitempty"`
+
+	// StartTime is the time when the current run started
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// CompletionTime is the time when the current run finished
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// LastScheduleTime is the last time the DataFlow was scheduled to run by the Schedule
+	// +optional
+	LastScheduleTime *metav1.Time `json:"lastScheduleTime,omitempty"`
+
+	// Steps are the states of the steps in the current run
+	// +optional
+	Steps []DataFlowStepStatus `json:"steps,omitempty"`
+
+	// Conditions consists of transition information on the DataFlow's Phase
+	// +optional
+	Conditions []Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:printcolumn:name="Run",type="integer",JSONPath=`.status.run`
+// +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Schedule",type="string",JSONPath=`.spec.schedule`
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced
+// +kubebuilder:resource:categories={fluid},shortName=flow
+
+// DataFlow is the Schema for the dataflows API
+type DataFlow struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DataFlowSpec   `json:"spec,omitempty"`
+	Status DataFlowStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Namespaced
+
+// DataFlowList contains a list of DataFlow
+type DataFlowList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DataFlow `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&DataFlow{}, &DataFlowList{})
+}
@@ -44,6 +44,18 @@ type TargetPath struct {
 	Replicas int32 `json:"replicas,omitempty"`
 }
 
+// DataLoadTargetNodes selects the nodes where the target paths should be cached by the co-located workers
+type DataLoadTargetNodes struct {
+	// NodeNames are the names of the target nodes
+	// +optional
+	NodeNames []string `json:"nodeNames,omitempty"`
+
+	// PodSelector selects the pods in the namespace of the DataLoad, the nodes where they are scheduled
+	// or nominated to run are the target nodes
+	// +optional
+	PodSelector *metav1.LabelSelector `json:"podSelector,omitempty"`
+}
+
 // DataLoadSpec defines the desired state of DataLoad
 type DataLoadSpec struct {
 	// Dataset defines the target dataset of the DataLoad
@@ -58,6 +70,11 @@ type DataLoadSpec struct {
 	// Options specifies the extra dataload properties for runtime
 	Options map[string]string `json:"options,omitempty"`
 
+	// TargetNodes makes the target paths cached on the workers co-located with the target nodes, instead of
+	// the workers chosen by the runtime. It's only supported by AlluxioRuntime.
+	// +optional
+	TargetNodes *DataLoadTargetNodes `json:"targetNodes,omitempty"`
+
 	// PodMetadata defines labels and annotations that will be propagated to DataLoad pods
 	PodMetadata PodMetadata `json:"podMetadata,omitempty"`
 
@@ -87,6 +104,10 @@ type DataLoadSpec struct {
 	// +optional
 	Schedule string `json:"schedule,omitempty"`
 
+	// Events defines the events which trigger the DataLoad to run again, only used when policy is OnEvent.
+	// +optional
+	Events []EventSource `json:"events,omitempty"`
+
 	// Specifies that the preceding operation in a workflow
 	// +optional
 	RunAfter *OperationRef `json:"runAfter,omitempty"`
@@ -95,6 +116,15 @@ type DataLoadSpec struct {
 	// +optional
 	TTLSecondsAfterFinished *int32 `json:"ttlSecondsAfterFinished,omitempty"`
 
+	// RetryPolicy defines how the DataLoad is retried when it fails, only used when policy is Once
+	// +optional
+	RetryPolicy *RetryPolicy `json:"retryPolicy,omitempty"`
+
+	// Priority of the DataLoad in the operation queue of the runtime, the DataLoad with a higher priority runs first
+	// when the runtime limits the number of concurrent data operations. Defaults to 0
+	// +optional
+	Priority int32 `json:"priority,omitempty"`
+
 	// Resources that will be requested by the DataLoad job. <br>
 	// +optional
 	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
@@ -109,6 +139,7 @@ type DataLoadSpec struct {
 // +kubebuilder:resource:scope=Namespaced
 // +kubebuilder:resource:categories={fluid},shortName=load
 // +genclient
+// +kubebuilder:storageversion
 
 // DataLoad is the Schema for the dataloads API
 type DataLoad struct {
@@ -85,6 +85,15 @@ type DataMigrateSpec struct {
 	// +optional
 	TTLSecondsAfterFinished *int32 `json:"ttlSecondsAfterFinished,omitempty"`
 
+	// RetryPolicy defines how the DataMigrate is retried when it fails, only used when policy is Once
+	// +optional
+	RetryPolicy *RetryPolicy `json:"retryPolicy,omitempty"`
+
+	// Priority of the DataMigrate in the operation queue of the runtime, the DataMigrate with a higher priority runs first
+	// when the runtime limits the number of concurrent data operations. Defaults to 0
+	// +optional
+	Priority int32 `json:"priority,omitempty"`
+
 	// Resources that will be requested by the DataMigrate job. <br>
 	// +optional
 	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
@@ -99,8 +108,37 @@ type DataMigrateSpec struct {
 	// ParallelOptions defines options like ssh port and ssh secret name when parallelism is greater than 1.
 	// +optional
 	ParallelOptions map[string]string `json:"parallelOptions,omitempty"`
+
+	// Resume makes a retried or re-created DataMigrate skip the files which are already migrated and verified
+	// by the previous attempts, instead of migrating all the files from scratch. It's only supported by JuiceFSRuntime
+	// when Parallelism is 1. Default is false.
+	// +optional
+	Resume bool `json:"resume,omitempty"`
+
+	// VerifyMode defines how a file already in the destination is verified to be skipped when resuming,
+	// including SizeAndMtime and Checksum. Default is SizeAndMtime.
+	// +kubebuilder:validation:Enum=SizeAndMtime;Checksum
+	// +optional
+	VerifyMode MigrateVerifyMode `json:"verifyMode,omitempty"`
+
+	// ManifestVolumeClaim is the name of the PersistentVolumeClaim in the namespace of the DataMigrate, which keeps
+	// the manifest of the files migrated and verified when resuming. It's required when Resume is true.
+	// +optional
+	ManifestVolumeClaim string `json:"manifestVolumeClaim,omitempty"`
 }
 
+// MigrateVerifyMode defines how a migrated file is verified
+type MigrateVerifyMode string
+
+const (
+	// SizeAndMtimeVerifyMode verifies a migrated file by comparing its size and modification time with the source,
+	// the file is verified if it has the same size and it's not older than the source
+	SizeAndMtimeVerifyMode MigrateVerifyMode = "SizeAndMtime"
+
+	// ChecksumVerifyMode verifies a migrated file by comparing its checksum with the source
+	ChecksumVerifyMode MigrateVerifyMode = "Checksum"
+)
+
 type DataToMigrate struct {
 	// dataset to migrate
 	DataSet *DatasetToMigrate `json:"dataset,omitempty"`
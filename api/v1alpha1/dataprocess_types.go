@@ -112,6 +112,15 @@ type DataProcessSpec struct {
 	// +optional
 	TTLSecondsAfterFinished *int32 `json:"ttlSecondsAfterFinished,omitempty"`
 
+	// RetryPolicy defines how the DataProcess is retried when it fails, only used when policy is Once
+	// +optional
+	RetryPolicy *RetryPolicy `json:"retryPolicy,omitempty"`
+
+	// Priority of the DataProcess in the operation queue of the runtime, the DataProcess with a higher priority runs first
+	// when the runtime limits the number of concurrent data operations. Defaults to 0
+	// +optional
+	Priority int32 `json:"priority,omitempty"`
+
 	//+kubebuilder:default:=Once
 	//+kubebuilder:validation:Enum=Once;Cron;OnEvent
 	// Policy defines the operation policy, including Once, Cron, OnEvent
@@ -122,6 +131,10 @@ type DataProcessSpec struct {
 	// See https://en.wikipedia.org/wiki/Cron.
 	// +optional
 	Schedule string `json:"schedule,omitempty"`
+
+	// Events defines the events which trigger the DataProcess to run again, only used when Policy is OnEvent.
+	// +optional
+	Events []EventSource `json:"events,omitempty"`
 }
 
 // +kubebuilder:printcolumn:name="Dataset",type="string",JSONPath=`.spec.dataset.name`
@@ -64,6 +64,41 @@ type EncryptOptionSource struct {
 	// The encryptInfo obtained from secret
 	// +optional
 	SecretKeyRef SecretKeySelector `json:"secretKeyRef,omitempty"`
+
+	// The encryptInfo exchanged from a credential provider with a service account token,
+	// it's refreshed before it expires
+	// +optional
+	CredentialProvider *CredentialProviderSelector `json:"credentialProvider,omitempty"`
+
+	// The encryptInfo read from a file on a Secrets Store CSI volume
+	// +optional
+	CSISecretStore *CSISecretStoreSelector `json:"csiSecretStore,omitempty"`
+}
+
+type CredentialProviderSelector struct {
+	// The URL of the credential provider. Fluid posts a token of the service account, whose audience is the URL,
+	// to it and gets the short-lived credentials back.
+	// +kubebuilder:validation:Pattern=`^https?://`
+	// +required
+	URL string `json:"url"`
+
+	// The service account in the namespace of the dataset whose token is exchanged, defaults to "default"
+	// +optional
+	ServiceAccountName string `json:"serviceAccountName,omitempty"`
+
+	// The required key in the credentials returned by the credential provider
+	// +required
+	Key string `json:"key"`
+}
+
+type CSISecretStoreSelector struct {
+	// The name of the SecretProviderClass in the namespace of the dataset
+	// +required
+	SecretProviderClass string `json:"secretProviderClass"`
+
+	// The required object name, i.e. the file name, in the volume
+	// +required
+	ObjectName string `json:"objectName"`
 }
 type EncryptOption struct {
 	// The name of encryptOption
@@ -120,6 +155,17 @@ type DataRestoreLocation struct {
 	// NodeName describes the nodeName of restore if Path is  in the form of local://subpath
 	// +optional
 	NodeName string `json:"nodeName,omitempty"`
+	// Dataset is the name of the dataset in the same namespace the backup was taken from, defaults to the dataset itself
+	// +optional
+	Dataset string `json:"dataset,omitempty"`
+}
+
+// DatasetSnapshotRef refers to a DatasetSnapshot in the same namespace
+type DatasetSnapshotRef struct {
+	// Name of the DatasetSnapshot
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Name string `json:"name"`
 }
 
 // DatasetSpec defines the desired state of Dataset
@@ -169,6 +215,11 @@ type DatasetSpec struct {
 	// SharedEncryptOptions is the encryptOption to all mount
 	// +optional
 	SharedEncryptOptions []EncryptOption `json:"sharedEncryptOptions,omitempty"`
+
+	// SnapshotRef mounts the dataset from a DatasetSnapshot read-only. The mounts, access modes and restore location
+	// are filled from the snapshot once it's complete, the runtime waits until then.
+	// +optional
+	SnapshotRef *DatasetSnapshotRef `json:"snapshotRef,omitempty"`
 }
 
 // Runtime describes a runtime to be used to support dataset
@@ -233,6 +284,22 @@ type DatasetStatus struct {
 
 	// DatasetRef specifies the datasets namespaced name mounting this Dataset.
 	DatasetRef []string `json:"datasetRef,omitempty"`
+
+	// DatasetRefGrants specifies the DatasetShares allowing the datasets in DatasetRef from other namespaces.
+	// +optional
+	DatasetRefGrants []DatasetRefGrant `json:"datasetRefGrants,omitempty"`
+}
+
+// DatasetRefGrant records the DatasetShare allowing a dataset in another namespace to mount this Dataset
+type DatasetRefGrant struct {
+	// DatasetRef is the namespaced name of the dataset mounting this Dataset
+	DatasetRef string `json:"datasetRef"`
+
+	// DatasetShare is the name of the DatasetShare allowing the reference
+	DatasetShare string `json:"datasetShare"`
+
+	// AccessMode is the access granted by the DatasetShare
+	AccessMode DatasetShareAccessMode `json:"accessMode"`
 }
 
 // DatasetConditionType defines all kinds of types of cacheStatus.<br>
@@ -296,6 +363,7 @@ type DatasetCondition struct {
 // +kubebuilder:resource:scope=Namespaced
 // +kubebuilder:resource:categories={fluid},shortName=dataset
 // +genclient
+// +kubebuilder:storageversion
 
 // Dataset is the Schema for the datasets API
 type Dataset struct {
@@ -342,6 +410,11 @@ func (dataset *Dataset) IsExclusiveMode() bool {
 	return dataset.Spec.PlacementMode == DefaultMode || dataset.Spec.PlacementMode == ExclusiveMode
 }
 
+// IsWaitingForSnapshot checks if the dataset is mounted from a snapshot whose mounts are not filled in yet
+func (dataset *Dataset) IsWaitingForSnapshot() bool {
+	return dataset.Spec.SnapshotRef != nil && len(dataset.Spec.Mounts) == 0
+}
+
 // GetDataOperationInProgress get the name of operation for certain type running on this dataset, otherwise return empty string
 func (dataset *Dataset) GetDataOperationInProgress(operationType string) string {
 	if dataset.Status.OperationRef == nil {
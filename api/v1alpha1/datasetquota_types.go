@@ -0,0 +1,114 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DatasetQuotaSpec defines the desired state of DatasetQuota
+type DatasetQuotaSpec struct {
+	// CacheCapacity is the upper limit of the cache capacity that the runtimes of the selected Datasets
+	// may request in total. The cache capacity requested by a runtime is its worker replicas multiplied by
+	// the sum of the quotas in its tiered store.
+	// +required
+	CacheCapacity resource.Quantity `json:"cacheCapacity"`
+
+	// Selector selects the Datasets in the same namespace that the quota applies to.
+	// All the Datasets in the namespace are selected if it's not set.
+	// +optional
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+}
+
+// DatasetCacheUsage describes the cache usage of a single Dataset
+type DatasetCacheUsage struct {
+	// Name of the Dataset
+	Name string `json:"name"`
+
+	// RuntimeType is the type of the runtime of the Dataset (e.g. alluxio)
+	// +optional
+	RuntimeType string `json:"runtimeType,omitempty"`
+
+	// Requested is the cache capacity requested by the runtime of the Dataset, whether it is bound or not
+	// +optional
+	Requested resource.Quantity `json:"requested,omitempty"`
+
+	// Used is the cache capacity reported in the Dataset's cache states
+	// +optional
+	Used resource.Quantity `json:"used,omitempty"`
+
+	// Cached is the amount of data cached reported in the Dataset's cache states
+	// +optional
+	Cached resource.Quantity `json:"cached,omitempty"`
+}
+
+// DatasetQuotaStatus defines the observed state of DatasetQuota
+type DatasetQuotaStatus struct {
+	// Requested is the total cache capacity requested by the runtimes of the selected Datasets
+	// +optional
+	Requested resource.Quantity `json:"requested,omitempty"`
+
+	// Used is the total cache capacity reported by the selected Datasets
+	// +optional
+	Used resource.Quantity `json:"used,omitempty"`
+
+	// Cached is the total amount of data cached by the selected Datasets
+	// +optional
+	Cached resource.Quantity `json:"cached,omitempty"`
+
+	// Datasets are the cache usages of the selected Datasets
+	// +optional
+	Datasets []DatasetCacheUsage `json:"datasets,omitempty"`
+
+	// LastUpdateTime is the last time the usage was calculated
+	// +optional
+	LastUpdateTime *metav1.Time `json:"lastUpdateTime,omitempty"`
+}
+
+// +kubebuilder:printcolumn:name="Capacity",type="string",JSONPath=`.spec.cacheCapacity`
+// +kubebuilder:printcolumn:name="Requested",type="string",JSONPath=`.status.requested`
+// +kubebuilder:printcolumn:name="Used",type="string",JSONPath=`.status.used`
+// +kubebuilder:printcolumn:name="Cached",type="string",JSONPath=`.status.cached`
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced
+// +kubebuilder:resource:categories={fluid},shortName=dsquota
+
+// DatasetQuota is the Schema for the datasetquotas API
+type DatasetQuota struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DatasetQuotaSpec   `json:"spec,omitempty"`
+	Status DatasetQuotaStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Namespaced
+
+// DatasetQuotaList contains a list of DatasetQuota
+type DatasetQuotaList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DatasetQuota `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&DatasetQuota{}, &DatasetQuotaList{})
+}
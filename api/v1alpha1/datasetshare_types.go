@@ -0,0 +1,104 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DatasetShareAccessMode is the access to the shared Dataset granted to the referring datasets
+// +kubebuilder:validation:Enum=ReadOnly;ReadWrite
+type DatasetShareAccessMode string
+
+const (
+	// DatasetShareReadOnly allows the referring datasets to be mounted read-only, i.e. ReadOnlyMany
+	DatasetShareReadOnly DatasetShareAccessMode = "ReadOnly"
+
+	// DatasetShareReadWrite allows the referring datasets to be mounted with any access modes
+	DatasetShareReadWrite DatasetShareAccessMode = "ReadWrite"
+)
+
+// DatasetShareServiceAccount refers to a service account allowed to create the referring datasets
+type DatasetShareServiceAccount struct {
+	// Namespace of the service account
+	// +required
+	Namespace string `json:"namespace"`
+
+	// Name of the service account
+	// +required
+	Name string `json:"name"`
+}
+
+// DatasetShareGrant allows the datasets in some namespaces, or created by some service accounts,
+// to refer to the shared Dataset
+type DatasetShareGrant struct {
+	// Namespaces are the namespaces whose datasets are allowed to refer to the shared Dataset,
+	// "*" means all the namespaces
+	// +optional
+	Namespaces []string `json:"namespaces,omitempty"`
+
+	// ServiceAccounts are the service accounts allowed to create datasets referring to the shared Dataset
+	// in their own namespaces
+	// +optional
+	ServiceAccounts []DatasetShareServiceAccount `json:"serviceAccounts,omitempty"`
+
+	// AccessMode is the access granted to the referring datasets, defaults to ReadOnly
+	// +kubebuilder:default=ReadOnly
+	// +optional
+	AccessMode DatasetShareAccessMode `json:"accessMode,omitempty"`
+}
+
+// DatasetShareSpec defines the desired state of DatasetShare
+type DatasetShareSpec struct {
+	// Dataset is the name of the shared Dataset in the same namespace
+	// +required
+	Dataset string `json:"dataset"`
+
+	// Grants are the datasets allowed to refer to the shared Dataset with dataset://
+	// +kubebuilder:validation:MinItems=1
+	// +required
+	Grants []DatasetShareGrant `json:"grants"`
+}
+
+// +kubebuilder:printcolumn:name="Dataset",type="string",JSONPath=`.spec.dataset`
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Namespaced
+// +kubebuilder:resource:categories={fluid},shortName=dsshare
+
+// DatasetShare is the Schema for the datasetshares API, it grants the datasets in other namespaces
+// access to a Dataset
+type DatasetShare struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec DatasetShareSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Namespaced
+
+// DatasetShareList contains a list of DatasetShare
+type DatasetShareList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DatasetShare `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&DatasetShare{}, &DatasetShareList{})
+}
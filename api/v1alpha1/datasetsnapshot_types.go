@@ -0,0 +1,141 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+)
+
+// DatasetSnapshotMethod is the way to capture the point-in-time view of a dataset
+type DatasetSnapshotMethod string
+
+const (
+	// DatasetSnapshotMethodClone clones the data of the dataset inside the file system, e.g. with `juicefs clone`
+	DatasetSnapshotMethodClone DatasetSnapshotMethod = "Clone"
+
+	// DatasetSnapshotMethodDataBackup backs up the metadata of the dataset through a DataBackup, and records the
+	// manifest of the files the data is verified against
+	DatasetSnapshotMethodDataBackup DatasetSnapshotMethod = "DataBackup"
+
+	// DatasetSnapshotMethodManifest records the paths, sizes and modification times of the files in the dataset,
+	// the data is verified against them before a dataset is mounted from the snapshot
+	DatasetSnapshotMethodManifest DatasetSnapshotMethod = "Manifest"
+)
+
+// DatasetSnapshotSpec defines the desired state of DatasetSnapshot
+type DatasetSnapshotSpec struct {
+	// Dataset is the name of the dataset to snapshot in the same namespace
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Dataset string `json:"dataset"`
+
+	// Method is the way to capture the snapshot. Defaults to Clone for JuiceFSRuntime mounting a sub directory
+	// of the volume, DataBackup for AlluxioRuntime and Manifest for the other runtimes.
+	// +kubebuilder:validation:Enum=Clone;DataBackup;Manifest
+	// +optional
+	Method DatasetSnapshotMethod `json:"method,omitempty"`
+
+	// BackupPath is the path to save the metadata backup with the DataBackup method,
+	// in the form of pvc://<pvcName>/subpath or local://subpath
+	// +optional
+	BackupPath string `json:"backupPath,omitempty"`
+}
+
+// DatasetSnapshotManifest describes the file manifest recorded by the Manifest and DataBackup methods
+type DatasetSnapshotManifest struct {
+	// ConfigMap is the name of the ConfigMap holding the gzipped manifest
+	// +optional
+	ConfigMap string `json:"configMap,omitempty"`
+
+	// Digest is the sha256 digest of the manifest, the data is unchanged if a later manifest has the same digest
+	// +optional
+	Digest string `json:"digest,omitempty"`
+
+	// FileCount is the number of the files in the manifest
+	// +optional
+	FileCount int64 `json:"fileCount,omitempty"`
+
+	// TotalSize is the total size in bytes of the files in the manifest
+	// +optional
+	TotalSize int64 `json:"totalSize,omitempty"`
+}
+
+// DatasetSnapshotStatus defines the observed state of DatasetSnapshot
+type DatasetSnapshotStatus struct {
+	// Phase describes the current phase of the snapshot
+	// +optional
+	Phase common.Phase `json:"phase,omitempty"`
+
+	// Method is the way the snapshot is captured
+	// +optional
+	Method DatasetSnapshotMethod `json:"method,omitempty"`
+
+	// SnapshotTime is the time when the snapshot was captured
+	// +optional
+	SnapshotTime *metav1.Time `json:"snapshotTime,omitempty"`
+
+	// Mounts are the mounts of a dataset mounted from the snapshot, which are read-only
+	// +optional
+	Mounts []Mount `json:"mounts,omitempty"`
+
+	// DataRestoreLocation is the location to restore the metadata from for a dataset mounted from the snapshot
+	// +optional
+	DataRestoreLocation *DataRestoreLocation `json:"dataRestoreLocation,omitempty"`
+
+	// Manifest describes the file manifest the data is verified against before a dataset is mounted from the snapshot
+	// +optional
+	Manifest *DatasetSnapshotManifest `json:"manifest,omitempty"`
+
+	// Conditions consists of transition information on the snapshot's Phase
+	// +optional
+	Conditions []Condition `json:"conditions,omitempty"`
+}
+
+// +kubebuilder:printcolumn:name="Dataset",type="string",JSONPath=`.spec.dataset`
+// +kubebuilder:printcolumn:name="Method",type="string",JSONPath=`.status.method`
+// +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="SnapshotTime",type="date",JSONPath=`.status.snapshotTime`
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced
+// +kubebuilder:resource:categories={fluid},shortName=snapshot
+
+// DatasetSnapshot is the Schema for the datasetsnapshots API
+type DatasetSnapshot struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DatasetSnapshotSpec   `json:"spec,omitempty"`
+	Status DatasetSnapshotStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Namespaced
+
+// DatasetSnapshotList contains a list of DatasetSnapshot
+type DatasetSnapshotList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DatasetSnapshot `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&DatasetSnapshot{}, &DatasetSnapshotList{})
+}
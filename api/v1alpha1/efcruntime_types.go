@@ -159,6 +159,10 @@ type EFCRuntimeSpec struct {
 	// PodMetadata defines labels and annotations that will be propagated to all EFC's pods
 	// +optional
 	PodMetadata PodMetadata `json:"podMetadata,omitempty"`
+
+	// DataOperationPolicy defines the policy of running the data operations on the runtime
+	// +optional
+	DataOperationPolicy DataOperationPolicy `json:"dataOperationPolicy,omitempty"`
 }
 
 // +kubebuilder:object:root=true
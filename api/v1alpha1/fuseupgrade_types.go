@@ -0,0 +1,120 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// FuseUpgradePhase is the phase of the rolling upgrade of the fuse pods
+type FuseUpgradePhase string
+
+const (
+	// FuseUpgradePhaseProgressing means some fuse pods are outdated and being upgraded
+	FuseUpgradePhaseProgressing FuseUpgradePhase = "Progressing"
+
+	// FuseUpgradePhasePaused means the upgrade is paused, no more nodes are drained or upgraded
+	FuseUpgradePhasePaused FuseUpgradePhase = "Paused"
+
+	// FuseUpgradePhaseCompleted means all the fuse pods are up to date
+	FuseUpgradePhaseCompleted FuseUpgradePhase = "Completed"
+)
+
+// FuseUpgradeTargetRef refers to the runtime whose fuse pods are upgraded in the same namespace
+type FuseUpgradeTargetRef struct {
+	// Kind of the runtime
+	// +kubebuilder:validation:Enum=AlluxioRuntime;JindoRuntime;JuiceFSRuntime;VineyardRuntime;EFCRuntime;ThinRuntime
+	// +required
+	Kind string `json:"kind"`
+
+	// Name of the runtime, which is the same as the name of the Dataset bound to it
+	// +required
+	Name string `json:"name"`
+}
+
+// FuseUpgradeSpec defines the desired state of FuseUpgrade
+type FuseUpgradeSpec struct {
+	// RuntimeRef refers to the runtime whose fuse pods are upgraded
+	// +required
+	RuntimeRef FuseUpgradeTargetRef `json:"runtimeRef"`
+
+	// MaxUnavailable is the maximum number or percentage of the nodes being drained or upgraded at the same time,
+	// defaults to 1
+	// +kubebuilder:default=1
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+
+	// Paused stops draining and upgrading more nodes, the upgrade resumes once it's set to false
+	// +optional
+	Paused bool `json:"paused,omitempty"`
+
+	// DrainNodes labels the nodes with outdated fuse pods still in use with draining.fuse.fluid.io/<namespace>-<name>,
+	// so that the workloads avoiding the label move away and the nodes can be upgraded. Otherwise, a node is only
+	// upgraded when the pods using the fuse on it go away by themselves.
+	// +optional
+	DrainNodes bool `json:"drainNodes,omitempty"`
+}
+
+// FuseUpgradeStatus defines the observed state of FuseUpgrade
+type FuseUpgradeStatus struct {
+	// Phase of the upgrade
+	// +optional
+	Phase FuseUpgradePhase `json:"phase,omitempty"`
+
+	// UpgradedNodes is the number of the nodes running the up-to-date fuse pod
+	// +optional
+	UpgradedNodes int32 `json:"upgradedNodes,omitempty"`
+
+	// TotalNodes is the number of the nodes running the fuse pod
+	// +optional
+	TotalNodes int32 `json:"totalNodes,omitempty"`
+}
+
+// +kubebuilder:printcolumn:name="Kind",type="string",JSONPath=`.spec.runtimeRef.kind`
+// +kubebuilder:printcolumn:name="Runtime",type="string",JSONPath=`.spec.runtimeRef.name`
+// +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Upgraded",type="integer",JSONPath=`.status.upgradedNodes`
+// +kubebuilder:printcolumn:name="Total",type="integer",JSONPath=`.status.totalNodes`
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced
+// +kubebuilder:resource:categories={fluid},shortName=fu
+
+// FuseUpgrade is the Schema for the fuseupgrades API
+type FuseUpgrade struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   FuseUpgradeSpec   `json:"spec,omitempty"`
+	Status FuseUpgradeStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Namespaced
+
+// FuseUpgradeList contains a list of FuseUpgrade
+type FuseUpgradeList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []FuseUpgrade `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&FuseUpgrade{}, &FuseUpgradeList{})
+}
@@ -219,6 +219,10 @@ type JindoRuntimeSpec struct {
 	// ImagePullSecrets that will be used to pull images
 	// +optional
 	ImagePullSecrets []corev1.LocalObjectReference `json:"imagePullSecrets,omitempty"`
+
+	// DataOperationPolicy defines the policy of running the data operations on the runtime
+	// +optional
+	DataOperationPolicy DataOperationPolicy `json:"dataOperationPolicy,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -85,6 +85,10 @@ type JuiceFSRuntimeSpec struct {
 	// RuntimeManagement defines policies when managing the runtime
 	// +optional
 	RuntimeManagement RuntimeManagement `json:"management,omitempty"`
+
+	// DataOperationPolicy defines the policy of running the data operations on the runtime
+	// +optional
+	DataOperationPolicy DataOperationPolicy `json:"dataOperationPolicy,omitempty"`
 }
 
 // JuiceFSCompTemplateSpec is a description of the JuiceFS components
@@ -35,6 +35,15 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.AlluxioRuntime":                    schema_fluid_cloudnative_fluid_api_v1alpha1_AlluxioRuntime(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.AlluxioRuntimeList":                schema_fluid_cloudnative_fluid_api_v1alpha1_AlluxioRuntimeList(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.AlluxioRuntimeSpec":                schema_fluid_cloudnative_fluid_api_v1alpha1_AlluxioRuntimeSpec(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.CSISecretStoreSelector":            schema_fluid_cloudnative_fluid_api_v1alpha1_CSISecretStoreSelector(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.CacheAutoscaler":                   schema_fluid_cloudnative_fluid_api_v1alpha1_CacheAutoscaler(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.CacheAutoscalerDecision":           schema_fluid_cloudnative_fluid_api_v1alpha1_CacheAutoscalerDecision(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.CacheAutoscalerList":               schema_fluid_cloudnative_fluid_api_v1alpha1_CacheAutoscalerList(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.CacheAutoscalerMetric":             schema_fluid_cloudnative_fluid_api_v1alpha1_CacheAutoscalerMetric(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.CacheAutoscalerMetricStatus":       schema_fluid_cloudnative_fluid_api_v1alpha1_CacheAutoscalerMetricStatus(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.CacheAutoscalerSpec":               schema_fluid_cloudnative_fluid_api_v1alpha1_CacheAutoscalerSpec(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.CacheAutoscalerStatus":             schema_fluid_cloudnative_fluid_api_v1alpha1_CacheAutoscalerStatus(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.CacheAutoscalerTargetRef":          schema_fluid_cloudnative_fluid_api_v1alpha1_CacheAutoscalerTargetRef(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.CacheRuntime":                      schema_fluid_cloudnative_fluid_api_v1alpha1_CacheRuntime(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.CacheRuntimeClass":                 schema_fluid_cloudnative_fluid_api_v1alpha1_CacheRuntimeClass(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.CacheRuntimeClassList":             schema_fluid_cloudnative_fluid_api_v1alpha1_CacheRuntimeClassList(ref),
@@ -53,16 +62,27 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.Condition":                         schema_fluid_cloudnative_fluid_api_v1alpha1_Condition(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.ConfigMapDependencyConfig":         schema_fluid_cloudnative_fluid_api_v1alpha1_ConfigMapDependencyConfig(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.ConfigMapRuntimeExtraResource":     schema_fluid_cloudnative_fluid_api_v1alpha1_ConfigMapRuntimeExtraResource(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.CredentialProviderSelector":        schema_fluid_cloudnative_fluid_api_v1alpha1_CredentialProviderSelector(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.Data":                              schema_fluid_cloudnative_fluid_api_v1alpha1_Data(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataBackup":                        schema_fluid_cloudnative_fluid_api_v1alpha1_DataBackup(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataBackupList":                    schema_fluid_cloudnative_fluid_api_v1alpha1_DataBackupList(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataBackupSpec":                    schema_fluid_cloudnative_fluid_api_v1alpha1_DataBackupSpec(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataFlow":                          schema_fluid_cloudnative_fluid_api_v1alpha1_DataFlow(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataFlowList":                      schema_fluid_cloudnative_fluid_api_v1alpha1_DataFlowList(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataFlowParameter":                 schema_fluid_cloudnative_fluid_api_v1alpha1_DataFlowParameter(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataFlowSpec":                      schema_fluid_cloudnative_fluid_api_v1alpha1_DataFlowSpec(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataFlowStatus":                    schema_fluid_cloudnative_fluid_api_v1alpha1_DataFlowStatus(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataFlowStep":                      schema_fluid_cloudnative_fluid_api_v1alpha1_DataFlowStep(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataFlowStepAffinity":              schema_fluid_cloudnative_fluid_api_v1alpha1_DataFlowStepAffinity(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataFlowStepStatus":                schema_fluid_cloudnative_fluid_api_v1alpha1_DataFlowStepStatus(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataLoad":                          schema_fluid_cloudnative_fluid_api_v1alpha1_DataLoad(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataLoadList":                      schema_fluid_cloudnative_fluid_api_v1alpha1_DataLoadList(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataLoadSpec":                      schema_fluid_cloudnative_fluid_api_v1alpha1_DataLoadSpec(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataLoadTargetNodes":               schema_fluid_cloudnative_fluid_api_v1alpha1_DataLoadTargetNodes(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataMigrate":                       schema_fluid_cloudnative_fluid_api_v1alpha1_DataMigrate(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataMigrateList":                   schema_fluid_cloudnative_fluid_api_v1alpha1_DataMigrateList(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataMigrateSpec":                   schema_fluid_cloudnative_fluid_api_v1alpha1_DataMigrateSpec(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataOperationPolicy":               schema_fluid_cloudnative_fluid_api_v1alpha1_DataOperationPolicy(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataOperationSpec":                 schema_fluid_cloudnative_fluid_api_v1alpha1_DataOperationSpec(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataProcess":                       schema_fluid_cloudnative_fluid_api_v1alpha1_DataProcess(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataProcessList":                   schema_fluid_cloudnative_fluid_api_v1alpha1_DataProcessList(ref),
@@ -70,8 +90,25 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataRestoreLocation":               schema_fluid_cloudnative_fluid_api_v1alpha1_DataRestoreLocation(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataToMigrate":                     schema_fluid_cloudnative_fluid_api_v1alpha1_DataToMigrate(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.Dataset":                           schema_fluid_cloudnative_fluid_api_v1alpha1_Dataset(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.DatasetCacheUsage":                 schema_fluid_cloudnative_fluid_api_v1alpha1_DatasetCacheUsage(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.DatasetCondition":                  schema_fluid_cloudnative_fluid_api_v1alpha1_DatasetCondition(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.DatasetList":                       schema_fluid_cloudnative_fluid_api_v1alpha1_DatasetList(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.DatasetQuota":                      schema_fluid_cloudnative_fluid_api_v1alpha1_DatasetQuota(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.DatasetQuotaList":                  schema_fluid_cloudnative_fluid_api_v1alpha1_DatasetQuotaList(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.DatasetQuotaSpec":                  schema_fluid_cloudnative_fluid_api_v1alpha1_DatasetQuotaSpec(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.DatasetQuotaStatus":                schema_fluid_cloudnative_fluid_api_v1alpha1_DatasetQuotaStatus(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.DatasetRefGrant":                   schema_fluid_cloudnative_fluid_api_v1alpha1_DatasetRefGrant(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.DatasetShare":                      schema_fluid_cloudnative_fluid_api_v1alpha1_DatasetShare(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.DatasetShareGrant":                 schema_fluid_cloudnative_fluid_api_v1alpha1_DatasetShareGrant(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.DatasetShareList":                  schema_fluid_cloudnative_fluid_api_v1alpha1_DatasetShareList(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.DatasetShareServiceAccount":        schema_fluid_cloudnative_fluid_api_v1alpha1_DatasetShareServiceAccount(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.DatasetShareSpec":                  schema_fluid_cloudnative_fluid_api_v1alpha1_DatasetShareSpec(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.DatasetSnapshot":                   schema_fluid_cloudnative_fluid_api_v1alpha1_DatasetSnapshot(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.DatasetSnapshotList":               schema_fluid_cloudnative_fluid_api_v1alpha1_DatasetSnapshotList(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.DatasetSnapshotManifest":           schema_fluid_cloudnative_fluid_api_v1alpha1_DatasetSnapshotManifest(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.DatasetSnapshotRef":                schema_fluid_cloudnative_fluid_api_v1alpha1_DatasetSnapshotRef(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.DatasetSnapshotSpec":               schema_fluid_cloudnative_fluid_api_v1alpha1_DatasetSnapshotSpec(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.DatasetSnapshotStatus":             schema_fluid_cloudnative_fluid_api_v1alpha1_DatasetSnapshotStatus(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.DatasetSpec":                       schema_fluid_cloudnative_fluid_api_v1alpha1_DatasetSpec(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.DatasetStatus":                     schema_fluid_cloudnative_fluid_api_v1alpha1_DatasetStatus(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.DatasetToMigrate":                  schema_fluid_cloudnative_fluid_api_v1alpha1_DatasetToMigrate(ref),
@@ -83,11 +120,21 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.EmptyDirMediumSource":              schema_fluid_cloudnative_fluid_api_v1alpha1_EmptyDirMediumSource(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.EncryptOption":                     schema_fluid_cloudnative_fluid_api_v1alpha1_EncryptOption(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.EncryptOptionSource":               schema_fluid_cloudnative_fluid_api_v1alpha1_EncryptOptionSource(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.EventRun":                          schema_fluid_cloudnative_fluid_api_v1alpha1_EventRun(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.EventSource":                       schema_fluid_cloudnative_fluid_api_v1alpha1_EventSource(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.EventTriggerStatus":                schema_fluid_cloudnative_fluid_api_v1alpha1_EventTriggerStatus(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.ExecutionCommonEntry":              schema_fluid_cloudnative_fluid_api_v1alpha1_ExecutionCommonEntry(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.ExecutionEntries":                  schema_fluid_cloudnative_fluid_api_v1alpha1_ExecutionEntries(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.ExternalEndpointSpec":              schema_fluid_cloudnative_fluid_api_v1alpha1_ExternalEndpointSpec(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.ExternalMutator":                   schema_fluid_cloudnative_fluid_api_v1alpha1_ExternalMutator(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.ExternalStorage":                   schema_fluid_cloudnative_fluid_api_v1alpha1_ExternalStorage(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.ExtraResourcesComponentDependency": schema_fluid_cloudnative_fluid_api_v1alpha1_ExtraResourcesComponentDependency(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.FuseNodeUpgradeStatus":             schema_fluid_cloudnative_fluid_api_v1alpha1_FuseNodeUpgradeStatus(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.FuseUpgrade":                       schema_fluid_cloudnative_fluid_api_v1alpha1_FuseUpgrade(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.FuseUpgradeList":                   schema_fluid_cloudnative_fluid_api_v1alpha1_FuseUpgradeList(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.FuseUpgradeSpec":                   schema_fluid_cloudnative_fluid_api_v1alpha1_FuseUpgradeSpec(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.FuseUpgradeStatus":                 schema_fluid_cloudnative_fluid_api_v1alpha1_FuseUpgradeStatus(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.FuseUpgradeTargetRef":              schema_fluid_cloudnative_fluid_api_v1alpha1_FuseUpgradeTargetRef(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.HCFSStatus":                        schema_fluid_cloudnative_fluid_api_v1alpha1_HCFSStatus(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.HeadlessRuntimeComponentService":   schema_fluid_cloudnative_fluid_api_v1alpha1_HeadlessRuntimeComponentService(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.HostPathMediumSource":              schema_fluid_cloudnative_fluid_api_v1alpha1_HostPathMediumSource(ref),
@@ -109,15 +156,23 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.Metadata":                          schema_fluid_cloudnative_fluid_api_v1alpha1_Metadata(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.MetadataSyncPolicy":                schema_fluid_cloudnative_fluid_api_v1alpha1_MetadataSyncPolicy(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.Mount":                             schema_fluid_cloudnative_fluid_api_v1alpha1_Mount(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.MutatorServiceReference":           schema_fluid_cloudnative_fluid_api_v1alpha1_MutatorServiceReference(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.OSAdvise":                          schema_fluid_cloudnative_fluid_api_v1alpha1_OSAdvise(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.ObjectRef":                         schema_fluid_cloudnative_fluid_api_v1alpha1_ObjectRef(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.OperationAttempt":                  schema_fluid_cloudnative_fluid_api_v1alpha1_OperationAttempt(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.OperationRef":                      schema_fluid_cloudnative_fluid_api_v1alpha1_OperationRef(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.OperationStatus":                   schema_fluid_cloudnative_fluid_api_v1alpha1_OperationStatus(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata":                       schema_fluid_cloudnative_fluid_api_v1alpha1_PodMetadata(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMutationPlugin":                 schema_fluid_cloudnative_fluid_api_v1alpha1_PodMutationPlugin(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMutationPolicy":                 schema_fluid_cloudnative_fluid_api_v1alpha1_PodMutationPolicy(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMutationPolicyList":             schema_fluid_cloudnative_fluid_api_v1alpha1_PodMutationPolicyList(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMutationPolicySpec":             schema_fluid_cloudnative_fluid_api_v1alpha1_PodMutationPolicySpec(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.Prefer":                            schema_fluid_cloudnative_fluid_api_v1alpha1_Prefer(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.ProcessMemoryMediumSource":         schema_fluid_cloudnative_fluid_api_v1alpha1_ProcessMemoryMediumSource(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.Processor":                         schema_fluid_cloudnative_fluid_api_v1alpha1_Processor(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.Require":                           schema_fluid_cloudnative_fluid_api_v1alpha1_Require(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.RetryBackoff":                      schema_fluid_cloudnative_fluid_api_v1alpha1_RetryBackoff(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.RetryPolicy":                       schema_fluid_cloudnative_fluid_api_v1alpha1_RetryPolicy(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.Runtime":                           schema_fluid_cloudnative_fluid_api_v1alpha1_Runtime(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeComponentCommonSpec":        schema_fluid_cloudnative_fluid_api_v1alpha1_RuntimeComponentCommonSpec(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeComponentDefinition":        schema_fluid_cloudnative_fluid_api_v1alpha1_RuntimeComponentDefinition(ref),
@@ -127,6 +182,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeComponentStatusCollection":  schema_fluid_cloudnative_fluid_api_v1alpha1_RuntimeComponentStatusCollection(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeCondition":                  schema_fluid_cloudnative_fluid_api_v1alpha1_RuntimeCondition(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeExtraResources":             schema_fluid_cloudnative_fluid_api_v1alpha1_RuntimeExtraResources(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeFuseUpgradeStatus":          schema_fluid_cloudnative_fluid_api_v1alpha1_RuntimeFuseUpgradeStatus(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeManagement":                 schema_fluid_cloudnative_fluid_api_v1alpha1_RuntimeManagement(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeStatus":                     schema_fluid_cloudnative_fluid_api_v1alpha1_RuntimeStatus(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeTieredStore":                schema_fluid_cloudnative_fluid_api_v1alpha1_RuntimeTieredStore(ref),
@@ -137,6 +193,7 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.SecretMountComponentDependency":    schema_fluid_cloudnative_fluid_api_v1alpha1_SecretMountComponentDependency(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.TargetDataset":                     schema_fluid_cloudnative_fluid_api_v1alpha1_TargetDataset(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.TargetDatasetWithMountPath":        schema_fluid_cloudnative_fluid_api_v1alpha1_TargetDatasetWithMountPath(ref),
+		"github.com/fluid-cloudnative/fluid/api/v1alpha1.TargetNodeStatus":                  schema_fluid_cloudnative_fluid_api_v1alpha1_TargetNodeStatus(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.TargetPath":                        schema_fluid_cloudnative_fluid_api_v1alpha1_TargetPath(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.ThinCompTemplateSpec":              schema_fluid_cloudnative_fluid_api_v1alpha1_ThinCompTemplateSpec(ref),
 		"github.com/fluid-cloudnative/fluid/api/v1alpha1.ThinFuseSpec":                      schema_fluid_cloudnative_fluid_api_v1alpha1_ThinFuseSpec(ref),
@@ -833,19 +890,55 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_AlluxioRuntimeSpec(ref common.R
 							},
 						},
 					},
+					"dataOperationPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DataOperationPolicy defines the policy of running the data operations on the runtime",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DataOperationPolicy"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/fluid-cloudnative/fluid/api/v1alpha1.AlluxioCompTemplateSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.AlluxioFuseSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.Data", "github.com/fluid-cloudnative/fluid/api/v1alpha1.InitUsersSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata", "github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeManagement", "github.com/fluid-cloudnative/fluid/api/v1alpha1.TieredStore", "github.com/fluid-cloudnative/fluid/api/v1alpha1.User", "github.com/fluid-cloudnative/fluid/api/v1alpha1.VersionSpec", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/api/core/v1.Volume"},
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.AlluxioCompTemplateSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.AlluxioFuseSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.Data", "github.com/fluid-cloudnative/fluid/api/v1alpha1.DataOperationPolicy", "github.com/fluid-cloudnative/fluid/api/v1alpha1.InitUsersSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata", "github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeManagement", "github.com/fluid-cloudnative/fluid/api/v1alpha1.TieredStore", "github.com/fluid-cloudnative/fluid/api/v1alpha1.User", "github.com/fluid-cloudnative/fluid/api/v1alpha1.VersionSpec", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/api/core/v1.Volume"},
 	}
 }
 
-func schema_fluid_cloudnative_fluid_api_v1alpha1_CacheRuntime(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_fluid_cloudnative_fluid_api_v1alpha1_CSISecretStoreSelector(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "CacheRuntime is the Schema for the CacheRuntimes API",
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"secretProviderClass": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The name of the SecretProviderClass in the namespace of the dataset",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"objectName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The required object name, i.e. the file name, in the volume",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"secretProviderClass", "objectName"},
+			},
+		},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_CacheAutoscaler(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CacheAutoscaler is the Schema for the cacheautoscalers API",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -871,99 +964,73 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_CacheRuntime(ref common.Referen
 					"spec": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.CacheRuntimeSpec"),
+							Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.CacheAutoscalerSpec"),
 						},
 					},
 					"status": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.CacheRuntimeStatus"),
+							Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.CacheAutoscalerStatus"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/fluid-cloudnative/fluid/api/v1alpha1.CacheRuntimeSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.CacheRuntimeStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.CacheAutoscalerSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.CacheAutoscalerStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_fluid_cloudnative_fluid_api_v1alpha1_CacheRuntimeClass(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_fluid_cloudnative_fluid_api_v1alpha1_CacheAutoscalerDecision(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "CacheRuntimeClass is the Schema for the cacheruntimeclasses API. CacheRuntimeClass defines a class of cache runtime implementations with specific configurations.",
+				Description: "CacheAutoscalerDecision records a scaling of the runtime workers",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"time": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Time when the runtime was scaled",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"apiVersion": {
+					"from": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "From is the worker replicas before scaling",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"metadata": {
+					"to": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Description: "To is the worker replicas after scaling",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"fileSystemType": {
+					"reason": {
 						SchemaProps: spec.SchemaProps{
-							Description: "FileSystemType is the file system type of the cache runtime (e.g., \"alluxio\", \"juicefs\")",
-							Default:     "",
+							Description: "Reason explains the decision",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"topology": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Topology describes the topology of the CacheRuntime components (master, worker, client)",
-							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeTopology"),
-						},
-					},
-					"extraResources": {
-						SchemaProps: spec.SchemaProps{
-							Description: "ExtraResources specifies additional resources (e.g., ConfigMaps) used by the CacheRuntime components",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeExtraResources"),
-						},
-					},
-					"dataOperationSpecs": {
-						SchemaProps: spec.SchemaProps{
-							Description: "DataOperationSpecs specifies the data operation spec",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DataOperationSpec"),
-									},
-								},
-							},
-						},
-					},
 				},
-				Required: []string{"fileSystemType"},
+				Required: []string{"time", "from", "to"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataOperationSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeExtraResources", "github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeTopology", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_fluid_cloudnative_fluid_api_v1alpha1_CacheRuntimeClassList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_fluid_cloudnative_fluid_api_v1alpha1_CacheAutoscalerList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "CacheRuntimeClassList contains a list of CacheRuntimeClass",
+				Description: "CacheAutoscalerList contains a list of CacheAutoscaler",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -993,7 +1060,7 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_CacheRuntimeClassList(ref commo
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.CacheRuntimeClass"),
+										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.CacheAutoscaler"),
 									},
 								},
 							},
@@ -1004,183 +1071,358 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_CacheRuntimeClassList(ref commo
 			},
 		},
 		Dependencies: []string{
-			"github.com/fluid-cloudnative/fluid/api/v1alpha1.CacheRuntimeClass", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.CacheAutoscaler", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_fluid_cloudnative_fluid_api_v1alpha1_CacheRuntimeClientSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_fluid_cloudnative_fluid_api_v1alpha1_CacheAutoscalerMetric(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "CacheRuntimeClientSpec describes the desired state of CacheRuntime client component",
+				Description: "CacheAutoscalerMetric defines a signal and its target value",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"disabled": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Disabled indicates whether the component should be disabled. If set to true, the component will not be created.",
-							Type:        []string{"boolean"},
+							Description: "Type of the metric",
+							Default:     "",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"runtimeVersion": {
-						SchemaProps: spec.SchemaProps{
-							Description: "RuntimeVersion is the version information that instructs Fluid to orchestrate a particular version of the runtime.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.VersionSpec"),
-						},
-					},
-					"options": {
+					"target": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Options is a set of key-value pairs that provide additional configuration for the cache system.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "Target is the target value of the metric. It's a percentage from 1 to 100 for CachedPercentage and CacheCapacityUsed, or the number of pods per worker for MountingPods.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"podMetadata": {
+				},
+				Required: []string{"type", "target"},
+			},
+		},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_CacheAutoscalerMetricStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CacheAutoscalerMetricStatus is the observed value of a metric and the replicas it recommends",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "PodMetadata contains labels and annotations that will be propagated to the component's pods.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata"),
+							Description: "Type of the metric",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"resources": {
+					"current": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Resources describes the compute resource requirements. More info: https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/",
-							Default:     map[string]interface{}{},
-							Ref:         ref("k8s.io/api/core/v1.ResourceRequirements"),
+							Description: "Current is the observed value of the metric, e.g. 85.5% or 12",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"env": {
-						VendorExtensible: spec.VendorExtensible{
-							Extensions: spec.Extensions{
-								"x-kubernetes-patch-merge-key": "name",
-								"x-kubernetes-patch-strategy":  "merge",
-							},
-						},
+					"recommendedReplicas": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Env is a list of environment variables to set in the container.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("k8s.io/api/core/v1.EnvVar"),
-									},
-								},
-							},
+							Description: "RecommendedReplicas is the worker replicas recommended by the metric",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"volumeMounts": {
-						VendorExtensible: spec.VendorExtensible{
-							Extensions: spec.Extensions{
-								"x-kubernetes-patch-merge-key": "mountPath",
-								"x-kubernetes-patch-strategy":  "merge",
-							},
-						},
+				},
+				Required: []string{"type"},
+			},
+		},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_CacheAutoscalerSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CacheAutoscalerSpec defines the desired state of CacheAutoscaler",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"scaleTargetRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "VolumeMounts are the volumes to mount into the container's filesystem. Cannot be updated.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("k8s.io/api/core/v1.VolumeMount"),
-									},
-								},
-							},
+							Description: "ScaleTargetRef refers to the runtime whose workers are scaled",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.CacheAutoscalerTargetRef"),
 						},
 					},
-					"args": {
-						VendorExtensible: spec.VendorExtensible{
-							Extensions: spec.Extensions{
-								"x-kubernetes-list-type": "atomic",
-							},
-						},
+					"minReplicas": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Args are arguments to the entrypoint. The container image's CMD is used if this is not provided.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "MinReplicas is the lower limit of the worker replicas, defaults to 1",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"nodeSelector": {
-						VendorExtensible: spec.VendorExtensible{
-							Extensions: spec.Extensions{
-								"x-kubernetes-map-type": "atomic",
-							},
-						},
+					"maxReplicas": {
 						SchemaProps: spec.SchemaProps{
-							Description: "NodeSelector is a selector which must be true for the pod to fit on a node. Selector which must match a node's labels for the pod to be scheduled on that node. More info: https://kubernetes.io/docs/concepts/configuration/assign-pod-node/",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "MaxReplicas is the upper limit of the worker replicas",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"tolerations": {
+					"metrics": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Tolerations are the pod's tolerations. If specified, the pod's tolerations. More info: https://kubernetes.io/docs/concepts/scheduling-eviction/taint-and-toleration/",
+							Description: "Metrics are the signals used to calculate the worker replicas. The largest replicas recommended by the metrics is used.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("k8s.io/api/core/v1.Toleration"),
+										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.CacheAutoscalerMetric"),
 									},
 								},
 							},
 						},
 					},
-					"tieredStore": {
+					"scaleUpCooldownSeconds": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TieredStore describes the tiered storage configuration used by the client component.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeTieredStore"),
+							Description: "ScaleUpCooldownSeconds is the minimum time between the last scaling and a scale up, defaults to 60",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"cleanPolicy": {
+					"scaleDownCooldownSeconds": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CleanPolicy determines when to clean up the FUSE client pods. Currently supports two policies: - \"OnDemand\": Clean up the FUSE pod when it is no longer needed on a node - \"OnRuntimeDeleted\": Clean up the FUSE pod only when the CacheRuntime is deleted Defaults to \"OnRuntimeDeleted\".",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "ScaleDownCooldownSeconds is the minimum time between the last scaling and a scale down, defaults to 300",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
 				},
+				Required: []string{"scaleTargetRef", "maxReplicas", "metrics"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata", "github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeTieredStore", "github.com/fluid-cloudnative/fluid/api/v1alpha1.VersionSpec", "k8s.io/api/core/v1.EnvVar", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.Toleration", "k8s.io/api/core/v1.VolumeMount"},
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.CacheAutoscalerMetric", "github.com/fluid-cloudnative/fluid/api/v1alpha1.CacheAutoscalerTargetRef"},
 	}
 }
 
-func schema_fluid_cloudnative_fluid_api_v1alpha1_CacheRuntimeList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_fluid_cloudnative_fluid_api_v1alpha1_CacheAutoscalerStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "CacheRuntimeList contains a list of CacheRuntime",
+				Description: "CacheAutoscalerStatus defines the observed state of CacheAutoscaler",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"currentReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CurrentReplicas is the worker replicas of the runtime observed last time",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"desiredReplicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DesiredReplicas is the worker replicas calculated last time",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"currentMetrics": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CurrentMetrics are the metrics observed last time",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.CacheAutoscalerMetricStatus"),
+									},
+								},
+							},
+						},
+					},
+					"lastScaleTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LastScaleTime is the last time the runtime was scaled by the autoscaler",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"decisions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Decisions are the recent scalings made by the autoscaler, the latest one comes first",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.CacheAutoscalerDecision"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.CacheAutoscalerDecision", "github.com/fluid-cloudnative/fluid/api/v1alpha1.CacheAutoscalerMetricStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_CacheAutoscalerTargetRef(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CacheAutoscalerTargetRef refers to the runtime to scale in the same namespace",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind of the runtime",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the runtime, which is the same as the name of the Dataset bound to it",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"kind", "name"},
+			},
+		},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_CacheRuntime(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CacheRuntime is the Schema for the CacheRuntimes API",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.CacheRuntimeSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.CacheRuntimeStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.CacheRuntimeSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.CacheRuntimeStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_CacheRuntimeClass(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CacheRuntimeClass is the Schema for the cacheruntimeclasses API. CacheRuntimeClass defines a class of cache runtime implementations with specific configurations.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"fileSystemType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FileSystemType is the file system type of the cache runtime (e.g., \"alluxio\", \"juicefs\")",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"topology": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Topology describes the topology of the CacheRuntime components (master, worker, client)",
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeTopology"),
+						},
+					},
+					"extraResources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExtraResources specifies additional resources (e.g., ConfigMaps) used by the CacheRuntime components",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeExtraResources"),
+						},
+					},
+					"dataOperationSpecs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DataOperationSpecs specifies the data operation spec",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DataOperationSpec"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"fileSystemType"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataOperationSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeExtraResources", "github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeTopology", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_CacheRuntimeClassList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CacheRuntimeClassList contains a list of CacheRuntimeClass",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -1210,7 +1452,7 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_CacheRuntimeList(ref common.Ref
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.CacheRuntime"),
+										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.CacheRuntimeClass"),
 									},
 								},
 							},
@@ -1221,15 +1463,15 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_CacheRuntimeList(ref common.Ref
 			},
 		},
 		Dependencies: []string{
-			"github.com/fluid-cloudnative/fluid/api/v1alpha1.CacheRuntime", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.CacheRuntimeClass", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_fluid_cloudnative_fluid_api_v1alpha1_CacheRuntimeMasterSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_fluid_cloudnative_fluid_api_v1alpha1_CacheRuntimeClientSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "CacheRuntimeMasterSpec describes the desired state of CacheRuntime master component",
+				Description: "CacheRuntimeClientSpec describes the desired state of CacheRuntime client component",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"disabled": {
@@ -1371,65 +1613,282 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_CacheRuntimeMasterSpec(ref comm
 							},
 						},
 					},
-					"replicas": {
+					"tieredStore": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Replicas is the desired number of replicas of the master component. If unspecified, defaults to 1.",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "TieredStore describes the tiered storage configuration used by the client component.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeTieredStore"),
 						},
 					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata", "github.com/fluid-cloudnative/fluid/api/v1alpha1.VersionSpec", "k8s.io/api/core/v1.EnvVar", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.Toleration", "k8s.io/api/core/v1.VolumeMount"},
-	}
-}
-
-func schema_fluid_cloudnative_fluid_api_v1alpha1_CacheRuntimeMountUfsOutput(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
-					"mounted": {
+					"cleanPolicy": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Mounted are the ufs paths that have been mounted.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "CleanPolicy determines when to clean up the FUSE client pods. Currently supports two policies: - \"OnDemand\": Clean up the FUSE pod when it is no longer needed on a node - \"OnRuntimeDeleted\": Clean up the FUSE pod only when the CacheRuntime is deleted Defaults to \"OnRuntimeDeleted\".",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata", "github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeTieredStore", "github.com/fluid-cloudnative/fluid/api/v1alpha1.VersionSpec", "k8s.io/api/core/v1.EnvVar", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.Toleration", "k8s.io/api/core/v1.VolumeMount"},
 	}
 }
 
-func schema_fluid_cloudnative_fluid_api_v1alpha1_CacheRuntimeReportSummary(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_fluid_cloudnative_fluid_api_v1alpha1_CacheRuntimeList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "CacheRuntimeReportSummary defines the return structure for ReportSummary execution entry. It contains cache status information such as capacity, hit ratio, and cached data size.",
+				Description: "CacheRuntimeList contains a list of CacheRuntime",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"cached": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Cached is the amount of data cached, in bytes. (500Gi = 500GiB = 500 * 1024 * 1024 * 1024)",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"cachedPercentage": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CachedPercentage is the percentage of data cached over the total data in the underlying filesystem.",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.CacheRuntime"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.CacheRuntime", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_CacheRuntimeMasterSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CacheRuntimeMasterSpec describes the desired state of CacheRuntime master component",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"disabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Disabled indicates whether the component should be disabled. If set to true, the component will not be created.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"runtimeVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RuntimeVersion is the version information that instructs Fluid to orchestrate a particular version of the runtime.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.VersionSpec"),
+						},
+					},
+					"options": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Options is a set of key-value pairs that provide additional configuration for the cache system.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"podMetadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PodMetadata contains labels and annotations that will be propagated to the component's pods.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata"),
+						},
+					},
+					"resources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Resources describes the compute resource requirements. More info: https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/api/core/v1.ResourceRequirements"),
+						},
+					},
+					"env": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-patch-merge-key": "name",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Env is a list of environment variables to set in the container.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.EnvVar"),
+									},
+								},
+							},
+						},
+					},
+					"volumeMounts": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-patch-merge-key": "mountPath",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "VolumeMounts are the volumes to mount into the container's filesystem. Cannot be updated.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.VolumeMount"),
+									},
+								},
+							},
+						},
+					},
+					"args": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "Args are arguments to the entrypoint. The container image's CMD is used if this is not provided.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"nodeSelector": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-map-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeSelector is a selector which must be true for the pod to fit on a node. Selector which must match a node's labels for the pod to be scheduled on that node. More info: https://kubernetes.io/docs/concepts/configuration/assign-pod-node/",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"tolerations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Tolerations are the pod's tolerations. If specified, the pod's tolerations. More info: https://kubernetes.io/docs/concepts/scheduling-eviction/taint-and-toleration/",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.Toleration"),
+									},
+								},
+							},
+						},
+					},
+					"replicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Replicas is the desired number of replicas of the master component. If unspecified, defaults to 1.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata", "github.com/fluid-cloudnative/fluid/api/v1alpha1.VersionSpec", "k8s.io/api/core/v1.EnvVar", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.Toleration", "k8s.io/api/core/v1.VolumeMount"},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_CacheRuntimeMountUfsOutput(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"mounted": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Mounted are the ufs paths that have been mounted.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_CacheRuntimeReportSummary(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "CacheRuntimeReportSummary defines the return structure for ReportSummary execution entry. It contains cache status information such as capacity, hit ratio, and cached data size.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"cached": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Cached is the amount of data cached, in bytes. (500Gi = 500GiB = 500 * 1024 * 1024 * 1024)",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"cachedPercentage": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CachedPercentage is the percentage of data cached over the total data in the underlying filesystem.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -1553,12 +2012,19 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_CacheRuntimeSpec(ref common.Ref
 							},
 						},
 					},
+					"dataOperationPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DataOperationPolicy defines the policy of running the data operations on the runtime",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DataOperationPolicy"),
+						},
+					},
 				},
 				Required: []string{"runtimeClassName"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/fluid-cloudnative/fluid/api/v1alpha1.CacheRuntimeClientSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.CacheRuntimeMasterSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.CacheRuntimeWorkerSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/api/core/v1.Volume"},
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.CacheRuntimeClientSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.CacheRuntimeMasterSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.CacheRuntimeWorkerSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.DataOperationPolicy", "github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/api/core/v1.Volume"},
 	}
 }
 
@@ -2039,24 +2505,60 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_ConfigMapRuntimeExtraResource(r
 	}
 }
 
-func schema_fluid_cloudnative_fluid_api_v1alpha1_Data(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_fluid_cloudnative_fluid_api_v1alpha1_CredentialProviderSelector(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Data management strategies",
-				Type:        []string{"object"},
+				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"replicas": {
+					"url": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The copies of the dataset",
-							Default:     0,
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "The URL of the credential provider. Fluid posts a token of the service account, whose audience is the URL, to it and gets the short-lived credentials back.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"pin": {
+					"serviceAccountName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Pin the dataset or not. Refer to <a href=\"https://docs.alluxio.io/os/user/stable/en/operation/User-CLI.html#pin\">Alluxio User-CLI pin</a>",
+							Description: "The service account in the namespace of the dataset whose token is exchanged, defaults to \"default\"",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"key": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The required key in the credentials returned by the credential provider",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"url", "key"},
+			},
+		},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_Data(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Data management strategies",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"replicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The copies of the dataset",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"pin": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Pin the dataset or not. Refer to <a href=\"https://docs.alluxio.io/os/user/stable/en/operation/User-CLI.html#pin\">Alluxio User-CLI pin</a>",
 							Default:     false,
 							Type:        []string{"boolean"},
 							Format:      "",
@@ -2204,6 +2706,13 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_DataBackupSpec(ref common.Refer
 							Format:      "int32",
 						},
 					},
+					"priority": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Priority of the DataBackup in the operation queue of the runtime, the DataBackup with a higher priority runs first when the runtime limits the number of concurrent data operations. Defaults to 0",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 				},
 			},
 		},
@@ -2212,11 +2721,11 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_DataBackupSpec(ref common.Refer
 	}
 }
 
-func schema_fluid_cloudnative_fluid_api_v1alpha1_DataLoad(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_fluid_cloudnative_fluid_api_v1alpha1_DataFlow(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DataLoad is the Schema for the dataloads API",
+				Description: "DataFlow is the Schema for the dataflows API",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -2242,28 +2751,28 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_DataLoad(ref common.ReferenceCa
 					"spec": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DataLoadSpec"),
+							Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DataFlowSpec"),
 						},
 					},
 					"status": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.OperationStatus"),
+							Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DataFlowStatus"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataLoadSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.OperationStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataFlowSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.DataFlowStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_fluid_cloudnative_fluid_api_v1alpha1_DataLoadList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_fluid_cloudnative_fluid_api_v1alpha1_DataFlowList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DataLoadList contains a list of DataLoad",
+				Description: "DataFlowList contains a list of DataFlow",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -2293,7 +2802,7 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_DataLoadList(ref common.Referen
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DataLoad"),
+										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DataFlow"),
 									},
 								},
 							},
@@ -2304,308 +2813,486 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_DataLoadList(ref common.Referen
 			},
 		},
 		Dependencies: []string{
-			"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataLoad", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataFlow", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_fluid_cloudnative_fluid_api_v1alpha1_DataLoadSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_fluid_cloudnative_fluid_api_v1alpha1_DataFlowParameter(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DataLoadSpec defines the desired state of DataLoad",
+				Description: "DataFlowParameter is a parameter substituted into the templates of the steps",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"dataset": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Dataset defines the target dataset of the DataLoad",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.TargetDataset"),
+							Description: "Name of the parameter, referred to as $(params.<name>) in the templates",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"loadMetadata": {
+					"value": {
 						SchemaProps: spec.SchemaProps{
-							Description: "LoadMetadata specifies if the dataload job should load metadata",
-							Type:        []string{"boolean"},
+							Description: "Value of the parameter",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"target": {
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_DataFlowSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DataFlowSpec defines the desired state of DataFlow",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"steps": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Target defines target paths that needs to be loaded",
+							Description: "Steps of the DataFlow, which form a directed acyclic graph by their dependencies",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.TargetPath"),
-									},
-								},
-							},
-						},
-					},
-					"options": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Options specifies the extra dataload properties for runtime",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DataFlowStep"),
 									},
 								},
 							},
 						},
 					},
-					"podMetadata": {
-						SchemaProps: spec.SchemaProps{
-							Description: "PodMetadata defines labels and annotations that will be propagated to DataLoad pods",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata"),
-						},
-					},
-					"affinity": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Affinity defines affinity for DataLoad pod",
-							Ref:         ref("k8s.io/api/core/v1.Affinity"),
-						},
-					},
-					"tolerations": {
+					"parameters": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Tolerations defines tolerations for DataLoad pod",
+							Description: "Parameters substituted into the templates of the steps",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("k8s.io/api/core/v1.Toleration"),
+										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DataFlowParameter"),
 									},
 								},
 							},
 						},
 					},
-					"nodeSelector": {
+					"failurePolicy": {
 						SchemaProps: spec.SchemaProps{
-							Description: "NodeSelector defiens node selector for DataLoad pod",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "FailurePolicy decides how the DataFlow goes on after a step fails, defaults to FailFast",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"schedulerName": {
+					"schedule": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SchedulerName sets the scheduler to be used for DataLoad pod",
+							Description: "Schedule is the cron schedule to re-run the whole DataFlow, which runs once if not set. A scheduled run is skipped if the previous run is not finished.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"policy": {
+				},
+				Required: []string{"steps"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataFlowParameter", "github.com/fluid-cloudnative/fluid/api/v1alpha1.DataFlowStep"},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_DataFlowStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DataFlowStatus defines the observed state of DataFlow",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"phase": {
 						SchemaProps: spec.SchemaProps{
-							Description: "including Once, Cron, OnEvent",
+							Description: "Phase of the current run",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"schedule": {
+					"run": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The schedule in Cron format, only set when policy is cron, see https://en.wikipedia.org/wiki/Cron.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Run is the sequence number of the current run, starting from 1",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"runAfter": {
+					"startTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Specifies that the preceding operation in a workflow",
-							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.OperationRef"),
+							Description: "StartTime is the time when the current run started",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"ttlSecondsAfterFinished": {
+					"completionTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TTLSecondsAfterFinished is the time second to clean up data operations after finished or failed",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "CompletionTime is the time when the current run finished",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"resources": {
+					"lastScheduleTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Resources that will be requested by the DataLoad job. <br>",
-							Default:     map[string]interface{}{},
-							Ref:         ref("k8s.io/api/core/v1.ResourceRequirements"),
+							Description: "LastScheduleTime is the last time the DataFlow was scheduled to run by the Schedule",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"steps": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Steps are the states of the steps in the current run",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DataFlowStepStatus"),
+									},
+								},
+							},
+						},
+					},
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions consists of transition information on the DataFlow's Phase",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.Condition"),
+									},
+								},
+							},
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/fluid-cloudnative/fluid/api/v1alpha1.OperationRef", "github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata", "github.com/fluid-cloudnative/fluid/api/v1alpha1.TargetDataset", "github.com/fluid-cloudnative/fluid/api/v1alpha1.TargetPath", "k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.Toleration"},
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.Condition", "github.com/fluid-cloudnative/fluid/api/v1alpha1.DataFlowStepStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_fluid_cloudnative_fluid_api_v1alpha1_DataMigrate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_fluid_cloudnative_fluid_api_v1alpha1_DataFlowStep(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DataMigrate is the Schema for the datamigrates API",
+				Description: "DataFlowStep is a data operation in the DataFlow, exactly one of the templates is set. A failed step is retried by the retryPolicy in its template.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "Name of the step, unique in the DataFlow",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"dependsOn": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "DependsOn are the names of the steps to complete before the step starts",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"metadata": {
+					"affinity": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Description: "Affinity specifies the pod affinity of the step with one of the steps it depends on",
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DataFlowStepAffinity"),
 						},
 					},
-					"spec": {
+					"dataLoad": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DataMigrateSpec"),
+							Description: "DataLoad is the template of the DataLoad run by the step",
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DataLoadSpec"),
 						},
 					},
-					"status": {
+					"dataProcess": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.OperationStatus"),
+							Description: "DataProcess is the template of the DataProcess run by the step",
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DataProcessSpec"),
+						},
+					},
+					"dataMigrate": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DataMigrate is the template of the DataMigrate run by the step",
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DataMigrateSpec"),
+						},
+					},
+					"dataBackup": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DataBackup is the template of the DataBackup run by the step",
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DataBackupSpec"),
 						},
 					},
 				},
+				Required: []string{"name"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataMigrateSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.OperationStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataBackupSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.DataFlowStepAffinity", "github.com/fluid-cloudnative/fluid/api/v1alpha1.DataLoadSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.DataMigrateSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.DataProcessSpec"},
 	}
 }
 
-func schema_fluid_cloudnative_fluid_api_v1alpha1_DataMigrateList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_fluid_cloudnative_fluid_api_v1alpha1_DataFlowStepAffinity(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DataMigrateList contains a list of DataMigrate",
+				Description: "DataFlowStepAffinity specifies the pod affinity of a step with one of the steps it depends on",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"step": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "Step is the name of the step to be affine to, defaults to the first step in DependsOn",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"policy": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "Policy one of: \"\", \"Require\", \"Prefer\"",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
+					"prefers": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.Prefer"),
+									},
+								},
+							},
 						},
 					},
-					"items": {
+					"requires": {
 						SchemaProps: spec.SchemaProps{
 							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DataMigrate"),
+										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.Require"),
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataMigrate", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.Prefer", "github.com/fluid-cloudnative/fluid/api/v1alpha1.Require"},
 	}
 }
 
-func schema_fluid_cloudnative_fluid_api_v1alpha1_DataMigrateSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_fluid_cloudnative_fluid_api_v1alpha1_DataFlowStepStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DataMigrateSpec defines the desired state of DataMigrate",
+				Description: "DataFlowStepStatus is the observed state of a step in the current run",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"image": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Image (e.g. alluxio/alluxio)",
+							Description: "Name of the step",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"imageTag": {
+					"phase": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Image tag (e.g. 2.3.0-SNAPSHOT)",
+							Description: "Phase of the step",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"imagePullPolicy": {
+					"operation": {
 						SchemaProps: spec.SchemaProps{
-							Description: "One of the three policies: `Always`, `IfNotPresent`, `Never`",
+							Description: "Operation is the data operation of the step",
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.ObjectRef"),
+						},
+					},
+					"message": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Message is the reason why the step failed or was skipped",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"from": {
+					"startTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "data to migrate source, including dataset and external storage",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DataToMigrate"),
+							Description: "StartTime is the time when the operation of the step was created",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"to": {
+					"completionTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "data to migrate destination, including dataset and external storage",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DataToMigrate"),
+							Description: "CompletionTime is the time when the step finished",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"block": {
+				},
+				Required: []string{"name"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.ObjectRef", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_DataLoad(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DataLoad is the Schema for the dataloads API",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "if dataMigrate blocked dataset usage, default is false",
-							Type:        []string{"boolean"},
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"runtimeType": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "using which runtime to migrate data; if none, take dataset runtime as default",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DataLoadSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.OperationStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataLoadSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.OperationStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_DataLoadList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DataLoadList contains a list of DataLoad",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DataLoad"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataLoad", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_DataLoadSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DataLoadSpec defines the desired state of DataLoad",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"dataset": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Dataset defines the target dataset of the DataLoad",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.TargetDataset"),
+						},
+					},
+					"loadMetadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "LoadMetadata specifies if the dataload job should load metadata",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"target": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Target defines target paths that needs to be loaded",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.TargetPath"),
+									},
+								},
+							},
+						},
+					},
 					"options": {
 						SchemaProps: spec.SchemaProps{
-							Description: "options for migrate, different for each runtime",
+							Description: "Options specifies the extra dataload properties for runtime",
 							Type:        []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
@@ -2619,36 +3306,28 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_DataMigrateSpec(ref common.Refe
 							},
 						},
 					},
-					"policy": {
-						SchemaProps: spec.SchemaProps{
-							Description: "policy for migrate, including Once, Cron, OnEvent",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"schedule": {
+					"targetNodes": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The schedule in Cron format, only set when policy is cron, see https://en.wikipedia.org/wiki/Cron.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "TargetNodes makes the target paths cached on the workers co-located with the target nodes, instead of the workers chosen by the runtime. It's only supported by AlluxioRuntime.",
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DataLoadTargetNodes"),
 						},
 					},
 					"podMetadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "PodMetadata defines labels and annotations that will be propagated to DataMigrate pods",
+							Description: "PodMetadata defines labels and annotations that will be propagated to DataLoad pods",
 							Default:     map[string]interface{}{},
 							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata"),
 						},
 					},
 					"affinity": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Affinity defines affinity for DataMigrate pod",
+							Description: "Affinity defines affinity for DataLoad pod",
 							Ref:         ref("k8s.io/api/core/v1.Affinity"),
 						},
 					},
 					"tolerations": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Tolerations defines tolerations for DataMigrate pod",
+							Description: "Tolerations defines tolerations for DataLoad pod",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -2662,7 +3341,7 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_DataMigrateSpec(ref common.Refe
 					},
 					"nodeSelector": {
 						SchemaProps: spec.SchemaProps{
-							Description: "NodeSelector defiens node selector for DataMigrate pod",
+							Description: "NodeSelector defiens node selector for DataLoad pod",
 							Type:        []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
@@ -2678,11 +3357,39 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_DataMigrateSpec(ref common.Refe
 					},
 					"schedulerName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SchedulerName sets the scheduler to be used for DataMigrate pod",
+							Description: "SchedulerName sets the scheduler to be used for DataLoad pod",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"policy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "including Once, Cron, OnEvent",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"schedule": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The schedule in Cron format, only set when policy is cron, see https://en.wikipedia.org/wiki/Cron.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"events": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Events defines the events which trigger the DataLoad to run again, only used when policy is OnEvent.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.EventSource"),
+									},
+								},
+							},
+						},
+					},
 					"runAfter": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Specifies that the preceding operation in a workflow",
@@ -2696,26 +3403,46 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_DataMigrateSpec(ref common.Refe
 							Format:      "int32",
 						},
 					},
-					"resources": {
+					"retryPolicy": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Resources that will be requested by the DataMigrate job. <br>",
-							Default:     map[string]interface{}{},
-							Ref:         ref("k8s.io/api/core/v1.ResourceRequirements"),
+							Description: "RetryPolicy defines how the DataLoad is retried when it fails, only used when policy is Once",
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.RetryPolicy"),
 						},
 					},
-					"parallelism": {
+					"priority": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Parallelism defines the parallelism tasks numbers for DataMigrate. If the value is greater than 1, the job acts as a launcher, and users should define the WorkerSpec.",
+							Description: "Priority of the DataLoad in the operation queue of the runtime, the DataLoad with a higher priority runs first when the runtime limits the number of concurrent data operations. Defaults to 0",
 							Type:        []string{"integer"},
 							Format:      "int32",
 						},
 					},
-					"parallelOptions": {
+					"resources": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ParallelOptions defines options like ssh port and ssh secret name when parallelism is greater than 1.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "Resources that will be requested by the DataLoad job. <br>",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/api/core/v1.ResourceRequirements"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataLoadTargetNodes", "github.com/fluid-cloudnative/fluid/api/v1alpha1.EventSource", "github.com/fluid-cloudnative/fluid/api/v1alpha1.OperationRef", "github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata", "github.com/fluid-cloudnative/fluid/api/v1alpha1.RetryPolicy", "github.com/fluid-cloudnative/fluid/api/v1alpha1.TargetDataset", "github.com/fluid-cloudnative/fluid/api/v1alpha1.TargetPath", "k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.Toleration"},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_DataLoadTargetNodes(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DataLoadTargetNodes selects the nodes where the target paths should be cached by the co-located workers",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"nodeNames": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeNames are the names of the target nodes",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: "",
@@ -2726,90 +3453,37 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_DataMigrateSpec(ref common.Refe
 							},
 						},
 					},
+					"podSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PodSelector selects the pods in the namespace of the DataLoad, the nodes where they are scheduled or nominated to run are the target nodes",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
 				},
-				Required: []string{"from", "to"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataToMigrate", "github.com/fluid-cloudnative/fluid/api/v1alpha1.OperationRef", "github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata", "k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.Toleration"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
 	}
 }
 
-func schema_fluid_cloudnative_fluid_api_v1alpha1_DataOperationSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_fluid_cloudnative_fluid_api_v1alpha1_DataMigrate(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "DataMigrate is the Schema for the datamigrates API",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name is the data operation name like DataLoad, DataBackup, DataMigrate etc.",
-							Default:     "",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"image": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Image the image for data operation, if not existed, use the runtime/runtimeclass defined worker image.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"command": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Command for data operation Pod container",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
-						},
-					},
-					"args": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Args for data operation Pod container",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
-						},
-					},
-				},
-				Required: []string{"name"},
-			},
-		},
-	}
-}
-
-func schema_fluid_cloudnative_fluid_api_v1alpha1_DataProcess(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "DataProcess is the Schema for the dataprocesses API",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"kind": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"apiVersion": {
-						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -2823,7 +3497,7 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_DataProcess(ref common.Referenc
 					"spec": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DataProcessSpec"),
+							Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DataMigrateSpec"),
 						},
 					},
 					"status": {
@@ -2836,15 +3510,15 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_DataProcess(ref common.Referenc
 			},
 		},
 		Dependencies: []string{
-			"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataProcessSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.OperationStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataMigrateSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.OperationStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_fluid_cloudnative_fluid_api_v1alpha1_DataProcessList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_fluid_cloudnative_fluid_api_v1alpha1_DataMigrateList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DataProcessList contains a list of DataProcess",
+				Description: "DataMigrateList contains a list of DataMigrate",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -2874,7 +3548,7 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_DataProcessList(ref common.Refe
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DataProcess"),
+										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DataMigrate"),
 									},
 								},
 							},
@@ -2885,230 +3559,362 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_DataProcessList(ref common.Refe
 			},
 		},
 		Dependencies: []string{
-			"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataProcess", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataMigrate", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_fluid_cloudnative_fluid_api_v1alpha1_DataProcessSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_fluid_cloudnative_fluid_api_v1alpha1_DataMigrateSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DataProcessSpec defines the desired state of DataProcess",
+				Description: "DataMigrateSpec defines the desired state of DataMigrate",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"dataset": {
+					"image": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Dataset specifies the target dataset and its mount path.",
+							Description: "Image (e.g. alluxio/alluxio)",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"imageTag": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Image tag (e.g. 2.3.0-SNAPSHOT)",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"imagePullPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "One of the three policies: `Always`, `IfNotPresent`, `Never`",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"from": {
+						SchemaProps: spec.SchemaProps{
+							Description: "data to migrate source, including dataset and external storage",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.TargetDatasetWithMountPath"),
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DataToMigrate"),
 						},
 					},
-					"processor": {
+					"to": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Processor specify how to process data.",
+							Description: "data to migrate destination, including dataset and external storage",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.Processor"),
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DataToMigrate"),
 						},
 					},
-					"runAfter": {
+					"block": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Specifies that the preceding operation in a workflow",
-							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.OperationRef"),
+							Description: "if dataMigrate blocked dataset usage, default is false",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"ttlSecondsAfterFinished": {
+					"runtimeType": {
 						SchemaProps: spec.SchemaProps{
-							Description: "TTLSecondsAfterFinished is the time second to clean up data operations after finished or failed",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "using which runtime to migrate data; if none, take dataset runtime as default",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"options": {
+						SchemaProps: spec.SchemaProps{
+							Description: "options for migrate, different for each runtime",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
 					"policy": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Policy defines the operation policy, including Once, Cron, OnEvent",
+							Description: "policy for migrate, including Once, Cron, OnEvent",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 					"schedule": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Schedule defines the Cron schedule, only used when Policy is Cron. See https://en.wikipedia.org/wiki/Cron.",
+							Description: "The schedule in Cron format, only set when policy is cron, see https://en.wikipedia.org/wiki/Cron.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-				},
-				Required: []string{"dataset", "processor"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/fluid-cloudnative/fluid/api/v1alpha1.OperationRef", "github.com/fluid-cloudnative/fluid/api/v1alpha1.Processor", "github.com/fluid-cloudnative/fluid/api/v1alpha1.TargetDatasetWithMountPath"},
-	}
-}
-
-func schema_fluid_cloudnative_fluid_api_v1alpha1_DataRestoreLocation(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "DataRestoreLocation describes the spec restore location of  Dataset",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"path": {
+					"podMetadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Path describes the path of restore, in the form of  local://subpath or pvc://<pvcName>/subpath",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "PodMetadata defines labels and annotations that will be propagated to DataMigrate pods",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata"),
 						},
 					},
-					"nodeName": {
+					"affinity": {
 						SchemaProps: spec.SchemaProps{
-							Description: "NodeName describes the nodeName of restore if Path is  in the form of local://subpath",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Affinity defines affinity for DataMigrate pod",
+							Ref:         ref("k8s.io/api/core/v1.Affinity"),
 						},
 					},
-				},
-			},
-		},
-	}
-}
-
-func schema_fluid_cloudnative_fluid_api_v1alpha1_DataToMigrate(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
-					"dataset": {
+					"tolerations": {
 						SchemaProps: spec.SchemaProps{
-							Description: "dataset to migrate",
-							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DatasetToMigrate"),
+							Description: "Tolerations defines tolerations for DataMigrate pod",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.Toleration"),
+									},
+								},
+							},
 						},
 					},
-					"externalStorage": {
+					"nodeSelector": {
 						SchemaProps: spec.SchemaProps{
-							Description: "external storage for data migrate",
-							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.ExternalStorage"),
+							Description: "NodeSelector defiens node selector for DataMigrate pod",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"github.com/fluid-cloudnative/fluid/api/v1alpha1.DatasetToMigrate", "github.com/fluid-cloudnative/fluid/api/v1alpha1.ExternalStorage"},
-	}
-}
-
-func schema_fluid_cloudnative_fluid_api_v1alpha1_Dataset(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "Dataset is the Schema for the datasets API",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"kind": {
+					"schedulerName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "SchedulerName sets the scheduler to be used for DataMigrate pod",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"runAfter": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Specifies that the preceding operation in a workflow",
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.OperationRef"),
 						},
 					},
-					"metadata": {
+					"ttlSecondsAfterFinished": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Description: "TTLSecondsAfterFinished is the time second to clean up data operations after finished or failed",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"spec": {
+					"retryPolicy": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DatasetSpec"),
+							Description: "RetryPolicy defines how the DataMigrate is retried when it fails, only used when policy is Once",
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.RetryPolicy"),
 						},
 					},
-					"status": {
+					"priority": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DatasetStatus"),
+							Description: "Priority of the DataMigrate in the operation queue of the runtime, the DataMigrate with a higher priority runs first when the runtime limits the number of concurrent data operations. Defaults to 0",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-				},
+					"resources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Resources that will be requested by the DataMigrate job. <br>",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/api/core/v1.ResourceRequirements"),
+						},
+					},
+					"parallelism": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Parallelism defines the parallelism tasks numbers for DataMigrate. If the value is greater than 1, the job acts as a launcher, and users should define the WorkerSpec.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"parallelOptions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ParallelOptions defines options like ssh port and ssh secret name when parallelism is greater than 1.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"resume": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Resume makes a retried or re-created DataMigrate skip the files which are already migrated and verified by the previous attempts, instead of migrating all the files from scratch. It's only supported by JuiceFSRuntime when Parallelism is 1. Default is false.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"verifyMode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VerifyMode defines how a file already in the destination is verified to be skipped when resuming, including SizeAndMtime and Checksum. Default is SizeAndMtime.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"manifestVolumeClaim": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ManifestVolumeClaim is the name of the PersistentVolumeClaim in the namespace of the DataMigrate, which keeps the manifest of the files migrated and verified when resuming. It's required when Resume is true.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"from", "to"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/fluid-cloudnative/fluid/api/v1alpha1.DatasetSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.DatasetStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataToMigrate", "github.com/fluid-cloudnative/fluid/api/v1alpha1.OperationRef", "github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata", "github.com/fluid-cloudnative/fluid/api/v1alpha1.RetryPolicy", "k8s.io/api/core/v1.Affinity", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.Toleration"},
 	}
 }
 
-func schema_fluid_cloudnative_fluid_api_v1alpha1_DatasetCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_fluid_cloudnative_fluid_api_v1alpha1_DataOperationPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Condition describes the state of the cache at a certain point.",
+				Description: "DataOperationPolicy defines the policy of running the data operations on a runtime",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"type": {
+					"maxConcurrency": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Type of cache condition.",
+							Description: "MaxConcurrency is the maximum number of data operations running on the runtime at the same time, the others wait in the operation queue of the runtime. Defaults to 0, which means no limit",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_DataOperationSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name is the data operation name like DataLoad, DataBackup, DataMigrate etc.",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"status": {
+					"image": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Status of the condition, one of True, False, Unknown.",
-							Default:     "",
+							Description: "Image the image for data operation, if not existed, use the runtime/runtimeclass defined worker image.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"reason": {
+					"command": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The reason for the condition's last transition.",
+							Description: "Command for data operation Pod container",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"args": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Args for data operation Pod container",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_DataProcess(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DataProcess is the Schema for the dataprocesses API",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"message": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "A human readable message indicating details about the transition.",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"lastUpdateTime": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The last time this condition was updated.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"lastTransitionTime": {
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Last time the condition transitioned from one status to another.",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DataProcessSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.OperationStatus"),
 						},
 					},
 				},
-				Required: []string{"type", "status"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataProcessSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.OperationStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_fluid_cloudnative_fluid_api_v1alpha1_DatasetList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_fluid_cloudnative_fluid_api_v1alpha1_DataProcessList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DatasetList contains a list of Dataset",
+				Description: "DataProcessList contains a list of DataProcess",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -3138,7 +3944,7 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_DatasetList(ref common.Referenc
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.Dataset"),
+										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DataProcess"),
 									},
 								},
 							},
@@ -3149,511 +3955,362 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_DatasetList(ref common.Referenc
 			},
 		},
 		Dependencies: []string{
-			"github.com/fluid-cloudnative/fluid/api/v1alpha1.Dataset", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataProcess", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_fluid_cloudnative_fluid_api_v1alpha1_DatasetSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_fluid_cloudnative_fluid_api_v1alpha1_DataProcessSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DatasetSpec defines the desired state of Dataset",
+				Description: "DataProcessSpec defines the desired state of DataProcess",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"mounts": {
+					"dataset": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Mount Points to be mounted on cache runtime. <br> This field can be empty because some runtimes don't need to mount external storage (e.g. <a href=\"https://v6d.io/\">Vineyard</a>).",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.Mount"),
-									},
-								},
-							},
+							Description: "Dataset specifies the target dataset and its mount path.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.TargetDatasetWithMountPath"),
 						},
 					},
-					"owner": {
+					"processor": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The owner of the dataset",
-							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.User"),
+							Description: "Processor specify how to process data.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.Processor"),
 						},
 					},
-					"nodeAffinity": {
+					"runAfter": {
 						SchemaProps: spec.SchemaProps{
-							Description: "NodeAffinity defines constraints that limit what nodes this dataset can be cached to. This field influences the scheduling of pods that use the cached dataset.",
-							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.CacheableNodeAffinity"),
+							Description: "Specifies that the preceding operation in a workflow",
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.OperationRef"),
 						},
 					},
-					"tolerations": {
+					"ttlSecondsAfterFinished": {
 						SchemaProps: spec.SchemaProps{
-							Description: "If specified, the pod's tolerations.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("k8s.io/api/core/v1.Toleration"),
-									},
-								},
-							},
+							Description: "TTLSecondsAfterFinished is the time second to clean up data operations after finished or failed",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"accessModes": {
+					"retryPolicy": {
 						SchemaProps: spec.SchemaProps{
-							Description: "AccessModes contains all ways the volume backing the PVC can be mounted",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "RetryPolicy defines how the DataProcess is retried when it fails, only used when policy is Once",
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.RetryPolicy"),
 						},
 					},
-					"runtimes": {
+					"priority": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Runtimes for supporting dataset (e.g. AlluxioRuntime)",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.Runtime"),
-									},
-								},
-							},
+							Description: "Priority of the DataProcess in the operation queue of the runtime, the DataProcess with a higher priority runs first when the runtime limits the number of concurrent data operations. Defaults to 0",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"placement": {
+					"policy": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Manage switch for opening Multiple datasets single node deployment or not",
+							Description: "Policy defines the operation policy, including Once, Cron, OnEvent",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"dataRestoreLocation": {
+					"schedule": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DataRestoreLocation is the location to load data of dataset  been backuped",
-							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DataRestoreLocation"),
+							Description: "Schedule defines the Cron schedule, only used when Policy is Cron. See https://en.wikipedia.org/wiki/Cron.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"sharedOptions": {
+					"events": {
 						SchemaProps: spec.SchemaProps{
-							Description: "SharedOptions is the options to all mount",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "Events defines the events which trigger the DataProcess to run again, only used when Policy is OnEvent.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
-						},
-					},
-					"sharedEncryptOptions": {
-						SchemaProps: spec.SchemaProps{
-							Description: "SharedEncryptOptions is the encryptOption to all mount",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.EncryptOption"),
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.EventSource"),
 									},
 								},
 							},
 						},
 					},
 				},
+				Required: []string{"dataset", "processor"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/fluid-cloudnative/fluid/api/v1alpha1.CacheableNodeAffinity", "github.com/fluid-cloudnative/fluid/api/v1alpha1.DataRestoreLocation", "github.com/fluid-cloudnative/fluid/api/v1alpha1.EncryptOption", "github.com/fluid-cloudnative/fluid/api/v1alpha1.Mount", "github.com/fluid-cloudnative/fluid/api/v1alpha1.Runtime", "github.com/fluid-cloudnative/fluid/api/v1alpha1.User", "k8s.io/api/core/v1.Toleration"},
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.EventSource", "github.com/fluid-cloudnative/fluid/api/v1alpha1.OperationRef", "github.com/fluid-cloudnative/fluid/api/v1alpha1.Processor", "github.com/fluid-cloudnative/fluid/api/v1alpha1.RetryPolicy", "github.com/fluid-cloudnative/fluid/api/v1alpha1.TargetDatasetWithMountPath"},
 	}
 }
 
-func schema_fluid_cloudnative_fluid_api_v1alpha1_DatasetStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_fluid_cloudnative_fluid_api_v1alpha1_DataRestoreLocation(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "DatasetStatus defines the observed state of Dataset",
+				Description: "DataRestoreLocation describes the spec restore location of  Dataset",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"mounts": {
-						SchemaProps: spec.SchemaProps{
-							Description: "the info of mount points have been mounted",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.Mount"),
-									},
-								},
-							},
-						},
-					},
-					"ufsTotal": {
+					"path": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Total in GB of dataset in the cluster",
+							Description: "Path describes the path of restore, in the form of  local://subpath or pvc://<pvcName>/subpath",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"phase": {
+					"nodeName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Dataset Phase. One of the four phases: `Pending`, `Bound`, `NotBound` and `Failed`",
+							Description: "NodeName describes the nodeName of restore if Path is  in the form of local://subpath",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"runtimes": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Runtimes for supporting dataset",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.Runtime"),
-									},
-								},
-							},
-						},
-					},
-					"conditions": {
+					"dataset": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Conditions is an array of current observed conditions.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DatasetCondition"),
-									},
-								},
-							},
+							Description: "Dataset is the name of the dataset in the same namespace the backup was taken from, defaults to the dataset itself",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"cacheStates": {
+				},
+			},
+		},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_DataToMigrate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"dataset": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CacheStatus represents the total resources of the dataset.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "dataset to migrate",
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DatasetToMigrate"),
 						},
 					},
-					"hcfs": {
+					"externalStorage": {
 						SchemaProps: spec.SchemaProps{
-							Description: "HCFSStatus represents hcfs info",
-							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.HCFSStatus"),
+							Description: "external storage for data migrate",
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.ExternalStorage"),
 						},
 					},
-					"fileNum": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.DatasetToMigrate", "github.com/fluid-cloudnative/fluid/api/v1alpha1.ExternalStorage"},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_Dataset(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Dataset is the Schema for the datasets API",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "FileNum represents the file numbers of the dataset",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"dataLoadRef": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DataLoadRef specifies the running DataLoad job that targets this Dataset. This is mainly used as a lock to prevent concurrent DataLoad jobs. Deprecated, use OperationRef instead",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"dataBackupRef": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DataBackupRef specifies the running Backup job that targets this Dataset. This is mainly used as a lock to prevent concurrent DataBackup jobs. Deprecated, use OperationRef instead",
-							Type:        []string{"string"},
-							Format:      "",
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"operationRef": {
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "OperationRef specifies the Operation that targets this Dataset. This is mainly used as a lock to prevent concurrent same Operation jobs.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DatasetSpec"),
 						},
 					},
-					"datasetRef": {
+					"status": {
 						SchemaProps: spec.SchemaProps{
-							Description: "DatasetRef specifies the datasets namespaced name mounting this Dataset.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DatasetStatus"),
 						},
 					},
 				},
-				Required: []string{"conditions"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/fluid-cloudnative/fluid/api/v1alpha1.DatasetCondition", "github.com/fluid-cloudnative/fluid/api/v1alpha1.HCFSStatus", "github.com/fluid-cloudnative/fluid/api/v1alpha1.Mount", "github.com/fluid-cloudnative/fluid/api/v1alpha1.Runtime"},
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.DatasetSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.DatasetStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_fluid_cloudnative_fluid_api_v1alpha1_DatasetToMigrate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_fluid_cloudnative_fluid_api_v1alpha1_DatasetCacheUsage(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "DatasetCacheUsage describes the cache usage of a single Dataset",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "name of dataset",
+							Description: "Name of the Dataset",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"namespace": {
+					"runtimeType": {
 						SchemaProps: spec.SchemaProps{
-							Description: "namespace of dataset",
-							Default:     "",
+							Description: "RuntimeType is the type of the runtime of the Dataset (e.g. alluxio)",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"path": {
+					"requested": {
 						SchemaProps: spec.SchemaProps{
-							Description: "path to migrate",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Requested is the cache capacity requested by the runtime of the Dataset, whether it is bound or not",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"used": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Used is the cache capacity reported in the Dataset's cache states",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"cached": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Cached is the amount of data cached reported in the Dataset's cache states",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
 						},
 					},
 				},
-				Required: []string{"name", "namespace"},
+				Required: []string{"name"},
 			},
 		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
 	}
 }
 
-func schema_fluid_cloudnative_fluid_api_v1alpha1_EFCCompTemplateSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_fluid_cloudnative_fluid_api_v1alpha1_DatasetCondition(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "EFCCompTemplateSpec is a description of the EFC components",
+				Description: "Condition describes the state of the cache at a certain point.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"replicas": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Replicas is the desired number of replicas of the given template. If unspecified, defaults to 1. replicas is the min replicas of dataset in the cluster",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "Type of cache condition.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"version": {
+					"status": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The version information that instructs fluid to orchestrate a particular version of EFC Comp",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.VersionSpec"),
+							Description: "Status of the condition, one of True, False, Unknown.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"properties": {
+					"reason": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Configurable properties for the EFC component.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "The reason for the condition's last transition.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"ports": {
+					"message": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Ports used by EFC(e.g. rpc: 19998 for master).",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: 0,
-										Type:    []string{"integer"},
-										Format:  "int32",
-									},
-								},
-							},
+							Description: "A human readable message indicating details about the transition.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"resources": {
+					"lastUpdateTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Resources that will be requested by the EFC component. <br> <br> Resources are not allowed for ephemeral containers. Ephemeral containers use spare resources already allocated to the pod.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("k8s.io/api/core/v1.ResourceRequirements"),
+							Description: "The last time this condition was updated.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"disabled": {
+					"lastTransitionTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Enabled or Disabled for the components. Default enable.",
-							Type:        []string{"boolean"},
-							Format:      "",
-						},
-					},
-					"nodeSelector": {
-						SchemaProps: spec.SchemaProps{
-							Description: "NodeSelector is a selector which must be true for the component to fit on a node.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
-						},
-					},
-					"networkMode": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Whether to use host network or not.",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"podMetadata": {
-						SchemaProps: spec.SchemaProps{
-							Description: "PodMetadata defines labels and annotations that will be propagated to EFC's master and worker pods",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata"),
+							Description: "Last time the condition transitioned from one status to another.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
 				},
+				Required: []string{"type", "status"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata", "github.com/fluid-cloudnative/fluid/api/v1alpha1.VersionSpec", "k8s.io/api/core/v1.ResourceRequirements"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_fluid_cloudnative_fluid_api_v1alpha1_EFCFuseSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_fluid_cloudnative_fluid_api_v1alpha1_DatasetList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "EFCFuseSpec is a description of the EFC Fuse",
+				Description: "DatasetList contains a list of Dataset",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"version": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The version information that instructs fluid to orchestrate a particular version of EFC Fuse",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.VersionSpec"),
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"properties": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Configurable properties for EFC fuse",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"resources": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Resources that will be requested by EFC Fuse. <br> <br> Resources are not allowed for ephemeral containers. Ephemeral containers use spare resources already allocated to the pod.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("k8s.io/api/core/v1.ResourceRequirements"),
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
 						},
 					},
-					"nodeSelector": {
+					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "NodeSelector is a selector which must be true for the fuse client to fit on a node, this option only effect when global is enabled",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.Dataset"),
 									},
 								},
 							},
 						},
 					},
-					"cleanPolicy": {
-						SchemaProps: spec.SchemaProps{
-							Description: "CleanPolicy decides when to clean EFC Fuse pods. Currently Fluid supports two policies: OnDemand and OnRuntimeDeleted OnDemand cleans fuse pod once th fuse pod on some node is not needed OnRuntimeDeleted cleans fuse pod only when the cache runtime is deleted Defaults to OnRuntimeDeleted",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"networkMode": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Whether to use hostnetwork or not",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"podMetadata": {
-						SchemaProps: spec.SchemaProps{
-							Description: "PodMetadata defines labels and annotations that will be propagated to EFC's fuse pods",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata"),
-						},
-					},
 				},
+				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata", "github.com/fluid-cloudnative/fluid/api/v1alpha1.VersionSpec", "k8s.io/api/core/v1.ResourceRequirements"},
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.Dataset", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_fluid_cloudnative_fluid_api_v1alpha1_EFCRuntime(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_fluid_cloudnative_fluid_api_v1alpha1_DatasetQuota(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "EFCRuntime is the Schema for the efcruntimes API",
+				Description: "DatasetQuota is the Schema for the datasetquotas API",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -3679,28 +4336,28 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_EFCRuntime(ref common.Reference
 					"spec": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.EFCRuntimeSpec"),
+							Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DatasetQuotaSpec"),
 						},
 					},
 					"status": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeStatus"),
+							Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DatasetQuotaStatus"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/fluid-cloudnative/fluid/api/v1alpha1.EFCRuntimeSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.DatasetQuotaSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.DatasetQuotaStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_fluid_cloudnative_fluid_api_v1alpha1_EFCRuntimeList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_fluid_cloudnative_fluid_api_v1alpha1_DatasetQuotaList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "EFCRuntimeList contains a list of EFCRuntime",
+				Description: "DatasetQuotaList contains a list of DatasetQuota",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -3730,7 +4387,7 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_EFCRuntimeList(ref common.Refer
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.EFCRuntime"),
+										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DatasetQuota"),
 									},
 								},
 							},
@@ -3741,177 +4398,181 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_EFCRuntimeList(ref common.Refer
 			},
 		},
 		Dependencies: []string{
-			"github.com/fluid-cloudnative/fluid/api/v1alpha1.EFCRuntime", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.DatasetQuota", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_fluid_cloudnative_fluid_api_v1alpha1_EFCRuntimeSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_fluid_cloudnative_fluid_api_v1alpha1_DatasetQuotaSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "EFCRuntimeSpec defines the desired state of EFCRuntime",
+				Description: "DatasetQuotaSpec defines the desired state of DatasetQuota",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"master": {
-						SchemaProps: spec.SchemaProps{
-							Description: "The component spec of EFC master",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.EFCCompTemplateSpec"),
-						},
-					},
-					"worker": {
-						SchemaProps: spec.SchemaProps{
-							Description: "The component spec of EFC worker",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.EFCCompTemplateSpec"),
-						},
-					},
-					"initFuse": {
+					"cacheCapacity": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The spec of init alifuse",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.InitFuseSpec"),
+							Description: "CacheCapacity is the upper limit of the cache capacity that the runtimes of the selected Datasets may request in total. The cache capacity requested by a runtime is its worker replicas multiplied by the sum of the quotas in its tiered store.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
 						},
 					},
-					"fuse": {
+					"selector": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The component spec of EFC Fuse",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.EFCFuseSpec"),
+							Description: "Selector selects the Datasets in the same namespace that the quota applies to. All the Datasets in the namespace are selected if it's not set.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
 						},
 					},
-					"tieredstore": {
+				},
+				Required: []string{"cacheCapacity"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_DatasetQuotaStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DatasetQuotaStatus defines the observed state of DatasetQuota",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"requested": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Tiered storage used by EFC worker",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.TieredStore"),
+							Description: "Requested is the total cache capacity requested by the runtimes of the selected Datasets",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
 						},
 					},
-					"replicas": {
+					"used": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The replicas of the worker, need to be specified",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "Used is the total cache capacity reported by the selected Datasets",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
 						},
 					},
-					"osAdvise": {
+					"cached": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Operating system optimization for EFC",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.OSAdvise"),
+							Description: "Cached is the total amount of data cached by the selected Datasets",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
 						},
 					},
-					"cleanCachePolicy": {
+					"datasets": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CleanCachePolicy defines cleanCache Policy",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.CleanCachePolicy"),
+							Description: "Datasets are the cache usages of the selected Datasets",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DatasetCacheUsage"),
+									},
+								},
+							},
 						},
 					},
-					"podMetadata": {
+					"lastUpdateTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "PodMetadata defines labels and annotations that will be propagated to all EFC's pods",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata"),
+							Description: "LastUpdateTime is the last time the usage was calculated",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/fluid-cloudnative/fluid/api/v1alpha1.CleanCachePolicy", "github.com/fluid-cloudnative/fluid/api/v1alpha1.EFCCompTemplateSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.EFCFuseSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.InitFuseSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.OSAdvise", "github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata", "github.com/fluid-cloudnative/fluid/api/v1alpha1.TieredStore"},
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.DatasetCacheUsage", "k8s.io/apimachinery/pkg/api/resource.Quantity", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_fluid_cloudnative_fluid_api_v1alpha1_EmptyDirMediumSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_fluid_cloudnative_fluid_api_v1alpha1_DatasetRefGrant(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "EmptyDirMediumSource describes emptyDir volume as a storage medium. Can be backed by node's default storage or memory (tmpfs).",
+				Description: "DatasetRefGrant records the DatasetShare allowing a dataset in another namespace to mount this Dataset",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"quota": {
+					"datasetRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Quota specifies the maximum storage capacity for the emptyDir volume. For Memory medium, this limit is also constrained by the sum of container memory limits. The actual limit = min(Quota, sum of container memory limits). Example: \"100Gi\" limits the emptyDir to 100GiB.",
-							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+							Description: "DatasetRef is the namespaced name of the dataset mounting this Dataset",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"medium": {
+					"datasetShare": {
 						SchemaProps: spec.SchemaProps{
-							Description: "medium represents what type of storage medium should back this directory. The default is \"\" which means to use the node's default medium. Must be an empty string (default) or Memory. More info: https://kubernetes.io/docs/concepts/storage/volumes#emptydir",
+							Description: "DatasetShare is the name of the DatasetShare allowing the reference",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"accessMode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AccessMode is the access granted by the DatasetShare",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"quota"},
+				Required: []string{"datasetRef", "datasetShare", "accessMode"},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
 	}
 }
 
-func schema_fluid_cloudnative_fluid_api_v1alpha1_EncryptOption(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_fluid_cloudnative_fluid_api_v1alpha1_DatasetShare(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "DatasetShare is the Schema for the datasetshares API, it grants the datasets in other namespaces access to a Dataset",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"name": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The name of encryptOption",
-							Default:     "",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"valueFrom": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The valueFrom of encryptOption",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.EncryptOptionSource"),
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-				},
-				Required: []string{"name"},
-			},
-		},
-		Dependencies: []string{
-			"github.com/fluid-cloudnative/fluid/api/v1alpha1.EncryptOptionSource"},
-	}
-}
-
-func schema_fluid_cloudnative_fluid_api_v1alpha1_EncryptOptionSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
-					"secretKeyRef": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The encryptInfo obtained from secret",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.SecretKeySelector"),
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DatasetShareSpec"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/fluid-cloudnative/fluid/api/v1alpha1.SecretKeySelector"},
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.DatasetShareSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_fluid_cloudnative_fluid_api_v1alpha1_ExecutionCommonEntry(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_fluid_cloudnative_fluid_api_v1alpha1_DatasetShareGrant(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "DatasetShareGrant allows the datasets in some namespaces, or created by some service accounts, to refer to the shared Dataset",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"command": {
+					"namespaces": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
+							Description: "Namespaces are the namespaces whose datasets are allowed to refer to the shared Dataset, \"*\" means all the namespaces",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
@@ -3923,419 +4584,455 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_ExecutionCommonEntry(ref common
 							},
 						},
 					},
-					"timeout": {
-						SchemaProps: spec.SchemaProps{
-							Description: "TimeoutSeconds is the timeout(seconds) for the execution entry, at least(default) 20 seconds.",
-							Type:        []string{"integer"},
-							Format:      "int32",
-						},
-					},
-				},
-				Required: []string{"command"},
-			},
-		},
-	}
-}
-
-func schema_fluid_cloudnative_fluid_api_v1alpha1_ExecutionEntries(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
-					"mountUFS": {
+					"serviceAccounts": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MountUFS defines the operations for mounting UFS. The command's stdout must be JSON matching CacheRuntimeMountUfsOutput.",
-							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.ExecutionCommonEntry"),
+							Description: "ServiceAccounts are the service accounts allowed to create datasets referring to the shared Dataset in their own namespaces",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DatasetShareServiceAccount"),
+									},
+								},
+							},
 						},
 					},
-					"reportSummary": {
+					"accessMode": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ReportSummary it defines the operation how to get cache status like capacity, hit ratio etc.",
-							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.ExecutionCommonEntry"),
+							Description: "AccessMode is the access granted to the referring datasets, defaults to ReadOnly",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/fluid-cloudnative/fluid/api/v1alpha1.ExecutionCommonEntry"},
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.DatasetShareServiceAccount"},
 	}
 }
 
-func schema_fluid_cloudnative_fluid_api_v1alpha1_ExternalEndpointSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_fluid_cloudnative_fluid_api_v1alpha1_DatasetShareList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ExternalEndpointSpec defines the configurations for external etcd cluster",
+				Description: "DatasetShareList contains a list of DatasetShare",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"uri": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "URI specifies the endpoint of external Etcd cluster E,g. \"etcd-svc.etcd-namespace.svc.cluster.local:2379\" Default is not set and use http protocol to connect to external etcd cluster",
-							Default:     "",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"encryptOptions": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "encrypt info for accessing the external etcd cluster",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.EncryptOption"),
-									},
-								},
-							},
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"options": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Configurable options for External Etcd cluster.",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DatasetShare"),
 									},
 								},
 							},
 						},
 					},
 				},
+				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/fluid-cloudnative/fluid/api/v1alpha1.EncryptOption"},
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.DatasetShare", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_fluid_cloudnative_fluid_api_v1alpha1_ExternalStorage(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_fluid_cloudnative_fluid_api_v1alpha1_DatasetShareServiceAccount(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "DatasetShareServiceAccount refers to a service account allowed to create the referring datasets",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"uri": {
+					"namespace": {
 						SchemaProps: spec.SchemaProps{
-							Description: "type of external storage, including s3, oss, gcs, ceph, nfs, pvc, etc. (related to runtime)",
+							Description: "Namespace of the service account",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"encryptOptions": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "encrypt info for external storage",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.EncryptOption"),
-									},
-								},
-							},
+							Description: "Name of the service account",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
-				Required: []string{"uri"},
+				Required: []string{"namespace", "name"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/fluid-cloudnative/fluid/api/v1alpha1.EncryptOption"},
 	}
 }
 
-func schema_fluid_cloudnative_fluid_api_v1alpha1_ExtraResourcesComponentDependency(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_fluid_cloudnative_fluid_api_v1alpha1_DatasetShareSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "ExtraResourcesComponentDependency defines the extra resources configuration for component dependencies",
+				Description: "DatasetShareSpec defines the desired state of DatasetShare",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"configMaps": {
+					"dataset": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ConfigMaps is a list of ConfigMaps in the same namespace to mount into the component",
+							Description: "Dataset is the name of the shared Dataset in the same namespace",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"grants": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Grants are the datasets allowed to refer to the shared Dataset with dataset://",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.ConfigMapDependencyConfig"),
+										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DatasetShareGrant"),
 									},
 								},
 							},
 						},
 					},
 				},
+				Required: []string{"dataset", "grants"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/fluid-cloudnative/fluid/api/v1alpha1.ConfigMapDependencyConfig"},
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.DatasetShareGrant"},
 	}
 }
 
-func schema_fluid_cloudnative_fluid_api_v1alpha1_HCFSStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_fluid_cloudnative_fluid_api_v1alpha1_DatasetSnapshot(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "HCFS Endpoint info",
+				Description: "DatasetSnapshot is the Schema for the datasetsnapshots API",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"endpoint": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Endpoint for accessing",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"underlayerFileSystemVersion": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Underlayer HCFS Compatible Version",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DatasetSnapshotSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DatasetSnapshotStatus"),
+						},
+					},
 				},
 			},
 		},
+		Dependencies: []string{
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.DatasetSnapshotSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.DatasetSnapshotStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_fluid_cloudnative_fluid_api_v1alpha1_HeadlessRuntimeComponentService(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_fluid_cloudnative_fluid_api_v1alpha1_DatasetSnapshotList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "HeadlessRuntimeComponentService defines the configuration for headless service",
+				Description: "DatasetSnapshotList contains a list of DatasetSnapshot",
 				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DatasetSnapshot"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.DatasetSnapshot", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_fluid_cloudnative_fluid_api_v1alpha1_HostPathMediumSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_fluid_cloudnative_fluid_api_v1alpha1_DatasetSnapshotManifest(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "HostPathMediumSource describes hostPath volumes as a storage medium. Multiple paths can be configured to distribute cache across different disks.",
+				Description: "DatasetSnapshotManifest describes the file manifest recorded by the Manifest and DataBackup methods",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"paths": {
+					"configMap": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Paths is a list of file paths on the host machine to be used for caching. Multiple paths allow distributing cache across different mount points or disks. Example: [\"/mnt/cache1\", \"/mnt/cache2\"]",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "ConfigMap is the name of the ConfigMap holding the gzipped manifest",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"quotas": {
+					"digest": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Quotas is a list of storage quotas corresponding to each path. The length of Quotas must match the length of Paths. Each quota defines the maximum cache size for the corresponding path. Example: [\"100Gi\", \"50Gi\"] allocates 100GiB to the first path and 50GiB to the second.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Ref: ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
-									},
-								},
-							},
+							Description: "Digest is the sha256 digest of the manifest, the data is unchanged if a later manifest has the same digest",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"type": {
+					"fileCount": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Type specifies the type of hostPath volume. Defaults to empty string (no validation). More info: https://kubernetes.io/docs/concepts/storage/volumes#hostpath",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "FileCount is the number of the files in the manifest",
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+					"totalSize": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TotalSize is the total size in bytes of the files in the manifest",
+							Type:        []string{"integer"},
+							Format:      "int64",
 						},
 					},
 				},
-				Required: []string{"paths", "quotas"},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
 	}
 }
 
-func schema_fluid_cloudnative_fluid_api_v1alpha1_InitFuseSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_fluid_cloudnative_fluid_api_v1alpha1_DatasetSnapshotRef(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "InitFuseSpec is a description of initialize the fuse kernel module for runtime",
+				Description: "DatasetSnapshotRef refers to a DatasetSnapshot in the same namespace",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"version": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The version information that instructs fluid to orchestrate a particular version of Alifuse",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.VersionSpec"),
+							Description: "Name of the DatasetSnapshot",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
+				Required: []string{"name"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/fluid-cloudnative/fluid/api/v1alpha1.VersionSpec"},
 	}
 }
 
-func schema_fluid_cloudnative_fluid_api_v1alpha1_InitUsersSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_fluid_cloudnative_fluid_api_v1alpha1_DatasetSnapshotSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "InitUsersSpec is a description of the initialize the users for runtime",
+				Description: "DatasetSnapshotSpec defines the desired state of DatasetSnapshot",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"image": {
+					"dataset": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Image for initialize the users for runtime(e.g. alluxio/alluxio-User init)",
+							Description: "Dataset is the name of the dataset to snapshot in the same namespace",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"imageTag": {
+					"method": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Image Tag for initialize the users for runtime(e.g. 2.3.0-SNAPSHOT)",
+							Description: "Method is the way to capture the snapshot. Defaults to Clone for JuiceFSRuntime mounting a sub directory of the volume, DataBackup for AlluxioRuntime and Manifest for the other runtimes.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"imagePullPolicy": {
+					"backupPath": {
 						SchemaProps: spec.SchemaProps{
-							Description: "One of the three policies: `Always`, `IfNotPresent`, `Never`",
+							Description: "BackupPath is the path to save the metadata backup with the DataBackup method, in the form of pvc://<pvcName>/subpath or local://subpath",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"env": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Environment variables that will be used by initialize the users for runtime",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
-						},
-					},
-					"resources": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Resources that will be requested by initialize the users for runtime. <br> <br> Resources are not allowed for ephemeral containers. Ephemeral containers use spare resources already allocated to the pod.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("k8s.io/api/core/v1.ResourceRequirements"),
-						},
-					},
 				},
+				Required: []string{"dataset"},
 			},
 		},
-		Dependencies: []string{
-			"k8s.io/api/core/v1.ResourceRequirements"},
 	}
 }
 
-func schema_fluid_cloudnative_fluid_api_v1alpha1_JindoCompTemplateSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_fluid_cloudnative_fluid_api_v1alpha1_DatasetSnapshotStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "JindoCompTemplateSpec is a description of the Jindo commponents",
+				Description: "DatasetSnapshotStatus defines the observed state of DatasetSnapshot",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"replicas": {
+					"phase": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Replicas is the desired number of replicas of the given template. If unspecified, defaults to 1. replicas is the min replicas of dataset in the cluster",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "Phase describes the current phase of the snapshot",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"properties": {
+					"method": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Configurable properties for the Jindo component. <br>",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "Method is the way the snapshot is captured",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"ports": {
+					"snapshotTime": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "SnapshotTime is the time when the snapshot was captured",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"mounts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Mounts are the mounts of a dataset mounted from the snapshot, which are read-only",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: 0,
-										Type:    []string{"integer"},
-										Format:  "int32",
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.Mount"),
 									},
 								},
 							},
 						},
 					},
-					"resources": {
+					"dataRestoreLocation": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Resources that will be requested by the Jindo component. <br> <br> Resources are not allowed for ephemeral containers. Ephemeral containers use spare resources already allocated to the pod.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("k8s.io/api/core/v1.ResourceRequirements"),
+							Description: "DataRestoreLocation is the location to restore the metadata from for a dataset mounted from the snapshot",
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DataRestoreLocation"),
 						},
 					},
-					"env": {
+					"manifest": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Environment variables that will be used by Jindo component. <br>",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "Manifest describes the file manifest the data is verified against before a dataset is mounted from the snapshot",
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DatasetSnapshotManifest"),
+						},
+					},
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions consists of transition information on the snapshot's Phase",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.Condition"),
 									},
 								},
 							},
 						},
 					},
-					"nodeSelector": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.Condition", "github.com/fluid-cloudnative/fluid/api/v1alpha1.DataRestoreLocation", "github.com/fluid-cloudnative/fluid/api/v1alpha1.DatasetSnapshotManifest", "github.com/fluid-cloudnative/fluid/api/v1alpha1.Mount", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_DatasetSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "DatasetSpec defines the desired state of Dataset",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"mounts": {
 						SchemaProps: spec.SchemaProps{
-							Description: "NodeSelector is a selector which must be true for the master to fit on a node",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "Mount Points to be mounted on cache runtime. <br> This field can be empty because some runtimes don't need to mount external storage (e.g. <a href=\"https://v6d.io/\">Vineyard</a>).",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.Mount"),
 									},
 								},
 							},
 						},
 					},
+					"owner": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The owner of the dataset",
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.User"),
+						},
+					},
+					"nodeAffinity": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeAffinity defines constraints that limit what nodes this dataset can be cached to. This field influences the scheduling of pods that use the cached dataset.",
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.CacheableNodeAffinity"),
+						},
+					},
 					"tolerations": {
 						SchemaProps: spec.SchemaProps{
 							Description: "If specified, the pod's tolerations.",
@@ -4350,12 +5047,11 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_JindoCompTemplateSpec(ref commo
 							},
 						},
 					},
-					"labels": {
+					"accessModes": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Labels will be added on JindoFS Master or Worker pods. DEPRECATED: This is a deprecated field. Please use PodMetadata instead. Note: this field is set to be exclusive with PodMetadata.Labels",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "AccessModes contains all ways the volume backing the PVC can be mounted",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: "",
@@ -4366,117 +5062,143 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_JindoCompTemplateSpec(ref commo
 							},
 						},
 					},
-					"podMetadata": {
+					"runtimes": {
 						SchemaProps: spec.SchemaProps{
-							Description: "PodMetadata defines labels and annotations that will be propagated to Jindo's pods",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata"),
+							Description: "Runtimes for supporting dataset (e.g. AlluxioRuntime)",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.Runtime"),
+									},
+								},
+							},
 						},
 					},
-					"disabled": {
+					"placement": {
 						SchemaProps: spec.SchemaProps{
-							Description: "If disable JindoFS master or worker",
-							Type:        []string{"boolean"},
+							Description: "Manage switch for opening Multiple datasets single node deployment or not",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"volumeMounts": {
+					"dataRestoreLocation": {
 						SchemaProps: spec.SchemaProps{
-							Description: "VolumeMounts specifies the volumes listed in \".spec.volumes\" to mount into the jindo runtime component's filesystem.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
+							Description: "DataRestoreLocation is the location to load data of dataset  been backuped",
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DataRestoreLocation"),
+						},
+					},
+					"sharedOptions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SharedOptions is the options to all mount",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("k8s.io/api/core/v1.VolumeMount"),
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
 						},
 					},
-					"imagePullSecrets": {
+					"sharedEncryptOptions": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ImagePullSecrets that will be used to pull images",
+							Description: "SharedEncryptOptions is the encryptOption to all mount",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("k8s.io/api/core/v1.LocalObjectReference"),
+										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.EncryptOption"),
 									},
 								},
 							},
 						},
 					},
+					"snapshotRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "SnapshotRef mounts the dataset from a DatasetSnapshot read-only. The mounts, access modes and restore location are filled from the snapshot once it's complete, the runtime waits until then.",
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DatasetSnapshotRef"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.Toleration", "k8s.io/api/core/v1.VolumeMount"},
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.CacheableNodeAffinity", "github.com/fluid-cloudnative/fluid/api/v1alpha1.DataRestoreLocation", "github.com/fluid-cloudnative/fluid/api/v1alpha1.DatasetSnapshotRef", "github.com/fluid-cloudnative/fluid/api/v1alpha1.EncryptOption", "github.com/fluid-cloudnative/fluid/api/v1alpha1.Mount", "github.com/fluid-cloudnative/fluid/api/v1alpha1.Runtime", "github.com/fluid-cloudnative/fluid/api/v1alpha1.User", "k8s.io/api/core/v1.Toleration"},
 	}
 }
 
-func schema_fluid_cloudnative_fluid_api_v1alpha1_JindoFuseSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_fluid_cloudnative_fluid_api_v1alpha1_DatasetStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "JindoFuseSpec is a description of the Jindo Fuse",
+				Description: "DatasetStatus defines the observed state of Dataset",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"image": {
+					"mounts": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Image for Jindo Fuse(e.g. jindo/jindo-fuse)",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "the info of mount points have been mounted",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.Mount"),
+									},
+								},
+							},
 						},
 					},
-					"imageTag": {
+					"ufsTotal": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Image Tag for Jindo Fuse(e.g. 2.3.0-SNAPSHOT)",
+							Description: "Total in GB of dataset in the cluster",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"imagePullPolicy": {
+					"phase": {
 						SchemaProps: spec.SchemaProps{
-							Description: "One of the three policies: `Always`, `IfNotPresent`, `Never`",
+							Description: "Dataset Phase. One of the four phases: `Pending`, `Bound`, `NotBound` and `Failed`",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"imagePullSecrets": {
+					"runtimes": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ImagePullSecrets that will be used to pull images",
+							Description: "Runtimes for supporting dataset",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("k8s.io/api/core/v1.LocalObjectReference"),
+										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.Runtime"),
 									},
 								},
 							},
 						},
 					},
-					"properties": {
+					"conditions": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Configurable properties for Jindo System. <br>",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "Conditions is an array of current observed conditions.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DatasetCondition"),
 									},
 								},
 							},
 						},
 					},
-					"env": {
+					"cacheStates": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Environment variables that will be used by Jindo Fuse",
+							Description: "CacheStatus represents the total resources of the dataset.",
 							Type:        []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
@@ -4490,31 +5212,36 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_JindoFuseSpec(ref common.Refere
 							},
 						},
 					},
-					"resources": {
+					"hcfs": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Resources that will be requested by Jindo Fuse. <br> <br> Resources are not allowed for ephemeral containers. Ephemeral containers use spare resources already allocated to the pod.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("k8s.io/api/core/v1.ResourceRequirements"),
+							Description: "HCFSStatus represents hcfs info",
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.HCFSStatus"),
 						},
 					},
-					"args": {
+					"fileNum": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Arguments that will be passed to Jindo Fuse",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "FileNum represents the file numbers of the dataset",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"nodeSelector": {
+					"dataLoadRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "NodeSelector is a selector which must be true for the fuse client to fit on a node, this option only effect when global is enabled",
+							Description: "DataLoadRef specifies the running DataLoad job that targets this Dataset. This is mainly used as a lock to prevent concurrent DataLoad jobs. Deprecated, use OperationRef instead",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"dataBackupRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DataBackupRef specifies the running Backup job that targets this Dataset. This is mainly used as a lock to prevent concurrent DataBackup jobs. Deprecated, use OperationRef instead",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"operationRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "OperationRef specifies the Operation that targets this Dataset. This is mainly used as a lock to prevent concurrent same Operation jobs.",
 							Type:        []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
@@ -4528,23 +5255,104 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_JindoFuseSpec(ref common.Refere
 							},
 						},
 					},
-					"tolerations": {
+					"datasetRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "If specified, the pod's tolerations.",
+							Description: "DatasetRef specifies the datasets namespaced name mounting this Dataset.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"datasetRefGrants": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DatasetRefGrants specifies the DatasetShares allowing the datasets in DatasetRef from other namespaces.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("k8s.io/api/core/v1.Toleration"),
+										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DatasetRefGrant"),
 									},
 								},
 							},
 						},
 					},
-					"labels": {
+				},
+				Required: []string{"conditions"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.DatasetCondition", "github.com/fluid-cloudnative/fluid/api/v1alpha1.DatasetRefGrant", "github.com/fluid-cloudnative/fluid/api/v1alpha1.HCFSStatus", "github.com/fluid-cloudnative/fluid/api/v1alpha1.Mount", "github.com/fluid-cloudnative/fluid/api/v1alpha1.Runtime"},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_DatasetToMigrate(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Labels will be added on all the JindoFS pods. DEPRECATED: this is a deprecated field. Please use PodMetadata.Labels instead. Note: this field is set to be exclusive with PodMetadata.Labels",
+							Description: "name of dataset",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "namespace of dataset",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "path to migrate",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name", "namespace"},
+			},
+		},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_EFCCompTemplateSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "EFCCompTemplateSpec is a description of the EFC components",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"replicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Replicas is the desired number of replicas of the given template. If unspecified, defaults to 1. replicas is the min replicas of dataset in the cluster",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The version information that instructs fluid to orchestrate a particular version of EFC Comp",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.VersionSpec"),
+						},
+					},
+					"properties": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Configurable properties for the EFC component.",
 							Type:        []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
@@ -4558,30 +5366,40 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_JindoFuseSpec(ref common.Refere
 							},
 						},
 					},
-					"podMetadata": {
+					"ports": {
 						SchemaProps: spec.SchemaProps{
-							Description: "PodMetadata defines labels and annotations that will be propagated to Jindo's fuse pods",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata"),
+							Description: "Ports used by EFC(e.g. rpc: 19998 for master).",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: 0,
+										Type:    []string{"integer"},
+										Format:  "int32",
+									},
+								},
+							},
 						},
 					},
-					"cleanPolicy": {
+					"resources": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CleanPolicy decides when to clean JindoFS Fuse pods. Currently Fluid supports two policies: OnDemand and OnRuntimeDeleted OnDemand cleans fuse pod once th fuse pod on some node is not needed OnRuntimeDeleted cleans fuse pod only when the cache runtime is deleted Defaults to OnRuntimeDeleted",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Resources that will be requested by the EFC component. <br> <br> Resources are not allowed for ephemeral containers. Ephemeral containers use spare resources already allocated to the pod.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/api/core/v1.ResourceRequirements"),
 						},
 					},
 					"disabled": {
 						SchemaProps: spec.SchemaProps{
-							Description: "If disable JindoFS fuse",
+							Description: "Enabled or Disabled for the components. Default enable.",
 							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
-					"logConfig": {
+					"nodeSelector": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
+							Description: "NodeSelector is a selector which must be true for the component to fit on a node.",
+							Type:        []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
 								Schema: &spec.Schema{
@@ -4594,26 +5412,115 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_JindoFuseSpec(ref common.Refere
 							},
 						},
 					},
-					"metrics": {
+					"networkMode": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Define whether fuse metrics will be enabled.",
+							Description: "Whether to use host network or not.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"podMetadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PodMetadata defines labels and annotations that will be propagated to EFC's master and worker pods",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.ClientMetrics"),
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/fluid-cloudnative/fluid/api/v1alpha1.ClientMetrics", "github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.Toleration"},
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata", "github.com/fluid-cloudnative/fluid/api/v1alpha1.VersionSpec", "k8s.io/api/core/v1.ResourceRequirements"},
 	}
 }
 
-func schema_fluid_cloudnative_fluid_api_v1alpha1_JindoRuntime(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_fluid_cloudnative_fluid_api_v1alpha1_EFCFuseSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "JindoRuntime is the Schema for the jindoruntimes API",
+				Description: "EFCFuseSpec is a description of the EFC Fuse",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The version information that instructs fluid to orchestrate a particular version of EFC Fuse",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.VersionSpec"),
+						},
+					},
+					"properties": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Configurable properties for EFC fuse",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"resources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Resources that will be requested by EFC Fuse. <br> <br> Resources are not allowed for ephemeral containers. Ephemeral containers use spare resources already allocated to the pod.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/api/core/v1.ResourceRequirements"),
+						},
+					},
+					"nodeSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeSelector is a selector which must be true for the fuse client to fit on a node, this option only effect when global is enabled",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"cleanPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CleanPolicy decides when to clean EFC Fuse pods. Currently Fluid supports two policies: OnDemand and OnRuntimeDeleted OnDemand cleans fuse pod once th fuse pod on some node is not needed OnRuntimeDeleted cleans fuse pod only when the cache runtime is deleted Defaults to OnRuntimeDeleted",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"networkMode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Whether to use hostnetwork or not",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"podMetadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PodMetadata defines labels and annotations that will be propagated to EFC's fuse pods",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata", "github.com/fluid-cloudnative/fluid/api/v1alpha1.VersionSpec", "k8s.io/api/core/v1.ResourceRequirements"},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_EFCRuntime(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "EFCRuntime is the Schema for the efcruntimes API",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -4639,7 +5546,7 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_JindoRuntime(ref common.Referen
 					"spec": {
 						SchemaProps: spec.SchemaProps{
 							Default: map[string]interface{}{},
-							Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.JindoRuntimeSpec"),
+							Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.EFCRuntimeSpec"),
 						},
 					},
 					"status": {
@@ -4652,15 +5559,15 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_JindoRuntime(ref common.Referen
 			},
 		},
 		Dependencies: []string{
-			"github.com/fluid-cloudnative/fluid/api/v1alpha1.JindoRuntimeSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.EFCRuntimeSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_fluid_cloudnative_fluid_api_v1alpha1_JindoRuntimeList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_fluid_cloudnative_fluid_api_v1alpha1_EFCRuntimeList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "JindoRuntimeList contains a list of JindoRuntime",
+				Description: "EFCRuntimeList contains a list of EFCRuntime",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -4690,7 +5597,7 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_JindoRuntimeList(ref common.Ref
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.JindoRuntime"),
+										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.EFCRuntime"),
 									},
 								},
 							},
@@ -4701,64 +5608,48 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_JindoRuntimeList(ref common.Ref
 			},
 		},
 		Dependencies: []string{
-			"github.com/fluid-cloudnative/fluid/api/v1alpha1.JindoRuntime", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.EFCRuntime", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_fluid_cloudnative_fluid_api_v1alpha1_JindoRuntimeSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_fluid_cloudnative_fluid_api_v1alpha1_EFCRuntimeSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "JindoRuntimeSpec defines the desired state of JindoRuntime",
+				Description: "EFCRuntimeSpec defines the desired state of EFCRuntime",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"jindoVersion": {
+					"master": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The version information that instructs fluid to orchestrate a particular version of Jindo.",
+							Description: "The component spec of EFC master",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.VersionSpec"),
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.EFCCompTemplateSpec"),
 						},
 					},
-					"master": {
+					"worker": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The component spec of Jindo master",
+							Description: "The component spec of EFC worker",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.JindoCompTemplateSpec"),
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.EFCCompTemplateSpec"),
 						},
 					},
-					"worker": {
+					"initFuse": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The component spec of Jindo worker",
+							Description: "The spec of init alifuse",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.JindoCompTemplateSpec"),
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.InitFuseSpec"),
 						},
 					},
 					"fuse": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The component spec of Jindo Fuse",
+							Description: "The component spec of EFC Fuse",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.JindoFuseSpec"),
-						},
-					},
-					"properties": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Configurable properties for Jindo system. <br>",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.EFCFuseSpec"),
 						},
 					},
 					"tieredstore": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Tiered storage used by Jindo",
+							Description: "Tiered storage used by EFC worker",
 							Default:     map[string]interface{}{},
 							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.TieredStore"),
 						},
@@ -4770,59 +5661,146 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_JindoRuntimeSpec(ref common.Ref
 							Format:      "int32",
 						},
 					},
-					"runAs": {
+					"osAdvise": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Manage the user to run Jindo Runtime",
-							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.User"),
+							Description: "Operating system optimization for EFC",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.OSAdvise"),
 						},
 					},
-					"user": {
+					"cleanCachePolicy": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "CleanCachePolicy defines cleanCache Policy",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.CleanCachePolicy"),
 						},
 					},
-					"hadoopConfig": {
+					"podMetadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name of the configMap used to support HDFS configurations when using HDFS as Jindo's UFS. The configMap must be in the same namespace with the JindoRuntime. The configMap should contain user-specific HDFS conf files in it. For now, only \"hdfs-site.xml\" and \"core-site.xml\" are supported. It must take the filename of the conf file as the key and content of the file as the value.",
+							Description: "PodMetadata defines labels and annotations that will be propagated to all EFC's pods",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata"),
+						},
+					},
+					"dataOperationPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DataOperationPolicy defines the policy of running the data operations on the runtime",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DataOperationPolicy"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.CleanCachePolicy", "github.com/fluid-cloudnative/fluid/api/v1alpha1.DataOperationPolicy", "github.com/fluid-cloudnative/fluid/api/v1alpha1.EFCCompTemplateSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.EFCFuseSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.InitFuseSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.OSAdvise", "github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata", "github.com/fluid-cloudnative/fluid/api/v1alpha1.TieredStore"},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_EmptyDirMediumSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "EmptyDirMediumSource describes emptyDir volume as a storage medium. Can be backed by node's default storage or memory (tmpfs).",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"quota": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Quota specifies the maximum storage capacity for the emptyDir volume. For Memory medium, this limit is also constrained by the sum of container memory limits. The actual limit = min(Quota, sum of container memory limits). Example: \"100Gi\" limits the emptyDir to 100GiB.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"medium": {
+						SchemaProps: spec.SchemaProps{
+							Description: "medium represents what type of storage medium should back this directory. The default is \"\" which means to use the node's default medium. Must be an empty string (default) or Memory. More info: https://kubernetes.io/docs/concepts/storage/volumes#emptydir",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"secret": {
+				},
+				Required: []string{"quota"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_EncryptOption(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Type:   []string{"string"},
-							Format: "",
+							Description: "The name of encryptOption",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"labels": {
+					"valueFrom": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Labels will be added on all the JindoFS pods. DEPRECATED: this is a deprecated field. Please use PodMetadata.Labels instead. Note: this field is set to be exclusive with PodMetadata.Labels",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Description: "The valueFrom of encryptOption",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.EncryptOptionSource"),
 						},
 					},
-					"podMetadata": {
+				},
+				Required: []string{"name"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.EncryptOptionSource"},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_EncryptOptionSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"secretKeyRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "PodMetadata defines labels and annotations that will be propagated to all Jindo's fuse pods",
+							Description: "The encryptInfo obtained from secret",
 							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata"),
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.SecretKeySelector"),
 						},
 					},
-					"logConfig": {
+					"credentialProvider": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "The encryptInfo exchanged from a credential provider with a service account token, it's refreshed before it expires",
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.CredentialProviderSelector"),
+						},
+					},
+					"csiSecretStore": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The encryptInfo read from a file on a Secrets Store CSI volume",
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.CSISecretStoreSelector"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.CSISecretStoreSelector", "github.com/fluid-cloudnative/fluid/api/v1alpha1.CredentialProviderSelector", "github.com/fluid-cloudnative/fluid/api/v1alpha1.SecretKeySelector"},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_EventRun(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "EventRun records a run of a data operation triggered by events",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"events": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Events are the types of the events which triggered the run",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: "",
@@ -4833,154 +5811,142 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_JindoRuntimeSpec(ref common.Ref
 							},
 						},
 					},
-					"networkmode": {
+					"message": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Whether to use hostnetwork or not",
+							Description: "Message is a human-readable message indicating details about the events",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"cleanCachePolicy": {
+					"triggerTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "CleanCachePolicy defines cleanCache Policy",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.CleanCachePolicy"),
+							Description: "TriggerTime is the time the run was triggered",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"volumes": {
+					"phase": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Volumes is the list of Kubernetes volumes that can be mounted by the jindo runtime components and/or fuses.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("k8s.io/api/core/v1.Volume"),
-									},
-								},
-							},
+							Description: "Phase is the phase of the run",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"imagePullSecrets": {
+					"duration": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ImagePullSecrets that will be used to pull images",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("k8s.io/api/core/v1.LocalObjectReference"),
-									},
-								},
-							},
+							Description: "Duration tells user how much time was spent on the run",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
+				Required: []string{"events", "triggerTime"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/fluid-cloudnative/fluid/api/v1alpha1.CleanCachePolicy", "github.com/fluid-cloudnative/fluid/api/v1alpha1.JindoCompTemplateSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.JindoFuseSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata", "github.com/fluid-cloudnative/fluid/api/v1alpha1.TieredStore", "github.com/fluid-cloudnative/fluid/api/v1alpha1.User", "github.com/fluid-cloudnative/fluid/api/v1alpha1.VersionSpec", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/api/core/v1.Volume"},
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_fluid_cloudnative_fluid_api_v1alpha1_JobProcessor(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_fluid_cloudnative_fluid_api_v1alpha1_EventSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "EventSource defines an event which triggers a data operation with OnEvent policy",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"podSpec": {
+					"type": {
 						SchemaProps: spec.SchemaProps{
-							Description: "PodSpec defines Pod specification of the DataProcess job.",
-							Ref:         ref("k8s.io/api/core/v1.PodSpec"),
+							Description: "Type of the event, one of `DatasetMountsChanged`, `OperationCompleted` or `UFSChanged`",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"operation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Operation specifies the data operation to watch, only used when type is OperationCompleted",
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.ObjectRef"),
+						},
+					},
+					"pathPrefixes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PathPrefixes filters UFS change notifications by path, only used when type is UFSChanged. A notification matches if its path is one of the prefixes or under one of them. Empty means all paths match.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
 				},
+				Required: []string{"type"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/api/core/v1.PodSpec"},
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.ObjectRef"},
 	}
 }
 
-func schema_fluid_cloudnative_fluid_api_v1alpha1_JuiceFSCompTemplateSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_fluid_cloudnative_fluid_api_v1alpha1_EventTriggerStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "JuiceFSCompTemplateSpec is a description of the JuiceFS components",
+				Description: "EventTriggerStatus records the observed events and the runs triggered by them",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"replicas": {
+					"observedRevisions": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Replicas is the desired number of replicas of the given template. If unspecified, defaults to 1. replicas is the min replicas of dataset in the cluster",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "ObservedRevisions records the last observed revision of each event source",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"ports": {
+					"runs": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Ports used by JuiceFS",
+							Description: "Runs is the history of the runs triggered by events, the latest run is the last one",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("k8s.io/api/core/v1.ContainerPort"),
-									},
-								},
-							},
-						},
-					},
-					"resources": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Resources that will be requested by the JuiceFS component.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("k8s.io/api/core/v1.ResourceRequirements"),
-						},
-					},
-					"options": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Options",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.EventRun"),
 									},
 								},
 							},
 						},
 					},
-					"env": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.EventRun"},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_ExecutionCommonEntry(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"command": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Environment variables that will be used by JuiceFS component.",
-							Type:        []string{"array"},
+							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("k8s.io/api/core/v1.EnvVar"),
-									},
-								},
-							},
-						},
-					},
-					"enabled": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Enabled or Disabled for the components.",
-							Type:        []string{"boolean"},
-							Format:      "",
-						},
-					},
-					"nodeSelector": {
-						SchemaProps: spec.SchemaProps{
-							Description: "NodeSelector is a selector",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: "",
@@ -4991,109 +5957,78 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_JuiceFSCompTemplateSpec(ref com
 							},
 						},
 					},
-					"volumeMounts": {
-						SchemaProps: spec.SchemaProps{
-							Description: "VolumeMounts specifies the volumes listed in \".spec.volumes\" to mount into runtime component's filesystem.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("k8s.io/api/core/v1.VolumeMount"),
-									},
-								},
-							},
-						},
-					},
-					"podMetadata": {
-						SchemaProps: spec.SchemaProps{
-							Description: "PodMetadata defines labels and annotations that will be propagated to JuiceFs's pods.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata"),
-						},
-					},
-					"networkMode": {
+					"timeout": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Whether to use hostnetwork or not",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "TimeoutSeconds is the timeout(seconds) for the execution entry, at least(default) 20 seconds.",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
 				},
+				Required: []string{"command"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata", "k8s.io/api/core/v1.ContainerPort", "k8s.io/api/core/v1.EnvVar", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.VolumeMount"},
 	}
 }
 
-func schema_fluid_cloudnative_fluid_api_v1alpha1_JuiceFSFuseSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_fluid_cloudnative_fluid_api_v1alpha1_ExecutionEntries(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"image": {
+					"mountUFS": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Image for JuiceFS fuse",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "MountUFS defines the operations for mounting UFS. The command's stdout must be JSON matching CacheRuntimeMountUfsOutput.",
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.ExecutionCommonEntry"),
 						},
 					},
-					"imageTag": {
+					"reportSummary": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Image for JuiceFS fuse",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "ReportSummary it defines the operation how to get cache status like capacity, hit ratio etc.",
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.ExecutionCommonEntry"),
 						},
 					},
-					"imagePullPolicy": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.ExecutionCommonEntry"},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_ExternalEndpointSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "ExternalEndpointSpec defines the configurations for external etcd cluster",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"uri": {
 						SchemaProps: spec.SchemaProps{
-							Description: "One of the three policies: `Always`, `IfNotPresent`, `Never`",
+							Description: "URI specifies the endpoint of external Etcd cluster E,g. \"etcd-svc.etcd-namespace.svc.cluster.local:2379\" Default is not set and use http protocol to connect to external etcd cluster",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"env": {
+					"encryptOptions": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Environment variables that will be used by JuiceFS Fuse",
+							Description: "encrypt info for accessing the external etcd cluster",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("k8s.io/api/core/v1.EnvVar"),
+										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.EncryptOption"),
 									},
 								},
 							},
 						},
 					},
-					"resources": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Resources that will be requested by JuiceFS Fuse.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("k8s.io/api/core/v1.ResourceRequirements"),
-						},
-					},
 					"options": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Options mount options that fuse pod will use",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
-						},
-					},
-					"nodeSelector": {
-						SchemaProps: spec.SchemaProps{
-							Description: "NodeSelector is a selector which must be true for the fuse client to fit on a node, this option only effect when global is enabled",
+							Description: "Configurable options for External Etcd cluster.",
 							Type:        []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
@@ -5107,402 +6042,522 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_JuiceFSFuseSpec(ref common.Refe
 							},
 						},
 					},
-					"volumeMounts": {
-						SchemaProps: spec.SchemaProps{
-							Description: "VolumeMounts specifies the volumes listed in \".spec.volumes\" to mount into runtime component's filesystem.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("k8s.io/api/core/v1.VolumeMount"),
-									},
-								},
-							},
-						},
-					},
-					"cleanPolicy": {
-						SchemaProps: spec.SchemaProps{
-							Description: "CleanPolicy decides when to clean Juicefs Fuse pods. Currently Fluid supports three policies: OnDemand, OnRuntimeDeleted and OnFuseChangedCleanPolicy OnDemand cleans fuse pod once the fuse pod on some node is not needed OnRuntimeDeleted cleans fuse pod only when the cache runtime is deleted OnFuseChangedCleanPolicy cleans fuse pod once the fuse pod on some node is not needed and the fuse in runtime is updated Defaults to OnRuntimeDeleted",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"podMetadata": {
-						SchemaProps: spec.SchemaProps{
-							Description: "PodMetadata defines labels and annotations that will be propagated to JuiceFs's pods.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata"),
-						},
-					},
-					"networkMode": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Whether to use hostnetwork or not",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata", "k8s.io/api/core/v1.EnvVar", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.VolumeMount"},
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.EncryptOption"},
 	}
 }
 
-func schema_fluid_cloudnative_fluid_api_v1alpha1_JuiceFSRuntime(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_fluid_cloudnative_fluid_api_v1alpha1_ExternalMutator(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "JuiceFSRuntime is the Schema for the juicefsruntimes API",
+				Description: "ExternalMutator is an HTTPS service which mutates pods. The Fluid webhook posts a JSON object with the pod in the \"pod\" field and expects a JSON patch of the pod in the \"patch\" field of the response.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Description: "Name of the external mutator",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"apiVersion": {
+					"service": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Service refers to the Service of the external mutator in the namespace of the PodMutationPolicy, which is called with https at <name>.<namespace>.svc",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.MutatorServiceReference"),
 						},
 					},
-					"metadata": {
+					"caBundle": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+							Description: "CABundle is a PEM encoded CA bundle used to verify the serving certificate of the Service. The system trust roots are used if it's not set.",
+							Type:        []string{"string"},
+							Format:      "byte",
 						},
 					},
-					"spec": {
+					"timeoutSeconds": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.JuiceFSRuntimeSpec"),
+							Description: "TimeoutSeconds is the timeout of a call to the external mutator, default to 10 seconds",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"status": {
+					"failurePolicy": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeStatus"),
+							Description: "FailurePolicy defines how an error from the external mutator is handled, default to Fail",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
+				Required: []string{"name", "service"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/fluid-cloudnative/fluid/api/v1alpha1.JuiceFSRuntimeSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.MutatorServiceReference"},
 	}
 }
 
-func schema_fluid_cloudnative_fluid_api_v1alpha1_JuiceFSRuntimeList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_fluid_cloudnative_fluid_api_v1alpha1_ExternalStorage(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "JuiceFSRuntimeList contains a list of JuiceFSRuntime",
-				Type:        []string{"object"},
+				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"kind": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"apiVersion": {
+					"uri": {
 						SchemaProps: spec.SchemaProps{
-							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Description: "type of external storage, including s3, oss, gcs, ceph, nfs, pvc, etc. (related to runtime)",
+							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
-						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
-						},
-					},
-					"items": {
+					"encryptOptions": {
 						SchemaProps: spec.SchemaProps{
-							Type: []string{"array"},
+							Description: "encrypt info for external storage",
+							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.JuiceFSRuntime"),
+										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.EncryptOption"),
 									},
 								},
 							},
 						},
 					},
 				},
-				Required: []string{"items"},
+				Required: []string{"uri"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/fluid-cloudnative/fluid/api/v1alpha1.JuiceFSRuntime", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.EncryptOption"},
 	}
 }
 
-func schema_fluid_cloudnative_fluid_api_v1alpha1_JuiceFSRuntimeSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_fluid_cloudnative_fluid_api_v1alpha1_ExtraResourcesComponentDependency(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "JuiceFSRuntimeSpec defines the desired state of JuiceFSRuntime",
+				Description: "ExtraResourcesComponentDependency defines the extra resources configuration for component dependencies",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"juicefsVersion": {
+					"configMaps": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The version information that instructs fluid to orchestrate a particular version of JuiceFS.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.VersionSpec"),
+							Description: "ConfigMaps is a list of ConfigMaps in the same namespace to mount into the component",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.ConfigMapDependencyConfig"),
+									},
+								},
+							},
 						},
 					},
-					"initUsers": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.ConfigMapDependencyConfig"},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_FuseNodeUpgradeStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "FuseNodeUpgradeStatus is the progress of the fuse upgrade on a node",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"nodeName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The spec of init users",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.InitUsersSpec"),
+							Description: "NodeName is the name of the node",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"master": {
+					"phase": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The component spec of JuiceFS master",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.JuiceFSCompTemplateSpec"),
+							Description: "Phase of the fuse upgrade on the node",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"worker": {
+					"mountingPods": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The component spec of JuiceFS worker",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.JuiceFSCompTemplateSpec"),
+							Description: "MountingPods is the number of the pods on the node mounting the Dataset",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"jobWorker": {
+					"lastTransitionTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The component spec of JuiceFS job Worker",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.JuiceFSCompTemplateSpec"),
+							Description: "LastTransitionTime is the last time the phase changed",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"fuse": {
+				},
+				Required: []string{"nodeName", "phase"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_FuseUpgrade(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "FuseUpgrade is the Schema for the fuseupgrades API",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Desired state for JuiceFS Fuse",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.JuiceFSFuseSpec"),
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"tieredstore": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Tiered storage used by JuiceFS",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.TieredStore"),
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"configs": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Configs of JuiceFS",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
-									},
-								},
-							},
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"replicas": {
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The replicas of the worker, need to be specified",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.FuseUpgradeSpec"),
 						},
 					},
-					"runAs": {
+					"status": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Manage the user to run Juicefs Runtime",
-							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.User"),
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.FuseUpgradeStatus"),
 						},
 					},
-					"disablePrometheus": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.FuseUpgradeSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.FuseUpgradeStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_FuseUpgradeList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "FuseUpgradeList contains a list of FuseUpgrade",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Disable monitoring for JuiceFS Runtime Prometheus is enabled by default",
-							Type:        []string{"boolean"},
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"volumes": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Volumes is the list of Kubernetes volumes that can be mounted by the JuiceFS runtime components and/or fuses.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("k8s.io/api/core/v1.Volume"),
-									},
-								},
-							},
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"volumeClaimTemplates": {
-						VendorExtensible: spec.VendorExtensible{
-							Extensions: spec.Extensions{
-								"x-kubernetes-list-type": "atomic",
-							},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
 						},
+					},
+					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "VolumeClaimTemplates is the list of Kubernetes persistent volume claim templates used by the JuiceFS worker StatefulSet.",
-							Type:        []string{"array"},
+							Type: []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("k8s.io/api/core/v1.PersistentVolumeClaim"),
+										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.FuseUpgrade"),
 									},
 								},
 							},
 						},
 					},
-					"podMetadata": {
-						SchemaProps: spec.SchemaProps{
-							Description: "PodMetadata defines labels and annotations that will be propagated to JuiceFs's pods.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata"),
-						},
-					},
-					"management": {
-						SchemaProps: spec.SchemaProps{
-							Description: "RuntimeManagement defines policies when managing the runtime",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeManagement"),
-						},
-					},
 				},
+				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/fluid-cloudnative/fluid/api/v1alpha1.InitUsersSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.JuiceFSCompTemplateSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.JuiceFSFuseSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata", "github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeManagement", "github.com/fluid-cloudnative/fluid/api/v1alpha1.TieredStore", "github.com/fluid-cloudnative/fluid/api/v1alpha1.User", "github.com/fluid-cloudnative/fluid/api/v1alpha1.VersionSpec", "k8s.io/api/core/v1.PersistentVolumeClaim", "k8s.io/api/core/v1.Volume"},
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.FuseUpgrade", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_fluid_cloudnative_fluid_api_v1alpha1_Level(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_fluid_cloudnative_fluid_api_v1alpha1_FuseUpgradeSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Level describes configurations a tier needs. <br> Refer to <a href=\"https://docs.alluxio.io/os/user/stable/en/core-services/Caching.html#configuring-tiered-storage\">Configuring Tiered Storage</a> for more info",
+				Description: "FuseUpgradeSpec defines the desired state of FuseUpgrade",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"mediumtype": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Medium Type of the tier. One of the three types: `MEM`, `SSD`, `HDD`",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"volumeType": {
+					"runtimeRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "VolumeType is the volume type of the tier. Should be one of the three types: `hostPath`, `emptyDir` and `volumeTemplate`. If not set, defaults to hostPath.",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "RuntimeRef refers to the runtime whose fuse pods are upgraded",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.FuseUpgradeTargetRef"),
 						},
 					},
-					"volumeSource": {
+					"maxUnavailable": {
 						SchemaProps: spec.SchemaProps{
-							Description: "VolumeSource is the volume source of the tier. It follows the form of corev1.VolumeSource. For now, users should only specify VolumeSource when VolumeType is set to emptyDir.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.VolumeSource"),
+							Description: "MaxUnavailable is the maximum number or percentage of the nodes being drained or upgraded at the same time, defaults to 1",
+							Ref:         ref("k8s.io/apimachinery/pkg/util/intstr.IntOrString"),
 						},
 					},
-					"path": {
+					"paused": {
 						SchemaProps: spec.SchemaProps{
-							Description: "File paths to be used for the tier. Multiple paths are supported. Multiple paths should be separated with comma. For example: \"/mnt/cache1,/mnt/cache2\".",
-							Type:        []string{"string"},
+							Description: "Paused stops draining and upgrading more nodes, the upgrade resumes once it's set to false",
+							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
-					"quota": {
+					"drainNodes": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Quota for the whole tier. (e.g. 100Gi) Please note that if there're multiple paths used for this tierstore, the quota will be equally divided into these paths. If you'd like to set quota for each, path, see QuotaList for more information.",
-							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+							Description: "DrainNodes labels the nodes with outdated fuse pods still in use with draining.fuse.fluid.io/<namespace>-<name>, so that the workloads avoiding the label move away and the nodes can be upgraded. Otherwise, a node is only upgraded when the pods using the fuse on it go away by themselves.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"quotaList": {
+				},
+				Required: []string{"runtimeRef"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.FuseUpgradeTargetRef", "k8s.io/apimachinery/pkg/util/intstr.IntOrString"},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_FuseUpgradeStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "FuseUpgradeStatus defines the observed state of FuseUpgrade",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"phase": {
 						SchemaProps: spec.SchemaProps{
-							Description: "QuotaList are quotas used to set quota on multiple paths. Quotas should be separated with comma. Quotas in this list will be set to paths with the same order in Path. For example, with Path defined with \"/mnt/cache1,/mnt/cache2\" and QuotaList set to \"100Gi, 50Gi\", then we get 100GiB cache storage under \"/mnt/cache1\" and 50GiB under \"/mnt/cache2\". Also note that num of quotas must be consistent with the num of paths defined in Path.",
+							Description: "Phase of the upgrade",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"high": {
+					"upgradedNodes": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Ratio of high watermark of the tier (e.g. 0.9)",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "UpgradedNodes is the number of the nodes running the up-to-date fuse pod",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
-					"low": {
+					"totalNodes": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Ratio of low watermark of the tier (e.g. 0.7)",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "TotalNodes is the number of the nodes running the fuse pod",
+							Type:        []string{"integer"},
+							Format:      "int32",
 						},
 					},
 				},
-				Required: []string{"mediumtype"},
 			},
 		},
-		Dependencies: []string{
-			"github.com/fluid-cloudnative/fluid/api/v1alpha1.VolumeSource", "k8s.io/apimachinery/pkg/api/resource.Quantity"},
 	}
 }
 
-func schema_fluid_cloudnative_fluid_api_v1alpha1_MasterSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_fluid_cloudnative_fluid_api_v1alpha1_FuseUpgradeTargetRef(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "MasterSpec defines the configurations for Vineyard Master component which is also regarded as the Etcd component in Vineyard. For more info about Vineyard, refer to <a href=\"https://v6d.io/\">Vineyard</a>",
+				Description: "FuseUpgradeTargetRef refers to the runtime whose fuse pods are upgraded in the same namespace",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"replicas": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The replicas of Vineyard component. If not specified, defaults to 1. For worker, the replicas should not be greater than the number of nodes in the cluster",
-							Type:        []string{"integer"},
-							Format:      "int32",
+							Description: "Kind of the runtime",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
-					"image": {
+					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The image of Vineyard component. For Master, the default image is `registry.aliyuncs.com/vineyard/vineyardd` For Worker, the default image is `registry.aliyuncs.com/vineyard/vineyardd` The default container registry is `docker.io`, you can change it by setting the image field",
+							Description: "Name of the runtime, which is the same as the name of the Dataset bound to it",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"kind", "name"},
+			},
+		},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_HCFSStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HCFS Endpoint info",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"endpoint": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Endpoint for accessing",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"underlayerFileSystemVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Underlayer HCFS Compatible Version",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_HeadlessRuntimeComponentService(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HeadlessRuntimeComponentService defines the configuration for headless service",
+				Type:        []string{"object"},
+			},
+		},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_HostPathMediumSource(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "HostPathMediumSource describes hostPath volumes as a storage medium. Multiple paths can be configured to distribute cache across different disks.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"paths": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Paths is a list of file paths on the host machine to be used for caching. Multiple paths allow distributing cache across different mount points or disks. Example: [\"/mnt/cache1\", \"/mnt/cache2\"]",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"quotas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Quotas is a list of storage quotas corresponding to each path. The length of Quotas must match the length of Paths. Each quota defines the maximum cache size for the corresponding path. Example: [\"100Gi\", \"50Gi\"] allocates 100GiB to the first path and 50GiB to the second.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Ref: ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+									},
+								},
+							},
+						},
+					},
+					"type": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Type specifies the type of hostPath volume. Defaults to empty string (no validation). More info: https://kubernetes.io/docs/concepts/storage/volumes#hostpath",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"paths", "quotas"},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_InitFuseSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InitFuseSpec is a description of initialize the fuse kernel module for runtime",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"version": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The version information that instructs fluid to orchestrate a particular version of Alifuse",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.VersionSpec"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.VersionSpec"},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_InitUsersSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "InitUsersSpec is a description of the initialize the users for runtime",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"image": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Image for initialize the users for runtime(e.g. alluxio/alluxio-User init)",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 					"imageTag": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The image tag of Vineyard component. For Master, the default image tag is `v0.22.2`. For Worker, the default image tag is `v0.22.2`.",
+							Description: "Image Tag for initialize the users for runtime(e.g. 2.3.0-SNAPSHOT)",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 					"imagePullPolicy": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The image pull policy of Vineyard component. Default is `IfNotPresent`.",
+							Description: "One of the three policies: `Always`, `IfNotPresent`, `Never`",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"nodeSelector": {
+					"env": {
 						SchemaProps: spec.SchemaProps{
-							Description: "NodeSelector is a selector to choose which nodes to launch the Vineyard component. E,g. {\"disktype\": \"ssd\"}",
+							Description: "Environment variables that will be used by initialize the users for runtime",
 							Type:        []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
@@ -5516,10 +6571,54 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_MasterSpec(ref common.Reference
 							},
 						},
 					},
-					"ports": {
+					"resources": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Ports used by Vineyard component. For Master, the default client port is 2379 and peer port is 2380. For Worker, the default rpc port is 9600 and the default exporter port is 9144.",
+							Description: "Resources that will be requested by initialize the users for runtime. <br> <br> Resources are not allowed for ephemeral containers. Ephemeral containers use spare resources already allocated to the pod.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/api/core/v1.ResourceRequirements"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.ResourceRequirements"},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_JindoCompTemplateSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "JindoCompTemplateSpec is a description of the Jindo commponents",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"replicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Replicas is the desired number of replicas of the given template. If unspecified, defaults to 1. replicas is the min replicas of dataset in the cluster",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"properties": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Configurable properties for the Jindo component. <br>",
 							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"ports": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
 								Schema: &spec.Schema{
@@ -5532,9 +6631,16 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_MasterSpec(ref common.Reference
 							},
 						},
 					},
+					"resources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Resources that will be requested by the Jindo component. <br> <br> Resources are not allowed for ephemeral containers. Ephemeral containers use spare resources already allocated to the pod.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/api/core/v1.ResourceRequirements"),
+						},
+					},
 					"env": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Environment variables that will be used by Vineyard component. For Master, refer to <a href=\"https://etcd.io/docs/v3.5/op-guide/configuration/\">Etcd Configuration</a> for more info Default is not set.",
+							Description: "Environment variables that will be used by Jindo component. <br>",
 							Type:        []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
@@ -5548,9 +6654,9 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_MasterSpec(ref common.Reference
 							},
 						},
 					},
-					"options": {
+					"nodeSelector": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Configurable options for Vineyard component. For Master, there is no configurable options. For Worker, support the following options.\n\n  vineyardd.reserve.memory: (Bool) where to reserve memory for vineyardd\n                            If set to true, the memory quota will be counted to the vineyardd rather than the application.\n  etcd.prefix: (String) the prefix of etcd key for vineyard objects\n  wait.etcd.timeout: (String) the timeout period before waiting the etcd to be ready, in seconds\n\n  Default value is as follows.\n\n    vineyardd.reserve.memory: \"true\"\n    etcd.prefix: \"/vineyard\"\n    wait.etcd.timeout: \"120\"",
+							Description: "NodeSelector is a selector which must be true for the master to fit on a node",
 							Type:        []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
@@ -5564,22 +6670,31 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_MasterSpec(ref common.Reference
 							},
 						},
 					},
-					"resources": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Resources contains the resource requirements and limits for the Vineyard component. Default is not set. For Worker, when the options contains vineyardd.reserve.memory=true, the resources.request.memory for worker should be greater than tieredstore.levels[0].quota(aka vineyardd shared memory)",
-							Default:     map[string]interface{}{},
-							Ref:         ref("k8s.io/api/core/v1.ResourceRequirements"),
-						},
-					},
-					"volumeMounts": {
+					"tolerations": {
 						SchemaProps: spec.SchemaProps{
-							Description: "VolumeMounts specifies the volumes listed in \".spec.volumes\" to mount into the vineyard runtime component's filesystem. It is useful for specifying a persistent storage. Default is not set.",
+							Description: "If specified, the pod's tolerations.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("k8s.io/api/core/v1.VolumeMount"),
+										Ref:     ref("k8s.io/api/core/v1.Toleration"),
+									},
+								},
+							},
+						},
+					},
+					"labels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Labels will be added on JindoFS Master or Worker pods. DEPRECATED: This is a deprecated field. Please use PodMetadata instead. Note: this field is set to be exclusive with PodMetadata.Labels",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
 									},
 								},
 							},
@@ -5587,124 +6702,1699 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_MasterSpec(ref common.Reference
 					},
 					"podMetadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "PodMetadata defines labels and annotations that will be propagated to Vineyard's pods including Master and Worker. Default is not set.",
+							Description: "PodMetadata defines labels and annotations that will be propagated to Jindo's pods",
 							Default:     map[string]interface{}{},
 							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata"),
 						},
 					},
-					"networkMode": {
+					"disabled": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Whether to use hostnetwork or not Default is HostNetwork",
-							Type:        []string{"string"},
+							Description: "If disable JindoFS master or worker",
+							Type:        []string{"boolean"},
 							Format:      "",
 						},
 					},
-					"endpoint": {
+					"volumeMounts": {
 						SchemaProps: spec.SchemaProps{
-							Description: "ExternalEndpoint defines the configurations for external etcd cluster Default is not set If set, the Vineyard Master component will not be deployed, which means the Vineyard Worker component will use an external Etcd cluster. E,g.\n  endpoint:\n    uri: \"etcd-svc.etcd-namespace.svc.cluster.local:2379\"\n    encryptOptions:\n      - name: access-key\n\t\t   valueFrom:\n          secretKeyRef:\n            name: etcd-secret\n\t\t\t   key: accesskey",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.ExternalEndpointSpec"),
+							Description: "VolumeMounts specifies the volumes listed in \".spec.volumes\" to mount into the jindo runtime component's filesystem.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.VolumeMount"),
+									},
+								},
+							},
 						},
 					},
-				},
-			},
-		},
+					"imagePullSecrets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ImagePullSecrets that will be used to pull images",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.LocalObjectReference"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 		Dependencies: []string{
-			"github.com/fluid-cloudnative/fluid/api/v1alpha1.ExternalEndpointSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.VolumeMount"},
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.Toleration", "k8s.io/api/core/v1.VolumeMount"},
 	}
 }
 
-func schema_fluid_cloudnative_fluid_api_v1alpha1_Metadata(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_fluid_cloudnative_fluid_api_v1alpha1_JindoFuseSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Metadata defines subgroup properties of metav1.ObjectMeta",
+				Description: "JindoFuseSpec is a description of the Jindo Fuse",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
+					"image": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Image for Jindo Fuse(e.g. jindo/jindo-fuse)",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"imageTag": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Image Tag for Jindo Fuse(e.g. 2.3.0-SNAPSHOT)",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"imagePullPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "One of the three policies: `Always`, `IfNotPresent`, `Never`",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"imagePullSecrets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ImagePullSecrets that will be used to pull images",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.LocalObjectReference"),
+									},
+								},
+							},
+						},
+					},
+					"properties": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Configurable properties for Jindo System. <br>",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"env": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Environment variables that will be used by Jindo Fuse",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"resources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Resources that will be requested by Jindo Fuse. <br> <br> Resources are not allowed for ephemeral containers. Ephemeral containers use spare resources already allocated to the pod.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/api/core/v1.ResourceRequirements"),
+						},
+					},
+					"args": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Arguments that will be passed to Jindo Fuse",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"nodeSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeSelector is a selector which must be true for the fuse client to fit on a node, this option only effect when global is enabled",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"tolerations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "If specified, the pod's tolerations.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.Toleration"),
+									},
+								},
+							},
+						},
+					},
 					"labels": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Labels are labels of pod specification",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "Labels will be added on all the JindoFS pods. DEPRECATED: this is a deprecated field. Please use PodMetadata.Labels instead. Note: this field is set to be exclusive with PodMetadata.Labels",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"podMetadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PodMetadata defines labels and annotations that will be propagated to Jindo's fuse pods",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata"),
+						},
+					},
+					"cleanPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CleanPolicy decides when to clean JindoFS Fuse pods. Currently Fluid supports two policies: OnDemand and OnRuntimeDeleted OnDemand cleans fuse pod once th fuse pod on some node is not needed OnRuntimeDeleted cleans fuse pod only when the cache runtime is deleted Defaults to OnRuntimeDeleted",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"disabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "If disable JindoFS fuse",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"logConfig": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"metrics": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Define whether fuse metrics will be enabled.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.ClientMetrics"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.ClientMetrics", "github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.Toleration"},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_JindoRuntime(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "JindoRuntime is the Schema for the jindoruntimes API",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.JindoRuntimeSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.JindoRuntimeSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_JindoRuntimeList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "JindoRuntimeList contains a list of JindoRuntime",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.JindoRuntime"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.JindoRuntime", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_JindoRuntimeSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "JindoRuntimeSpec defines the desired state of JindoRuntime",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"jindoVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The version information that instructs fluid to orchestrate a particular version of Jindo.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.VersionSpec"),
+						},
+					},
+					"master": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The component spec of Jindo master",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.JindoCompTemplateSpec"),
+						},
+					},
+					"worker": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The component spec of Jindo worker",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.JindoCompTemplateSpec"),
+						},
+					},
+					"fuse": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The component spec of Jindo Fuse",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.JindoFuseSpec"),
+						},
+					},
+					"properties": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Configurable properties for Jindo system. <br>",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"tieredstore": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Tiered storage used by Jindo",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.TieredStore"),
+						},
+					},
+					"replicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The replicas of the worker, need to be specified",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"runAs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Manage the user to run Jindo Runtime",
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.User"),
+						},
+					},
+					"user": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"hadoopConfig": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the configMap used to support HDFS configurations when using HDFS as Jindo's UFS. The configMap must be in the same namespace with the JindoRuntime. The configMap should contain user-specific HDFS conf files in it. For now, only \"hdfs-site.xml\" and \"core-site.xml\" are supported. It must take the filename of the conf file as the key and content of the file as the value.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"secret": {
+						SchemaProps: spec.SchemaProps{
+							Type:   []string{"string"},
+							Format: "",
+						},
+					},
+					"labels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Labels will be added on all the JindoFS pods. DEPRECATED: this is a deprecated field. Please use PodMetadata.Labels instead. Note: this field is set to be exclusive with PodMetadata.Labels",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"podMetadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PodMetadata defines labels and annotations that will be propagated to all Jindo's fuse pods",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata"),
+						},
+					},
+					"logConfig": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"networkmode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Whether to use hostnetwork or not",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"cleanCachePolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CleanCachePolicy defines cleanCache Policy",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.CleanCachePolicy"),
+						},
+					},
+					"volumes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Volumes is the list of Kubernetes volumes that can be mounted by the jindo runtime components and/or fuses.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.Volume"),
+									},
+								},
+							},
+						},
+					},
+					"imagePullSecrets": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ImagePullSecrets that will be used to pull images",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.LocalObjectReference"),
+									},
+								},
+							},
+						},
+					},
+					"dataOperationPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DataOperationPolicy defines the policy of running the data operations on the runtime",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DataOperationPolicy"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.CleanCachePolicy", "github.com/fluid-cloudnative/fluid/api/v1alpha1.DataOperationPolicy", "github.com/fluid-cloudnative/fluid/api/v1alpha1.JindoCompTemplateSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.JindoFuseSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata", "github.com/fluid-cloudnative/fluid/api/v1alpha1.TieredStore", "github.com/fluid-cloudnative/fluid/api/v1alpha1.User", "github.com/fluid-cloudnative/fluid/api/v1alpha1.VersionSpec", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/api/core/v1.Volume"},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_JobProcessor(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"podSpec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PodSpec defines Pod specification of the DataProcess job.",
+							Ref:         ref("k8s.io/api/core/v1.PodSpec"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/api/core/v1.PodSpec"},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_JuiceFSCompTemplateSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "JuiceFSCompTemplateSpec is a description of the JuiceFS components",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"replicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Replicas is the desired number of replicas of the given template. If unspecified, defaults to 1. replicas is the min replicas of dataset in the cluster",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"ports": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Ports used by JuiceFS",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.ContainerPort"),
+									},
+								},
+							},
+						},
+					},
+					"resources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Resources that will be requested by the JuiceFS component.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/api/core/v1.ResourceRequirements"),
+						},
+					},
+					"options": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Options",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"env": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Environment variables that will be used by JuiceFS component.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.EnvVar"),
+									},
+								},
+							},
+						},
+					},
+					"enabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Enabled or Disabled for the components.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"nodeSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeSelector is a selector",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"volumeMounts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VolumeMounts specifies the volumes listed in \".spec.volumes\" to mount into runtime component's filesystem.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.VolumeMount"),
+									},
+								},
+							},
+						},
+					},
+					"podMetadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PodMetadata defines labels and annotations that will be propagated to JuiceFs's pods.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata"),
+						},
+					},
+					"networkMode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Whether to use hostnetwork or not",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata", "k8s.io/api/core/v1.ContainerPort", "k8s.io/api/core/v1.EnvVar", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.VolumeMount"},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_JuiceFSFuseSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"image": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Image for JuiceFS fuse",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"imageTag": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Image for JuiceFS fuse",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"imagePullPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "One of the three policies: `Always`, `IfNotPresent`, `Never`",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"env": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Environment variables that will be used by JuiceFS Fuse",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.EnvVar"),
+									},
+								},
+							},
+						},
+					},
+					"resources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Resources that will be requested by JuiceFS Fuse.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/api/core/v1.ResourceRequirements"),
+						},
+					},
+					"options": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Options mount options that fuse pod will use",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"nodeSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeSelector is a selector which must be true for the fuse client to fit on a node, this option only effect when global is enabled",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"volumeMounts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VolumeMounts specifies the volumes listed in \".spec.volumes\" to mount into runtime component's filesystem.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.VolumeMount"),
+									},
+								},
+							},
+						},
+					},
+					"cleanPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CleanPolicy decides when to clean Juicefs Fuse pods. Currently Fluid supports three policies: OnDemand, OnRuntimeDeleted and OnFuseChangedCleanPolicy OnDemand cleans fuse pod once the fuse pod on some node is not needed OnRuntimeDeleted cleans fuse pod only when the cache runtime is deleted OnFuseChangedCleanPolicy cleans fuse pod once the fuse pod on some node is not needed and the fuse in runtime is updated Defaults to OnRuntimeDeleted",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"podMetadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PodMetadata defines labels and annotations that will be propagated to JuiceFs's pods.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata"),
+						},
+					},
+					"networkMode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Whether to use hostnetwork or not",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata", "k8s.io/api/core/v1.EnvVar", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.VolumeMount"},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_JuiceFSRuntime(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "JuiceFSRuntime is the Schema for the juicefsruntimes API",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.JuiceFSRuntimeSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeStatus"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.JuiceFSRuntimeSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_JuiceFSRuntimeList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "JuiceFSRuntimeList contains a list of JuiceFSRuntime",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.JuiceFSRuntime"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.JuiceFSRuntime", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_JuiceFSRuntimeSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "JuiceFSRuntimeSpec defines the desired state of JuiceFSRuntime",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"juicefsVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The version information that instructs fluid to orchestrate a particular version of JuiceFS.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.VersionSpec"),
+						},
+					},
+					"initUsers": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The spec of init users",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.InitUsersSpec"),
+						},
+					},
+					"master": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The component spec of JuiceFS master",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.JuiceFSCompTemplateSpec"),
+						},
+					},
+					"worker": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The component spec of JuiceFS worker",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.JuiceFSCompTemplateSpec"),
+						},
+					},
+					"jobWorker": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The component spec of JuiceFS job Worker",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.JuiceFSCompTemplateSpec"),
+						},
+					},
+					"fuse": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Desired state for JuiceFS Fuse",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.JuiceFSFuseSpec"),
+						},
+					},
+					"tieredstore": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Tiered storage used by JuiceFS",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.TieredStore"),
+						},
+					},
+					"configs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Configs of JuiceFS",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"replicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The replicas of the worker, need to be specified",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"runAs": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Manage the user to run Juicefs Runtime",
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.User"),
+						},
+					},
+					"disablePrometheus": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Disable monitoring for JuiceFS Runtime Prometheus is enabled by default",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"volumes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Volumes is the list of Kubernetes volumes that can be mounted by the JuiceFS runtime components and/or fuses.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.Volume"),
+									},
+								},
+							},
+						},
+					},
+					"volumeClaimTemplates": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "VolumeClaimTemplates is the list of Kubernetes persistent volume claim templates used by the JuiceFS worker StatefulSet.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.PersistentVolumeClaim"),
+									},
+								},
+							},
+						},
+					},
+					"podMetadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PodMetadata defines labels and annotations that will be propagated to JuiceFs's pods.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata"),
+						},
+					},
+					"management": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RuntimeManagement defines policies when managing the runtime",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeManagement"),
+						},
+					},
+					"dataOperationPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DataOperationPolicy defines the policy of running the data operations on the runtime",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DataOperationPolicy"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataOperationPolicy", "github.com/fluid-cloudnative/fluid/api/v1alpha1.InitUsersSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.JuiceFSCompTemplateSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.JuiceFSFuseSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata", "github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeManagement", "github.com/fluid-cloudnative/fluid/api/v1alpha1.TieredStore", "github.com/fluid-cloudnative/fluid/api/v1alpha1.User", "github.com/fluid-cloudnative/fluid/api/v1alpha1.VersionSpec", "k8s.io/api/core/v1.PersistentVolumeClaim", "k8s.io/api/core/v1.Volume"},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_Level(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Level describes configurations a tier needs. <br> Refer to <a href=\"https://docs.alluxio.io/os/user/stable/en/core-services/Caching.html#configuring-tiered-storage\">Configuring Tiered Storage</a> for more info",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"mediumtype": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Medium Type of the tier. One of the three types: `MEM`, `SSD`, `HDD`",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"volumeType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VolumeType is the volume type of the tier. Should be one of the three types: `hostPath`, `emptyDir` and `volumeTemplate`. If not set, defaults to hostPath.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"volumeSource": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VolumeSource is the volume source of the tier. It follows the form of corev1.VolumeSource. For now, users should only specify VolumeSource when VolumeType is set to emptyDir.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.VolumeSource"),
+						},
+					},
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "File paths to be used for the tier. Multiple paths are supported. Multiple paths should be separated with comma. For example: \"/mnt/cache1,/mnt/cache2\".",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"quota": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Quota for the whole tier. (e.g. 100Gi) Please note that if there're multiple paths used for this tierstore, the quota will be equally divided into these paths. If you'd like to set quota for each, path, see QuotaList for more information.",
+							Ref:         ref("k8s.io/apimachinery/pkg/api/resource.Quantity"),
+						},
+					},
+					"quotaList": {
+						SchemaProps: spec.SchemaProps{
+							Description: "QuotaList are quotas used to set quota on multiple paths. Quotas should be separated with comma. Quotas in this list will be set to paths with the same order in Path. For example, with Path defined with \"/mnt/cache1,/mnt/cache2\" and QuotaList set to \"100Gi, 50Gi\", then we get 100GiB cache storage under \"/mnt/cache1\" and 50GiB under \"/mnt/cache2\". Also note that num of quotas must be consistent with the num of paths defined in Path.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"high": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Ratio of high watermark of the tier (e.g. 0.9)",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"low": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Ratio of low watermark of the tier (e.g. 0.7)",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"mediumtype"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.VolumeSource", "k8s.io/apimachinery/pkg/api/resource.Quantity"},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_MasterSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MasterSpec defines the configurations for Vineyard Master component which is also regarded as the Etcd component in Vineyard. For more info about Vineyard, refer to <a href=\"https://v6d.io/\">Vineyard</a>",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"replicas": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The replicas of Vineyard component. If not specified, defaults to 1. For worker, the replicas should not be greater than the number of nodes in the cluster",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"image": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The image of Vineyard component. For Master, the default image is `registry.aliyuncs.com/vineyard/vineyardd` For Worker, the default image is `registry.aliyuncs.com/vineyard/vineyardd` The default container registry is `docker.io`, you can change it by setting the image field",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"imageTag": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The image tag of Vineyard component. For Master, the default image tag is `v0.22.2`. For Worker, the default image tag is `v0.22.2`.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"imagePullPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The image pull policy of Vineyard component. Default is `IfNotPresent`.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"nodeSelector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeSelector is a selector to choose which nodes to launch the Vineyard component. E,g. {\"disktype\": \"ssd\"}",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"ports": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Ports used by Vineyard component. For Master, the default client port is 2379 and peer port is 2380. For Worker, the default rpc port is 9600 and the default exporter port is 9144.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: 0,
+										Type:    []string{"integer"},
+										Format:  "int32",
+									},
+								},
+							},
+						},
+					},
+					"env": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Environment variables that will be used by Vineyard component. For Master, refer to <a href=\"https://etcd.io/docs/v3.5/op-guide/configuration/\">Etcd Configuration</a> for more info Default is not set.",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"options": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Configurable options for Vineyard component. For Master, there is no configurable options. For Worker, support the following options.\n\n  vineyardd.reserve.memory: (Bool) where to reserve memory for vineyardd\n                            If set to true, the memory quota will be counted to the vineyardd rather than the application.\n  etcd.prefix: (String) the prefix of etcd key for vineyard objects\n  wait.etcd.timeout: (String) the timeout period before waiting the etcd to be ready, in seconds\n\n  Default value is as follows.\n\n    vineyardd.reserve.memory: \"true\"\n    etcd.prefix: \"/vineyard\"\n    wait.etcd.timeout: \"120\"",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"resources": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Resources contains the resource requirements and limits for the Vineyard component. Default is not set. For Worker, when the options contains vineyardd.reserve.memory=true, the resources.request.memory for worker should be greater than tieredstore.levels[0].quota(aka vineyardd shared memory)",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/api/core/v1.ResourceRequirements"),
+						},
+					},
+					"volumeMounts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "VolumeMounts specifies the volumes listed in \".spec.volumes\" to mount into the vineyard runtime component's filesystem. It is useful for specifying a persistent storage. Default is not set.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/api/core/v1.VolumeMount"),
+									},
+								},
+							},
+						},
+					},
+					"podMetadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "PodMetadata defines labels and annotations that will be propagated to Vineyard's pods including Master and Worker. Default is not set.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata"),
+						},
+					},
+					"networkMode": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Whether to use hostnetwork or not Default is HostNetwork",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"endpoint": {
+						SchemaProps: spec.SchemaProps{
+							Description: "ExternalEndpoint defines the configurations for external etcd cluster Default is not set If set, the Vineyard Master component will not be deployed, which means the Vineyard Worker component will use an external Etcd cluster. E,g.\n  endpoint:\n    uri: \"etcd-svc.etcd-namespace.svc.cluster.local:2379\"\n    encryptOptions:\n      - name: access-key\n\t\t   valueFrom:\n          secretKeyRef:\n            name: etcd-secret\n\t\t\t   key: accesskey",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.ExternalEndpointSpec"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.ExternalEndpointSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata", "k8s.io/api/core/v1.ResourceRequirements", "k8s.io/api/core/v1.VolumeMount"},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_Metadata(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Metadata defines subgroup properties of metav1.ObjectMeta",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"labels": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Labels are labels of pod specification",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"annotations": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Annotations are annotations of pod specification",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"selector": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.GroupKind"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.GroupKind"},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_MetadataSyncPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MetadataSyncPolicy defines policies when syncing metadata",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"autoSync": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AutoSync enables automatic metadata sync when setting up a runtime. If not set, it defaults to true.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_Mount(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Mount describes a mounting. <br> Refer to <a href=\"https://docs.alluxio.io/os/user/stable/en/ufs/S3.html\">Alluxio Storage Integrations</a> for more info",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"mountPoint": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MountPoint is the mount point of source.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"options": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The Mount Options. <br> Refer to <a href=\"https://docs.alluxio.io/os/user/stable/en/reference/Properties-List.html\">Mount Options</a>.  <br> The option has Prefix 'fs.' And you can Learn more from <a href=\"https://docs.alluxio.io/os/user/stable/en/ufs/S3.html\">The Storage Integrations</a>",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The name of mount",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The path of mount, if not set will be /{Name}",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"readOnly": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Optional: Defaults to false (read-write).",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"shared": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Optional: Defaults to false (shared).",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"encryptOptions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "The secret information",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.EncryptOption"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"mountPoint"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.EncryptOption"},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_MutatorServiceReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "MutatorServiceReference refers to a Service in the namespace of the PodMutationPolicy",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name of the Service",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"path": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Path is the URL path of the requests to the Service, default to \"/\"",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"port": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Port of the Service, default to 443",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+				Required: []string{"name"},
+			},
+		},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_OSAdvise(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OSAdvise is a description of choices to have optimization on specific operating system",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"osVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Specific operating system version that can have optimization.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"enabled": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Enable operating system optimization not enabled by default.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_ObjectRef(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "API version of the referent operation",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind specifies the type of the referent operation",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name specifies the name of the referent operation",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace specifies the namespace of the referent operation.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"kind", "name"},
+			},
+		},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_OperationAttempt(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OperationAttempt records an attempt to run a data operation",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"attempt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Attempt is the sequence number of the attempt, starting from 1",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phase is the phase of the attempt",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions consists of transition information on the attempt's Phase",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.Condition"),
 									},
 								},
 							},
 						},
 					},
-					"annotations": {
+					"exitCodes": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Annotations are annotations of pod specification",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "ExitCodes are the non-zero exit codes of the containers of the failed attempt",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: 0,
+										Type:    []string{"integer"},
+										Format:  "int32",
 									},
 								},
 							},
 						},
 					},
-					"selector": {
+					"startTime": {
 						SchemaProps: spec.SchemaProps{
-							Default: map[string]interface{}{},
-							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.GroupKind"),
+							Description: "StartTime is the time the attempt started",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"completionTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "CompletionTime is the time the attempt finished",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"duration": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Duration tells user how much time was spent on the attempt",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
+				Required: []string{"attempt", "phase", "startTime"},
 			},
 		},
 		Dependencies: []string{
-			"k8s.io/apimachinery/pkg/apis/meta/v1.GroupKind"},
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.Condition", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_fluid_cloudnative_fluid_api_v1alpha1_MetadataSyncPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_fluid_cloudnative_fluid_api_v1alpha1_OperationRef(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "MetadataSyncPolicy defines policies when syncing metadata",
-				Type:        []string{"object"},
+				Type: []string{"object"},
 				Properties: map[string]spec.Schema{
-					"autoSync": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "AutoSync enables automatic metadata sync when setting up a runtime. If not set, it defaults to true.",
-							Type:        []string{"boolean"},
+							Description: "API version of the referent operation",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind specifies the type of the referent operation",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Name specifies the name of the referent operation",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Namespace specifies the namespace of the referent operation.",
+							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"affinityStrategy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "AffinityStrategy specifies the pod affinity strategy with the referent operation.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.AffinityStrategy"),
+						},
+					},
 				},
+				Required: []string{"kind", "name"},
 			},
 		},
+		Dependencies: []string{
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.AffinityStrategy"},
 	}
 }
 
-func schema_fluid_cloudnative_fluid_api_v1alpha1_Mount(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_fluid_cloudnative_fluid_api_v1alpha1_OperationStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "Mount describes a mounting. <br> Refer to <a href=\"https://docs.alluxio.io/os/user/stable/en/ufs/S3.html\">Alluxio Storage Integrations</a> for more info",
+				Description: "OperationStatus defines the observed state of operation",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"mountPoint": {
+					"phase": {
 						SchemaProps: spec.SchemaProps{
-							Description: "MountPoint is the mount point of source.",
+							Description: "Phase describes current phase of operation",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"options": {
+					"duration": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The Mount Options. <br> Refer to <a href=\"https://docs.alluxio.io/os/user/stable/en/reference/Properties-List.html\">Mount Options</a>.  <br> The option has Prefix 'fs.' And you can Learn more from <a href=\"https://docs.alluxio.io/os/user/stable/en/ufs/S3.html\">The Storage Integrations</a>",
+							Description: "Duration tell user how much time was spent to operation",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"conditions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Conditions consists of transition information on operation's Phase",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.Condition"),
+									},
+								},
+							},
+						},
+					},
+					"infos": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Infos operation customized name-value",
 							Type:        []string{"object"},
 							AdditionalProperties: &spec.SchemaOrBool{
 								Allows: true,
@@ -5718,76 +8408,117 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_Mount(ref common.ReferenceCallb
 							},
 						},
 					},
-					"name": {
+					"lastScheduleTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The name of mount",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "LastScheduleTime is the last time the cron operation was scheduled",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"path": {
+					"lastSuccessfulTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The path of mount, if not set will be /{Name}",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "LastSuccessfulTime is the last time the cron operation successfully completed",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"readOnly": {
+					"waitingFor": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Optional: Defaults to false (read-write).",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "WaitingStatus stores information about waiting operation.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.WaitingStatus"),
 						},
 					},
-					"shared": {
+					"nodeAffinity": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Optional: Defaults to false (shared).",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "NodeAffinity records the node affinity for operation pods",
+							Ref:         ref("k8s.io/api/core/v1.NodeAffinity"),
 						},
 					},
-					"encryptOptions": {
+					"eventTrigger": {
 						SchemaProps: spec.SchemaProps{
-							Description: "The secret information",
+							Description: "EventTrigger records the observed events and the history of runs triggered by them, only used when policy is OnEvent",
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.EventTriggerStatus"),
+						},
+					},
+					"targetNodes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TargetNodes records whether the data is loaded to the worker on each target node, only used by the DataLoad with target nodes",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.EncryptOption"),
+										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.TargetNodeStatus"),
+									},
+								},
+							},
+						},
+					},
+					"attempts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Attempts records the attempts to run the operation, only used when the operation has a retry policy. The latest attempt is the last one",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.OperationAttempt"),
 									},
 								},
 							},
 						},
 					},
+					"nextRetryTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NextRetryTime is the time the failed attempt will be retried at",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
 				},
-				Required: []string{"mountPoint"},
+				Required: []string{"phase", "duration", "conditions"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/fluid-cloudnative/fluid/api/v1alpha1.EncryptOption"},
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.Condition", "github.com/fluid-cloudnative/fluid/api/v1alpha1.EventTriggerStatus", "github.com/fluid-cloudnative/fluid/api/v1alpha1.OperationAttempt", "github.com/fluid-cloudnative/fluid/api/v1alpha1.TargetNodeStatus", "github.com/fluid-cloudnative/fluid/api/v1alpha1.WaitingStatus", "k8s.io/api/core/v1.NodeAffinity", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
-func schema_fluid_cloudnative_fluid_api_v1alpha1_OSAdvise(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_fluid_cloudnative_fluid_api_v1alpha1_PodMetadata(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "OSAdvise is a description of choices to have optimization on specific operating system",
+				Description: "PodMetadata defines subgroup properties of metav1.ObjectMeta",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"osVersion": {
+					"labels": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Specific operating system version that can have optimization.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "Labels are labels of pod specification",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"enabled": {
+					"annotations": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Enable operating system optimization not enabled by default.",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "Annotations are annotations of pod specification",
+							Type:        []string{"object"},
+							AdditionalProperties: &spec.SchemaOrBool{
+								Allows: true,
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
 				},
@@ -5796,222 +8527,161 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_OSAdvise(ref common.ReferenceCa
 	}
 }
 
-func schema_fluid_cloudnative_fluid_api_v1alpha1_ObjectRef(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_fluid_cloudnative_fluid_api_v1alpha1_PodMutationPlugin(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "PodMutationPlugin is a built-in mutating plugin of the Fluid webhook",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"apiVersion": {
-						SchemaProps: spec.SchemaProps{
-							Description: "API version of the referent operation",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
-					"kind": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Kind specifies the type of the referent operation",
-							Default:     "",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
 					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name specifies the name of the referent operation",
+							Description: "Name of the built-in plugin, e.g. FilePrefetcher",
 							Default:     "",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"namespace": {
+					"args": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Namespace specifies the namespace of the referent operation.",
+							Description: "Args are the arguments (yaml format) passed to the plugin at the time of initialization",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"kind", "name"},
+				Required: []string{"name"},
 			},
 		},
 	}
 }
 
-func schema_fluid_cloudnative_fluid_api_v1alpha1_OperationRef(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_fluid_cloudnative_fluid_api_v1alpha1_PodMutationPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "PodMutationPolicy is the Schema for the podmutationpolicies API",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"apiVersion": {
-						SchemaProps: spec.SchemaProps{
-							Description: "API version of the referent operation",
-							Type:        []string{"string"},
-							Format:      "",
-						},
-					},
 					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Kind specifies the type of the referent operation",
-							Default:     "",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"name": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Name specifies the name of the referent operation",
-							Default:     "",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"namespace": {
-						SchemaProps: spec.SchemaProps{
-							Description: "Namespace specifies the namespace of the referent operation.",
-							Type:        []string{"string"},
-							Format:      "",
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
 						},
 					},
-					"affinityStrategy": {
+					"spec": {
 						SchemaProps: spec.SchemaProps{
-							Description: "AffinityStrategy specifies the pod affinity strategy with the referent operation.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.AffinityStrategy"),
+							Default: map[string]interface{}{},
+							Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMutationPolicySpec"),
 						},
 					},
 				},
-				Required: []string{"kind", "name"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/fluid-cloudnative/fluid/api/v1alpha1.AffinityStrategy"},
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMutationPolicySpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
 	}
 }
 
-func schema_fluid_cloudnative_fluid_api_v1alpha1_OperationStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_fluid_cloudnative_fluid_api_v1alpha1_PodMutationPolicyList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "OperationStatus defines the observed state of operation",
+				Description: "PodMutationPolicyList contains a list of PodMutationPolicy",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"phase": {
+					"kind": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Phase describes current phase of operation",
-							Default:     "",
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"duration": {
+					"apiVersion": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Duration tell user how much time was spent to operation",
-							Default:     "",
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"conditions": {
+					"metadata": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Conditions consists of transition information on operation's Phase",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.Condition"),
-									},
-								},
-							},
+							Default: map[string]interface{}{},
+							Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
 						},
 					},
-					"infos": {
+					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Infos operation customized name-value",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Type: []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMutationPolicy"),
 									},
 								},
 							},
 						},
 					},
-					"lastScheduleTime": {
-						SchemaProps: spec.SchemaProps{
-							Description: "LastScheduleTime is the last time the cron operation was scheduled",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
-						},
-					},
-					"lastSuccessfulTime": {
-						SchemaProps: spec.SchemaProps{
-							Description: "LastSuccessfulTime is the last time the cron operation successfully completed",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
-						},
-					},
-					"waitingFor": {
-						SchemaProps: spec.SchemaProps{
-							Description: "WaitingStatus stores information about waiting operation.",
-							Default:     map[string]interface{}{},
-							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.WaitingStatus"),
-						},
-					},
-					"nodeAffinity": {
-						SchemaProps: spec.SchemaProps{
-							Description: "NodeAffinity records the node affinity for operation pods",
-							Ref:         ref("k8s.io/api/core/v1.NodeAffinity"),
-						},
-					},
 				},
-				Required: []string{"phase", "duration", "conditions"},
+				Required: []string{"items"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/fluid-cloudnative/fluid/api/v1alpha1.Condition", "github.com/fluid-cloudnative/fluid/api/v1alpha1.WaitingStatus", "k8s.io/api/core/v1.NodeAffinity", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMutationPolicy", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_fluid_cloudnative_fluid_api_v1alpha1_PodMetadata(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_fluid_cloudnative_fluid_api_v1alpha1_PodMutationPolicySpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "PodMetadata defines subgroup properties of metav1.ObjectMeta",
+				Description: "PodMutationPolicySpec defines the desired state of PodMutationPolicy",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
-					"labels": {
+					"podSelector": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Labels are labels of pod specification",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "PodSelector selects the pods in the same namespace that the policy applies to. All the pods mutated by the Fluid webhook in the namespace are selected if it's not set.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+					"plugins": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Plugins are the built-in plugins called in order after the plugins enabled in the webhook's plugins profile. The plugins already enabled in the profile for the pod are skipped.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMutationPlugin"),
 									},
 								},
 							},
 						},
 					},
-					"annotations": {
+					"externalMutators": {
 						SchemaProps: spec.SchemaProps{
-							Description: "Annotations are annotations of pod specification",
-							Type:        []string{"object"},
-							AdditionalProperties: &spec.SchemaOrBool{
-								Allows: true,
+							Description: "ExternalMutators are the external HTTP mutators called in order after the plugins",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
-										Default: "",
-										Type:    []string{"string"},
-										Format:  "",
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.ExternalMutator"),
 									},
 								},
 							},
@@ -6020,6 +8690,8 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_PodMetadata(ref common.Referenc
 				},
 			},
 		},
+		Dependencies: []string{
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.ExternalMutator", "github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMutationPlugin", "k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
 	}
 }
 
@@ -6135,6 +8807,100 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_Require(ref common.ReferenceCal
 	}
 }
 
+func schema_fluid_cloudnative_fluid_api_v1alpha1_RetryBackoff(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RetryBackoff defines the exponential backoff between the attempts of a data operation",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"initialDelaySeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "InitialDelaySeconds is the delay before the first retry",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"factor": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Factor multiplies the delay for each following retry",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"maxDelaySeconds": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxDelaySeconds is the upper limit of the delay",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_fluid_cloudnative_fluid_api_v1alpha1_RetryPolicy(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RetryPolicy defines how a failed data operation is retried, only used when policy is Once",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"maxAttempts": {
+						SchemaProps: spec.SchemaProps{
+							Description: "MaxAttempts is the maximum number of attempts to run the data operation, including the first one",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"backoff": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Backoff defines the delay before retrying a failed attempt",
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.RetryBackoff"),
+						},
+					},
+					"retryOnExitCodes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RetryOnExitCodes retries a failed attempt only if a container of the attempt exited with one of the exit codes. A failed attempt is retried if it matches either retryOnExitCodes or retryOnReasons, and all the failed attempts are retried if neither of them is set.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: 0,
+										Type:    []string{"integer"},
+										Format:  "int32",
+									},
+								},
+							},
+						},
+					},
+					"retryOnReasons": {
+						SchemaProps: spec.SchemaProps{
+							Description: "RetryOnReasons retries a failed attempt only if the reason of the failed job condition (e.g. `BackoffLimitExceeded`, `DeadlineExceeded`) or the reason of a terminated container (e.g. `Error`, `OOMKilled`) is one of the reasons.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"maxAttempts"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.RetryBackoff"},
+	}
+}
+
 func schema_fluid_cloudnative_fluid_api_v1alpha1_Runtime(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -6637,6 +9403,56 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_RuntimeExtraResources(ref commo
 	}
 }
 
+func schema_fluid_cloudnative_fluid_api_v1alpha1_RuntimeFuseUpgradeStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "RuntimeFuseUpgradeStatus is the progress of the rolling upgrade of the fuse pods",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"paused": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Paused means no more nodes are drained or upgraded",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"upgradedNodes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "UpgradedNodes is the number of the nodes running the up-to-date fuse pod",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"totalNodes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "TotalNodes is the number of the nodes running the fuse pod",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"nodes": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Nodes are the progress of the nodes whose fuse pods are not upgraded yet",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.FuseNodeUpgradeStatus"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.FuseNodeUpgradeStatus"},
+	}
+}
+
 func schema_fluid_cloudnative_fluid_api_v1alpha1_RuntimeManagement(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -6908,12 +9724,18 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_RuntimeStatus(ref common.Refere
 							Ref:         ref("k8s.io/api/core/v1.NodeAffinity"),
 						},
 					},
+					"fuseUpgrade": {
+						SchemaProps: spec.SchemaProps{
+							Description: "FuseUpgrade represents the progress of the rolling upgrade of the fuse pods driven by a FuseUpgrade",
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeFuseUpgradeStatus"),
+						},
+					},
 				},
 				Required: []string{"valueFile", "masterPhase", "workerPhase", "desiredWorkerNumberScheduled", "currentWorkerNumberScheduled", "workerNumberReady", "desiredMasterNumberScheduled", "currentMasterNumberScheduled", "masterNumberReady", "fusePhase", "currentFuseNumberScheduled", "desiredFuseNumberScheduled", "fuseNumberReady"},
 			},
 		},
 		Dependencies: []string{
-			"github.com/fluid-cloudnative/fluid/api/v1alpha1.APIGatewayStatus", "github.com/fluid-cloudnative/fluid/api/v1alpha1.Mount", "github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeCondition", "k8s.io/api/core/v1.NodeAffinity", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.APIGatewayStatus", "github.com/fluid-cloudnative/fluid/api/v1alpha1.Mount", "github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeCondition", "github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeFuseUpgradeStatus", "k8s.io/api/core/v1.NodeAffinity", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -7262,6 +10084,36 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_TargetDatasetWithMountPath(ref
 	}
 }
 
+func schema_fluid_cloudnative_fluid_api_v1alpha1_TargetNodeStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "TargetNodeStatus is the phase of loading the data to a target node",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"nodeName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "NodeName is the name of the target node",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"phase": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Phase of loading the data to the node",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"nodeName", "phase"},
+			},
+		},
+	}
+}
+
 func schema_fluid_cloudnative_fluid_api_v1alpha1_TargetPath(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -8004,11 +10856,18 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_ThinRuntimeSpec(ref common.Refe
 							},
 						},
 					},
+					"dataOperationPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DataOperationPolicy defines the policy of running the data operations on the runtime",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DataOperationPolicy"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeManagement", "github.com/fluid-cloudnative/fluid/api/v1alpha1.ThinCompTemplateSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.ThinFuseSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.TieredStore", "github.com/fluid-cloudnative/fluid/api/v1alpha1.User", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/api/core/v1.Volume"},
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataOperationPolicy", "github.com/fluid-cloudnative/fluid/api/v1alpha1.RuntimeManagement", "github.com/fluid-cloudnative/fluid/api/v1alpha1.ThinCompTemplateSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.ThinFuseSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.TieredStore", "github.com/fluid-cloudnative/fluid/api/v1alpha1.User", "k8s.io/api/core/v1.LocalObjectReference", "k8s.io/api/core/v1.Volume"},
 	}
 }
 
@@ -8523,11 +11382,18 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_VineyardRuntimeSpec(ref common.
 							},
 						},
 					},
+					"dataOperationPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "DataOperationPolicy defines the policy of running the data operations on the runtime",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/fluid-cloudnative/fluid/api/v1alpha1.DataOperationPolicy"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/fluid-cloudnative/fluid/api/v1alpha1.MasterSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata", "github.com/fluid-cloudnative/fluid/api/v1alpha1.TieredStore", "github.com/fluid-cloudnative/fluid/api/v1alpha1.VineyardClientSocketSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.VineyardCompTemplateSpec", "k8s.io/api/core/v1.Volume"},
+			"github.com/fluid-cloudnative/fluid/api/v1alpha1.DataOperationPolicy", "github.com/fluid-cloudnative/fluid/api/v1alpha1.MasterSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.PodMetadata", "github.com/fluid-cloudnative/fluid/api/v1alpha1.TieredStore", "github.com/fluid-cloudnative/fluid/api/v1alpha1.VineyardClientSocketSpec", "github.com/fluid-cloudnative/fluid/api/v1alpha1.VineyardCompTemplateSpec", "k8s.io/api/core/v1.Volume"},
 	}
 }
 
@@ -8733,6 +11599,13 @@ func schema_fluid_cloudnative_fluid_api_v1alpha1_WaitingStatus(ref common.Refere
 							Format:      "",
 						},
 					},
+					"queuePosition": {
+						SchemaProps: spec.SchemaProps{
+							Description: "QueuePosition is the position of the operation in the operation queue of the runtime, starting from 1. It's only set when the operation waits for the runtime to run fewer data operations",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
 				},
 			},
 		},
@@ -0,0 +1,136 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MutatorFailurePolicy specifies how an unrecognized error from an external mutator is handled.
+// +kubebuilder:validation:Enum=Ignore;Fail
+type MutatorFailurePolicy string
+
+const (
+	// MutatorFailurePolicyIgnore ignores the error and keeps the pod unchanged by the external mutator
+	MutatorFailurePolicyIgnore MutatorFailurePolicy = "Ignore"
+
+	// MutatorFailurePolicyFail fails the mutation of the pod
+	MutatorFailurePolicyFail MutatorFailurePolicy = "Fail"
+)
+
+// PodMutationPlugin is a built-in mutating plugin of the Fluid webhook
+type PodMutationPlugin struct {
+	// Name of the built-in plugin, e.g. FilePrefetcher
+	// +required
+	Name string `json:"name"`
+
+	// Args are the arguments (yaml format) passed to the plugin at the time of initialization
+	// +optional
+	Args string `json:"args,omitempty"`
+}
+
+// ExternalMutator is an HTTPS service which mutates pods. The Fluid webhook posts a JSON object
+// with the pod in the "pod" field and expects a JSON patch of the pod in the "patch" field of the response.
+type ExternalMutator struct {
+	// Name of the external mutator
+	// +required
+	Name string `json:"name"`
+
+	// Service refers to the Service of the external mutator in the namespace of the PodMutationPolicy,
+	// which is called with https at <name>.<namespace>.svc
+	// +required
+	Service MutatorServiceReference `json:"service"`
+
+	// CABundle is a PEM encoded CA bundle used to verify the serving certificate of the Service.
+	// The system trust roots are used if it's not set.
+	// +optional
+	CABundle []byte `json:"caBundle,omitempty"`
+
+	// TimeoutSeconds is the timeout of a call to the external mutator, default to 10 seconds
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=30
+	// +optional
+	TimeoutSeconds *int32 `json:"timeoutSeconds,omitempty"`
+
+	// FailurePolicy defines how an error from the external mutator is handled, default to Fail
+	// +kubebuilder:default=Fail
+	// +optional
+	FailurePolicy MutatorFailurePolicy `json:"failurePolicy,omitempty"`
+}
+
+// MutatorServiceReference refers to a Service in the namespace of the PodMutationPolicy
+type MutatorServiceReference struct {
+	// Name of the Service
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +required
+	Name string `json:"name"`
+
+	// Path is the URL path of the requests to the Service, default to "/"
+	// +kubebuilder:validation:Pattern=`^/`
+	// +optional
+	Path string `json:"path,omitempty"`
+
+	// Port of the Service, default to 443
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +optional
+	Port *int32 `json:"port,omitempty"`
+}
+
+// PodMutationPolicySpec defines the desired state of PodMutationPolicy
+type PodMutationPolicySpec struct {
+	// PodSelector selects the pods in the same namespace that the policy applies to.
+	// All the pods mutated by the Fluid webhook in the namespace are selected if it's not set.
+	// +optional
+	PodSelector *metav1.LabelSelector `json:"podSelector,omitempty"`
+
+	// Plugins are the built-in plugins called in order after the plugins enabled in the webhook's plugins profile.
+	// The plugins already enabled in the profile for the pod are skipped.
+	// +optional
+	Plugins []PodMutationPlugin `json:"plugins,omitempty"`
+
+	// ExternalMutators are the external HTTP mutators called in order after the plugins
+	// +optional
+	ExternalMutators []ExternalMutator `json:"externalMutators,omitempty"`
+}
+
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Namespaced
+// +kubebuilder:resource:categories={fluid},shortName=pmp
+
+// PodMutationPolicy is the Schema for the podmutationpolicies API
+type PodMutationPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec PodMutationPolicySpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Namespaced
+
+// PodMutationPolicyList contains a list of PodMutationPolicy
+type PodMutationPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []PodMutationPolicy `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&PodMutationPolicy{}, &PodMutationPolicyList{})
+}
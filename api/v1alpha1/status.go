@@ -132,6 +132,60 @@ type RuntimeStatus struct {
 
 	// CacheAffinity represents the runtime worker pods node affinity including node selector
 	CacheAffinity *corev1.NodeAffinity `json:"cacheAffinity,omitempty"`
+
+	// FuseUpgrade represents the progress of the rolling upgrade of the fuse pods driven by a FuseUpgrade
+	// +optional
+	FuseUpgrade *RuntimeFuseUpgradeStatus `json:"fuseUpgrade,omitempty"`
+}
+
+// RuntimeFuseUpgradeStatus is the progress of the rolling upgrade of the fuse pods
+type RuntimeFuseUpgradeStatus struct {
+	// Paused means no more nodes are drained or upgraded
+	// +optional
+	Paused bool `json:"paused,omitempty"`
+
+	// UpgradedNodes is the number of the nodes running the up-to-date fuse pod
+	// +optional
+	UpgradedNodes int32 `json:"upgradedNodes,omitempty"`
+
+	// TotalNodes is the number of the nodes running the fuse pod
+	// +optional
+	TotalNodes int32 `json:"totalNodes,omitempty"`
+
+	// Nodes are the progress of the nodes whose fuse pods are not upgraded yet
+	// +optional
+	Nodes []FuseNodeUpgradeStatus `json:"nodes,omitempty"`
+}
+
+// FuseNodeUpgradePhase is the phase of the fuse upgrade on a node
+type FuseNodeUpgradePhase string
+
+const (
+	// FuseNodeUpgradePending means the fuse pod on the node is outdated and waits for its turn
+	FuseNodeUpgradePending FuseNodeUpgradePhase = "Pending"
+
+	// FuseNodeUpgradeDraining means the node is labeled to drain the pods using the outdated fuse pod
+	FuseNodeUpgradeDraining FuseNodeUpgradePhase = "Draining"
+
+	// FuseNodeUpgradeUpgrading means the outdated fuse pod is deleted and the up-to-date one is not ready yet
+	FuseNodeUpgradeUpgrading FuseNodeUpgradePhase = "Upgrading"
+)
+
+// FuseNodeUpgradeStatus is the progress of the fuse upgrade on a node
+type FuseNodeUpgradeStatus struct {
+	// NodeName is the name of the node
+	NodeName string `json:"nodeName"`
+
+	// Phase of the fuse upgrade on the node
+	Phase FuseNodeUpgradePhase `json:"phase"`
+
+	// MountingPods is the number of the pods on the node mounting the Dataset
+	// +optional
+	MountingPods int32 `json:"mountingPods,omitempty"`
+
+	// LastTransitionTime is the last time the phase changed
+	// +optional
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
 }
 
 // OperationStatus defines the observed state of operation
@@ -155,6 +209,74 @@ type OperationStatus struct {
 
 	// NodeAffinity records the node affinity for operation pods
 	NodeAffinity *corev1.NodeAffinity `json:"nodeAffinity,omitempty"`
+
+	// EventTrigger records the observed events and the history of runs triggered by them, only used when policy is OnEvent
+	EventTrigger *EventTriggerStatus `json:"eventTrigger,omitempty"`
+
+	// TargetNodes records whether the data is loaded to the worker on each target node, only used by the DataLoad with target nodes
+	// +optional
+	TargetNodes []TargetNodeStatus `json:"targetNodes,omitempty"`
+
+	// Attempts records the attempts to run the operation, only used when the operation has a retry policy.
+	// The latest attempt is the last one
+	// +optional
+	Attempts []OperationAttempt `json:"attempts,omitempty"`
+
+	// NextRetryTime is the time the failed attempt will be retried at
+	// +optional
+	NextRetryTime *metav1.Time `json:"nextRetryTime,omitempty"`
+}
+
+// OperationAttempt records an attempt to run a data operation
+type OperationAttempt struct {
+	// Attempt is the sequence number of the attempt, starting from 1
+	Attempt int32 `json:"attempt"`
+
+	// Phase is the phase of the attempt
+	Phase common.Phase `json:"phase"`
+
+	// Conditions consists of transition information on the attempt's Phase
+	// +optional
+	Conditions []Condition `json:"conditions,omitempty"`
+
+	// ExitCodes are the non-zero exit codes of the containers of the failed attempt
+	// +optional
+	ExitCodes []int32 `json:"exitCodes,omitempty"`
+
+	// StartTime is the time the attempt started
+	StartTime metav1.Time `json:"startTime"`
+
+	// CompletionTime is the time the attempt finished
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// Duration tells user how much time was spent on the attempt
+	// +optional
+	Duration string `json:"duration,omitempty"`
+}
+
+// TargetNodePhase is the phase of loading the data to a target node
+type TargetNodePhase string
+
+const (
+	// TargetNodePending means the data is being loaded to the worker of the runtime on the node
+	TargetNodePending TargetNodePhase = "Pending"
+
+	// TargetNodeLoaded means the load completed with the worker of the runtime on the node as one of its targets.
+	// The files are spread over the targets by their replicas, so it's not verified which of them the worker caches.
+	TargetNodeLoaded TargetNodePhase = "Loaded"
+
+	// TargetNodeNoCacheWorker means no worker of the runtime runs on the node, so the data can't be loaded to it
+	TargetNodeNoCacheWorker TargetNodePhase = "NoCacheWorker"
+)
+
+// TargetNodeStatus is the phase of loading the data to a target node
+type TargetNodeStatus struct {
+	// NodeName is the name of the target node
+	NodeName string `json:"nodeName"`
+
+	// Phase of loading the data to the node
+	Phase TargetNodePhase `json:"phase"`
 }
 
 type RuntimePhase string
@@ -302,6 +302,10 @@ type VineyardRuntimeSpec struct {
 	// Default is null.
 	// +optional
 	Volumes []corev1.Volume `json:"volumes,omitempty"`
+
+	// DataOperationPolicy defines the policy of running the data operations on the runtime
+	// +optional
+	DataOperationPolicy DataOperationPolicy `json:"dataOperationPolicy,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -8,7 +8,9 @@ import (
 	"github.com/fluid-cloudnative/fluid/pkg/common"
 	"k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -281,6 +283,7 @@ func (in *AlluxioRuntimeSpec) DeepCopyInto(out *AlluxioRuntimeSpec) {
 		*out = make([]v1.LocalObjectReference, len(*in))
 		copy(*out, *in)
 	}
+	out.DataOperationPolicy = in.DataOperationPolicy
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AlluxioRuntimeSpec.
@@ -293,6 +296,208 @@ func (in *AlluxioRuntimeSpec) DeepCopy() *AlluxioRuntimeSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CSISecretStoreSelector) DeepCopyInto(out *CSISecretStoreSelector) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CSISecretStoreSelector.
+func (in *CSISecretStoreSelector) DeepCopy() *CSISecretStoreSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(CSISecretStoreSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CacheAutoscaler) DeepCopyInto(out *CacheAutoscaler) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheAutoscaler.
+func (in *CacheAutoscaler) DeepCopy() *CacheAutoscaler {
+	if in == nil {
+		return nil
+	}
+	out := new(CacheAutoscaler)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CacheAutoscaler) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CacheAutoscalerDecision) DeepCopyInto(out *CacheAutoscalerDecision) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheAutoscalerDecision.
+func (in *CacheAutoscalerDecision) DeepCopy() *CacheAutoscalerDecision {
+	if in == nil {
+		return nil
+	}
+	out := new(CacheAutoscalerDecision)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CacheAutoscalerList) DeepCopyInto(out *CacheAutoscalerList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CacheAutoscaler, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheAutoscalerList.
+func (in *CacheAutoscalerList) DeepCopy() *CacheAutoscalerList {
+	if in == nil {
+		return nil
+	}
+	out := new(CacheAutoscalerList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CacheAutoscalerList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CacheAutoscalerMetric) DeepCopyInto(out *CacheAutoscalerMetric) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheAutoscalerMetric.
+func (in *CacheAutoscalerMetric) DeepCopy() *CacheAutoscalerMetric {
+	if in == nil {
+		return nil
+	}
+	out := new(CacheAutoscalerMetric)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CacheAutoscalerMetricStatus) DeepCopyInto(out *CacheAutoscalerMetricStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheAutoscalerMetricStatus.
+func (in *CacheAutoscalerMetricStatus) DeepCopy() *CacheAutoscalerMetricStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CacheAutoscalerMetricStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CacheAutoscalerSpec) DeepCopyInto(out *CacheAutoscalerSpec) {
+	*out = *in
+	out.ScaleTargetRef = in.ScaleTargetRef
+	if in.MinReplicas != nil {
+		in, out := &in.MinReplicas, &out.MinReplicas
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		*out = make([]CacheAutoscalerMetric, len(*in))
+		copy(*out, *in)
+	}
+	if in.ScaleUpCooldownSeconds != nil {
+		in, out := &in.ScaleUpCooldownSeconds, &out.ScaleUpCooldownSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ScaleDownCooldownSeconds != nil {
+		in, out := &in.ScaleDownCooldownSeconds, &out.ScaleDownCooldownSeconds
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheAutoscalerSpec.
+func (in *CacheAutoscalerSpec) DeepCopy() *CacheAutoscalerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CacheAutoscalerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CacheAutoscalerStatus) DeepCopyInto(out *CacheAutoscalerStatus) {
+	*out = *in
+	if in.CurrentMetrics != nil {
+		in, out := &in.CurrentMetrics, &out.CurrentMetrics
+		*out = make([]CacheAutoscalerMetricStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.LastScaleTime != nil {
+		in, out := &in.LastScaleTime, &out.LastScaleTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Decisions != nil {
+		in, out := &in.Decisions, &out.Decisions
+		*out = make([]CacheAutoscalerDecision, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheAutoscalerStatus.
+func (in *CacheAutoscalerStatus) DeepCopy() *CacheAutoscalerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CacheAutoscalerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CacheAutoscalerTargetRef) DeepCopyInto(out *CacheAutoscalerTargetRef) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheAutoscalerTargetRef.
+func (in *CacheAutoscalerTargetRef) DeepCopy() *CacheAutoscalerTargetRef {
+	if in == nil {
+		return nil
+	}
+	out := new(CacheAutoscalerTargetRef)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CacheRuntime) DeepCopyInto(out *CacheRuntime) {
 	*out = *in
@@ -501,6 +706,7 @@ func (in *CacheRuntimeSpec) DeepCopyInto(out *CacheRuntimeSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	out.DataOperationPolicy = in.DataOperationPolicy
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheRuntimeSpec.
@@ -696,6 +902,21 @@ func (in *ConfigMapRuntimeExtraResource) DeepCopy() *ConfigMapRuntimeExtraResour
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CredentialProviderSelector) DeepCopyInto(out *CredentialProviderSelector) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CredentialProviderSelector.
+func (in *CredentialProviderSelector) DeepCopy() *CredentialProviderSelector {
+	if in == nil {
+		return nil
+	}
+	out := new(CredentialProviderSelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Data) DeepCopyInto(out *Data) {
 	*out = *in
@@ -801,7 +1022,7 @@ func (in *DataBackupSpec) DeepCopy() *DataBackupSpec {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DataLoad) DeepCopyInto(out *DataLoad) {
+func (in *DataFlow) DeepCopyInto(out *DataFlow) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -809,18 +1030,18 @@ func (in *DataLoad) DeepCopyInto(out *DataLoad) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataLoad.
-func (in *DataLoad) DeepCopy() *DataLoad {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataFlow.
+func (in *DataFlow) DeepCopy() *DataFlow {
 	if in == nil {
 		return nil
 	}
-	out := new(DataLoad)
+	out := new(DataFlow)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *DataLoad) DeepCopyObject() runtime.Object {
+func (in *DataFlow) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -828,31 +1049,31 @@ func (in *DataLoad) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DataLoadList) DeepCopyInto(out *DataLoadList) {
+func (in *DataFlowList) DeepCopyInto(out *DataFlowList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]DataLoad, len(*in))
+		*out = make([]DataFlow, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataLoadList.
-func (in *DataLoadList) DeepCopy() *DataLoadList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataFlowList.
+func (in *DataFlowList) DeepCopy() *DataFlowList {
 	if in == nil {
 		return nil
 	}
-	out := new(DataLoadList)
+	out := new(DataFlowList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *DataLoadList) DeepCopyObject() runtime.Object {
+func (in *DataFlowList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -860,95 +1081,377 @@ func (in *DataLoadList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DataLoadSpec) DeepCopyInto(out *DataLoadSpec) {
+func (in *DataFlowParameter) DeepCopyInto(out *DataFlowParameter) {
 	*out = *in
-	out.Dataset = in.Dataset
-	if in.Target != nil {
-		in, out := &in.Target, &out.Target
-		*out = make([]TargetPath, len(*in))
-		copy(*out, *in)
-	}
-	if in.Options != nil {
-		in, out := &in.Options, &out.Options
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
-	in.PodMetadata.DeepCopyInto(&out.PodMetadata)
-	if in.Affinity != nil {
-		in, out := &in.Affinity, &out.Affinity
-		*out = new(v1.Affinity)
-		(*in).DeepCopyInto(*out)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataFlowParameter.
+func (in *DataFlowParameter) DeepCopy() *DataFlowParameter {
+	if in == nil {
+		return nil
 	}
-	if in.Tolerations != nil {
-		in, out := &in.Tolerations, &out.Tolerations
-		*out = make([]v1.Toleration, len(*in))
+	out := new(DataFlowParameter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataFlowSpec) DeepCopyInto(out *DataFlowSpec) {
+	*out = *in
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]DataFlowStep, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.NodeSelector != nil {
-		in, out := &in.NodeSelector, &out.NodeSelector
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
-	if in.RunAfter != nil {
-		in, out := &in.RunAfter, &out.RunAfter
-		*out = new(OperationRef)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.TTLSecondsAfterFinished != nil {
-		in, out := &in.TTLSecondsAfterFinished, &out.TTLSecondsAfterFinished
-		*out = new(int32)
-		**out = **in
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]DataFlowParameter, len(*in))
+		copy(*out, *in)
 	}
-	in.Resources.DeepCopyInto(&out.Resources)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataLoadSpec.
-func (in *DataLoadSpec) DeepCopy() *DataLoadSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataFlowSpec.
+func (in *DataFlowSpec) DeepCopy() *DataFlowSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(DataLoadSpec)
+	out := new(DataFlowSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DataMigrate) DeepCopyInto(out *DataMigrate) {
+func (in *DataFlowStatus) DeepCopyInto(out *DataFlowStatus) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastScheduleTime != nil {
+		in, out := &in.LastScheduleTime, &out.LastScheduleTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]DataFlowStepStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataMigrate.
-func (in *DataMigrate) DeepCopy() *DataMigrate {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataFlowStatus.
+func (in *DataFlowStatus) DeepCopy() *DataFlowStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(DataMigrate)
+	out := new(DataFlowStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *DataMigrate) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DataMigrateList) DeepCopyInto(out *DataMigrateList) {
+func (in *DataFlowStep) DeepCopyInto(out *DataFlowStep) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
+	if in.DependsOn != nil {
+		in, out := &in.DependsOn, &out.DependsOn
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Affinity != nil {
+		in, out := &in.Affinity, &out.Affinity
+		*out = new(DataFlowStepAffinity)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DataLoad != nil {
+		in, out := &in.DataLoad, &out.DataLoad
+		*out = new(DataLoadSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DataProcess != nil {
+		in, out := &in.DataProcess, &out.DataProcess
+		*out = new(DataProcessSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DataMigrate != nil {
+		in, out := &in.DataMigrate, &out.DataMigrate
+		*out = new(DataMigrateSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DataBackup != nil {
+		in, out := &in.DataBackup, &out.DataBackup
+		*out = new(DataBackupSpec)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataFlowStep.
+func (in *DataFlowStep) DeepCopy() *DataFlowStep {
+	if in == nil {
+		return nil
+	}
+	out := new(DataFlowStep)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataFlowStepAffinity) DeepCopyInto(out *DataFlowStepAffinity) {
+	*out = *in
+	if in.Prefers != nil {
+		in, out := &in.Prefers, &out.Prefers
+		*out = make([]Prefer, len(*in))
+		copy(*out, *in)
+	}
+	if in.Requires != nil {
+		in, out := &in.Requires, &out.Requires
+		*out = make([]Require, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataFlowStepAffinity.
+func (in *DataFlowStepAffinity) DeepCopy() *DataFlowStepAffinity {
+	if in == nil {
+		return nil
+	}
+	out := new(DataFlowStepAffinity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataFlowStepStatus) DeepCopyInto(out *DataFlowStepStatus) {
+	*out = *in
+	if in.Operation != nil {
+		in, out := &in.Operation, &out.Operation
+		*out = new(ObjectRef)
+		**out = **in
+	}
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataFlowStepStatus.
+func (in *DataFlowStepStatus) DeepCopy() *DataFlowStepStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DataFlowStepStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataLoad) DeepCopyInto(out *DataLoad) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataLoad.
+func (in *DataLoad) DeepCopy() *DataLoad {
+	if in == nil {
+		return nil
+	}
+	out := new(DataLoad)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DataLoad) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataLoadList) DeepCopyInto(out *DataLoadList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DataLoad, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataLoadList.
+func (in *DataLoadList) DeepCopy() *DataLoadList {
+	if in == nil {
+		return nil
+	}
+	out := new(DataLoadList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DataLoadList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataLoadSpec) DeepCopyInto(out *DataLoadSpec) {
+	*out = *in
+	out.Dataset = in.Dataset
+	if in.Target != nil {
+		in, out := &in.Target, &out.Target
+		*out = make([]TargetPath, len(*in))
+		copy(*out, *in)
+	}
+	if in.Options != nil {
+		in, out := &in.Options, &out.Options
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.TargetNodes != nil {
+		in, out := &in.TargetNodes, &out.TargetNodes
+		*out = new(DataLoadTargetNodes)
+		(*in).DeepCopyInto(*out)
+	}
+	in.PodMetadata.DeepCopyInto(&out.PodMetadata)
+	if in.Affinity != nil {
+		in, out := &in.Affinity, &out.Affinity
+		*out = new(v1.Affinity)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]v1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Events != nil {
+		in, out := &in.Events, &out.Events
+		*out = make([]EventSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RunAfter != nil {
+		in, out := &in.RunAfter, &out.RunAfter
+		*out = new(OperationRef)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TTLSecondsAfterFinished != nil {
+		in, out := &in.TTLSecondsAfterFinished, &out.TTLSecondsAfterFinished
+		*out = new(int32)
+		**out = **in
+	}
+	if in.RetryPolicy != nil {
+		in, out := &in.RetryPolicy, &out.RetryPolicy
+		*out = new(RetryPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Resources.DeepCopyInto(&out.Resources)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataLoadSpec.
+func (in *DataLoadSpec) DeepCopy() *DataLoadSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DataLoadSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataLoadTargetNodes) DeepCopyInto(out *DataLoadTargetNodes) {
+	*out = *in
+	if in.NodeNames != nil {
+		in, out := &in.NodeNames, &out.NodeNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PodSelector != nil {
+		in, out := &in.PodSelector, &out.PodSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataLoadTargetNodes.
+func (in *DataLoadTargetNodes) DeepCopy() *DataLoadTargetNodes {
+	if in == nil {
+		return nil
+	}
+	out := new(DataLoadTargetNodes)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataMigrate) DeepCopyInto(out *DataMigrate) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataMigrate.
+func (in *DataMigrate) DeepCopy() *DataMigrate {
+	if in == nil {
+		return nil
+	}
+	out := new(DataMigrate)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DataMigrate) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataMigrateList) DeepCopyInto(out *DataMigrateList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
@@ -1020,6 +1523,11 @@ func (in *DataMigrateSpec) DeepCopyInto(out *DataMigrateSpec) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.RetryPolicy != nil {
+		in, out := &in.RetryPolicy, &out.RetryPolicy
+		*out = new(RetryPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 	in.Resources.DeepCopyInto(&out.Resources)
 	if in.ParallelOptions != nil {
 		in, out := &in.ParallelOptions, &out.ParallelOptions
@@ -1040,6 +1548,21 @@ func (in *DataMigrateSpec) DeepCopy() *DataMigrateSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DataOperationPolicy) DeepCopyInto(out *DataOperationPolicy) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataOperationPolicy.
+func (in *DataOperationPolicy) DeepCopy() *DataOperationPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(DataOperationPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DataOperationSpec) DeepCopyInto(out *DataOperationSpec) {
 	*out = *in
@@ -1139,6 +1662,18 @@ func (in *DataProcessSpec) DeepCopyInto(out *DataProcessSpec) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.RetryPolicy != nil {
+		in, out := &in.RetryPolicy, &out.RetryPolicy
+		*out = new(RetryPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Events != nil {
+		in, out := &in.Events, &out.Events
+		*out = make([]EventSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DataProcessSpec.
@@ -1192,81 +1727,490 @@ func (in *DataToMigrate) DeepCopy() *DataToMigrate {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Dataset) DeepCopyInto(out *Dataset) {
+func (in *Dataset) DeepCopyInto(out *Dataset) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Dataset.
+func (in *Dataset) DeepCopy() *Dataset {
+	if in == nil {
+		return nil
+	}
+	out := new(Dataset)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Dataset) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatasetCacheUsage) DeepCopyInto(out *DatasetCacheUsage) {
+	*out = *in
+	out.Requested = in.Requested.DeepCopy()
+	out.Used = in.Used.DeepCopy()
+	out.Cached = in.Cached.DeepCopy()
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatasetCacheUsage.
+func (in *DatasetCacheUsage) DeepCopy() *DatasetCacheUsage {
+	if in == nil {
+		return nil
+	}
+	out := new(DatasetCacheUsage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatasetCondition) DeepCopyInto(out *DatasetCondition) {
+	*out = *in
+	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatasetCondition.
+func (in *DatasetCondition) DeepCopy() *DatasetCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(DatasetCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatasetList) DeepCopyInto(out *DatasetList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Dataset, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatasetList.
+func (in *DatasetList) DeepCopy() *DatasetList {
+	if in == nil {
+		return nil
+	}
+	out := new(DatasetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DatasetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatasetQuota) DeepCopyInto(out *DatasetQuota) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatasetQuota.
+func (in *DatasetQuota) DeepCopy() *DatasetQuota {
+	if in == nil {
+		return nil
+	}
+	out := new(DatasetQuota)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DatasetQuota) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatasetQuotaList) DeepCopyInto(out *DatasetQuotaList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DatasetQuota, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatasetQuotaList.
+func (in *DatasetQuotaList) DeepCopy() *DatasetQuotaList {
+	if in == nil {
+		return nil
+	}
+	out := new(DatasetQuotaList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DatasetQuotaList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatasetQuotaSpec) DeepCopyInto(out *DatasetQuotaSpec) {
+	*out = *in
+	out.CacheCapacity = in.CacheCapacity.DeepCopy()
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatasetQuotaSpec.
+func (in *DatasetQuotaSpec) DeepCopy() *DatasetQuotaSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DatasetQuotaSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatasetQuotaStatus) DeepCopyInto(out *DatasetQuotaStatus) {
+	*out = *in
+	out.Requested = in.Requested.DeepCopy()
+	out.Used = in.Used.DeepCopy()
+	out.Cached = in.Cached.DeepCopy()
+	if in.Datasets != nil {
+		in, out := &in.Datasets, &out.Datasets
+		*out = make([]DatasetCacheUsage, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LastUpdateTime != nil {
+		in, out := &in.LastUpdateTime, &out.LastUpdateTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatasetQuotaStatus.
+func (in *DatasetQuotaStatus) DeepCopy() *DatasetQuotaStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DatasetQuotaStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatasetRefGrant) DeepCopyInto(out *DatasetRefGrant) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatasetRefGrant.
+func (in *DatasetRefGrant) DeepCopy() *DatasetRefGrant {
+	if in == nil {
+		return nil
+	}
+	out := new(DatasetRefGrant)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatasetShare) DeepCopyInto(out *DatasetShare) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatasetShare.
+func (in *DatasetShare) DeepCopy() *DatasetShare {
+	if in == nil {
+		return nil
+	}
+	out := new(DatasetShare)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DatasetShare) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatasetShareGrant) DeepCopyInto(out *DatasetShareGrant) {
+	*out = *in
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ServiceAccounts != nil {
+		in, out := &in.ServiceAccounts, &out.ServiceAccounts
+		*out = make([]DatasetShareServiceAccount, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatasetShareGrant.
+func (in *DatasetShareGrant) DeepCopy() *DatasetShareGrant {
+	if in == nil {
+		return nil
+	}
+	out := new(DatasetShareGrant)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatasetShareList) DeepCopyInto(out *DatasetShareList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DatasetShare, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatasetShareList.
+func (in *DatasetShareList) DeepCopy() *DatasetShareList {
+	if in == nil {
+		return nil
+	}
+	out := new(DatasetShareList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DatasetShareList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatasetShareServiceAccount) DeepCopyInto(out *DatasetShareServiceAccount) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatasetShareServiceAccount.
+func (in *DatasetShareServiceAccount) DeepCopy() *DatasetShareServiceAccount {
+	if in == nil {
+		return nil
+	}
+	out := new(DatasetShareServiceAccount)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatasetShareSpec) DeepCopyInto(out *DatasetShareSpec) {
+	*out = *in
+	if in.Grants != nil {
+		in, out := &in.Grants, &out.Grants
+		*out = make([]DatasetShareGrant, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatasetShareSpec.
+func (in *DatasetShareSpec) DeepCopy() *DatasetShareSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DatasetShareSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatasetSnapshot) DeepCopyInto(out *DatasetSnapshot) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatasetSnapshot.
+func (in *DatasetSnapshot) DeepCopy() *DatasetSnapshot {
+	if in == nil {
+		return nil
+	}
+	out := new(DatasetSnapshot)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DatasetSnapshot) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatasetSnapshotList) DeepCopyInto(out *DatasetSnapshotList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DatasetSnapshot, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatasetSnapshotList.
+func (in *DatasetSnapshotList) DeepCopy() *DatasetSnapshotList {
+	if in == nil {
+		return nil
+	}
+	out := new(DatasetSnapshotList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DatasetSnapshotList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatasetSnapshotManifest) DeepCopyInto(out *DatasetSnapshotManifest) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatasetSnapshotManifest.
+func (in *DatasetSnapshotManifest) DeepCopy() *DatasetSnapshotManifest {
+	if in == nil {
+		return nil
+	}
+	out := new(DatasetSnapshotManifest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DatasetSnapshotRef) DeepCopyInto(out *DatasetSnapshotRef) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Dataset.
-func (in *Dataset) DeepCopy() *Dataset {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatasetSnapshotRef.
+func (in *DatasetSnapshotRef) DeepCopy() *DatasetSnapshotRef {
 	if in == nil {
 		return nil
 	}
-	out := new(Dataset)
+	out := new(DatasetSnapshotRef)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *Dataset) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DatasetCondition) DeepCopyInto(out *DatasetCondition) {
+func (in *DatasetSnapshotSpec) DeepCopyInto(out *DatasetSnapshotSpec) {
 	*out = *in
-	in.LastUpdateTime.DeepCopyInto(&out.LastUpdateTime)
-	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatasetCondition.
-func (in *DatasetCondition) DeepCopy() *DatasetCondition {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatasetSnapshotSpec.
+func (in *DatasetSnapshotSpec) DeepCopy() *DatasetSnapshotSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(DatasetCondition)
+	out := new(DatasetSnapshotSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DatasetList) DeepCopyInto(out *DatasetList) {
+func (in *DatasetSnapshotStatus) DeepCopyInto(out *DatasetSnapshotStatus) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ListMeta.DeepCopyInto(&out.ListMeta)
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]Dataset, len(*in))
+	if in.SnapshotTime != nil {
+		in, out := &in.SnapshotTime, &out.SnapshotTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Mounts != nil {
+		in, out := &in.Mounts, &out.Mounts
+		*out = make([]Mount, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DataRestoreLocation != nil {
+		in, out := &in.DataRestoreLocation, &out.DataRestoreLocation
+		*out = new(DataRestoreLocation)
+		**out = **in
+	}
+	if in.Manifest != nil {
+		in, out := &in.Manifest, &out.Manifest
+		*out = new(DatasetSnapshotManifest)
+		**out = **in
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]Condition, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatasetList.
-func (in *DatasetList) DeepCopy() *DatasetList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatasetSnapshotStatus.
+func (in *DatasetSnapshotStatus) DeepCopy() *DatasetSnapshotStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(DatasetList)
+	out := new(DatasetSnapshotStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *DatasetList) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
-	}
-	return nil
-}
-
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DatasetSpec) DeepCopyInto(out *DatasetSpec) {
 	*out = *in
@@ -1319,7 +2263,14 @@ func (in *DatasetSpec) DeepCopyInto(out *DatasetSpec) {
 	if in.SharedEncryptOptions != nil {
 		in, out := &in.SharedEncryptOptions, &out.SharedEncryptOptions
 		*out = make([]EncryptOption, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.SnapshotRef != nil {
+		in, out := &in.SnapshotRef, &out.SnapshotRef
+		*out = new(DatasetSnapshotRef)
+		**out = **in
 	}
 }
 
@@ -1379,6 +2330,11 @@ func (in *DatasetStatus) DeepCopyInto(out *DatasetStatus) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.DatasetRefGrants != nil {
+		in, out := &in.DatasetRefGrants, &out.DatasetRefGrants
+		*out = make([]DatasetRefGrant, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DatasetStatus.
@@ -1547,6 +2503,7 @@ func (in *EFCRuntimeSpec) DeepCopyInto(out *EFCRuntimeSpec) {
 	out.OSAdvise = in.OSAdvise
 	in.CleanCachePolicy.DeepCopyInto(&out.CleanCachePolicy)
 	in.PodMetadata.DeepCopyInto(&out.PodMetadata)
+	out.DataOperationPolicy = in.DataOperationPolicy
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EFCRuntimeSpec.
@@ -1578,7 +2535,7 @@ func (in *EmptyDirMediumSource) DeepCopy() *EmptyDirMediumSource {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EncryptOption) DeepCopyInto(out *EncryptOption) {
 	*out = *in
-	out.ValueFrom = in.ValueFrom
+	in.ValueFrom.DeepCopyInto(&out.ValueFrom)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EncryptOption.
@@ -1595,6 +2552,16 @@ func (in *EncryptOption) DeepCopy() *EncryptOption {
 func (in *EncryptOptionSource) DeepCopyInto(out *EncryptOptionSource) {
 	*out = *in
 	out.SecretKeyRef = in.SecretKeyRef
+	if in.CredentialProvider != nil {
+		in, out := &in.CredentialProvider, &out.CredentialProvider
+		*out = new(CredentialProviderSelector)
+		**out = **in
+	}
+	if in.CSISecretStore != nil {
+		in, out := &in.CSISecretStore, &out.CSISecretStore
+		*out = new(CSISecretStoreSelector)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EncryptOptionSource.
@@ -1607,6 +2574,81 @@ func (in *EncryptOptionSource) DeepCopy() *EncryptOptionSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventRun) DeepCopyInto(out *EventRun) {
+	*out = *in
+	if in.Events != nil {
+		in, out := &in.Events, &out.Events
+		*out = make([]EventSourceType, len(*in))
+		copy(*out, *in)
+	}
+	in.TriggerTime.DeepCopyInto(&out.TriggerTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventRun.
+func (in *EventRun) DeepCopy() *EventRun {
+	if in == nil {
+		return nil
+	}
+	out := new(EventRun)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventSource) DeepCopyInto(out *EventSource) {
+	*out = *in
+	if in.Operation != nil {
+		in, out := &in.Operation, &out.Operation
+		*out = new(ObjectRef)
+		**out = **in
+	}
+	if in.PathPrefixes != nil {
+		in, out := &in.PathPrefixes, &out.PathPrefixes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventSource.
+func (in *EventSource) DeepCopy() *EventSource {
+	if in == nil {
+		return nil
+	}
+	out := new(EventSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventTriggerStatus) DeepCopyInto(out *EventTriggerStatus) {
+	*out = *in
+	if in.ObservedRevisions != nil {
+		in, out := &in.ObservedRevisions, &out.ObservedRevisions
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Runs != nil {
+		in, out := &in.Runs, &out.Runs
+		*out = make([]EventRun, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventTriggerStatus.
+func (in *EventTriggerStatus) DeepCopy() *EventTriggerStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(EventTriggerStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExecutionCommonEntry) DeepCopyInto(out *ExecutionCommonEntry) {
 	*out = *in
@@ -1635,86 +2677,242 @@ func (in *ExecutionEntries) DeepCopyInto(out *ExecutionEntries) {
 		*out = new(ExecutionCommonEntry)
 		(*in).DeepCopyInto(*out)
 	}
-	if in.ReportSummary != nil {
-		in, out := &in.ReportSummary, &out.ReportSummary
-		*out = new(ExecutionCommonEntry)
-		(*in).DeepCopyInto(*out)
+	if in.ReportSummary != nil {
+		in, out := &in.ReportSummary, &out.ReportSummary
+		*out = new(ExecutionCommonEntry)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExecutionEntries.
+func (in *ExecutionEntries) DeepCopy() *ExecutionEntries {
+	if in == nil {
+		return nil
+	}
+	out := new(ExecutionEntries)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalEndpointSpec) DeepCopyInto(out *ExternalEndpointSpec) {
+	*out = *in
+	if in.EncryptOptions != nil {
+		in, out := &in.EncryptOptions, &out.EncryptOptions
+		*out = make([]EncryptOption, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Options != nil {
+		in, out := &in.Options, &out.Options
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalEndpointSpec.
+func (in *ExternalEndpointSpec) DeepCopy() *ExternalEndpointSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalEndpointSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalMutator) DeepCopyInto(out *ExternalMutator) {
+	*out = *in
+	in.Service.DeepCopyInto(&out.Service)
+	if in.CABundle != nil {
+		in, out := &in.CABundle, &out.CABundle
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	if in.TimeoutSeconds != nil {
+		in, out := &in.TimeoutSeconds, &out.TimeoutSeconds
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalMutator.
+func (in *ExternalMutator) DeepCopy() *ExternalMutator {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalMutator)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalStorage) DeepCopyInto(out *ExternalStorage) {
+	*out = *in
+	if in.EncryptOptions != nil {
+		in, out := &in.EncryptOptions, &out.EncryptOptions
+		*out = make([]EncryptOption, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalStorage.
+func (in *ExternalStorage) DeepCopy() *ExternalStorage {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalStorage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExtraResourcesComponentDependency) DeepCopyInto(out *ExtraResourcesComponentDependency) {
+	*out = *in
+	if in.ConfigMaps != nil {
+		in, out := &in.ConfigMaps, &out.ConfigMaps
+		*out = make([]ConfigMapDependencyConfig, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExtraResourcesComponentDependency.
+func (in *ExtraResourcesComponentDependency) DeepCopy() *ExtraResourcesComponentDependency {
+	if in == nil {
+		return nil
+	}
+	out := new(ExtraResourcesComponentDependency)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FuseNodeUpgradeStatus) DeepCopyInto(out *FuseNodeUpgradeStatus) {
+	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FuseNodeUpgradeStatus.
+func (in *FuseNodeUpgradeStatus) DeepCopy() *FuseNodeUpgradeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(FuseNodeUpgradeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FuseUpgrade) DeepCopyInto(out *FuseUpgrade) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	out.Status = in.Status
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FuseUpgrade.
+func (in *FuseUpgrade) DeepCopy() *FuseUpgrade {
+	if in == nil {
+		return nil
+	}
+	out := new(FuseUpgrade)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FuseUpgrade) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FuseUpgradeList) DeepCopyInto(out *FuseUpgradeList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]FuseUpgrade, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExecutionEntries.
-func (in *ExecutionEntries) DeepCopy() *ExecutionEntries {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FuseUpgradeList.
+func (in *FuseUpgradeList) DeepCopy() *FuseUpgradeList {
 	if in == nil {
 		return nil
 	}
-	out := new(ExecutionEntries)
+	out := new(FuseUpgradeList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FuseUpgradeList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ExternalEndpointSpec) DeepCopyInto(out *ExternalEndpointSpec) {
+func (in *FuseUpgradeSpec) DeepCopyInto(out *FuseUpgradeSpec) {
 	*out = *in
-	if in.EncryptOptions != nil {
-		in, out := &in.EncryptOptions, &out.EncryptOptions
-		*out = make([]EncryptOption, len(*in))
-		copy(*out, *in)
-	}
-	if in.Options != nil {
-		in, out := &in.Options, &out.Options
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
+	out.RuntimeRef = in.RuntimeRef
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(intstr.IntOrString)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalEndpointSpec.
-func (in *ExternalEndpointSpec) DeepCopy() *ExternalEndpointSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FuseUpgradeSpec.
+func (in *FuseUpgradeSpec) DeepCopy() *FuseUpgradeSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ExternalEndpointSpec)
+	out := new(FuseUpgradeSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ExternalStorage) DeepCopyInto(out *ExternalStorage) {
+func (in *FuseUpgradeStatus) DeepCopyInto(out *FuseUpgradeStatus) {
 	*out = *in
-	if in.EncryptOptions != nil {
-		in, out := &in.EncryptOptions, &out.EncryptOptions
-		*out = make([]EncryptOption, len(*in))
-		copy(*out, *in)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalStorage.
-func (in *ExternalStorage) DeepCopy() *ExternalStorage {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FuseUpgradeStatus.
+func (in *FuseUpgradeStatus) DeepCopy() *FuseUpgradeStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ExternalStorage)
+	out := new(FuseUpgradeStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ExtraResourcesComponentDependency) DeepCopyInto(out *ExtraResourcesComponentDependency) {
+func (in *FuseUpgradeTargetRef) DeepCopyInto(out *FuseUpgradeTargetRef) {
 	*out = *in
-	if in.ConfigMaps != nil {
-		in, out := &in.ConfigMaps, &out.ConfigMaps
-		*out = make([]ConfigMapDependencyConfig, len(*in))
-		copy(*out, *in)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExtraResourcesComponentDependency.
-func (in *ExtraResourcesComponentDependency) DeepCopy() *ExtraResourcesComponentDependency {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FuseUpgradeTargetRef.
+func (in *FuseUpgradeTargetRef) DeepCopy() *FuseUpgradeTargetRef {
 	if in == nil {
 		return nil
 	}
-	out := new(ExtraResourcesComponentDependency)
+	out := new(FuseUpgradeTargetRef)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -2068,6 +3266,7 @@ func (in *JindoRuntimeSpec) DeepCopyInto(out *JindoRuntimeSpec) {
 		*out = make([]v1.LocalObjectReference, len(*in))
 		copy(*out, *in)
 	}
+	out.DataOperationPolicy = in.DataOperationPolicy
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JindoRuntimeSpec.
@@ -2294,6 +3493,7 @@ func (in *JuiceFSRuntimeSpec) DeepCopyInto(out *JuiceFSRuntimeSpec) {
 	}
 	in.PodMetadata.DeepCopyInto(&out.PodMetadata)
 	in.RuntimeManagement.DeepCopyInto(&out.RuntimeManagement)
+	out.DataOperationPolicy = in.DataOperationPolicy
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JuiceFSRuntimeSpec.
@@ -2394,7 +3594,9 @@ func (in *Mount) DeepCopyInto(out *Mount) {
 	if in.EncryptOptions != nil {
 		in, out := &in.EncryptOptions, &out.EncryptOptions
 		*out = make([]EncryptOption, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
@@ -2408,6 +3610,26 @@ func (in *Mount) DeepCopy() *Mount {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MutatorServiceReference) DeepCopyInto(out *MutatorServiceReference) {
+	*out = *in
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MutatorServiceReference.
+func (in *MutatorServiceReference) DeepCopy() *MutatorServiceReference {
+	if in == nil {
+		return nil
+	}
+	out := new(MutatorServiceReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OSAdvise) DeepCopyInto(out *OSAdvise) {
 	*out = *in
@@ -2438,6 +3660,38 @@ func (in *ObjectRef) DeepCopy() *ObjectRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OperationAttempt) DeepCopyInto(out *OperationAttempt) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ExitCodes != nil {
+		in, out := &in.ExitCodes, &out.ExitCodes
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperationAttempt.
+func (in *OperationAttempt) DeepCopy() *OperationAttempt {
+	if in == nil {
+		return nil
+	}
+	out := new(OperationAttempt)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OperationRef) DeepCopyInto(out *OperationRef) {
 	*out = *in
@@ -2486,6 +3740,27 @@ func (in *OperationStatus) DeepCopyInto(out *OperationStatus) {
 		*out = new(v1.NodeAffinity)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.EventTrigger != nil {
+		in, out := &in.EventTrigger, &out.EventTrigger
+		*out = new(EventTriggerStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TargetNodes != nil {
+		in, out := &in.TargetNodes, &out.TargetNodes
+		*out = make([]TargetNodeStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.Attempts != nil {
+		in, out := &in.Attempts, &out.Attempts
+		*out = make([]OperationAttempt, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.NextRetryTime != nil {
+		in, out := &in.NextRetryTime, &out.NextRetryTime
+		*out = (*in).DeepCopy()
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OperationStatus.
@@ -2527,6 +3802,111 @@ func (in *PodMetadata) DeepCopy() *PodMetadata {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodMutationPlugin) DeepCopyInto(out *PodMutationPlugin) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodMutationPlugin.
+func (in *PodMutationPlugin) DeepCopy() *PodMutationPlugin {
+	if in == nil {
+		return nil
+	}
+	out := new(PodMutationPlugin)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodMutationPolicy) DeepCopyInto(out *PodMutationPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodMutationPolicy.
+func (in *PodMutationPolicy) DeepCopy() *PodMutationPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(PodMutationPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PodMutationPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodMutationPolicyList) DeepCopyInto(out *PodMutationPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PodMutationPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodMutationPolicyList.
+func (in *PodMutationPolicyList) DeepCopy() *PodMutationPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(PodMutationPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PodMutationPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PodMutationPolicySpec) DeepCopyInto(out *PodMutationPolicySpec) {
+	*out = *in
+	if in.PodSelector != nil {
+		in, out := &in.PodSelector, &out.PodSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Plugins != nil {
+		in, out := &in.Plugins, &out.Plugins
+		*out = make([]PodMutationPlugin, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExternalMutators != nil {
+		in, out := &in.ExternalMutators, &out.ExternalMutators
+		*out = make([]ExternalMutator, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodMutationPolicySpec.
+func (in *PodMutationPolicySpec) DeepCopy() *PodMutationPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PodMutationPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Prefer) DeepCopyInto(out *Prefer) {
 	*out = *in
@@ -2599,6 +3979,66 @@ func (in *Require) DeepCopy() *Require {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetryBackoff) DeepCopyInto(out *RetryBackoff) {
+	*out = *in
+	if in.InitialDelaySeconds != nil {
+		in, out := &in.InitialDelaySeconds, &out.InitialDelaySeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Factor != nil {
+		in, out := &in.Factor, &out.Factor
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MaxDelaySeconds != nil {
+		in, out := &in.MaxDelaySeconds, &out.MaxDelaySeconds
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryBackoff.
+func (in *RetryBackoff) DeepCopy() *RetryBackoff {
+	if in == nil {
+		return nil
+	}
+	out := new(RetryBackoff)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetryPolicy) DeepCopyInto(out *RetryPolicy) {
+	*out = *in
+	if in.Backoff != nil {
+		in, out := &in.Backoff, &out.Backoff
+		*out = new(RetryBackoff)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RetryOnExitCodes != nil {
+		in, out := &in.RetryOnExitCodes, &out.RetryOnExitCodes
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
+	if in.RetryOnReasons != nil {
+		in, out := &in.RetryOnReasons, &out.RetryOnReasons
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryPolicy.
+func (in *RetryPolicy) DeepCopy() *RetryPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(RetryPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Runtime) DeepCopyInto(out *Runtime) {
 	*out = *in
@@ -2815,6 +4255,28 @@ func (in *RuntimeExtraResources) DeepCopy() *RuntimeExtraResources {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RuntimeFuseUpgradeStatus) DeepCopyInto(out *RuntimeFuseUpgradeStatus) {
+	*out = *in
+	if in.Nodes != nil {
+		in, out := &in.Nodes, &out.Nodes
+		*out = make([]FuseNodeUpgradeStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuntimeFuseUpgradeStatus.
+func (in *RuntimeFuseUpgradeStatus) DeepCopy() *RuntimeFuseUpgradeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RuntimeFuseUpgradeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RuntimeManagement) DeepCopyInto(out *RuntimeManagement) {
 	*out = *in
@@ -2870,6 +4332,11 @@ func (in *RuntimeStatus) DeepCopyInto(out *RuntimeStatus) {
 		*out = new(v1.NodeAffinity)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.FuseUpgrade != nil {
+		in, out := &in.FuseUpgrade, &out.FuseUpgrade
+		*out = new(RuntimeFuseUpgradeStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RuntimeStatus.
@@ -3068,6 +4535,21 @@ func (in *TargetDatasetWithMountPath) DeepCopy() *TargetDatasetWithMountPath {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TargetNodeStatus) DeepCopyInto(out *TargetNodeStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TargetNodeStatus.
+func (in *TargetNodeStatus) DeepCopy() *TargetNodeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TargetNodeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TargetPath) DeepCopyInto(out *TargetPath) {
 	*out = *in
@@ -3406,6 +4888,7 @@ func (in *ThinRuntimeSpec) DeepCopyInto(out *ThinRuntimeSpec) {
 		*out = make([]v1.LocalObjectReference, len(*in))
 		copy(*out, *in)
 	}
+	out.DataOperationPolicy = in.DataOperationPolicy
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ThinRuntimeSpec.
@@ -3637,6 +5120,7 @@ func (in *VineyardRuntimeSpec) DeepCopyInto(out *VineyardRuntimeSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	out.DataOperationPolicy = in.DataOperationPolicy
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VineyardRuntimeSpec.
@@ -3673,6 +5157,11 @@ func (in *WaitingStatus) DeepCopyInto(out *WaitingStatus) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.QueuePosition != nil {
+		in, out := &in.QueuePosition, &out.QueuePosition
+		*out = new(int32)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WaitingStatus.
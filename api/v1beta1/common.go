@@ -0,0 +1,375 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+)
+
+// User explains the user and group to run a Container
+type User struct {
+	// The uid to run the alluxio runtime
+	UID *int64 `json:"uid"`
+	// The gid to run the alluxio runtime
+	GID *int64 `json:"gid"`
+	// The user name to run the alluxio runtime
+	UserName string `json:"user"`
+	// The group name to run the alluxio runtime
+	GroupName string `json:"group"`
+}
+
+// HCFSStatus is the HCFS endpoint info
+type HCFSStatus struct {
+	// Endpoint for accessing
+	Endpoint string `json:"endpoint,omitempty"`
+
+	// Underlayer HCFS Compatible Version
+	UnderlayerFileSystemVersion string `json:"underlayerFileSystemVersion,omitempty"`
+}
+
+// PodMetadata defines subgroup properties of metav1.ObjectMeta
+type PodMetadata struct {
+	// Labels are labels of pod specification
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Annotations are annotations of pod specification
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// PlacementMode is the placement of the cache of a dataset, either exclusive or shared with other datasets
+type PlacementMode string
+
+const (
+	ExclusiveMode PlacementMode = "Exclusive"
+
+	ShareMode PlacementMode = "Shared"
+
+	// DefaultMode is exclusive
+	DefaultMode PlacementMode = ""
+)
+
+// ************************************************
+// * Common structs/constants for data operations *
+// ************************************************
+
+// TargetDataset defines the target dataset of a data operation
+type TargetDataset struct {
+	// Name defines name of the target dataset
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Name string `json:"name"`
+
+	// Namespace defines namespace of the target dataset, which must be the namespace of the data operation if set
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// Policy is the policy to run a data operation, one of Once, Cron and OnEvent
+type Policy string
+
+const (
+	// Once runs the data operation once, which is the default policy
+	Once Policy = "Once"
+
+	// Cron runs the data operation by cron
+	Cron Policy = "Cron"
+
+	// OnEvent runs the data operation when events occur
+	OnEvent Policy = "OnEvent"
+)
+
+// EventSourceType is the type of event which triggers a data operation with OnEvent policy
+type EventSourceType string
+
+const (
+	// DatasetMountsChangedEvent is fired when the mounts of the target dataset are changed
+	DatasetMountsChangedEvent EventSourceType = "DatasetMountsChanged"
+
+	// OperationCompletedEvent is fired when the referenced data operation completes
+	OperationCompletedEvent EventSourceType = "OperationCompleted"
+
+	// UFSChangedEvent is fired when a UFS change notification is delivered through the event receiver
+	UFSChangedEvent EventSourceType = "UFSChanged"
+)
+
+// EventSource defines an event which triggers a data operation with OnEvent policy
+type EventSource struct {
+	// Type of the event, one of `DatasetMountsChanged`, `OperationCompleted` or `UFSChanged`
+	// +kubebuilder:validation:Enum=DatasetMountsChanged;OperationCompleted;UFSChanged
+	// +required
+	Type EventSourceType `json:"type"`
+
+	// Operation specifies the data operation to watch, only used when type is OperationCompleted
+	// +optional
+	Operation *ObjectRef `json:"operation,omitempty"`
+
+	// PathPrefixes filters UFS change notifications by path, only used when type is UFSChanged.
+	// A notification matches if its path is one of the prefixes or under one of them. Empty means all paths match.
+	// +optional
+	PathPrefixes []string `json:"pathPrefixes,omitempty"`
+}
+
+// AffinityPolicy the strategy for the affinity between Data Operation Pods.
+type AffinityPolicy string
+
+const (
+	DefaultAffinityStrategy AffinityPolicy = ""
+	RequireAffinityStrategy AffinityPolicy = "Require"
+	PreferAffinityStrategy  AffinityPolicy = "Prefer"
+)
+
+// AffinityStrategy defines the pod affinity with the preceding operation in a workflow
+type AffinityStrategy struct {
+	// Specifies the dependent preceding operation in a workflow. If not set, use the operation referred to by RunAfter.
+	// +optional
+	DependOn *ObjectRef `json:"dependOn,omitempty"`
+	// Policy one of: "", "Require", "Prefer"
+	// +optional
+	Policy AffinityPolicy `json:"policy,omitempty"`
+
+	// +optional
+	Prefers []Prefer `json:"prefers,omitempty"`
+	// +optional
+	Requires []Require `json:"requires,omitempty"`
+}
+
+// Prefer defines the label key and weight for generating a PreferredSchedulingTerm.
+type Prefer struct {
+	Name   string `json:"name"`
+	Weight int32  `json:"weight"`
+}
+
+// Require defines the label key for generating a NodeSelectorTerm.
+type Require struct {
+	Name string `json:"name"`
+}
+
+// ObjectRef refers to a data operation
+type ObjectRef struct {
+	// API version of the referent operation
+	// +optional
+	APIVersion string `json:"apiVersion,omitempty"`
+
+	// Kind specifies the type of the referent operation
+	// +required
+	// +kubebuilder:validation:Enum=DataLoad;DataBackup;DataMigrate;DataProcess
+	Kind string `json:"kind"`
+
+	// Name specifies the name of the referent operation
+	// +required
+	Name string `json:"name"`
+
+	// Namespace specifies the namespace of the referent operation.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// OperationRef refers to the preceding data operation in a workflow
+type OperationRef struct {
+	ObjectRef `json:",inline"`
+
+	// AffinityStrategy specifies the pod affinity strategy with the referent operation.
+	// +optional
+	AffinityStrategy AffinityStrategy `json:"affinityStrategy,omitempty"`
+}
+
+// OperationStatus defines the observed state of operation
+type OperationStatus struct {
+	// Phase describes current phase of operation
+	Phase common.Phase `json:"phase"`
+	// Duration tell user how much time was spent to operation
+	Duration string `json:"duration"`
+	// Conditions consists of transition information on operation's Phase
+	Conditions []Condition `json:"conditions"`
+
+	// Infos operation customized name-value
+	Infos map[string]string `json:"infos,omitempty"`
+
+	// LastScheduleTime is the last time the cron operation was scheduled
+	LastScheduleTime *metav1.Time `json:"lastScheduleTime,omitempty"`
+	// LastSuccessfulTime is the last time the cron operation successfully completed
+	LastSuccessfulTime *metav1.Time `json:"lastSuccessfulTime,omitempty"`
+	// WaitingStatus stores information about waiting operation.
+	WaitingFor WaitingStatus `json:"waitingFor,omitempty"`
+
+	// NodeAffinity records the node affinity for operation pods
+	NodeAffinity *corev1.NodeAffinity `json:"nodeAffinity,omitempty"`
+
+	// EventTrigger records the observed events and the history of runs triggered by them, only used when policy is OnEvent
+	EventTrigger *EventTriggerStatus `json:"eventTrigger,omitempty"`
+
+	// TargetNodes records whether the data is loaded to the worker on each target node, only used by the DataLoad with target nodes
+	// +optional
+	TargetNodes []TargetNodeStatus `json:"targetNodes,omitempty"`
+
+	// Attempts records the attempts to run the operation, only used when the operation has a retry policy.
+	// The latest attempt is the last one
+	// +optional
+	Attempts []OperationAttempt `json:"attempts,omitempty"`
+
+	// NextRetryTime is the time the failed attempt will be retried at
+	// +optional
+	NextRetryTime *metav1.Time `json:"nextRetryTime,omitempty"`
+}
+
+// OperationAttempt records an attempt to run a data operation
+type OperationAttempt struct {
+	// Attempt is the sequence number of the attempt, starting from 1
+	Attempt int32 `json:"attempt"`
+
+	// Phase is the phase of the attempt
+	Phase common.Phase `json:"phase"`
+
+	// Conditions consists of transition information on the attempt's Phase
+	// +optional
+	Conditions []Condition `json:"conditions,omitempty"`
+
+	// ExitCodes are the non-zero exit codes of the containers of the failed attempt
+	// +optional
+	ExitCodes []int32 `json:"exitCodes,omitempty"`
+
+	// StartTime is the time the attempt started
+	StartTime metav1.Time `json:"startTime"`
+
+	// CompletionTime is the time the attempt finished
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// Duration tells user how much time was spent on the attempt
+	// +optional
+	Duration string `json:"duration,omitempty"`
+}
+
+// Condition explains the transitions on phase
+type Condition struct {
+	// Type of condition, either `Complete` or `Failed`
+	Type common.ConditionType `json:"type"`
+	// Status of the condition, one of `True`, `False` or `Unknown`
+	Status corev1.ConditionStatus `json:"status"`
+	// Reason for the condition's last transition
+	Reason string `json:"reason,omitempty"`
+	// Message is a human-readable message indicating details about the transition
+	Message string `json:"message,omitempty"`
+	// LastProbeTime describes last time this condition was updated.
+	LastProbeTime metav1.Time `json:"lastProbeTime,omitempty"`
+	// LastTransitionTime describes last time the condition transitioned from one status to another.
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+}
+
+// WaitingStatus records the preceding operation a data operation waits for
+type WaitingStatus struct {
+	// OperationComplete indicates if the preceding operation is complete
+	OperationComplete *bool `json:"operationComplete,omitempty"`
+
+	// QueuePosition is the position of the operation in the operation queue of the runtime, starting from 1.
+	// It's only set when the operation waits for the runtime to run fewer data operations
+	// +optional
+	QueuePosition *int32 `json:"queuePosition,omitempty"`
+}
+
+// EventRun records a run of a data operation triggered by events
+type EventRun struct {
+	// Events are the types of the events which triggered the run
+	Events []EventSourceType `json:"events"`
+	// Message is a human-readable message indicating details about the events
+	Message string `json:"message,omitempty"`
+	// TriggerTime is the time the run was triggered
+	TriggerTime metav1.Time `json:"triggerTime"`
+	// Phase is the phase of the run
+	Phase common.Phase `json:"phase,omitempty"`
+	// Duration tells user how much time was spent on the run
+	Duration string `json:"duration,omitempty"`
+}
+
+// EventTriggerStatus records the observed events and the runs triggered by them
+type EventTriggerStatus struct {
+	// ObservedRevisions records the last observed revision of each event source
+	ObservedRevisions map[string]string `json:"observedRevisions,omitempty"`
+	// Runs is the history of the runs triggered by events, the latest run is the last one
+	Runs []EventRun `json:"runs,omitempty"`
+}
+
+// TargetNodePhase is the phase of loading the data to a target node
+type TargetNodePhase string
+
+const (
+	// TargetNodePending means the data is being loaded to the worker of the runtime on the node
+	TargetNodePending TargetNodePhase = "Pending"
+
+	// TargetNodeLoaded means the load completed with the worker of the runtime on the node as one of its targets.
+	// The files are spread over the targets by their replicas, so it's not verified which of them the worker caches.
+	TargetNodeLoaded TargetNodePhase = "Loaded"
+
+	// TargetNodeNoCacheWorker means no worker of the runtime runs on the node, so the data can't be loaded to it
+	TargetNodeNoCacheWorker TargetNodePhase = "NoCacheWorker"
+)
+
+// TargetNodeStatus is the phase of loading the data to a target node
+type TargetNodeStatus struct {
+	// NodeName is the name of the target node
+	NodeName string `json:"nodeName"`
+
+	// Phase of loading the data to the node
+	Phase TargetNodePhase `json:"phase"`
+}
+
+// RetryPolicy defines how a failed data operation is retried, only used when policy is Once
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts to run the data operation, including the first one
+	// +kubebuilder:validation:Minimum=1
+	// +required
+	MaxAttempts int32 `json:"maxAttempts"`
+
+	// Backoff defines the delay before retrying a failed attempt
+	// +optional
+	Backoff *RetryBackoff `json:"backoff,omitempty"`
+
+	// RetryOnExitCodes retries a failed attempt only if a container of the attempt exited with one of the exit codes.
+	// A failed attempt is retried if it matches either retryOnExitCodes or retryOnReasons, and all the failed
+	// attempts are retried if neither of them is set.
+	// +optional
+	RetryOnExitCodes []int32 `json:"retryOnExitCodes,omitempty"`
+
+	// RetryOnReasons retries a failed attempt only if the reason of the failed job condition (e.g. `BackoffLimitExceeded`,
+	// `DeadlineExceeded`) or the reason of a terminated container (e.g. `Error`, `OOMKilled`) is one of the reasons.
+	// +optional
+	RetryOnReasons []string `json:"retryOnReasons,omitempty"`
+}
+
+// RetryBackoff defines the exponential backoff between the attempts of a data operation
+type RetryBackoff struct {
+	// InitialDelaySeconds is the delay before the first retry
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:default=10
+	// +optional
+	InitialDelaySeconds *int32 `json:"initialDelaySeconds,omitempty"`
+
+	// Factor multiplies the delay for each following retry
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:default=2
+	// +optional
+	Factor *int32 `json:"factor,omitempty"`
+
+	// MaxDelaySeconds is the upper limit of the delay
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:default=300
+	// +optional
+	MaxDelaySeconds *int32 `json:"maxDelaySeconds,omitempty"`
+}
@@ -0,0 +1,223 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"github.com/fluid-cloudnative/fluid/api/v1alpha1"
+)
+
+// The conversions of the types shared by the kinds. The types having the same fields in both versions are
+// converted directly, so the converted objects share the maps, the slices and the pointers with the source
+// objects.
+
+func convertOperationRefTo(src *OperationRef) *v1alpha1.OperationRef {
+	if src == nil {
+		return nil
+	}
+	dst := &v1alpha1.OperationRef{
+		ObjectRef: v1alpha1.ObjectRef(src.ObjectRef),
+		AffinityStrategy: v1alpha1.AffinityStrategy{
+			DependOn: (*v1alpha1.ObjectRef)(src.AffinityStrategy.DependOn),
+			Policy:   v1alpha1.AffinityPolicy(src.AffinityStrategy.Policy),
+		},
+	}
+	for _, prefer := range src.AffinityStrategy.Prefers {
+		dst.AffinityStrategy.Prefers = append(dst.AffinityStrategy.Prefers, v1alpha1.Prefer(prefer))
+	}
+	for _, require := range src.AffinityStrategy.Requires {
+		dst.AffinityStrategy.Requires = append(dst.AffinityStrategy.Requires, v1alpha1.Require(require))
+	}
+	return dst
+}
+
+func convertOperationRefFrom(src *v1alpha1.OperationRef) *OperationRef {
+	if src == nil {
+		return nil
+	}
+	dst := &OperationRef{
+		ObjectRef: ObjectRef(src.ObjectRef),
+		AffinityStrategy: AffinityStrategy{
+			DependOn: (*ObjectRef)(src.AffinityStrategy.DependOn),
+			Policy:   AffinityPolicy(src.AffinityStrategy.Policy),
+		},
+	}
+	for _, prefer := range src.AffinityStrategy.Prefers {
+		dst.AffinityStrategy.Prefers = append(dst.AffinityStrategy.Prefers, Prefer(prefer))
+	}
+	for _, require := range src.AffinityStrategy.Requires {
+		dst.AffinityStrategy.Requires = append(dst.AffinityStrategy.Requires, Require(require))
+	}
+	return dst
+}
+
+func convertEventSourcesTo(src []EventSource) []v1alpha1.EventSource {
+	if src == nil {
+		return nil
+	}
+	dst := make([]v1alpha1.EventSource, 0, len(src))
+	for _, source := range src {
+		dst = append(dst, v1alpha1.EventSource{
+			Type:         v1alpha1.EventSourceType(source.Type),
+			Operation:    (*v1alpha1.ObjectRef)(source.Operation),
+			PathPrefixes: source.PathPrefixes,
+		})
+	}
+	return dst
+}
+
+func convertEventSourcesFrom(src []v1alpha1.EventSource) []EventSource {
+	if src == nil {
+		return nil
+	}
+	dst := make([]EventSource, 0, len(src))
+	for _, source := range src {
+		dst = append(dst, EventSource{
+			Type:         EventSourceType(source.Type),
+			Operation:    (*ObjectRef)(source.Operation),
+			PathPrefixes: source.PathPrefixes,
+		})
+	}
+	return dst
+}
+
+func convertOperationStatusTo(src *OperationStatus) v1alpha1.OperationStatus {
+	dst := v1alpha1.OperationStatus{
+		Phase:              src.Phase,
+		Duration:           src.Duration,
+		Infos:              src.Infos,
+		LastScheduleTime:   src.LastScheduleTime,
+		LastSuccessfulTime: src.LastSuccessfulTime,
+		WaitingFor:         v1alpha1.WaitingStatus(src.WaitingFor),
+		NodeAffinity:       src.NodeAffinity,
+		NextRetryTime:      src.NextRetryTime,
+	}
+	if src.Conditions != nil {
+		dst.Conditions = make([]v1alpha1.Condition, 0, len(src.Conditions))
+		for _, condition := range src.Conditions {
+			dst.Conditions = append(dst.Conditions, v1alpha1.Condition(condition))
+		}
+	}
+	if src.EventTrigger != nil {
+		dst.EventTrigger = &v1alpha1.EventTriggerStatus{ObservedRevisions: src.EventTrigger.ObservedRevisions}
+		for _, run := range src.EventTrigger.Runs {
+			converted := v1alpha1.EventRun{
+				Message:     run.Message,
+				TriggerTime: run.TriggerTime,
+				Phase:       run.Phase,
+				Duration:    run.Duration,
+			}
+			for _, event := range run.Events {
+				converted.Events = append(converted.Events, v1alpha1.EventSourceType(event))
+			}
+			dst.EventTrigger.Runs = append(dst.EventTrigger.Runs, converted)
+		}
+	}
+	for _, node := range src.TargetNodes {
+		dst.TargetNodes = append(dst.TargetNodes, v1alpha1.TargetNodeStatus{NodeName: node.NodeName, Phase: v1alpha1.TargetNodePhase(node.Phase)})
+	}
+	for _, attempt := range src.Attempts {
+		converted := v1alpha1.OperationAttempt{
+			Attempt:        attempt.Attempt,
+			Phase:          attempt.Phase,
+			ExitCodes:      attempt.ExitCodes,
+			StartTime:      attempt.StartTime,
+			CompletionTime: attempt.CompletionTime,
+			Duration:       attempt.Duration,
+		}
+		for _, condition := range attempt.Conditions {
+			converted.Conditions = append(converted.Conditions, v1alpha1.Condition(condition))
+		}
+		dst.Attempts = append(dst.Attempts, converted)
+	}
+	return dst
+}
+
+func convertOperationStatusFrom(src *v1alpha1.OperationStatus) OperationStatus {
+	dst := OperationStatus{
+		Phase:              src.Phase,
+		Duration:           src.Duration,
+		Infos:              src.Infos,
+		LastScheduleTime:   src.LastScheduleTime,
+		LastSuccessfulTime: src.LastSuccessfulTime,
+		WaitingFor:         WaitingStatus(src.WaitingFor),
+		NodeAffinity:       src.NodeAffinity,
+		NextRetryTime:      src.NextRetryTime,
+	}
+	if src.Conditions != nil {
+		dst.Conditions = make([]Condition, 0, len(src.Conditions))
+		for _, condition := range src.Conditions {
+			dst.Conditions = append(dst.Conditions, Condition(condition))
+		}
+	}
+	if src.EventTrigger != nil {
+		dst.EventTrigger = &EventTriggerStatus{ObservedRevisions: src.EventTrigger.ObservedRevisions}
+		for _, run := range src.EventTrigger.Runs {
+			converted := EventRun{
+				Message:     run.Message,
+				TriggerTime: run.TriggerTime,
+				Phase:       run.Phase,
+				Duration:    run.Duration,
+			}
+			for _, event := range run.Events {
+				converted.Events = append(converted.Events, EventSourceType(event))
+			}
+			dst.EventTrigger.Runs = append(dst.EventTrigger.Runs, converted)
+		}
+	}
+	for _, node := range src.TargetNodes {
+		dst.TargetNodes = append(dst.TargetNodes, TargetNodeStatus{NodeName: node.NodeName, Phase: TargetNodePhase(node.Phase)})
+	}
+	for _, attempt := range src.Attempts {
+		converted := OperationAttempt{
+			Attempt:        attempt.Attempt,
+			Phase:          attempt.Phase,
+			ExitCodes:      attempt.ExitCodes,
+			StartTime:      attempt.StartTime,
+			CompletionTime: attempt.CompletionTime,
+			Duration:       attempt.Duration,
+		}
+		for _, condition := range attempt.Conditions {
+			converted.Conditions = append(converted.Conditions, Condition(condition))
+		}
+		dst.Attempts = append(dst.Attempts, converted)
+	}
+	return dst
+}
+
+func convertRetryPolicyTo(src *RetryPolicy) *v1alpha1.RetryPolicy {
+	if src == nil {
+		return nil
+	}
+	return &v1alpha1.RetryPolicy{
+		MaxAttempts:      src.MaxAttempts,
+		Backoff:          (*v1alpha1.RetryBackoff)(src.Backoff),
+		RetryOnExitCodes: src.RetryOnExitCodes,
+		RetryOnReasons:   src.RetryOnReasons,
+	}
+}
+
+func convertRetryPolicyFrom(src *v1alpha1.RetryPolicy) *RetryPolicy {
+	if src == nil {
+		return nil
+	}
+	return &RetryPolicy{
+		MaxAttempts:      src.MaxAttempts,
+		Backoff:          (*RetryBackoff)(src.Backoff),
+		RetryOnExitCodes: src.RetryOnExitCodes,
+		RetryOnReasons:   src.RetryOnReasons,
+	}
+}
@@ -0,0 +1,166 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	fuzz "github.com/google/gofuzz"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	"github.com/fluid-cloudnative/fluid/api/v1alpha1"
+)
+
+const fuzzIterations = 100
+
+// newFuzzer fills the objects randomly except the fields which are not converted losslessly by design
+func newFuzzer() *fuzz.Fuzzer {
+	return fuzz.New().NilChance(0.2).NumElements(0, 3).Funcs(
+		// the apiVersion and kind are set by the conversion webhook instead of the conversion functions
+		func(typeMeta *metav1.TypeMeta, c fuzz.Continue) {},
+		func(status *v1alpha1.DatasetStatus, c fuzz.Continue) {
+			c.FuzzNoCustom(status)
+			status.DataLoadRef = ""
+			status.DataBackupRef = ""
+		},
+		func(spec *DataBackupSpec, c fuzz.Continue) {
+			c.FuzzNoCustom(spec)
+			spec.Dataset.Namespace = ""
+		},
+	)
+}
+
+func TestConversionRoundTrip(t *testing.T) {
+	tests := []struct {
+		name  string
+		spoke func() conversion.Convertible
+		hub   func() conversion.Hub
+	}{
+		{
+			name:  "Dataset",
+			spoke: func() conversion.Convertible { return &Dataset{} },
+			hub:   func() conversion.Hub { return &v1alpha1.Dataset{} },
+		},
+		{
+			name:  "DataLoad",
+			spoke: func() conversion.Convertible { return &DataLoad{} },
+			hub:   func() conversion.Hub { return &v1alpha1.DataLoad{} },
+		},
+		{
+			name:  "DataBackup",
+			spoke: func() conversion.Convertible { return &DataBackup{} },
+			hub:   func() conversion.Hub { return &v1alpha1.DataBackup{} },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name+" spoke-hub-spoke", func(t *testing.T) {
+			fuzzer := newFuzzer()
+			for i := 0; i < fuzzIterations; i++ {
+				spoke := tt.spoke()
+				fuzzer.Fuzz(spoke)
+				hub := tt.hub()
+				if err := spoke.ConvertTo(hub); err != nil {
+					t.Fatalf("ConvertTo() error = %v", err)
+				}
+				converted := tt.spoke()
+				if err := converted.ConvertFrom(hub); err != nil {
+					t.Fatalf("ConvertFrom() error = %v", err)
+				}
+				if !apiequality.Semantic.DeepEqual(spoke, converted) {
+					t.Fatalf("the object is changed after the round trip: %s", cmp.Diff(spoke, converted))
+				}
+			}
+		})
+
+		t.Run(tt.name+" hub-spoke-hub", func(t *testing.T) {
+			fuzzer := newFuzzer()
+			for i := 0; i < fuzzIterations; i++ {
+				hub := tt.hub()
+				fuzzer.Fuzz(hub)
+				spoke := tt.spoke()
+				if err := spoke.ConvertFrom(hub); err != nil {
+					t.Fatalf("ConvertFrom() error = %v", err)
+				}
+				converted := tt.hub()
+				if err := spoke.ConvertTo(converted); err != nil {
+					t.Fatalf("ConvertTo() error = %v", err)
+				}
+				if !apiequality.Semantic.DeepEqual(hub, converted) {
+					t.Fatalf("the object is changed after the round trip: %s", cmp.Diff(hub, converted))
+				}
+			}
+		})
+	}
+}
+
+func TestDatasetConvertFromDeprecatedRefs(t *testing.T) {
+	hub := &v1alpha1.Dataset{
+		Status: v1alpha1.DatasetStatus{
+			DataLoadRef:   "default-load",
+			DataBackupRef: "default-backup",
+			OperationRef:  map[string]string{"DataBackup": "default-backup-2", "DataMigrate": "default-migrate"},
+		},
+	}
+
+	dataset := &Dataset{}
+	if err := dataset.ConvertFrom(hub); err != nil {
+		t.Fatalf("ConvertFrom() error = %v", err)
+	}
+
+	want := map[string]string{"DataLoad": "default-load", "DataBackup": "default-backup-2", "DataMigrate": "default-migrate"}
+	if !apiequality.Semantic.DeepEqual(dataset.Status.OperationRef, want) {
+		t.Errorf("ConvertFrom() operationRef = %v, want %v", dataset.Status.OperationRef, want)
+	}
+	if len(hub.Status.OperationRef) != 2 {
+		t.Errorf("ConvertFrom() changed the operationRef of the source object: %v", hub.Status.OperationRef)
+	}
+}
+
+func TestDataBackupConvertToWithDatasetNamespace(t *testing.T) {
+	tests := []struct {
+		name      string
+		namespace string
+		wantErr   bool
+	}{
+		{name: "same namespace", namespace: "default", wantErr: false},
+		{name: "namespace not set", namespace: "", wantErr: false},
+		{name: "other namespace", namespace: "other", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dataBackup := &DataBackup{
+				ObjectMeta: metav1.ObjectMeta{Name: "hbase-backup", Namespace: "default"},
+				Spec: DataBackupSpec{
+					Dataset:    TargetDataset{Name: "hbase", Namespace: tt.namespace},
+					BackupPath: "pvc://backup-pvc",
+				},
+			}
+			hub := &v1alpha1.DataBackup{}
+			err := dataBackup.ConvertTo(hub)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ConvertTo() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && hub.Spec.Dataset != "hbase" {
+				t.Errorf("ConvertTo() dataset = %v, want hbase", hub.Spec.Dataset)
+			}
+		})
+	}
+}
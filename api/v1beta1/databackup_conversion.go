@@ -0,0 +1,66 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"fmt"
+
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	"github.com/fluid-cloudnative/fluid/api/v1alpha1"
+)
+
+// ConvertTo converts the DataBackup to the v1alpha1 version, in which the dataset is always in the namespace
+// of the DataBackup.
+func (src *DataBackup) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*v1alpha1.DataBackup)
+	if len(src.Spec.Dataset.Namespace) > 0 && src.Spec.Dataset.Namespace != src.Namespace {
+		return fmt.Errorf("spec.dataset.namespace %q must be the namespace of the DataBackup %s/%s",
+			src.Spec.Dataset.Namespace, src.Namespace, src.Name)
+	}
+	dst.ObjectMeta = src.ObjectMeta
+
+	dst.Spec = v1alpha1.DataBackupSpec{
+		Dataset:                 src.Spec.Dataset.Name,
+		BackupPath:              src.Spec.BackupPath,
+		RunAs:                   (*v1alpha1.User)(src.Spec.RunAs),
+		RunAfter:                convertOperationRefTo(src.Spec.RunAfter),
+		TTLSecondsAfterFinished: src.Spec.TTLSecondsAfterFinished,
+		Priority:                src.Spec.Priority,
+	}
+
+	dst.Status = convertOperationStatusTo(&src.Status)
+	return nil
+}
+
+// ConvertFrom converts the v1alpha1 DataBackup to this version.
+func (dst *DataBackup) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*v1alpha1.DataBackup)
+	dst.ObjectMeta = src.ObjectMeta
+
+	dst.Spec = DataBackupSpec{
+		Dataset:                 TargetDataset{Name: src.Spec.Dataset},
+		BackupPath:              src.Spec.BackupPath,
+		RunAs:                   (*User)(src.Spec.RunAs),
+		RunAfter:                convertOperationRefFrom(src.Spec.RunAfter),
+		TTLSecondsAfterFinished: src.Spec.TTLSecondsAfterFinished,
+		Priority:                src.Spec.Priority,
+	}
+
+	dst.Status = convertOperationStatusFrom(&src.Status)
+	return nil
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	"github.com/fluid-cloudnative/fluid/api/v1alpha1"
+)
+
+// ConvertTo converts the DataLoad to the v1alpha1 version.
+func (src *DataLoad) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*v1alpha1.DataLoad)
+	dst.ObjectMeta = src.ObjectMeta
+
+	dst.Spec = v1alpha1.DataLoadSpec{
+		Dataset:                 v1alpha1.TargetDataset(src.Spec.Dataset),
+		LoadMetadata:            src.Spec.LoadMetadata,
+		Options:                 src.Spec.Options,
+		TargetNodes:             (*v1alpha1.DataLoadTargetNodes)(src.Spec.TargetNodes),
+		PodMetadata:             v1alpha1.PodMetadata(src.Spec.PodMetadata),
+		Affinity:                src.Spec.Affinity,
+		Tolerations:             src.Spec.Tolerations,
+		NodeSelector:            src.Spec.NodeSelector,
+		SchedulerName:           src.Spec.SchedulerName,
+		Policy:                  v1alpha1.Policy(src.Spec.Policy),
+		Schedule:                src.Spec.Schedule,
+		Events:                  convertEventSourcesTo(src.Spec.Events),
+		RunAfter:                convertOperationRefTo(src.Spec.RunAfter),
+		TTLSecondsAfterFinished: src.Spec.TTLSecondsAfterFinished,
+		RetryPolicy:             convertRetryPolicyTo(src.Spec.RetryPolicy),
+		Priority:                src.Spec.Priority,
+		Resources:               src.Spec.Resources,
+	}
+	for _, target := range src.Spec.Target {
+		dst.Spec.Target = append(dst.Spec.Target, v1alpha1.TargetPath(target))
+	}
+
+	dst.Status = convertOperationStatusTo(&src.Status)
+	return nil
+}
+
+// ConvertFrom converts the v1alpha1 DataLoad to this version.
+func (dst *DataLoad) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*v1alpha1.DataLoad)
+	dst.ObjectMeta = src.ObjectMeta
+
+	dst.Spec = DataLoadSpec{
+		Dataset:                 TargetDataset(src.Spec.Dataset),
+		LoadMetadata:            src.Spec.LoadMetadata,
+		Options:                 src.Spec.Options,
+		TargetNodes:             (*DataLoadTargetNodes)(src.Spec.TargetNodes),
+		PodMetadata:             PodMetadata(src.Spec.PodMetadata),
+		Affinity:                src.Spec.Affinity,
+		Tolerations:             src.Spec.Tolerations,
+		NodeSelector:            src.Spec.NodeSelector,
+		SchedulerName:           src.Spec.SchedulerName,
+		Policy:                  Policy(src.Spec.Policy),
+		Schedule:                src.Spec.Schedule,
+		Events:                  convertEventSourcesFrom(src.Spec.Events),
+		RunAfter:                convertOperationRefFrom(src.Spec.RunAfter),
+		TTLSecondsAfterFinished: src.Spec.TTLSecondsAfterFinished,
+		RetryPolicy:             convertRetryPolicyFrom(src.Spec.RetryPolicy),
+		Priority:                src.Spec.Priority,
+		Resources:               src.Spec.Resources,
+	}
+	for _, target := range src.Spec.Target {
+		dst.Spec.Target = append(dst.Spec.Target, TargetPath(target))
+	}
+
+	dst.Status = convertOperationStatusFrom(&src.Status)
+	return nil
+}
@@ -0,0 +1,155 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TargetPath defines the target path of the DataLoad
+type TargetPath struct {
+	// Path defines path to be load
+	Path string `json:"path"`
+
+	// Replicas defines how many replicas will be loaded
+	// +optional
+	Replicas int32 `json:"replicas,omitempty"`
+}
+
+// DataLoadTargetNodes selects the nodes where the target paths should be cached by the co-located workers
+type DataLoadTargetNodes struct {
+	// NodeNames are the names of the target nodes
+	// +optional
+	NodeNames []string `json:"nodeNames,omitempty"`
+
+	// PodSelector selects the pods in the namespace of the DataLoad, the nodes where they are scheduled
+	// or nominated to run are the target nodes
+	// +optional
+	PodSelector *metav1.LabelSelector `json:"podSelector,omitempty"`
+}
+
+// DataLoadSpec defines the desired state of DataLoad
+type DataLoadSpec struct {
+	// Dataset defines the target dataset of the DataLoad
+	// +required
+	Dataset TargetDataset `json:"dataset"`
+
+	// LoadMetadata specifies if the dataload job should load metadata
+	// +optional
+	LoadMetadata bool `json:"loadMetadata,omitempty"`
+
+	// Target defines target paths that needs to be loaded
+	// +optional
+	Target []TargetPath `json:"target,omitempty"`
+
+	// Options specifies the extra dataload properties for runtime
+	// +optional
+	Options map[string]string `json:"options,omitempty"`
+
+	// TargetNodes makes the target paths cached on the workers co-located with the target nodes, instead of
+	// the workers chosen by the runtime. It's only supported by AlluxioRuntime.
+	// +optional
+	TargetNodes *DataLoadTargetNodes `json:"targetNodes,omitempty"`
+
+	// PodMetadata defines labels and annotations that will be propagated to DataLoad pods
+	// +optional
+	PodMetadata PodMetadata `json:"podMetadata,omitempty"`
+
+	// Affinity defines affinity for DataLoad pod
+	// +optional
+	Affinity *corev1.Affinity `json:"affinity,omitempty"`
+
+	// Tolerations defines tolerations for DataLoad pod
+	// +optional
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+
+	// NodeSelector defines node selector for DataLoad pod
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// SchedulerName sets the scheduler to be used for DataLoad pod
+	// +optional
+	SchedulerName string `json:"schedulerName,omitempty"`
+
+	// Policy including Once, Cron, OnEvent
+	// +kubebuilder:default:=Once
+	// +kubebuilder:validation:Enum=Once;Cron;OnEvent
+	// +optional
+	Policy Policy `json:"policy,omitempty"`
+
+	// The schedule in Cron format, only set when policy is cron, see https://en.wikipedia.org/wiki/Cron.
+	// +optional
+	Schedule string `json:"schedule,omitempty"`
+
+	// Events defines the events which trigger the DataLoad to run again, only used when policy is OnEvent.
+	// +optional
+	Events []EventSource `json:"events,omitempty"`
+
+	// Specifies that the preceding operation in a workflow
+	// +optional
+	RunAfter *OperationRef `json:"runAfter,omitempty"`
+
+	// TTLSecondsAfterFinished is the time second to clean up data operations after finished or failed
+	// +optional
+	TTLSecondsAfterFinished *int32 `json:"ttlSecondsAfterFinished,omitempty"`
+
+	// RetryPolicy defines how the DataLoad is retried when it fails, only used when policy is Once
+	// +optional
+	RetryPolicy *RetryPolicy `json:"retryPolicy,omitempty"`
+
+	// Priority of the DataLoad in the operation queue of the runtime, the DataLoad with a higher priority runs first
+	// when the runtime limits the number of concurrent data operations. Defaults to 0
+	// +optional
+	Priority int32 `json:"priority,omitempty"`
+
+	// Resources that will be requested by the DataLoad job. <br>
+	// +optional
+	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
+}
+
+// +kubebuilder:printcolumn:name="Dataset",type="string",JSONPath=`.spec.dataset.name`
+// +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:printcolumn:name="Duration",type="string",JSONPath=`.status.duration`
+// +kubebuilder:object:root=true
+// +kubebuilder:unservedversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced
+// +kubebuilder:resource:categories={fluid},shortName=load
+
+// DataLoad is the Schema for the dataloads API
+type DataLoad struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DataLoadSpec    `json:"spec,omitempty"`
+	Status OperationStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DataLoadList contains a list of DataLoad
+type DataLoadList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DataLoad `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&DataLoad{}, &DataLoadList{})
+}
@@ -0,0 +1,238 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	"github.com/fluid-cloudnative/fluid/api/v1alpha1"
+)
+
+// ConvertTo converts the Dataset to the v1alpha1 version.
+func (src *Dataset) ConvertTo(dstRaw conversion.Hub) error {
+	dst := dstRaw.(*v1alpha1.Dataset)
+	dst.ObjectMeta = src.ObjectMeta
+
+	dst.Spec = v1alpha1.DatasetSpec{
+		Mounts:               convertMountsTo(src.Spec.Mounts),
+		Owner:                (*v1alpha1.User)(src.Spec.Owner),
+		NodeAffinity:         (*v1alpha1.CacheableNodeAffinity)(src.Spec.NodeAffinity),
+		Tolerations:          src.Spec.Tolerations,
+		AccessModes:          src.Spec.AccessModes,
+		PlacementMode:        v1alpha1.PlacementMode(src.Spec.PlacementMode),
+		DataRestoreLocation:  (*v1alpha1.DataRestoreLocation)(src.Spec.DataRestoreLocation),
+		SharedOptions:        src.Spec.SharedOptions,
+		SharedEncryptOptions: convertEncryptOptionsTo(src.Spec.SharedEncryptOptions),
+		SnapshotRef:          (*v1alpha1.DatasetSnapshotRef)(src.Spec.SnapshotRef),
+	}
+	for _, runtime := range src.Spec.Runtimes {
+		dst.Spec.Runtimes = append(dst.Spec.Runtimes, v1alpha1.Runtime(runtime))
+	}
+
+	dst.Status = v1alpha1.DatasetStatus{
+		Mounts:       convertMountsTo(src.Status.Mounts),
+		UfsTotal:     src.Status.UfsTotal,
+		Phase:        v1alpha1.DatasetPhase(src.Status.Phase),
+		CacheStates:  src.Status.CacheStates,
+		HCFSStatus:   (*v1alpha1.HCFSStatus)(src.Status.HCFSStatus),
+		FileNum:      src.Status.FileNum,
+		OperationRef: src.Status.OperationRef,
+		DatasetRef:   src.Status.DatasetRef,
+	}
+	for _, runtime := range src.Status.Runtimes {
+		dst.Status.Runtimes = append(dst.Status.Runtimes, v1alpha1.Runtime(runtime))
+	}
+	if src.Status.Conditions != nil {
+		dst.Status.Conditions = make([]v1alpha1.DatasetCondition, 0, len(src.Status.Conditions))
+		for _, condition := range src.Status.Conditions {
+			dst.Status.Conditions = append(dst.Status.Conditions, v1alpha1.DatasetCondition{
+				Type:               v1alpha1.DatasetConditionType(condition.Type),
+				Status:             condition.Status,
+				Reason:             condition.Reason,
+				Message:            condition.Message,
+				LastUpdateTime:     condition.LastUpdateTime,
+				LastTransitionTime: condition.LastTransitionTime,
+			})
+		}
+	}
+	for _, grant := range src.Status.DatasetRefGrants {
+		dst.Status.DatasetRefGrants = append(dst.Status.DatasetRefGrants, v1alpha1.DatasetRefGrant{
+			DatasetRef:   grant.DatasetRef,
+			DatasetShare: grant.DatasetShare,
+			AccessMode:   v1alpha1.DatasetShareAccessMode(grant.AccessMode),
+		})
+	}
+	return nil
+}
+
+// ConvertFrom converts the v1alpha1 Dataset to this version. The deprecated DataLoadRef and DataBackupRef
+// are moved to OperationRef.
+func (dst *Dataset) ConvertFrom(srcRaw conversion.Hub) error {
+	src := srcRaw.(*v1alpha1.Dataset)
+	dst.ObjectMeta = src.ObjectMeta
+
+	dst.Spec = DatasetSpec{
+		Mounts:               convertMountsFrom(src.Spec.Mounts),
+		Owner:                (*User)(src.Spec.Owner),
+		NodeAffinity:         (*CacheableNodeAffinity)(src.Spec.NodeAffinity),
+		Tolerations:          src.Spec.Tolerations,
+		AccessModes:          src.Spec.AccessModes,
+		PlacementMode:        PlacementMode(src.Spec.PlacementMode),
+		DataRestoreLocation:  (*DataRestoreLocation)(src.Spec.DataRestoreLocation),
+		SharedOptions:        src.Spec.SharedOptions,
+		SharedEncryptOptions: convertEncryptOptionsFrom(src.Spec.SharedEncryptOptions),
+		SnapshotRef:          (*DatasetSnapshotRef)(src.Spec.SnapshotRef),
+	}
+	for _, runtime := range src.Spec.Runtimes {
+		dst.Spec.Runtimes = append(dst.Spec.Runtimes, Runtime(runtime))
+	}
+
+	dst.Status = DatasetStatus{
+		Mounts:       convertMountsFrom(src.Status.Mounts),
+		UfsTotal:     src.Status.UfsTotal,
+		Phase:        DatasetPhase(src.Status.Phase),
+		CacheStates:  src.Status.CacheStates,
+		HCFSStatus:   (*HCFSStatus)(src.Status.HCFSStatus),
+		FileNum:      src.Status.FileNum,
+		OperationRef: convertDeprecatedOperationRefs(&src.Status),
+		DatasetRef:   src.Status.DatasetRef,
+	}
+	for _, runtime := range src.Status.Runtimes {
+		dst.Status.Runtimes = append(dst.Status.Runtimes, Runtime(runtime))
+	}
+	if src.Status.Conditions != nil {
+		dst.Status.Conditions = make([]DatasetCondition, 0, len(src.Status.Conditions))
+		for _, condition := range src.Status.Conditions {
+			dst.Status.Conditions = append(dst.Status.Conditions, DatasetCondition{
+				Type:               DatasetConditionType(condition.Type),
+				Status:             condition.Status,
+				Reason:             condition.Reason,
+				Message:            condition.Message,
+				LastUpdateTime:     condition.LastUpdateTime,
+				LastTransitionTime: condition.LastTransitionTime,
+			})
+		}
+	}
+	for _, grant := range src.Status.DatasetRefGrants {
+		dst.Status.DatasetRefGrants = append(dst.Status.DatasetRefGrants, DatasetRefGrant{
+			DatasetRef:   grant.DatasetRef,
+			DatasetShare: grant.DatasetShare,
+			AccessMode:   DatasetShareAccessMode(grant.AccessMode),
+		})
+	}
+	return nil
+}
+
+// convertDeprecatedOperationRefs returns the OperationRef of the v1alpha1 dataset status with the operations in
+// DataLoadRef and DataBackupRef added, keyed by their operation types as the data operation controllers do.
+func convertDeprecatedOperationRefs(src *v1alpha1.DatasetStatus) map[string]string {
+	deprecated := map[string]string{
+		"DataLoad":   src.DataLoadRef,
+		"DataBackup": src.DataBackupRef,
+	}
+
+	operationRef, copied := src.OperationRef, false
+	for operationType, name := range deprecated {
+		if len(name) == 0 || len(operationRef[operationType]) > 0 {
+			continue
+		}
+		// copy the map before changing it, which is shared with the source object
+		if !copied {
+			operationRef = make(map[string]string, len(src.OperationRef)+len(deprecated))
+			for key, value := range src.OperationRef {
+				operationRef[key] = value
+			}
+			copied = true
+		}
+		operationRef[operationType] = name
+	}
+	return operationRef
+}
+
+func convertMountsTo(src []Mount) []v1alpha1.Mount {
+	if src == nil {
+		return nil
+	}
+	dst := make([]v1alpha1.Mount, 0, len(src))
+	for _, mount := range src {
+		dst = append(dst, v1alpha1.Mount{
+			MountPoint:     mount.MountPoint,
+			Options:        mount.Options,
+			Name:           mount.Name,
+			Path:           mount.Path,
+			ReadOnly:       mount.ReadOnly,
+			Shared:         mount.Shared,
+			EncryptOptions: convertEncryptOptionsTo(mount.EncryptOptions),
+		})
+	}
+	return dst
+}
+
+func convertMountsFrom(src []v1alpha1.Mount) []Mount {
+	if src == nil {
+		return nil
+	}
+	dst := make([]Mount, 0, len(src))
+	for _, mount := range src {
+		dst = append(dst, Mount{
+			MountPoint:     mount.MountPoint,
+			Options:        mount.Options,
+			Name:           mount.Name,
+			Path:           mount.Path,
+			ReadOnly:       mount.ReadOnly,
+			Shared:         mount.Shared,
+			EncryptOptions: convertEncryptOptionsFrom(mount.EncryptOptions),
+		})
+	}
+	return dst
+}
+
+func convertEncryptOptionsTo(src []EncryptOption) []v1alpha1.EncryptOption {
+	if src == nil {
+		return nil
+	}
+	dst := make([]v1alpha1.EncryptOption, 0, len(src))
+	for _, option := range src {
+		dst = append(dst, v1alpha1.EncryptOption{
+			Name: option.Name,
+			ValueFrom: v1alpha1.EncryptOptionSource{
+				SecretKeyRef:       v1alpha1.SecretKeySelector(option.ValueFrom.SecretKeyRef),
+				CredentialProvider: (*v1alpha1.CredentialProviderSelector)(option.ValueFrom.CredentialProvider),
+				CSISecretStore:     (*v1alpha1.CSISecretStoreSelector)(option.ValueFrom.CSISecretStore),
+			},
+		})
+	}
+	return dst
+}
+
+func convertEncryptOptionsFrom(src []v1alpha1.EncryptOption) []EncryptOption {
+	if src == nil {
+		return nil
+	}
+	dst := make([]EncryptOption, 0, len(src))
+	for _, option := range src {
+		dst = append(dst, EncryptOption{
+			Name: option.Name,
+			ValueFrom: EncryptOptionSource{
+				SecretKeyRef:       SecretKeySelector(option.ValueFrom.SecretKeyRef),
+				CredentialProvider: (*CredentialProviderSelector)(option.ValueFrom.CredentialProvider),
+				CSISecretStore:     (*CSISecretStoreSelector)(option.ValueFrom.CSISecretStore),
+			},
+		})
+	}
+	return dst
+}
@@ -0,0 +1,384 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+)
+
+// DatasetPhase indicates whether the loading is behaving
+type DatasetPhase string
+
+const (
+	PendingDatasetPhase DatasetPhase = "Pending"
+	// Bound to dataset, can't be released
+	BoundDatasetPhase DatasetPhase = "Bound"
+	// Failed, can't be deleted
+	FailedDatasetPhase DatasetPhase = "Failed"
+	// Not bound to runtime, can be deleted
+	NotBoundDatasetPhase DatasetPhase = "NotBound"
+	// updating dataset, can't be released
+	UpdatingDatasetPhase DatasetPhase = "Updating"
+	// migrating dataset, can't be mounted
+	DataMigrating DatasetPhase = "DataMigrating"
+	// the dataset have no phase and need to be judged
+	NoneDatasetPhase DatasetPhase = ""
+)
+
+// SecretKeySelector selects a key of a secret in the namespace of the dataset
+type SecretKeySelector struct {
+	// The name of required secret
+	// +required
+	Name string `json:"name"`
+
+	// The required key in the secret
+	// +optional
+	Key string `json:"key,omitempty"`
+}
+
+// EncryptOptionSource is the source of the value of an encrypt option
+type EncryptOptionSource struct {
+	// The encryptInfo obtained from secret
+	// +optional
+	SecretKeyRef SecretKeySelector `json:"secretKeyRef,omitempty"`
+
+	// The encryptInfo exchanged from a credential provider with a service account token,
+	// it's refreshed before it expires
+	// +optional
+	CredentialProvider *CredentialProviderSelector `json:"credentialProvider,omitempty"`
+
+	// The encryptInfo read from a file on a Secrets Store CSI volume
+	// +optional
+	CSISecretStore *CSISecretStoreSelector `json:"csiSecretStore,omitempty"`
+}
+
+// CredentialProviderSelector selects a key of the credentials returned by a credential provider
+type CredentialProviderSelector struct {
+	// The URL of the credential provider. Fluid posts a token of the service account, whose audience is the URL,
+	// to it and gets the short-lived credentials back.
+	// +kubebuilder:validation:Pattern=`^https?://`
+	// +required
+	URL string `json:"url"`
+
+	// The service account in the namespace of the dataset whose token is exchanged, defaults to "default"
+	// +optional
+	ServiceAccountName string `json:"serviceAccountName,omitempty"`
+
+	// The required key in the credentials returned by the credential provider
+	// +required
+	Key string `json:"key"`
+}
+
+// CSISecretStoreSelector selects an object mounted by a Secrets Store CSI volume
+type CSISecretStoreSelector struct {
+	// The name of the SecretProviderClass in the namespace of the dataset
+	// +required
+	SecretProviderClass string `json:"secretProviderClass"`
+
+	// The required object name, i.e. the file name, in the volume
+	// +required
+	ObjectName string `json:"objectName"`
+}
+
+// EncryptOption is an option whose value is sensitive
+type EncryptOption struct {
+	// The name of encryptOption
+	// +required
+	Name string `json:"name"`
+
+	// The valueFrom of encryptOption
+	// +optional
+	ValueFrom EncryptOptionSource `json:"valueFrom,omitempty"`
+}
+
+// Mount describes a mounting. <br>
+// Refer to <a href="https://docs.alluxio.io/os/user/stable/en/ufs/S3.html">Alluxio Storage Integrations</a> for more info
+type Mount struct {
+	// MountPoint is the mount point of source.
+	// +kubebuilder:validation:MinLength=5
+	// +required
+	MountPoint string `json:"mountPoint"`
+
+	// The Mount Options. <br>
+	// Refer to <a href="https://docs.alluxio.io/os/user/stable/en/reference/Properties-List.html">Mount Options</a>.  <br>
+	// The option has Prefix 'fs.' And you can Learn more from
+	// <a href="https://docs.alluxio.io/os/user/stable/en/ufs/S3.html">The Storage Integrations</a>
+	// +optional
+	Options map[string]string `json:"options,omitempty"`
+
+	// The name of mount
+	// +kubebuilder:validation:MinLength=0
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// The path of mount, if not set will be /{Name}
+	// +optional
+	Path string `json:"path,omitempty"`
+
+	// Optional: Defaults to false (read-write).
+	// +optional
+	ReadOnly bool `json:"readOnly,omitempty"`
+
+	// Optional: Defaults to false (shared).
+	// +optional
+	Shared bool `json:"shared,omitempty"`
+
+	// The secret information
+	// +optional
+	EncryptOptions []EncryptOption `json:"encryptOptions,omitempty"`
+}
+
+// DataRestoreLocation describes the spec restore location of Dataset
+type DataRestoreLocation struct {
+	// Path describes the path of restore, in the form of local://subpath or pvc://<pvcName>/subpath
+	// +optional
+	Path string `json:"path,omitempty"`
+	// NodeName describes the nodeName of restore if Path is in the form of local://subpath
+	// +optional
+	NodeName string `json:"nodeName,omitempty"`
+	// Dataset is the name of the dataset in the same namespace the backup was taken from, defaults to the dataset itself
+	// +optional
+	Dataset string `json:"dataset,omitempty"`
+}
+
+// DatasetSnapshotRef refers to a DatasetSnapshot in the same namespace
+type DatasetSnapshotRef struct {
+	// Name of the DatasetSnapshot
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Name string `json:"name"`
+}
+
+// DatasetSpec defines the desired state of Dataset
+type DatasetSpec struct {
+	// Mount Points to be mounted on cache runtime. <br>
+	// This field can be empty because some runtimes don't need to mount external storage (e.g.
+	// <a href="https://v6d.io/">Vineyard</a>).
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:UniqueItems=false
+	// +optional
+	Mounts []Mount `json:"mounts,omitempty"`
+
+	// The owner of the dataset
+	// +optional
+	Owner *User `json:"owner,omitempty"`
+
+	// NodeAffinity defines constraints that limit what nodes this dataset can be cached to.
+	// This field influences the scheduling of pods that use the cached dataset.
+	// +optional
+	NodeAffinity *CacheableNodeAffinity `json:"nodeAffinity,omitempty"`
+
+	// If specified, the pod's tolerations.
+	// +optional
+	Tolerations []corev1.Toleration `json:"tolerations,omitempty"`
+
+	// AccessModes contains all ways the volume backing the PVC can be mounted
+	// +optional
+	AccessModes []corev1.PersistentVolumeAccessMode `json:"accessModes,omitempty"`
+
+	// Runtimes for supporting dataset (e.g. AlluxioRuntime)
+	// +optional
+	Runtimes []Runtime `json:"runtimes,omitempty"`
+
+	// Manage switch for opening Multiple datasets single node deployment or not
+	// +kubebuilder:validation:Enum=Exclusive;"";Shared
+	// +optional
+	PlacementMode PlacementMode `json:"placement,omitempty"`
+
+	// DataRestoreLocation is the location to load data of dataset been backuped
+	// +optional
+	DataRestoreLocation *DataRestoreLocation `json:"dataRestoreLocation,omitempty"`
+
+	// SharedOptions is the options to all mount
+	// +optional
+	SharedOptions map[string]string `json:"sharedOptions,omitempty"`
+
+	// SharedEncryptOptions is the encryptOption to all mount
+	// +optional
+	SharedEncryptOptions []EncryptOption `json:"sharedEncryptOptions,omitempty"`
+
+	// SnapshotRef mounts the dataset from a DatasetSnapshot read-only. The mounts, access modes and restore location
+	// are filled from the snapshot once it's complete, the runtime waits until then.
+	// +optional
+	SnapshotRef *DatasetSnapshotRef `json:"snapshotRef,omitempty"`
+}
+
+// Runtime describes a runtime to be used to support dataset
+type Runtime struct {
+	// Name of the runtime object
+	Name string `json:"name,omitempty"`
+
+	// Namespace of the runtime object
+	Namespace string `json:"namespace,omitempty"`
+
+	// Category the runtime object belongs to (e.g. Accelerate)
+	Category common.Category `json:"category,omitempty"`
+
+	// Runtime object's type (e.g. Alluxio)
+	Type string `json:"type,omitempty"`
+
+	// Runtime master replicas
+	MasterReplicas int32 `json:"masterReplicas,omitempty"`
+}
+
+// DatasetStatus defines the observed state of Dataset
+type DatasetStatus struct {
+	// the info of mount points have been mounted
+	Mounts []Mount `json:"mounts,omitempty"`
+
+	// Total in GB of dataset in the cluster
+	UfsTotal string `json:"ufsTotal,omitempty"`
+
+	// Dataset Phase. One of the four phases: `Pending`, `Bound`, `NotBound` and `Failed`
+	Phase DatasetPhase `json:"phase,omitempty"`
+
+	// Runtimes for supporting dataset
+	Runtimes []Runtime `json:"runtimes,omitempty"`
+
+	// Conditions is an array of current observed conditions.
+	Conditions []DatasetCondition `json:"conditions"`
+
+	// CacheStatus represents the total resources of the dataset.
+	CacheStates common.CacheStateList `json:"cacheStates,omitempty"`
+
+	// HCFSStatus represents hcfs info
+	HCFSStatus *HCFSStatus `json:"hcfs,omitempty"`
+
+	// FileNum represents the file numbers of the dataset
+	FileNum string `json:"fileNum,omitempty"`
+
+	// OperationRef specifies the Operation that targets this Dataset.
+	// This is mainly used as a lock to prevent concurrent same Operation jobs.
+	OperationRef map[string]string `json:"operationRef,omitempty"`
+
+	// DatasetRef specifies the datasets namespaced name mounting this Dataset.
+	DatasetRef []string `json:"datasetRef,omitempty"`
+
+	// DatasetRefGrants specifies the DatasetShares allowing the datasets in DatasetRef from other namespaces.
+	// +optional
+	DatasetRefGrants []DatasetRefGrant `json:"datasetRefGrants,omitempty"`
+}
+
+// DatasetShareAccessMode is the access to a dataset granted by a DatasetShare
+type DatasetShareAccessMode string
+
+const (
+	// DatasetShareReadOnly allows the referring datasets to be mounted read-only, i.e. ReadOnlyMany
+	DatasetShareReadOnly DatasetShareAccessMode = "ReadOnly"
+
+	// DatasetShareReadWrite allows the referring datasets to be mounted with any access modes
+	DatasetShareReadWrite DatasetShareAccessMode = "ReadWrite"
+)
+
+// DatasetRefGrant records the DatasetShare allowing a dataset in another namespace to mount this Dataset
+type DatasetRefGrant struct {
+	// DatasetRef is the namespaced name of the dataset mounting this Dataset
+	DatasetRef string `json:"datasetRef"`
+
+	// DatasetShare is the name of the DatasetShare allowing the reference
+	DatasetShare string `json:"datasetShare"`
+
+	// AccessMode is the access granted by the DatasetShare
+	AccessMode DatasetShareAccessMode `json:"accessMode"`
+}
+
+// DatasetConditionType defines all kinds of types of cacheStatus.<br>
+// one of the three types: `RuntimeScheduled`, `Ready` and `Initialized`
+type DatasetConditionType string
+
+const (
+	// RuntimeScheduled means the runtime CRD has been accepted by the system,
+	// But master and workers are not ready
+	RuntimeScheduled DatasetConditionType = "RuntimeScheduled"
+
+	// DatasetReady means the cache system for the dataset is ready.
+	DatasetReady DatasetConditionType = "Ready"
+
+	// DatasetNotReady means the dataset is not bound due to some unexpected error
+	DatasetNotReady DatasetConditionType = "NotReady"
+
+	// DatasetUpdateReady means the cache system for the dataset is updated.
+	DatasetUpdateReady DatasetConditionType = "UpdateReady"
+
+	// DatasetUpdating means the cache system for the dataset is updating.
+	DatasetUpdating DatasetConditionType = "Updating"
+
+	// DatasetInitialized means the cache system for the dataset is Initialized.
+	DatasetInitialized DatasetConditionType = "Initialized"
+)
+
+// CacheableNodeAffinity defines constraints that limit what nodes this dataset can be cached to.
+type CacheableNodeAffinity struct {
+	// Required specifies hard node constraints that must be met.
+	Required *corev1.NodeSelector `json:"required,omitempty"`
+}
+
+// DatasetCondition describes the state of the cache at a certain point.
+type DatasetCondition struct {
+	// Type of cache condition.
+	Type DatasetConditionType `json:"type"`
+	// Status of the condition, one of True, False, Unknown.
+	Status corev1.ConditionStatus `json:"status"`
+	// The reason for the condition's last transition.
+	Reason string `json:"reason,omitempty"`
+	// A human readable message indicating details about the transition.
+	Message string `json:"message,omitempty"`
+	// The last time this condition was updated.
+	LastUpdateTime metav1.Time `json:"lastUpdateTime,omitempty"`
+	// Last time the condition transitioned from one status to another.
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+}
+
+// +kubebuilder:printcolumn:name="Ufs Total Size",type="string",JSONPath=`.status.ufsTotal`
+// +kubebuilder:printcolumn:name="Cached",type="string",JSONPath=`.status.cacheStates.cached`
+// +kubebuilder:printcolumn:name="Cache Capacity",type="string",JSONPath=`.status.cacheStates.cacheCapacity`
+// +kubebuilder:printcolumn:name="Cached Percentage",type="string",JSONPath=`.status.cacheStates.cachedPercentage`
+// +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="HCFS URL",type="string",JSONPath=`.status.hcfs.endpoint`,priority=10
+// +kubebuilder:printcolumn:name="TOTAL FILES",type="string",JSONPath=`.status.fileNum`,priority=11
+// +kubebuilder:printcolumn:name="CACHE HIT RATIO",type="string",JSONPath=`.status.cacheStates.cacheHitRatio`,priority=10
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:object:root=true
+// +kubebuilder:unservedversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced
+// +kubebuilder:resource:categories={fluid},shortName=dataset
+
+// Dataset is the Schema for the datasets API
+type Dataset struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DatasetSpec   `json:"spec,omitempty"`
+	Status DatasetStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DatasetList contains a list of Dataset
+type DatasetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Dataset `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&Dataset{}, &DatasetList{})
+}
@@ -0,0 +1,27 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package v1beta1 is the v1beta1 version of the API.
+// +groupName=data.fluid.io
+// +k8s:openapi-gen=true
+package v1beta1
+
+// ******************************************************************************
+// THIS IS A FILE ONLY USED TO MAKE THE `gen-crd-api-reference-docs` TOOL WORK
+// WE USE THE TOOL TO GENERATE API DOCS.
+//
+// ANY CHANGES SHOULD BE COMMITTED TO `groupversion_info.go`
+// ******************************************************************************
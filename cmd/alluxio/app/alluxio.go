@@ -17,6 +17,7 @@ limitations under the License.
 package app
 
 import (
+	"context"
 	"os"
 	"time"
 	// +kubebuilder:scaffold:imports
@@ -29,6 +30,7 @@ import (
 	"github.com/fluid-cloudnative/fluid/pkg/ddc/base"
 	"github.com/fluid-cloudnative/fluid/pkg/ddc/base/portallocator"
 	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/tracing"
 	"github.com/spf13/cobra"
 	zapOpt "go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
@@ -55,6 +57,9 @@ var (
 	portRange               string
 	maxConcurrentReconciles int
 	pprofAddr               string
+	tracingEndpoint         string
+	tracingSamplingRatio    float64
+	renderMode              string
 	portAllocatePolicy      string
 
 	kubeClientQPS   float32
@@ -88,6 +93,8 @@ func init() {
 	alluxioCmd.Flags().StringVar(&portRange, "runtime-node-port-range", "20000-25000", "Set available port range for Alluxio")
 	alluxioCmd.Flags().IntVar(&maxConcurrentReconciles, "runtime-workers", 3, "Set max concurrent workers for AlluxioRuntime controller")
 	alluxioCmd.Flags().StringVarP(&pprofAddr, "pprof-addr", "", "", "The address for pprof to use while exporting profiling results")
+	alluxioCmd.Flags().StringVar(&tracingEndpoint, "tracing-endpoint", "", "The OTLP/HTTP endpoint to export the traces of reconciliation to, e.g. http://otel-collector:4318. Tracing is disabled if it is empty.")
+	alluxioCmd.Flags().Float64Var(&tracingSamplingRatio, "tracing-sampling-ratio", 1, "The ratio of the reconciliations to trace, between 0 and 1.")
 	alluxioCmd.Flags().StringVar(&portAllocatePolicy, "port-allocate-policy", "random", "Set port allocating policy, available choice is bitmap or random(default random).")
 	alluxioCmd.Flags().Float32VarP(&kubeClientQPS, "kube-api-qps", "", 20, "QPS to use while talking with kubernetes apiserver.")   // 20 is the default qps in controller-runtime
 	alluxioCmd.Flags().IntVarP(&kubeClientBurst, "kube-api-burst", "", 30, "Burst to use while talking with kubernetes apiserver.") // 30 is the default burst in controller-runtime
@@ -95,6 +102,7 @@ func init() {
 	alluxioCmd.Flags().StringVar(&controllerWorkqueueMaxSyncBackoffStr, "workqueue-max-sync-backoff", "1000s", "max backoff period for failed reconciliation in controller's workqueue")
 	alluxioCmd.Flags().IntVar(&controllerWorkqueueQPS, "workqueue-qps", 10, "qps limit value for controller's workqueue")
 	alluxioCmd.Flags().IntVar(&controllerWorkqueueBurst, "workqueue-burst", 100, "burst limit value for controller's workqueue")
+	alluxioCmd.Flags().StringVar(&renderMode, "render-mode", base.RenderModeHelm, "The default mode to deploy runtimes and data operations, helm or apply. It can be overridden by the annotation render.fluid.io/mode.")
 }
 
 func handle() {
@@ -115,6 +123,13 @@ func handle() {
 
 	utils.NewPprofServer(setupLog, pprofAddr, development)
 
+	shutdownTracing, err := tracing.Setup(setupLog, tracingEndpoint, tracingSamplingRatio, "alluxioruntime-controller")
+	if err != nil {
+		setupLog.Error(err, "failed to set up tracing")
+		os.Exit(1)
+	}
+	defer func() { _ = shutdownTracing(context.Background()) }()
+
 	// the default webhook server port is 9443, no need to set
 	mgr, err := ctrl.NewManager(controllers.GetConfigOrDieWithQPSAndBurst(kubeClientQPS, kubeClientBurst), ctrl.Options{
 		Scheme: scheme,
@@ -131,6 +146,11 @@ func handle() {
 		os.Exit(1)
 	}
 
+	if err = base.SetupRenderMode(mgr.GetClient(), renderMode); err != nil {
+		setupLog.Error(err, "unable to setup render mode")
+		os.Exit(1)
+	}
+
 	defaultSyncBackoff, err := time.ParseDuration(controllerWorkqueueDefaultSyncBackoffStr)
 	if err != nil {
 		setupLog.Error(err, "workqueue-default-sync-backoff is not a valid duration, please use string like \"100ms\", \"5s\", \"3m\", ...")
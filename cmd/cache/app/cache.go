@@ -17,11 +17,13 @@ limitations under the License.
 package app
 
 import (
+	"context"
 	"os"
 	"time"
 
 	"github.com/fluid-cloudnative/fluid/pkg/controllers"
 	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/tracing"
 	"github.com/spf13/cobra"
 	zapOpt "go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
@@ -52,6 +54,8 @@ var (
 	development             bool
 	maxConcurrentReconciles int
 	pprofAddr               string
+	tracingEndpoint         string
+	tracingSamplingRatio    float64
 
 	kubeClientQPS   float32
 	kubeClientBurst int
@@ -82,6 +86,8 @@ func init() {
 	startCmd.Flags().BoolVarP(&enableLeaderElection, "enable-leader-election", "", false, "Enable leader election for controller manager. Enabling this will ensure there is only one active controller manager.")
 	startCmd.Flags().StringVarP(&leaderElectionNamespace, "leader-election-namespace", "", "fluid-system", "The namespace in which the leader election resource will be created.")
 	startCmd.Flags().StringVarP(&pprofAddr, "pprof-addr", "", "", "The address for pprof to use while exporting profiling results")
+	startCmd.Flags().StringVar(&tracingEndpoint, "tracing-endpoint", "", "The OTLP/HTTP endpoint to export the traces of reconciliation to, e.g. http://otel-collector:4318. Tracing is disabled if it is empty.")
+	startCmd.Flags().Float64Var(&tracingSamplingRatio, "tracing-sampling-ratio", 1, "The ratio of the reconciliations to trace, between 0 and 1.")
 	startCmd.Flags().BoolVarP(&development, "development", "", true, "Enable development mode for fluid controller.")
 	startCmd.Flags().BoolVar(&eventDriven, "event-driven", true, "The reconciler's loop strategy. if it's false, it indicates period driven.")
 	startCmd.Flags().IntVar(&maxConcurrentReconciles, "runtime-workers", 3, "Set max concurrent workers for CacheRuntime controller")
@@ -119,6 +125,13 @@ func handle() {
 
 	utils.NewPprofServer(setupLog, pprofAddr, development)
 
+	shutdownTracing, err := tracing.Setup(setupLog, tracingEndpoint, tracingSamplingRatio, "cacheruntime-controller")
+	if err != nil {
+		setupLog.Error(err, "failed to set up tracing")
+		os.Exit(1)
+	}
+	defer func() { _ = shutdownTracing(context.Background()) }()
+
 	// the default webhook server port is 9443, no need to set
 	mgr, err := ctrl.NewManager(controllers.GetConfigOrDieWithQPSAndBurst(kubeClientQPS, kubeClientBurst), ctrl.Options{
 		Scheme: scheme,
@@ -17,6 +17,7 @@ limitations under the License.
 package app
 
 import (
+	"context"
 	"os"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"time"
@@ -40,17 +41,24 @@ import (
 	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
 	"github.com/fluid-cloudnative/fluid/pkg/common"
 	"github.com/fluid-cloudnative/fluid/pkg/controllers"
+	cacheautoscalerctl "github.com/fluid-cloudnative/fluid/pkg/controllers/v1alpha1/cacheautoscaler"
 	databackupctl "github.com/fluid-cloudnative/fluid/pkg/controllers/v1alpha1/databackup"
 	dataflowctl "github.com/fluid-cloudnative/fluid/pkg/controllers/v1alpha1/dataflow"
 	dataloadctl "github.com/fluid-cloudnative/fluid/pkg/controllers/v1alpha1/dataload"
 	datamigratectl "github.com/fluid-cloudnative/fluid/pkg/controllers/v1alpha1/datamigrate"
 	dataprocessctl "github.com/fluid-cloudnative/fluid/pkg/controllers/v1alpha1/dataprocess"
 	datasetctl "github.com/fluid-cloudnative/fluid/pkg/controllers/v1alpha1/dataset"
+	datasetquotactl "github.com/fluid-cloudnative/fluid/pkg/controllers/v1alpha1/datasetquota"
+	datasetsnapshotctl "github.com/fluid-cloudnative/fluid/pkg/controllers/v1alpha1/datasetsnapshot"
+	fuseupgradectl "github.com/fluid-cloudnative/fluid/pkg/controllers/v1alpha1/fuseupgrade"
+	"github.com/fluid-cloudnative/fluid/pkg/credentials"
+	"github.com/fluid-cloudnative/fluid/pkg/dataflow"
 	"github.com/fluid-cloudnative/fluid/pkg/ddc/alluxio"
 	"github.com/fluid-cloudnative/fluid/pkg/ddc/base"
 	"github.com/fluid-cloudnative/fluid/pkg/utils"
 	"github.com/fluid-cloudnative/fluid/pkg/utils/compatibility"
 	"github.com/fluid-cloudnative/fluid/pkg/utils/discovery"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/tracing"
 )
 
 var (
@@ -64,7 +72,13 @@ var (
 	leaderElectionNamespace string
 	development             bool
 	pprofAddr               string
+	renderMode              string
+	eventReceiverAddr       string
+	eventReceiverTokenFile  string
+	credentialProviderURLs  []string
 	maxConcurrentReconciles int
+	tracingEndpoint         string
+	tracingSamplingRatio    float64
 
 	kubeClientQPS   float32
 	kubeClientBurst int
@@ -95,6 +109,9 @@ func init() {
 	datasetCmd.Flags().StringVarP(&leaderElectionNamespace, "leader-election-namespace", "", "fluid-system", "The namespace in which the leader election resource will be created.")
 	datasetCmd.Flags().BoolVarP(&development, "development", "", true, "Enable development mode for fluid controller.")
 	datasetCmd.Flags().StringVarP(&pprofAddr, "pprof-addr", "", "", "The address for pprof to use while exporting profiling results")
+	datasetCmd.Flags().StringVarP(&eventReceiverAddr, "event-receiver-addr", "", "", "The address the event receiver binds to for UFS change notifications of OnEvent data operations. Disabled if empty.")
+	datasetCmd.Flags().StringVarP(&eventReceiverTokenFile, "event-receiver-token-file", "", "", "The file containing the bearer token which UFS change notifications must carry. Required if the event receiver is enabled.")
+	datasetCmd.Flags().StringSliceVar(&credentialProviderURLs, "credential-provider-urls", []string{}, "The URLs of the credential providers allowed to exchange the credentials of encrypt options. No credentials are exchanged if empty.")
 	datasetCmd.Flags().IntVar(&maxConcurrentReconciles, "reconcile-workers", 3, "Set the number of max concurrent workers for reconciling dataset and dataset operations")
 	datasetCmd.Flags().Float32VarP(&kubeClientQPS, "kube-api-qps", "", 20, "QPS to use while talking with kubernetes apiserver.")   // 20 is the default qps in controller-runtime
 	datasetCmd.Flags().IntVarP(&kubeClientBurst, "kube-api-burst", "", 30, "Burst to use while talking with kubernetes apiserver.") // 30 is the default burst in controller-runtime
@@ -102,6 +119,9 @@ func init() {
 	datasetCmd.Flags().StringVar(&controllerWorkqueueMaxSyncBackoffStr, "workqueue-max-sync-backoff", "1000s", "max backoff period for failed reconciliation in controller's workqueue")
 	datasetCmd.Flags().IntVar(&controllerWorkqueueQPS, "workqueue-qps", 10, "qps limit value for controller's workqueue")
 	datasetCmd.Flags().IntVar(&controllerWorkqueueBurst, "workqueue-burst", 100, "burst limit value for controller's workqueue")
+	datasetCmd.Flags().StringVar(&tracingEndpoint, "tracing-endpoint", "", "The OTLP/HTTP endpoint to export the traces of reconciliation to, e.g. http://otel-collector:4318. Tracing is disabled if it is empty.")
+	datasetCmd.Flags().Float64Var(&tracingSamplingRatio, "tracing-sampling-ratio", 1, "The ratio of the reconciliations to trace, between 0 and 1.")
+	datasetCmd.Flags().StringVar(&renderMode, "render-mode", base.RenderModeHelm, "The default mode to deploy runtimes and data operations, helm or apply. It can be overridden by the annotation render.fluid.io/mode.")
 }
 
 func handle() {
@@ -123,6 +143,15 @@ func handle() {
 
 	utils.NewPprofServer(setupLog, pprofAddr, development)
 
+	shutdownTracing, err := tracing.Setup(setupLog, tracingEndpoint, tracingSamplingRatio, "dataset-controller")
+	if err != nil {
+		setupLog.Error(err, "failed to set up tracing")
+		os.Exit(1)
+	}
+	defer func() { _ = shutdownTracing(context.Background()) }()
+
+	credentials.SetAllowedProviderURLs(credentialProviderURLs)
+
 	// the default webhook server port is 9443, no need to set
 	mgr, err := ctrl.NewManager(controllers.GetConfigOrDieWithQPSAndBurst(kubeClientQPS, kubeClientBurst), ctrl.Options{
 		Scheme: scheme,
@@ -140,6 +169,11 @@ func handle() {
 		os.Exit(1)
 	}
 
+	if err = base.SetupRenderMode(mgr.GetClient(), renderMode); err != nil {
+		setupLog.Error(err, "unable to setup render mode")
+		os.Exit(1)
+	}
+
 	defaultSyncBackoff, err := time.ParseDuration(controllerWorkqueueDefaultSyncBackoffStr)
 	if err != nil {
 		setupLog.Error(err, "workqueue-default-sync-backoff is not a valid duration, please use string like \"100ms\", \"5s\", \"3m\", ...")
@@ -169,6 +203,54 @@ func handle() {
 		os.Exit(1)
 	}
 
+	if fluidDiscovery.ResourceEnabled("datasetquota") {
+		setupLog.Info("Registering DatasetQuota reconciler to Fluid controller manager.")
+		if err = (datasetquotactl.NewDatasetQuotaReconciler(mgr.GetClient(),
+			ctrl.Log.WithName("datasetquotactl").WithName("DatasetQuota"),
+			mgr.GetEventRecorderFor("DatasetQuota"),
+			time.Duration(10*time.Second),
+		)).SetupWithManager(mgr, controllerOptions); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "DatasetQuota")
+			os.Exit(1)
+		}
+	}
+
+	if fluidDiscovery.ResourceEnabled("cacheautoscaler") {
+		setupLog.Info("Registering CacheAutoscaler reconciler to Fluid controller manager.")
+		if err = (cacheautoscalerctl.NewCacheAutoscalerReconciler(mgr.GetClient(),
+			ctrl.Log.WithName("cacheautoscalerctl").WithName("CacheAutoscaler"),
+			mgr.GetEventRecorderFor("CacheAutoscaler"),
+			time.Duration(15*time.Second),
+		)).SetupWithManager(mgr, controllerOptions); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "CacheAutoscaler")
+			os.Exit(1)
+		}
+	}
+
+	if fluidDiscovery.ResourceEnabled("fuseupgrade") {
+		setupLog.Info("Registering FuseUpgrade reconciler to Fluid controller manager.")
+		if err = (fuseupgradectl.NewFuseUpgradeReconciler(mgr.GetClient(),
+			ctrl.Log.WithName("fuseupgradectl").WithName("FuseUpgrade"),
+			mgr.GetEventRecorderFor("FuseUpgrade"),
+			time.Duration(10*time.Second),
+		)).SetupWithManager(mgr, controllerOptions); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "FuseUpgrade")
+			os.Exit(1)
+		}
+	}
+
+	if fluidDiscovery.ResourceEnabled("datasetsnapshot") {
+		setupLog.Info("Registering DatasetSnapshot reconciler to Fluid controller manager.")
+		if err = (datasetsnapshotctl.NewDatasetSnapshotReconciler(mgr.GetClient(),
+			ctrl.Log.WithName("datasetsnapshotctl").WithName("DatasetSnapshot"),
+			mgr.GetEventRecorderFor("DatasetSnapshot"),
+			time.Duration(10*time.Second),
+		)).SetupWithManager(mgr, controllerOptions); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "DatasetSnapshot")
+			os.Exit(1)
+		}
+	}
+
 	if fluidDiscovery.ResourceEnabled("dataload") {
 		setupLog.Info("Registering DataLoad reconciler to Fluid controller manager.")
 		if err = (dataloadctl.NewDataLoadReconciler(mgr.GetClient(),
@@ -229,6 +311,30 @@ func handle() {
 		}
 	}
 
+	if fluidDiscovery.ResourceEnabled("dataflow") {
+		setupLog.Info("Registering DataFlow object reconciler to Fluid controller manager.")
+		if err = (dataflowctl.NewFlowReconciler(mgr.GetClient(),
+			ctrl.Log.WithName("dataflowctl").WithName("Flow"),
+			mgr.GetEventRecorderFor("DataFlow"),
+			time.Duration(10*time.Second),
+		)).SetupWithManager(mgr, controllerOptions); err != nil {
+			setupLog.Error(err, "unable to create controller", "controller", "DataFlow")
+			os.Exit(1)
+		}
+	}
+
+	if eventReceiverAddr != "" {
+		setupLog.Info("Registering event receiver to Fluid controller manager.")
+		if err = mgr.Add(dataflow.NewEventReceiver(mgr.GetClient(),
+			ctrl.Log.WithName("dataflow").WithName("EventReceiver"),
+			eventReceiverAddr,
+			eventReceiverTokenFile,
+		)); err != nil {
+			setupLog.Error(err, "unable to add event receiver")
+			os.Exit(1)
+		}
+	}
+
 	setupLog.Info("starting dataset-controller")
 	if err := mgr.Start(ctrl.SetupSignalHandler()); err != nil {
 		setupLog.Error(err, "problem running dataset-controller")
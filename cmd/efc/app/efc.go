@@ -17,6 +17,7 @@
 package app
 
 import (
+	"context"
 	"os"
 	"time"
 
@@ -26,6 +27,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/net"
 
 	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/tracing"
 	"github.com/spf13/cobra"
 	zapOpt "go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
@@ -55,6 +57,9 @@ var (
 	development             bool
 	maxConcurrentReconciles int
 	pprofAddr               string
+	tracingEndpoint         string
+	tracingSamplingRatio    float64
+	renderMode              string
 	portRange               string
 
 	kubeClientQPS   float32
@@ -85,6 +90,8 @@ func init() {
 	startCmd.Flags().BoolVarP(&enableLeaderElection, "enable-leader-election", "", false, "Enable leader election for controller manager. Enabling this will ensure there is only one active controller manager.")
 	startCmd.Flags().StringVarP(&leaderElectionNamespace, "leader-election-namespace", "", "fluid-system", "The namespace in which the leader election resource will be created.")
 	startCmd.Flags().StringVarP(&pprofAddr, "pprof-addr", "", "", "The address for pprof to use while exporting profiling results")
+	startCmd.Flags().StringVar(&tracingEndpoint, "tracing-endpoint", "", "The OTLP/HTTP endpoint to export the traces of reconciliation to, e.g. http://otel-collector:4318. Tracing is disabled if it is empty.")
+	startCmd.Flags().Float64Var(&tracingSamplingRatio, "tracing-sampling-ratio", 1, "The ratio of the reconciliations to trace, between 0 and 1.")
 	startCmd.Flags().BoolVarP(&development, "development", "", true, "Enable development mode for fluid controller.")
 	startCmd.Flags().BoolVar(&eventDriven, "event-driven", true, "The reconciler's loop strategy. if it's false, it indicates period driven.")
 	startCmd.Flags().StringVar(&portRange, "runtime-node-port-range", "16000-17999", "Set available port range for EFC")
@@ -95,6 +102,7 @@ func init() {
 	startCmd.Flags().IntVar(&controllerWorkqueueQPS, "workqueue-qps", 10, "qps limit value for controller's workqueue")
 	startCmd.Flags().IntVar(&controllerWorkqueueBurst, "workqueue-burst", 100, "burst limit value for controller's workqueue")
 	startCmd.Flags().IntVar(&maxConcurrentReconciles, "runtime-workers", 3, "Set max concurrent workers for efc controller")
+	startCmd.Flags().StringVar(&renderMode, "render-mode", base.RenderModeHelm, "The default mode to deploy runtimes and data operations, helm or apply. It can be overridden by the annotation render.fluid.io/mode.")
 }
 
 func handle() {
@@ -115,6 +123,13 @@ func handle() {
 
 	utils.NewPprofServer(setupLog, pprofAddr, development)
 
+	shutdownTracing, err := tracing.Setup(setupLog, tracingEndpoint, tracingSamplingRatio, "efcruntime-controller")
+	if err != nil {
+		setupLog.Error(err, "failed to set up tracing")
+		os.Exit(1)
+	}
+	defer func() { _ = shutdownTracing(context.Background()) }()
+
 	// the default webhook server port is 9443, no need to set
 	mgr, err := ctrl.NewManager(controllers.GetConfigOrDieWithQPSAndBurst(kubeClientQPS, kubeClientBurst), ctrl.Options{
 		Scheme: scheme,
@@ -131,6 +146,11 @@ func handle() {
 		os.Exit(1)
 	}
 
+	if err = base.SetupRenderMode(mgr.GetClient(), renderMode); err != nil {
+		setupLog.Error(err, "unable to setup render mode")
+		os.Exit(1)
+	}
+
 	defaultSyncBackoff, err := time.ParseDuration(controllerWorkqueueDefaultSyncBackoffStr)
 	if err != nil {
 		setupLog.Error(err, "workqueue-default-sync-backoff is not a valid duration, please use string like \"100ms\", \"5s\", \"3m\", ...")
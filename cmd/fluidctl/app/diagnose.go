@@ -0,0 +1,88 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/fluid-cloudnative/fluid/pkg/fluidctl"
+)
+
+var (
+	collectPath string
+	tailLines   int64
+)
+
+var diagnoseCmd = &cobra.Command{
+	Use:   "diagnose DATASET",
+	Short: "collect the objects, events, logs and values of the Dataset and its runtime into a tarball",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) (err error) {
+		c, err := newClient()
+		if err != nil {
+			return err
+		}
+		target, err := getTarget(cmd, c, args)
+		if err != nil {
+			return err
+		}
+		config, err := configFlags.ToRESTConfig()
+		if err != nil {
+			return err
+		}
+		clientset, err := kubernetes.NewForConfig(config)
+		if err != nil {
+			return err
+		}
+
+		if collectPath == "" {
+			collectPath = fmt.Sprintf("diagnose_fluid_%s.tar.gz", time.Now().Format("20060102150405"))
+		}
+		file, err := os.Create(collectPath)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			if closeErr := file.Close(); err == nil {
+				err = closeErr
+			}
+		}()
+
+		diagnoser := &fluidctl.Diagnoser{
+			Reader:         c,
+			Scheme:         scheme,
+			GetLogs:        fluidctl.NewLogGetter(clientset, tailLines),
+			FluidNamespace: fluidNamespace,
+		}
+		if err = diagnoser.Collect(cmd.Context(), target, file); err != nil {
+			return err
+		}
+		fmt.Fprintf(cmd.OutOrStdout(), "please get %s for diagnostics\n", collectPath)
+		return nil
+	},
+}
+
+func init() {
+	diagnoseCmd.Flags().StringVar(&collectPath, "collect-path", "", "The file to collect the information into. (default diagnose_fluid_${timestamp}.tar.gz)")
+	diagnoseCmd.Flags().Int64Var(&tailLines, "tail", 5000, "The number of the lines of the latest logs to collect from each container.")
+	diagnoseCmd.Flags().StringVar(&fluidNamespace, "fluid-namespace", "fluid-system", "The namespace where Fluid is installed.")
+}
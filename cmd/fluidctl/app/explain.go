@@ -0,0 +1,55 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/fluid-cloudnative/fluid/pkg/fluidctl"
+)
+
+var fluidNamespace string
+
+var explainCmd = &cobra.Command{
+	Use:   "explain DATASET",
+	Short: "explain why the Dataset is not Bound",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, err := newClient()
+		if err != nil {
+			return err
+		}
+		target, err := getTarget(cmd, c, args)
+		if err != nil {
+			return err
+		}
+		reasons, err := fluidctl.Explain(cmd.Context(), c, target, fluidNamespace)
+		if err != nil {
+			return err
+		}
+		for _, reason := range reasons {
+			fmt.Fprintf(cmd.OutOrStdout(), "- %s\n", reason)
+		}
+		return nil
+	},
+}
+
+func init() {
+	explainCmd.Flags().StringVar(&fluidNamespace, "fluid-namespace", "fluid-system", "The namespace where Fluid is installed.")
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/fluidctl"
+)
+
+var (
+	scheme      = runtime.NewScheme()
+	configFlags = genericclioptions.NewConfigFlags(true)
+)
+
+func init() {
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(datav1alpha1.AddToScheme(scheme))
+}
+
+func NewFluidCtlCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:          "fluidctl",
+		Short:        "Inspect and diagnose Fluid datasets and runtimes",
+		SilenceUsage: true,
+	}
+	configFlags.AddFlags(cmd.PersistentFlags())
+	cmd.AddCommand(versionCmd, statusCmd, explainCmd, diagnoseCmd)
+
+	return cmd
+}
+
+// newClient returns the client reading the Fluid and Kubernetes objects.
+func newClient() (client.Client, error) {
+	config, err := configFlags.ToRESTConfig()
+	if err != nil {
+		return nil, err
+	}
+	return client.New(config, client.Options{Scheme: scheme})
+}
+
+// getTarget gets the Dataset named by the only argument in the namespace of the flags, and its runtime.
+func getTarget(cmd *cobra.Command, c client.Client, args []string) (*fluidctl.Target, error) {
+	namespace, _, err := configFlags.ToRawKubeConfigLoader().Namespace()
+	if err != nil {
+		return nil, err
+	}
+	target, err := fluidctl.GetTarget(cmd.Context(), c, args[0], namespace)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get dataset %s/%s: %w", namespace, args[0], err)
+	}
+	return target, nil
+}
@@ -0,0 +1,45 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/fluid-cloudnative/fluid/pkg/fluidctl"
+)
+
+var statusCmd = &cobra.Command{
+	Use:   "status DATASET",
+	Short: "show the Dataset, its runtime with the components, and its PVC as a tree",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		c, err := newClient()
+		if err != nil {
+			return err
+		}
+		target, err := getTarget(cmd, c, args)
+		if err != nil {
+			return err
+		}
+		tree, err := fluidctl.GetStatusTree(cmd.Context(), c, target)
+		if err != nil {
+			return err
+		}
+		tree.Print(cmd.OutOrStdout())
+		return nil
+	},
+}
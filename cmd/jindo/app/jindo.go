@@ -17,6 +17,7 @@ limitations under the License.
 package app
 
 import (
+	"context"
 	"os"
 	"time"
 
@@ -32,6 +33,7 @@ import (
 	"github.com/fluid-cloudnative/fluid/pkg/ddc/base/portallocator"
 	"github.com/fluid-cloudnative/fluid/pkg/ddc/jindo"
 	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/tracing"
 	"github.com/spf13/cobra"
 	zapOpt "go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
@@ -60,6 +62,9 @@ var (
 	eventDriven             bool
 	maxConcurrentReconciles int
 	pprofAddr               string
+	tracingEndpoint         string
+	tracingSamplingRatio    float64
+	renderMode              string
 	portRange               string
 	portAllocatePolicy      string
 
@@ -96,12 +101,15 @@ func init() {
 	jindoCmd.Flags().IntVar(&maxConcurrentReconciles, "runtime-workers", 3, "Set max concurrent workers for JindoRuntime controller")
 	jindoCmd.Flags().BoolVar(&eventDriven, "event-driven", true, "The reconciler's loop strategy. if it's false, it indicates period driven.")
 	jindoCmd.Flags().StringVarP(&pprofAddr, "pprof-addr", "", "", "The address for pprof to use while exporting profiling results")
+	jindoCmd.Flags().StringVar(&tracingEndpoint, "tracing-endpoint", "", "The OTLP/HTTP endpoint to export the traces of reconciliation to, e.g. http://otel-collector:4318. Tracing is disabled if it is empty.")
+	jindoCmd.Flags().Float64Var(&tracingSamplingRatio, "tracing-sampling-ratio", 1, "The ratio of the reconciliations to trace, between 0 and 1.")
 	jindoCmd.Flags().Float32VarP(&kubeClientQPS, "kube-api-qps", "", 20, "QPS to use while talking with kubernetes apiserver.")   // 20 is the default qps in controller-runtime
 	jindoCmd.Flags().IntVarP(&kubeClientBurst, "kube-api-burst", "", 30, "Burst to use while talking with kubernetes apiserver.") // 30 is the default burst in controller-runtime
 	jindoCmd.Flags().StringVar(&controllerWorkqueueDefaultSyncBackoffStr, "workqueue-default-sync-backoff", "5ms", "base backoff period for failed reconciliation in controller's workqueue")
 	jindoCmd.Flags().StringVar(&controllerWorkqueueMaxSyncBackoffStr, "workqueue-max-sync-backoff", "1000s", "max backoff period for failed reconciliation in controller's workqueue")
 	jindoCmd.Flags().IntVar(&controllerWorkqueueQPS, "workqueue-qps", 10, "qps limit value for controller's workqueue")
 	jindoCmd.Flags().IntVar(&controllerWorkqueueBurst, "workqueue-burst", 100, "burst limit value for controller's workqueue")
+	jindoCmd.Flags().StringVar(&renderMode, "render-mode", base.RenderModeHelm, "The default mode to deploy runtimes and data operations, helm or apply. It can be overridden by the annotation render.fluid.io/mode.")
 }
 
 func handle() {
@@ -122,6 +130,13 @@ func handle() {
 
 	utils.NewPprofServer(setupLog, pprofAddr, development)
 
+	shutdownTracing, err := tracing.Setup(setupLog, tracingEndpoint, tracingSamplingRatio, "jindoruntime-controller")
+	if err != nil {
+		setupLog.Error(err, "failed to set up tracing")
+		os.Exit(1)
+	}
+	defer func() { _ = shutdownTracing(context.Background()) }()
+
 	// the default webhook server port is 9443, no need to set
 	mgr, err := ctrl.NewManager(controllers.GetConfigOrDieWithQPSAndBurst(kubeClientQPS, kubeClientBurst), ctrl.Options{
 		Scheme: scheme,
@@ -138,6 +153,11 @@ func handle() {
 		os.Exit(1)
 	}
 
+	if err = base.SetupRenderMode(mgr.GetClient(), renderMode); err != nil {
+		setupLog.Error(err, "unable to setup render mode")
+		os.Exit(1)
+	}
+
 	defaultSyncBackoff, err := time.ParseDuration(controllerWorkqueueDefaultSyncBackoffStr)
 	if err != nil {
 		setupLog.Error(err, "workqueue-default-sync-backoff is not a valid duration, please use string like \"100ms\", \"5s\", \"3m\", ...")
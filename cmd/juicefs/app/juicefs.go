@@ -17,6 +17,7 @@ limitations under the License.
 package app
 
 import (
+	"context"
 	"os"
 	"time"
 
@@ -38,6 +39,7 @@ import (
 	"github.com/fluid-cloudnative/fluid/pkg/controllers"
 	"github.com/fluid-cloudnative/fluid/pkg/ddc/base/portallocator"
 	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/tracing"
 
 	"github.com/fluid-cloudnative/fluid"
 	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
@@ -60,6 +62,9 @@ var (
 	portRange               string
 	maxConcurrentReconciles int
 	pprofAddr               string
+	tracingEndpoint         string
+	tracingSamplingRatio    float64
+	renderMode              string
 	portAllocatePolicy      string
 
 	kubeClientQPS   float32
@@ -90,6 +95,8 @@ func init() {
 	startCmd.Flags().BoolVarP(&enableLeaderElection, "enable-leader-election", "", false, "Enable leader election for controller manager. Enabling this will ensure there is only one active controller manager.")
 	startCmd.Flags().StringVarP(&leaderElectionNamespace, "leader-election-namespace", "", "fluid-system", "The namespace in which the leader election resource will be created.")
 	startCmd.Flags().StringVarP(&pprofAddr, "pprof-addr", "", "", "The address for pprof to use while exporting profiling results")
+	startCmd.Flags().StringVar(&tracingEndpoint, "tracing-endpoint", "", "The OTLP/HTTP endpoint to export the traces of reconciliation to, e.g. http://otel-collector:4318. Tracing is disabled if it is empty.")
+	startCmd.Flags().Float64Var(&tracingSamplingRatio, "tracing-sampling-ratio", 1, "The ratio of the reconciliations to trace, between 0 and 1.")
 	startCmd.Flags().BoolVarP(&development, "development", "", true, "Enable development mode for fluid controller.")
 	startCmd.Flags().BoolVar(&eventDriven, "event-driven", true, "The reconciler's loop strategy. if it's false, it indicates period driven.")
 	startCmd.Flags().StringVar(&portRange, "runtime-node-port-range", "14000-15999", "Set available port range for JuiceFS")
@@ -101,6 +108,7 @@ func init() {
 	startCmd.Flags().StringVar(&controllerWorkqueueMaxSyncBackoffStr, "workqueue-max-sync-backoff", "1000s", "max backoff period for failed reconcilation in controller's workqueue")
 	startCmd.Flags().IntVar(&controllerWorkqueueQPS, "workqueue-qps", 10, "qps limit value for controller's workqueue")
 	startCmd.Flags().IntVar(&controllerWorkqueueBurst, "workqueue-burst", 100, "burst limit value for controller's workqueue")
+	startCmd.Flags().StringVar(&renderMode, "render-mode", base.RenderModeHelm, "The default mode to deploy runtimes and data operations, helm or apply. It can be overridden by the annotation render.fluid.io/mode.")
 }
 
 func handle() {
@@ -121,6 +129,13 @@ func handle() {
 
 	utils.NewPprofServer(setupLog, pprofAddr, development)
 
+	shutdownTracing, err := tracing.Setup(setupLog, tracingEndpoint, tracingSamplingRatio, "juicefsruntime-controller")
+	if err != nil {
+		setupLog.Error(err, "failed to set up tracing")
+		os.Exit(1)
+	}
+	defer func() { _ = shutdownTracing(context.Background()) }()
+
 	NewControllerClient := func(config *rest.Config, options client.Options) (client.Client, error) {
 		options.Cache.DisableFor = append(options.Cache.DisableFor, &rbacv1.RoleBinding{}, &rbacv1.Role{}, &corev1.ServiceAccount{})
 		return controllers.NewFluidControllerClient(config, options)
@@ -143,6 +158,11 @@ func handle() {
 		os.Exit(1)
 	}
 
+	if err = base.SetupRenderMode(mgr.GetClient(), renderMode); err != nil {
+		setupLog.Error(err, "unable to setup render mode")
+		os.Exit(1)
+	}
+
 	defaultSyncBackoff, err := time.ParseDuration(controllerWorkqueueDefaultSyncBackoffStr)
 	if err != nil {
 		setupLog.Error(err, "workqueue-default-sync-backoff is not a valid duration, please use string like \"100ms\", \"5s\", \"3m\", ...")
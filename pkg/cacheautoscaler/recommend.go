@@ -0,0 +1,157 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cacheautoscaler
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	// tolerance is the ratio that the observed cache capacity used may deviate from the target without scaling,
+	// which avoids scaling back and forth around the target.
+	tolerance = 0.1
+
+	defaultMinReplicas              int32 = 1
+	defaultScaleUpCooldownSeconds   int32 = 60
+	defaultScaleDownCooldownSeconds int32 = 300
+)
+
+// Recommendation is the worker replicas calculated from the signals
+type Recommendation struct {
+	// DesiredReplicas is the largest replicas recommended by the metrics, bounded by the min and max replicas
+	DesiredReplicas int32
+	// Metrics are the observed values of the metrics and the replicas they recommend
+	Metrics []datav1alpha1.CacheAutoscalerMetricStatus
+	// Reason explains which metric decides the desired replicas
+	Reason string
+}
+
+// Recommend calculates the worker replicas from the signals. A metric whose signal is unknown recommends the current
+// replicas, so that it doesn't scale the runtime by mistake.
+func Recommend(spec datav1alpha1.CacheAutoscalerSpec, signals Signals) (recommendation Recommendation) {
+	desired := int32(-1)
+	var reasons []string
+	for _, metric := range spec.Metrics {
+		status := recommendByMetric(metric, signals)
+		recommendation.Metrics = append(recommendation.Metrics, status)
+
+		if status.RecommendedReplicas > desired {
+			desired = status.RecommendedReplicas
+			reasons = reasons[:0]
+		}
+		if status.RecommendedReplicas == desired {
+			reasons = append(reasons, fmt.Sprintf("%s %s (target %d)", metric.Type, status.Current, metric.Target))
+		}
+	}
+	if desired < 0 {
+		desired = signals.CurrentReplicas
+	}
+
+	minReplicas := ptr.Deref(spec.MinReplicas, defaultMinReplicas)
+	switch {
+	case desired < minReplicas:
+		desired = minReplicas
+		reasons = []string{fmt.Sprintf("minReplicas %d", minReplicas)}
+	case desired > spec.MaxReplicas:
+		desired = spec.MaxReplicas
+		reasons = []string{fmt.Sprintf("maxReplicas %d", spec.MaxReplicas)}
+	}
+
+	recommendation.DesiredReplicas = desired
+	recommendation.Reason = strings.Join(reasons, ", ")
+	return
+}
+
+func recommendByMetric(metric datav1alpha1.CacheAutoscalerMetric, signals Signals) (status datav1alpha1.CacheAutoscalerMetricStatus) {
+	status.Type = metric.Type
+	status.RecommendedReplicas = signals.CurrentReplicas
+	target := float64(metric.Target)
+
+	switch metric.Type {
+	case datav1alpha1.CachedPercentageMetric:
+		if signals.CachedRatio == nil || signals.CurrentReplicas <= 0 {
+			return
+		}
+		cached := *signals.CachedRatio * 100
+		status.Current = fmt.Sprintf("%.1f%%", cached)
+		if cached <= 0 || cached >= target*(1-tolerance) {
+			return
+		}
+		// more workers don't cache more data until the cache of the current workers is full. The workers are never
+		// scaled in by the cached percentage, since the cache evicted lowers it and scales the workers out again.
+		if !isCacheFull(signals) {
+			return
+		}
+		status.RecommendedReplicas = ceil(float64(signals.CurrentReplicas) * target / cached)
+	case datav1alpha1.CacheCapacityUsedMetric:
+		if signals.CacheCapacity <= 0 || signals.CurrentReplicas <= 0 {
+			return
+		}
+		used := float64(signals.Cached) * 100 / float64(signals.CacheCapacity)
+		status.Current = fmt.Sprintf("%.1f%%", used)
+		if math.Abs(used/target-1) <= tolerance {
+			return
+		}
+		status.RecommendedReplicas = ceil(float64(signals.CurrentReplicas) * used / target)
+	case datav1alpha1.MountingPodsMetric:
+		status.Current = fmt.Sprintf("%d", signals.MountingPods)
+		status.RecommendedReplicas = ceil(float64(signals.MountingPods) / target)
+	}
+	return
+}
+
+// isCacheFull checks if the used cache capacity is within the tolerance of the total cache capacity
+func isCacheFull(signals Signals) bool {
+	return signals.CacheCapacity > 0 && float64(signals.Cached) >= float64(signals.CacheCapacity)*(1-tolerance)
+}
+
+// IsUnappliedDecision checks if the latest decision of the autoscaler scales the runtime from the current replicas to
+// the desired ones, i.e. the decision is recorded but the runtime is not scaled yet, e.g. because the scaling failed.
+func IsUnappliedDecision(autoscaler *datav1alpha1.CacheAutoscaler, current, desired int32) bool {
+	decisions := autoscaler.Status.Decisions
+	return len(decisions) > 0 && decisions[0].From == current && decisions[0].To == desired
+}
+
+// InCooldown checks if scaling from the current replicas to the desired replicas must wait for the cooldown window
+// since the last scaling. It returns the remaining time of the window.
+func InCooldown(autoscaler *datav1alpha1.CacheAutoscaler, current, desired int32, now time.Time) (bool, time.Duration) {
+	lastScaleTime := autoscaler.Status.LastScaleTime
+	if lastScaleTime == nil || current == desired {
+		return false, 0
+	}
+
+	cooldownSeconds := ptr.Deref(autoscaler.Spec.ScaleDownCooldownSeconds, defaultScaleDownCooldownSeconds)
+	if desired > current {
+		cooldownSeconds = ptr.Deref(autoscaler.Spec.ScaleUpCooldownSeconds, defaultScaleUpCooldownSeconds)
+	}
+
+	remaining := lastScaleTime.Add(time.Duration(cooldownSeconds) * time.Second).Sub(now)
+	if remaining > 0 {
+		return true, remaining
+	}
+	return false, 0
+}
+
+func ceil(value float64) int32 {
+	return int32(math.Ceil(value))
+}
@@ -0,0 +1,181 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cacheautoscaler
+
+import (
+	"testing"
+	"time"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+const gi = int64(1) << 30
+
+func TestRecommend(t *testing.T) {
+	testCases := map[string]struct {
+		metrics     []datav1alpha1.CacheAutoscalerMetric
+		signals     Signals
+		minReplicas *int32
+		want        int32
+		wantReason  string
+	}{
+		"cached percentage below target with full cache": {
+			metrics:    []datav1alpha1.CacheAutoscalerMetric{{Type: datav1alpha1.CachedPercentageMetric, Target: 80}},
+			signals:    Signals{CurrentReplicas: 2, CacheCapacity: 8 * gi, Cached: 8 * gi, CachedRatio: ptr.To(0.4)},
+			want:       4,
+			wantReason: "CachedPercentage 40.0% (target 80)",
+		},
+		"cached percentage below target with free cache": {
+			metrics: []datav1alpha1.CacheAutoscalerMetric{{Type: datav1alpha1.CachedPercentageMetric, Target: 80}},
+			signals: Signals{CurrentReplicas: 2, CacheCapacity: 8 * gi, Cached: 2 * gi, CachedRatio: ptr.To(0.1)},
+			want:    2,
+		},
+		"cached percentage above target never scales in": {
+			metrics: []datav1alpha1.CacheAutoscalerMetric{{Type: datav1alpha1.CachedPercentageMetric, Target: 50}},
+			signals: Signals{CurrentReplicas: 4, CacheCapacity: 16 * gi, Cached: 16 * gi, CachedRatio: ptr.To(0.9)},
+			want:    4,
+		},
+		"cached percentage within tolerance": {
+			metrics: []datav1alpha1.CacheAutoscalerMetric{{Type: datav1alpha1.CachedPercentageMetric, Target: 80}},
+			signals: Signals{CurrentReplicas: 2, CacheCapacity: 8 * gi, Cached: 8 * gi, CachedRatio: ptr.To(0.76)},
+			want:    2,
+		},
+		"cached percentage unknown": {
+			metrics: []datav1alpha1.CacheAutoscalerMetric{{Type: datav1alpha1.CachedPercentageMetric, Target: 80}},
+			signals: Signals{CurrentReplicas: 2, CacheCapacity: 8 * gi, Cached: 8 * gi},
+			want:    2,
+		},
+		"cache capacity used above target": {
+			metrics: []datav1alpha1.CacheAutoscalerMetric{{Type: datav1alpha1.CacheCapacityUsedMetric, Target: 50}},
+			signals: Signals{CurrentReplicas: 2, CacheCapacity: 8 * gi, Cached: 6 * gi},
+			want:    3,
+		},
+		"cache capacity used within tolerance": {
+			metrics: []datav1alpha1.CacheAutoscalerMetric{{Type: datav1alpha1.CacheCapacityUsedMetric, Target: 50}},
+			signals: Signals{CurrentReplicas: 4, CacheCapacity: 100 * gi, Cached: 52 * gi},
+			want:    4,
+		},
+		"cache capacity used below target": {
+			metrics: []datav1alpha1.CacheAutoscalerMetric{{Type: datav1alpha1.CacheCapacityUsedMetric, Target: 80}},
+			signals: Signals{CurrentReplicas: 4, CacheCapacity: 16 * gi, Cached: 4 * gi},
+			want:    2,
+		},
+		"mounting pods": {
+			metrics: []datav1alpha1.CacheAutoscalerMetric{{Type: datav1alpha1.MountingPodsMetric, Target: 5}},
+			signals: Signals{CurrentReplicas: 1, MountingPods: 12},
+			want:    3,
+		},
+		"largest recommendation wins": {
+			metrics: []datav1alpha1.CacheAutoscalerMetric{
+				{Type: datav1alpha1.MountingPodsMetric, Target: 5},
+				{Type: datav1alpha1.CacheCapacityUsedMetric, Target: 50},
+			},
+			signals:    Signals{CurrentReplicas: 2, MountingPods: 12, CacheCapacity: 8 * gi, Cached: 6 * gi},
+			want:       3,
+			wantReason: "MountingPods 12 (target 5), CacheCapacityUsed 75.0% (target 50)",
+		},
+		"bounded by max replicas": {
+			metrics:    []datav1alpha1.CacheAutoscalerMetric{{Type: datav1alpha1.MountingPodsMetric, Target: 1}},
+			signals:    Signals{CurrentReplicas: 2, MountingPods: 100},
+			want:       10,
+			wantReason: "maxReplicas 10",
+		},
+		"bounded by min replicas": {
+			metrics:     []datav1alpha1.CacheAutoscalerMetric{{Type: datav1alpha1.MountingPodsMetric, Target: 1}},
+			signals:     Signals{CurrentReplicas: 2},
+			minReplicas: ptr.To[int32](2),
+			want:        2,
+			wantReason:  "minReplicas 2",
+		},
+	}
+
+	for name, tc := range testCases {
+		spec := datav1alpha1.CacheAutoscalerSpec{MinReplicas: tc.minReplicas, MaxReplicas: 10, Metrics: tc.metrics}
+		got := Recommend(spec, tc.signals)
+		if got.DesiredReplicas != tc.want {
+			t.Errorf("%s: Recommend() = %d, want %d", name, got.DesiredReplicas, tc.want)
+		}
+		if len(got.Metrics) != len(tc.metrics) {
+			t.Errorf("%s: Recommend() got %d metrics, want %d", name, len(got.Metrics), len(tc.metrics))
+		}
+		if len(tc.wantReason) > 0 && got.Reason != tc.wantReason {
+			t.Errorf("%s: Recommend() reason = %q, want %q", name, got.Reason, tc.wantReason)
+		}
+	}
+}
+
+func TestInCooldown(t *testing.T) {
+	now := time.Now()
+	lastScaleTime := metav1.NewTime(now.Add(-2 * time.Minute))
+
+	testCases := map[string]struct {
+		lastScaleTime  *metav1.Time
+		current        int32
+		desired        int32
+		wantInCooldown bool
+		wantRemaining  time.Duration
+	}{
+		"never scaled": {
+			current: 1, desired: 3,
+		},
+		"scale up after cooldown": {
+			lastScaleTime: &lastScaleTime, current: 1, desired: 3,
+		},
+		"scale down in cooldown": {
+			lastScaleTime: &lastScaleTime, current: 3, desired: 1,
+			wantInCooldown: true, wantRemaining: 3 * time.Minute,
+		},
+		"no scaling": {
+			lastScaleTime: &lastScaleTime, current: 3, desired: 3,
+		},
+	}
+
+	for name, tc := range testCases {
+		autoscaler := &datav1alpha1.CacheAutoscaler{Status: datav1alpha1.CacheAutoscalerStatus{LastScaleTime: tc.lastScaleTime}}
+		inCooldown, remaining := InCooldown(autoscaler, tc.current, tc.desired, now)
+		if inCooldown != tc.wantInCooldown || remaining != tc.wantRemaining {
+			t.Errorf("%s: InCooldown() = (%v, %v), want (%v, %v)", name, inCooldown, remaining, tc.wantInCooldown, tc.wantRemaining)
+		}
+	}
+}
+
+func TestIsUnappliedDecision(t *testing.T) {
+	autoscaler := &datav1alpha1.CacheAutoscaler{
+		Status: datav1alpha1.CacheAutoscalerStatus{
+			Decisions: []datav1alpha1.CacheAutoscalerDecision{{From: 2, To: 4}, {From: 1, To: 2}},
+		},
+	}
+
+	testCases := map[string]struct {
+		current int32
+		desired int32
+		want    bool
+	}{
+		"latest decision not applied": {current: 2, desired: 4, want: true},
+		"latest decision applied":     {current: 4, desired: 4},
+		"older decision":              {current: 1, desired: 2},
+		"new decision":                {current: 2, desired: 3},
+	}
+
+	for name, tc := range testCases {
+		if got := IsUnappliedDecision(autoscaler, tc.current, tc.desired); got != tc.want {
+			t.Errorf("%s: IsUnappliedDecision() = %v, want %v", name, got, tc.want)
+		}
+	}
+}
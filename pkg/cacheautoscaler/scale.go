@@ -0,0 +1,37 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cacheautoscaler
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+)
+
+// ScaleRuntime sets the worker replicas in the runtime's spec. The runtime controller reconciles the workers
+// as if the replicas were changed through the scale subresource.
+func ScaleRuntime(c client.Client, runtime client.Object, replicas int32) error {
+	patch := client.RawPatch(types.MergePatchType, []byte(fmt.Sprintf(`{"spec":{"replicas":%d}}`, replicas)))
+	if _, ok := runtime.(*datav1alpha1.CacheRuntime); ok {
+		patch = client.RawPatch(types.MergePatchType, []byte(fmt.Sprintf(`{"spec":{"worker":{"replicas":%d}}}`, replicas)))
+	}
+	return c.Patch(context.TODO(), runtime, patch)
+}
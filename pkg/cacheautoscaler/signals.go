@@ -0,0 +1,124 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cacheautoscaler
+
+import (
+	"fmt"
+	"strings"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/datasetquota"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/base"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Signals are the observations used to calculate the worker replicas of a runtime
+type Signals struct {
+	// CurrentReplicas is the worker replicas in the runtime's spec
+	CurrentReplicas int32
+	// UfsTotal is the total size of the data in the underlying file system in bytes
+	UfsTotal int64
+	// CacheCapacity is the total cache capacity of the workers in bytes
+	CacheCapacity int64
+	// Cached is the size of the data cached in bytes
+	Cached int64
+	// CachedRatio is the cached percentage of the data in the Dataset's cache states, from 0 to 1. It's nil if unknown.
+	CachedRatio *float64
+	// MountingPods is the number of running pods mounting the Dataset's PVC, including the ones mounting
+	// the Datasets referring to it in other namespaces
+	MountingPods int32
+}
+
+// GetTargetRuntime gets the runtime object referred by the autoscaler.
+func GetTargetRuntime(reader client.Reader, autoscaler *datav1alpha1.CacheAutoscaler) (runtime client.Object, err error) {
+	name, namespace := autoscaler.Spec.ScaleTargetRef.Name, autoscaler.Namespace
+	switch autoscaler.Spec.ScaleTargetRef.Kind {
+	case datav1alpha1.AlluxioRuntimeKind:
+		return utils.GetAlluxioRuntime(reader, name, namespace)
+	case datav1alpha1.JindoRuntimeKind:
+		return utils.GetJindoRuntime(reader, name, namespace)
+	case datav1alpha1.JuiceFSRuntimeKind:
+		return utils.GetJuiceFSRuntime(reader, name, namespace)
+	case datav1alpha1.VineyardRuntimeKind:
+		return utils.GetVineyardRuntime(reader, name, namespace)
+	case datav1alpha1.EFCRuntimeKind:
+		return utils.GetEFCRuntime(reader, name, namespace)
+	case datav1alpha1.ThinRuntimeKind:
+		return utils.GetThinRuntime(reader, name, namespace)
+	case datav1alpha1.CacheRuntimeKind:
+		return utils.GetCacheRuntime(reader, name, namespace)
+	}
+	return nil, fmt.Errorf("runtime kind %q is not supported by CacheAutoscaler", autoscaler.Spec.ScaleTargetRef.Kind)
+}
+
+// CollectSignals collects the signals of the runtime from the runtime itself, the Dataset bound to it and the pods
+// mounting the Dataset.
+func CollectSignals(c client.Client, runtime client.Object) (signals Signals, err error) {
+	signals.CurrentReplicas, _, _, err = datasetquota.WorkerCapacity(runtime)
+	if err != nil {
+		return signals, err
+	}
+
+	dataset, err := utils.GetDataset(c, runtime.GetName(), runtime.GetNamespace())
+	if err != nil {
+		return signals, err
+	}
+
+	states := dataset.Status.CacheStates
+	signals.UfsTotal, _ = base.ParseSizeBytes(dataset.Status.UfsTotal)
+	signals.CacheCapacity, _ = base.ParseCacheStateBytes(states, common.CacheCapacity)
+	cached, cachedFound := base.ParseCacheStateBytes(states, common.Cached)
+	signals.Cached = cached
+	signals.CachedRatio = base.ParseCacheStateRatio(states, common.CachedPercentage)
+	if signals.CachedRatio == nil && cachedFound && signals.UfsTotal > 0 {
+		// the runtime doesn't report the cached percentage, so calculate it from the cached bytes
+		ratio := float64(cached) / float64(signals.UfsTotal)
+		signals.CachedRatio = &ratio
+	}
+
+	signals.MountingPods, err = countMountingPods(c, dataset)
+	return signals, err
+}
+
+// countMountingPods counts the pods mounting the PVC of the dataset and the PVCs of the datasets referring to it.
+func countMountingPods(c client.Client, dataset *datav1alpha1.Dataset) (count int32, err error) {
+	pvcs := []types.NamespacedName{{Namespace: dataset.Namespace, Name: dataset.Name}}
+	for _, ref := range dataset.Status.DatasetRef {
+		namespace, name, found := strings.Cut(ref, "/")
+		if !found {
+			continue
+		}
+		pvcs = append(pvcs, types.NamespacedName{Namespace: namespace, Name: name})
+	}
+
+	for _, pvc := range pvcs {
+		pods, err := kubeclient.GetPvcMountPods(c, pvc.Name, pvc.Namespace)
+		if err != nil {
+			return 0, err
+		}
+		for i := range pods {
+			if !kubeclient.IsCompletePod(&pods[i]) {
+				count++
+			}
+		}
+	}
+	return count, nil
+}
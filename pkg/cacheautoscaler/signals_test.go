@@ -0,0 +1,188 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cacheautoscaler
+
+import (
+	"context"
+	"testing"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func mountingPod(name, namespace, claimName string, phase corev1.PodPhase) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Spec: corev1.PodSpec{
+			Volumes: []corev1.Volume{{
+				Name: "data",
+				VolumeSource: corev1.VolumeSource{
+					PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: claimName},
+				},
+			}},
+		},
+		Status: corev1.PodStatus{Phase: phase},
+	}
+}
+
+func TestCollectSignals(t *testing.T) {
+	s := runtime.NewScheme()
+	_ = datav1alpha1.AddToScheme(s)
+	_ = corev1.AddToScheme(s)
+
+	quota := resource.MustParse("4Gi")
+	runtimeObj := &datav1alpha1.AlluxioRuntime{
+		ObjectMeta: metav1.ObjectMeta{Name: "demo", Namespace: "fluid"},
+		Spec: datav1alpha1.AlluxioRuntimeSpec{
+			Replicas:    2,
+			TieredStore: datav1alpha1.TieredStore{Levels: []datav1alpha1.Level{{MediumType: common.SSD, Quota: &quota}}},
+		},
+	}
+	dataset := &datav1alpha1.Dataset{
+		ObjectMeta: metav1.ObjectMeta{Name: "demo", Namespace: "fluid"},
+		Status: datav1alpha1.DatasetStatus{
+			UfsTotal: "20.00GiB",
+			CacheStates: common.CacheStateList{
+				common.CacheCapacity:    "8.00GiB",
+				common.Cached:           "6.00GiB",
+				common.CachedPercentage: "30.0%",
+			},
+			DatasetRef: []string{"ref/shared"},
+		},
+	}
+
+	c := fake.NewFakeClientWithScheme(s, runtimeObj, dataset,
+		mountingPod("app-1", "fluid", "demo", corev1.PodRunning),
+		mountingPod("app-2", "fluid", "demo", corev1.PodSucceeded),
+		mountingPod("app-3", "fluid", "other", corev1.PodRunning),
+		mountingPod("app-4", "ref", "shared", corev1.PodPending))
+
+	autoscaler := &datav1alpha1.CacheAutoscaler{
+		ObjectMeta: metav1.ObjectMeta{Name: "demo", Namespace: "fluid"},
+		Spec: datav1alpha1.CacheAutoscalerSpec{
+			ScaleTargetRef: datav1alpha1.CacheAutoscalerTargetRef{Kind: datav1alpha1.AlluxioRuntimeKind, Name: "demo"},
+		},
+	}
+	target, err := GetTargetRuntime(c, autoscaler)
+	if err != nil {
+		t.Fatalf("GetTargetRuntime() got error: %v", err)
+	}
+
+	signals, err := CollectSignals(c, target)
+	if err != nil {
+		t.Fatalf("CollectSignals() got error: %v", err)
+	}
+	if signals.CurrentReplicas != 2 || signals.UfsTotal != 20*gi ||
+		signals.CacheCapacity != 8*gi || signals.Cached != 6*gi || signals.MountingPods != 2 {
+		t.Errorf("CollectSignals() = %+v", signals)
+	}
+	if signals.CachedRatio == nil || *signals.CachedRatio != 0.3 {
+		t.Errorf("CollectSignals() got cached ratio %v, want 0.3", signals.CachedRatio)
+	}
+
+	if err = ScaleRuntime(c, target, 4); err != nil {
+		t.Fatalf("ScaleRuntime() got error: %v", err)
+	}
+	scaled := &datav1alpha1.AlluxioRuntime{}
+	if err = c.Get(context.TODO(), types.NamespacedName{Name: "demo", Namespace: "fluid"}, scaled); err != nil {
+		t.Fatalf("failed to get runtime: %v", err)
+	}
+	if scaled.Spec.Replicas != 4 {
+		t.Errorf("ScaleRuntime() got replicas %d, want 4", scaled.Spec.Replicas)
+	}
+}
+
+func TestCollectSignalsWithoutCachedPercentage(t *testing.T) {
+	s := runtime.NewScheme()
+	_ = datav1alpha1.AddToScheme(s)
+	_ = corev1.AddToScheme(s)
+
+	runtimeObj := &datav1alpha1.JuiceFSRuntime{
+		ObjectMeta: metav1.ObjectMeta{Name: "demo", Namespace: "fluid"},
+		Spec:       datav1alpha1.JuiceFSRuntimeSpec{Replicas: 1},
+	}
+	dataset := &datav1alpha1.Dataset{
+		ObjectMeta: metav1.ObjectMeta{Name: "demo", Namespace: "fluid"},
+		Status: datav1alpha1.DatasetStatus{
+			UfsTotal:    "20.00GiB",
+			CacheStates: common.CacheStateList{common.Cached: "5.00GiB"},
+		},
+	}
+	c := fake.NewFakeClientWithScheme(s, runtimeObj, dataset)
+
+	signals, err := CollectSignals(c, runtimeObj)
+	if err != nil {
+		t.Fatalf("CollectSignals() got error: %v", err)
+	}
+	if signals.CachedRatio == nil || *signals.CachedRatio != 0.25 {
+		t.Errorf("CollectSignals() got cached ratio %v, want 0.25", signals.CachedRatio)
+	}
+}
+
+func TestScaleCacheRuntime(t *testing.T) {
+	s := runtime.NewScheme()
+	_ = datav1alpha1.AddToScheme(s)
+	runtimeObj := &datav1alpha1.CacheRuntime{
+		ObjectMeta: metav1.ObjectMeta{Name: "demo", Namespace: "fluid"},
+		Spec:       datav1alpha1.CacheRuntimeSpec{Worker: datav1alpha1.CacheRuntimeWorkerSpec{Replicas: 1}},
+	}
+	c := fake.NewFakeClientWithScheme(s, runtimeObj)
+
+	autoscaler := &datav1alpha1.CacheAutoscaler{
+		ObjectMeta: metav1.ObjectMeta{Name: "demo", Namespace: "fluid"},
+		Spec: datav1alpha1.CacheAutoscalerSpec{
+			ScaleTargetRef: datav1alpha1.CacheAutoscalerTargetRef{Kind: datav1alpha1.CacheRuntimeKind, Name: "demo"},
+		},
+	}
+	target, err := GetTargetRuntime(c, autoscaler)
+	if err != nil {
+		t.Fatalf("GetTargetRuntime() got error: %v", err)
+	}
+
+	if err = ScaleRuntime(c, target, 3); err != nil {
+		t.Fatalf("ScaleRuntime() got error: %v", err)
+	}
+	scaled := &datav1alpha1.CacheRuntime{}
+	if err = c.Get(context.TODO(), types.NamespacedName{Name: "demo", Namespace: "fluid"}, scaled); err != nil {
+		t.Fatalf("failed to get runtime: %v", err)
+	}
+	if scaled.Spec.Worker.Replicas != 3 {
+		t.Errorf("ScaleRuntime() got worker replicas %d, want 3", scaled.Spec.Worker.Replicas)
+	}
+}
+
+func TestGetTargetRuntimeWithUnsupportedKind(t *testing.T) {
+	s := runtime.NewScheme()
+	_ = datav1alpha1.AddToScheme(s)
+	c := fake.NewFakeClientWithScheme(s)
+
+	autoscaler := &datav1alpha1.CacheAutoscaler{
+		ObjectMeta: metav1.ObjectMeta{Name: "demo", Namespace: "fluid"},
+		Spec: datav1alpha1.CacheAutoscalerSpec{
+			ScaleTargetRef: datav1alpha1.CacheAutoscalerTargetRef{Kind: "GooseFSRuntime", Name: "demo"},
+		},
+	}
+	if _, err := GetTargetRuntime(c, autoscaler); err == nil {
+		t.Errorf("GetTargetRuntime() expects error for kind GooseFSRuntime")
+	}
+}
@@ -40,11 +40,49 @@ const (
 
 	FuseUmountDuplicate = "UnmountDuplicateMountpoint"
 
+	FuseRecoverDryRun = "FuseRecoverDryRun"
+
+	FuseRecoverNeedRestart = "FuseRecoverNeedRestart"
+
 	RuntimeDeprecated = "RuntimeDeprecated"
 
 	RuntimeWithSecretNotSupported = "RuntimeWithSecretNotSupported"
 
 	RuntimeMountUfsFailed = "RuntimeMountUfsFailed"
+
+	RuntimeDriftCorrected = "RuntimeDriftCorrected"
+
+	DatasetQuotaExceeded = "DatasetQuotaExceeded"
+
+	DatasetShareNotGranted = "DatasetShareNotGranted"
+
+	CredentialsRefreshed = "CredentialsRefreshed"
+
+	CredentialsRefreshFailed = "CredentialsRefreshFailed"
+
+	CacheAutoscalerScaled = "CacheAutoscalerScaled"
+
+	CacheAutoscalerFailed = "CacheAutoscalerFailed"
+
+	WorkerDecommissioning = "WorkerDecommissioning"
+
+	WorkerCacheHandedOff = "WorkerCacheHandedOff"
+
+	WorkerCacheHandoffTimeout = "WorkerCacheHandoffTimeout"
+
+	FuseUpgradeNodeDraining = "FuseUpgradeNodeDraining"
+
+	FuseUpgradeNodeUpgrading = "FuseUpgradeNodeUpgrading"
+
+	FuseUpgradeCompleted = "FuseUpgradeCompleted"
+
+	FuseUpgradeFailed = "FuseUpgradeFailed"
+
+	DatasetSnapshotCompleted = "DatasetSnapshotCompleted"
+
+	DatasetSnapshotFailed = "DatasetSnapshotFailed"
+
+	DatasetSnapshotNotReady = "DatasetSnapshotNotReady"
 )
 
 // Events related to all type of Data Operations
@@ -67,7 +105,15 @@ const (
 
 	DataOperationCollision = "DataOperationCollision"
 
+	DataOperationRetrying = "DataOperationRetrying"
+
+	DataOperationQueued = "DataOperationQueued"
+
 	TargetSSHSecretNameNotSet = "TargetSSHSecretNameNotSet"
+
+	ResumeManifestVolumeClaimNotSet = "ResumeManifestVolumeClaimNotSet"
+
+	ResumeNotSupported = "ResumeNotSupported"
 )
 
 // Events related to dataflow
@@ -75,6 +121,18 @@ const (
 	DataOperationNotFound = "DataOperationNotFound"
 
 	DataOperationWaiting = "DataOperationWaiting"
+
+	DataOperationEventSourceInvalid = "EventSourceInvalid"
+
+	DataFlowStepStarted = "DataFlowStepStarted"
+
+	DataFlowStepFailed = "DataFlowStepFailed"
+
+	DataFlowRunSkipped = "DataFlowRunSkipped"
+
+	DataFlowCompleted = "DataFlowCompleted"
+
+	DataFlowFailed = "DataFlowFailed"
 )
 
 // Events related to DataLoad
@@ -88,6 +146,10 @@ const (
 	DataLoadJobFailed = "DataLoadJobFailed"
 
 	DataLoadJobComplete = "DataLoadJobComplete"
+
+	DataLoadTargetNodesNotCached = "DataLoadTargetNodesNotCached"
+
+	DataLoadTargetNodesNotSupported = "DataLoadTargetNodesNotSupported"
 )
 
 // Events related to DataMigrate
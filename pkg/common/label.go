@@ -86,6 +86,14 @@ const (
 	// AnnotationDisableRuntimeHelmValueConfig is a runtime label indicates the configmap contains helm value will not be created in setup.
 	AnnotationDisableRuntimeHelmValueConfig = "runtime." + LabelAnnotationPrefix + "disable-helm-value-config"
 
+	// AnnotationRenderMode is an annotation on runtimes and data operations to select how they are deployed, "helm" or "apply".
+	// i.e. render.fluid.io/mode
+	AnnotationRenderMode = "render." + LabelAnnotationPrefix + "mode"
+
+	// LabelAppliedRelease is a label on the inventory ConfigMap of a release deployed in apply render mode.
+	// i.e. fluid.io/applied-release
+	LabelAppliedRelease = LabelAnnotationPrefix + "applied-release"
+
 	// LabelAnnotationMountingDatasets is a label/annotation key indicating which datasets are currently being used by a pod.
 	// i.e. fluid.io/datasets-in-use
 	LabelAnnotationDatasetsInUse = LabelAnnotationPrefix + "datasets-in-use"
@@ -98,6 +106,44 @@ const (
 	// i.e. controller.runtime.fluid.io/replicas
 	RuntimeControllerReplicas = "controller.runtime." + LabelAnnotationPrefix + "replicas"
 
+	// i.e. dataset.fluid.io/creator, the user creating the dataset, which is set by the webhook and can't be changed
+	AnnotationDatasetCreator = "dataset." + LabelAnnotationPrefix + "creator"
+
+	// i.e. decommission.worker.fluid.io/since, the time when a worker pod started to hand off its cache on scale-in
+	AnnotationWorkerDecommissioningSince = "decommission.worker." + LabelAnnotationPrefix + "since"
+
+	// i.e. decommission.worker.fluid.io/handed-off, set once the cache of a decommissioning worker pod is handed off
+	AnnotationWorkerCacheHandedOff = "decommission.worker." + LabelAnnotationPrefix + "handed-off"
+
+	// i.e. decommission.worker.fluid.io/target, the worker pod to which a decommissioning worker pod hands off its cache
+	AnnotationWorkerCacheHandoffTarget = "decommission.worker." + LabelAnnotationPrefix + "target"
+
+	// i.e. draining.fuse.fluid.io/<namespace>-<name>, labeled on the nodes drained for the fuse upgrade of a runtime
+	LabelFuseUpgradeDrainingPrefix = "draining.fuse." + LabelAnnotationPrefix
+
+	// i.e. credentials.fluid.io/managed, labeled on the secrets holding the credentials exchanged from credential providers
+	LabelCredentialsManaged = "credentials." + LabelAnnotationPrefix + "managed"
+
+	// i.e. credentials.fluid.io/expiration, the time when the credentials in the secret expire
+	AnnotationCredentialsExpiration = "credentials." + LabelAnnotationPrefix + "expiration"
+
+	// i.e. credentials.fluid.io/refresh-after, the time after which the credentials in the secret are refreshed
+	AnnotationCredentialsRefreshAfter = "credentials." + LabelAnnotationPrefix + "refresh-after"
+
+	// i.e. credentials.fluid.io/options-hash, the hash of the encrypt options the credentials are exchanged for
+	AnnotationCredentialsOptionsHash = "credentials." + LabelAnnotationPrefix + "options-hash"
+
+	// i.e. credentials.fluid.io/datasets, annotated on a service account by its owner with the comma separated names
+	// of the datasets in its namespace allowed to exchange credentials with its tokens, or "*" for all of them
+	AnnotationCredentialsDatasets = "credentials." + LabelAnnotationPrefix + "datasets"
+
+	// i.e. credentials.fluid.io/refreshed-at, annotated on the pod templates of a runtime to restart its pods
+	// once the credentials they take at startup are refreshed
+	AnnotationCredentialsRefreshedAt = "credentials." + LabelAnnotationPrefix + "refreshed-at"
+
+	// i.e. snapshot.fluid.io/name, labeled on the pods and configmaps created for a DatasetSnapshot
+	LabelDatasetSnapshotName = "snapshot." + LabelAnnotationPrefix + "name"
+
 	// i.e. prometheus.fuse.fluid.io/scrape
 	AnnotationPrometheusFuseMetricsScrapeKey = "prometheus.fuse." + LabelAnnotationPrefix + "scrape"
 
@@ -120,6 +166,23 @@ const (
 	// AnnotationDataFlowCustomizedAffinityPrefix is a prefix used to
 	// i.e. affinity.dataflow.fluid.io.
 	AnnotationDataFlowCustomizedAffinityPrefix = "affinity.dataflow.fluid.io."
+
+	// AnnotationDataFlowEventScopePrefix is an annotation prefix representing dataflow event trigger related functions.
+	// i.e. event.dataflow.fluid.io/
+	AnnotationDataFlowEventScopePrefix = "event.dataflow." + LabelAnnotationPrefix
+	// AnnotationDataFlowUFSChanged is an annotation recording the last UFS change notification delivered to an operation, for internal use.
+	// i.e. event.dataflow.fluid.io/ufs-changed
+	AnnotationDataFlowUFSChanged = AnnotationDataFlowEventScopePrefix + "ufs-changed"
+
+	// LabelDataFlowName is a label key labeled on the data operations created for the steps of a DataFlow.
+	// i.e. dataflow.fluid.io/name
+	LabelDataFlowName = "dataflow." + LabelAnnotationPrefix + "name"
+	// LabelDataFlowStep is a label key recording the step of the DataFlow a data operation is created for.
+	// i.e. dataflow.fluid.io/step
+	LabelDataFlowStep = "dataflow." + LabelAnnotationPrefix + "step"
+	// LabelDataFlowRun is a label key recording the run of the DataFlow a data operation is created in.
+	// i.e. dataflow.fluid.io/run
+	LabelDataFlowRun = "dataflow." + LabelAnnotationPrefix + "run"
 )
 
 const (
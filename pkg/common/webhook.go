@@ -24,7 +24,15 @@ import (
 const (
 	WebhookName            = "fluid-pod-admission-webhook"
 	WebhookServiceName     = "fluid-pod-admission-webhook"
+	WebhookServicePort     = 9443
 	WebhookSchedulePodPath = "mutate-fluid-io-v1alpha1-schedulepod"
+	WebhookConvertPath     = "convert"
+
+	WebhookMutateDatasetPath = "mutate-fluid-io-v1alpha1-dataset"
+
+	WebhookValidateRuntimePath = "validate-fluid-io-v1alpha1-runtime"
+	WebhookValidateDatasetPath = "validate-fluid-io-v1alpha1-dataset"
+	WebhookValidateSpecPath    = "validate-fluid-io-v1alpha1-spec"
 
 	CertSecretName = "fluid-webhook-certs"
 
@@ -23,6 +23,7 @@ import (
 
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/trace"
 	v1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -39,6 +40,7 @@ import (
 	"github.com/fluid-cloudnative/fluid/pkg/utils"
 	"github.com/fluid-cloudnative/fluid/pkg/utils/helm"
 	jindoutils "github.com/fluid-cloudnative/fluid/pkg/utils/jindo"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/tracing"
 )
 
 // OperationReconciler is the default implementation
@@ -120,7 +122,7 @@ func (o *OperationReconciler) ReconcileDeletion(ctx dataoperation.ReconcileReque
 	return utils.NoRequeue()
 }
 
-func (o *OperationReconciler) ReconcileInternal(ctx dataoperation.ReconcileRequestContext) (ctrl.Result, error) {
+func (o *OperationReconciler) ReconcileInternal(ctx dataoperation.ReconcileRequestContext) (result ctrl.Result, err error) {
 	var object = ctx.DataObject
 	implement, err := o.implementBuilder.Build(object)
 
@@ -129,6 +131,12 @@ func (o *OperationReconciler) ReconcileInternal(ctx dataoperation.ReconcileReque
 		return utils.RequeueIfError(err)
 	}
 
+	// Trace the reconciliation
+	var span trace.Span
+	ctx.Context, span = tracing.StartSpan(ctx.Context, "OperationReconciler.Reconcile",
+		tracing.OperationAttributes(string(implement.GetOperationType()), object.GetNamespace(), object.GetName())...)
+	defer func() { tracing.EndSpan(span, err) }()
+
 	// 1. Reconcile deletion of the object if necessary
 	if !object.GetDeletionTimestamp().IsZero() {
 		return o.ReconcileDeletion(ctx, implement)
@@ -158,6 +166,7 @@ func (o *OperationReconciler) ReconcileInternal(ctx dataoperation.ReconcileReque
 		Name:      targetDataset.Name,
 	}
 	ctx.Dataset = targetDataset
+	span.SetAttributes(tracing.DatasetAttributes(targetDataset.Namespace, targetDataset.Name)...)
 
 	// 3. set target runtime and runtimeType
 	index, boundedRuntime := utils.GetRuntimeByCategory(targetDataset.Status.Runtimes, common.AccelerateCategory)
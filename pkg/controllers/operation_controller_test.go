@@ -107,6 +107,7 @@ func (m *mockOperationInterface) RemoveTargetDatasetStatusInProgress(dataset *da
 }
 func (m *mockOperationInterface) GetStatusHandler() dataoperation.StatusHandler { return nil }
 func (m *mockOperationInterface) GetTTL() (ttl *int32, err error)               { return nil, nil }
+func (m *mockOperationInterface) GetRetryPolicy() *datav1alpha1.RetryPolicy     { return nil }
 func (m *mockOperationInterface) GetParallelTaskNumber() int32                  { return 1 }
 
 var _ = Describe("NewDataOperationReconciler", func() {
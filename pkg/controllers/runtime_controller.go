@@ -47,6 +47,8 @@ import (
 	"github.com/fluid-cloudnative/fluid/pkg/common"
 	"github.com/fluid-cloudnative/fluid/pkg/ddc/base"
 	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/tracing"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // var _ RuntimeReconcilerInterface = (*RuntimeReconciler)(nil)
@@ -76,13 +78,19 @@ func NewRuntimeReconciler(reconciler RuntimeReconcilerInterface, client client.C
 }
 
 // ReconcileInternal handles the logic of reconcile runtime
-func (r *RuntimeReconciler) ReconcileInternal(ctx cruntime.ReconcileRequestContext) (ctrl.Result, error) {
+func (r *RuntimeReconciler) ReconcileInternal(ctx cruntime.ReconcileRequestContext) (result ctrl.Result, err error) {
 
 	// 0. Set context time limit
 	ctxWithTimeout, cancel := context.WithTimeout(ctx.Context, reconcileTimeout)
 	defer cancel()
 	ctx.Context = ctxWithTimeout
 
+	// Trace the reconciliation
+	var span trace.Span
+	ctx.Context, span = tracing.StartSpan(ctx.Context, "RuntimeReconciler.Reconcile",
+		tracing.RuntimeAttributes(ctx.RuntimeType, ctx.Namespace, ctx.Name)...)
+	defer func() { tracing.EndSpan(span, err) }()
+
 	// 1.Get the runtime
 	runtime := ctx.Runtime
 	if runtime == nil {
@@ -124,6 +132,9 @@ func (r *RuntimeReconciler) ReconcileInternal(ctx cruntime.ReconcileRequestConte
 		}
 	}
 	ctx.Dataset = dataset
+	if dataset != nil {
+		span.SetAttributes(tracing.DatasetAttributes(dataset.Namespace, dataset.Name)...)
+	}
 
 	// 6.Reconcile delete the runtime
 	// it should be after getting the dataset because need to edit the dataset during deleting
@@ -164,6 +175,14 @@ func (r *RuntimeReconciler) ReconcileInternal(ctx cruntime.ReconcileRequestConte
 			return utils.RequeueAfterInterval(time.Duration(20 * time.Second))
 		}
 
+		// wait until the mounts of the dataset are filled in from the snapshot it's mounted from
+		if dataset.IsWaitingForSnapshot() {
+			ctx.Log.Info("the dataset is waiting for the snapshot to mount from", "snapshot", dataset.Spec.SnapshotRef.Name)
+			r.Recorder.Eventf(runtime, corev1.EventTypeWarning, common.DatasetSnapshotNotReady,
+				"the dataset is waiting for DatasetSnapshot %s to mount from", dataset.Spec.SnapshotRef.Name)
+			return utils.RequeueAfterInterval(time.Duration(5 * time.Second))
+		}
+
 		// 8. Add Finalizer of runtime and requeue
 		if !utils.ContainsString(objectMeta.GetFinalizers(), ctx.FinalizerName) {
 			return r.implement.AddFinalizerAndRequeue(ctx, ctx.FinalizerName)
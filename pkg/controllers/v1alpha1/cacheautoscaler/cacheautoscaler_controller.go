@@ -0,0 +1,196 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cacheautoscaler
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/cacheautoscaler"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+)
+
+const (
+	controllerName = "CacheAutoscalerController"
+
+	// maxDecisions is the number of the recent decisions kept in the status
+	maxDecisions = 10
+)
+
+// CacheAutoscalerReconciler reconciles a CacheAutoscaler object
+type CacheAutoscalerReconciler struct {
+	client.Client
+	Recorder     record.EventRecorder
+	Log          logr.Logger
+	ResyncPeriod time.Duration
+}
+
+// NewCacheAutoscalerReconciler creates the reconciler which periodically calculates the worker replicas
+// of the runtimes targeted by CacheAutoscalers and scales them.
+func NewCacheAutoscalerReconciler(client client.Client,
+	log logr.Logger,
+	recorder record.EventRecorder,
+	resyncPeriod time.Duration) *CacheAutoscalerReconciler {
+	return &CacheAutoscalerReconciler{
+		Client:       client,
+		Recorder:     recorder,
+		Log:          log,
+		ResyncPeriod: resyncPeriod,
+	}
+}
+
+// +kubebuilder:rbac:groups=data.fluid.io,resources=cacheautoscalers,verbs=get;list;watch
+// +kubebuilder:rbac:groups=data.fluid.io,resources=cacheautoscalers/status,verbs=get;update;patch
+
+func (r *CacheAutoscalerReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := r.Log.WithValues("cacheautoscaler", req.NamespacedName)
+	log.V(1).Info("process the request", "request", req)
+
+	autoscaler := &datav1alpha1.CacheAutoscaler{}
+	if err := r.Get(ctx, req.NamespacedName, autoscaler); err != nil {
+		if utils.IgnoreNotFound(err) == nil {
+			log.V(1).Info("Not found.")
+			return utils.NoRequeue()
+		}
+		log.Error(err, "failed to get cacheautoscaler")
+		return utils.RequeueIfError(err)
+	}
+
+	if utils.HasDeletionTimestamp(autoscaler.ObjectMeta) {
+		return utils.NoRequeue()
+	}
+
+	targetRef := autoscaler.Spec.ScaleTargetRef
+	runtime, err := cacheautoscaler.GetTargetRuntime(r.Client, autoscaler)
+	if err != nil {
+		if utils.IgnoreNotFound(err) == nil {
+			r.Recorder.Eventf(autoscaler, v1.EventTypeWarning, common.CacheAutoscalerFailed,
+				"Target %s %s is not found", targetRef.Kind, targetRef.Name)
+			return utils.RequeueAfterInterval(r.ResyncPeriod)
+		}
+		log.Error(err, "failed to get the target runtime")
+		return utils.RequeueIfError(err)
+	}
+
+	signals, err := cacheautoscaler.CollectSignals(r.Client, runtime)
+	if err != nil {
+		log.Error(err, "failed to collect the signals of the target runtime")
+		return utils.RequeueIfError(err)
+	}
+
+	recommendation := cacheautoscaler.Recommend(autoscaler.Spec, signals)
+	current, desired := signals.CurrentReplicas, recommendation.DesiredReplicas
+	log.V(1).Info("calculated the worker replicas", "current", current, "desired", desired, "reason", recommendation.Reason)
+
+	now := time.Now()
+	requeueAfter := r.ResyncPeriod
+	if current != desired {
+		// the decision recorded but not applied is applied again regardless of the cooldown window it started
+		unapplied := cacheautoscaler.IsUnappliedDecision(autoscaler, current, desired)
+		if inCooldown, remaining := cacheautoscaler.InCooldown(autoscaler, current, desired, now); inCooldown && !unapplied {
+			log.V(1).Info("skip scaling in the cooldown window", "remaining", remaining)
+			if remaining < requeueAfter {
+				requeueAfter = remaining
+			}
+		} else {
+			if !unapplied {
+				// record the decision before scaling, otherwise the cooldown window is lost if the status
+				// fails to update after the runtime is scaled, and the next loop scales the runtime again
+				decision := &datav1alpha1.CacheAutoscalerDecision{
+					Time:   metav1.NewTime(now),
+					From:   current,
+					To:     desired,
+					Reason: recommendation.Reason,
+				}
+				if err = r.updateStatus(ctx, req, current, recommendation, decision); err != nil {
+					log.Error(err, "failed to record the decision in the status of cacheautoscaler")
+					return utils.RequeueIfError(err)
+				}
+			}
+			if err = cacheautoscaler.ScaleRuntime(r.Client, runtime, desired); err != nil {
+				r.Recorder.Eventf(autoscaler, v1.EventTypeWarning, common.CacheAutoscalerFailed,
+					"Failed to scale %s %s from %d to %d: %v", targetRef.Kind, targetRef.Name, current, desired, err)
+				return utils.RequeueIfError(err)
+			}
+			r.Recorder.Eventf(autoscaler, v1.EventTypeNormal, common.CacheAutoscalerScaled,
+				"Scaled %s %s from %d to %d: %s", targetRef.Kind, targetRef.Name, current, desired, recommendation.Reason)
+			current = desired
+		}
+	}
+
+	if err = r.updateStatus(ctx, req, current, recommendation, nil); err != nil {
+		log.Error(err, "failed to update the status of cacheautoscaler")
+		return utils.RequeueIfError(err)
+	}
+
+	return utils.RequeueAfterInterval(requeueAfter)
+}
+
+// updateStatus records the observed metrics and the decision in the status of the autoscaler.
+func (r *CacheAutoscalerReconciler) updateStatus(ctx context.Context,
+	req ctrl.Request,
+	currentReplicas int32,
+	recommendation cacheautoscaler.Recommendation,
+	decision *datav1alpha1.CacheAutoscalerDecision) error {
+	return retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		autoscaler := &datav1alpha1.CacheAutoscaler{}
+		if err := r.Get(ctx, req.NamespacedName, autoscaler); err != nil {
+			return err
+		}
+
+		autoscalerToUpdate := autoscaler.DeepCopy()
+		autoscalerToUpdate.Status.CurrentReplicas = currentReplicas
+		autoscalerToUpdate.Status.DesiredReplicas = recommendation.DesiredReplicas
+		autoscalerToUpdate.Status.CurrentMetrics = recommendation.Metrics
+		if decision != nil {
+			autoscalerToUpdate.Status.LastScaleTime = decision.Time.DeepCopy()
+			decisions := append([]datav1alpha1.CacheAutoscalerDecision{*decision}, autoscaler.Status.Decisions...)
+			if len(decisions) > maxDecisions {
+				decisions = decisions[:maxDecisions]
+			}
+			autoscalerToUpdate.Status.Decisions = decisions
+		}
+		if equality.Semantic.DeepEqual(autoscaler.Status, autoscalerToUpdate.Status) {
+			return nil
+		}
+
+		return r.Status().Update(ctx, autoscalerToUpdate)
+	})
+}
+
+func (r *CacheAutoscalerReconciler) SetupWithManager(mgr ctrl.Manager, options controller.Options) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		WithOptions(options).
+		For(&datav1alpha1.CacheAutoscaler{}).
+		Complete(r)
+}
+
+func (r *CacheAutoscalerReconciler) ControllerName() string {
+	return controllerName
+}
@@ -0,0 +1,173 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cacheautoscaler
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+)
+
+var _ = Describe("CacheAutoscalerReconciler", func() {
+	var (
+		s          *runtime.Scheme
+		recorder   *record.FakeRecorder
+		key        = types.NamespacedName{Name: "demo", Namespace: "fluid"}
+		runtimeKey = types.NamespacedName{Name: "demo", Namespace: "fluid"}
+	)
+
+	newAutoscaler := func(lastScaleTime *metav1.Time) *datav1alpha1.CacheAutoscaler {
+		return &datav1alpha1.CacheAutoscaler{
+			ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace},
+			Spec: datav1alpha1.CacheAutoscalerSpec{
+				ScaleTargetRef: datav1alpha1.CacheAutoscalerTargetRef{Kind: datav1alpha1.AlluxioRuntimeKind, Name: runtimeKey.Name},
+				MinReplicas:    ptr.To[int32](1),
+				MaxReplicas:    5,
+				Metrics: []datav1alpha1.CacheAutoscalerMetric{
+					{Type: datav1alpha1.CacheCapacityUsedMetric, Target: 50},
+				},
+			},
+			Status: datav1alpha1.CacheAutoscalerStatus{LastScaleTime: lastScaleTime},
+		}
+	}
+
+	newObjects := func() []runtime.Object {
+		q := resource.MustParse("4Gi")
+		return []runtime.Object{
+			&datav1alpha1.Dataset{
+				ObjectMeta: metav1.ObjectMeta{Name: runtimeKey.Name, Namespace: runtimeKey.Namespace},
+				Status: datav1alpha1.DatasetStatus{
+					CacheStates: common.CacheStateList{common.CacheCapacity: "8.00GiB", common.Cached: "8.00GiB"},
+				},
+			},
+			&datav1alpha1.AlluxioRuntime{
+				ObjectMeta: metav1.ObjectMeta{Name: runtimeKey.Name, Namespace: runtimeKey.Namespace},
+				Spec: datav1alpha1.AlluxioRuntimeSpec{
+					Replicas:    2,
+					TieredStore: datav1alpha1.TieredStore{Levels: []datav1alpha1.Level{{MediumType: common.SSD, Quota: &q}}},
+				},
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		s = runtime.NewScheme()
+		Expect(datav1alpha1.AddToScheme(s)).To(Succeed())
+		Expect(corev1.AddToScheme(s)).To(Succeed())
+		recorder = record.NewFakeRecorder(10)
+	})
+
+	It("should scale the runtime and record the decision", func() {
+		c := fake.NewFakeClientWithScheme(s, append(newObjects(), newAutoscaler(nil))...)
+		r := NewCacheAutoscalerReconciler(c, fake.NullLogger(), recorder, 15*time.Second)
+
+		result, err := r.Reconcile(context.TODO(), ctrl.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(15 * time.Second))
+
+		alluxioRuntime := &datav1alpha1.AlluxioRuntime{}
+		Expect(c.Get(context.TODO(), runtimeKey, alluxioRuntime)).To(Succeed())
+		Expect(alluxioRuntime.Spec.Replicas).To(Equal(int32(4)))
+
+		autoscaler := &datav1alpha1.CacheAutoscaler{}
+		Expect(c.Get(context.TODO(), key, autoscaler)).To(Succeed())
+		Expect(autoscaler.Status.CurrentReplicas).To(Equal(int32(4)))
+		Expect(autoscaler.Status.DesiredReplicas).To(Equal(int32(4)))
+		Expect(autoscaler.Status.CurrentMetrics).To(HaveLen(1))
+		Expect(autoscaler.Status.CurrentMetrics[0].Current).To(Equal("100.0%"))
+		Expect(autoscaler.Status.LastScaleTime).NotTo(BeNil())
+		Expect(autoscaler.Status.Decisions).To(HaveLen(1))
+		Expect(autoscaler.Status.Decisions[0].From).To(Equal(int32(2)))
+		Expect(autoscaler.Status.Decisions[0].To).To(Equal(int32(4)))
+		Expect(recorder.Events).To(Receive(ContainSubstring(common.CacheAutoscalerScaled)))
+	})
+
+	It("should not scale the runtime in the cooldown window", func() {
+		lastScaleTime := metav1.NewTime(time.Now().Add(-10 * time.Second))
+		c := fake.NewFakeClientWithScheme(s, append(newObjects(), newAutoscaler(&lastScaleTime))...)
+		r := NewCacheAutoscalerReconciler(c, fake.NullLogger(), recorder, 15*time.Second)
+
+		result, err := r.Reconcile(context.TODO(), ctrl.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(BeNumerically("<=", 15*time.Second))
+
+		alluxioRuntime := &datav1alpha1.AlluxioRuntime{}
+		Expect(c.Get(context.TODO(), runtimeKey, alluxioRuntime)).To(Succeed())
+		Expect(alluxioRuntime.Spec.Replicas).To(Equal(int32(2)))
+
+		autoscaler := &datav1alpha1.CacheAutoscaler{}
+		Expect(c.Get(context.TODO(), key, autoscaler)).To(Succeed())
+		Expect(autoscaler.Status.CurrentReplicas).To(Equal(int32(2)))
+		Expect(autoscaler.Status.DesiredReplicas).To(Equal(int32(4)))
+		Expect(autoscaler.Status.Decisions).To(BeEmpty())
+		Expect(recorder.Events).To(BeEmpty())
+	})
+
+	It("should scale the runtime by the recorded decision in the cooldown window", func() {
+		lastScaleTime := metav1.NewTime(time.Now().Add(-10 * time.Second))
+		autoscalerObj := newAutoscaler(&lastScaleTime)
+		autoscalerObj.Status.Decisions = []datav1alpha1.CacheAutoscalerDecision{{Time: lastScaleTime, From: 2, To: 4}}
+		c := fake.NewFakeClientWithScheme(s, append(newObjects(), autoscalerObj)...)
+		r := NewCacheAutoscalerReconciler(c, fake.NullLogger(), recorder, 15*time.Second)
+
+		_, err := r.Reconcile(context.TODO(), ctrl.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+
+		alluxioRuntime := &datav1alpha1.AlluxioRuntime{}
+		Expect(c.Get(context.TODO(), runtimeKey, alluxioRuntime)).To(Succeed())
+		Expect(alluxioRuntime.Spec.Replicas).To(Equal(int32(4)))
+
+		autoscaler := &datav1alpha1.CacheAutoscaler{}
+		Expect(c.Get(context.TODO(), key, autoscaler)).To(Succeed())
+		Expect(autoscaler.Status.CurrentReplicas).To(Equal(int32(4)))
+		Expect(autoscaler.Status.Decisions).To(HaveLen(1))
+		Expect(recorder.Events).To(Receive(ContainSubstring(common.CacheAutoscalerScaled)))
+	})
+
+	It("should record an event when the target runtime is not found", func() {
+		c := fake.NewFakeClientWithScheme(s, newAutoscaler(nil))
+		r := NewCacheAutoscalerReconciler(c, fake.NullLogger(), recorder, 15*time.Second)
+
+		result, err := r.Reconcile(context.TODO(), ctrl.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(15 * time.Second))
+		Expect(recorder.Events).To(Receive(ContainSubstring(common.CacheAutoscalerFailed)))
+	})
+
+	It("should not requeue when the autoscaler is not found", func() {
+		c := fake.NewFakeClientWithScheme(s)
+		r := NewCacheAutoscalerReconciler(c, fake.NullLogger(), recorder, 15*time.Second)
+
+		result, err := r.Reconcile(context.TODO(), ctrl.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(ctrl.Result{}))
+	})
+})
@@ -33,6 +33,7 @@ import (
 	"github.com/fluid-cloudnative/fluid/pkg/controllers"
 	cdatabackup "github.com/fluid-cloudnative/fluid/pkg/databackup"
 	"github.com/fluid-cloudnative/fluid/pkg/dataoperation"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/base"
 	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
 	"github.com/fluid-cloudnative/fluid/pkg/utils"
 )
@@ -108,6 +109,10 @@ func (r *DataBackupReconciler) Reconcile(context context.Context, req ctrl.Reque
 
 // SetupWithManager sets up the controller with the given controller manager
 func (r *DataBackupReconciler) SetupWithManager(mgr ctrl.Manager, options controller.Options) error {
+	// the operation queue of the runtime finds the data operations by their target datasets
+	if err := base.SetupDataOperationIndexer(context.Background(), mgr.GetFieldIndexer(), &datav1alpha1.DataBackup{}); err != nil {
+		return err
+	}
 	return ctrl.NewControllerManagedBy(mgr).
 		WithOptions(options).
 		For(&datav1alpha1.DataBackup{}).
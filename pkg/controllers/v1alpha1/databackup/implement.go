@@ -86,7 +86,7 @@ func (r *dataBackupOperation) Validate(ctx runtime.ReconcileRequestContext) ([]d
 	dataBackup := r.dataBackup
 
 	// 0. check the supported backup path format
-	if !strings.HasPrefix(dataBackup.Spec.BackupPath, common.PathScheme.String()) && !strings.HasPrefix(dataBackup.Spec.BackupPath, common.VolumeScheme.String()) {
+	if !cdatabackup.IsSupportedBackupPath(dataBackup.Spec.BackupPath) {
 		err := fmt.Errorf("don't support path in this form, path: %s", dataBackup.Spec.BackupPath)
 		return []datav1alpha1.Condition{
 			{
@@ -146,6 +146,11 @@ func (r *dataBackupOperation) GetTTL() (ttl *int32, err error) {
 	return
 }
 
+// GetRetryPolicy implements dataoperation.OperationInterface.
+func (r *dataBackupOperation) GetRetryPolicy() *datav1alpha1.RetryPolicy {
+	return nil
+}
+
 func (r *dataBackupOperation) GetParallelTaskNumber() int32 {
 	return 1
 }
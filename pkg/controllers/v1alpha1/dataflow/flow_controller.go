@@ -0,0 +1,561 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dataflow
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/robfig/cron/v3"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/dataflow"
+	"github.com/fluid-cloudnative/fluid/pkg/dataoperation"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/discovery"
+)
+
+const (
+	flowControllerName string = "FlowReconciler"
+	flowFinalizer      string = "fluid-dataflow-controller-finalizer"
+)
+
+// FlowReconciler reconciles DataFlow objects. It creates the data operations of the steps in the order of their
+// dependencies, while DataFlowReconciler lets each of the operations wait for the operation it runs after.
+type FlowReconciler struct {
+	client.Client
+	Recorder     record.EventRecorder
+	Log          logr.Logger
+	ResyncPeriod time.Duration
+}
+
+// NewFlowReconciler creates the reconciler which runs the steps of DataFlows
+func NewFlowReconciler(client client.Client,
+	log logr.Logger,
+	recorder record.EventRecorder,
+	resyncPeriod time.Duration) *FlowReconciler {
+	return &FlowReconciler{
+		Client:       client,
+		Recorder:     recorder,
+		Log:          log,
+		ResyncPeriod: resyncPeriod,
+	}
+}
+
+// +kubebuilder:rbac:groups=data.fluid.io,resources=dataflows,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=data.fluid.io,resources=dataflows/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=data.fluid.io,resources=dataloads;dataprocesses;datamigrates;databackups,verbs=get;list;watch;create;delete;patch
+
+func (r *FlowReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := r.Log.WithValues("dataflow", req.NamespacedName)
+	log.V(1).Info("process the request", "request", req)
+
+	flow := &datav1alpha1.DataFlow{}
+	if err := r.Get(ctx, req.NamespacedName, flow); err != nil {
+		if utils.IgnoreNotFound(err) == nil {
+			log.V(1).Info("Not found.")
+			return utils.NoRequeue()
+		}
+		log.Error(err, "failed to get dataflow")
+		return utils.RequeueIfError(err)
+	}
+
+	// the operations of the steps are garbage collected with the DataFlow once they're released
+	if utils.HasDeletionTimestamp(flow.ObjectMeta) {
+		return r.reconcileDeletion(ctx, log, flow)
+	}
+	if !utils.ContainsString(flow.GetFinalizers(), flowFinalizer) {
+		flowToUpdate := flow.DeepCopy()
+		flowToUpdate.Finalizers = append(flowToUpdate.Finalizers, flowFinalizer)
+		if err := r.Patch(ctx, flowToUpdate, client.MergeFrom(flow)); err != nil {
+			log.Error(err, "failed to add finalizer to dataflow")
+			return utils.RequeueIfError(err)
+		}
+		flow = flowToUpdate
+	}
+
+	// release the operations of the steps whose phases are recorded
+	if err := r.releaseOperations(ctx, flow); err != nil {
+		log.Error(err, "failed to release the operations of the finished steps")
+		return utils.RequeueIfError(err)
+	}
+
+	if errs := dataflow.ValidateFlowSpec(flow.Name, &flow.Spec, field.NewPath("spec")); len(errs) > 0 {
+		if flow.Status.Phase == common.PhaseFailed {
+			return utils.NoRequeue()
+		}
+		return r.finishRun(ctx, flow, common.PhaseFailed, "InvalidSpec", errs.ToAggregate().Error())
+	}
+
+	now := time.Now()
+	startRun, nextRunAfter, err := r.checkSchedule(ctx, flow, now)
+	if err != nil {
+		log.Error(err, "failed to check the schedule of dataflow")
+		return utils.RequeueIfError(err)
+	}
+	if startRun {
+		if err = r.startRun(ctx, flow, now); err != nil {
+			log.Error(err, "failed to start a new run of dataflow")
+			return utils.RequeueIfError(err)
+		}
+		return utils.RequeueImmediately()
+	}
+
+	if isRunFinished(flow) {
+		if nextRunAfter > 0 {
+			return utils.RequeueAfterInterval(nextRunAfter)
+		}
+		return utils.NoRequeue()
+	}
+
+	if err = r.runSteps(ctx, log, flow); err != nil {
+		log.Error(err, "failed to run the steps of dataflow")
+		return utils.RequeueIfError(err)
+	}
+	return utils.RequeueAfterInterval(r.ResyncPeriod)
+}
+
+// checkSchedule tells whether a new run should start. A DataFlow without a schedule runs once. A DataFlow with a
+// schedule runs at each schedule time, and the run is skipped if the previous one is not finished.
+func (r *FlowReconciler) checkSchedule(ctx context.Context, flow *datav1alpha1.DataFlow, now time.Time) (startRun bool, nextRunAfter time.Duration, err error) {
+	if len(flow.Spec.Schedule) == 0 {
+		return flow.Status.Run == 0, 0, nil
+	}
+
+	schedule, err := cron.ParseStandard(flow.Spec.Schedule)
+	if err != nil {
+		return false, 0, err
+	}
+	lastScheduleTime := flow.CreationTimestamp.Time
+	if flow.Status.LastScheduleTime != nil {
+		lastScheduleTime = flow.Status.LastScheduleTime.Time
+	}
+	if next := schedule.Next(lastScheduleTime); now.Before(next) {
+		return false, next.Sub(now), nil
+	}
+
+	if flow.Status.Run == 0 || isRunFinished(flow) {
+		return true, 0, nil
+	}
+
+	r.Recorder.Eventf(flow, v1.EventTypeWarning, common.DataFlowRunSkipped, "Skipped the scheduled run because run %d is not finished", flow.Status.Run)
+	scheduleTime := metav1.NewTime(now)
+	err = r.updateStatus(ctx, client.ObjectKeyFromObject(flow), func(status *datav1alpha1.DataFlowStatus) {
+		status.LastScheduleTime = &scheduleTime
+	})
+	return false, schedule.Next(now).Sub(now), err
+}
+
+// startRun removes the operations of the previous runs and resets the status of the steps for a new run.
+func (r *FlowReconciler) startRun(ctx context.Context, flow *datav1alpha1.DataFlow, now time.Time) error {
+	for _, kind := range operationKinds(flow) {
+		list := newOperationList(kind)
+		if err := r.List(ctx, list, client.InNamespace(flow.Namespace), client.MatchingLabels{common.LabelDataFlowName: flow.Name}); err != nil {
+			return err
+		}
+		items, err := apimeta.ExtractList(list)
+		if err != nil {
+			return err
+		}
+		for _, item := range items {
+			if err = r.releaseOperation(ctx, item.(client.Object)); err != nil {
+				return err
+			}
+			if err = r.Delete(ctx, item.(client.Object)); utils.IgnoreNotFound(err) != nil {
+				return err
+			}
+		}
+	}
+
+	startTime := metav1.NewTime(now)
+	return r.updateStatus(ctx, client.ObjectKeyFromObject(flow), func(status *datav1alpha1.DataFlowStatus) {
+		status.Run++
+		status.Phase = common.PhaseExecuting
+		status.StartTime = &startTime
+		status.CompletionTime = nil
+		if len(flow.Spec.Schedule) != 0 {
+			status.LastScheduleTime = &startTime
+		}
+		status.Steps = make([]datav1alpha1.DataFlowStepStatus, 0, len(flow.Spec.Steps))
+		for _, step := range flow.Spec.Steps {
+			status.Steps = append(status.Steps, datav1alpha1.DataFlowStepStatus{Name: step.Name, Phase: common.PhasePending})
+		}
+		status.Conditions = nil
+	})
+}
+
+// runSteps moves the steps of the current run forward, and finishes the run once all the steps are finished.
+func (r *FlowReconciler) runSteps(ctx context.Context, log logr.Logger, flow *datav1alpha1.DataFlow) error {
+	steps, err := dataflow.SortSteps(flow.Spec.Steps)
+	if err != nil {
+		return err
+	}
+
+	// the steps changed in the middle of the run are taken as they are in the spec
+	existing := stepStatusMap(flow.Status.Steps)
+	newSteps := make([]datav1alpha1.DataFlowStepStatus, 0, len(flow.Spec.Steps))
+	for _, step := range flow.Spec.Steps {
+		status := datav1alpha1.DataFlowStepStatus{Name: step.Name, Phase: common.PhasePending}
+		if s, found := existing[step.Name]; found {
+			status = *s.DeepCopy()
+		}
+		newSteps = append(newSteps, status)
+	}
+	statuses := stepStatusMap(newSteps)
+
+	var failedSteps []string
+	for i := range steps {
+		step := &steps[i]
+		status := statuses[step.Name]
+		switch status.Phase {
+		case common.PhasePending:
+			err = r.startStep(ctx, flow, step, status, statuses, failedSteps)
+		case common.PhaseExecuting:
+			err = r.checkStep(ctx, flow, step, status)
+		}
+		if err != nil {
+			return err
+		}
+		if status.Phase == common.PhaseFailed {
+			failedSteps = append(failedSteps, step.Name)
+		}
+	}
+
+	if !reflect.DeepEqual(newSteps, flow.Status.Steps) {
+		if err = r.updateStatus(ctx, client.ObjectKeyFromObject(flow), func(status *datav1alpha1.DataFlowStatus) {
+			status.Steps = newSteps
+		}); err != nil {
+			return err
+		}
+	}
+
+	for _, status := range newSteps {
+		if !isStepFinished(status.Phase) {
+			return nil
+		}
+	}
+	if len(failedSteps) > 0 {
+		_, err = r.finishRun(ctx, flow, common.PhaseFailed, "StepsFailed", fmt.Sprintf("Steps %s failed", strings.Join(failedSteps, ", ")))
+	} else {
+		_, err = r.finishRun(ctx, flow, common.PhaseComplete, "StepsComplete", fmt.Sprintf("All the %d steps are complete", len(steps)))
+	}
+	return err
+}
+
+// startStep creates the first operation of the step once all the steps it depends on are complete, or skips the
+// step if any of them is not run successfully.
+func (r *FlowReconciler) startStep(ctx context.Context, flow *datav1alpha1.DataFlow, step *datav1alpha1.DataFlowStep,
+	status *datav1alpha1.DataFlowStepStatus, statuses map[string]*datav1alpha1.DataFlowStepStatus, failedSteps []string) error {
+	if len(failedSteps) > 0 && flow.Spec.FailurePolicy != datav1alpha1.ContinueFailurePolicy {
+		skipStep(status, fmt.Sprintf("step %s failed", failedSteps[0]))
+		return nil
+	}
+
+	for _, dep := range step.DependsOn {
+		switch statuses[dep].Phase {
+		case common.PhaseComplete:
+		case common.PhaseFailed, datav1alpha1.DataFlowStepSkipped:
+			skipStep(status, fmt.Sprintf("step %s it depends on is %s", dep, statuses[dep].Phase))
+			return nil
+		default:
+			return nil
+		}
+	}
+
+	now := metav1.Now()
+	status.StartTime = &now
+	return r.createOperation(ctx, flow, step, status, statuses)
+}
+
+// checkStep follows the phase of the operation of the step. The failed operation has been retried by its retry policy,
+// and the operation is kept by its finalizer until the phase of the step is recorded, even if it's deleted by its TTL.
+func (r *FlowReconciler) checkStep(ctx context.Context, flow *datav1alpha1.DataFlow, step *datav1alpha1.DataFlowStep,
+	status *datav1alpha1.DataFlowStepStatus) error {
+	var message string
+	opStatus, err := utils.GetPrecedingOperationStatus(r.Client, status.Operation, flow.Namespace)
+	if err != nil {
+		if utils.IgnoreNotFound(err) != nil {
+			return err
+		}
+		message = fmt.Sprintf("%s %s is not found", status.Operation.Kind, status.Operation.Name)
+	} else {
+		switch opStatus.Phase {
+		case common.PhaseComplete:
+			now := metav1.Now()
+			status.Phase = common.PhaseComplete
+			status.CompletionTime = &now
+			return nil
+		case common.PhaseFailed:
+			message = fmt.Sprintf("%s %s failed", status.Operation.Kind, status.Operation.Name)
+			if len(opStatus.Conditions) > 0 {
+				message = fmt.Sprintf("%s: %s", message, opStatus.Conditions[len(opStatus.Conditions)-1].Message)
+			}
+		default:
+			return nil
+		}
+	}
+
+	now := metav1.Now()
+	status.Phase = common.PhaseFailed
+	status.Message = message
+	status.CompletionTime = &now
+	r.Recorder.Eventf(flow, v1.EventTypeWarning, common.DataFlowStepFailed, "Step %s failed: %s", step.Name, message)
+	return nil
+}
+
+// createOperation creates the operation of the step.
+func (r *FlowReconciler) createOperation(ctx context.Context, flow *datav1alpha1.DataFlow, step *datav1alpha1.DataFlowStep,
+	status *datav1alpha1.DataFlowStepStatus, statuses map[string]*datav1alpha1.DataFlowStepStatus) error {
+	operations := make(map[string]*datav1alpha1.ObjectRef, len(statuses))
+	for name, s := range statuses {
+		operations[name] = s.Operation
+	}
+
+	obj, err := dataflow.BuildStepOperation(flow, step, dataflow.GetStepRunAfter(step, operations))
+	if err != nil {
+		now := metav1.Now()
+		status.Phase = common.PhaseFailed
+		status.Message = fmt.Sprintf("failed to render the template: %v", err)
+		status.CompletionTime = &now
+		return nil
+	}
+	// the operation may be created before the status failed to update
+	if err = r.Create(ctx, obj); err != nil && !apierrors.IsAlreadyExists(err) {
+		return err
+	}
+
+	status.Phase = common.PhaseExecuting
+	status.Message = ""
+	status.Operation = &datav1alpha1.ObjectRef{
+		APIVersion: datav1alpha1.GroupVersion.String(),
+		Kind:       dataflow.GetStepKind(step),
+		Name:       obj.GetName(),
+	}
+	r.Recorder.Eventf(flow, v1.EventTypeNormal, common.DataFlowStepStarted, "Created %s %s for step %s", status.Operation.Kind, obj.GetName(), step.Name)
+	return nil
+}
+
+// releaseOperations removes the finalizers of the operations of the steps whose finished phases are recorded in the
+// status of the DataFlow.
+func (r *FlowReconciler) releaseOperations(ctx context.Context, flow *datav1alpha1.DataFlow) error {
+	for _, status := range flow.Status.Steps {
+		if !isStepFinished(status.Phase) || status.Operation == nil {
+			continue
+		}
+		obj := newOperation(status.Operation.Kind)
+		if obj == nil {
+			continue
+		}
+		err := r.Get(ctx, types.NamespacedName{Namespace: flow.Namespace, Name: status.Operation.Name}, obj)
+		if utils.IgnoreNotFound(err) != nil {
+			return err
+		}
+		if err == nil {
+			if err = r.releaseOperation(ctx, obj); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// releaseOperation removes the finalizer of the DataFlow from the operation of a step.
+func (r *FlowReconciler) releaseOperation(ctx context.Context, obj client.Object) error {
+	if !utils.ContainsString(obj.GetFinalizers(), dataflow.StepOperationFinalizer) {
+		return nil
+	}
+	objToUpdate := obj.DeepCopyObject().(client.Object)
+	objToUpdate.SetFinalizers(utils.RemoveString(obj.GetFinalizers(), dataflow.StepOperationFinalizer))
+	return utils.IgnoreNotFound(r.Patch(ctx, objToUpdate, client.MergeFrom(obj)))
+}
+
+// reconcileDeletion releases all the operations of the DataFlow so that they're garbage collected, and removes the
+// finalizer of the DataFlow.
+func (r *FlowReconciler) reconcileDeletion(ctx context.Context, log logr.Logger, flow *datav1alpha1.DataFlow) (ctrl.Result, error) {
+	if !utils.ContainsString(flow.GetFinalizers(), flowFinalizer) {
+		return utils.NoRequeue()
+	}
+
+	for _, kind := range operationKinds(flow) {
+		list := newOperationList(kind)
+		if err := r.List(ctx, list, client.InNamespace(flow.Namespace), client.MatchingLabels{common.LabelDataFlowName: flow.Name}); err != nil {
+			log.Error(err, "failed to list the operations of dataflow", "kind", kind)
+			return utils.RequeueIfError(err)
+		}
+		items, err := apimeta.ExtractList(list)
+		if err != nil {
+			return utils.RequeueIfError(err)
+		}
+		for _, item := range items {
+			if err = r.releaseOperation(ctx, item.(client.Object)); err != nil {
+				log.Error(err, "failed to release the operation of dataflow", "kind", kind, "name", item.(client.Object).GetName())
+				return utils.RequeueIfError(err)
+			}
+		}
+	}
+
+	flowToUpdate := flow.DeepCopy()
+	flowToUpdate.Finalizers = utils.RemoveString(flowToUpdate.Finalizers, flowFinalizer)
+	if err := r.Patch(ctx, flowToUpdate, client.MergeFrom(flow)); utils.IgnoreNotFound(err) != nil {
+		log.Error(err, "failed to remove finalizer from dataflow")
+		return utils.RequeueIfError(err)
+	}
+	return utils.NoRequeue()
+}
+
+// finishRun marks the current run of the DataFlow as complete or failed.
+func (r *FlowReconciler) finishRun(ctx context.Context, flow *datav1alpha1.DataFlow, phase common.Phase, reason, message string) (ctrl.Result, error) {
+	now := metav1.Now()
+	conditionType, eventType, eventReason := common.Complete, v1.EventTypeNormal, common.DataFlowCompleted
+	if phase == common.PhaseFailed {
+		conditionType, eventType, eventReason = common.Failed, v1.EventTypeWarning, common.DataFlowFailed
+	}
+
+	err := r.updateStatus(ctx, client.ObjectKeyFromObject(flow), func(status *datav1alpha1.DataFlowStatus) {
+		status.Phase = phase
+		status.CompletionTime = &now
+		status.Conditions = []datav1alpha1.Condition{{
+			Type:               conditionType,
+			Status:             v1.ConditionTrue,
+			Reason:             reason,
+			Message:            message,
+			LastProbeTime:      now,
+			LastTransitionTime: now,
+		}}
+	})
+	if err != nil {
+		r.Log.Error(err, "failed to update the status of dataflow", "dataflow", client.ObjectKeyFromObject(flow))
+		return utils.RequeueIfError(err)
+	}
+	r.Recorder.Event(flow, eventType, eventReason, message)
+	return utils.NoRequeue()
+}
+
+// updateStatus applies the change to the latest status of the DataFlow.
+func (r *FlowReconciler) updateStatus(ctx context.Context, key types.NamespacedName, mutate func(status *datav1alpha1.DataFlowStatus)) error {
+	return retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		flow := &datav1alpha1.DataFlow{}
+		if err := r.Get(ctx, key, flow); err != nil {
+			return err
+		}
+		flowToUpdate := flow.DeepCopy()
+		mutate(&flowToUpdate.Status)
+		if reflect.DeepEqual(flow.Status, flowToUpdate.Status) {
+			return nil
+		}
+		return r.Status().Update(ctx, flowToUpdate)
+	})
+}
+
+// operationKinds returns the kinds of the operations created for the steps of the DataFlow, including the ones
+// of the steps removed from the spec in the middle of the run
+func operationKinds(flow *datav1alpha1.DataFlow) (kinds []string) {
+	for i := range flow.Spec.Steps {
+		if kind := dataflow.GetStepKind(&flow.Spec.Steps[i]); newOperationList(kind) != nil && !utils.ContainsString(kinds, kind) {
+			kinds = append(kinds, kind)
+		}
+	}
+	for _, status := range flow.Status.Steps {
+		if status.Operation != nil && newOperationList(status.Operation.Kind) != nil && !utils.ContainsString(kinds, status.Operation.Kind) {
+			kinds = append(kinds, status.Operation.Kind)
+		}
+	}
+	return
+}
+
+func newOperationList(kind string) client.ObjectList {
+	switch kind {
+	case string(dataoperation.DataLoadType):
+		return &datav1alpha1.DataLoadList{}
+	case string(dataoperation.DataProcessType):
+		return &datav1alpha1.DataProcessList{}
+	case string(dataoperation.DataMigrateType):
+		return &datav1alpha1.DataMigrateList{}
+	case string(dataoperation.DataBackupType):
+		return &datav1alpha1.DataBackupList{}
+	}
+	return nil
+}
+
+func newOperation(kind string) client.Object {
+	switch kind {
+	case string(dataoperation.DataLoadType):
+		return &datav1alpha1.DataLoad{}
+	case string(dataoperation.DataProcessType):
+		return &datav1alpha1.DataProcess{}
+	case string(dataoperation.DataMigrateType):
+		return &datav1alpha1.DataMigrate{}
+	case string(dataoperation.DataBackupType):
+		return &datav1alpha1.DataBackup{}
+	}
+	return nil
+}
+
+func skipStep(status *datav1alpha1.DataFlowStepStatus, reason string) {
+	now := metav1.Now()
+	status.Phase = datav1alpha1.DataFlowStepSkipped
+	status.Message = fmt.Sprintf("skipped because %s", reason)
+	status.CompletionTime = &now
+}
+
+func stepStatusMap(steps []datav1alpha1.DataFlowStepStatus) map[string]*datav1alpha1.DataFlowStepStatus {
+	statuses := make(map[string]*datav1alpha1.DataFlowStepStatus, len(steps))
+	for i := range steps {
+		statuses[steps[i].Name] = &steps[i]
+	}
+	return statuses
+}
+
+func isStepFinished(phase common.Phase) bool {
+	return phase == common.PhaseComplete || phase == common.PhaseFailed || phase == datav1alpha1.DataFlowStepSkipped
+}
+
+func isRunFinished(flow *datav1alpha1.DataFlow) bool {
+	return flow.Status.Phase == common.PhaseComplete || flow.Status.Phase == common.PhaseFailed
+}
+
+func (r *FlowReconciler) SetupWithManager(mgr ctrl.Manager, options controller.Options) error {
+	bld := ctrl.NewControllerManagedBy(mgr).
+		WithOptions(options).
+		For(&datav1alpha1.DataFlow{})
+	for kind, obj := range reconcileKinds {
+		if discovery.GetFluidDiscovery().ResourceEnabled(kind) {
+			bld = bld.Owns(obj)
+		}
+	}
+	return bld.Complete(r)
+}
+
+func (r *FlowReconciler) ControllerName() string {
+	return flowControllerName
+}
@@ -0,0 +1,329 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dataflow
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/dataflow"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+)
+
+var _ = Describe("FlowReconciler", func() {
+	var (
+		s            *runtime.Scheme
+		recorder     *record.FakeRecorder
+		key          = types.NamespacedName{Name: "preprocess", Namespace: "default"}
+		resyncPeriod = 10 * time.Second
+	)
+
+	loadStep := func(name string, dependsOn ...string) datav1alpha1.DataFlowStep {
+		return datav1alpha1.DataFlowStep{
+			Name:      name,
+			DependsOn: dependsOn,
+			DataLoad:  &datav1alpha1.DataLoadSpec{Dataset: datav1alpha1.TargetDataset{Name: "hbase"}},
+		}
+	}
+
+	newFlow := func(steps ...datav1alpha1.DataFlowStep) *datav1alpha1.DataFlow {
+		return &datav1alpha1.DataFlow{
+			ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace, UID: "flow-uid"},
+			Spec:       datav1alpha1.DataFlowSpec{Steps: steps},
+		}
+	}
+
+	// running sets the flow to be running the first run with the phases of the steps
+	running := func(flow *datav1alpha1.DataFlow, phases map[string]common.Phase) *datav1alpha1.DataFlow {
+		flow.Status.Run = 1
+		flow.Status.Phase = common.PhaseExecuting
+		for _, step := range flow.Spec.Steps {
+			status := datav1alpha1.DataFlowStepStatus{Name: step.Name, Phase: common.PhasePending}
+			if phase, found := phases[step.Name]; found {
+				status.Phase = phase
+				status.Operation = &datav1alpha1.ObjectRef{Kind: "DataLoad", Name: key.Name + "-" + step.Name + "-1"}
+			}
+			flow.Status.Steps = append(flow.Status.Steps, status)
+		}
+		return flow
+	}
+
+	newDataLoad := func(name string, phase common.Phase) *datav1alpha1.DataLoad {
+		return &datav1alpha1.DataLoad{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: key.Namespace, Labels: map[string]string{common.LabelDataFlowName: key.Name}},
+			Status: datav1alpha1.OperationStatus{
+				Phase:      phase,
+				Conditions: []datav1alpha1.Condition{{Type: common.Failed, Message: "job failed"}},
+			},
+		}
+	}
+
+	reconcile := func(c client.Client) (ctrl.Result, error) {
+		r := NewFlowReconciler(c, fake.NullLogger(), recorder, resyncPeriod)
+		return r.Reconcile(context.TODO(), ctrl.Request{NamespacedName: key})
+	}
+
+	getFlow := func(c client.Client) *datav1alpha1.DataFlow {
+		flow := &datav1alpha1.DataFlow{}
+		Expect(c.Get(context.TODO(), key, flow)).To(Succeed())
+		return flow
+	}
+
+	getDataLoad := func(c client.Client, name string) *datav1alpha1.DataLoad {
+		dataLoad := &datav1alpha1.DataLoad{}
+		Expect(c.Get(context.TODO(), types.NamespacedName{Name: name, Namespace: key.Namespace}, dataLoad)).To(Succeed())
+		return dataLoad
+	}
+
+	BeforeEach(func() {
+		s = runtime.NewScheme()
+		Expect(datav1alpha1.AddToScheme(s)).To(Succeed())
+		Expect(corev1.AddToScheme(s)).To(Succeed())
+		recorder = record.NewFakeRecorder(10)
+	})
+
+	It("should start the first run of a flow without schedule", func() {
+		c := fake.NewFakeClientWithScheme(s, newFlow(loadStep("load"), loadStep("warmup", "load")))
+
+		result, err := reconcile(c)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Requeue).To(BeTrue())
+
+		flow := getFlow(c)
+		Expect(flow.Status.Run).To(BeEquivalentTo(1))
+		Expect(flow.Status.Phase).To(Equal(common.PhaseExecuting))
+		Expect(flow.Status.StartTime).NotTo(BeNil())
+		Expect(flow.Status.Steps).To(HaveLen(2))
+		Expect(flow.Status.Steps[0].Phase).To(Equal(common.PhasePending))
+	})
+
+	It("should fail the flow with an invalid spec", func() {
+		c := fake.NewFakeClientWithScheme(s, newFlow(loadStep("a", "b"), loadStep("b", "a")))
+
+		_, err := reconcile(c)
+		Expect(err).NotTo(HaveOccurred())
+
+		flow := getFlow(c)
+		Expect(flow.Status.Phase).To(Equal(common.PhaseFailed))
+		Expect(flow.Status.Conditions).To(HaveLen(1))
+		Expect(flow.Status.Conditions[0].Reason).To(Equal("InvalidSpec"))
+		Expect(recorder.Events).To(Receive(ContainSubstring(common.DataFlowFailed)))
+	})
+
+	It("should create the operations of the steps whose dependencies are complete", func() {
+		flow := newFlow(loadStep("load"), loadStep("warmup", "load"))
+		flow.Spec.Parameters = []datav1alpha1.DataFlowParameter{{Name: "path", Value: "/train"}}
+		flow.Spec.Steps[0].DataLoad.Target = []datav1alpha1.TargetPath{{Path: "$(params.path)"}}
+		c := fake.NewFakeClientWithScheme(s, running(flow, nil))
+
+		result, err := reconcile(c)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(resyncPeriod))
+
+		dataLoad := getDataLoad(c, "preprocess-load-1")
+		Expect(dataLoad.Spec.Target[0].Path).To(Equal("/train"))
+		Expect(dataLoad.Spec.RunAfter).To(BeNil())
+		Expect(dataLoad.OwnerReferences).To(HaveLen(1))
+		Expect(dataLoad.Finalizers).To(ConsistOf(dataflow.StepOperationFinalizer))
+		err = c.Get(context.TODO(), types.NamespacedName{Name: "preprocess-warmup-1", Namespace: key.Namespace}, &datav1alpha1.DataLoad{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+
+		flow = getFlow(c)
+		Expect(flow.Status.Steps[0].Phase).To(Equal(common.PhaseExecuting))
+		Expect(flow.Status.Steps[0].Operation.Name).To(Equal("preprocess-load-1"))
+		Expect(flow.Status.Steps[1].Phase).To(Equal(common.PhasePending))
+		Expect(recorder.Events).To(Receive(ContainSubstring(common.DataFlowStepStarted)))
+	})
+
+	It("should run the step after the operation of the step it depends on with the affinity", func() {
+		warmup := loadStep("warmup", "load")
+		warmup.Affinity = &datav1alpha1.DataFlowStepAffinity{Policy: datav1alpha1.RequireAffinityStrategy}
+		flow := running(newFlow(loadStep("load"), warmup), map[string]common.Phase{"load": common.PhaseExecuting})
+		c := fake.NewFakeClientWithScheme(s, flow, newDataLoad("preprocess-load-1", common.PhaseComplete))
+
+		_, err := reconcile(c)
+		Expect(err).NotTo(HaveOccurred())
+
+		dataLoad := getDataLoad(c, "preprocess-warmup-1")
+		Expect(dataLoad.Spec.RunAfter).NotTo(BeNil())
+		Expect(dataLoad.Spec.RunAfter.Name).To(Equal("preprocess-load-1"))
+		Expect(dataLoad.Spec.RunAfter.AffinityStrategy.Policy).To(Equal(datav1alpha1.RequireAffinityStrategy))
+
+		flow = getFlow(c)
+		Expect(flow.Status.Steps[0].Phase).To(Equal(common.PhaseComplete))
+		Expect(flow.Status.Steps[0].CompletionTime).NotTo(BeNil())
+		Expect(flow.Status.Steps[1].Phase).To(Equal(common.PhaseExecuting))
+	})
+
+	It("should add the finalizer to the flow", func() {
+		c := fake.NewFakeClientWithScheme(s, running(newFlow(loadStep("load")), nil))
+
+		_, err := reconcile(c)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(getFlow(c).Finalizers).To(ConsistOf(flowFinalizer))
+	})
+
+	It("should keep the finished operation until the phase of the step is recorded", func() {
+		flow := running(newFlow(loadStep("load"), loadStep("warmup", "load")), map[string]common.Phase{"load": common.PhaseExecuting})
+		dataLoad := newDataLoad("preprocess-load-1", common.PhaseComplete)
+		dataLoad.Finalizers = []string{dataflow.StepOperationFinalizer}
+		c := fake.NewFakeClientWithScheme(s, flow, dataLoad)
+		// the operation is deleted by its TTL before the flow observes it
+		Expect(c.Delete(context.TODO(), dataLoad)).To(Succeed())
+
+		_, err := reconcile(c)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(getFlow(c).Status.Steps[0].Phase).To(Equal(common.PhaseComplete))
+		Expect(getDataLoad(c, "preprocess-load-1").Finalizers).To(ConsistOf(dataflow.StepOperationFinalizer))
+
+		_, err = reconcile(c)
+		Expect(err).NotTo(HaveOccurred())
+		err = c.Get(context.TODO(), types.NamespacedName{Name: "preprocess-load-1", Namespace: key.Namespace}, &datav1alpha1.DataLoad{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		Expect(getFlow(c).Status.Steps[1].Phase).To(Equal(common.PhaseExecuting))
+	})
+
+	It("should release the operations and remove the finalizer of a deleted flow", func() {
+		flow := running(newFlow(loadStep("load")), map[string]common.Phase{"load": common.PhaseExecuting})
+		flow.Finalizers = []string{flowFinalizer}
+		dataLoad := newDataLoad("preprocess-load-1", common.PhaseExecuting)
+		dataLoad.Finalizers = []string{dataflow.StepOperationFinalizer}
+		c := fake.NewFakeClientWithScheme(s, flow, dataLoad)
+		Expect(c.Delete(context.TODO(), flow)).To(Succeed())
+
+		_, err := reconcile(c)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(getDataLoad(c, "preprocess-load-1").Finalizers).To(BeEmpty())
+		err = c.Get(context.TODO(), key, &datav1alpha1.DataFlow{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("should skip the pending steps and fail the flow once a step fails with FailFast", func() {
+		flow := running(newFlow(loadStep("load"), loadStep("other"), loadStep("warmup", "load")),
+			map[string]common.Phase{"load": common.PhaseExecuting})
+		c := fake.NewFakeClientWithScheme(s, flow, newDataLoad("preprocess-load-1", common.PhaseFailed))
+
+		_, err := reconcile(c)
+		Expect(err).NotTo(HaveOccurred())
+
+		flow = getFlow(c)
+		Expect(flow.Status.Steps[0].Phase).To(Equal(common.PhaseFailed))
+		Expect(flow.Status.Steps[0].Message).To(ContainSubstring("job failed"))
+		Expect(flow.Status.Steps[1].Phase).To(Equal(datav1alpha1.DataFlowStepSkipped))
+		Expect(flow.Status.Steps[2].Phase).To(Equal(datav1alpha1.DataFlowStepSkipped))
+		Expect(flow.Status.Phase).To(Equal(common.PhaseFailed))
+		Expect(flow.Status.CompletionTime).NotTo(BeNil())
+		Expect(flow.Status.Conditions[0].Message).To(ContainSubstring("load"))
+	})
+
+	It("should keep running the steps not depending on the failed step with Continue", func() {
+		flow := running(newFlow(loadStep("load"), loadStep("other"), loadStep("warmup", "load")),
+			map[string]common.Phase{"load": common.PhaseExecuting})
+		flow.Spec.FailurePolicy = datav1alpha1.ContinueFailurePolicy
+		c := fake.NewFakeClientWithScheme(s, flow, newDataLoad("preprocess-load-1", common.PhaseFailed))
+
+		_, err := reconcile(c)
+		Expect(err).NotTo(HaveOccurred())
+
+		getDataLoad(c, "preprocess-other-1")
+		flow = getFlow(c)
+		Expect(flow.Status.Steps[1].Phase).To(Equal(common.PhaseExecuting))
+		Expect(flow.Status.Steps[2].Phase).To(Equal(datav1alpha1.DataFlowStepSkipped))
+		Expect(flow.Status.Phase).To(Equal(common.PhaseExecuting))
+	})
+
+	It("should complete the flow once all the steps are complete", func() {
+		flow := running(newFlow(loadStep("load")), map[string]common.Phase{"load": common.PhaseExecuting})
+		c := fake.NewFakeClientWithScheme(s, flow, newDataLoad("preprocess-load-1", common.PhaseComplete))
+
+		_, err := reconcile(c)
+		Expect(err).NotTo(HaveOccurred())
+
+		flow = getFlow(c)
+		Expect(flow.Status.Phase).To(Equal(common.PhaseComplete))
+		Expect(flow.Status.Conditions[0].Type).To(Equal(common.Complete))
+
+		result, err := reconcile(c)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(ctrl.Result{}))
+	})
+
+	It("should start a new run at the schedule time and remove the operations of the previous run", func() {
+		flow := running(newFlow(loadStep("load")), map[string]common.Phase{"load": common.PhaseComplete})
+		flow.Status.Phase = common.PhaseComplete
+		flow.Spec.Schedule = "*/5 * * * *"
+		lastScheduleTime := metav1.NewTime(time.Now().Add(-time.Hour))
+		flow.Status.LastScheduleTime = &lastScheduleTime
+		c := fake.NewFakeClientWithScheme(s, flow, newDataLoad("preprocess-load-1", common.PhaseComplete))
+
+		result, err := reconcile(c)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Requeue).To(BeTrue())
+
+		err = c.Get(context.TODO(), types.NamespacedName{Name: "preprocess-load-1", Namespace: key.Namespace}, &datav1alpha1.DataLoad{})
+		Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		flow = getFlow(c)
+		Expect(flow.Status.Run).To(BeEquivalentTo(2))
+		Expect(flow.Status.Phase).To(Equal(common.PhaseExecuting))
+		Expect(flow.Status.LastScheduleTime.After(lastScheduleTime.Time)).To(BeTrue())
+		Expect(flow.Status.Steps[0].Phase).To(Equal(common.PhasePending))
+		Expect(flow.Status.Steps[0].Operation).To(BeNil())
+	})
+
+	It("should wait for the next schedule time once the run is finished", func() {
+		flow := running(newFlow(loadStep("load")), map[string]common.Phase{"load": common.PhaseComplete})
+		flow.Status.Phase = common.PhaseComplete
+		flow.Spec.Schedule = "0 0 1 1 *"
+		lastScheduleTime := metav1.Now()
+		flow.Status.LastScheduleTime = &lastScheduleTime
+		c := fake.NewFakeClientWithScheme(s, flow)
+
+		result, err := reconcile(c)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(BeNumerically(">", time.Hour))
+		Expect(getFlow(c).Status.Run).To(BeEquivalentTo(1))
+	})
+
+	It("should skip the scheduled run if the previous run is not finished", func() {
+		flow := running(newFlow(loadStep("load")), map[string]common.Phase{"load": common.PhaseExecuting})
+		flow.Spec.Schedule = "*/5 * * * *"
+		lastScheduleTime := metav1.NewTime(time.Now().Add(-time.Hour))
+		flow.Status.LastScheduleTime = &lastScheduleTime
+		c := fake.NewFakeClientWithScheme(s, flow, newDataLoad("preprocess-load-1", common.PhaseExecuting))
+
+		_, err := reconcile(c)
+		Expect(err).NotTo(HaveOccurred())
+
+		flow = getFlow(c)
+		Expect(flow.Status.Run).To(BeEquivalentTo(1))
+		Expect(flow.Status.LastScheduleTime.After(lastScheduleTime.Time)).To(BeTrue())
+		Expect(recorder.Events).To(Receive(ContainSubstring(common.DataFlowRunSkipped)))
+	})
+})
@@ -27,6 +27,7 @@ import (
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -34,8 +35,11 @@ import (
 
 	"github.com/fluid-cloudnative/fluid/pkg/common"
 	"github.com/fluid-cloudnative/fluid/pkg/controllers"
+	"github.com/fluid-cloudnative/fluid/pkg/dataflow"
 	cdataload "github.com/fluid-cloudnative/fluid/pkg/dataload"
 	"github.com/fluid-cloudnative/fluid/pkg/dataoperation"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/base"
+	"github.com/fluid-cloudnative/fluid/pkg/metrics"
 	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
 	"github.com/fluid-cloudnative/fluid/pkg/utils"
 
@@ -99,6 +103,7 @@ func (r *DataLoadReconciler) Reconcile(context context.Context, req ctrl.Request
 	if err != nil {
 		if utils.IgnoreNotFound(err) == nil {
 			ctx.Log.Info("DataLoad not found")
+			metrics.GetOrCreateDataLoadMetrics(req.Namespace, req.Name, "").Forget()
 			return utils.NoRequeue()
 		} else {
 			ctx.Log.Error(err, "failed to get DataLoad")
@@ -113,22 +118,42 @@ func (r *DataLoadReconciler) Reconcile(context context.Context, req ctrl.Request
 
 // SetupWithManager sets up the controller with the given controller manager
 func (r *DataLoadReconciler) SetupWithManager(mgr ctrl.Manager, options controller.Options) error {
+	// the operation queue of the runtime finds the data operations by their target datasets
+	if err := base.SetupDataOperationIndexer(context.Background(), mgr.GetFieldIndexer(), &datav1alpha1.DataLoad{}); err != nil {
+		return err
+	}
+	bld := ctrl.NewControllerManagedBy(mgr).
+		WithOptions(options).
+		For(&datav1alpha1.DataLoad{}).
+		Owns(&batchv1.Job{})
 	if compatibility.IsBatchV1CronJobSupported() {
-		return ctrl.NewControllerManagedBy(mgr).
-			WithOptions(options).
-			For(&datav1alpha1.DataLoad{}).
-			Owns(&batchv1.Job{}).
-			Owns(&batchv1.CronJob{}).
-			Complete(r)
+		bld.Owns(&batchv1.CronJob{})
 	} else {
 		ctrl.Log.Info("batch/v1 cronjobs cannnot be found in cluster, fallback to watch batch/v1beta1 cronjobs for compatibility")
-		return ctrl.NewControllerManagedBy(mgr).
-			WithOptions(options).
-			For(&datav1alpha1.DataLoad{}).
-			Owns(&batchv1.Job{}).
-			Owns(&batchv1beta1.CronJob{}).
-			Complete(r)
+		bld.Owns(&batchv1beta1.CronJob{})
+	}
+	return dataflow.SetupEventSourceWatches(bld, r.Client, listOnEventDataLoads).Complete(r)
+}
+
+// listOnEventDataLoads lists the DataLoads with OnEvent policy for the event source watches
+func listOnEventDataLoads(ctx context.Context, c client.Client, namespace string) ([]dataflow.OnEventOperation, error) {
+	var dataLoads datav1alpha1.DataLoadList
+	if err := c.List(ctx, &dataLoads, client.InNamespace(namespace)); err != nil {
+		return nil, err
+	}
+
+	var operations []dataflow.OnEventOperation
+	for _, dataLoad := range dataLoads.Items {
+		if dataLoad.Spec.Policy != datav1alpha1.OnEvent {
+			continue
+		}
+		operations = append(operations, dataflow.OnEventOperation{
+			NamespacedName: types.NamespacedName{Namespace: dataLoad.Namespace, Name: dataLoad.Name},
+			Dataset:        types.NamespacedName{Namespace: dataLoad.Spec.Dataset.Namespace, Name: dataLoad.Spec.Dataset.Name},
+			Events:         dataLoad.Spec.Events,
+		})
 	}
+	return operations, nil
 }
 
 func (r *DataLoadReconciler) ControllerName() string {
@@ -33,7 +33,9 @@ import (
 
 	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
 	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/dataflow"
 	cdataload "github.com/fluid-cloudnative/fluid/pkg/dataload"
+	"github.com/fluid-cloudnative/fluid/pkg/metrics"
 	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
 	"github.com/fluid-cloudnative/fluid/pkg/utils"
 )
@@ -110,11 +112,54 @@ func (r *dataLoadOperation) Validate(ctx cruntime.ReconcileRequestContext) ([]da
 			},
 		}, err
 	}
+
+	// 2. Check event sources are valid for OnEvent policy
+	if dataLoad.Spec.Policy == datav1alpha1.OnEvent {
+		if err := dataflow.ValidateEventSources(dataLoad.Spec.Events); err != nil {
+			r.Recorder.Eventf(dataLoad,
+				v1.EventTypeWarning,
+				common.DataOperationEventSourceInvalid,
+				"dataLoad(%s) has invalid event sources: %v",
+				dataLoad.Name, err)
+
+			return []datav1alpha1.Condition{
+				{
+					Type:               common.Failed,
+					Status:             v1.ConditionTrue,
+					Reason:             common.DataOperationEventSourceInvalid,
+					Message:            err.Error(),
+					LastProbeTime:      metav1.NewTime(time.Now()),
+					LastTransitionTime: metav1.NewTime(time.Now()),
+				},
+			}, err
+		}
+	}
+
+	// 3. Check the runtime is able to cache the data on the target nodes
+	if dataLoad.Spec.TargetNodes != nil && !cdataload.TargetNodesSupported(ctx.RuntimeType) {
+		err := fmt.Errorf("dataLoad(%s) with target nodes is not supported by runtime %s", dataLoad.Name, ctx.RuntimeType)
+		r.Recorder.Event(dataLoad, v1.EventTypeWarning, common.DataLoadTargetNodesNotSupported, err.Error())
+
+		return []datav1alpha1.Condition{
+			{
+				Type:               common.Failed,
+				Status:             v1.ConditionTrue,
+				Reason:             common.DataLoadTargetNodesNotSupported,
+				Message:            err.Error(),
+				LastProbeTime:      metav1.NewTime(time.Now()),
+				LastTransitionTime: metav1.NewTime(time.Now()),
+			},
+		}, err
+	}
 	return nil, nil
 }
 
 func (r *dataLoadOperation) UpdateStatusInfoForCompleted(infos map[string]string) error {
-	// DataLoad does not need to update OperationStatus's Infos field.
+	// the progress reported while executing is kept, and marked as completed
+	if _, found := infos[cdataload.InfoProgress]; found {
+		metrics.GetOrCreateDataLoadMetrics(r.dataLoad.GetNamespace(), r.dataLoad.GetName(), r.dataLoad.Spec.Dataset.Name).SetProgressRatio(1)
+	}
+	cdataload.CompleteInfos(infos)
 	return nil
 }
 
@@ -159,6 +204,16 @@ func (r *dataLoadOperation) GetTTL() (ttl *int32, err error) {
 	return
 }
 
+// GetRetryPolicy implements dataoperation.OperationInterface.
+func (r *dataLoadOperation) GetRetryPolicy() *datav1alpha1.RetryPolicy {
+	switch r.dataLoad.Spec.Policy {
+	case datav1alpha1.Cron, datav1alpha1.OnEvent:
+		return nil
+	default:
+		return r.dataLoad.Spec.RetryPolicy
+	}
+}
+
 func (r *dataLoadOperation) GetParallelTaskNumber() int32 {
 	return 1
 }
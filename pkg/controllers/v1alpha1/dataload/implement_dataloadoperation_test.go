@@ -31,6 +31,7 @@ import (
 
 	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
 	"github.com/fluid-cloudnative/fluid/pkg/common"
+	cdataload "github.com/fluid-cloudnative/fluid/pkg/dataload"
 	"github.com/fluid-cloudnative/fluid/pkg/dataoperation"
 	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
 	"github.com/fluid-cloudnative/fluid/pkg/utils/compatibility"
@@ -123,9 +124,25 @@ var _ = Describe("dataLoadOperation", func() {
 	})
 
 	Describe("UpdateStatusInfoForCompleted", func() {
-		It("returns nil (no-op)", func() {
+		It("returns nil and keeps infos empty when no progress is reported", func() {
 			op := newTestDataLoadOperation(mockDataLoad)
-			Expect(op.UpdateStatusInfoForCompleted(nil)).To(Succeed())
+			infos := map[string]string{}
+			Expect(op.UpdateStatusInfoForCompleted(infos)).To(Succeed())
+			Expect(infos).To(BeEmpty())
+		})
+
+		It("marks the reported progress as completed", func() {
+			op := newTestDataLoadOperation(mockDataLoad)
+			infos := map[string]string{
+				cdataload.InfoProgress:            "50.00%",
+				cdataload.InfoBytesLoaded:         "100",
+				cdataload.InfoBytesTotal:          "200",
+				cdataload.InfoEstimatedFinishTime: "2026-01-01T00:00:00Z",
+			}
+			Expect(op.UpdateStatusInfoForCompleted(infos)).To(Succeed())
+			Expect(infos).To(HaveKeyWithValue(cdataload.InfoProgress, "100.00%"))
+			Expect(infos).To(HaveKeyWithValue(cdataload.InfoBytesLoaded, "200"))
+			Expect(infos).NotTo(HaveKey(cdataload.InfoEstimatedFinishTime))
 		})
 	})
 
@@ -238,6 +255,22 @@ var _ = Describe("dataLoadOperation", func() {
 		})
 	})
 
+	Describe("GetRetryPolicy", func() {
+		It("returns the retry policy for Once policy", func() {
+			mockDataLoad.Spec.Policy = datav1alpha1.Once
+			mockDataLoad.Spec.RetryPolicy = &datav1alpha1.RetryPolicy{MaxAttempts: 3}
+			op := newTestDataLoadOperation(mockDataLoad)
+			Expect(op.GetRetryPolicy()).To(Equal(&datav1alpha1.RetryPolicy{MaxAttempts: 3}))
+		})
+
+		It("returns nil for Cron policy", func() {
+			mockDataLoad.Spec.Policy = datav1alpha1.Cron
+			mockDataLoad.Spec.RetryPolicy = &datav1alpha1.RetryPolicy{MaxAttempts: 3}
+			op := newTestDataLoadOperation(mockDataLoad)
+			Expect(op.GetRetryPolicy()).To(BeNil())
+		})
+	})
+
 	Describe("Validate", func() {
 		It("returns nil when namespace matches dataset namespace", func() {
 			op := newTestDataLoadOperation(mockDataLoad)
@@ -257,6 +290,21 @@ var _ = Describe("dataLoadOperation", func() {
 			Expect(conditions).To(HaveLen(1))
 			Expect(conditions[0].Type).To(Equal(common.Failed))
 		})
+
+		It("returns error when the runtime does not support target nodes", func() {
+			mockDataLoad.Spec.TargetNodes = &datav1alpha1.DataLoadTargetNodes{NodeNames: []string{"node-1"}}
+			op := newTestDataLoadOperation(mockDataLoad)
+			ctx := cruntime.ReconcileRequestContext{Log: fake.NullLogger(), RuntimeType: common.JuiceFSRuntime}
+			conditions, err := op.Validate(ctx)
+			Expect(err).To(HaveOccurred())
+			Expect(conditions).To(HaveLen(1))
+			Expect(conditions[0].Reason).To(Equal(common.DataLoadTargetNodesNotSupported))
+
+			ctx.RuntimeType = common.AlluxioRuntime
+			conditions, err = op.Validate(ctx)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(conditions).To(BeNil())
+		})
 	})
 })
 
@@ -17,6 +17,8 @@
 package dataload
 
 import (
+	"reflect"
+
 	"github.com/fluid-cloudnative/fluid/pkg/dataflow"
 	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
 	"github.com/pkg/errors"
@@ -26,7 +28,9 @@ import (
 
 	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
 	"github.com/fluid-cloudnative/fluid/pkg/common"
+	cdataload "github.com/fluid-cloudnative/fluid/pkg/dataload"
 	"github.com/fluid-cloudnative/fluid/pkg/dataoperation"
+	"github.com/fluid-cloudnative/fluid/pkg/metrics"
 	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
 	"github.com/fluid-cloudnative/fluid/pkg/utils"
 	"github.com/fluid-cloudnative/fluid/pkg/utils/helm"
@@ -54,7 +58,15 @@ type OnEventStatusHandler struct {
 func (r *OnceStatusHandler) GetOperationStatus(ctx cruntime.ReconcileRequestContext, opStatus *datav1alpha1.OperationStatus) (result *datav1alpha1.OperationStatus, err error) {
 	releaseName := utils.GetDataLoadReleaseName(r.dataLoad.GetName())
 	jobName := utils.GetDataLoadJobName(releaseName)
-	return getJobOperationStatus(ctx, r.Client, releaseName, jobName, ctx.Namespace, true, opStatus)
+	result, err = getJobOperationStatus(ctx, r.Client, releaseName, jobName, ctx.Namespace, true, opStatus)
+	if err != nil {
+		return
+	}
+	if result.Phase == common.PhaseExecuting {
+		updateJobProgress(ctx, r.Client, r.dataLoad, jobName, result)
+	}
+	err = updateTargetNodes(ctx, r.Client, r.dataLoad, result)
+	return
 }
 func (c *CronStatusHandler) GetOperationStatus(ctx cruntime.ReconcileRequestContext, opStatus *datav1alpha1.OperationStatus) (result *datav1alpha1.OperationStatus, err error) {
 	result = opStatus.DeepCopy()
@@ -102,6 +114,14 @@ func (c *CronStatusHandler) GetOperationStatus(ctx cruntime.ReconcileRequestCont
 		return
 	}
 
+	// the jobs of the cronjob start suspended, and the current job runs once the DataLoad leaves the operation queue
+	if opStatus.Phase == common.PhaseExecuting {
+		if err = kubeclient.ResumeJob(c.Client, currentJob); err != nil {
+			ctx.Log.Error(err, "can't resume DataLoad job", "namespace", ctx.Namespace, "jobName", currentJob.Name)
+			return
+		}
+	}
+
 	finishedJobCondition := kubeclient.GetFinishedJobCondition(currentJob)
 
 	if finishedJobCondition == nil {
@@ -111,7 +131,11 @@ func (c *CronStatusHandler) GetOperationStatus(ctx cruntime.ReconcileRequestCont
 			// dataset will be locked only when dataload pending
 			result.Phase = common.PhasePending
 			result.Duration = "-"
+			// the progress of the previous run is not carried over to the new run
+			cdataload.ResetInfos(result.Infos)
 		}
+		updateProgress(ctx, c.Client, c.dataLoad, currentJob, result)
+		err = updateTargetNodes(ctx, c.Client, c.dataLoad, result)
 		return
 	}
 	// job either failed or complete, update dataload's phase status
@@ -131,13 +155,44 @@ func (c *CronStatusHandler) GetOperationStatus(ctx cruntime.ReconcileRequestCont
 		result.Phase = common.PhaseComplete
 	}
 	result.Duration = utils.CalculateDuration(currentJob.CreationTimestamp.Time, finishedJobCondition.LastTransitionTime.Time)
+	if (opStatus.Phase == common.PhaseComplete || opStatus.Phase == common.PhaseFailed) &&
+		!reflect.DeepEqual(opStatus.Conditions, result.Conditions) {
+		// another run finished without being observed running, whose target nodes are not resolved yet
+		result.TargetNodes = nil
+	}
+	err = updateTargetNodes(ctx, c.Client, c.dataLoad, result)
 	return
 }
 
 func (o *OnEventStatusHandler) GetOperationStatus(ctx cruntime.ReconcileRequestContext, opStatus *datav1alpha1.OperationStatus) (result *datav1alpha1.OperationStatus, err error) {
 	releaseName := utils.GetDataLoadReleaseName(o.dataLoad.GetName())
 	jobName := utils.GetDataLoadJobName(releaseName)
-	return getJobOperationStatus(ctx, o.Client, releaseName, jobName, ctx.Namespace, true, opStatus)
+	result, err = getJobOperationStatus(ctx, o.Client, releaseName, jobName, ctx.Namespace, true, opStatus)
+	if err != nil {
+		return
+	}
+	if result.Phase == common.PhaseExecuting {
+		updateJobProgress(ctx, o.Client, o.dataLoad, jobName, result)
+	}
+	if err = updateTargetNodes(ctx, o.Client, o.dataLoad, result); err != nil {
+		return nil, err
+	}
+
+	// check if any event occurs since the last run, if so, delete the helm release to run the DataLoad again
+	triggered, err := dataflow.ReconcileEventTrigger(o.Client, o.dataLoad, ctx.Dataset, o.dataLoad.Spec.Events, result)
+	if err != nil {
+		ctx.Log.Error(err, "can't check events of DataLoad", "namespace", ctx.Namespace, "name", o.dataLoad.GetName())
+		return nil, err
+	}
+	if triggered {
+		ctx.Log.Info("DataLoad is triggered by events, will delete helm chart and run again", "namespace", ctx.Namespace, "releaseName", releaseName)
+		cdataload.ResetInfos(result.Infos)
+		if err = helm.DeleteReleaseIfExists(releaseName, o.dataLoad.GetNamespace()); err != nil {
+			ctx.Log.Error(err, "can't delete DataLoad release", "namespace", ctx.Namespace, "releaseName", releaseName)
+			return nil, err
+		}
+	}
+	return
 }
 
 // getJobOperationStatus is a shared helper for OnceStatusHandler and OnEventStatusHandler.
@@ -194,3 +249,101 @@ func getJobOperationStatus(ctx cruntime.ReconcileRequestContext, c client.Client
 	result.Duration = utils.CalculateDuration(job.CreationTimestamp.Time, finishedJobCondition.LastTransitionTime.Time)
 	return
 }
+
+// updateJobProgress gets the DataLoad job by name and updates the progress reported by its loader pod.
+func updateJobProgress(ctx cruntime.ReconcileRequestContext, c client.Client, dataLoad *datav1alpha1.DataLoad, jobName string, result *datav1alpha1.OperationStatus) {
+	job, err := kubeclient.GetJob(c, jobName, dataLoad.GetNamespace())
+	if err != nil {
+		ctx.Log.V(1).Info("can't get DataLoad job to update progress", "jobName", jobName, "error", err.Error())
+		return
+	}
+	updateProgress(ctx, c, dataLoad, job, result)
+}
+
+// updateProgress reads the progress reported by the running loader pod of the job, and surfaces it in
+// the Infos of the OperationStatus and the DataLoad metrics. Only the loaders of some runtimes report
+// the progress, and it's read at most once every 30 seconds from a pod. Failing to read it is not fatal.
+func updateProgress(ctx cruntime.ReconcileRequestContext, c client.Client, dataLoad *datav1alpha1.DataLoad, job *batchv1.Job, result *datav1alpha1.OperationStatus) {
+	if !cdataload.SupportsProgress(ctx.RuntimeType) {
+		return
+	}
+	pod, err := kubeclient.GetRunningPodForJob(c, job)
+	if err != nil || pod == nil {
+		ctx.Log.V(1).Info("no running DataLoad pod to read progress from", "jobName", job.GetName(), "error", err)
+		return
+	}
+	if !cdataload.AllowReadProgress(pod.GetUID()) {
+		return
+	}
+
+	progress, err := cdataload.ReadProgress(pod.GetName(), pod.GetNamespace())
+	if err != nil || progress == nil {
+		ctx.Log.V(1).Info("no progress reported by DataLoad pod", "podName", pod.GetName(), "error", err)
+		return
+	}
+
+	if result.Infos == nil {
+		result.Infos = map[string]string{}
+	}
+	progress.UpdateInfos(result.Infos)
+
+	m := metrics.GetOrCreateDataLoadMetrics(dataLoad.GetNamespace(), dataLoad.GetName(), dataLoad.Spec.Dataset.Name)
+	m.SetFilesLoaded(float64(progress.FilesLoaded))
+	m.SetBytesLoaded(float64(progress.BytesLoaded))
+	if ratio, ok := progress.Ratio(); ok {
+		m.SetProgressRatio(ratio)
+	}
+	if progress.UpdateTime > 0 {
+		m.SetLastProgressTime(float64(progress.UpdateTime))
+	}
+	if finishTime, ok := progress.EstimatedFinishTime(); ok {
+		m.SetEstimatedFinishTime(float64(finishTime.Unix()))
+	}
+}
+
+// updateTargetNodes records the target nodes of the DataLoad. The target nodes are resolved when a run of the DataLoad
+// starts, and the ones with a worker of the runtime are marked as loaded when the run completes.
+func updateTargetNodes(ctx cruntime.ReconcileRequestContext, c client.Client, dataLoad *datav1alpha1.DataLoad,
+	result *datav1alpha1.OperationStatus) error {
+	if dataLoad.Spec.TargetNodes == nil || ctx.Dataset == nil {
+		return nil
+	}
+	runtimeLabel := utils.GetRuntimeLabelName(ctx.RuntimeType, ctx.Dataset.Namespace, ctx.Dataset.Name, string(ctx.Dataset.UID))
+
+	finished := result.Phase == common.PhaseComplete || result.Phase == common.PhaseFailed
+	loaded := targetNodesLoaded(result.TargetNodes)
+	if finished && loaded {
+		// the target nodes have been marked when the run completed
+		return nil
+	}
+
+	var nodeNames []string
+	for _, node := range result.TargetNodes {
+		nodeNames = append(nodeNames, node.NodeName)
+	}
+	if len(nodeNames) == 0 || loaded {
+		// the nodes are resolved again when a new run starts after the last run loaded them
+		var err error
+		nodeNames, err = cdataload.ResolveTargetNodes(c, dataLoad)
+		if err != nil {
+			return errors.Wrap(err, "failed to resolve the target nodes")
+		}
+	}
+
+	targetNodes, err := cdataload.VerifyTargetNodes(c, nodeNames, runtimeLabel)
+	if err != nil {
+		return errors.Wrap(err, "failed to verify the target nodes")
+	}
+	result.TargetNodes = targetNodes
+	return nil
+}
+
+// targetNodesLoaded checks if the target nodes are marked as loaded by the last completed run
+func targetNodesLoaded(targetNodes []datav1alpha1.TargetNodeStatus) bool {
+	for _, node := range targetNodes {
+		if node.Phase == datav1alpha1.TargetNodeLoaded {
+			return true
+		}
+	}
+	return false
+}
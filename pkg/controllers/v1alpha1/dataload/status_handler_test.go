@@ -27,12 +27,17 @@ import (
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
 
 	"github.com/fluid-cloudnative/fluid/api/v1alpha1"
 	"github.com/fluid-cloudnative/fluid/pkg/common"
+	cdataload "github.com/fluid-cloudnative/fluid/pkg/dataload"
 	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
 	"github.com/fluid-cloudnative/fluid/pkg/utils/compatibility"
 	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
 )
 
 const (
@@ -197,6 +202,107 @@ var _ = Describe("OnceStatusHandler", func() {
 		Expect(opStatus).NotTo(BeIdenticalTo(&mockDataload.Status))
 		Expect(*opStatus).To(Equal(*originalStatus))
 	})
+
+	It("GetOperationStatus reports progress of the running loader pod when executing", func() {
+		mockDataload.Status.Phase = common.PhaseExecuting
+		runningJob := batchv1.Job{
+			ObjectMeta: v1.ObjectMeta{Name: loaderJobName, Namespace: defaultNamespace},
+			Spec: batchv1.JobSpec{
+				Selector: &v1.LabelSelector{MatchLabels: map[string]string{"job-name": loaderJobName}},
+			},
+		}
+		loaderPod := corev1.Pod{
+			ObjectMeta: v1.ObjectMeta{Name: loaderJobName + "-abcde", Namespace: defaultNamespace, UID: "loader-pod-uid", Labels: map[string]string{"job-name": loaderJobName}},
+			Status:     corev1.PodStatus{Phase: corev1.PodRunning},
+		}
+		Expect(corev1.AddToScheme(testScheme)).To(Succeed())
+		client := fake.NewFakeClientWithScheme(testScheme, &mockDataload, &runningJob, &loaderPod)
+		handler := &OnceStatusHandler{Client: client, dataLoad: &mockDataload}
+		ctx := cruntime.ReconcileRequestContext{
+			NamespacedName: types.NamespacedName{Namespace: defaultNamespace, Name: ""},
+			Log:            fake.NullLogger(),
+			RuntimeType:    common.AlluxioRuntime,
+		}
+
+		var readFrom string
+		patches := gomonkey.ApplyFunc(cdataload.ReadProgress, func(podName, namespace string) (*cdataload.Progress, error) {
+			readFrom = podName
+			return &cdataload.Progress{
+				FilesLoaded: 1,
+				FilesTotal:  4,
+				Targets:     []cdataload.TargetProgress{{Path: "/data", FilesLoaded: 1, FilesTotal: 4}},
+			}, nil
+		})
+		defer patches.Reset()
+
+		opStatus, err := handler.GetOperationStatus(ctx, &mockDataload.Status)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(readFrom).To(Equal(loaderPod.Name))
+		Expect(opStatus.Phase).To(Equal(common.PhaseExecuting))
+		Expect(opStatus.Infos).To(HaveKeyWithValue(cdataload.InfoFilesLoaded, "1"))
+		Expect(opStatus.Infos).To(HaveKeyWithValue(cdataload.InfoProgress, "25.00%"))
+		Expect(opStatus.Infos).To(HaveKeyWithValue(cdataload.InfoTargetsCompleted, "0/1"))
+	})
+})
+
+var _ = Describe("updateTargetNodes", func() {
+	It("records the target nodes with the runtime label to be marked when the job completes", func() {
+		testScheme := runtime.NewScheme()
+		Expect(v1alpha1.AddToScheme(testScheme)).To(Succeed())
+		Expect(batchv1.AddToScheme(testScheme)).To(Succeed())
+		Expect(corev1.AddToScheme(testScheme)).To(Succeed())
+
+		dataset := &v1alpha1.Dataset{ObjectMeta: v1.ObjectMeta{Name: targetDataset, Namespace: defaultNamespace, UID: "dataset-uid"}}
+		runtimeLabel := utils.GetRuntimeLabelName(common.AlluxioRuntime, defaultNamespace, targetDataset, "dataset-uid")
+		dataLoad := v1alpha1.DataLoad{
+			ObjectMeta: v1.ObjectMeta{Name: dataLoadName, Namespace: defaultNamespace},
+			Spec: v1alpha1.DataLoadSpec{
+				Dataset:     v1alpha1.TargetDataset{Name: targetDataset, Namespace: defaultNamespace},
+				TargetNodes: &v1alpha1.DataLoadTargetNodes{NodeNames: []string{"node-2", nodeName}},
+			},
+			Status: v1alpha1.OperationStatus{Phase: common.PhaseExecuting},
+		}
+		job := batchv1.Job{
+			ObjectMeta: v1.ObjectMeta{Name: loaderJobName, Namespace: defaultNamespace},
+			Status: batchv1.JobStatus{
+				Conditions: []batchv1.JobCondition{{
+					Type:               batchv1.JobComplete,
+					Status:             corev1.ConditionTrue,
+					LastProbeTime:      v1.NewTime(time.Now()),
+					LastTransitionTime: v1.NewTime(time.Now()),
+				}},
+			},
+		}
+		cacheNode := corev1.Node{ObjectMeta: v1.ObjectMeta{Name: nodeName, Labels: map[string]string{runtimeLabel: "true"}}}
+		otherNode := corev1.Node{ObjectMeta: v1.ObjectMeta{Name: "node-2"}}
+
+		client := fake.NewFakeClientWithScheme(testScheme, &dataLoad, &job, &cacheNode, &otherNode)
+		recorder := record.NewFakeRecorder(1)
+		handler := &OnceStatusHandler{Client: client, dataLoad: &dataLoad}
+		ctx := cruntime.ReconcileRequestContext{
+			NamespacedName: types.NamespacedName{Namespace: defaultNamespace, Name: targetDataset},
+			Dataset:        dataset,
+			RuntimeType:    common.AlluxioRuntime,
+			Log:            fake.NullLogger(),
+			Recorder:       recorder,
+		}
+
+		opStatus, err := handler.GetOperationStatus(ctx, &dataLoad.Status)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(opStatus.Phase).To(Equal(common.PhaseComplete))
+		Expect(opStatus.TargetNodes).To(Equal([]v1alpha1.TargetNodeStatus{
+			{NodeName: nodeName, Phase: v1alpha1.TargetNodePending},
+			{NodeName: "node-2", Phase: v1alpha1.TargetNodeNoCacheWorker},
+		}))
+		Expect(recorder.Events).To(BeEmpty())
+
+		// the target nodes marked when the run completed are kept
+		loaded := opStatus.DeepCopy()
+		loaded.TargetNodes[0] = v1alpha1.TargetNodeStatus{NodeName: nodeName, Phase: v1alpha1.TargetNodeLoaded}
+		opStatus, err = handler.GetOperationStatus(ctx, loaded)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(opStatus.TargetNodes).To(Equal(loaded.TargetNodes))
+	})
 })
 
 var _ = Describe("CronStatusHandler", func() {
@@ -299,6 +405,36 @@ var _ = Describe("CronStatusHandler", func() {
 		Expect(opStatus.Phase).To(Equal(common.PhasePending))
 	})
 
+	It("resumes the suspended job only when the DataLoad leaves the operation queue", func() {
+		job := batchv1.Job{
+			ObjectMeta: v1.ObjectMeta{
+				Name:              "test-dataload-loader-job-1",
+				Namespace:         defaultNamespace,
+				Labels:            map[string]string{"cronjob": loaderJobName},
+				CreationTimestamp: lastScheduleTime,
+			},
+			Spec: batchv1.JobSpec{Suspend: ptr.To(true)},
+		}
+		client := fake.NewFakeClientWithScheme(testScheme, &mockCronDataload, &mockCronJob, &job)
+		handler := &CronStatusHandler{Client: client, dataLoad: &mockCronDataload}
+		ctx := cruntime.ReconcileRequestContext{Log: fake.NullLogger()}
+
+		opStatus, err := handler.GetOperationStatus(ctx, &mockCronDataload.Status)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(opStatus.Phase).To(Equal(common.PhasePending))
+		jobToCheck, err := kubeclient.GetJob(client, job.Name, job.Namespace)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(*jobToCheck.Spec.Suspend).To(BeTrue())
+
+		mockCronDataload.Status.Phase = common.PhaseExecuting
+		opStatus, err = handler.GetOperationStatus(ctx, &mockCronDataload.Status)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(opStatus.Phase).To(Equal(common.PhaseExecuting))
+		jobToCheck, err = kubeclient.GetJob(client, job.Name, job.Namespace)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(*jobToCheck.Spec.Suspend).To(BeFalse())
+	})
+
 	It("returns status with timestamps when no current job matches schedule time", func() {
 		// no jobs in the fake client that match lastScheduleTime
 		client := fake.NewFakeClientWithScheme(testScheme, &mockCronDataload, &mockCronJob)
@@ -32,6 +32,7 @@ import (
 
 	"github.com/fluid-cloudnative/fluid/pkg/controllers"
 	"github.com/fluid-cloudnative/fluid/pkg/dataoperation"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/base"
 	"github.com/fluid-cloudnative/fluid/pkg/utils/compatibility"
 
 	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
@@ -111,6 +112,10 @@ func (r *DataMigrateReconciler) Reconcile(context context.Context, req ctrl.Requ
 
 // SetupWithManager sets up the controller with the given controller manager
 func (r *DataMigrateReconciler) SetupWithManager(mgr ctrl.Manager, options controller.Options) error {
+	// the operation queue of the runtime finds the data operations by their target datasets
+	if err := base.SetupDataOperationIndexer(context.Background(), mgr.GetFieldIndexer(), &datav1alpha1.DataMigrate{}); err != nil {
+		return err
+	}
 	if compatibility.IsBatchV1CronJobSupported() {
 		return ctrl.NewControllerManagedBy(mgr).
 			WithOptions(options).
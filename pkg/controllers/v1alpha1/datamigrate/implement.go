@@ -118,6 +118,44 @@ func (r *dataMigrateOperation) Validate(ctx cruntime.ReconcileRequestContext) ([
 		}
 	}
 
+	// resumable data migration must specify the volume keeping the manifest
+	if r.dataMigrate.Spec.Resume && len(r.dataMigrate.Spec.ManifestVolumeClaim) == 0 {
+		err := fmt.Errorf("resumable DataMigrate(%s) does not set the manifestVolumeClaim", r.dataMigrate.GetName())
+		return []datav1alpha1.Condition{
+			{
+				Type:               common.Failed,
+				Status:             v1.ConditionTrue,
+				Reason:             common.ResumeManifestVolumeClaimNotSet,
+				Message:            "the manifestVolumeClaim is not set for the resumable DataMigrate",
+				LastProbeTime:      metav1.NewTime(time.Now()),
+				LastTransitionTime: metav1.NewTime(time.Now()),
+			},
+		}, err
+	}
+
+	// the manifest is only kept by the migrate job of JuiceFSRuntime, and recorded from the logs of the launcher
+	if r.dataMigrate.Spec.Resume {
+		var message string
+		if r.dataMigrate.Spec.Parallelism > 1 {
+			message = "resuming is not supported when parallelism is greater than 1"
+		} else if ctx.RuntimeType != common.JuiceFSRuntime {
+			message = fmt.Sprintf("resuming is not supported by the runtime type %s", ctx.RuntimeType)
+		}
+		if len(message) > 0 {
+			err := fmt.Errorf("DataMigrate(%s) can't be resumed: %s", r.dataMigrate.GetName(), message)
+			return []datav1alpha1.Condition{
+				{
+					Type:               common.Failed,
+					Status:             v1.ConditionTrue,
+					Reason:             common.ResumeNotSupported,
+					Message:            message,
+					LastProbeTime:      metav1.NewTime(time.Now()),
+					LastTransitionTime: metav1.NewTime(time.Now()),
+				},
+			}, err
+		}
+	}
+
 	if r.dataMigrate.GetNamespace() != targetDataSet.Namespace {
 		err := fmt.Errorf("DataMigrate(%s) namespace is not same as dataset", r.dataMigrate.GetName())
 		return []datav1alpha1.Condition{
@@ -180,6 +218,16 @@ func (r *dataMigrateOperation) GetTTL() (ttl *int32, err error) {
 	return
 }
 
+// GetRetryPolicy implements dataoperation.OperationInterface.
+func (r *dataMigrateOperation) GetRetryPolicy() *datav1alpha1.RetryPolicy {
+	switch r.dataMigrate.Spec.Policy {
+	case datav1alpha1.Cron, datav1alpha1.OnEvent:
+		return nil
+	default:
+		return r.dataMigrate.Spec.RetryPolicy
+	}
+}
+
 func (r *dataMigrateOperation) GetParallelTaskNumber() int32 {
 	return r.dataMigrate.Spec.Parallelism
 }
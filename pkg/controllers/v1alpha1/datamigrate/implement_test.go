@@ -60,6 +60,70 @@ var _ = Describe("dataMigrateOperation", func() {
 			Expect(got[0].Reason).To(Equal(common.TargetSSHSecretNameNotSet))
 		})
 
+		It("should error when manifest volume claim is not set for resumable migrate", func() {
+			op := &dataMigrateOperation{
+				dataMigrate: &datav1alpha1.DataMigrate{
+					Spec: datav1alpha1.DataMigrateSpec{
+						Parallelism: 1,
+						Resume:      true,
+					},
+				},
+			}
+			ctx := runtime.ReconcileRequestContext{
+				Dataset: nil,
+			}
+
+			got, err := op.Validate(ctx)
+
+			Expect(err).To(HaveOccurred())
+			Expect(got).NotTo(BeEmpty())
+			Expect(got[0].Reason).To(Equal(common.ResumeManifestVolumeClaimNotSet))
+		})
+
+		It("should error when resuming a parallel migrate", func() {
+			op := &dataMigrateOperation{
+				dataMigrate: &datav1alpha1.DataMigrate{
+					Spec: datav1alpha1.DataMigrateSpec{
+						Parallelism:         2,
+						ParallelOptions:     map[string]string{cdatamigrate.SSHSecretName: "ssh-secret"},
+						Resume:              true,
+						ManifestVolumeClaim: "migrate-manifest",
+					},
+				},
+			}
+			ctx := runtime.ReconcileRequestContext{
+				RuntimeType: common.JuiceFSRuntime,
+			}
+
+			got, err := op.Validate(ctx)
+
+			Expect(err).To(HaveOccurred())
+			Expect(got).NotTo(BeEmpty())
+			Expect(got[0].Reason).To(Equal(common.ResumeNotSupported))
+		})
+
+		It("should error when the runtime does not support resuming", func() {
+			op := &dataMigrateOperation{
+				dataMigrate: &datav1alpha1.DataMigrate{
+					Spec: datav1alpha1.DataMigrateSpec{
+						Parallelism:         1,
+						Resume:              true,
+						ManifestVolumeClaim: "migrate-manifest",
+					},
+				},
+			}
+			ctx := runtime.ReconcileRequestContext{
+				RuntimeType: common.CacheRuntime,
+			}
+
+			got, err := op.Validate(ctx)
+
+			Expect(err).To(HaveOccurred())
+			Expect(got).NotTo(BeEmpty())
+			Expect(got[0].Reason).To(Equal(common.ResumeNotSupported))
+			Expect(got[0].Message).To(ContainSubstring(common.CacheRuntime))
+		})
+
 		It("should error when datamigrate namespace differs from dataset namespace", func() {
 			op := &dataMigrateOperation{
 				dataMigrate: &datav1alpha1.DataMigrate{
@@ -111,20 +111,17 @@ func (c *CronStatusHandler) GetOperationStatus(ctx cruntime.ReconcileRequestCont
 		return
 	}
 
-	// only parallel tasks will set the field Suspend to true, no need to check the parallel task number > 1.
-	if currentJob.Spec.Suspend != nil && *currentJob.Spec.Suspend {
-		ctx.Log.Info("scale the migrate workers statefulset", "name", utils.GetParallelOperationWorkersName(releaseName))
-		// scale the stateful set, the job acts as a worker.
-		err = kubeclient.ScaleStatefulSet(c.Client, utils.GetParallelOperationWorkersName(releaseName), c.dataMigrate.Namespace, c.dataMigrate.Spec.Parallelism-1)
-		if err != nil {
-			return
+	// the jobs of the cronjob start suspended, and the current job runs once the DataMigrate leaves the operation queue
+	if opStatus.Phase == common.PhaseExecuting && currentJob.Spec.Suspend != nil && *currentJob.Spec.Suspend {
+		if c.dataMigrate.Spec.Parallelism > 1 {
+			ctx.Log.Info("scale the migrate workers statefulset", "name", utils.GetParallelOperationWorkersName(releaseName))
+			// scale the stateful set, the job acts as a worker.
+			err = kubeclient.ScaleStatefulSet(c.Client, utils.GetParallelOperationWorkersName(releaseName), c.dataMigrate.Namespace, c.dataMigrate.Spec.Parallelism-1)
+			if err != nil {
+				return
+			}
 		}
-		// set the job suspend field to false
-		jobToUpdate := currentJob.DeepCopy()
-		flag := false
-		jobToUpdate.Spec.Suspend = &flag
-		err = kubeclient.UpdateJob(c.Client, jobToUpdate)
-		if err != nil {
+		if err = kubeclient.ResumeJob(c.Client, currentJob); err != nil {
 			return
 		}
 	}
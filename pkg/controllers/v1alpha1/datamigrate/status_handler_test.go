@@ -470,7 +470,7 @@ var _ = Describe("CronStatusHandler", func() {
 			Expect(*updatedSts.Spec.Replicas).To(Equal(defaultStsReplicas))
 		})
 
-		It("should return PhasePending, scale StatefulSet, and unsuspend job when job starts with suspend=true", func() {
+		It("should return PhasePending, then scale StatefulSet and unsuspend job once Executing when job starts with suspend=true", func() {
 			trueFlag := true
 			job := batchv1.Job{
 				ObjectMeta: v1.ObjectMeta{
@@ -510,9 +510,18 @@ var _ = Describe("CronStatusHandler", func() {
 			Expect(opStatus.LastSuccessfulTime).To(Equal(&lastSuccessfulTime))
 			Expect(opStatus.Phase).To(Equal(common.PhasePending))
 
-			// Verify StatefulSet was scaled up to Parallelism-1
+			// Verify the job waits in the operation queue
 			updatedSts, err := kubeclient.GetStatefulSet(fakeClient, sts.Name, sts.Namespace)
 			Expect(err).NotTo(HaveOccurred())
+			Expect(*updatedSts.Spec.Replicas).To(Equal(zeroReplicas))
+
+			parallelDataMigrate.Status.Phase = common.PhaseExecuting
+			_, err = handler.GetOperationStatus(ctx, &parallelDataMigrate.Status)
+			Expect(err).NotTo(HaveOccurred())
+
+			// Verify StatefulSet was scaled up to Parallelism-1
+			updatedSts, err = kubeclient.GetStatefulSet(fakeClient, sts.Name, sts.Namespace)
+			Expect(err).NotTo(HaveOccurred())
 			Expect(*updatedSts.Spec.Replicas).To(Equal(defaultStsReplicas))
 
 			// Verify job was unsuspended
@@ -21,6 +21,7 @@ import (
 	"fmt"
 
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -29,8 +30,10 @@ import (
 	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
 	"github.com/fluid-cloudnative/fluid/pkg/common"
 	"github.com/fluid-cloudnative/fluid/pkg/controllers"
+	"github.com/fluid-cloudnative/fluid/pkg/dataflow"
 	"github.com/fluid-cloudnative/fluid/pkg/dataoperation"
 	cdataprocess "github.com/fluid-cloudnative/fluid/pkg/dataprocess"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/base"
 	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
 	"github.com/fluid-cloudnative/fluid/pkg/utils"
 	"github.com/go-logr/logr"
@@ -115,10 +118,35 @@ func (r *DataProcessReconciler) Reconcile(context context.Context, req ctrl.Requ
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *DataProcessReconciler) SetupWithManager(mgr ctrl.Manager, options controller.Options) error {
-	return ctrl.NewControllerManagedBy(mgr).
+	// the operation queue of the runtime finds the data operations by their target datasets
+	if err := base.SetupDataOperationIndexer(context.Background(), mgr.GetFieldIndexer(), &datav1alpha1.DataProcess{}); err != nil {
+		return err
+	}
+	bld := ctrl.NewControllerManagedBy(mgr).
 		WithOptions(options).
-		For(&datav1alpha1.DataProcess{}).
-		Complete(r)
+		For(&datav1alpha1.DataProcess{})
+	return dataflow.SetupEventSourceWatches(bld, r.Client, listOnEventDataProcesses).Complete(r)
+}
+
+// listOnEventDataProcesses lists the DataProcesses with OnEvent policy for the event source watches
+func listOnEventDataProcesses(ctx context.Context, c client.Client, namespace string) ([]dataflow.OnEventOperation, error) {
+	var dataProcesses datav1alpha1.DataProcessList
+	if err := c.List(ctx, &dataProcesses, client.InNamespace(namespace)); err != nil {
+		return nil, err
+	}
+
+	var operations []dataflow.OnEventOperation
+	for _, dataProcess := range dataProcesses.Items {
+		if dataProcess.Spec.Policy != datav1alpha1.OnEvent {
+			continue
+		}
+		operations = append(operations, dataflow.OnEventOperation{
+			NamespacedName: types.NamespacedName{Namespace: dataProcess.Namespace, Name: dataProcess.Name},
+			Dataset:        types.NamespacedName{Namespace: dataProcess.Spec.Dataset.Namespace, Name: dataProcess.Spec.Dataset.Name},
+			Events:         dataProcess.Spec.Events,
+		})
+	}
+	return operations, nil
 }
 
 func (r *DataProcessReconciler) ControllerName() string {
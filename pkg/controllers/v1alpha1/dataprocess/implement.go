@@ -25,6 +25,7 @@ import (
 
 	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
 	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/dataflow"
 	"github.com/fluid-cloudnative/fluid/pkg/dataoperation"
 	cdataprocess "github.com/fluid-cloudnative/fluid/pkg/dataprocess"
 	"github.com/fluid-cloudnative/fluid/pkg/runtime"
@@ -222,6 +223,29 @@ func (r *dataProcessOperation) Validate(ctx runtime.ReconcileRequestContext) ([]
 			},
 		}, err
 	}
+
+	// DataProcess with OnEvent policy must specify valid event sources
+	if dataProcess.Spec.Policy == datav1alpha1.OnEvent {
+		if err := dataflow.ValidateEventSources(dataProcess.Spec.Events); err != nil {
+			r.Recorder.Eventf(dataProcess,
+				corev1.EventTypeWarning,
+				common.DataOperationEventSourceInvalid,
+				"DataProcess(%s) has invalid event sources: %v",
+				dataProcess.Name, err,
+			)
+			now := time.Now()
+			return []datav1alpha1.Condition{
+				{
+					Type:               common.Failed,
+					Status:             corev1.ConditionTrue,
+					Reason:             common.DataOperationEventSourceInvalid,
+					Message:            err.Error(),
+					LastProbeTime:      metav1.NewTime(now),
+					LastTransitionTime: metav1.NewTime(now),
+				},
+			}, err
+		}
+	}
 	return nil, nil
 }
 
@@ -274,6 +298,16 @@ func (r *dataProcessOperation) GetTTL() (ttl *int32, err error) {
 	return
 }
 
+// GetRetryPolicy implements dataoperation.OperationInterface.
+func (r *dataProcessOperation) GetRetryPolicy() *datav1alpha1.RetryPolicy {
+	switch r.dataProcess.Spec.Policy {
+	case datav1alpha1.Cron, datav1alpha1.OnEvent:
+		return nil
+	default:
+		return r.dataProcess.Spec.RetryPolicy
+	}
+}
+
 func (r *dataProcessOperation) GetParallelTaskNumber() int32 {
 	return 1
 }
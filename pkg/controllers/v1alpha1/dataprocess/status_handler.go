@@ -19,6 +19,7 @@ package dataprocess
 import (
 	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
 	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/dataflow"
 	"github.com/fluid-cloudnative/fluid/pkg/dataoperation"
 	"github.com/fluid-cloudnative/fluid/pkg/runtime"
 	"github.com/fluid-cloudnative/fluid/pkg/utils"
@@ -146,6 +147,14 @@ func (handler *CronStatusHandler) GetOperationStatus(ctx runtime.ReconcileReques
 		return
 	}
 
+	// the jobs of the cronjob start suspended, and the current job runs once the DataProcess leaves the operation queue
+	if opStatus.Phase == common.PhaseExecuting {
+		if err = kubeclient.ResumeJob(handler.Client, currentJob); err != nil {
+			ctx.Log.Error(err, "can't resume DataProcess job", "namespace", ctx.Namespace, "jobName", currentJob.Name)
+			return
+		}
+	}
+
 	finishedJobCondition := kubeclient.GetFinishedJobCondition(currentJob)
 	if finishedJobCondition == nil {
 		ctx.Log.V(1).Info("DataProcess job still running", "namespace", ctx.Namespace, "cronjobName", cronjobName)
@@ -183,6 +192,30 @@ type OnEventStatusHandler struct {
 var _ dataoperation.StatusHandler = &OnEventStatusHandler{}
 
 func (handler *OnEventStatusHandler) GetOperationStatus(ctx runtime.ReconcileRequestContext, opStatus *datav1alpha1.OperationStatus) (result *datav1alpha1.OperationStatus, err error) {
+	result, err = handler.getJobStatus(ctx, opStatus)
+	if err != nil {
+		return
+	}
+
+	// check if any event occurs since the last run, if so, delete the helm release to run the DataProcess again
+	object := handler.dataProcess
+	triggered, err := dataflow.ReconcileEventTrigger(handler.Client, object, ctx.Dataset, object.Spec.Events, result)
+	if err != nil {
+		ctx.Log.Error(err, "can't check events of dataprocess", "namespace", object.GetNamespace(), "name", object.GetName())
+		return nil, err
+	}
+	if triggered {
+		releaseName := utils.GetDataProcessReleaseName(object.GetName())
+		ctx.Log.Info("DataProcess is triggered by events, will delete helm chart and run again", "namespace", object.GetNamespace(), "releaseName", releaseName)
+		if err = helm.DeleteReleaseIfExists(releaseName, object.GetNamespace()); err != nil {
+			ctx.Log.Error(err, "failed to delete dataprocess helm release", "namespace", object.GetNamespace(), "releaseName", releaseName)
+			return nil, err
+		}
+	}
+	return
+}
+
+func (handler *OnEventStatusHandler) getJobStatus(ctx runtime.ReconcileRequestContext, opStatus *datav1alpha1.OperationStatus) (result *datav1alpha1.OperationStatus, err error) {
 	result = opStatus.DeepCopy()
 	object := handler.dataProcess
 
@@ -17,6 +17,7 @@ package dataset
 import (
 	"context"
 	"errors"
+	"fmt"
 	"reflect"
 	"strings"
 	"time"
@@ -26,6 +27,9 @@ import (
 
 	"github.com/fluid-cloudnative/fluid/pkg/common"
 	"github.com/fluid-cloudnative/fluid/pkg/controllers/deploy"
+	"github.com/fluid-cloudnative/fluid/pkg/credentials"
+	"github.com/fluid-cloudnative/fluid/pkg/datasetshare"
+	"github.com/fluid-cloudnative/fluid/pkg/datasetsnapshot"
 	"github.com/fluid-cloudnative/fluid/pkg/ddc/base"
 	v1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
@@ -46,8 +50,14 @@ import (
 const (
 	finalizer      = "fluid-dataset-controller-finalizer"
 	controllerName = "DatasetController"
+
+	// maxChangedFiles is the maximum number of the changed files reported when a dataset fails to be mounted
+	// from a snapshot
+	maxChangedFiles = 5
 )
 
+var listManifest = datasetsnapshot.ListManifest
+
 // DatasetReconciler reconciles a Dataset object
 type DatasetReconciler struct {
 	client.Client
@@ -66,6 +76,13 @@ type reconcileRequestContext struct {
 
 // +kubebuilder:rbac:groups=data.fluid.io,resources=datasets,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=data.fluid.io,resources=datasets/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=data.fluid.io,resources=datasetsnapshots,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch;create;delete
+// +kubebuilder:rbac:groups="",resources=pods/exec,verbs=create
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch
+
+// The secrets, service accounts and service account tokens used to exchange the credentials from credential providers
+// are granted by the Roles in the namespaces allowed by the administrator, see dataset.credentialProviders of the chart.
 
 func (r *DatasetReconciler) Reconcile(context context.Context, req ctrl.Request) (ctrl.Result, error) {
 	ctx := reconcileRequestContext{
@@ -157,7 +174,12 @@ func (r *DatasetReconciler) reconcileDataset(ctx reconcileRequestContext, needRe
 		}
 	}
 
-	// 4. Update the phase to NotBoundDatasetPhase
+	// 4. Fill in the mounts from the snapshot if the dataset is mounted from a snapshot
+	if ctx.Dataset.IsWaitingForSnapshot() {
+		return r.mountFromSnapshot(ctx)
+	}
+
+	// 5. Update the phase to NotBoundDatasetPhase
 	if ctx.Dataset.Status.Phase == datav1alpha1.NoneDatasetPhase {
 		dataset := ctx.Dataset.DeepCopy()
 		dataset.Status.Phase = datav1alpha1.NotBoundDatasetPhase
@@ -172,15 +194,157 @@ func (r *DatasetReconciler) reconcileDataset(ctx reconcileRequestContext, needRe
 		}
 	}
 
-	// 5. Check if needRequeue
+	// 6. Refresh the credentials exchanged from credential providers
+	refreshAfter, refreshed, err := credentials.RefreshCredentials(r.Client, &ctx.Dataset)
+	if err != nil {
+		ctx.Log.Error(err, "Failed to refresh the credentials of the dataset")
+		r.Recorder.Eventf(&ctx.Dataset, v1.EventTypeWarning, common.CredentialsRefreshFailed, "Failed to refresh the credentials because err: %v", err)
+		return utils.RequeueIfError(err)
+	}
+	if refreshed {
+		r.Recorder.Eventf(&ctx.Dataset, v1.EventTypeNormal, common.CredentialsRefreshed, "Refreshed the credentials in secret %s", credentials.GetSecretName(ctx.Dataset.Name))
+	}
+
+	// 7. Check if needRequeue
 	if needRequeue {
+		if refreshAfter > 0 && refreshAfter < r.ResyncPeriod {
+			return utils.RequeueAfterInterval(refreshAfter)
+		}
 		return utils.RequeueAfterInterval(r.ResyncPeriod)
 	}
 
+	if refreshAfter > 0 {
+		return utils.RequeueAfterInterval(refreshAfter)
+	}
+
 	// return utils.RequeueAfterInterval(r.ResyncPeriod)
 	return utils.NoRequeue()
 }
 
+// mountFromSnapshot fills in the mounts of the dataset once the snapshot it's mounted from is complete. The data of
+// a snapshot not cloned is read from the dataset the snapshot is taken from, so it's verified against the manifest
+// of the snapshot first, and the dataset fails if the data can't be reproduced.
+func (r *DatasetReconciler) mountFromSnapshot(ctx reconcileRequestContext) (ctrl.Result, error) {
+	if ctx.Dataset.Status.Phase == datav1alpha1.FailedDatasetPhase {
+		return utils.NoRequeue()
+	}
+
+	snapshotName := ctx.Dataset.Spec.SnapshotRef.Name
+	snapshot := &datav1alpha1.DatasetSnapshot{}
+	if err := r.Get(ctx, types.NamespacedName{Namespace: ctx.Dataset.Namespace, Name: snapshotName}, snapshot); err != nil {
+		if utils.IgnoreNotFound(err) != nil {
+			ctx.Log.Error(err, "Failed to get the snapshot", "snapshot", snapshotName)
+			return utils.RequeueIfError(err)
+		}
+		r.Recorder.Eventf(&ctx.Dataset, v1.EventTypeWarning, common.DatasetSnapshotNotReady, "DatasetSnapshot %s is not found", snapshotName)
+		return utils.RequeueAfterInterval(r.ResyncPeriod)
+	}
+	if snapshot.Status.Phase != common.PhaseComplete {
+		r.Recorder.Eventf(&ctx.Dataset, v1.EventTypeWarning, common.DatasetSnapshotNotReady, "DatasetSnapshot %s is not complete yet", snapshotName)
+		return utils.RequeueAfterInterval(r.ResyncPeriod)
+	}
+
+	if snapshot.Status.Method != datav1alpha1.DatasetSnapshotMethodClone {
+		verified, failure, err := r.verifySnapshot(ctx, snapshot)
+		if err != nil {
+			ctx.Log.Error(err, "Failed to verify the data of the snapshot", "snapshot", snapshotName)
+			return utils.RequeueIfError(err)
+		}
+		if failure != "" {
+			return r.failToMountFromSnapshot(ctx, fmt.Sprintf("Failed to mount the dataset from DatasetSnapshot %s: %s", snapshotName, failure))
+		}
+		if !verified {
+			return utils.RequeueAfterInterval(r.ResyncPeriod)
+		}
+	}
+
+	datasetToUpdate := ctx.Dataset.DeepCopy()
+	datasetsnapshot.MountFromSnapshot(datasetToUpdate, snapshot)
+	if err := r.Update(ctx, datasetToUpdate); err != nil {
+		ctx.Log.Error(err, "Failed to mount the dataset from the snapshot", "snapshot", snapshotName)
+		return utils.RequeueIfError(err)
+	}
+	ctx.Log.Info("Mount the dataset from the snapshot", "snapshot", snapshotName)
+	return utils.RequeueImmediately()
+}
+
+// verifySnapshot lists the files of the dataset the snapshot is taken from in a pod mounting it, and compares them
+// with the manifest of the snapshot. It returns true once the data is verified, or the failure if the data can't
+// be reproduced.
+func (r *DatasetReconciler) verifySnapshot(ctx reconcileRequestContext, snapshot *datav1alpha1.DatasetSnapshot) (verified bool, failure string, err error) {
+	if snapshot.Status.Manifest == nil || snapshot.Status.Manifest.ConfigMap == "" {
+		return false, "the snapshot has no manifest to verify the data against", nil
+	}
+
+	source, err := utils.GetDataset(r.Client, snapshot.Spec.Dataset, snapshot.Namespace)
+	if err != nil {
+		if utils.IgnoreNotFound(err) == nil {
+			return false, fmt.Sprintf("dataset %s the snapshot is taken from is not found", snapshot.Spec.Dataset), nil
+		}
+		return false, "", err
+	}
+	if err = datasetsnapshot.VerifySourceMounts(source, snapshot); err != nil {
+		return false, err.Error(), nil
+	}
+	if source.Status.Phase != datav1alpha1.BoundDatasetPhase {
+		r.Recorder.Eventf(&ctx.Dataset, v1.EventTypeWarning, common.DatasetSnapshotNotReady, "Dataset %s the snapshot is taken from is not bound", source.Name)
+		return false, "", nil
+	}
+
+	pod := &v1.Pod{}
+	err = r.Get(ctx, types.NamespacedName{Namespace: ctx.Dataset.Namespace, Name: datasetsnapshot.GetVerifyPodName(ctx.Dataset.Name)}, pod)
+	if utils.IgnoreNotFound(err) != nil {
+		return false, "", err
+	}
+	if err != nil {
+		return false, "", r.Create(ctx, datasetsnapshot.BuildVerifyPod(&ctx.Dataset, snapshot))
+	}
+	if pod.Status.Phase != v1.PodRunning {
+		ctx.Log.V(1).Info("The pod to verify the snapshot is not running yet", "phase", pod.Status.Phase)
+		return false, "", nil
+	}
+
+	content, manifest, err := listManifest(ctx, pod)
+	if err != nil {
+		r.Recorder.Eventf(&ctx.Dataset, v1.EventTypeWarning, common.DatasetSnapshotNotReady, "Failed to list the files of dataset %s, retrying: %v", source.Name, err)
+		return false, "", nil
+	}
+	if err = r.Delete(ctx, pod); utils.IgnoreNotFound(err) != nil {
+		return false, "", err
+	}
+	if manifest.Digest == snapshot.Status.Manifest.Digest {
+		return true, "", nil
+	}
+
+	configMap, err := kubeclient.GetConfigmapByName(r.Client, snapshot.Status.Manifest.ConfigMap, snapshot.Namespace)
+	if err != nil {
+		return false, "", err
+	}
+	if configMap == nil {
+		return false, "the data is changed since the snapshot is taken", nil
+	}
+	expected, err := datasetsnapshot.ReadManifest(configMap)
+	if err != nil {
+		return false, "the data is changed since the snapshot is taken", nil
+	}
+	return false, fmt.Sprintf("the data is changed since the snapshot is taken, e.g. %s",
+		strings.Join(datasetsnapshot.DiffManifest(expected, content, maxChangedFiles), ", ")), nil
+}
+
+// failToMountFromSnapshot marks the dataset as failed, it's not mounted from the snapshot anymore.
+func (r *DatasetReconciler) failToMountFromSnapshot(ctx reconcileRequestContext, message string) (ctrl.Result, error) {
+	datasetToUpdate := ctx.Dataset.DeepCopy()
+	datasetToUpdate.Status.Phase = datav1alpha1.FailedDatasetPhase
+	datasetToUpdate.Status.Conditions = utils.UpdateDatasetCondition(datasetToUpdate.Status.Conditions,
+		utils.NewDatasetCondition(datav1alpha1.DatasetNotReady, common.DatasetSnapshotFailed, message, v1.ConditionTrue))
+	if err := r.Status().Update(ctx, datasetToUpdate); err != nil {
+		ctx.Log.Error(err, "Failed to update the dataset", "StatusUpdateError", ctx)
+		return utils.RequeueIfError(err)
+	}
+	r.Recorder.Event(&ctx.Dataset, v1.EventTypeWarning, common.DatasetSnapshotFailed, message)
+	return utils.NoRequeue()
+}
+
 // reconcile Dataset Deletion
 func (r *DatasetReconciler) reconcileDatasetDeletion(ctx reconcileRequestContext) (ctrl.Result, error) {
 	log := ctx.Log.WithName("reconcileDatasetDeletion")
@@ -216,6 +380,7 @@ func (r *DatasetReconciler) reconcileDatasetDeletion(ctx reconcileRequestContext
 		if !reflect.DeepEqual(datasetRefToUpdate, ctx.Dataset.Status.DatasetRef) {
 			datasetToUpdate := ctx.Dataset.DeepCopy()
 			datasetToUpdate.Status.DatasetRef = datasetRefToUpdate
+			datasetToUpdate.Status.DatasetRefGrants = datasetshare.RetainRefGrants(datasetToUpdate.Status.DatasetRefGrants, datasetRefToUpdate)
 			if err := r.Status().Update(ctx, datasetToUpdate); err != nil {
 				ctx.Log.Error(err, "DatasetRef has changed but update failed", "DatasetDeleteError", datasetToUpdate)
 				return utils.RequeueAfterInterval(time.Duration(10 * time.Second))
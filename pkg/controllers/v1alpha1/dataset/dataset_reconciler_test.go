@@ -19,12 +19,15 @@ package dataset
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
 	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/datasetsnapshot"
 	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -505,4 +508,133 @@ var _ = Describe("DatasetReconciler (fake client)", func() {
 			Expect(result.RequeueAfter > 0 || result.Requeue).To(BeTrue())
 		})
 	})
+
+	Describe("mountFromSnapshot", func() {
+		const manifestContent = "a.txt\t3\t1700000000\n"
+
+		var (
+			source   *datav1alpha1.Dataset
+			snapshot *datav1alpha1.DatasetSnapshot
+			restored datav1alpha1.Dataset
+			listed   string
+		)
+
+		BeforeEach(func() {
+			source = &datav1alpha1.Dataset{
+				ObjectMeta: metav1.ObjectMeta{Name: "hbase", Namespace: "default"},
+				Spec:       datav1alpha1.DatasetSpec{Mounts: []datav1alpha1.Mount{{Name: "hbase", MountPoint: "oss://bucket/hbase"}}},
+				Status:     datav1alpha1.DatasetStatus{Phase: datav1alpha1.BoundDatasetPhase},
+			}
+			_, manifest, err := datasetsnapshot.ParseManifest("3 1700000000 ./a.txt\n")
+			Expect(err).NotTo(HaveOccurred())
+			manifest.ConfigMap = "hbase-v1-manifest"
+			snapshot = &datav1alpha1.DatasetSnapshot{
+				ObjectMeta: metav1.ObjectMeta{Name: "hbase-v1", Namespace: "default"},
+				Spec:       datav1alpha1.DatasetSnapshotSpec{Dataset: "hbase"},
+				Status: datav1alpha1.DatasetSnapshotStatus{
+					Phase:    common.PhaseComplete,
+					Method:   datav1alpha1.DatasetSnapshotMethodManifest,
+					Mounts:   datasetsnapshot.ReadOnlyMounts(source.Spec.Mounts),
+					Manifest: manifest,
+				},
+			}
+			restored = datav1alpha1.Dataset{
+				ObjectMeta: metav1.ObjectMeta{Name: "hbase-restored", Namespace: "default", Finalizers: []string{finalizer}},
+				Spec:       datav1alpha1.DatasetSpec{SnapshotRef: &datav1alpha1.DatasetSnapshotRef{Name: "hbase-v1"}},
+			}
+
+			listed = manifestContent
+			originalListManifest := listManifest
+			listManifest = func(ctx context.Context, pod *corev1.Pod) (string, *datav1alpha1.DatasetSnapshotManifest, error) {
+				// the files are listed by stat as "<size> <mtime> ./<path>"
+				fields := strings.Split(strings.TrimSpace(listed), "\t")
+				return datasetsnapshot.ParseManifest(fmt.Sprintf("%s %s ./%s\n", fields[1], fields[2], fields[0]))
+			}
+			DeferCleanup(func() {
+				listManifest = originalListManifest
+			})
+		})
+
+		newReconciler := func() *DatasetReconciler {
+			configMap, err := datasetsnapshot.BuildManifestConfigMap(snapshot, manifestContent)
+			Expect(err).NotTo(HaveOccurred())
+			return newTestReconcilerWithInterceptor(interceptor.Funcs{}, source, snapshot, configMap, &restored)
+		}
+
+		runVerifyPod := func(r *DatasetReconciler) {
+			pod := &corev1.Pod{}
+			Expect(r.Get(context.TODO(), types.NamespacedName{Namespace: "default", Name: "hbase-restored-snapshot-verify"}, pod)).To(Succeed())
+			pod.Status.Phase = corev1.PodRunning
+			Expect(r.Status().Update(context.TODO(), pod)).To(Succeed())
+		}
+
+		getRestored := func(r *DatasetReconciler) *datav1alpha1.Dataset {
+			stored := &datav1alpha1.Dataset{}
+			Expect(r.Get(context.TODO(), types.NamespacedName{Namespace: "default", Name: "hbase-restored"}, stored)).To(Succeed())
+			return stored
+		}
+
+		It("mounts the dataset once the data is verified against the manifest", func() {
+			r := newReconciler()
+			result, err := r.mountFromSnapshot(makeReconcileCtx(r, restored))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(Equal(r.ResyncPeriod))
+			Expect(getRestored(r).Spec.Mounts).To(BeEmpty())
+
+			runVerifyPod(r)
+			_, err = r.mountFromSnapshot(makeReconcileCtx(r, restored))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(getRestored(r).Spec.Mounts).To(Equal(snapshot.Status.Mounts))
+			err = r.Get(context.TODO(), types.NamespacedName{Namespace: "default", Name: "hbase-restored-snapshot-verify"}, &corev1.Pod{})
+			Expect(apierrors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("fails the dataset if the data is changed since the snapshot", func() {
+			listed = "a.txt\t4\t1700000100\n"
+			r := newReconciler()
+			_, err := r.mountFromSnapshot(makeReconcileCtx(r, restored))
+			Expect(err).NotTo(HaveOccurred())
+			runVerifyPod(r)
+			result, err := r.mountFromSnapshot(makeReconcileCtx(r, restored))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(ctrl.Result{}))
+
+			stored := getRestored(r)
+			Expect(stored.Spec.Mounts).To(BeEmpty())
+			Expect(stored.Status.Phase).To(Equal(datav1alpha1.FailedDatasetPhase))
+			Expect(stored.Status.Conditions).To(HaveLen(1))
+			Expect(stored.Status.Conditions[0].Message).To(ContainSubstring("the data is changed since the snapshot is taken, e.g. a.txt"))
+
+			// the failed dataset is not verified again
+			result, err = r.mountFromSnapshot(makeReconcileCtx(r, *stored))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(Equal(ctrl.Result{}))
+		})
+
+		It("fails the dataset if the dataset the snapshot is taken from mounts other paths", func() {
+			source.Spec.Mounts[0].MountPoint = "oss://bucket/hbase-v2"
+			r := newReconciler()
+			_, err := r.mountFromSnapshot(makeReconcileCtx(r, restored))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(getRestored(r).Status.Phase).To(Equal(datav1alpha1.FailedDatasetPhase))
+		})
+
+		It("fails the dataset if the snapshot has no manifest", func() {
+			snapshot.Status.Manifest = nil
+			r := newReconciler()
+			_, err := r.mountFromSnapshot(makeReconcileCtx(r, restored))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(getRestored(r).Status.Phase).To(Equal(datav1alpha1.FailedDatasetPhase))
+		})
+
+		It("mounts the dataset from a clone without verification", func() {
+			snapshot.Status.Method = datav1alpha1.DatasetSnapshotMethodClone
+			snapshot.Status.Manifest = nil
+			r := newReconciler()
+			result, err := r.mountFromSnapshot(makeReconcileCtx(r, restored))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Requeue).To(BeTrue())
+			Expect(getRestored(r).Spec.Mounts).To(Equal(snapshot.Status.Mounts))
+		})
+	})
 })
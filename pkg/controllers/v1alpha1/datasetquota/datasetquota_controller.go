@@ -0,0 +1,136 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datasetquota
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/datasetquota"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+)
+
+const controllerName = "DatasetQuotaController"
+
+// DatasetQuotaReconciler reconciles a DatasetQuota object
+type DatasetQuotaReconciler struct {
+	client.Client
+	Recorder     record.EventRecorder
+	Log          logr.Logger
+	ResyncPeriod time.Duration
+}
+
+// NewDatasetQuotaReconciler creates the reconciler which periodically refreshes the cache usage in
+// the status of DatasetQuotas.
+func NewDatasetQuotaReconciler(client client.Client,
+	log logr.Logger,
+	recorder record.EventRecorder,
+	resyncPeriod time.Duration) *DatasetQuotaReconciler {
+	return &DatasetQuotaReconciler{
+		Client:       client,
+		Recorder:     recorder,
+		Log:          log,
+		ResyncPeriod: resyncPeriod,
+	}
+}
+
+// +kubebuilder:rbac:groups=data.fluid.io,resources=datasetquotas,verbs=get;list;watch
+// +kubebuilder:rbac:groups=data.fluid.io,resources=datasetquotas/status,verbs=get;update;patch
+
+func (r *DatasetQuotaReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := r.Log.WithValues("datasetquota", req.NamespacedName)
+	log.V(1).Info("process the request", "request", req)
+
+	quota := &datav1alpha1.DatasetQuota{}
+	if err := r.Get(ctx, req.NamespacedName, quota); err != nil {
+		if utils.IgnoreNotFound(err) == nil {
+			log.V(1).Info("Not found.")
+			return utils.NoRequeue()
+		}
+		log.Error(err, "failed to get datasetquota")
+		return utils.RequeueIfError(err)
+	}
+
+	if utils.HasDeletionTimestamp(quota.ObjectMeta) {
+		return utils.NoRequeue()
+	}
+
+	status, err := datasetquota.CalculateStatus(r.Client, quota)
+	if err != nil {
+		log.Error(err, "failed to calculate the cache usage")
+		return utils.RequeueIfError(err)
+	}
+
+	if status.Requested.Cmp(quota.Spec.CacheCapacity) > 0 {
+		r.Recorder.Eventf(quota, v1.EventTypeWarning, common.DatasetQuotaExceeded,
+			"Cache capacity requested by the datasets %s exceeds the quota %s",
+			status.Requested.String(), quota.Spec.CacheCapacity.String())
+	}
+
+	if err = r.updateStatus(ctx, req, status); err != nil {
+		log.Error(err, "failed to update the status of datasetquota")
+		return utils.RequeueIfError(err)
+	}
+
+	return utils.RequeueAfterInterval(r.ResyncPeriod)
+}
+
+// updateStatus updates the status of the quota only when the usage changes.
+func (r *DatasetQuotaReconciler) updateStatus(ctx context.Context, req ctrl.Request, status datav1alpha1.DatasetQuotaStatus) error {
+	return retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		quota := &datav1alpha1.DatasetQuota{}
+		if err := r.Get(ctx, req.NamespacedName, quota); err != nil {
+			return err
+		}
+
+		quotaToUpdate := quota.DeepCopy()
+		quotaToUpdate.Status.Requested = status.Requested
+		quotaToUpdate.Status.Used = status.Used
+		quotaToUpdate.Status.Cached = status.Cached
+		quotaToUpdate.Status.Datasets = status.Datasets
+		if quotaToUpdate.Status.LastUpdateTime != nil && equality.Semantic.DeepEqual(quota.Status, quotaToUpdate.Status) {
+			return nil
+		}
+
+		now := metav1.Now()
+		quotaToUpdate.Status.LastUpdateTime = &now
+		return r.Status().Update(ctx, quotaToUpdate)
+	})
+}
+
+func (r *DatasetQuotaReconciler) SetupWithManager(mgr ctrl.Manager, options controller.Options) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		WithOptions(options).
+		For(&datav1alpha1.DatasetQuota{}).
+		Complete(r)
+}
+
+func (r *DatasetQuotaReconciler) ControllerName() string {
+	return controllerName
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datasetquota
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+)
+
+var _ = Describe("DatasetQuotaReconciler", func() {
+	var (
+		s        *runtime.Scheme
+		recorder *record.FakeRecorder
+		key      = types.NamespacedName{Name: "quota", Namespace: "fluid"}
+	)
+
+	newQuota := func(capacity string) *datav1alpha1.DatasetQuota {
+		return &datav1alpha1.DatasetQuota{
+			ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace},
+			Spec:       datav1alpha1.DatasetQuotaSpec{CacheCapacity: resource.MustParse(capacity)},
+		}
+	}
+
+	newObjects := func() []runtime.Object {
+		q := resource.MustParse("4Gi")
+		return []runtime.Object{
+			&datav1alpha1.Dataset{
+				ObjectMeta: metav1.ObjectMeta{Name: "demo", Namespace: "fluid"},
+				Status: datav1alpha1.DatasetStatus{
+					Runtimes:    []datav1alpha1.Runtime{{Name: "demo", Namespace: "fluid", Type: common.AlluxioRuntime}},
+					CacheStates: common.CacheStateList{common.CacheCapacity: "8.00GiB", common.Cached: "2.00GiB"},
+				},
+			},
+			&datav1alpha1.AlluxioRuntime{
+				ObjectMeta: metav1.ObjectMeta{Name: "demo", Namespace: "fluid"},
+				Spec: datav1alpha1.AlluxioRuntimeSpec{
+					Replicas:    2,
+					TieredStore: datav1alpha1.TieredStore{Levels: []datav1alpha1.Level{{MediumType: common.SSD, Quota: &q}}},
+				},
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		s = runtime.NewScheme()
+		Expect(datav1alpha1.AddToScheme(s)).To(Succeed())
+		recorder = record.NewFakeRecorder(10)
+	})
+
+	It("should report the cache usage in status", func() {
+		c := fake.NewFakeClientWithScheme(s, append(newObjects(), newQuota("10Gi"))...)
+		r := NewDatasetQuotaReconciler(c, fake.NullLogger(), recorder, 10*time.Second)
+
+		result, err := r.Reconcile(context.TODO(), ctrl.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(10 * time.Second))
+
+		quota := &datav1alpha1.DatasetQuota{}
+		Expect(c.Get(context.TODO(), key, quota)).To(Succeed())
+		Expect(quota.Status.Requested.String()).To(Equal("8Gi"))
+		Expect(quota.Status.Used.String()).To(Equal("8Gi"))
+		Expect(quota.Status.Cached.String()).To(Equal("2Gi"))
+		Expect(quota.Status.Datasets).To(HaveLen(1))
+		Expect(quota.Status.LastUpdateTime).NotTo(BeNil())
+		Expect(recorder.Events).To(BeEmpty())
+	})
+
+	It("should record an event when the requested capacity exceeds the quota", func() {
+		c := fake.NewFakeClientWithScheme(s, append(newObjects(), newQuota("4Gi"))...)
+		r := NewDatasetQuotaReconciler(c, fake.NullLogger(), recorder, 10*time.Second)
+
+		_, err := r.Reconcile(context.TODO(), ctrl.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(recorder.Events).To(Receive(ContainSubstring(common.DatasetQuotaExceeded)))
+	})
+
+	It("should not requeue when the quota is not found", func() {
+		c := fake.NewFakeClientWithScheme(s)
+		r := NewDatasetQuotaReconciler(c, fake.NullLogger(), recorder, 10*time.Second)
+
+		result, err := r.Reconcile(context.TODO(), ctrl.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(Equal(ctrl.Result{}))
+	})
+})
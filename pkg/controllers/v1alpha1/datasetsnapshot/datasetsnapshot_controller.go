@@ -0,0 +1,419 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datasetsnapshot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/datasetsnapshot"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+)
+
+const (
+	finalizer      = "fluid-datasetsnapshot-controller-finalizer"
+	controllerName = "DatasetSnapshotController"
+)
+
+// datasetCloner clones the data of a dataset for the snapshots, stubbed in the tests
+type datasetCloner interface {
+	Clone(snapshot *datav1alpha1.DatasetSnapshot) (done bool, err error)
+	Delete(snapshot *datav1alpha1.DatasetSnapshot) error
+}
+
+var (
+	newCloner = func(c client.Client, name, namespace string, log logr.Logger) (datasetCloner, error) {
+		return datasetsnapshot.NewCloner(c, name, namespace, log)
+	}
+	listManifest = datasetsnapshot.ListManifest
+)
+
+// DatasetSnapshotReconciler reconciles a DatasetSnapshot object
+type DatasetSnapshotReconciler struct {
+	client.Client
+	Recorder     record.EventRecorder
+	Log          logr.Logger
+	ResyncPeriod time.Duration
+}
+
+// NewDatasetSnapshotReconciler creates the reconciler which captures the point-in-time views of the datasets
+// targeted by DatasetSnapshots.
+func NewDatasetSnapshotReconciler(client client.Client,
+	log logr.Logger,
+	recorder record.EventRecorder,
+	resyncPeriod time.Duration) *DatasetSnapshotReconciler {
+	return &DatasetSnapshotReconciler{
+		Client:       client,
+		Recorder:     recorder,
+		Log:          log,
+		ResyncPeriod: resyncPeriod,
+	}
+}
+
+// +kubebuilder:rbac:groups=data.fluid.io,resources=datasetsnapshots,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=data.fluid.io,resources=datasetsnapshots/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=data.fluid.io,resources=databackups,verbs=get;list;watch;create
+// +kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch;create;delete
+// +kubebuilder:rbac:groups="",resources=pods/exec,verbs=create
+// +kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch;create;update
+
+func (r *DatasetSnapshotReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := r.Log.WithValues("datasetsnapshot", req.NamespacedName)
+	log.V(1).Info("process the request", "request", req)
+
+	snapshot := &datav1alpha1.DatasetSnapshot{}
+	if err := r.Get(ctx, req.NamespacedName, snapshot); err != nil {
+		if utils.IgnoreNotFound(err) == nil {
+			log.V(1).Info("Not found.")
+			return utils.NoRequeue()
+		}
+		log.Error(err, "failed to get datasetsnapshot")
+		return utils.RequeueIfError(err)
+	}
+
+	if utils.HasDeletionTimestamp(snapshot.ObjectMeta) {
+		return r.reconcileDeletion(ctx, log, snapshot)
+	}
+
+	if !utils.ContainsString(snapshot.GetFinalizers(), finalizer) {
+		snapshot.Finalizers = append(snapshot.Finalizers, finalizer)
+		if err := r.Update(ctx, snapshot); err != nil {
+			log.Error(err, "failed to add finalizer")
+			return utils.RequeueIfError(err)
+		}
+		return utils.RequeueImmediately()
+	}
+
+	// a snapshot is captured only once
+	if snapshot.Status.Phase == common.PhaseComplete || snapshot.Status.Phase == common.PhaseFailed {
+		return utils.NoRequeue()
+	}
+
+	dataset, err := utils.GetDataset(r.Client, snapshot.Spec.Dataset, snapshot.Namespace)
+	if err != nil {
+		if utils.IgnoreNotFound(err) == nil {
+			r.Recorder.Eventf(snapshot, v1.EventTypeWarning, common.DatasetSnapshotNotReady, "Dataset %s is not found", snapshot.Spec.Dataset)
+			return utils.RequeueAfterInterval(r.ResyncPeriod)
+		}
+		log.Error(err, "failed to get the dataset")
+		return utils.RequeueIfError(err)
+	}
+	if dataset.Status.Phase != datav1alpha1.BoundDatasetPhase || len(dataset.Status.Runtimes) == 0 {
+		r.Recorder.Eventf(snapshot, v1.EventTypeWarning, common.DatasetSnapshotNotReady, "Dataset %s is not bound yet", snapshot.Spec.Dataset)
+		return utils.RequeueAfterInterval(r.ResyncPeriod)
+	}
+
+	method, err := datasetsnapshot.ResolveMethod(snapshot, dataset, dataset.Status.Runtimes[0].Type)
+	if err != nil {
+		return r.fail(ctx, snapshot, "MethodNotSupported", err.Error())
+	}
+	if snapshot.Status.Phase != common.PhaseExecuting || snapshot.Status.Method != method {
+		if err = r.updateStatus(ctx, req.NamespacedName, func(status *datav1alpha1.DatasetSnapshotStatus) {
+			status.Phase = common.PhaseExecuting
+			status.Method = method
+		}); err != nil {
+			log.Error(err, "failed to update the status of datasetsnapshot")
+			return utils.RequeueIfError(err)
+		}
+		return utils.RequeueImmediately()
+	}
+
+	switch method {
+	case datav1alpha1.DatasetSnapshotMethodClone:
+		return r.snapshotByClone(ctx, log, snapshot, dataset)
+	case datav1alpha1.DatasetSnapshotMethodDataBackup:
+		return r.snapshotByDataBackup(ctx, log, snapshot, dataset)
+	default:
+		return r.snapshotByManifest(ctx, log, snapshot, dataset)
+	}
+}
+
+// snapshotByClone clones the data of the dataset inside the JuiceFS file system, out of the sub directory mounted
+// by the dataset. The clone runs in the background of a worker and is polled until it's done.
+func (r *DatasetSnapshotReconciler) snapshotByClone(ctx context.Context, log logr.Logger,
+	snapshot *datav1alpha1.DatasetSnapshot, dataset *datav1alpha1.Dataset) (ctrl.Result, error) {
+	cloner, err := newCloner(r.Client, dataset.Name, dataset.Namespace, log)
+	if err != nil {
+		r.Recorder.Eventf(snapshot, v1.EventTypeWarning, common.DatasetSnapshotNotReady, "Not able to clone the dataset: %v", err)
+		return utils.RequeueAfterInterval(r.ResyncPeriod)
+	}
+	done, err := cloner.Clone(snapshot)
+	if err != nil {
+		r.Recorder.Eventf(snapshot, v1.EventTypeWarning, common.DatasetSnapshotFailed, "Failed to clone the dataset, retrying: %v", err)
+		return utils.RequeueAfterInterval(r.ResyncPeriod)
+	}
+	if !done {
+		log.V(1).Info("the clone of the dataset is running")
+		return utils.RequeueAfterInterval(r.ResyncPeriod)
+	}
+
+	return r.complete(ctx, snapshot, fmt.Sprintf("Cloned the dataset into %s", datasetsnapshot.GetClonePath(snapshot)),
+		func(status *datav1alpha1.DatasetSnapshotStatus) {
+			status.Mounts = datasetsnapshot.CloneMounts(dataset.Spec.Mounts, snapshot)
+		})
+}
+
+// snapshotByDataBackup backs up the metadata of the dataset through a DataBackup, and records the manifest of
+// the data as well, since only the metadata is restored from the backup.
+func (r *DatasetSnapshotReconciler) snapshotByDataBackup(ctx context.Context, log logr.Logger,
+	snapshot *datav1alpha1.DatasetSnapshot, dataset *datav1alpha1.Dataset) (ctrl.Result, error) {
+	backup := &datav1alpha1.DataBackup{}
+	err := r.Get(ctx, types.NamespacedName{Namespace: snapshot.Namespace, Name: datasetsnapshot.GetDataBackupName(snapshot.Name)}, backup)
+	if utils.IgnoreNotFound(err) != nil {
+		log.Error(err, "failed to get the databackup")
+		return utils.RequeueIfError(err)
+	}
+	if err != nil {
+		backup = datasetsnapshot.BuildDataBackup(snapshot)
+		if err = r.Create(ctx, backup); err != nil {
+			log.Error(err, "failed to create the databackup")
+			return utils.RequeueIfError(err)
+		}
+		return utils.RequeueAfterInterval(r.ResyncPeriod)
+	}
+
+	switch backup.Status.Phase {
+	case common.PhaseComplete:
+		return r.completeWithManifest(ctx, log, snapshot, fmt.Sprintf("Backed up the metadata of the dataset through DataBackup %s", backup.Name),
+			func(status *datav1alpha1.DatasetSnapshotStatus) {
+				status.Mounts = datasetsnapshot.ReadOnlyMounts(dataset.Spec.Mounts)
+				status.DataRestoreLocation = datasetsnapshot.GetRestoreLocation(backup)
+			})
+	case common.PhaseFailed:
+		return r.fail(ctx, snapshot, "DataBackupFailed", fmt.Sprintf("DataBackup %s failed", backup.Name))
+	}
+	return utils.RequeueAfterInterval(r.ResyncPeriod)
+}
+
+// snapshotByManifest records the files of the dataset listed in a pod mounting it.
+func (r *DatasetSnapshotReconciler) snapshotByManifest(ctx context.Context, log logr.Logger,
+	snapshot *datav1alpha1.DatasetSnapshot, dataset *datav1alpha1.Dataset) (ctrl.Result, error) {
+	return r.completeWithManifest(ctx, log, snapshot, "Listed the files of the dataset", func(status *datav1alpha1.DatasetSnapshotStatus) {
+		status.Mounts = datasetsnapshot.ReadOnlyMounts(dataset.Spec.Mounts)
+	})
+}
+
+// recordManifest lists the files of the dataset in a pod mounting it and saves the manifest in a ConfigMap, the
+// datasets mounted from the snapshot are verified against it. The manifest is nil until it's recorded, and
+// ErrManifestTooLarge is returned if the manifest can't be saved.
+func (r *DatasetSnapshotReconciler) recordManifest(ctx context.Context, log logr.Logger,
+	snapshot *datav1alpha1.DatasetSnapshot) (*datav1alpha1.DatasetSnapshotManifest, error) {
+	pod := &v1.Pod{}
+	err := r.Get(ctx, types.NamespacedName{Namespace: snapshot.Namespace, Name: datasetsnapshot.GetManifestPodName(snapshot.Name)}, pod)
+	if utils.IgnoreNotFound(err) != nil {
+		log.Error(err, "failed to get the manifest pod")
+		return nil, err
+	}
+	if err != nil {
+		if err = r.Create(ctx, datasetsnapshot.BuildManifestPod(snapshot)); err != nil {
+			log.Error(err, "failed to create the manifest pod")
+			return nil, err
+		}
+		return nil, nil
+	}
+	if pod.Status.Phase != v1.PodRunning {
+		log.V(1).Info("the manifest pod is not running yet", "phase", pod.Status.Phase)
+		return nil, nil
+	}
+
+	content, manifest, err := listManifest(ctx, pod)
+	if err != nil {
+		r.Recorder.Eventf(snapshot, v1.EventTypeWarning, common.DatasetSnapshotFailed, "Failed to list the files of the dataset, retrying: %v", err)
+		return nil, nil
+	}
+	configMap, err := datasetsnapshot.BuildManifestConfigMap(snapshot, content)
+	if err != nil {
+		return nil, err
+	}
+	if err = r.saveManifest(ctx, configMap); err != nil {
+		log.Error(err, "failed to save the manifest")
+		return nil, err
+	}
+	manifest.ConfigMap = configMap.Name
+	if err = r.Delete(ctx, pod); utils.IgnoreNotFound(err) != nil {
+		log.Error(err, "failed to delete the manifest pod")
+		return nil, err
+	}
+	return manifest, nil
+}
+
+// completeWithManifest records the manifest and completes the snapshot with it, or fails the snapshot if the
+// manifest is too large to save.
+func (r *DatasetSnapshotReconciler) completeWithManifest(ctx context.Context, log logr.Logger, snapshot *datav1alpha1.DatasetSnapshot,
+	message string, captured func(status *datav1alpha1.DatasetSnapshotStatus)) (ctrl.Result, error) {
+	manifest, err := r.recordManifest(ctx, log, snapshot)
+	if errors.Is(err, datasetsnapshot.ErrManifestTooLarge) {
+		return r.fail(ctx, snapshot, "ManifestTooLarge", err.Error())
+	}
+	if err != nil {
+		return utils.RequeueIfError(err)
+	}
+	if manifest == nil {
+		return utils.RequeueAfterInterval(r.ResyncPeriod)
+	}
+	return r.complete(ctx, snapshot, fmt.Sprintf("%s, recorded the manifest of %d files", message, manifest.FileCount),
+		func(status *datav1alpha1.DatasetSnapshotStatus) {
+			captured(status)
+			status.Manifest = manifest
+		})
+}
+
+// saveManifest creates or updates the ConfigMap holding the manifest.
+func (r *DatasetSnapshotReconciler) saveManifest(ctx context.Context, configMap *v1.ConfigMap) error {
+	err := r.Create(ctx, configMap)
+	if apierrors.IsAlreadyExists(err) {
+		existing := &v1.ConfigMap{}
+		if err = r.Get(ctx, client.ObjectKeyFromObject(configMap), existing); err != nil {
+			return err
+		}
+		existing.Data = configMap.Data
+		existing.BinaryData = configMap.BinaryData
+		return r.Update(ctx, existing)
+	}
+	return err
+}
+
+// reconcileDeletion removes the clone of the snapshot before the DatasetSnapshot is deleted, the snapshot is
+// kept while any dataset is mounted from it. The DataBackup, pod and ConfigMap created for the snapshot are
+// garbage collected with it.
+func (r *DatasetSnapshotReconciler) reconcileDeletion(ctx context.Context, log logr.Logger, snapshot *datav1alpha1.DatasetSnapshot) (ctrl.Result, error) {
+	if !utils.ContainsString(snapshot.GetFinalizers(), finalizer) {
+		return utils.NoRequeue()
+	}
+
+	datasetList := &datav1alpha1.DatasetList{}
+	if err := r.List(ctx, datasetList, client.InNamespace(snapshot.Namespace)); err != nil {
+		log.Error(err, "failed to list the datasets")
+		return utils.RequeueIfError(err)
+	}
+	for _, dataset := range datasetList.Items {
+		if dataset.Spec.SnapshotRef != nil && dataset.Spec.SnapshotRef.Name == snapshot.Name {
+			r.Recorder.Eventf(snapshot, v1.EventTypeWarning, common.DatasetSnapshotNotReady,
+				"The snapshot is not deleted because dataset %s is mounted from it", dataset.Name)
+			return utils.RequeueAfterInterval(r.ResyncPeriod)
+		}
+	}
+
+	if snapshot.Status.Method == datav1alpha1.DatasetSnapshotMethodClone {
+		_, err := utils.GetDataset(r.Client, snapshot.Spec.Dataset, snapshot.Namespace)
+		if utils.IgnoreNotFound(err) != nil {
+			log.Error(err, "failed to get the dataset")
+			return utils.RequeueIfError(err)
+		}
+		// the clone is gone with the file system if the dataset is deleted
+		if err == nil {
+			cloner, err := newCloner(r.Client, snapshot.Spec.Dataset, snapshot.Namespace, log)
+			if err == nil {
+				err = cloner.Delete(snapshot)
+			}
+			if err != nil {
+				r.Recorder.Eventf(snapshot, v1.EventTypeWarning, common.DatasetSnapshotFailed, "Failed to delete the clone of the snapshot, retrying: %v", err)
+				return utils.RequeueAfterInterval(r.ResyncPeriod)
+			}
+		}
+	}
+
+	snapshot.Finalizers = utils.RemoveString(snapshot.Finalizers, finalizer)
+	if err := r.Update(ctx, snapshot); err != nil {
+		log.Error(err, "failed to remove finalizer")
+		return utils.RequeueIfError(err)
+	}
+	return utils.NoRequeue()
+}
+
+// complete marks the snapshot as complete with the view of the dataset captured.
+func (r *DatasetSnapshotReconciler) complete(ctx context.Context, snapshot *datav1alpha1.DatasetSnapshot, message string,
+	captured func(status *datav1alpha1.DatasetSnapshotStatus)) (ctrl.Result, error) {
+	now := metav1.Now()
+	err := r.updateStatus(ctx, client.ObjectKeyFromObject(snapshot), func(status *datav1alpha1.DatasetSnapshotStatus) {
+		captured(status)
+		status.Phase = common.PhaseComplete
+		status.SnapshotTime = &now
+		status.Conditions = []datav1alpha1.Condition{newCondition(common.Complete, "SnapshotCaptured", message, now)}
+	})
+	if err != nil {
+		r.Log.Error(err, "failed to update the status of datasetsnapshot", "datasetsnapshot", client.ObjectKeyFromObject(snapshot))
+		return utils.RequeueIfError(err)
+	}
+	r.Recorder.Event(snapshot, v1.EventTypeNormal, common.DatasetSnapshotCompleted, message)
+	return utils.NoRequeue()
+}
+
+// fail marks the snapshot as failed, it's not retried.
+func (r *DatasetSnapshotReconciler) fail(ctx context.Context, snapshot *datav1alpha1.DatasetSnapshot, reason, message string) (ctrl.Result, error) {
+	now := metav1.Now()
+	err := r.updateStatus(ctx, client.ObjectKeyFromObject(snapshot), func(status *datav1alpha1.DatasetSnapshotStatus) {
+		status.Phase = common.PhaseFailed
+		status.Conditions = []datav1alpha1.Condition{newCondition(common.Failed, reason, message, now)}
+	})
+	if err != nil {
+		r.Log.Error(err, "failed to update the status of datasetsnapshot", "datasetsnapshot", client.ObjectKeyFromObject(snapshot))
+		return utils.RequeueIfError(err)
+	}
+	r.Recorder.Event(snapshot, v1.EventTypeWarning, common.DatasetSnapshotFailed, message)
+	return utils.NoRequeue()
+}
+
+// updateStatus applies the change to the latest status of the DatasetSnapshot.
+func (r *DatasetSnapshotReconciler) updateStatus(ctx context.Context, key types.NamespacedName, mutate func(status *datav1alpha1.DatasetSnapshotStatus)) error {
+	return retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		snapshot := &datav1alpha1.DatasetSnapshot{}
+		if err := r.Get(ctx, key, snapshot); err != nil {
+			return err
+		}
+		snapshotToUpdate := snapshot.DeepCopy()
+		mutate(&snapshotToUpdate.Status)
+		return r.Status().Update(ctx, snapshotToUpdate)
+	})
+}
+
+func newCondition(conditionType common.ConditionType, reason, message string, now metav1.Time) datav1alpha1.Condition {
+	return datav1alpha1.Condition{
+		Type:               conditionType,
+		Status:             v1.ConditionTrue,
+		Reason:             reason,
+		Message:            message,
+		LastProbeTime:      now,
+		LastTransitionTime: now,
+	}
+}
+
+func (r *DatasetSnapshotReconciler) SetupWithManager(mgr ctrl.Manager, options controller.Options) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		WithOptions(options).
+		For(&datav1alpha1.DatasetSnapshot{}).
+		Complete(r)
+}
+
+func (r *DatasetSnapshotReconciler) ControllerName() string {
+	return controllerName
+}
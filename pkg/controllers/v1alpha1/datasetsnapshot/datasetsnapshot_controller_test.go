@@ -0,0 +1,362 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datasetsnapshot
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	cdatabackup "github.com/fluid-cloudnative/fluid/pkg/databackup"
+	"github.com/fluid-cloudnative/fluid/pkg/datasetsnapshot"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+)
+
+type fakeCloner struct {
+	cloned  []string
+	deleted []string
+	running bool
+	err     error
+}
+
+func (f *fakeCloner) Clone(snapshot *datav1alpha1.DatasetSnapshot) (bool, error) {
+	f.cloned = append(f.cloned, snapshot.Name)
+	return !f.running, f.err
+}
+
+func (f *fakeCloner) Delete(snapshot *datav1alpha1.DatasetSnapshot) error {
+	f.deleted = append(f.deleted, snapshot.Name)
+	return f.err
+}
+
+var _ = Describe("DatasetSnapshotReconciler", func() {
+	var (
+		s            *runtime.Scheme
+		recorder     *record.FakeRecorder
+		cloner       *fakeCloner
+		key          = types.NamespacedName{Name: "hbase-v1", Namespace: "fluid"}
+		resyncPeriod = 10 * time.Second
+	)
+
+	newSnapshot := func(method datav1alpha1.DatasetSnapshotMethod) *datav1alpha1.DatasetSnapshot {
+		return &datav1alpha1.DatasetSnapshot{
+			ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace, Finalizers: []string{finalizer}},
+			Spec: datav1alpha1.DatasetSnapshotSpec{
+				Dataset:    "hbase",
+				Method:     method,
+				BackupPath: "pvc://backup/",
+			},
+			Status: datav1alpha1.DatasetSnapshotStatus{Phase: common.PhaseExecuting, Method: method},
+		}
+	}
+
+	newDataset := func(runtimeType string) *datav1alpha1.Dataset {
+		return &datav1alpha1.Dataset{
+			ObjectMeta: metav1.ObjectMeta{Name: "hbase", Namespace: key.Namespace},
+			Spec: datav1alpha1.DatasetSpec{
+				Mounts: []datav1alpha1.Mount{{Name: "hbase", MountPoint: "juicefs:///hbase"}},
+			},
+			Status: datav1alpha1.DatasetStatus{
+				Phase:    datav1alpha1.BoundDatasetPhase,
+				Runtimes: []datav1alpha1.Runtime{{Name: "hbase", Namespace: key.Namespace, Type: runtimeType}},
+			},
+		}
+	}
+
+	reconcile := func(c client.Client) (ctrl.Result, error) {
+		r := NewDatasetSnapshotReconciler(c, fake.NullLogger(), recorder, resyncPeriod)
+		return r.Reconcile(context.TODO(), ctrl.Request{NamespacedName: key})
+	}
+
+	getSnapshot := func(c client.Client) *datav1alpha1.DatasetSnapshot {
+		snapshot := &datav1alpha1.DatasetSnapshot{}
+		Expect(c.Get(context.TODO(), key, snapshot)).To(Succeed())
+		return snapshot
+	}
+
+	stubListManifest := func(content string) {
+		originalListManifest := listManifest
+		listManifest = func(ctx context.Context, pod *corev1.Pod) (string, *datav1alpha1.DatasetSnapshotManifest, error) {
+			return content, &datav1alpha1.DatasetSnapshotManifest{Digest: "sha256:abc", FileCount: int64(strings.Count(content, "\n")), TotalSize: 3}, nil
+		}
+		DeferCleanup(func() {
+			listManifest = originalListManifest
+		})
+	}
+
+	runManifestPod := func(c client.Client) {
+		pod := &corev1.Pod{}
+		Expect(c.Get(context.TODO(), types.NamespacedName{Name: datasetsnapshot.GetManifestPodName(key.Name), Namespace: key.Namespace}, pod)).To(Succeed())
+		pod.Status.Phase = corev1.PodRunning
+		Expect(c.Status().Update(context.TODO(), pod)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		s = runtime.NewScheme()
+		Expect(datav1alpha1.AddToScheme(s)).To(Succeed())
+		Expect(corev1.AddToScheme(s)).To(Succeed())
+		recorder = record.NewFakeRecorder(10)
+
+		cloner = &fakeCloner{}
+		originalNewCloner := newCloner
+		newCloner = func(c client.Client, name, namespace string, log logr.Logger) (datasetCloner, error) {
+			return cloner, nil
+		}
+		DeferCleanup(func() {
+			newCloner = originalNewCloner
+		})
+	})
+
+	It("should add the finalizer first", func() {
+		snapshot := newSnapshot("")
+		snapshot.Finalizers = nil
+		c := fake.NewFakeClientWithScheme(s, newDataset(common.JuiceFSRuntime), snapshot)
+
+		result, err := reconcile(c)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Requeue).To(BeTrue())
+		Expect(getSnapshot(c).Finalizers).To(ContainElement(finalizer))
+	})
+
+	It("should wait for the dataset to be bound", func() {
+		dataset := newDataset(common.JuiceFSRuntime)
+		dataset.Status.Phase = datav1alpha1.NotBoundDatasetPhase
+		snapshot := newSnapshot("")
+		snapshot.Status = datav1alpha1.DatasetSnapshotStatus{}
+		c := fake.NewFakeClientWithScheme(s, dataset, snapshot)
+
+		result, err := reconcile(c)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(resyncPeriod))
+		Expect(recorder.Events).To(Receive(ContainSubstring(common.DatasetSnapshotNotReady)))
+		Expect(getSnapshot(c).Status.Phase).To(BeEmpty())
+	})
+
+	It("should resolve the method by the runtime of the dataset", func() {
+		snapshot := newSnapshot("")
+		snapshot.Status = datav1alpha1.DatasetSnapshotStatus{}
+		c := fake.NewFakeClientWithScheme(s, newDataset(common.JuiceFSRuntime), snapshot)
+
+		result, err := reconcile(c)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Requeue).To(BeTrue())
+
+		snapshot = getSnapshot(c)
+		Expect(snapshot.Status.Phase).To(Equal(common.PhaseExecuting))
+		Expect(snapshot.Status.Method).To(Equal(datav1alpha1.DatasetSnapshotMethodClone))
+	})
+
+	It("should record the manifest of a juicefs dataset mounting the root of the volume", func() {
+		dataset := newDataset(common.JuiceFSRuntime)
+		dataset.Spec.Mounts[0].MountPoint = "juicefs:///"
+		snapshot := newSnapshot("")
+		snapshot.Status = datav1alpha1.DatasetSnapshotStatus{}
+		c := fake.NewFakeClientWithScheme(s, dataset, snapshot)
+
+		_, err := reconcile(c)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(getSnapshot(c).Status.Method).To(Equal(datav1alpha1.DatasetSnapshotMethodManifest))
+	})
+
+	It("should fail if the method is not supported by the runtime", func() {
+		snapshot := newSnapshot(datav1alpha1.DatasetSnapshotMethodClone)
+		snapshot.Status = datav1alpha1.DatasetSnapshotStatus{}
+		c := fake.NewFakeClientWithScheme(s, newDataset(common.AlluxioRuntime), snapshot)
+
+		_, err := reconcile(c)
+		Expect(err).NotTo(HaveOccurred())
+
+		snapshot = getSnapshot(c)
+		Expect(snapshot.Status.Phase).To(Equal(common.PhaseFailed))
+		Expect(snapshot.Status.Conditions).To(HaveLen(1))
+		Expect(snapshot.Status.Conditions[0].Reason).To(Equal("MethodNotSupported"))
+		Expect(recorder.Events).To(Receive(ContainSubstring(common.DatasetSnapshotFailed)))
+	})
+
+	It("should clone the dataset and record the mounts of the clone", func() {
+		c := fake.NewFakeClientWithScheme(s, newDataset(common.JuiceFSRuntime), newSnapshot(datav1alpha1.DatasetSnapshotMethodClone))
+
+		_, err := reconcile(c)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cloner.cloned).To(Equal([]string{key.Name}))
+
+		snapshot := getSnapshot(c)
+		Expect(snapshot.Status.Phase).To(Equal(common.PhaseComplete))
+		Expect(snapshot.Status.SnapshotTime).NotTo(BeNil())
+		Expect(snapshot.Status.Mounts).To(Equal([]datav1alpha1.Mount{
+			{Name: "hbase", MountPoint: "juicefs:///.fluid-snapshots/fluid/hbase/hbase-v1", ReadOnly: true},
+		}))
+		Expect(recorder.Events).To(Receive(ContainSubstring(common.DatasetSnapshotCompleted)))
+	})
+
+	It("should poll the clone until it's done", func() {
+		cloner.running = true
+		c := fake.NewFakeClientWithScheme(s, newDataset(common.JuiceFSRuntime), newSnapshot(datav1alpha1.DatasetSnapshotMethodClone))
+
+		result, err := reconcile(c)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(resyncPeriod))
+		Expect(getSnapshot(c).Status.Phase).To(Equal(common.PhaseExecuting))
+
+		cloner.running = false
+		_, err = reconcile(c)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cloner.cloned).To(Equal([]string{key.Name, key.Name}))
+		Expect(getSnapshot(c).Status.Phase).To(Equal(common.PhaseComplete))
+	})
+
+	It("should retry the clone if it fails", func() {
+		cloner.err = fmt.Errorf("juicefs clone failed")
+		c := fake.NewFakeClientWithScheme(s, newDataset(common.JuiceFSRuntime), newSnapshot(datav1alpha1.DatasetSnapshotMethodClone))
+
+		result, err := reconcile(c)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(resyncPeriod))
+		Expect(getSnapshot(c).Status.Phase).To(Equal(common.PhaseExecuting))
+		Expect(recorder.Events).To(Receive(ContainSubstring(common.DatasetSnapshotFailed)))
+	})
+
+	It("should back up the metadata through a DataBackup and record the manifest", func() {
+		stubListManifest("a.txt\t3\t1700000000\n")
+		c := fake.NewFakeClientWithScheme(s, newDataset(common.AlluxioRuntime), newSnapshot(datav1alpha1.DatasetSnapshotMethodDataBackup))
+
+		result, err := reconcile(c)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(resyncPeriod))
+
+		backup := &datav1alpha1.DataBackup{}
+		backupKey := types.NamespacedName{Name: datasetsnapshot.GetDataBackupName(key.Name), Namespace: key.Namespace}
+		Expect(c.Get(context.TODO(), backupKey, backup)).To(Succeed())
+		Expect(backup.Spec.Dataset).To(Equal("hbase"))
+		Expect(backup.OwnerReferences).To(HaveLen(1))
+
+		backup.Status.Phase = common.PhaseComplete
+		backup.Status.Infos = map[string]string{
+			cdatabackup.BackupLocationPath:     "pvc://backup/",
+			cdatabackup.BackupLocationNodeName: "NA",
+		}
+		Expect(c.Update(context.TODO(), backup)).To(Succeed())
+
+		// the metadata alone doesn't reproduce the data, so the manifest is recorded as well
+		_, err = reconcile(c)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(getSnapshot(c).Status.Phase).To(Equal(common.PhaseExecuting))
+		runManifestPod(c)
+		_, err = reconcile(c)
+		Expect(err).NotTo(HaveOccurred())
+
+		snapshot := getSnapshot(c)
+		Expect(snapshot.Status.Phase).To(Equal(common.PhaseComplete))
+		Expect(snapshot.Status.Mounts[0].ReadOnly).To(BeTrue())
+		Expect(snapshot.Status.DataRestoreLocation).To(Equal(&datav1alpha1.DataRestoreLocation{Path: "pvc://backup/", Dataset: "hbase"}))
+		Expect(snapshot.Status.Manifest).NotTo(BeNil())
+		Expect(snapshot.Status.Manifest.FileCount).To(Equal(int64(1)))
+	})
+
+	It("should record the manifest in a pod mounting the dataset", func() {
+		stubListManifest("a.txt\t3\t1700000000\n")
+		c := fake.NewFakeClientWithScheme(s, newDataset(common.JindoRuntime), newSnapshot(datav1alpha1.DatasetSnapshotMethodManifest))
+
+		_, err := reconcile(c)
+		Expect(err).NotTo(HaveOccurred())
+		pod := &corev1.Pod{}
+		podKey := types.NamespacedName{Name: datasetsnapshot.GetManifestPodName(key.Name), Namespace: key.Namespace}
+		Expect(c.Get(context.TODO(), podKey, pod)).To(Succeed())
+
+		// not completed before the pod is running
+		result, err := reconcile(c)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(resyncPeriod))
+		Expect(getSnapshot(c).Status.Phase).To(Equal(common.PhaseExecuting))
+
+		runManifestPod(c)
+		_, err = reconcile(c)
+		Expect(err).NotTo(HaveOccurred())
+
+		configMap := &corev1.ConfigMap{}
+		configMapKey := types.NamespacedName{Name: datasetsnapshot.GetManifestConfigMapName(key.Name), Namespace: key.Namespace}
+		Expect(c.Get(context.TODO(), configMapKey, configMap)).To(Succeed())
+		Expect(datasetsnapshot.ReadManifest(configMap)).To(Equal("a.txt\t3\t1700000000\n"))
+		Expect(errors.IsNotFound(c.Get(context.TODO(), podKey, &corev1.Pod{}))).To(BeTrue())
+
+		snapshot := getSnapshot(c)
+		Expect(snapshot.Status.Phase).To(Equal(common.PhaseComplete))
+		Expect(snapshot.Status.Manifest).To(Equal(&datav1alpha1.DatasetSnapshotManifest{
+			ConfigMap: configMapKey.Name, Digest: "sha256:abc", FileCount: 1, TotalSize: 3,
+		}))
+	})
+
+	It("should fail if the manifest is too large to save", func() {
+		// random paths are hardly compressed
+		content := strings.Builder{}
+		for content.Len() <= datasetsnapshot.MaxManifestSize*2 {
+			fmt.Fprintf(&content, "%s\t1\t1700000000\n", rand.String(64))
+		}
+		stubListManifest(content.String())
+		c := fake.NewFakeClientWithScheme(s, newDataset(common.JindoRuntime), newSnapshot(datav1alpha1.DatasetSnapshotMethodManifest))
+
+		_, err := reconcile(c)
+		Expect(err).NotTo(HaveOccurred())
+		runManifestPod(c)
+		_, err = reconcile(c)
+		Expect(err).NotTo(HaveOccurred())
+
+		snapshot := getSnapshot(c)
+		Expect(snapshot.Status.Phase).To(Equal(common.PhaseFailed))
+		Expect(snapshot.Status.Conditions[0].Reason).To(Equal("ManifestTooLarge"))
+	})
+
+	It("should not be deleted while a dataset is mounted from it", func() {
+		snapshot := newSnapshot(datav1alpha1.DatasetSnapshotMethodClone)
+		now := metav1.Now()
+		snapshot.DeletionTimestamp = &now
+		restored := &datav1alpha1.Dataset{
+			ObjectMeta: metav1.ObjectMeta{Name: "hbase-restored", Namespace: key.Namespace},
+			Spec:       datav1alpha1.DatasetSpec{SnapshotRef: &datav1alpha1.DatasetSnapshotRef{Name: key.Name}},
+		}
+		c := fake.NewFakeClientWithScheme(s, newDataset(common.JuiceFSRuntime), restored, snapshot)
+
+		result, err := reconcile(c)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(resyncPeriod))
+		Expect(getSnapshot(c).Finalizers).To(ContainElement(finalizer))
+		Expect(cloner.deleted).To(BeEmpty())
+		Expect(recorder.Events).To(Receive(ContainSubstring("hbase-restored")))
+
+		Expect(c.Delete(context.TODO(), restored)).To(Succeed())
+		_, err = reconcile(c)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cloner.deleted).To(Equal([]string{key.Name}))
+		Expect(errors.IsNotFound(c.Get(context.TODO(), key, &datav1alpha1.DatasetSnapshot{}))).To(BeTrue())
+	})
+})
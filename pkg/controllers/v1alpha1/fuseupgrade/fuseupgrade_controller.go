@@ -0,0 +1,320 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fuseupgrade
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-logr/logr"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/base"
+	"github.com/fluid-cloudnative/fluid/pkg/fuseupgrade"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+)
+
+const (
+	finalizer      = "fluid-fuseupgrade-controller-finalizer"
+	controllerName = "FuseUpgradeController"
+)
+
+// FuseUpgradeReconciler reconciles a FuseUpgrade object
+type FuseUpgradeReconciler struct {
+	client.Client
+	Recorder     record.EventRecorder
+	Log          logr.Logger
+	ResyncPeriod time.Duration
+}
+
+// NewFuseUpgradeReconciler creates the reconciler which periodically drains and upgrades the nodes running
+// the outdated fuse pods of the runtimes targeted by FuseUpgrades.
+func NewFuseUpgradeReconciler(client client.Client,
+	log logr.Logger,
+	recorder record.EventRecorder,
+	resyncPeriod time.Duration) *FuseUpgradeReconciler {
+	return &FuseUpgradeReconciler{
+		Client:       client,
+		Recorder:     recorder,
+		Log:          log,
+		ResyncPeriod: resyncPeriod,
+	}
+}
+
+// +kubebuilder:rbac:groups=data.fluid.io,resources=fuseupgrades,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=data.fluid.io,resources=fuseupgrades/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=apps,resources=controllerrevisions,verbs=get;list;watch
+
+func (r *FuseUpgradeReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := r.Log.WithValues("fuseupgrade", req.NamespacedName)
+	log.V(1).Info("process the request", "request", req)
+
+	upgrade := &datav1alpha1.FuseUpgrade{}
+	if err := r.Get(ctx, req.NamespacedName, upgrade); err != nil {
+		if utils.IgnoreNotFound(err) == nil {
+			log.V(1).Info("Not found.")
+			return utils.NoRequeue()
+		}
+		log.Error(err, "failed to get fuseupgrade")
+		return utils.RequeueIfError(err)
+	}
+
+	if utils.HasDeletionTimestamp(upgrade.ObjectMeta) {
+		return r.reconcileDeletion(ctx, log, upgrade)
+	}
+
+	if !utils.ContainsString(upgrade.GetFinalizers(), finalizer) {
+		upgrade.Finalizers = append(upgrade.Finalizers, finalizer)
+		if err := r.Update(ctx, upgrade); err != nil {
+			log.Error(err, "failed to add finalizer")
+			return utils.RequeueIfError(err)
+		}
+		return utils.RequeueImmediately()
+	}
+
+	runtimeRef := upgrade.Spec.RuntimeRef
+	runtime, err := fuseupgrade.GetTargetRuntime(r.Client, upgrade)
+	if err != nil {
+		if utils.IgnoreNotFound(err) == nil {
+			r.Recorder.Eventf(upgrade, v1.EventTypeWarning, common.FuseUpgradeFailed,
+				"Runtime %s %s is not found", runtimeRef.Kind, runtimeRef.Name)
+			return utils.RequeueAfterInterval(r.ResyncPeriod)
+		}
+		log.Error(err, "failed to get the target runtime")
+		return utils.RequeueIfError(err)
+	}
+
+	runtimeInfo, err := base.GetRuntimeInfo(r.Client, runtimeRef.Name, upgrade.Namespace)
+	if err != nil {
+		log.Error(err, "failed to get the runtime info, the dataset may be not bound yet")
+		return utils.RequeueAfterInterval(r.ResyncPeriod)
+	}
+	dataset, err := utils.GetDataset(r.Client, runtimeRef.Name, upgrade.Namespace)
+	if err != nil {
+		log.Error(err, "failed to get the dataset")
+		return utils.RequeueIfError(err)
+	}
+
+	nodes, err := fuseupgrade.CollectNodes(r.Client, runtimeInfo, dataset)
+	if err != nil {
+		if utils.IgnoreNotFound(err) == nil {
+			log.V(1).Info("The fuse of the runtime is not created yet")
+			return utils.RequeueAfterInterval(r.ResyncPeriod)
+		}
+		log.Error(err, "failed to collect the nodes running the fuse")
+		return utils.RequeueIfError(err)
+	}
+
+	maxUnavailable, err := getMaxUnavailable(upgrade.Spec.MaxUnavailable, len(nodes))
+	if err != nil {
+		r.Recorder.Eventf(upgrade, v1.EventTypeWarning, common.FuseUpgradeFailed, "Invalid maxUnavailable: %v", err)
+		return utils.RequeueAfterInterval(r.ResyncPeriod)
+	}
+	plan := fuseupgrade.MakePlan(nodes, maxUnavailable, upgrade.Spec.DrainNodes, upgrade.Spec.Paused)
+	log.V(1).Info("made the plan of the fuse upgrade", "upgrade", len(plan.Upgrade), "drain", plan.Drain, "undrain", plan.Undrain)
+
+	drainingLabel := utils.GetFuseUpgradeDrainingLabelName(runtimeInfo.GetNamespace(), runtimeInfo.GetName(), runtimeInfo.GetOwnerDatasetUID())
+	for _, nodeName := range plan.Undrain {
+		if err = r.labelNode(nodeName, drainingLabel, false); err != nil {
+			log.Error(err, "failed to remove the draining label from node", "node", nodeName)
+			return utils.RequeueIfError(err)
+		}
+	}
+	for _, node := range plan.Upgrade {
+		err = r.Delete(ctx, node.FusePod, client.Preconditions{UID: &node.FusePod.UID})
+		if utils.IgnoreNotFound(err) != nil {
+			log.Error(err, "failed to delete the outdated fuse pod", "node", node.Name, "pod", node.FusePod.Name)
+			return utils.RequeueIfError(err)
+		}
+		r.Recorder.Eventf(upgrade, v1.EventTypeNormal, common.FuseUpgradeNodeUpgrading,
+			"Upgrading the fuse on node %s without pods mounting the dataset", node.Name)
+	}
+	for _, nodeName := range plan.Drain {
+		if err = r.labelNode(nodeName, drainingLabel, true); err != nil {
+			log.Error(err, "failed to label node as draining", "node", nodeName)
+			return utils.RequeueIfError(err)
+		}
+		r.Recorder.Eventf(upgrade, v1.EventTypeNormal, common.FuseUpgradeNodeDraining,
+			"Draining node %s with label %s for the fuse upgrade", nodeName, drainingLabel)
+	}
+
+	previous := getRuntimeFuseUpgradeStatus(runtime)
+	status := fuseupgrade.BuildStatus(previous, nodes, plan, upgrade.Spec.Paused, metav1.Now())
+	if !equality.Semantic.DeepEqual(previous, status) {
+		if err = fuseupgrade.UpdateRuntimeStatus(r.Client, runtime, status); err != nil {
+			log.Error(err, "failed to update the fuse upgrade status of the runtime")
+			return utils.RequeueIfError(err)
+		}
+	}
+
+	phase := datav1alpha1.FuseUpgradePhaseProgressing
+	if status.UpgradedNodes == status.TotalNodes {
+		phase = datav1alpha1.FuseUpgradePhaseCompleted
+	} else if upgrade.Spec.Paused {
+		phase = datav1alpha1.FuseUpgradePhasePaused
+	}
+	if phase == datav1alpha1.FuseUpgradePhaseCompleted && upgrade.Status.Phase != phase {
+		r.Recorder.Eventf(upgrade, v1.EventTypeNormal, common.FuseUpgradeCompleted,
+			"The fuse of %s %s is upgraded on all the %d nodes", runtimeRef.Kind, runtimeRef.Name, status.TotalNodes)
+	}
+	if err = r.updateStatus(ctx, req, datav1alpha1.FuseUpgradeStatus{
+		Phase:         phase,
+		UpgradedNodes: status.UpgradedNodes,
+		TotalNodes:    status.TotalNodes,
+	}); err != nil {
+		log.Error(err, "failed to update the status of fuseupgrade")
+		return utils.RequeueIfError(err)
+	}
+
+	return utils.RequeueAfterInterval(r.ResyncPeriod)
+}
+
+// reconcileDeletion removes the draining labels and the progress in the runtime's status before the FuseUpgrade
+// is deleted, the nodes not upgraded yet keep the outdated fuse pods.
+func (r *FuseUpgradeReconciler) reconcileDeletion(ctx context.Context, log logr.Logger, upgrade *datav1alpha1.FuseUpgrade) (ctrl.Result, error) {
+	if !utils.ContainsString(upgrade.GetFinalizers(), finalizer) {
+		return utils.NoRequeue()
+	}
+
+	runtimeRef := upgrade.Spec.RuntimeRef
+	runtime, err := fuseupgrade.GetTargetRuntime(r.Client, upgrade)
+	if utils.IgnoreNotFound(err) != nil {
+		log.Error(err, "failed to get the target runtime")
+		return utils.RequeueIfError(err)
+	}
+	if err == nil {
+		dataset, err := utils.GetDataset(r.Client, runtimeRef.Name, upgrade.Namespace)
+		if utils.IgnoreNotFound(err) != nil {
+			log.Error(err, "failed to get the dataset")
+			return utils.RequeueIfError(err)
+		}
+		var ownerDatasetUID string
+		if dataset != nil {
+			ownerDatasetUID = string(dataset.UID)
+		}
+		drainingLabel := utils.GetFuseUpgradeDrainingLabelName(upgrade.Namespace, runtimeRef.Name, ownerDatasetUID)
+		nodeList := &v1.NodeList{}
+		if err = r.List(ctx, nodeList, client.HasLabels{drainingLabel}); err != nil {
+			log.Error(err, "failed to list the draining nodes")
+			return utils.RequeueIfError(err)
+		}
+		for _, node := range nodeList.Items {
+			if err = r.labelNode(node.Name, drainingLabel, false); err != nil {
+				log.Error(err, "failed to remove the draining label from node", "node", node.Name)
+				return utils.RequeueIfError(err)
+			}
+		}
+
+		if getRuntimeFuseUpgradeStatus(runtime) != nil {
+			if err = fuseupgrade.UpdateRuntimeStatus(r.Client, runtime, nil); err != nil {
+				log.Error(err, "failed to remove the fuse upgrade status of the runtime")
+				return utils.RequeueIfError(err)
+			}
+		}
+	}
+
+	upgrade.Finalizers = utils.RemoveString(upgrade.Finalizers, finalizer)
+	if err = r.Update(ctx, upgrade); err != nil {
+		log.Error(err, "failed to remove finalizer")
+		return utils.RequeueIfError(err)
+	}
+	return utils.NoRequeue()
+}
+
+// labelNode adds or removes the draining label of the runtime on the node
+func (r *FuseUpgradeReconciler) labelNode(nodeName, label string, draining bool) error {
+	labelsToModify := common.LabelsToModify{}
+	if draining {
+		labelsToModify.Add(label, "true")
+	} else {
+		labelsToModify.Delete(label)
+	}
+	node := &v1.Node{ObjectMeta: metav1.ObjectMeta{Name: nodeName}}
+	_, err := utils.ChangeNodeLabelWithPatchMode(r.Client, node, labelsToModify)
+	return utils.IgnoreNotFound(err)
+}
+
+// updateStatus records the summary of the progress in the status of the FuseUpgrade.
+func (r *FuseUpgradeReconciler) updateStatus(ctx context.Context, req ctrl.Request, status datav1alpha1.FuseUpgradeStatus) error {
+	return retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		upgrade := &datav1alpha1.FuseUpgrade{}
+		if err := r.Get(ctx, req.NamespacedName, upgrade); err != nil {
+			return err
+		}
+		if equality.Semantic.DeepEqual(upgrade.Status, status) {
+			return nil
+		}
+
+		upgradeToUpdate := upgrade.DeepCopy()
+		upgradeToUpdate.Status = status
+		return r.Status().Update(ctx, upgradeToUpdate)
+	})
+}
+
+// getMaxUnavailable resolves maxUnavailable against the number of the nodes, at least 1 node is upgraded at a time.
+func getMaxUnavailable(maxUnavailable *intstr.IntOrString, total int) (int, error) {
+	if maxUnavailable == nil {
+		return 1, nil
+	}
+	value, err := intstr.GetScaledValueFromIntOrPercent(maxUnavailable, total, false)
+	if err != nil {
+		return 0, err
+	}
+	if value < 1 {
+		value = 1
+	}
+	return value, nil
+}
+
+func getRuntimeFuseUpgradeStatus(runtime client.Object) *datav1alpha1.RuntimeFuseUpgradeStatus {
+	switch runtime := runtime.(type) {
+	case *datav1alpha1.AlluxioRuntime:
+		return runtime.Status.FuseUpgrade
+	case *datav1alpha1.JindoRuntime:
+		return runtime.Status.FuseUpgrade
+	case *datav1alpha1.JuiceFSRuntime:
+		return runtime.Status.FuseUpgrade
+	case *datav1alpha1.VineyardRuntime:
+		return runtime.Status.FuseUpgrade
+	case *datav1alpha1.EFCRuntime:
+		return runtime.Status.FuseUpgrade
+	case *datav1alpha1.ThinRuntime:
+		return runtime.Status.FuseUpgrade
+	}
+	return nil
+}
+
+func (r *FuseUpgradeReconciler) SetupWithManager(mgr ctrl.Manager, options controller.Options) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		WithOptions(options).
+		For(&datav1alpha1.FuseUpgrade{}).
+		Complete(r)
+}
+
+func (r *FuseUpgradeReconciler) ControllerName() string {
+	return controllerName
+}
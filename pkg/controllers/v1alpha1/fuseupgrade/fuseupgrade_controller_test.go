@@ -0,0 +1,278 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fuseupgrade
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+)
+
+var _ = Describe("FuseUpgradeReconciler", func() {
+	var (
+		s            *runtime.Scheme
+		recorder     *record.FakeRecorder
+		key          = types.NamespacedName{Name: "demo", Namespace: "fluid"}
+		drainingKey  = common.LabelFuseUpgradeDrainingPrefix + "fluid-demo"
+		fuseDsUID    = types.UID("fuse-ds-uid")
+		resyncPeriod = 10 * time.Second
+	)
+
+	newUpgrade := func(drainNodes bool) *datav1alpha1.FuseUpgrade {
+		return &datav1alpha1.FuseUpgrade{
+			ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace, Finalizers: []string{finalizer}},
+			Spec: datav1alpha1.FuseUpgradeSpec{
+				RuntimeRef: datav1alpha1.FuseUpgradeTargetRef{Kind: datav1alpha1.AlluxioRuntimeKind, Name: key.Name},
+				DrainNodes: drainNodes,
+			},
+		}
+	}
+
+	newFusePod := func(nodeName, generation string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "demo-fuse-" + nodeName,
+				Namespace: key.Namespace,
+				Labels:    map[string]string{"app": "demo-fuse", appsv1.DefaultDaemonSetUniqueLabelKey: "rev-" + generation},
+				OwnerReferences: []metav1.OwnerReference{{
+					APIVersion: "apps/v1",
+					Kind:       "DaemonSet",
+					Name:       "demo-fuse",
+					UID:        fuseDsUID,
+					Controller: ptr.To(true),
+				}},
+			},
+			Spec: corev1.PodSpec{NodeName: nodeName},
+			Status: corev1.PodStatus{
+				Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}},
+			},
+		}
+	}
+
+	newMountingPod := func(name, nodeName string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: key.Namespace},
+			Spec: corev1.PodSpec{
+				NodeName: nodeName,
+				Volumes: []corev1.Volume{{
+					Name: "data",
+					VolumeSource: corev1.VolumeSource{
+						PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: key.Name},
+					},
+				}},
+			},
+			Status: corev1.PodStatus{Phase: corev1.PodRunning},
+		}
+	}
+
+	newObjects := func() []runtime.Object {
+		return []runtime.Object{
+			&datav1alpha1.Dataset{
+				ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace},
+				Status: datav1alpha1.DatasetStatus{
+					Runtimes: []datav1alpha1.Runtime{{Name: key.Name, Namespace: key.Namespace, Type: common.AlluxioRuntime}},
+				},
+			},
+			&datav1alpha1.AlluxioRuntime{
+				ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace},
+			},
+			&appsv1.DaemonSet{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "demo-fuse",
+					Namespace: key.Namespace,
+					UID:       fuseDsUID,
+				},
+				Spec: appsv1.DaemonSetSpec{
+					Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "demo-fuse"}},
+				},
+			},
+			&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-1"}},
+			&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-2"}},
+			&appsv1.ControllerRevision{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "demo-fuse-rev-2",
+					Namespace: key.Namespace,
+					Labels:    map[string]string{"app": "demo-fuse", appsv1.DefaultDaemonSetUniqueLabelKey: "rev-2"},
+					OwnerReferences: []metav1.OwnerReference{{
+						APIVersion: "apps/v1",
+						Kind:       "DaemonSet",
+						Name:       "demo-fuse",
+						UID:        fuseDsUID,
+						Controller: ptr.To(true),
+					}},
+				},
+				Revision: 2,
+			},
+			newFusePod("node-1", "1"),
+			newFusePod("node-2", "1"),
+			newMountingPod("app-1", "node-1"),
+		}
+	}
+
+	BeforeEach(func() {
+		s = runtime.NewScheme()
+		Expect(datav1alpha1.AddToScheme(s)).To(Succeed())
+		Expect(corev1.AddToScheme(s)).To(Succeed())
+		Expect(appsv1.AddToScheme(s)).To(Succeed())
+		recorder = record.NewFakeRecorder(10)
+	})
+
+	It("should add the finalizer first", func() {
+		upgrade := newUpgrade(false)
+		upgrade.Finalizers = nil
+		c := fake.NewFakeClientWithScheme(s, append(newObjects(), upgrade)...)
+		r := NewFuseUpgradeReconciler(c, fake.NullLogger(), recorder, resyncPeriod)
+
+		result, err := r.Reconcile(context.TODO(), ctrl.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Requeue).To(BeTrue())
+
+		Expect(c.Get(context.TODO(), key, upgrade)).To(Succeed())
+		Expect(upgrade.Finalizers).To(ContainElement(finalizer))
+	})
+
+	It("should upgrade the idle node and wait for the busy node", func() {
+		c := fake.NewFakeClientWithScheme(s, append(newObjects(), newUpgrade(false))...)
+		r := NewFuseUpgradeReconciler(c, fake.NullLogger(), recorder, resyncPeriod)
+
+		result, err := r.Reconcile(context.TODO(), ctrl.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(resyncPeriod))
+
+		err = c.Get(context.TODO(), types.NamespacedName{Name: "demo-fuse-node-2", Namespace: key.Namespace}, &corev1.Pod{})
+		Expect(errors.IsNotFound(err)).To(BeTrue())
+		Expect(c.Get(context.TODO(), types.NamespacedName{Name: "demo-fuse-node-1", Namespace: key.Namespace}, &corev1.Pod{})).To(Succeed())
+		Expect(recorder.Events).To(Receive(ContainSubstring(common.FuseUpgradeNodeUpgrading)))
+
+		alluxioRuntime := &datav1alpha1.AlluxioRuntime{}
+		Expect(c.Get(context.TODO(), key, alluxioRuntime)).To(Succeed())
+		Expect(alluxioRuntime.Status.FuseUpgrade).NotTo(BeNil())
+		Expect(alluxioRuntime.Status.FuseUpgrade.TotalNodes).To(Equal(int32(2)))
+		Expect(alluxioRuntime.Status.FuseUpgrade.Nodes).To(HaveLen(2))
+		Expect(alluxioRuntime.Status.FuseUpgrade.Nodes[0].NodeName).To(Equal("node-1"))
+		Expect(alluxioRuntime.Status.FuseUpgrade.Nodes[0].Phase).To(Equal(datav1alpha1.FuseNodeUpgradePending))
+		Expect(alluxioRuntime.Status.FuseUpgrade.Nodes[0].MountingPods).To(Equal(int32(1)))
+		Expect(alluxioRuntime.Status.FuseUpgrade.Nodes[1].Phase).To(Equal(datav1alpha1.FuseNodeUpgradeUpgrading))
+
+		upgrade := &datav1alpha1.FuseUpgrade{}
+		Expect(c.Get(context.TODO(), key, upgrade)).To(Succeed())
+		Expect(upgrade.Status.Phase).To(Equal(datav1alpha1.FuseUpgradePhaseProgressing))
+		Expect(upgrade.Status.TotalNodes).To(Equal(int32(2)))
+	})
+
+	It("should drain the busy node when the budget allows", func() {
+		upgrade := newUpgrade(true)
+		upgrade.Spec.MaxUnavailable = ptr.To(intstr.FromString("100%"))
+		c := fake.NewFakeClientWithScheme(s, append(newObjects(), upgrade)...)
+		r := NewFuseUpgradeReconciler(c, fake.NullLogger(), recorder, resyncPeriod)
+
+		_, err := r.Reconcile(context.TODO(), ctrl.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+
+		node := &corev1.Node{}
+		Expect(c.Get(context.TODO(), types.NamespacedName{Name: "node-1"}, node)).To(Succeed())
+		Expect(node.Labels).To(HaveKeyWithValue(drainingKey, "true"))
+		Expect(c.Get(context.TODO(), types.NamespacedName{Name: "node-2"}, node)).To(Succeed())
+		Expect(node.Labels).NotTo(HaveKey(drainingKey))
+
+		alluxioRuntime := &datav1alpha1.AlluxioRuntime{}
+		Expect(c.Get(context.TODO(), key, alluxioRuntime)).To(Succeed())
+		Expect(alluxioRuntime.Status.FuseUpgrade.Nodes[0].Phase).To(Equal(datav1alpha1.FuseNodeUpgradeDraining))
+	})
+
+	It("should not drain or upgrade any node when paused", func() {
+		upgrade := newUpgrade(true)
+		upgrade.Spec.Paused = true
+		c := fake.NewFakeClientWithScheme(s, append(newObjects(), upgrade)...)
+		r := NewFuseUpgradeReconciler(c, fake.NullLogger(), recorder, resyncPeriod)
+
+		_, err := r.Reconcile(context.TODO(), ctrl.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+
+		podList := &corev1.PodList{}
+		Expect(c.List(context.TODO(), podList, client.MatchingLabels{"app": "demo-fuse"})).To(Succeed())
+		Expect(podList.Items).To(HaveLen(2))
+		Expect(recorder.Events).To(BeEmpty())
+
+		Expect(c.Get(context.TODO(), key, upgrade)).To(Succeed())
+		Expect(upgrade.Status.Phase).To(Equal(datav1alpha1.FuseUpgradePhasePaused))
+	})
+
+	It("should complete when all the fuse pods are up to date", func() {
+		objects := newObjects()
+		objects[len(objects)-3] = newFusePod("node-1", "2")
+		objects[len(objects)-2] = newFusePod("node-2", "2")
+		c := fake.NewFakeClientWithScheme(s, append(objects, newUpgrade(false))...)
+		r := NewFuseUpgradeReconciler(c, fake.NullLogger(), recorder, resyncPeriod)
+
+		_, err := r.Reconcile(context.TODO(), ctrl.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+
+		upgrade := &datav1alpha1.FuseUpgrade{}
+		Expect(c.Get(context.TODO(), key, upgrade)).To(Succeed())
+		Expect(upgrade.Status.Phase).To(Equal(datav1alpha1.FuseUpgradePhaseCompleted))
+		Expect(upgrade.Status.UpgradedNodes).To(Equal(int32(2)))
+		Expect(recorder.Events).To(Receive(ContainSubstring(common.FuseUpgradeCompleted)))
+	})
+
+	It("should remove the draining labels and the progress when deleted", func() {
+		objects := newObjects()
+		objects[3] = &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "node-1", Labels: map[string]string{drainingKey: "true"}}}
+		objects[1] = &datav1alpha1.AlluxioRuntime{
+			ObjectMeta: metav1.ObjectMeta{Name: key.Name, Namespace: key.Namespace},
+			Status: datav1alpha1.RuntimeStatus{
+				FuseUpgrade: &datav1alpha1.RuntimeFuseUpgradeStatus{TotalNodes: 2},
+			},
+		}
+		upgrade := newUpgrade(true)
+		now := metav1.Now()
+		upgrade.DeletionTimestamp = &now
+		c := fake.NewFakeClientWithScheme(s, append(objects, upgrade)...)
+		r := NewFuseUpgradeReconciler(c, fake.NullLogger(), recorder, resyncPeriod)
+
+		_, err := r.Reconcile(context.TODO(), ctrl.Request{NamespacedName: key})
+		Expect(err).NotTo(HaveOccurred())
+
+		node := &corev1.Node{}
+		Expect(c.Get(context.TODO(), types.NamespacedName{Name: "node-1"}, node)).To(Succeed())
+		Expect(node.Labels).NotTo(HaveKey(drainingKey))
+
+		alluxioRuntime := &datav1alpha1.AlluxioRuntime{}
+		Expect(c.Get(context.TODO(), key, alluxioRuntime)).To(Succeed())
+		Expect(alluxioRuntime.Status.FuseUpgrade).To(BeNil())
+
+		err = c.Get(context.TODO(), key, &datav1alpha1.FuseUpgrade{})
+		Expect(errors.IsNotFound(err)).To(BeTrue())
+	})
+})
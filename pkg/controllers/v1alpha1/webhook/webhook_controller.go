@@ -37,5 +37,11 @@ func (r *WebhookReconciler) Reconcile(context.Context, ctrl.Request) (ctrl.Resul
 	if err != nil {
 		return utils.RequeueAfterInterval(10 * time.Second)
 	}
+
+	// patch ca of the conversion of the CRDs served in multiple versions
+	err = r.CertBuilder.PatchConversionCABundle(r.CaCert)
+	if err != nil {
+		return utils.RequeueAfterInterval(10 * time.Second)
+	}
 	return utils.NoRequeue()
 }
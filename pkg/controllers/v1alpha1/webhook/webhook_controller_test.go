@@ -65,6 +65,15 @@ var _ = Describe("WebhookReconciler", func() {
 					},
 				)
 				defer patch.Reset()
+				conversionPatch := gomonkey.ApplyMethod(
+					certBuilder,
+					"PatchConversionCABundle",
+					func(_ *webhook.CertificateBuilder, ca []byte) error {
+						Expect(ca).To(Equal(caCert))
+						return nil
+					},
+				)
+				defer conversionPatch.Reset()
 
 				result, err := reconciler.Reconcile(context.Background(), ctrl.Request{})
 				Expect(err).NotTo(HaveOccurred())
@@ -73,6 +82,31 @@ var _ = Describe("WebhookReconciler", func() {
 			})
 		})
 
+		Context("when PatchConversionCABundle fails", func() {
+			It("should requeue after 10 seconds and return no error", func() {
+				patch := gomonkey.ApplyMethod(
+					certBuilder,
+					"PatchCABundle",
+					func(_ *webhook.CertificateBuilder, _ string, _ []byte) error {
+						return nil
+					},
+				)
+				defer patch.Reset()
+				conversionPatch := gomonkey.ApplyMethod(
+					certBuilder,
+					"PatchConversionCABundle",
+					func(_ *webhook.CertificateBuilder, _ []byte) error {
+						return errors.New("patch failed")
+					},
+				)
+				defer conversionPatch.Reset()
+
+				result, err := reconciler.Reconcile(context.Background(), ctrl.Request{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result.RequeueAfter).To(Equal(10 * time.Second))
+			})
+		})
+
 		Context("when PatchCABundle fails", func() {
 			It("should requeue after 10 seconds and return no error", func() {
 				patch := gomonkey.ApplyMethod(
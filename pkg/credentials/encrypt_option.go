@@ -0,0 +1,117 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentials
+
+import (
+	"fmt"
+	"path/filepath"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	// secretMountPathPrefix is where the volumes holding encrypt options are mounted, the runtimes may look for
+	// the files in /etc/fluid/secrets/<volume source>/<key> to read the values
+	secretMountPathPrefix = "/etc/fluid/secrets"
+
+	// secretStoreSourcePrefix distinguishes the SecretProviderClasses from the secrets of the same names
+	secretStoreSourcePrefix = "spc-"
+
+	// SecretsStoreCSIDriver is the driver of the Secrets Store CSI volumes
+	SecretsStoreCSIDriver = "secrets-store.csi.k8s.io"
+)
+
+// GetSecretName returns the name of the secret holding the credentials exchanged from
+// the credential providers for the dataset
+func GetSecretName(datasetName string) string {
+	return fmt.Sprintf("%s-fluid-credentials", datasetName)
+}
+
+// GetSecretKeyRef returns the secret and the key holding the value of the encrypt option.
+// The credentials exchanged from a credential provider are held in the secret of the dataset keyed by the
+// option name, and the values read from a Secrets Store CSI volume are not held in any secret.
+func GetSecretKeyRef(datasetName string, option datav1alpha1.EncryptOption) (datav1alpha1.SecretKeySelector, error) {
+	source := option.ValueFrom
+	switch {
+	case source.CredentialProvider != nil:
+		return datav1alpha1.SecretKeySelector{
+			Name: GetSecretName(datasetName),
+			Key:  option.Name,
+		}, nil
+	case source.CSISecretStore != nil:
+		return datav1alpha1.SecretKeySelector{}, fmt.Errorf("encryptOption %s is read from the SecretProviderClass %s, which is only supported by the runtimes reading encrypt options from files",
+			option.Name, source.CSISecretStore.SecretProviderClass)
+	default:
+		return source.SecretKeyRef, nil
+	}
+}
+
+// File is the file in the pods holding the value of an encrypt option
+type File struct {
+	// Volume holding the file
+	Volume corev1.Volume
+
+	// MountPath of the volume
+	MountPath string
+
+	// Path of the file
+	Path string
+}
+
+// GetFile returns the file holding the value of the encrypt option and the volume to mount for it.
+// volumeName names the volume after the name of the secret or the SecretProviderClass the volume comes from,
+// e.g. "spc-<name>" for the SecretProviderClass.
+func GetFile(datasetName string, option datav1alpha1.EncryptOption, volumeName func(source string) string) File {
+	if store := option.ValueFrom.CSISecretStore; store != nil {
+		source := secretStoreSourcePrefix + store.SecretProviderClass
+		mountPath := filepath.Join(secretMountPathPrefix, source)
+		return File{
+			Volume: corev1.Volume{
+				Name: volumeName(source),
+				VolumeSource: corev1.VolumeSource{
+					CSI: &corev1.CSIVolumeSource{
+						Driver:   SecretsStoreCSIDriver,
+						ReadOnly: ptr.To(true),
+						VolumeAttributes: map[string]string{
+							"secretProviderClass": store.SecretProviderClass,
+						},
+					},
+				},
+			},
+			MountPath: mountPath,
+			Path:      filepath.Join(mountPath, store.ObjectName),
+		}
+	}
+
+	// the error is returned only for the Secrets Store CSI volume
+	secretKeyRef, _ := GetSecretKeyRef(datasetName, option)
+	mountPath := filepath.Join(secretMountPathPrefix, secretKeyRef.Name)
+	return File{
+		Volume: corev1.Volume{
+			Name: volumeName(secretKeyRef.Name),
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: secretKeyRef.Name,
+				},
+			},
+		},
+		MountPath: mountPath,
+		Path:      filepath.Join(mountPath, secretKeyRef.Key),
+	}
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentials
+
+import (
+	"testing"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+)
+
+func TestGetSecretKeyRef(t *testing.T) {
+	testCases := map[string]struct {
+		option  datav1alpha1.EncryptOption
+		want    datav1alpha1.SecretKeySelector
+		wantErr bool
+	}{
+		"secret": {
+			option: datav1alpha1.EncryptOption{
+				Name: "fs.s3a.access.key",
+				ValueFrom: datav1alpha1.EncryptOptionSource{
+					SecretKeyRef: datav1alpha1.SecretKeySelector{Name: "mysecret", Key: "ak"},
+				},
+			},
+			want: datav1alpha1.SecretKeySelector{Name: "mysecret", Key: "ak"},
+		},
+		"credential provider": {
+			option: datav1alpha1.EncryptOption{
+				Name: "fs.s3a.access.key",
+				ValueFrom: datav1alpha1.EncryptOptionSource{
+					CredentialProvider: &datav1alpha1.CredentialProviderSelector{URL: "http://provider", Key: "accessKeyId"},
+				},
+			},
+			want: datav1alpha1.SecretKeySelector{Name: "hbase-fluid-credentials", Key: "fs.s3a.access.key"},
+		},
+		"csi secret store": {
+			option: datav1alpha1.EncryptOption{
+				Name: "fs.s3a.access.key",
+				ValueFrom: datav1alpha1.EncryptOptionSource{
+					CSISecretStore: &datav1alpha1.CSISecretStoreSelector{SecretProviderClass: "vault", ObjectName: "ak"},
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range testCases {
+		got, err := GetSecretKeyRef("hbase", tc.option)
+		if (err != nil) != tc.wantErr {
+			t.Errorf("%s: expect error %v, got %v", name, tc.wantErr, err)
+		}
+		if got != tc.want {
+			t.Errorf("%s: expect %v, got %v", name, tc.want, got)
+		}
+	}
+}
+
+func TestGetFile(t *testing.T) {
+	volumeName := func(source string) string {
+		return "mount-" + source
+	}
+
+	file := GetFile("hbase", datav1alpha1.EncryptOption{
+		Name: "fs.s3a.access.key",
+		ValueFrom: datav1alpha1.EncryptOptionSource{
+			SecretKeyRef: datav1alpha1.SecretKeySelector{Name: "mysecret", Key: "ak"},
+		},
+	}, volumeName)
+	if file.Volume.Name != "mount-mysecret" || file.Volume.Secret == nil || file.Volume.Secret.SecretName != "mysecret" {
+		t.Errorf("unexpected volume of the secret: %v", file.Volume)
+	}
+	if file.MountPath != "/etc/fluid/secrets/mysecret" || file.Path != "/etc/fluid/secrets/mysecret/ak" {
+		t.Errorf("unexpected path of the secret: %s, %s", file.MountPath, file.Path)
+	}
+
+	file = GetFile("hbase", datav1alpha1.EncryptOption{
+		Name: "fs.s3a.access.key",
+		ValueFrom: datav1alpha1.EncryptOptionSource{
+			CredentialProvider: &datav1alpha1.CredentialProviderSelector{URL: "http://provider", Key: "accessKeyId"},
+		},
+	}, volumeName)
+	if file.Volume.Secret == nil || file.Volume.Secret.SecretName != "hbase-fluid-credentials" {
+		t.Errorf("unexpected volume of the credential provider: %v", file.Volume)
+	}
+	if file.Path != "/etc/fluid/secrets/hbase-fluid-credentials/fs.s3a.access.key" {
+		t.Errorf("unexpected path of the credential provider: %s", file.Path)
+	}
+
+	file = GetFile("hbase", datav1alpha1.EncryptOption{
+		Name: "fs.s3a.access.key",
+		ValueFrom: datav1alpha1.EncryptOptionSource{
+			CSISecretStore: &datav1alpha1.CSISecretStoreSelector{SecretProviderClass: "vault", ObjectName: "ak"},
+		},
+	}, volumeName)
+	if file.Volume.Name != "mount-spc-vault" || file.Volume.CSI == nil || file.Volume.CSI.Driver != SecretsStoreCSIDriver ||
+		file.Volume.CSI.VolumeAttributes["secretProviderClass"] != "vault" {
+		t.Errorf("unexpected volume of the csi secret store: %v", file.Volume)
+	}
+	if file.MountPath != "/etc/fluid/secrets/spc-vault" || file.Path != "/etc/fluid/secrets/spc-vault/ak" {
+		t.Errorf("unexpected path of the csi secret store: %s, %s", file.MountPath, file.Path)
+	}
+}
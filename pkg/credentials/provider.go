@@ -0,0 +1,313 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentials
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
+)
+
+const (
+	defaultServiceAccountName = "default"
+
+	// tokenExpirationSeconds is the lifetime of the service account token posted to the credential provider,
+	// which is the minimum allowed by the TokenRequest API
+	tokenExpirationSeconds = 600
+
+	// refreshRatio is the ratio of the lifetime of the credentials after which they are refreshed
+	refreshRatio = 0.8
+
+	// allDatasets allows all the datasets in the namespace of a service account to exchange credentials with its tokens
+	allDatasets = "*"
+)
+
+var providerClient = &http.Client{Timeout: 30 * time.Second}
+
+// allowedProviderURLs are the URLs of the credential providers allowed by the administrator
+var allowedProviderURLs = map[string]bool{}
+
+// SetAllowedProviderURLs sets the URLs of the credential providers allowed by the administrator. The dataset controller
+// never posts tokens to the URLs not in the list, so no credentials are exchanged if it's empty.
+func SetAllowedProviderURLs(urls []string) {
+	allowedProviderURLs = map[string]bool{}
+	for _, url := range urls {
+		if url = strings.TrimSpace(url); url != "" {
+			allowedProviderURLs[url] = true
+		}
+	}
+}
+
+// ProviderRequest is posted to the URL of the credential provider as JSON, with a token of the service account
+// as the bearer token in the Authorization header. The audience of the token is the URL of the credential provider,
+// so the provider can verify it by a TokenReview without being able to reuse it elsewhere.
+type ProviderRequest struct {
+	// Namespace of the dataset and the service account
+	Namespace string `json:"namespace"`
+
+	// Dataset whose encrypt options request the credentials
+	Dataset string `json:"dataset"`
+
+	// ServiceAccountName of the token
+	ServiceAccountName string `json:"serviceAccountName"`
+}
+
+// ProviderResponse is returned by the credential provider as JSON with the status 200
+type ProviderResponse struct {
+	// Credentials keyed by the key in the credentialProvider of the encrypt options
+	Credentials map[string]string `json:"credentials"`
+
+	// ExpirationTimestamp is the time when the credentials expire, they never expire if it's not set
+	ExpirationTimestamp *metav1.Time `json:"expirationTimestamp,omitempty"`
+}
+
+type providerKey struct {
+	url                string
+	serviceAccountName string
+}
+
+// RefreshCredentials exchanges the credentials of the encrypt options of the dataset from the credential providers,
+// and stores them in the secret of the dataset, which is mounted to the pods of the runtime instead of the secrets
+// of the users. The credentials are exchanged again once most of their lifetime passes, or the encrypt options change,
+// and the kubelet propagates them to the mounted secret volumes of master, worker and fuse. The pods of the runtimes
+// taking the credentials at startup are restarted once the credentials are refreshed.
+// It returns the duration after which the credentials need to be refreshed, or zero if they never expire.
+func RefreshCredentials(c client.Client, dataset *datav1alpha1.Dataset) (refreshAfter time.Duration, refreshed bool, err error) {
+	options := getProviderOptions(dataset)
+	if len(options) == 0 {
+		return 0, false, nil
+	}
+	for _, option := range options {
+		if url := option.ValueFrom.CredentialProvider.URL; !allowedProviderURLs[url] {
+			return 0, false, fmt.Errorf("credential provider %s of encryptOption %s is not allowed by the administrator", url, option.Name)
+		}
+	}
+
+	optionsHash, err := hashOptions(options)
+	if err != nil {
+		return 0, false, err
+	}
+
+	now := time.Now()
+	secret, err := kubeclient.GetSecret(c, GetSecretName(dataset.Name), dataset.Namespace)
+	if err != nil && !apierrs.IsNotFound(err) {
+		return 0, false, err
+	}
+	if err == nil && secret.Annotations[common.AnnotationCredentialsOptionsHash] == optionsHash {
+		value, found := secret.Annotations[common.AnnotationCredentialsRefreshAfter]
+		if !found {
+			// the credentials never expire
+			return 0, false, nil
+		}
+		refreshAt, parseErr := time.Parse(time.RFC3339, value)
+		if parseErr == nil && refreshAt.After(now) {
+			return refreshAt.Sub(now), false, nil
+		}
+	}
+
+	data := map[string][]byte{}
+	var expiration *time.Time
+	responses := map[providerKey]*ProviderResponse{}
+	for _, option := range options {
+		provider := option.ValueFrom.CredentialProvider
+		key := providerKey{url: provider.URL, serviceAccountName: provider.ServiceAccountName}
+		if key.serviceAccountName == "" {
+			key.serviceAccountName = defaultServiceAccountName
+		}
+
+		response, found := responses[key]
+		if !found {
+			response, err = exchange(c, dataset, key)
+			if err != nil {
+				return 0, false, err
+			}
+			responses[key] = response
+		}
+
+		value, found := response.Credentials[provider.Key]
+		if !found {
+			return 0, false, fmt.Errorf("credential provider %s returns no credential %s for encryptOption %s", provider.URL, provider.Key, option.Name)
+		}
+		if existing, found := data[option.Name]; found && string(existing) != value {
+			return 0, false, fmt.Errorf("encryptOption %s is set more than one times with different credential providers", option.Name)
+		}
+		data[option.Name] = []byte(value)
+
+		if response.ExpirationTimestamp != nil && (expiration == nil || response.ExpirationTimestamp.Time.Before(*expiration)) {
+			expiration = &response.ExpirationTimestamp.Time
+		}
+	}
+
+	annotations := map[string]string{
+		common.AnnotationCredentialsOptionsHash: optionsHash,
+	}
+	if expiration != nil {
+		if !expiration.After(now) {
+			return 0, false, fmt.Errorf("the credentials exchanged for dataset %s/%s expired at %s", dataset.Namespace, dataset.Name, expiration.Format(time.RFC3339))
+		}
+		refreshAfter = time.Duration(float64(expiration.Sub(now)) * refreshRatio)
+		annotations[common.AnnotationCredentialsExpiration] = expiration.Format(time.RFC3339)
+		annotations[common.AnnotationCredentialsRefreshAfter] = now.Add(refreshAfter).Format(time.RFC3339)
+	}
+
+	if secret == nil {
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        GetSecretName(dataset.Name),
+				Namespace:   dataset.Namespace,
+				Labels:      map[string]string{common.LabelCredentialsManaged: "true"},
+				Annotations: annotations,
+				OwnerReferences: []metav1.OwnerReference{{
+					APIVersion: datav1alpha1.GroupVersion.String(),
+					Kind:       datav1alpha1.Datasetkind,
+					Name:       dataset.Name,
+					UID:        dataset.UID,
+				}},
+			},
+			Data: data,
+		}
+		err = kubeclient.CreateSecret(c, secret)
+	} else {
+		secretToUpdate := secret.DeepCopy()
+		secretToUpdate.Annotations = annotations
+		secretToUpdate.Data = data
+		if err = kubeclient.UpdateSecret(c, secretToUpdate); err == nil {
+			err = restartRuntime(c, dataset, now)
+		}
+	}
+	if err != nil {
+		return 0, false, err
+	}
+
+	return refreshAfter, true, nil
+}
+
+// getProviderOptions returns the encrypt options exchanged from credential providers
+func getProviderOptions(dataset *datav1alpha1.Dataset) (options []datav1alpha1.EncryptOption) {
+	for _, option := range dataset.Spec.SharedEncryptOptions {
+		if option.ValueFrom.CredentialProvider != nil {
+			options = append(options, option)
+		}
+	}
+	for _, mount := range dataset.Spec.Mounts {
+		if common.IsFluidNativeScheme(mount.MountPoint) {
+			continue
+		}
+		for _, option := range mount.EncryptOptions {
+			if option.ValueFrom.CredentialProvider != nil {
+				options = append(options, option)
+			}
+		}
+	}
+	return
+}
+
+func hashOptions(options []datav1alpha1.EncryptOption) (string, error) {
+	content, err := json.Marshal(options)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])[:16], nil
+}
+
+// checkServiceAccount checks if the owner of the service account allows the dataset to exchange credentials
+// with its tokens, so creating a dataset doesn't grant the tokens of all the service accounts in the namespace
+func checkServiceAccount(c client.Client, dataset *datav1alpha1.Dataset, serviceAccount *corev1.ServiceAccount) error {
+	err := c.Get(context.TODO(), client.ObjectKeyFromObject(serviceAccount), serviceAccount)
+	if err != nil {
+		return fmt.Errorf("failed to get service account %s/%s: %w", serviceAccount.Namespace, serviceAccount.Name, err)
+	}
+	for _, name := range strings.Split(serviceAccount.Annotations[common.AnnotationCredentialsDatasets], ",") {
+		if name = strings.TrimSpace(name); name == allDatasets || name == dataset.Name {
+			return nil
+		}
+	}
+	return fmt.Errorf("service account %s/%s doesn't allow dataset %s to exchange credentials with its tokens, annotate it with %s",
+		serviceAccount.Namespace, serviceAccount.Name, dataset.Name, common.AnnotationCredentialsDatasets)
+}
+
+// exchange posts a token of the service account to the credential provider for the credentials
+func exchange(c client.Client, dataset *datav1alpha1.Dataset, key providerKey) (*ProviderResponse, error) {
+	serviceAccount := &corev1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      key.serviceAccountName,
+			Namespace: dataset.Namespace,
+		},
+	}
+	if err := checkServiceAccount(c, dataset, serviceAccount); err != nil {
+		return nil, err
+	}
+	tokenRequest := &authenticationv1.TokenRequest{
+		Spec: authenticationv1.TokenRequestSpec{
+			Audiences:         []string{key.url},
+			ExpirationSeconds: ptr.To(int64(tokenExpirationSeconds)),
+		},
+	}
+	if err := c.SubResource("token").Create(context.TODO(), serviceAccount, tokenRequest); err != nil {
+		return nil, fmt.Errorf("failed to request a token of service account %s/%s: %w", dataset.Namespace, key.serviceAccountName, err)
+	}
+
+	body, err := json.Marshal(ProviderRequest{
+		Namespace:          dataset.Namespace,
+		Dataset:            dataset.Name,
+		ServiceAccountName: key.serviceAccountName,
+	})
+	if err != nil {
+		return nil, err
+	}
+	request, err := http.NewRequest(http.MethodPost, key.url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("Authorization", "Bearer "+tokenRequest.Status.Token)
+
+	response, err := providerClient.Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to exchange credentials from credential provider %s: %w", key.url, err)
+	}
+	defer response.Body.Close()
+
+	// the body is not kept in the error, which is recorded in the events of the dataset
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("credential provider %s returns status %d", key.url, response.StatusCode)
+	}
+
+	result := &ProviderResponse{}
+	if err = json.NewDecoder(response.Body).Decode(result); err != nil {
+		return nil, fmt.Errorf("failed to decode the response of credential provider %s: %w", key.url, err)
+	}
+	return result, nil
+}
@@ -0,0 +1,292 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentials
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+)
+
+// newTokenClient returns a client issuing the token "<namespace>/<service account>@<audience>" for the service
+// account "trainer" allowing the dataset "hbase" to exchange credentials with its tokens
+func newTokenClient(t *testing.T, objs ...runtime.Object) client.Client {
+	s := runtime.NewScheme()
+	_ = corev1.AddToScheme(s)
+	_ = appsv1.AddToScheme(s)
+	_ = datav1alpha1.AddToScheme(s)
+	objs = append(objs, &corev1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "trainer",
+			Namespace:   "default",
+			Annotations: map[string]string{common.AnnotationCredentialsDatasets: "spark, hbase"},
+		},
+	})
+	return interceptor.NewClient(fakeclient.NewClientBuilder().WithScheme(s).WithRuntimeObjects(objs...).Build(), interceptor.Funcs{
+		SubResourceCreate: func(ctx context.Context, c client.Client, subResourceName string, obj client.Object, subResource client.Object, opts ...client.SubResourceCreateOption) error {
+			tokenRequest, ok := subResource.(*authenticationv1.TokenRequest)
+			if subResourceName != "token" || !ok {
+				t.Fatalf("unexpected subresource %s", subResourceName)
+			}
+			tokenRequest.Status.Token = obj.GetNamespace() + "/" + obj.GetName() + "@" + tokenRequest.Spec.Audiences[0]
+			return nil
+		},
+	})
+}
+
+func newProvider(t *testing.T, expiration time.Duration, requests *int) *httptest.Server {
+	var server *httptest.Server
+	server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*requests++
+		request := ProviderRequest{}
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+		if r.Header.Get("Authorization") != "Bearer "+request.Namespace+"/"+request.ServiceAccountName+"@"+server.URL {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		response := ProviderResponse{
+			Credentials: map[string]string{
+				"accessKeyId":     "ak-" + request.ServiceAccountName,
+				"accessKeySecret": "sk-" + request.ServiceAccountName,
+			},
+		}
+		if expiration > 0 {
+			response.ExpirationTimestamp = &metav1.Time{Time: time.Now().Add(expiration)}
+		}
+		_ = json.NewEncoder(w).Encode(response)
+	}))
+	SetAllowedProviderURLs([]string{server.URL})
+	return server
+}
+
+func providerDataset(url string, keys ...string) *datav1alpha1.Dataset {
+	dataset := &datav1alpha1.Dataset{
+		ObjectMeta: metav1.ObjectMeta{Name: "hbase", Namespace: "default", UID: "uid"},
+		Spec: datav1alpha1.DatasetSpec{
+			Mounts: []datav1alpha1.Mount{{MountPoint: "s3://bucket"}},
+		},
+	}
+	for _, key := range keys {
+		dataset.Spec.Mounts[0].EncryptOptions = append(dataset.Spec.Mounts[0].EncryptOptions, datav1alpha1.EncryptOption{
+			Name: "fs.s3a." + key,
+			ValueFrom: datav1alpha1.EncryptOptionSource{
+				CredentialProvider: &datav1alpha1.CredentialProviderSelector{URL: url, ServiceAccountName: "trainer", Key: key},
+			},
+		})
+	}
+	return dataset
+}
+
+func TestRefreshCredentials(t *testing.T) {
+	var requests int
+	server := newProvider(t, time.Hour, &requests)
+	defer server.Close()
+	c := newTokenClient(t)
+
+	refreshAfter, refreshed, err := RefreshCredentials(c, providerDataset(server.URL))
+	if err != nil || refreshed || refreshAfter != 0 || requests != 0 {
+		t.Fatalf("expect nothing to refresh without credential providers, got %v, %v, %v", refreshAfter, refreshed, err)
+	}
+
+	dataset := providerDataset(server.URL, "accessKeyId", "accessKeySecret")
+	refreshAfter, refreshed, err = RefreshCredentials(c, dataset)
+	if err != nil || !refreshed {
+		t.Fatalf("expect the credentials refreshed, got %v, %v", refreshed, err)
+	}
+	if refreshAfter <= 40*time.Minute || refreshAfter > 48*time.Minute {
+		t.Errorf("expect the credentials refreshed after 80%% of their lifetime, got %v", refreshAfter)
+	}
+	if requests != 1 {
+		t.Errorf("expect the credentials of a provider exchanged once, got %d requests", requests)
+	}
+
+	secret := &corev1.Secret{}
+	if err = c.Get(context.TODO(), client.ObjectKey{Namespace: "default", Name: "hbase-fluid-credentials"}, secret); err != nil {
+		t.Fatalf("failed to get the secret of the credentials: %v", err)
+	}
+	if string(secret.Data["fs.s3a.accessKeyId"]) != "ak-trainer" || string(secret.Data["fs.s3a.accessKeySecret"]) != "sk-trainer" {
+		t.Errorf("unexpected credentials: %v", secret.Data)
+	}
+	if secret.Labels[common.LabelCredentialsManaged] != "true" || len(secret.OwnerReferences) != 1 || secret.OwnerReferences[0].UID != "uid" {
+		t.Errorf("unexpected metadata of the secret: %v", secret.ObjectMeta)
+	}
+
+	// the credentials are not exchanged again before most of their lifetime passes
+	refreshAfter, refreshed, err = RefreshCredentials(c, dataset)
+	if err != nil || refreshed || refreshAfter <= 0 || requests != 1 {
+		t.Errorf("expect the credentials not refreshed, got %v, %v, %v, %d requests", refreshAfter, refreshed, err, requests)
+	}
+
+	// the credentials are exchanged again once the encrypt options change
+	dataset = providerDataset(server.URL, "accessKeyId")
+	_, refreshed, err = RefreshCredentials(c, dataset)
+	if err != nil || !refreshed || requests != 2 {
+		t.Errorf("expect the credentials refreshed for the changed options, got %v, %v, %d requests", refreshed, err, requests)
+	}
+
+	// the credentials are exchanged again once the refresh time passes
+	secret = &corev1.Secret{}
+	_ = c.Get(context.TODO(), client.ObjectKey{Namespace: "default", Name: "hbase-fluid-credentials"}, secret)
+	secret.Annotations[common.AnnotationCredentialsRefreshAfter] = time.Now().Add(-time.Minute).Format(time.RFC3339)
+	_ = c.Update(context.TODO(), secret)
+	_, refreshed, err = RefreshCredentials(c, dataset)
+	if err != nil || !refreshed || requests != 3 {
+		t.Errorf("expect the credentials refreshed after the refresh time, got %v, %v, %d requests", refreshed, err, requests)
+	}
+	secret = &corev1.Secret{}
+	_ = c.Get(context.TODO(), client.ObjectKey{Namespace: "default", Name: "hbase-fluid-credentials"}, secret)
+	if _, found := secret.Data["fs.s3a.accessKeySecret"]; found {
+		t.Errorf("expect the credentials of the removed option deleted, got %v", secret.Data)
+	}
+}
+
+func TestRefreshCredentialsNeverExpire(t *testing.T) {
+	var requests int
+	server := newProvider(t, 0, &requests)
+	defer server.Close()
+	c := newTokenClient(t)
+
+	dataset := providerDataset(server.URL, "accessKeyId")
+	refreshAfter, refreshed, err := RefreshCredentials(c, dataset)
+	if err != nil || !refreshed || refreshAfter != 0 {
+		t.Fatalf("expect the credentials refreshed without requeue, got %v, %v, %v", refreshAfter, refreshed, err)
+	}
+	_, refreshed, err = RefreshCredentials(c, dataset)
+	if err != nil || refreshed || requests != 1 {
+		t.Errorf("expect the credentials never refreshed again, got %v, %v, %d requests", refreshed, err, requests)
+	}
+}
+
+func TestRefreshCredentialsFailed(t *testing.T) {
+	var requests int
+	server := newProvider(t, time.Hour, &requests)
+	defer server.Close()
+	c := newTokenClient(t)
+
+	// the provider doesn't return the key
+	if _, _, err := RefreshCredentials(c, providerDataset(server.URL, "token")); err == nil {
+		t.Errorf("expect error for the credential not returned by the provider")
+	}
+
+	// the service account doesn't allow the dataset to exchange credentials with its tokens
+	dataset := providerDataset(server.URL, "accessKeyId")
+	dataset.Name = "hive"
+	if _, _, err := RefreshCredentials(c, dataset); err == nil || !strings.Contains(err.Error(), common.AnnotationCredentialsDatasets) {
+		t.Errorf("expect error for the service account not allowing the dataset, got %v", err)
+	}
+
+	// the provider rejects the token
+	unauthorized := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "invalid token for trainer", http.StatusUnauthorized)
+	}))
+	defer unauthorized.Close()
+	SetAllowedProviderURLs([]string{server.URL, unauthorized.URL})
+	_, _, err := RefreshCredentials(c, providerDataset(unauthorized.URL, "accessKeyId"))
+	if err == nil || strings.Contains(err.Error(), "invalid token") {
+		t.Errorf("expect error without the body of the response for the rejected token, got %v", err)
+	}
+
+	// the provider is not allowed by the administrator
+	SetAllowedProviderURLs(nil)
+	exchanged := requests
+	if _, _, err := RefreshCredentials(c, providerDataset(server.URL, "accessKeyId")); err == nil || requests != exchanged {
+		t.Errorf("expect error without any request for the provider not allowed, got %v, %d requests", err, requests)
+	}
+
+	secret := &corev1.Secret{}
+	if err := c.Get(context.TODO(), client.ObjectKey{Namespace: "default", Name: "hbase-fluid-credentials"}, secret); err == nil {
+		t.Errorf("expect no secret created for the failed exchanges")
+	}
+}
+
+func TestRefreshCredentialsRestartRuntime(t *testing.T) {
+	var requests int
+	server := newProvider(t, time.Hour, &requests)
+	defer server.Close()
+
+	workload := func(name, role string) *appsv1.StatefulSet {
+		return &appsv1.StatefulSet{ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "default",
+			Labels:    map[string]string{"release": "hbase", "role": role},
+		}}
+	}
+	fuse := &appsv1.DaemonSet{ObjectMeta: metav1.ObjectMeta{
+		Name:      "hbase-fuse",
+		Namespace: "default",
+		Labels:    map[string]string{"release": "hbase", "role": "alluxio-fuse"},
+	}}
+	c := newTokenClient(t, workload("hbase-master", "alluxio-master"), workload("hbase-worker", "alluxio-worker"), fuse)
+
+	dataset := providerDataset(server.URL, "accessKeyId")
+	dataset.Status.Runtimes = []datav1alpha1.Runtime{{Name: "hbase", Namespace: "default", Type: common.AlluxioRuntime}}
+	if _, _, err := RefreshCredentials(c, dataset); err != nil {
+		t.Fatalf("failed to exchange the credentials: %v", err)
+	}
+
+	restarted := func(name string) bool {
+		statefulSet := &appsv1.StatefulSet{}
+		_ = c.Get(context.TODO(), client.ObjectKey{Namespace: "default", Name: name}, statefulSet)
+		_, found := statefulSet.Spec.Template.Annotations[common.AnnotationCredentialsRefreshedAt]
+		return found
+	}
+	if restarted("hbase-master") {
+		t.Errorf("expect the master not restarted for the credentials exchanged the first time")
+	}
+
+	secret := &corev1.Secret{}
+	_ = c.Get(context.TODO(), client.ObjectKey{Namespace: "default", Name: "hbase-fluid-credentials"}, secret)
+	secret.Annotations[common.AnnotationCredentialsRefreshAfter] = time.Now().Add(-time.Minute).Format(time.RFC3339)
+	_ = c.Update(context.TODO(), secret)
+	if _, refreshed, err := RefreshCredentials(c, dataset); err != nil || !refreshed {
+		t.Fatalf("expect the credentials refreshed, got %v, %v", refreshed, err)
+	}
+	if !restarted("hbase-master") || restarted("hbase-worker") {
+		t.Errorf("expect only the master of alluxio restarted to mount the storage with the refreshed credentials")
+	}
+
+	// the pods of juicefs take the credentials as environment variables
+	dataset.Status.Runtimes[0].Type = common.JuiceFSRuntime
+	_ = c.Get(context.TODO(), client.ObjectKey{Namespace: "default", Name: "hbase-fluid-credentials"}, secret)
+	secret.Annotations[common.AnnotationCredentialsRefreshAfter] = time.Now().Add(-time.Minute).Format(time.RFC3339)
+	_ = c.Update(context.TODO(), secret)
+	if _, _, err := RefreshCredentials(c, dataset); err != nil {
+		t.Fatalf("failed to refresh the credentials: %v", err)
+	}
+	_ = c.Get(context.TODO(), client.ObjectKeyFromObject(fuse), fuse)
+	if !restarted("hbase-worker") || fuse.Spec.Template.Annotations[common.AnnotationCredentialsRefreshedAt] == "" {
+		t.Errorf("expect the workers and the fuse of juicefs restarted with the refreshed credentials")
+	}
+}
@@ -0,0 +1,80 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package credentials
+
+import (
+	"context"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+)
+
+// restartRuntime restarts the pods of the runtime bound to the dataset which take the credentials at startup,
+// by annotating the pod templates of the statefulsets and daemonsets released with the runtime. The fuse
+// daemonsets are updated on delete, so the fuse pods take the refreshed credentials once they are upgraded.
+func restartRuntime(c client.Client, dataset *datav1alpha1.Dataset, refreshedAt time.Time) error {
+	if len(dataset.Status.Runtimes) == 0 {
+		return nil
+	}
+	runtime := dataset.Status.Runtimes[0]
+
+	selector := client.MatchingLabels{"release": runtime.Name}
+	switch runtime.Type {
+	case common.ThinRuntime, common.CacheRuntime:
+		// the files of the credentials are read again once they change
+		return nil
+	case common.AlluxioRuntime:
+		// the storage is mounted with the refreshed credentials again once the master restarts
+		selector["role"] = "alluxio-master"
+	}
+
+	statefulSets := &appsv1.StatefulSetList{}
+	if err := c.List(context.TODO(), statefulSets, client.InNamespace(runtime.Namespace), selector); err != nil {
+		return err
+	}
+	for i := range statefulSets.Items {
+		statefulSet := &statefulSets.Items[i]
+		if err := annotatePodTemplate(c, statefulSet, &statefulSet.Spec.Template.Annotations, refreshedAt); err != nil {
+			return err
+		}
+	}
+
+	daemonSets := &appsv1.DaemonSetList{}
+	if err := c.List(context.TODO(), daemonSets, client.InNamespace(runtime.Namespace), selector); err != nil {
+		return err
+	}
+	for i := range daemonSets.Items {
+		daemonSet := &daemonSets.Items[i]
+		if err := annotatePodTemplate(c, daemonSet, &daemonSet.Spec.Template.Annotations, refreshedAt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func annotatePodTemplate(c client.Client, obj client.Object, annotations *map[string]string, refreshedAt time.Time) error {
+	patch := client.MergeFrom(obj.DeepCopyObject().(client.Object))
+	if *annotations == nil {
+		*annotations = map[string]string{}
+	}
+	(*annotations)[common.AnnotationCredentialsRefreshedAt] = refreshedAt.Format(time.RFC3339)
+	return c.Patch(context.TODO(), obj, patch)
+}
@@ -23,6 +23,7 @@ import (
 	"os/exec"
 	"path/filepath"
 	"strings"
+	"sync"
 	"syscall"
 	"time"
 
@@ -54,6 +55,11 @@ const (
 	AllowPatchStaleNodeEnv = "ALLOW_PATCH_STALE_NODE"
 )
 
+// statfsTimeout is the timeout of statfs on the fuse mount point, which hangs if the fuse file system doesn't respond
+var statfsTimeout = 10 * time.Second
+
+var errStatfsTimeout = errors.New("statfs timed out")
+
 type nodeServer struct {
 	nodeId string
 	*csicommon.DefaultNodeServer
@@ -62,6 +68,10 @@ type nodeServer struct {
 	nodeAuthorizedClient *kubernetes.Clientset
 	locks                *utils.VolumeLocks
 	node                 *corev1.Node
+	// pendingStatfs are the volume paths whose statfs calls haven't returned
+	pendingStatfs sync.Map
+	// datasetByVolume caches the namespaced name of the dataset bound to each volume, which doesn't change until the volume is unstaged
+	datasetByVolume sync.Map
 }
 
 func (ns *nodeServer) NodePublishVolume(ctx context.Context, req *csi.NodePublishVolumeRequest) (*csi.NodePublishVolumeResponse, error) {
@@ -301,6 +311,7 @@ func (ns *nodeServer) NodeUnstageVolume(ctx context.Context, req *csi.NodeUnstag
 			return nil, errors.Wrapf(err, "NodeUnstageVolume: failed to clean fuse for volume %s", volumeId)
 		}
 	}
+	ns.datasetByVolume.Delete(volumeId)
 
 	return &csi.NodeUnstageVolumeResponse{}, nil
 }
@@ -376,6 +387,172 @@ func (ns *nodeServer) NodeExpandVolume(ctx context.Context, req *csi.NodeExpandV
 	return nil, status.Error(codes.Unimplemented, "")
 }
 
+// NodeGetVolumeStats reports the usage of the volume mounted at the volume path. The usage comes from statfs on the fuse mount point,
+// and falls back to the cache states of the bound Dataset if the fuse file system doesn't report its capacity.
+// A broken or hanging fuse mount point is reported as an abnormal volume condition so that kubelet can expose it.
+func (ns *nodeServer) NodeGetVolumeStats(ctx context.Context, req *csi.NodeGetVolumeStatsRequest) (*csi.NodeGetVolumeStatsResponse, error) {
+	volumeId := req.GetVolumeId()
+	if len(volumeId) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "NodeGetVolumeStats: volume ID must be provided")
+	}
+	volumePath := req.GetVolumePath()
+	if len(volumePath) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "NodeGetVolumeStats: volume path must be provided")
+	}
+
+	fsStat, err := ns.statfs(ctx, volumePath)
+	if errors.Is(err, errStatfsTimeout) {
+		glog.Warningf("NodeGetVolumeStats: volume %s is abnormal, statfs on the fuse mount point %s doesn't return in %v", volumeId, volumePath, statfsTimeout)
+		return &csi.NodeGetVolumeStatsResponse{
+			VolumeCondition: &csi.VolumeCondition{
+				Abnormal: true,
+				Message:  fmt.Sprintf("statfs on fuse mount point %s doesn't return in %v, the fuse file system may hang", volumePath, statfsTimeout),
+			},
+		}, nil
+	}
+	if err != nil {
+		// wrap the errno so that mount.IsCorruptedMnt can recognize the broken fuse mount point
+		err = &os.PathError{Op: "statfs", Path: volumePath, Err: err}
+		if os.IsNotExist(err) {
+			return nil, status.Errorf(codes.NotFound, "NodeGetVolumeStats: volume path %s does not exist", volumePath)
+		}
+		if mount.IsCorruptedMnt(err) {
+			glog.Warningf("NodeGetVolumeStats: volume %s is abnormal, the fuse mount point %s is broken: %v", volumeId, volumePath, err)
+			return &csi.NodeGetVolumeStatsResponse{
+				VolumeCondition: &csi.VolumeCondition{
+					Abnormal: true,
+					Message:  fmt.Sprintf("fuse mount point %s is broken: %v", volumePath, err),
+				},
+			}, nil
+		}
+		return nil, status.Errorf(codes.Internal, "NodeGetVolumeStats: failed to statfs volume path %s: %v", volumePath, err)
+	}
+
+	usage, err := ns.getVolumeUsage(volumeId, fsStat)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "NodeGetVolumeStats: failed to get usage of volume %s: %v", volumeId, err)
+	}
+
+	return &csi.NodeGetVolumeStatsResponse{
+		Usage: usage,
+		VolumeCondition: &csi.VolumeCondition{
+			Abnormal: false,
+			Message:  "fuse mount point is healthy",
+		},
+	}, nil
+}
+
+// statfs calls statfs on the volume path with a timeout. The statfs call blocked by a hanging fuse file system is left
+// in the background, and no more statfs calls are made on the volume path until it returns.
+func (ns *nodeServer) statfs(ctx context.Context, volumePath string) (fsStat syscall.Statfs_t, err error) {
+	if _, pending := ns.pendingStatfs.LoadOrStore(volumePath, struct{}{}); pending {
+		return fsStat, errStatfsTimeout
+	}
+
+	type statfsResult struct {
+		fsStat syscall.Statfs_t
+		err    error
+	}
+	resultCh := make(chan statfsResult, 1)
+	go func() {
+		defer ns.pendingStatfs.Delete(volumePath)
+		var result statfsResult
+		result.err = syscall.Statfs(volumePath, &result.fsStat)
+		resultCh <- result
+	}()
+
+	timer := time.NewTimer(statfsTimeout)
+	defer timer.Stop()
+	select {
+	case result := <-resultCh:
+		return result.fsStat, result.err
+	case <-timer.C:
+		return fsStat, errStatfsTimeout
+	case <-ctx.Done():
+		return fsStat, errStatfsTimeout
+	}
+}
+
+// getVolumeUsage converts the statfs result of the fuse mount point to volume usages. Some fuse file systems report
+// zero blocks, in which case the byte usage is calculated from the cache states of the Dataset bound to the volume:
+// the capacity is the cache capacity (or the UFS total if the cache capacity is unknown) and the used bytes are the cached bytes.
+func (ns *nodeServer) getVolumeUsage(volumeId string, fsStat syscall.Statfs_t) (usage []*csi.VolumeUsage, err error) {
+	if fsStat.Blocks > 0 {
+		blockSize := int64(fsStat.Bsize)
+		total := int64(fsStat.Blocks) * blockSize
+		available := int64(fsStat.Bavail) * blockSize
+		usage = append(usage, &csi.VolumeUsage{
+			Unit:      csi.VolumeUsage_BYTES,
+			Total:     total,
+			Available: available,
+			Used:      total - int64(fsStat.Bfree)*blockSize,
+		})
+	} else {
+		bytesUsage, err := ns.getDatasetUsage(volumeId)
+		if err != nil {
+			return nil, err
+		}
+		usage = append(usage, bytesUsage)
+	}
+
+	if fsStat.Files > 0 {
+		usage = append(usage, &csi.VolumeUsage{
+			Unit:      csi.VolumeUsage_INODES,
+			Total:     int64(fsStat.Files),
+			Available: int64(fsStat.Ffree),
+			Used:      int64(fsStat.Files) - int64(fsStat.Ffree),
+		})
+	}
+
+	return usage, nil
+}
+
+func (ns *nodeServer) getDatasetUsage(volumeId string) (*csi.VolumeUsage, error) {
+	datasetKey, err := ns.getDatasetByVolume(volumeId)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get dataset bound to volume %s", volumeId)
+	}
+
+	// the dataset is read from the informer cache, kubelet polls the volume stats periodically
+	dataset, err := utils.GetDataset(ns.client, datasetKey.Name, datasetKey.Namespace)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get dataset %s", datasetKey)
+	}
+
+	// the sizes in the dataset status are reported by the runtimes in best effort, so ignore the ones can't be parsed
+	used, _ := base.ParseCacheStateBytes(dataset.Status.CacheStates, common.Cached)
+	total, _ := base.ParseCacheStateBytes(dataset.Status.CacheStates, common.CacheCapacity)
+	if total == 0 {
+		total, _ = base.ParseSizeBytes(dataset.Status.UfsTotal)
+	}
+	if total < used {
+		total = used
+	}
+
+	return &csi.VolumeUsage{
+		Unit:      csi.VolumeUsage_BYTES,
+		Total:     total,
+		Available: total - used,
+		Used:      used,
+	}, nil
+}
+
+// getDatasetByVolume gets the namespaced name of the dataset bound to the volume, which is cached to avoid getting
+// the PV and PVC from the API server on every poll of the volume stats.
+func (ns *nodeServer) getDatasetByVolume(volumeId string) (types.NamespacedName, error) {
+	if cached, found := ns.datasetByVolume.Load(volumeId); found {
+		return cached.(types.NamespacedName), nil
+	}
+
+	namespace, name, err := volume.GetNamespacedNameByVolumeId(ns.apiReader, volumeId)
+	if err != nil {
+		return types.NamespacedName{}, err
+	}
+	datasetKey := types.NamespacedName{Namespace: namespace, Name: name}
+	ns.datasetByVolume.Store(volumeId, datasetKey)
+	return datasetKey, nil
+}
+
 func (ns *nodeServer) NodeGetCapabilities(ctx context.Context, req *csi.NodeGetCapabilitiesRequest) (*csi.NodeGetCapabilitiesResponse, error) {
 	glog.V(5).Infof("Using default NodeGetCapabilities")
 
@@ -388,6 +565,20 @@ func (ns *nodeServer) NodeGetCapabilities(ctx context.Context, req *csi.NodeGetC
 					},
 				},
 			},
+			{
+				Type: &csi.NodeServiceCapability_Rpc{
+					Rpc: &csi.NodeServiceCapability_RPC{
+						Type: csi.NodeServiceCapability_RPC_GET_VOLUME_STATS,
+					},
+				},
+			},
+			{
+				Type: &csi.NodeServiceCapability_Rpc{
+					Rpc: &csi.NodeServiceCapability_RPC{
+						Type: csi.NodeServiceCapability_RPC_VOLUME_CONDITION,
+					},
+				},
+			},
 		},
 	}, nil
 }
@@ -23,6 +23,8 @@ import (
 	"os/exec"
 	"path/filepath"
 	"reflect"
+	"sync/atomic"
+	"syscall"
 	"time"
 
 	"github.com/agiledragon/gomonkey/v2"
@@ -32,6 +34,7 @@ import (
 	"github.com/fluid-cloudnative/fluid/pkg/ddc/base"
 	"github.com/fluid-cloudnative/fluid/pkg/utils"
 	"github.com/fluid-cloudnative/fluid/pkg/utils/cmdguard"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/dataset/volume"
 	csicommon "github.com/kubernetes-csi/drivers/pkg/csi-common"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -781,15 +784,168 @@ var _ = Describe("NodeServer", func() {
 	})
 
 	Describe("NodeGetCapabilities", func() {
-		It("should return STAGE_UNSTAGE_VOLUME capability", func() {
+		It("should return STAGE_UNSTAGE_VOLUME, GET_VOLUME_STATS and VOLUME_CONDITION capabilities", func() {
 			req := &csi.NodeGetCapabilitiesRequest{}
 
 			resp, err := ns.NodeGetCapabilities(context.Background(), req)
 
 			Expect(err).NotTo(HaveOccurred())
 			Expect(resp).NotTo(BeNil())
-			Expect(resp.Capabilities).To(HaveLen(1))
+			Expect(resp.Capabilities).To(HaveLen(3))
 			Expect(resp.Capabilities[0].GetRpc().GetType()).To(Equal(csi.NodeServiceCapability_RPC_STAGE_UNSTAGE_VOLUME))
+			Expect(resp.Capabilities[1].GetRpc().GetType()).To(Equal(csi.NodeServiceCapability_RPC_GET_VOLUME_STATS))
+			Expect(resp.Capabilities[2].GetRpc().GetType()).To(Equal(csi.NodeServiceCapability_RPC_VOLUME_CONDITION))
+		})
+	})
+
+	Describe("NodeGetVolumeStats", func() {
+		Context("when validating request parameters", func() {
+			It("should return error when volume id is empty", func() {
+				resp, err := ns.NodeGetVolumeStats(context.Background(), &csi.NodeGetVolumeStatsRequest{VolumePath: testTargetPath})
+
+				Expect(err).To(HaveOccurred())
+				Expect(resp).To(BeNil())
+				Expect(err.Error()).To(ContainSubstring("volume ID"))
+			})
+
+			It("should return error when volume path is empty", func() {
+				resp, err := ns.NodeGetVolumeStats(context.Background(), &csi.NodeGetVolumeStatsRequest{VolumeId: testVolumeID})
+
+				Expect(err).To(HaveOccurred())
+				Expect(resp).To(BeNil())
+				Expect(err.Error()).To(ContainSubstring("volume path"))
+			})
+
+			It("should return not found when volume path does not exist", func() {
+				resp, err := ns.NodeGetVolumeStats(context.Background(), &csi.NodeGetVolumeStatsRequest{
+					VolumeId:   testVolumeID,
+					VolumePath: testTargetPath,
+				})
+
+				Expect(err).To(HaveOccurred())
+				Expect(resp).To(BeNil())
+				Expect(err.Error()).To(ContainSubstring("does not exist"))
+			})
+		})
+
+		Context("when the fuse mount point is healthy", func() {
+			It("should report usage from statfs", func() {
+				Expect(os.MkdirAll(testTargetPath, 0750)).To(Succeed())
+
+				resp, err := ns.NodeGetVolumeStats(context.Background(), &csi.NodeGetVolumeStatsRequest{
+					VolumeId:   testVolumeID,
+					VolumePath: testTargetPath,
+				})
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(resp.VolumeCondition.Abnormal).To(BeFalse())
+				Expect(resp.Usage).NotTo(BeEmpty())
+				Expect(resp.Usage[0].Unit).To(Equal(csi.VolumeUsage_BYTES))
+				Expect(resp.Usage[0].Total).To(BeNumerically(">", 0))
+			})
+
+			It("should report usage from dataset when fuse reports no blocks", func() {
+				dataset := &v1alpha1.Dataset{
+					ObjectMeta: metav1.ObjectMeta{Name: testName, Namespace: testNamespace},
+					Status: v1alpha1.DatasetStatus{
+						UfsTotal: "10.00GiB",
+						CacheStates: common.CacheStateList{
+							common.Cached: "1.00GiB",
+						},
+					},
+				}
+				ns.client = fake.NewClientBuilder().WithScheme(scheme).WithObjects(dataset).Build()
+
+				statfsPatch := gomonkey.ApplyFunc(syscall.Statfs, func(path string, buf *syscall.Statfs_t) error {
+					*buf = syscall.Statfs_t{Files: 100, Ffree: 40}
+					return nil
+				})
+				defer statfsPatch.Reset()
+				volumeLookups := 0
+				volumePatch := gomonkey.ApplyFunc(volume.GetNamespacedNameByVolumeId, func(client client.Reader, volumeId string) (string, string, error) {
+					volumeLookups++
+					return testNamespace, testName, nil
+				})
+				defer volumePatch.Reset()
+
+				var resp *csi.NodeGetVolumeStatsResponse
+				var err error
+				for i := 0; i < 2; i++ {
+					resp, err = ns.NodeGetVolumeStats(context.Background(), &csi.NodeGetVolumeStatsRequest{
+						VolumeId:   testVolumeID,
+						VolumePath: testTargetPath,
+					})
+				}
+
+				Expect(err).NotTo(HaveOccurred())
+				// the dataset bound to the volume is looked up once
+				Expect(volumeLookups).To(Equal(1))
+				Expect(resp.Usage).To(HaveLen(2))
+				Expect(resp.Usage[0]).To(Equal(&csi.VolumeUsage{
+					Unit:      csi.VolumeUsage_BYTES,
+					Total:     10 * 1024 * 1024 * 1024,
+					Available: 9 * 1024 * 1024 * 1024,
+					Used:      1024 * 1024 * 1024,
+				}))
+				Expect(resp.Usage[1]).To(Equal(&csi.VolumeUsage{
+					Unit:      csi.VolumeUsage_INODES,
+					Total:     100,
+					Available: 40,
+					Used:      60,
+				}))
+			})
+		})
+
+		Context("when the fuse mount point is broken", func() {
+			It("should report abnormal volume condition", func() {
+				statfsPatch := gomonkey.ApplyFunc(syscall.Statfs, func(path string, buf *syscall.Statfs_t) error {
+					return syscall.ENOTCONN
+				})
+				defer statfsPatch.Reset()
+
+				resp, err := ns.NodeGetVolumeStats(context.Background(), &csi.NodeGetVolumeStatsRequest{
+					VolumeId:   testVolumeID,
+					VolumePath: testTargetPath,
+				})
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(resp.Usage).To(BeEmpty())
+				Expect(resp.VolumeCondition.Abnormal).To(BeTrue())
+				Expect(resp.VolumeCondition.Message).To(ContainSubstring("is broken"))
+			})
+
+			It("should report abnormal volume condition when statfs hangs", func() {
+				timeout := statfsTimeout
+				statfsTimeout = 50 * time.Millisecond
+				defer func() { statfsTimeout = timeout }()
+
+				release := make(chan struct{})
+				var calls int32
+				statfsPatch := gomonkey.ApplyFunc(syscall.Statfs, func(path string, buf *syscall.Statfs_t) error {
+					atomic.AddInt32(&calls, 1)
+					<-release
+					return nil
+				})
+				defer statfsPatch.Reset()
+
+				for i := 0; i < 2; i++ {
+					resp, err := ns.NodeGetVolumeStats(context.Background(), &csi.NodeGetVolumeStatsRequest{
+						VolumeId:   testVolumeID,
+						VolumePath: testTargetPath,
+					})
+
+					Expect(err).NotTo(HaveOccurred())
+					Expect(resp.VolumeCondition.Abnormal).To(BeTrue())
+					Expect(resp.VolumeCondition.Message).To(ContainSubstring("may hang"))
+				}
+				// no more statfs calls are made until the hanging one returns
+				Expect(atomic.LoadInt32(&calls)).To(Equal(int32(1)))
+				close(release)
+				Eventually(func() bool {
+					_, pending := ns.pendingStatfs.Load(testTargetPath)
+					return pending
+				}).Should(BeFalse())
+			})
 		})
 	})
 
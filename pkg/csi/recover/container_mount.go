@@ -0,0 +1,220 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package recover
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+
+	"github.com/fluid-cloudnative/fluid/pkg/utils/mountinfo"
+	"golang.org/x/sys/unix"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// procRoot is the proc filesystem of the host, which requires hostPID to find the processes of the containers
+var procRoot = "/proc"
+
+var (
+	// findContainerPid and remountInContainer are variables so that they can be replaced in tests
+	findContainerPid   = findContainerPidByCgroup
+	remountInContainer = moveMountIntoNamespace
+)
+
+// containerMount is a mount point in a container without mount propagation, which keeps the stale fuse mount
+// after the mount point on the host is recovered
+type containerMount struct {
+	container   string
+	containerID string
+	// source is the recovered mount point on the host
+	source string
+	// target is the mount path in the container
+	target   string
+	readOnly bool
+}
+
+func (m containerMount) String() string {
+	return fmt.Sprintf("%s:%s", m.container, m.target)
+}
+
+// containerMountsToRecover returns the mount points of the containers that mount the recovered mount points of
+// the pod without HostToContainer or Bidirectional mount propagation. The recovered mount points are not
+// propagated into them, so they have to be remounted in the mount namespaces of the containers.
+func containerMountsToRecover(pod *corev1.Pod, points []mountinfo.MountPoint) (mounts []containerMount) {
+	// the recovered volume paths by the names of the pod volumes
+	volumePoints := map[string]mountinfo.MountPoint{}
+	// the recovered subpaths by {volumeName}/{containerName}/{volumeMountIndex}
+	subPathPoints := map[string]mountinfo.MountPoint{}
+	for _, point := range points {
+		if point.SubPath {
+			if key, ok := parseSubPathKey(point.MountPath); ok {
+				subPathPoints[key] = point
+			}
+			continue
+		}
+		for _, volume := range pod.Spec.Volumes {
+			if volume.PersistentVolumeClaim == nil {
+				continue
+			}
+			// the volume id of a dataset is {namespace}-{datasetName}, and the PVC is named after the dataset
+			if point.NamespacedDatasetName == fmt.Sprintf("%s-%s", pod.Namespace, volume.PersistentVolumeClaim.ClaimName) ||
+				point.NamespacedDatasetName == volume.Name {
+				volumePoints[volume.Name] = point
+			}
+		}
+	}
+
+	containerIDs := map[string]string{}
+	for _, status := range pod.Status.ContainerStatuses {
+		containerIDs[status.Name] = status.ContainerID
+	}
+
+	for _, container := range pod.Spec.Containers {
+		for i, volumeMount := range container.VolumeMounts {
+			if volumeMount.MountPropagation != nil && *volumeMount.MountPropagation != corev1.MountPropagationNone {
+				continue
+			}
+
+			var point mountinfo.MountPoint
+			var found bool
+			if len(volumeMount.SubPath) > 0 || len(volumeMount.SubPathExpr) > 0 {
+				point, found = subPathPoints[fmt.Sprintf("%s/%s/%d", volumeMount.Name, container.Name, i)]
+			} else {
+				point, found = volumePoints[volumeMount.Name]
+			}
+			if !found {
+				continue
+			}
+
+			mounts = append(mounts, containerMount{
+				container:   container.Name,
+				containerID: containerIDs[container.Name],
+				source:      point.MountPath,
+				target:      volumeMount.MountPath,
+				readOnly:    volumeMount.ReadOnly || point.ReadOnly,
+			})
+		}
+	}
+	return
+}
+
+// parseSubPathKey parses {volumeName}/{containerName}/{volumeMountIndex} from a subpath bind mount path, i.e.
+// /{kubeletRootDir}/pods/{podUID}/volume-subpaths/{volumeName}/{containerName}/{volumeMountIndex}
+func parseSubPathKey(mountPath string) (key string, ok bool) {
+	fields := strings.Split(strings.TrimSuffix(mountPath, "/"), "/")
+	for i := 0; i+3 < len(fields); i++ {
+		if fields[i] == "volume-subpaths" && i+4 == len(fields) {
+			return strings.Join(fields[i+1:], "/"), true
+		}
+	}
+	return "", false
+}
+
+// remountContainer replaces the stale mount point in the container with the recovered one on the host
+func (r *FuseRecover) remountContainer(mount containerMount) error {
+	// the container id is in the format of <type>://<container_id>
+	containerID := mount.containerID
+	if idx := strings.Index(containerID, "://"); idx >= 0 {
+		containerID = containerID[idx+3:]
+	}
+	if len(containerID) == 0 {
+		return fmt.Errorf("container %s is not running", mount.container)
+	}
+
+	pid, err := findContainerPid(containerID)
+	if err != nil {
+		return err
+	}
+	return remountInContainer(pid, mount.source, mount.target, mount.readOnly)
+}
+
+// findContainerPidByCgroup finds a process of the container by the container id in its cgroup path,
+// which works with both cgroupfs and systemd cgroup drivers.
+func findContainerPidByCgroup(containerID string) (int, error) {
+	entries, err := os.ReadDir(procRoot)
+	if err != nil {
+		return 0, err
+	}
+	for _, entry := range entries {
+		pid, err := strconv.Atoi(entry.Name())
+		if err != nil {
+			continue
+		}
+		cgroup, err := os.ReadFile(filepath.Join(procRoot, entry.Name(), "cgroup"))
+		if err != nil {
+			// the process may have exited
+			continue
+		}
+		if strings.Contains(string(cgroup), containerID) {
+			return pid, nil
+		}
+	}
+	return 0, fmt.Errorf("no process of container %s is found in %s, hostPID is required for the CSI plugin", containerID, procRoot)
+}
+
+// moveMountIntoNamespace clones the mount point on the host and moves the clone onto the target path in the
+// mount namespace of the process, after detaching the stale mount point there. It requires Linux 5.2+.
+func moveMountIntoNamespace(pid int, source, target string, readOnly bool) error {
+	errCh := make(chan error, 1)
+	go func() {
+		// The thread is not unlocked after entering the mount namespace of the container,
+		// so that it's terminated when the goroutine exits instead of being reused.
+		runtime.LockOSThread()
+		errCh <- moveMount(pid, source, target, readOnly)
+	}()
+	return <-errCh
+}
+
+func moveMount(pid int, source, target string, readOnly bool) error {
+	treeFd, err := unix.OpenTree(unix.AT_FDCWD, source, unix.OPEN_TREE_CLONE|unix.OPEN_TREE_CLOEXEC)
+	if err != nil {
+		return fmt.Errorf("failed to clone mount point %s: %v", source, err)
+	}
+	defer unix.Close(treeFd)
+
+	if readOnly {
+		if err = unix.MountSetattr(treeFd, "", unix.AT_EMPTY_PATH, &unix.MountAttr{Attr_set: unix.MOUNT_ATTR_RDONLY}); err != nil {
+			return fmt.Errorf("failed to make mount point %s read only: %v", source, err)
+		}
+	}
+
+	nsFd, err := unix.Open(filepath.Join(procRoot, strconv.Itoa(pid), "ns", "mnt"), unix.O_RDONLY|unix.O_CLOEXEC, 0)
+	if err != nil {
+		return fmt.Errorf("failed to open the mount namespace of process %d: %v", pid, err)
+	}
+	defer unix.Close(nsFd)
+
+	// the filesystem attributes are shared by the threads of the process, which prevents entering another mount namespace
+	if err = unix.Unshare(unix.CLONE_FS); err != nil {
+		return fmt.Errorf("failed to unshare filesystem attributes: %v", err)
+	}
+	// the root and working directories are changed to the root of the container after entering its mount namespace
+	if err = unix.Setns(nsFd, unix.CLONE_NEWNS); err != nil {
+		return fmt.Errorf("failed to enter the mount namespace of process %d: %v", pid, err)
+	}
+
+	if err = unix.Unmount(target, unix.MNT_DETACH); err != nil && err != unix.EINVAL && err != unix.ENOENT {
+		return fmt.Errorf("failed to detach the stale mount point %s in the container: %v", target, err)
+	}
+	if err = unix.MoveMount(treeFd, "", unix.AT_FDCWD, target, unix.MOVE_MOUNT_F_EMPTY_PATH); err != nil {
+		return fmt.Errorf("failed to mount %s in the container: %v", target, err)
+	}
+	return nil
+}
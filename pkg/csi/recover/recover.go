@@ -18,6 +18,7 @@ package recover
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"strconv"
 	"strings"
@@ -31,7 +32,10 @@ import (
 	"github.com/golang/glog"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/record"
 	k8sexec "k8s.io/utils/exec"
@@ -47,6 +51,17 @@ const (
 	serviceAccountTokenFile        = "/var/run/secrets/kubernetes.io/serviceaccount/token"
 	FuseRecoveryPeriod             = "RECOVER_FUSE_PERIOD"
 	RecoverWarningThreshold        = "RECOVER_WARNING_THRESHOLD"
+	FuseRecoveryDryRun             = "RECOVER_FUSE_DRY_RUN"
+)
+
+// recoverResult is the outcome of recovering a broken mount point
+type recoverResult string
+
+const (
+	recoverSkipped recoverResult = "Skipped"
+	recoverSucceed recoverResult = "Succeed"
+	recoverFailed  recoverResult = "Failed"
+	recoverDryRun  recoverResult = "DryRun"
 )
 
 var _ manager.Runnable = &FuseRecover{}
@@ -57,9 +72,15 @@ type FuseRecover struct {
 	ApiReader  client.Reader
 	// KubeletClient *kubelet.KubeletClient
 	Recorder record.EventRecorder
+	// NodeName is the name of the node, used to find the pods of the broken mount points
+	NodeName string
+	// NodeAuthorizedClient lists the pods on the node with kubelet's identity if it's set
+	NodeAuthorizedClient kubernetes.Interface
 
 	recoverFusePeriod       time.Duration
 	recoverWarningThreshold int
+	// dryRun only reports the broken mount points to the pods without remounting them
+	dryRun bool
 
 	locks *utils.VolumeLocks
 }
@@ -131,6 +152,7 @@ func NewFuseRecover(kubeClient client.Client, recorder record.EventRecorder, api
 		Recorder:                recorder,
 		recoverFusePeriod:       recoverFusePeriod,
 		recoverWarningThreshold: recoverWarningThreshold,
+		dryRun:                  utils.GetBoolValueFromEnv(FuseRecoveryDryRun, false),
 		locks:                   locks,
 	}, nil
 }
@@ -167,8 +189,22 @@ func (r *FuseRecover) recover() {
 		glog.V(3).Infof("FuseRecovery: detected %d broken mount point(s) requiring recovery", len(brokenMounts))
 	}
 
+	podRecoveries := map[string]*podRecovery{}
 	for _, point := range brokenMounts {
-		r.doRecover(point)
+		result, err := r.doRecover(point)
+		if result == recoverSkipped || len(point.PodUID) == 0 {
+			continue
+		}
+		recovery, found := podRecoveries[point.PodUID]
+		if !found {
+			recovery = &podRecovery{}
+			podRecoveries[point.PodUID] = recovery
+		}
+		recovery.add(point, result, err)
+	}
+
+	if len(podRecoveries) > 0 {
+		r.reportToPods(podRecoveries)
 	}
 }
 
@@ -181,7 +217,7 @@ func (r *FuseRecover) recoverBrokenMount(point mountinfo.MountPoint) (err error)
 
 	// Info: Attempting recovery action
 	glog.V(3).Infof("FuseRecovery: attempting bind mount, source=%s mountPath=%s options=%v", point.SourcePath, point.MountPath, mountOption)
-	if err := r.Mount(point.SourcePath, point.MountPath, "none", mountOption); err != nil {
+	if err = r.Mount(point.SourcePath, point.MountPath, "none", mountOption); err != nil {
 		// Warning: Mount failure is recoverable - will retry on next cycle
 		glog.Warningf("FuseRecovery: bind mount failed, mountPath=%s source=%s error=%v", point.MountPath, point.SourcePath, err)
 	}
@@ -248,11 +284,11 @@ func (r *FuseRecover) shouldRecover(mountPath string) (should bool, err error) {
 	return true, nil
 }
 
-func (r *FuseRecover) doRecover(point mountinfo.MountPoint) {
+func (r *FuseRecover) doRecover(point mountinfo.MountPoint) (result recoverResult, err error) {
 	if lock := r.locks.TryAcquire(point.MountPath); !lock {
 		// Info: Concurrent recovery in progress - skip to avoid race
 		glog.V(4).Infof("FuseRecovery: skipping recovery, lock held by another goroutine, mountPath=%s", point.MountPath)
-		return
+		return recoverSkipped, nil
 	}
 	defer r.locks.Release(point.MountPath)
 
@@ -260,13 +296,18 @@ func (r *FuseRecover) doRecover(point mountinfo.MountPoint) {
 	if err != nil {
 		// Warning: Recovery check failed - may retry next cycle
 		glog.Warningf("FuseRecovery: unable to determine recovery state, mountPath=%s error=%v", point.MountPath, err)
-		return
+		return recoverSkipped, nil
 	}
 
 	if !should {
 		// Info: Mount already healthy or cleaned up
 		glog.V(3).Infof("FuseRecovery: skipping recovery, mount already cleaned up, mountPath=%s", point.MountPath)
-		return
+		return recoverSkipped, nil
+	}
+
+	if r.dryRun {
+		glog.V(3).Infof("FuseRecovery: dry run, skip remounting mountPath=%s source=%s mountCount=%d", point.MountPath, point.SourcePath, point.Count)
+		return recoverDryRun, nil
 	}
 
 	// Info: Starting recovery attempt
@@ -285,10 +326,113 @@ func (r *FuseRecover) doRecover(point mountinfo.MountPoint) {
 	if err := r.recoverBrokenMount(point); err != nil {
 		// Warning logged inside recoverBrokenMount, just record event
 		r.eventRecord(point, corev1.EventTypeWarning, common.FuseRecoverFailed)
-		return
+		return recoverFailed, err
 	}
 
 	// Info: Recovery succeeded - state transition from broken to healthy
 	glog.V(3).Infof("FuseRecovery: recovery succeeded, mountPath=%s", point.MountPath)
 	r.eventRecord(point, corev1.EventTypeNormal, common.FuseRecoverSucceed)
+	return recoverSucceed, nil
+}
+
+// podRecovery collects the outcomes of recovering the broken mount points of a pod
+type podRecovery struct {
+	succeeded []string
+	failed    []string
+	dryRun    []string
+	errs      []string
+	// recovered are the mount points remounted on the host, or to be remounted in dry-run mode
+	recovered []mountinfo.MountPoint
+}
+
+func (p *podRecovery) add(point mountinfo.MountPoint, result recoverResult, err error) {
+	switch result {
+	case recoverSucceed:
+		p.succeeded = append(p.succeeded, point.MountPath)
+		p.recovered = append(p.recovered, point)
+	case recoverFailed:
+		p.failed = append(p.failed, point.MountPath)
+		if err != nil {
+			p.errs = append(p.errs, err.Error())
+		}
+	case recoverDryRun:
+		p.dryRun = append(p.dryRun, point.MountPath)
+		p.recovered = append(p.recovered, point)
+	}
+}
+
+// reportToPods records the outcome of the recovery on each affected pod. The recovered mount points are not
+// propagated into the containers without HostToContainer or Bidirectional mount propagation, so they're
+// remounted in the mount namespaces of the containers.
+func (r *FuseRecover) reportToPods(podRecoveries map[string]*podRecovery) {
+	pods, err := r.listNodePods()
+	if err != nil {
+		glog.Errorf("FuseRecovery: failed to list pods on node %s, error=%v", r.NodeName, err)
+		return
+	}
+
+	for i := range pods {
+		pod := &pods[i]
+		recovery, found := podRecoveries[string(pod.UID)]
+		if !found {
+			continue
+		}
+		containerMounts := containerMountsToRecover(pod, recovery.recovered)
+
+		if len(recovery.dryRun) > 0 {
+			r.Recorder.Eventf(pod, corev1.EventTypeNormal, common.FuseRecoverDryRun,
+				"Fuse recovery is in dry-run mode, broken mount points %v and container mount points %v are not remounted", recovery.dryRun, containerMounts)
+		}
+		if len(recovery.failed) > 0 {
+			r.Recorder.Eventf(pod, corev1.EventTypeWarning, common.FuseRecoverFailed,
+				"Fuse recover %v failed: %s", recovery.failed, strings.Join(recovery.errs, "; "))
+		}
+		if len(recovery.succeeded) == 0 {
+			continue
+		}
+
+		var remounted, failed []string
+		var errs []string
+		for _, containerMount := range containerMounts {
+			if err := r.remountContainer(containerMount); err != nil {
+				glog.Warningf("FuseRecovery: failed to remount in container, pod=%s/%s mount=%s error=%v", pod.Namespace, pod.Name, containerMount, err)
+				failed = append(failed, containerMount.String())
+				errs = append(errs, err.Error())
+				continue
+			}
+			glog.V(3).Infof("FuseRecovery: remounted in container, pod=%s/%s mount=%s source=%s", pod.Namespace, pod.Name, containerMount, containerMount.source)
+			remounted = append(remounted, containerMount.String())
+		}
+
+		r.Recorder.Eventf(pod, corev1.EventTypeNormal, common.FuseRecoverSucceed,
+			"Fuse recover %v succeed, remounted container mount points %v", recovery.succeeded, remounted)
+		if len(failed) > 0 {
+			r.Recorder.Eventf(pod, corev1.EventTypeWarning, common.FuseRecoverNeedRestart,
+				"Failed to remount container mount points %v without HostToContainer or Bidirectional mountPropagation: %s, restart the containers to access the data", failed, strings.Join(errs, "; "))
+		}
+	}
+}
+
+// listNodePods lists the pods on the node, with the node authorized client if it's set.
+func (r *FuseRecover) listNodePods() ([]corev1.Pod, error) {
+	if len(r.NodeName) == 0 {
+		return nil, fmt.Errorf("node name is not set")
+	}
+	selector := fields.OneTermEqualSelector("spec.nodeName", r.NodeName)
+
+	if r.NodeAuthorizedClient != nil {
+		podList, err := r.NodeAuthorizedClient.CoreV1().Pods(metav1.NamespaceAll).List(context.TODO(), metav1.ListOptions{
+			FieldSelector: selector.String(),
+		})
+		if err != nil {
+			return nil, err
+		}
+		return podList.Items, nil
+	}
+
+	podList := &corev1.PodList{}
+	if err := r.ApiReader.List(context.TODO(), podList, client.MatchingFieldsSelector{Selector: selector}); err != nil {
+		return nil, err
+	}
+	return podList.Items, nil
 }
@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"path/filepath"
 	"reflect"
 	"time"
 
@@ -35,10 +36,12 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	apimachineryRuntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/mount"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
 const testfuseRecoverPeriod = 30
@@ -374,7 +377,7 @@ var _ = Describe("FuseRecover", func() {
 		})
 
 		Context("when mount fails", func() {
-			It("should return without error but log the failure", func() {
+			It("should return the error", func() {
 				fakeMounter := &mount.FakeMounter{}
 				r := FuseRecover{SafeFormatAndMount: mount.SafeFormatAndMount{
 					Interface: fakeMounter,
@@ -394,7 +397,7 @@ var _ = Describe("FuseRecover", func() {
 				defer patch1.Reset()
 
 				err := r.recoverBrokenMount(point)
-				Expect(err).NotTo(HaveOccurred())
+				Expect(err).To(HaveOccurred())
 			})
 		})
 	})
@@ -934,3 +937,212 @@ var _ = Describe("FuseRecover", func() {
 		})
 	})
 })
+
+var _ = Describe("FuseRecover reporting to pods", func() {
+	var (
+		s                *apimachineryRuntime.Scheme
+		recorder         *record.FakeRecorder
+		r                *FuseRecover
+		hostToContainer  = corev1.MountPropagationHostToContainer
+		podUID           = "6fe8418f-3f78-4adb-9e02-416d8601c1b6"
+		volumeMountPath  = "/var/lib/kubelet/pods/" + podUID + "/volumes/kubernetes.io~csi/default-jfsdemo/mount"
+		subPathMountPath = "/var/lib/kubelet/pods/" + podUID + "/volume-subpaths/data/legacy/0"
+		patches          *Patches
+	)
+
+	newPod := func(uid, nodeName string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "demo-" + uid[:4], Namespace: "default", UID: types.UID(uid)},
+			Spec: corev1.PodSpec{
+				NodeName: nodeName,
+				Containers: []corev1.Container{
+					{Name: "app", VolumeMounts: []corev1.VolumeMount{{Name: "data", MountPath: "/data", MountPropagation: &hostToContainer}}},
+					{Name: "legacy", VolumeMounts: []corev1.VolumeMount{{Name: "data", MountPath: "/data", SubPath: "sub"}}},
+				},
+				Volumes: []corev1.Volume{{
+					Name: "data",
+					VolumeSource: corev1.VolumeSource{
+						PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "jfsdemo"},
+					},
+				}},
+			},
+			Status: corev1.PodStatus{
+				ContainerStatuses: []corev1.ContainerStatus{
+					{Name: "app", ContainerID: "containerd://app-" + uid},
+					{Name: "legacy", ContainerID: "containerd://legacy-" + uid},
+				},
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		s = apimachineryRuntime.NewScheme()
+		Expect(v1alpha1.AddToScheme(s)).To(Succeed())
+		Expect(corev1.AddToScheme(s)).To(Succeed())
+
+		fakeClient := ctrlfake.NewClientBuilder().WithScheme(s).
+			WithObjects(
+				&v1alpha1.Dataset{ObjectMeta: metav1.ObjectMeta{Name: "jfsdemo", Namespace: "default"}},
+				newPod(podUID, "node-a"),
+				newPod("a7e6b9c0-0000-0000-0000-000000000000", "node-b"),
+			).
+			WithIndex(&corev1.Pod{}, "spec.nodeName", func(obj client.Object) []string {
+				return []string{obj.(*corev1.Pod).Spec.NodeName}
+			}).
+			Build()
+
+		recorder = record.NewFakeRecorder(20)
+		r = &FuseRecover{
+			SafeFormatAndMount: mount.SafeFormatAndMount{
+				Interface: &mount.FakeMounter{},
+			},
+			KubeClient:              fakeClient,
+			ApiReader:               fakeClient,
+			Recorder:                recorder,
+			NodeName:                "node-a",
+			recoverWarningThreshold: 50,
+			locks:                   utils.NewVolumeLocks(),
+		}
+
+		patches = ApplyFunc(mountinfo.GetBrokenMountPoints, func() ([]mountinfo.MountPoint, error) {
+			return []mountinfo.MountPoint{
+				{SourcePath: "/runtime-mnt/juicefs/default/jfsdemo/juicefs-fuse", MountPath: volumeMountPath, Count: 1,
+					NamespacedDatasetName: "default-jfsdemo", PodUID: podUID},
+				{SourcePath: "/runtime-mnt/juicefs/default/jfsdemo/juicefs-fuse/sub", MountPath: subPathMountPath, Count: 1,
+					NamespacedDatasetName: "default-jfsdemo", PodUID: podUID, SubPath: true},
+			}, nil
+		})
+		patches.ApplyPrivateMethod(r, "shouldRecover", func(mountPath string) (bool, error) {
+			return true, nil
+		})
+		patches.ApplyFunc(volume.GetNamespacedNameByVolumeId, func(client client.Reader, volumeId string) (namespace, name string, err error) {
+			return "default", "jfsdemo", nil
+		})
+	})
+
+	AfterEach(func() {
+		patches.Reset()
+		findContainerPid = findContainerPidByCgroup
+		remountInContainer = moveMountIntoNamespace
+	})
+
+	stubContainers := func(remountErr error) (remounted *[]string) {
+		remounted = &[]string{}
+		findContainerPid = func(containerID string) (int, error) {
+			Expect(containerID).To(Equal("legacy-" + podUID))
+			return 42, nil
+		}
+		remountInContainer = func(pid int, source, target string, readOnly bool) error {
+			*remounted = append(*remounted, fmt.Sprintf("%d:%s:%s", pid, source, target))
+			return remountErr
+		}
+		return
+	}
+
+	drainEvents := func() (events []string) {
+		for {
+			select {
+			case event := <-recorder.Events:
+				events = append(events, event)
+			default:
+				return
+			}
+		}
+	}
+
+	It("should remount the whole chain and report the outcome to the pod", func() {
+		var mounted []string
+		patches.ApplyMethod(reflect.TypeOf(r.Interface), "Mount", func(_ *mount.FakeMounter, source string, target string, fstype string, options []string) error {
+			mounted = append(mounted, target)
+			return nil
+		})
+
+		remounted := stubContainers(nil)
+
+		r.recover()
+
+		Expect(mounted).To(Equal([]string{volumeMountPath, subPathMountPath}))
+		// only the container without mount propagation is remounted, with the recovered subpath
+		Expect(*remounted).To(Equal([]string{"42:" + subPathMountPath + ":/data"}))
+		events := drainEvents()
+		Expect(events).To(ContainElement(And(HavePrefix("Normal "+common.FuseRecoverSucceed), ContainSubstring(subPathMountPath), ContainSubstring("[legacy:/data]"))))
+		Expect(events).NotTo(ContainElement(HavePrefix("Warning " + common.FuseRecoverNeedRestart)))
+	})
+
+	It("should ask to restart the containers failed to be remounted", func() {
+		patches.ApplyMethod(reflect.TypeOf(r.Interface), "Mount", func(_ *mount.FakeMounter, source string, target string, fstype string, options []string) error {
+			return nil
+		})
+		stubContainers(fmt.Errorf("function not implemented"))
+
+		r.recover()
+
+		events := drainEvents()
+		Expect(events).To(ContainElement(And(HavePrefix("Warning "+common.FuseRecoverNeedRestart), ContainSubstring("[legacy:/data]"), ContainSubstring("function not implemented"))))
+	})
+
+	It("should report the failures to the pod", func() {
+		patches.ApplyMethod(reflect.TypeOf(r.Interface), "Mount", func(_ *mount.FakeMounter, source string, target string, fstype string, options []string) error {
+			return fmt.Errorf("transport endpoint is not connected")
+		})
+
+		r.recover()
+
+		events := drainEvents()
+		Expect(events).To(ContainElement(And(HavePrefix("Warning "+common.FuseRecoverFailed), ContainSubstring("transport endpoint is not connected"))))
+		Expect(events).NotTo(ContainElement(HavePrefix("Warning " + common.FuseRecoverNeedRestart)))
+	})
+
+	It("should not remount in dry-run mode", func() {
+		r.dryRun = true
+		mountCalled := false
+		patches.ApplyMethod(reflect.TypeOf(r.Interface), "Mount", func(_ *mount.FakeMounter, source string, target string, fstype string, options []string) error {
+			mountCalled = true
+			return nil
+		})
+
+		remounted := stubContainers(nil)
+
+		r.recover()
+
+		Expect(mountCalled).To(BeFalse())
+		Expect(*remounted).To(BeEmpty())
+		events := drainEvents()
+		Expect(events).To(HaveLen(1))
+		Expect(events[0]).To(And(HavePrefix("Normal "+common.FuseRecoverDryRun), ContainSubstring(volumeMountPath), ContainSubstring("[legacy:/data]")))
+	})
+})
+
+var _ = Describe("Container mounts", func() {
+	It("should parse the subpath key from the subpath mount path", func() {
+		key, ok := parseSubPathKey("/var/lib/kubelet/pods/6fe8418f/volume-subpaths/data/legacy/0")
+		Expect(ok).To(BeTrue())
+		Expect(key).To(Equal("data/legacy/0"))
+
+		_, ok = parseSubPathKey("/var/lib/kubelet/pods/6fe8418f/volumes/kubernetes.io~csi/default-jfsdemo/mount")
+		Expect(ok).To(BeFalse())
+	})
+
+	It("should find the process of the container by its cgroup", func() {
+		dir := GinkgoT().TempDir()
+		Expect(os.MkdirAll(filepath.Join(dir, "12"), 0755)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(dir, "12", "cgroup"), []byte("0::/kubepods.slice/cri-containerd-abc.scope\n"), 0644)).To(Succeed())
+		Expect(os.MkdirAll(filepath.Join(dir, "34"), 0755)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(dir, "34", "cgroup"), []byte("0::/kubepods.slice/cri-containerd-def.scope\n"), 0644)).To(Succeed())
+		procRoot = dir
+		defer func() { procRoot = "/proc" }()
+
+		pid, err := findContainerPidByCgroup("def")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(pid).To(Equal(34))
+
+		_, err = findContainerPidByCgroup("xyz")
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should not remount the container which is not running", func() {
+		r := &FuseRecover{}
+		err := r.remountContainer(containerMount{container: "app", target: "/data"})
+		Expect(err).To(MatchError(ContainSubstring("not running")))
+	})
+})
@@ -23,6 +23,7 @@ import (
 	"github.com/fluid-cloudnative/fluid/pkg/csi/config"
 	"github.com/fluid-cloudnative/fluid/pkg/csi/features"
 	utilfeature "github.com/fluid-cloudnative/fluid/pkg/utils/feature"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/kubelet"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 )
 
@@ -32,6 +33,16 @@ func Register(mgr manager.Manager, ctx config.RunningContext) error {
 	if err != nil {
 		return err
 	}
+	fuseRecover.NodeName = ctx.NodeId
+
+	// list the pods of the broken mount points with kubelet's identity if node authorization is used
+	if _, err = os.Stat(ctx.KubeletConfigPath); err == nil {
+		nodeAuthorizedClient, err := kubelet.InitNodeAuthorizedClient(ctx.KubeletConfigPath)
+		if err != nil {
+			return err
+		}
+		fuseRecover.NodeAuthorizedClient = nodeAuthorizedClient
+	}
 
 	if err = mgr.Add(fuseRecover); err != nil {
 		return err
@@ -0,0 +1,308 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ctrl
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/base"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	podutil "k8s.io/kubernetes/pkg/api/v1/pod"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	workerCacheHandoffTimeoutEnv     = "FLUID_WORKER_CACHE_HANDOFF_TIMEOUT"
+	defaultWorkerCacheHandoffTimeout = 10 * time.Minute
+)
+
+// WorkerCacheHandoff is implemented by the engines which know the cached bytes of each worker
+// and are able to hand the cache of a worker off to another worker before it's removed on scale-in.
+type WorkerCacheHandoff interface {
+	// GetWorkersCachedBytes returns the cached bytes of the worker pods keyed by the pod name.
+	GetWorkersCachedBytes(workers []corev1.Pod) (map[string]int64, error)
+
+	// HandoffWorkerCache replicates the cache of the decommissioning worker to the target worker.
+	// It's called on every sync until it returns true, so it must be able to resume.
+	HandoffWorkerCache(worker corev1.Pod, target corev1.Pod) (done bool, err error)
+}
+
+// DecommissionWorkers hands the cache of the workers to be removed by scale-in off to the remaining workers.
+// The workers with the least cached bytes are picked as the victims of the scale-in. However, the pods of a StatefulSet
+// are always removed from the highest ordinal, so a removed worker which is not a victim hands its cache off to a victim
+// which is kept, and the cache of a removed victim is handed off to the remaining worker with the least cached bytes.
+// The removed workers are marked as decommissioning, and the scale-in is held until their cache is handed off
+// or the handoff times out. It returns true if the workers are ready to be scaled in.
+func (e *Helper) DecommissionWorkers(ctx cruntime.ReconcileRequestContext,
+	runtime base.RuntimeInterface,
+	workers *appsv1.StatefulSet,
+	handoff WorkerCacheHandoff) (ready bool, err error) {
+
+	var currentReplicas int32
+	if workers.Spec.Replicas != nil {
+		currentReplicas = *workers.Spec.Replicas
+	}
+	desiredReplicas := runtime.Replicas()
+
+	selector, err := metav1.LabelSelectorAsSelector(workers.Spec.Selector)
+	if err != nil {
+		return false, err
+	}
+	podList := &corev1.PodList{}
+	err = e.client.List(context.TODO(), podList, client.InNamespace(workers.Namespace), client.MatchingLabelsSelector{Selector: selector})
+	if err != nil {
+		return false, err
+	}
+	var pods []corev1.Pod
+	for _, pod := range podList.Items {
+		if kubeclient.GetOrdinalOfStatefulSetPod(workers, &pod) >= 0 {
+			pods = append(pods, pod)
+		}
+	}
+
+	if desiredReplicas >= currentReplicas {
+		// the scale-in is done or cancelled, so no worker is decommissioning any more
+		return true, e.cancelDecommission(pods)
+	}
+
+	var removed, remaining []corev1.Pod
+	for _, pod := range pods {
+		if kubeclient.GetOrdinalOfStatefulSetPod(workers, &pod) >= int(desiredReplicas) {
+			removed = append(removed, pod)
+		} else if podutil.IsPodReady(&pod) {
+			remaining = append(remaining, pod)
+		}
+	}
+	if len(removed) == 0 || len(remaining) == 0 {
+		return true, nil
+	}
+
+	cachedBytes, err := handoff.GetWorkersCachedBytes(pods)
+	if err != nil {
+		// hand off the cache of all the removed workers if their cached bytes are unknown
+		e.log.Error(err, "Failed to get the cached bytes of workers, hand off the cache of all the decommissioning workers")
+		cachedBytes = map[string]int64{}
+	}
+	targets := pickHandoffTargets(removed, remaining, cachedBytes)
+
+	timeout := utils.GetDurationValueFromEnv(workerCacheHandoffTimeoutEnv, defaultWorkerCacheHandoffTimeout)
+	ready = true
+	for _, worker := range removed {
+		if worker.Annotations[common.AnnotationWorkerCacheHandedOff] == "true" {
+			continue
+		}
+		if bytes, found := cachedBytes[worker.Name]; found && bytes == 0 {
+			e.log.V(1).Info("Skip handing off the cache of worker without cache", "worker", worker.Name)
+			continue
+		}
+		target := targets[worker.Name]
+
+		since, err := e.markDecommissioning(&worker, target.Name)
+		if err != nil {
+			return false, err
+		}
+		if since.IsZero() {
+			ctx.Recorder.Eventf(runtime, corev1.EventTypeNormal, common.WorkerDecommissioning,
+				"Worker %s with %s cache is decommissioning for scale-in, its cache is handed off to worker %s with %s cache",
+				worker.Name, utils.BytesSize(float64(cachedBytes[worker.Name])), target.Name, utils.BytesSize(float64(cachedBytes[target.Name])))
+		} else if time.Since(since) > timeout {
+			ctx.Recorder.Eventf(runtime, corev1.EventTypeWarning, common.WorkerCacheHandoffTimeout,
+				"Timed out handing off the cache of worker %s after %v, scale in anyway", worker.Name, timeout)
+			continue
+		}
+
+		done, err := handoff.HandoffWorkerCache(worker, target)
+		if err != nil {
+			e.log.Error(err, "Failed to hand off the cache of worker", "worker", worker.Name, "target", target.Name)
+			ready = false
+			continue
+		}
+		if !done {
+			e.log.Info("The cache of worker is being handed off", "worker", worker.Name, "target", target.Name)
+			ready = false
+			continue
+		}
+
+		err = e.setPodAnnotation(&worker, common.AnnotationWorkerCacheHandedOff, "true")
+		if err != nil {
+			return false, err
+		}
+		ctx.Recorder.Eventf(runtime, corev1.EventTypeNormal, common.WorkerCacheHandedOff,
+			"The cache of worker %s is handed off to worker %s", worker.Name, target.Name)
+	}
+
+	return ready, nil
+}
+
+// pickHandoffTargets picks the target of handoff for each removed worker. The workers with the least cached bytes
+// among the removed and the remaining ones are the victims. Each removed worker which is not a victim hands its cache
+// off to a distinct remaining victim, the one with more cached bytes to the one with less, so that the remaining
+// workers end up with the cache of the workers which are not victims. Each removed victim hands its cache off to
+// the remaining worker with the least cached bytes. The targets picked in the previous syncs are kept,
+// because the cached bytes change with the handoff.
+func pickHandoffTargets(removed, remaining []corev1.Pod, cachedBytes map[string]int64) map[string]corev1.Pod {
+	targets := map[string]corev1.Pod{}
+	taken := map[string]bool{}
+	remainingByName := map[string]corev1.Pod{}
+	for _, pod := range remaining {
+		remainingByName[pod.Name] = pod
+	}
+
+	var unpicked []corev1.Pod
+	for _, pod := range removed {
+		if target, found := remainingByName[pod.Annotations[common.AnnotationWorkerCacheHandoffTarget]]; found {
+			targets[pod.Name] = target
+			taken[target.Name] = true
+		} else {
+			unpicked = append(unpicked, pod)
+		}
+	}
+	if len(unpicked) == 0 {
+		return targets
+	}
+
+	var candidates []corev1.Pod
+	for _, pod := range remaining {
+		if !taken[pod.Name] {
+			candidates = append(candidates, pod)
+		}
+	}
+
+	// sort the workers by their cached bytes, and prefer the removed workers as victims if the cached bytes are equal
+	workers := append(append([]corev1.Pod{}, unpicked...), candidates...)
+	sort.SliceStable(workers, func(i, j int) bool {
+		return cachedBytes[workers[i].Name] < cachedBytes[workers[j].Name]
+	})
+	victims := map[string]bool{}
+	for _, pod := range workers[:len(unpicked)] {
+		victims[pod.Name] = true
+	}
+
+	var remainingVictims, removedSurvivors, removedVictims []corev1.Pod
+	for _, pod := range candidates {
+		if victims[pod.Name] {
+			remainingVictims = append(remainingVictims, pod)
+		}
+	}
+	for _, pod := range unpicked {
+		if victims[pod.Name] {
+			removedVictims = append(removedVictims, pod)
+		} else {
+			removedSurvivors = append(removedSurvivors, pod)
+		}
+	}
+	sort.SliceStable(remainingVictims, func(i, j int) bool {
+		return cachedBytes[remainingVictims[i].Name] < cachedBytes[remainingVictims[j].Name]
+	})
+	sort.SliceStable(removedSurvivors, func(i, j int) bool {
+		return cachedBytes[removedSurvivors[i].Name] > cachedBytes[removedSurvivors[j].Name]
+	})
+
+	// the numbers of the removed survivors and the remaining victims are equal
+	for i, pod := range removedSurvivors {
+		targets[pod.Name] = remainingVictims[i]
+		taken[remainingVictims[i].Name] = true
+	}
+
+	// the removed victims prefer the remaining workers which are not targets yet
+	receivedBytes := map[string]int64{}
+	for name, bytes := range cachedBytes {
+		receivedBytes[name] = bytes
+	}
+	for _, pod := range removedVictims {
+		var target *corev1.Pod
+		for i := range remaining {
+			candidate := &remaining[i]
+			if target == nil ||
+				(taken[target.Name] && !taken[candidate.Name]) ||
+				(taken[target.Name] == taken[candidate.Name] && receivedBytes[candidate.Name] < receivedBytes[target.Name]) {
+				target = candidate
+			}
+		}
+		targets[pod.Name] = *target
+		receivedBytes[target.Name] += cachedBytes[pod.Name]
+	}
+
+	return targets
+}
+
+// markDecommissioning marks the worker pod as decommissioning with the target of handoff, and returns the time
+// when it was marked before, or zero time if it's marked just now.
+func (e *Helper) markDecommissioning(pod *corev1.Pod, target string) (since time.Time, err error) {
+	if pod.Annotations[common.AnnotationWorkerCacheHandoffTarget] != target {
+		err = e.setPodAnnotation(pod, common.AnnotationWorkerCacheHandoffTarget, target)
+		if err != nil {
+			return
+		}
+	}
+
+	if value, found := pod.Annotations[common.AnnotationWorkerDecommissioningSince]; found {
+		since, err = time.Parse(time.RFC3339, value)
+		if err == nil {
+			return since, nil
+		}
+		e.log.Error(err, "Failed to parse the decommissioning time of worker, mark it again", "worker", pod.Name, "value", value)
+	}
+
+	err = e.setPodAnnotation(pod, common.AnnotationWorkerDecommissioningSince, time.Now().Format(time.RFC3339))
+	return time.Time{}, err
+}
+
+// cancelDecommission removes the decommissioning marks from the worker pods
+func (e *Helper) cancelDecommission(pods []corev1.Pod) error {
+	for i := range pods {
+		pod := &pods[i]
+		_, decommissioning := pod.Annotations[common.AnnotationWorkerDecommissioningSince]
+		_, handedOff := pod.Annotations[common.AnnotationWorkerCacheHandedOff]
+		_, targeted := pod.Annotations[common.AnnotationWorkerCacheHandoffTarget]
+		if !decommissioning && !handedOff && !targeted {
+			continue
+		}
+
+		podToUpdate := pod.DeepCopy()
+		delete(podToUpdate.Annotations, common.AnnotationWorkerDecommissioningSince)
+		delete(podToUpdate.Annotations, common.AnnotationWorkerCacheHandedOff)
+		delete(podToUpdate.Annotations, common.AnnotationWorkerCacheHandoffTarget)
+		err := e.client.Patch(context.TODO(), podToUpdate, client.MergeFrom(pod))
+		if err != nil {
+			return fmt.Errorf("failed to cancel the decommission of worker %s: %w", pod.Name, err)
+		}
+	}
+	return nil
+}
+
+func (e *Helper) setPodAnnotation(pod *corev1.Pod, key, value string) error {
+	podToUpdate := pod.DeepCopy()
+	if podToUpdate.Annotations == nil {
+		podToUpdate.Annotations = map[string]string{}
+	}
+	podToUpdate.Annotations[key] = value
+	err := e.client.Patch(context.TODO(), podToUpdate, client.MergeFrom(pod))
+	if err != nil {
+		return fmt.Errorf("failed to annotate worker %s with %s: %w", pod.Name, key, err)
+	}
+	return nil
+}
@@ -0,0 +1,259 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ctrl
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/base"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+type fakeWorkerCacheHandoff struct {
+	cachedBytes map[string]int64
+	done        bool
+	err         error
+	handoffs    map[string]string
+}
+
+func (f *fakeWorkerCacheHandoff) GetWorkersCachedBytes(workers []corev1.Pod) (map[string]int64, error) {
+	cachedBytes := map[string]int64{}
+	for k, v := range f.cachedBytes {
+		cachedBytes[k] = v
+	}
+	return cachedBytes, nil
+}
+
+func (f *fakeWorkerCacheHandoff) HandoffWorkerCache(worker corev1.Pod, target corev1.Pod) (bool, error) {
+	f.handoffs[worker.Name] = target.Name
+	return f.done, f.err
+}
+
+var _ = Describe("Ctrl DecommissionWorkers Tests", func() {
+	var (
+		helper       *Helper
+		k8sClient    client.Client
+		fluidRuntime *datav1alpha1.JuiceFSRuntime
+		workerSts    *appsv1.StatefulSet
+		pods         []*corev1.Pod
+		handoff      *fakeWorkerCacheHandoff
+		recorder     *record.FakeRecorder
+	)
+
+	newWorkerPod := func(ordinal int) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      fmt.Sprintf("test-worker-%d", ordinal),
+				Namespace: "fluid",
+				Labels:    map[string]string{"app": "juicefs", "role": "juicefs-worker"},
+			},
+			Status: corev1.PodStatus{
+				Phase:      corev1.PodRunning,
+				Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}},
+			},
+		}
+	}
+
+	getPod := func(name string) *corev1.Pod {
+		pod := &corev1.Pod{}
+		Expect(k8sClient.Get(context.TODO(), types.NamespacedName{Namespace: "fluid", Name: name}, pod)).To(Succeed())
+		return pod
+	}
+
+	BeforeEach(func() {
+		fluidRuntime = &datav1alpha1.JuiceFSRuntime{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test",
+				Namespace: "fluid",
+			},
+			Spec: datav1alpha1.JuiceFSRuntimeSpec{
+				Replicas: 1,
+			},
+		}
+		workerSts = &appsv1.StatefulSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-worker",
+				Namespace: "fluid",
+			},
+			Spec: appsv1.StatefulSetSpec{
+				Replicas: ptr.To[int32](3),
+				Selector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{"app": "juicefs", "role": "juicefs-worker"},
+				},
+			},
+		}
+		pods = []*corev1.Pod{newWorkerPod(0), newWorkerPod(1), newWorkerPod(2)}
+		handoff = &fakeWorkerCacheHandoff{
+			cachedBytes: map[string]int64{"test-worker-0": 100, "test-worker-1": 200, "test-worker-2": 300},
+			done:        true,
+			handoffs:    map[string]string{},
+		}
+		recorder = record.NewFakeRecorder(10)
+	})
+
+	JustBeforeEach(func() {
+		resources := []runtime.Object{fluidRuntime, workerSts}
+		for _, pod := range pods {
+			resources = append(resources, pod)
+		}
+		k8sClient = fake.NewFakeClientWithScheme(datav1alpha1.UnitTestScheme, resources...)
+		runtimeInfo, err := base.BuildRuntimeInfo("test", "fluid", common.JuiceFSRuntime)
+		Expect(err).NotTo(HaveOccurred())
+		helper = BuildHelper(runtimeInfo, k8sClient, fake.NullLogger())
+	})
+
+	decommission := func() (bool, error) {
+		return helper.DecommissionWorkers(cruntime.ReconcileRequestContext{
+			Log:      fake.NullLogger(),
+			Recorder: recorder,
+		}, fluidRuntime, workerSts, handoff)
+	}
+
+	It("should hand off the cache of the workers to be removed", func() {
+		ready, err := decommission()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ready).To(BeTrue())
+		Expect(handoff.handoffs).To(Equal(map[string]string{
+			"test-worker-1": "test-worker-0",
+			"test-worker-2": "test-worker-0",
+		}))
+		for _, name := range []string{"test-worker-1", "test-worker-2"} {
+			pod := getPod(name)
+			Expect(pod.Annotations).To(HaveKey(common.AnnotationWorkerDecommissioningSince))
+			Expect(pod.Annotations).To(HaveKeyWithValue(common.AnnotationWorkerCacheHandedOff, "true"))
+		}
+		Expect(getPod("test-worker-0").Annotations).NotTo(HaveKey(common.AnnotationWorkerDecommissioningSince))
+	})
+
+	Context("when a remaining worker has less cache than the workers to be removed", func() {
+		BeforeEach(func() {
+			fluidRuntime.Spec.Replicas = 2
+			workerSts.Spec.Replicas = ptr.To[int32](4)
+			pods = append(pods, newWorkerPod(3))
+			handoff.cachedBytes = map[string]int64{"test-worker-0": 300, "test-worker-1": 50, "test-worker-2": 200, "test-worker-3": 100}
+		})
+
+		It("should hand off the cache of the worker which is not a victim to the victim", func() {
+			ready, err := decommission()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ready).To(BeTrue())
+			Expect(handoff.handoffs).To(Equal(map[string]string{
+				"test-worker-2": "test-worker-1",
+				"test-worker-3": "test-worker-0",
+			}))
+			Expect(getPod("test-worker-2").Annotations).To(HaveKeyWithValue(common.AnnotationWorkerCacheHandoffTarget, "test-worker-1"))
+			Expect(getPod("test-worker-3").Annotations).To(HaveKeyWithValue(common.AnnotationWorkerCacheHandoffTarget, "test-worker-0"))
+		})
+	})
+
+	Context("when the target of handoff is picked in the previous sync", func() {
+		BeforeEach(func() {
+			fluidRuntime.Spec.Replicas = 2
+			handoff.cachedBytes["test-worker-1"] = 500
+			pods[2].Annotations = map[string]string{common.AnnotationWorkerCacheHandoffTarget: "test-worker-1"}
+		})
+
+		It("should keep the target", func() {
+			ready, err := decommission()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ready).To(BeTrue())
+			Expect(handoff.handoffs).To(Equal(map[string]string{"test-worker-2": "test-worker-1"}))
+		})
+	})
+
+	Context("when a worker to be removed has no cache", func() {
+		BeforeEach(func() {
+			handoff.cachedBytes["test-worker-2"] = 0
+		})
+
+		It("should skip the handoff of the worker", func() {
+			ready, err := decommission()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ready).To(BeTrue())
+			Expect(handoff.handoffs).To(Equal(map[string]string{"test-worker-1": "test-worker-0"}))
+		})
+	})
+
+	Context("when the handoff is not done", func() {
+		BeforeEach(func() {
+			handoff.done = false
+		})
+
+		It("should hold the scale-in", func() {
+			ready, err := decommission()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ready).To(BeFalse())
+			Expect(getPod("test-worker-1").Annotations).NotTo(HaveKey(common.AnnotationWorkerCacheHandedOff))
+			Expect(recorder.Events).To(Receive(ContainSubstring(common.WorkerDecommissioning)))
+		})
+	})
+
+	Context("when the handoff times out", func() {
+		BeforeEach(func() {
+			handoff.done = false
+			since := time.Now().Add(-time.Hour).Format(time.RFC3339)
+			for _, pod := range pods[1:] {
+				pod.Annotations = map[string]string{common.AnnotationWorkerDecommissioningSince: since}
+			}
+		})
+
+		It("should scale in anyway", func() {
+			ready, err := decommission()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ready).To(BeTrue())
+			Expect(handoff.handoffs).To(BeEmpty())
+			Expect(recorder.Events).To(Receive(ContainSubstring(common.WorkerCacheHandoffTimeout)))
+		})
+	})
+
+	Context("when the scale-in is cancelled", func() {
+		BeforeEach(func() {
+			fluidRuntime.Spec.Replicas = 3
+			pods[2].Annotations = map[string]string{
+				common.AnnotationWorkerDecommissioningSince: time.Now().Format(time.RFC3339),
+				common.AnnotationWorkerCacheHandedOff:       "true",
+				common.AnnotationWorkerCacheHandoffTarget:   "test-worker-0",
+			}
+		})
+
+		It("should remove the decommissioning marks", func() {
+			ready, err := decommission()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ready).To(BeTrue())
+			Expect(handoff.handoffs).To(BeEmpty())
+			Expect(getPod("test-worker-2").Annotations).NotTo(HaveKey(common.AnnotationWorkerDecommissioningSince))
+			Expect(getPod("test-worker-2").Annotations).NotTo(HaveKey(common.AnnotationWorkerCacheHandedOff))
+			Expect(getPod("test-worker-2").Annotations).NotTo(HaveKey(common.AnnotationWorkerCacheHandoffTarget))
+		})
+	})
+})
@@ -0,0 +1,60 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package watch
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+)
+
+// customResourceDefinitionEventHandler triggers the reconciliation when the CRDs converted by the webhook are
+// created or replaced, e.g. by the CRD upgrade job, which resets their conversion and served versions.
+type customResourceDefinitionEventHandler struct{}
+
+func (handler *customResourceDefinitionEventHandler) onCreateFunc(crdNames []string) func(e event.CreateEvent) bool {
+	return func(e event.CreateEvent) bool {
+		if !utils.ContainsString(crdNames, e.Object.GetName()) {
+			return false
+		}
+
+		log.V(1).Info("customResourceDefinitionEventHandler.onCreateFunc", "name", e.Object.GetName())
+		return true
+	}
+}
+
+func (handler *customResourceDefinitionEventHandler) onUpdateFunc(crdNames []string) func(e event.UpdateEvent) bool {
+	return func(e event.UpdateEvent) bool {
+		if !utils.ContainsString(crdNames, e.ObjectNew.GetName()) {
+			return false
+		}
+
+		// the metadata of the CRDs is watched only, and the generation is increased on the change of the spec
+		if e.ObjectOld.GetGeneration() == e.ObjectNew.GetGeneration() {
+			return false
+		}
+
+		log.V(1).Info("customResourceDefinitionEventHandler.onUpdateFunc", "name", e.ObjectNew.GetName())
+		return true
+	}
+}
+
+func (handler *customResourceDefinitionEventHandler) onDeleteFunc(crdNames []string) func(e event.DeleteEvent) bool {
+	return func(e event.DeleteEvent) bool {
+		return false
+	}
+}
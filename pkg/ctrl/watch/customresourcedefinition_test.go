@@ -0,0 +1,85 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package watch
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("customResourceDefinitionEventHandler", func() {
+	var (
+		crdNames = []string{"datasets.data.fluid.io"}
+		handler  *customResourceDefinitionEventHandler
+	)
+
+	newCRD := func(name string, generation int64) *metav1.PartialObjectMetadata {
+		return &metav1.PartialObjectMetadata{ObjectMeta: metav1.ObjectMeta{Name: name, Generation: generation}}
+	}
+
+	BeforeEach(func() {
+		handler = &customResourceDefinitionEventHandler{}
+	})
+
+	Describe("onCreateFunc", func() {
+		It("should reconcile if the CRD is converted by the webhook", func() {
+			f := handler.onCreateFunc(crdNames)
+			Expect(f(event.CreateEvent{Object: newCRD("datasets.data.fluid.io", 1)})).To(BeTrue())
+		})
+
+		It("should not reconcile if the CRD is not converted by the webhook", func() {
+			f := handler.onCreateFunc(crdNames)
+			Expect(f(event.CreateEvent{Object: newCRD("thinruntimes.data.fluid.io", 1)})).To(BeFalse())
+		})
+	})
+
+	Describe("onUpdateFunc", func() {
+		It("should reconcile if the spec of the CRD is changed", func() {
+			f := handler.onUpdateFunc(crdNames)
+			Expect(f(event.UpdateEvent{
+				ObjectOld: newCRD("datasets.data.fluid.io", 1),
+				ObjectNew: newCRD("datasets.data.fluid.io", 2),
+			})).To(BeTrue())
+		})
+
+		It("should not reconcile if the spec of the CRD is not changed", func() {
+			f := handler.onUpdateFunc(crdNames)
+			Expect(f(event.UpdateEvent{
+				ObjectOld: newCRD("datasets.data.fluid.io", 2),
+				ObjectNew: newCRD("datasets.data.fluid.io", 2),
+			})).To(BeFalse())
+		})
+
+		It("should not reconcile if the CRD is not converted by the webhook", func() {
+			f := handler.onUpdateFunc(crdNames)
+			Expect(f(event.UpdateEvent{
+				ObjectOld: newCRD("thinruntimes.data.fluid.io", 1),
+				ObjectNew: newCRD("thinruntimes.data.fluid.io", 2),
+			})).To(BeFalse())
+		})
+	})
+
+	Describe("onDeleteFunc", func() {
+		It("should not reconcile on delete", func() {
+			f := handler.onDeleteFunc(crdNames)
+			Expect(f(event.DeleteEvent{Object: newCRD("datasets.data.fluid.io", 1)})).To(BeFalse())
+		})
+	})
+})
@@ -26,6 +26,7 @@ import (
 	webhookReconcile "github.com/fluid-cloudnative/fluid/pkg/controllers/v1alpha1/webhook"
 	"github.com/fluid-cloudnative/fluid/pkg/webhook"
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -255,5 +256,21 @@ func SetupWatcherForWebhook(mgr ctrl.Manager, certBuilder *webhook.CertificateBu
 		return err
 	}
 
+	// watch the metadata of the CRDs only to re-patch their conversion when they're replaced
+	crd := &metav1.PartialObjectMetadata{}
+	crd.SetGroupVersionKind(apiextensionsv1.SchemeGroupVersion.WithKind("CustomResourceDefinition"))
+	customResourceDefinitionEventHandler := &customResourceDefinitionEventHandler{}
+	err = webhookController.Watch(source.Kind(mgr.GetCache(), crd),
+		&handler.EnqueueRequestForObject{},
+		predicate.Funcs{
+			CreateFunc: customResourceDefinitionEventHandler.onCreateFunc(webhook.ConvertibleCRDs),
+			UpdateFunc: customResourceDefinitionEventHandler.onUpdateFunc(webhook.ConvertibleCRDs),
+			DeleteFunc: customResourceDefinitionEventHandler.onDeleteFunc(webhook.ConvertibleCRDs),
+		})
+	if err != nil {
+		log.Error(err, "Failed to watch customResourceDefinition")
+		return err
+	}
+
 	return
 }
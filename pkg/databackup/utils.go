@@ -0,0 +1,29 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package databackup
+
+import (
+	"strings"
+
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+)
+
+// IsSupportedBackupPath checks if the backup path is a local path or a path in a PVC, which are the only
+// locations a DataBackup can write to.
+func IsSupportedBackupPath(path string) bool {
+	return strings.HasPrefix(path, common.PathScheme.String()) || strings.HasPrefix(path, common.VolumeScheme.String())
+}
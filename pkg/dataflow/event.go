@@ -0,0 +1,229 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dataflow
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+)
+
+// MaxEventRunHistory is the max number of event runs kept in the operation status
+const MaxEventRunHistory = 10
+
+// EventSourceKey returns the key of the event source in EventTriggerStatus.ObservedRevisions.
+func EventSourceKey(source datav1alpha1.EventSource, namespace string) string {
+	switch source.Type {
+	case datav1alpha1.OperationCompletedEvent:
+		if source.Operation == nil {
+			return string(source.Type)
+		}
+		opNamespace := source.Operation.Namespace
+		if opNamespace == "" {
+			opNamespace = namespace
+		}
+		return fmt.Sprintf("%s/%s/%s/%s", source.Type, source.Operation.Kind, opNamespace, source.Operation.Name)
+	default:
+		return string(source.Type)
+	}
+}
+
+// GetEventRevision returns the current revision of the event source. An empty revision means the event has never happened.
+// The revision changes every time the event happens, so comparing it with the observed one tells whether a new event occurs.
+func GetEventRevision(c client.Client, object client.Object, dataset *datav1alpha1.Dataset, source datav1alpha1.EventSource) (string, error) {
+	switch source.Type {
+	case datav1alpha1.DatasetMountsChangedEvent:
+		if dataset == nil {
+			return "", nil
+		}
+		return hashMounts(dataset.Spec.Mounts)
+	case datav1alpha1.OperationCompletedEvent:
+		if source.Operation == nil {
+			return "", fmt.Errorf("event source %s must specify the operation", source.Type)
+		}
+		opNamespace := source.Operation.Namespace
+		if opNamespace == "" {
+			opNamespace = object.GetNamespace()
+		}
+		opStatus, err := utils.GetPrecedingOperationStatus(c, source.Operation, opNamespace)
+		if err != nil {
+			return "", err
+		}
+		return getOperationCompletedRevision(opStatus), nil
+	case datav1alpha1.UFSChangedEvent:
+		return object.GetAnnotations()[common.AnnotationDataFlowUFSChanged], nil
+	default:
+		return "", fmt.Errorf("unknown event source type %s", source.Type)
+	}
+}
+
+// getOperationCompletedRevision uses the last completion time of the operation as the revision.
+func getOperationCompletedRevision(opStatus *datav1alpha1.OperationStatus) string {
+	if opStatus == nil {
+		return ""
+	}
+	// cron operation records its last successful time
+	if opStatus.LastSuccessfulTime != nil {
+		return opStatus.LastSuccessfulTime.UTC().Format(time.RFC3339)
+	}
+	if opStatus.Phase == common.PhaseComplete && len(opStatus.Conditions) > 0 {
+		return opStatus.Conditions[0].LastTransitionTime.UTC().Format(time.RFC3339)
+	}
+	return ""
+}
+
+func hashMounts(mounts []datav1alpha1.Mount) (string, error) {
+	content, err := json.Marshal(mounts)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])[:16], nil
+}
+
+// MatchUFSChangedEvent checks if the ufs path matches any UFSChanged event source.
+func MatchUFSChangedEvent(sources []datav1alpha1.EventSource, path string) bool {
+	for _, source := range sources {
+		if source.Type != datav1alpha1.UFSChangedEvent {
+			continue
+		}
+		if len(source.PathPrefixes) == 0 {
+			return true
+		}
+		for _, prefix := range source.PathPrefixes {
+			if matchPathPrefix(path, prefix) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// matchPathPrefix checks if the path is the prefix or under it, matching whole path segments,
+// e.g. /data matches /data and /data/train, but not /database
+func matchPathPrefix(path, prefix string) bool {
+	prefix = strings.TrimSuffix(prefix, "/")
+	return path == prefix || strings.HasPrefix(path, prefix+"/")
+}
+
+// ReconcileEventTrigger checks the event sources of an operation with OnEvent policy and updates opStatus.EventTrigger.
+// It records the revisions of all the event sources when the operation runs for the first time. Once the current run
+// finishes, any event source whose revision changed triggers a new run: the run is appended to the history and opStatus
+// is set back to Pending. The caller is responsible for cleaning up the finished run, e.g. deleting the helm release.
+func ReconcileEventTrigger(c client.Client, object client.Object, dataset *datav1alpha1.Dataset,
+	sources []datav1alpha1.EventSource, opStatus *datav1alpha1.OperationStatus) (triggered bool, err error) {
+	if len(sources) == 0 {
+		return false, nil
+	}
+
+	revisions := make(map[string]string, len(sources))
+	for _, source := range sources {
+		revision, err := GetEventRevision(c, object, dataset, source)
+		if err != nil {
+			return false, err
+		}
+		revisions[EventSourceKey(source, object.GetNamespace())] = revision
+	}
+
+	// first run, take the current revisions as the baseline
+	if opStatus.EventTrigger == nil {
+		opStatus.EventTrigger = &datav1alpha1.EventTriggerStatus{
+			ObservedRevisions: revisions,
+		}
+		return false, nil
+	}
+
+	finished := opStatus.Phase == common.PhaseComplete || opStatus.Phase == common.PhaseFailed
+	if !finished {
+		return false, nil
+	}
+
+	eventTrigger := opStatus.EventTrigger
+	if runs := eventTrigger.Runs; len(runs) > 0 && runs[len(runs)-1].Phase == common.PhasePending {
+		runs[len(runs)-1].Phase = opStatus.Phase
+		runs[len(runs)-1].Duration = opStatus.Duration
+	}
+
+	var (
+		events   []datav1alpha1.EventSourceType
+		messages []string
+	)
+	for _, source := range sources {
+		key := EventSourceKey(source, object.GetNamespace())
+		revision := revisions[key]
+		observed, found := eventTrigger.ObservedRevisions[key]
+		// event sources added after the first run take the current revision as the baseline
+		if !found || revision == "" || revision == observed {
+			continue
+		}
+		events = append(events, source.Type)
+		messages = append(messages, fmt.Sprintf("%s changed to revision %s", key, revision))
+	}
+	eventTrigger.ObservedRevisions = revisions
+
+	if len(events) == 0 {
+		return false, nil
+	}
+
+	eventTrigger.Runs = append(eventTrigger.Runs, datav1alpha1.EventRun{
+		Events:      events,
+		Message:     strings.Join(messages, "; "),
+		TriggerTime: metav1.Now(),
+		Phase:       common.PhasePending,
+	})
+	if len(eventTrigger.Runs) > MaxEventRunHistory {
+		eventTrigger.Runs = eventTrigger.Runs[len(eventTrigger.Runs)-MaxEventRunHistory:]
+	}
+
+	opStatus.Phase = common.PhasePending
+	opStatus.Duration = "-"
+	return true, nil
+}
+
+// ValidateEventSources checks if the event sources of an operation are valid.
+func ValidateEventSources(sources []datav1alpha1.EventSource) error {
+	for i, source := range sources {
+		if err := ValidateEventSource(source); err != nil {
+			return fmt.Errorf("events[%d]: %v", i, err)
+		}
+	}
+	return nil
+}
+
+// ValidateEventSource checks if an event source of an operation is valid.
+func ValidateEventSource(source datav1alpha1.EventSource) error {
+	switch source.Type {
+	case datav1alpha1.DatasetMountsChangedEvent, datav1alpha1.UFSChangedEvent:
+	case datav1alpha1.OperationCompletedEvent:
+		if source.Operation == nil || source.Operation.Name == "" {
+			return fmt.Errorf("event source %s must specify the operation", source.Type)
+		}
+	default:
+		return fmt.Errorf("unknown event source type %s", source.Type)
+	}
+	return nil
+}
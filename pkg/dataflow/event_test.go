@@ -0,0 +1,282 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dataflow
+
+import (
+	"testing"
+	"time"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func newEventTestDataLoad(annotations map[string]string, events ...datav1alpha1.EventSource) *datav1alpha1.DataLoad {
+	return &datav1alpha1.DataLoad{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "test-load",
+			Namespace:   "default",
+			Annotations: annotations,
+		},
+		Spec: datav1alpha1.DataLoadSpec{
+			Dataset: datav1alpha1.TargetDataset{Name: "test-dataset", Namespace: "default"},
+			Policy:  datav1alpha1.OnEvent,
+			Events:  events,
+		},
+	}
+}
+
+func newEventTestDataset(mountPoint string) *datav1alpha1.Dataset {
+	return &datav1alpha1.Dataset{
+		ObjectMeta: metav1.ObjectMeta{Name: "test-dataset", Namespace: "default"},
+		Spec: datav1alpha1.DatasetSpec{
+			Mounts: []datav1alpha1.Mount{{Name: "data", MountPoint: mountPoint}},
+		},
+	}
+}
+
+func TestReconcileEventTriggerDatasetMountsChanged(t *testing.T) {
+	testScheme := runtime.NewScheme()
+	_ = datav1alpha1.AddToScheme(testScheme)
+	c := fake.NewFakeClientWithScheme(testScheme)
+
+	dataLoad := newEventTestDataLoad(nil, datav1alpha1.EventSource{Type: datav1alpha1.DatasetMountsChangedEvent})
+	opStatus := &datav1alpha1.OperationStatus{Phase: common.PhaseExecuting}
+
+	// first run records the baseline
+	triggered, err := ReconcileEventTrigger(c, dataLoad, newEventTestDataset("s3://bucket/v1"), dataLoad.Spec.Events, opStatus)
+	if err != nil || triggered {
+		t.Fatalf("expect no trigger on first run, got triggered=%v, err=%v", triggered, err)
+	}
+	if opStatus.EventTrigger == nil || len(opStatus.EventTrigger.ObservedRevisions) != 1 {
+		t.Fatalf("expect baseline revisions to be recorded, got %v", opStatus.EventTrigger)
+	}
+
+	// mounts changed while running, wait for the current run to finish
+	triggered, err = ReconcileEventTrigger(c, dataLoad, newEventTestDataset("s3://bucket/v2"), dataLoad.Spec.Events, opStatus)
+	if err != nil || triggered {
+		t.Fatalf("expect no trigger while running, got triggered=%v, err=%v", triggered, err)
+	}
+
+	opStatus.Phase = common.PhaseComplete
+	opStatus.Duration = "10s"
+	triggered, err = ReconcileEventTrigger(c, dataLoad, newEventTestDataset("s3://bucket/v2"), dataLoad.Spec.Events, opStatus)
+	if err != nil || !triggered {
+		t.Fatalf("expect trigger after mounts changed, got triggered=%v, err=%v", triggered, err)
+	}
+	if opStatus.Phase != common.PhasePending {
+		t.Errorf("expect phase Pending, got %s", opStatus.Phase)
+	}
+	runs := opStatus.EventTrigger.Runs
+	if len(runs) != 1 || runs[0].Phase != common.PhasePending || runs[0].Events[0] != datav1alpha1.DatasetMountsChangedEvent {
+		t.Fatalf("unexpected runs %v", runs)
+	}
+
+	// the triggered run finishes, no new event
+	opStatus.Phase = common.PhaseFailed
+	opStatus.Duration = "5s"
+	triggered, err = ReconcileEventTrigger(c, dataLoad, newEventTestDataset("s3://bucket/v2"), dataLoad.Spec.Events, opStatus)
+	if err != nil || triggered {
+		t.Fatalf("expect no trigger without new events, got triggered=%v, err=%v", triggered, err)
+	}
+	if runs := opStatus.EventTrigger.Runs; runs[0].Phase != common.PhaseFailed || runs[0].Duration != "5s" {
+		t.Errorf("expect the run to be updated with the result, got %v", runs[0])
+	}
+}
+
+func TestReconcileEventTriggerOperationCompleted(t *testing.T) {
+	completeTime := metav1.NewTime(time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC))
+	preceding := &datav1alpha1.DataProcess{
+		ObjectMeta: metav1.ObjectMeta{Name: "preprocess", Namespace: "default"},
+		Status: datav1alpha1.OperationStatus{
+			Phase:      common.PhaseComplete,
+			Conditions: []datav1alpha1.Condition{{Type: common.Complete, Status: v1.ConditionTrue, LastTransitionTime: completeTime}},
+		},
+	}
+	testScheme := runtime.NewScheme()
+	_ = datav1alpha1.AddToScheme(testScheme)
+	c := fake.NewFakeClientWithScheme(testScheme, preceding)
+
+	dataLoad := newEventTestDataLoad(nil, datav1alpha1.EventSource{
+		Type:      datav1alpha1.OperationCompletedEvent,
+		Operation: &datav1alpha1.ObjectRef{Kind: "DataProcess", Name: "preprocess"},
+	})
+	opStatus := &datav1alpha1.OperationStatus{
+		Phase: common.PhaseComplete,
+		EventTrigger: &datav1alpha1.EventTriggerStatus{
+			ObservedRevisions: map[string]string{"OperationCompleted/DataProcess/default/preprocess": ""},
+		},
+	}
+
+	triggered, err := ReconcileEventTrigger(c, dataLoad, nil, dataLoad.Spec.Events, opStatus)
+	if err != nil || !triggered {
+		t.Fatalf("expect trigger after preceding operation completed, got triggered=%v, err=%v", triggered, err)
+	}
+	if got := opStatus.EventTrigger.ObservedRevisions["OperationCompleted/DataProcess/default/preprocess"]; got != "2026-01-01T00:00:00Z" {
+		t.Errorf("unexpected observed revision %s", got)
+	}
+}
+
+func TestReconcileEventTriggerUFSChanged(t *testing.T) {
+	testScheme := runtime.NewScheme()
+	_ = datav1alpha1.AddToScheme(testScheme)
+	c := fake.NewFakeClientWithScheme(testScheme)
+
+	source := datav1alpha1.EventSource{Type: datav1alpha1.UFSChangedEvent}
+	opStatus := &datav1alpha1.OperationStatus{
+		Phase: common.PhaseComplete,
+		EventTrigger: &datav1alpha1.EventTriggerStatus{
+			ObservedRevisions: map[string]string{"UFSChanged": "rev-1"},
+		},
+	}
+
+	dataLoad := newEventTestDataLoad(map[string]string{common.AnnotationDataFlowUFSChanged: "rev-1"}, source)
+	triggered, err := ReconcileEventTrigger(c, dataLoad, nil, dataLoad.Spec.Events, opStatus)
+	if err != nil || triggered {
+		t.Fatalf("expect no trigger with the same revision, got triggered=%v, err=%v", triggered, err)
+	}
+
+	dataLoad = newEventTestDataLoad(map[string]string{common.AnnotationDataFlowUFSChanged: "rev-2"}, source)
+	triggered, err = ReconcileEventTrigger(c, dataLoad, nil, dataLoad.Spec.Events, opStatus)
+	if err != nil || !triggered {
+		t.Fatalf("expect trigger with a new revision, got triggered=%v, err=%v", triggered, err)
+	}
+}
+
+func TestReconcileEventTriggerHistoryLimit(t *testing.T) {
+	testScheme := runtime.NewScheme()
+	_ = datav1alpha1.AddToScheme(testScheme)
+	c := fake.NewFakeClientWithScheme(testScheme)
+
+	opStatus := &datav1alpha1.OperationStatus{
+		EventTrigger: &datav1alpha1.EventTriggerStatus{ObservedRevisions: map[string]string{"UFSChanged": "rev-0"}},
+	}
+	for i := 1; i <= MaxEventRunHistory+3; i++ {
+		opStatus.Phase = common.PhaseComplete
+		dataLoad := newEventTestDataLoad(map[string]string{common.AnnotationDataFlowUFSChanged: time.Duration(i).String()},
+			datav1alpha1.EventSource{Type: datav1alpha1.UFSChangedEvent})
+		if _, err := ReconcileEventTrigger(c, dataLoad, nil, dataLoad.Spec.Events, opStatus); err != nil {
+			t.Fatalf("unexpected error %v", err)
+		}
+	}
+	if len(opStatus.EventTrigger.Runs) != MaxEventRunHistory {
+		t.Errorf("expect %d runs in history, got %d", MaxEventRunHistory, len(opStatus.EventTrigger.Runs))
+	}
+}
+
+func TestMatchUFSChangedEvent(t *testing.T) {
+	tests := []struct {
+		name    string
+		sources []datav1alpha1.EventSource
+		path    string
+		want    bool
+	}{
+		{
+			name:    "no ufs changed event",
+			sources: []datav1alpha1.EventSource{{Type: datav1alpha1.DatasetMountsChangedEvent}},
+			path:    "/train",
+			want:    false,
+		},
+		{
+			name:    "empty prefixes match all",
+			sources: []datav1alpha1.EventSource{{Type: datav1alpha1.UFSChangedEvent}},
+			path:    "/train",
+			want:    true,
+		},
+		{
+			name:    "prefix matched",
+			sources: []datav1alpha1.EventSource{{Type: datav1alpha1.UFSChangedEvent, PathPrefixes: []string{"/eval", "/train"}}},
+			path:    "/train/part-0001",
+			want:    true,
+		},
+		{
+			name:    "prefix not matched",
+			sources: []datav1alpha1.EventSource{{Type: datav1alpha1.UFSChangedEvent, PathPrefixes: []string{"/eval"}}},
+			path:    "/train/part-0001",
+			want:    false,
+		},
+		{
+			name:    "prefix equal to path",
+			sources: []datav1alpha1.EventSource{{Type: datav1alpha1.UFSChangedEvent, PathPrefixes: []string{"/train"}}},
+			path:    "/train",
+			want:    true,
+		},
+		{
+			name:    "prefix with trailing slash",
+			sources: []datav1alpha1.EventSource{{Type: datav1alpha1.UFSChangedEvent, PathPrefixes: []string{"/train/"}}},
+			path:    "/train/part-0001",
+			want:    true,
+		},
+		{
+			name:    "prefix matched partial segment",
+			sources: []datav1alpha1.EventSource{{Type: datav1alpha1.UFSChangedEvent, PathPrefixes: []string{"/data"}}},
+			path:    "/database/part-0001",
+			want:    false,
+		},
+		{
+			name:    "root prefix",
+			sources: []datav1alpha1.EventSource{{Type: datav1alpha1.UFSChangedEvent, PathPrefixes: []string{"/"}}},
+			path:    "/database/part-0001",
+			want:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MatchUFSChangedEvent(tt.sources, tt.path); got != tt.want {
+				t.Errorf("MatchUFSChangedEvent() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateEventSources(t *testing.T) {
+	tests := []struct {
+		name    string
+		sources []datav1alpha1.EventSource
+		wantErr bool
+	}{
+		{
+			name: "valid",
+			sources: []datav1alpha1.EventSource{
+				{Type: datav1alpha1.DatasetMountsChangedEvent},
+				{Type: datav1alpha1.UFSChangedEvent},
+				{Type: datav1alpha1.OperationCompletedEvent, Operation: &datav1alpha1.ObjectRef{Kind: "DataLoad", Name: "a"}},
+			},
+			wantErr: false,
+		},
+		{
+			name:    "operation not specified",
+			sources: []datav1alpha1.EventSource{{Type: datav1alpha1.OperationCompletedEvent}},
+			wantErr: true,
+		},
+		{
+			name:    "unknown type",
+			sources: []datav1alpha1.EventSource{{Type: "Unknown"}},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateEventSources(tt.sources); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateEventSources() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
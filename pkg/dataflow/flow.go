@@ -0,0 +1,379 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dataflow
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/dataoperation"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+)
+
+// templateVariableRegex matches the variables in the step templates, i.e. $(params.<name>), $(flow.name) and $(flow.run)
+var templateVariableRegex = regexp.MustCompile(`\$\((params|flow)\.([A-Za-z0-9_.-]+)\)`)
+
+var parameterNameRegex = regexp.MustCompile(`^[A-Za-z0-9_.-]+$`)
+
+// StepOperationFinalizer is the finalizer of the data operations created for the steps, which keeps a finished
+// operation, e.g. one deleted by its TTL, until the DataFlow records the phase of its step.
+const StepOperationFinalizer = "fluid-dataflow-step-finalizer"
+
+// helmReleaseNameMaxLength is the maximum length of the helm release names
+const helmReleaseNameMaxLength = 53
+
+// GetStepOperationName returns the name of the data operation created for the step in a run of the DataFlow
+func GetStepOperationName(flowName, stepName string, run int32) string {
+	return fmt.Sprintf("%s-%s-%d", flowName, stepName, run)
+}
+
+// getStepOperationDerivedNames returns the names of the helm release and the job (or pod) derived from the name of
+// the data operation of the step
+func getStepOperationDerivedNames(kind, name string) (releaseName, jobName string) {
+	switch kind {
+	case string(dataoperation.DataLoadType):
+		releaseName = utils.GetDataLoadReleaseName(name)
+		jobName = utils.GetDataLoadJobName(releaseName)
+	case string(dataoperation.DataProcessType):
+		releaseName = utils.GetDataProcessReleaseName(name)
+		jobName = utils.GetDataProcessJobName(releaseName)
+	case string(dataoperation.DataMigrateType):
+		releaseName = utils.GetDataMigrateReleaseName(name)
+		jobName = utils.GetDataMigrateJobName(releaseName)
+	case string(dataoperation.DataBackupType):
+		releaseName = utils.GetDataBackupReleaseName(name)
+		jobName = utils.GetDataBackupPodName(name)
+	}
+	return
+}
+
+// validateStepOperationName checks that the names derived from the data operation of the step are valid in every run
+// of the DataFlow, i.e. with the run number of the most digits
+func validateStepOperationName(flowName string, step *datav1alpha1.DataFlowStep, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	name := GetStepOperationName(flowName, step.Name, math.MaxInt32)
+	releaseName, jobName := getStepOperationDerivedNames(GetStepKind(step), name)
+	if len(releaseName) > helmReleaseNameMaxLength {
+		return append(allErrs, field.Invalid(fldPath, step.Name, fmt.Sprintf(
+			"the helm release name %s derived from the operation of the step must be no more than %d characters",
+			releaseName, helmReleaseNameMaxLength)))
+	}
+	if msgs := validation.IsDNS1123Label(jobName); len(msgs) > 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath, step.Name, fmt.Sprintf(
+			"the job name %s derived from the operation of the step is invalid: %s", jobName, strings.Join(msgs, ", "))))
+	}
+	return allErrs
+}
+
+// GetStepKind returns the kind of the data operation run by the step, empty if not exactly one template is set
+func GetStepKind(step *datav1alpha1.DataFlowStep) string {
+	kinds := stepKinds(step)
+	if len(kinds) != 1 {
+		return ""
+	}
+	return kinds[0]
+}
+
+func stepKinds(step *datav1alpha1.DataFlowStep) (kinds []string) {
+	if step.DataLoad != nil {
+		kinds = append(kinds, string(dataoperation.DataLoadType))
+	}
+	if step.DataProcess != nil {
+		kinds = append(kinds, string(dataoperation.DataProcessType))
+	}
+	if step.DataMigrate != nil {
+		kinds = append(kinds, string(dataoperation.DataMigrateType))
+	}
+	if step.DataBackup != nil {
+		kinds = append(kinds, string(dataoperation.DataBackupType))
+	}
+	return
+}
+
+// SortSteps sorts the steps in a topological order of their dependencies, keeping the order in the spec when possible.
+// It fails if a step depends on an unknown step or the dependencies have a cycle.
+func SortSteps(steps []datav1alpha1.DataFlowStep) ([]datav1alpha1.DataFlowStep, error) {
+	indexes := make(map[string]int, len(steps))
+	for i, step := range steps {
+		indexes[step.Name] = i
+	}
+
+	inDegrees := make([]int, len(steps))
+	dependents := make([][]int, len(steps))
+	for i, step := range steps {
+		for _, dep := range step.DependsOn {
+			j, found := indexes[dep]
+			if !found {
+				return nil, fmt.Errorf("step %s depends on unknown step %s", step.Name, dep)
+			}
+			inDegrees[i]++
+			dependents[j] = append(dependents[j], i)
+		}
+	}
+
+	sorted := make([]datav1alpha1.DataFlowStep, 0, len(steps))
+	visited := make([]bool, len(steps))
+	for len(sorted) < len(steps) {
+		next := -1
+		for i := range steps {
+			if !visited[i] && inDegrees[i] == 0 {
+				next = i
+				break
+			}
+		}
+		if next < 0 {
+			return nil, fmt.Errorf("the dependencies of the steps have a cycle")
+		}
+		visited[next] = true
+		sorted = append(sorted, steps[next])
+		for _, i := range dependents[next] {
+			inDegrees[i]--
+		}
+	}
+	return sorted, nil
+}
+
+// ValidateFlowSpec checks that the steps of the DataFlow form a directed acyclic graph, their templates can be rendered
+// and the names derived from their operations are valid
+func ValidateFlowSpec(flowName string, spec *datav1alpha1.DataFlowSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	params := map[string]string{}
+	for i, param := range spec.Parameters {
+		namePath := fldPath.Child("parameters").Index(i).Child("name")
+		if !parameterNameRegex.MatchString(param.Name) {
+			allErrs = append(allErrs, field.Invalid(namePath, param.Name, "must consist of alphanumeric characters, '_', '-' or '.'"))
+		}
+		if _, found := params[param.Name]; found {
+			allErrs = append(allErrs, field.Duplicate(namePath, param.Name))
+		}
+		params[param.Name] = param.Value
+	}
+
+	names := map[string]bool{}
+	for i := range spec.Steps {
+		step := &spec.Steps[i]
+		stepPath := fldPath.Child("steps").Index(i)
+		nameErrs := validation.IsDNS1123Label(step.Name)
+		for _, msg := range nameErrs {
+			allErrs = append(allErrs, field.Invalid(stepPath.Child("name"), step.Name, msg))
+		}
+		if names[step.Name] {
+			allErrs = append(allErrs, field.Duplicate(stepPath.Child("name"), step.Name))
+		}
+		names[step.Name] = true
+		allErrs = append(allErrs, validateStep(step, params, stepPath)...)
+		if len(flowName) > 0 && len(nameErrs) == 0 && GetStepKind(step) != "" {
+			allErrs = append(allErrs, validateStepOperationName(flowName, step, stepPath.Child("name"))...)
+		}
+	}
+
+	if len(allErrs) == 0 {
+		if _, err := SortSteps(spec.Steps); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("steps"), len(spec.Steps), err.Error()))
+		}
+	}
+
+	if len(spec.Schedule) != 0 {
+		allErrs = append(allErrs, dataoperation.ValidateSchedule(datav1alpha1.Cron, spec.Schedule, fldPath.Child("schedule"))...)
+	}
+	return allErrs
+}
+
+func validateStep(step *datav1alpha1.DataFlowStep, params map[string]string, stepPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	deps := map[string]bool{}
+	for j, dep := range step.DependsOn {
+		if dep == step.Name {
+			allErrs = append(allErrs, field.Invalid(stepPath.Child("dependsOn").Index(j), dep, "a step can't depend on itself"))
+		}
+		deps[dep] = true
+	}
+	if step.Affinity != nil {
+		if len(step.DependsOn) == 0 {
+			allErrs = append(allErrs, field.Forbidden(stepPath.Child("affinity"), "affinity can only be set for a step depending on other steps"))
+		} else if step.Affinity.Step != "" && !deps[step.Affinity.Step] {
+			allErrs = append(allErrs, field.Invalid(stepPath.Child("affinity", "step"), step.Affinity.Step, "must be one of the steps in dependsOn"))
+		}
+	}
+
+	kinds := stepKinds(step)
+	if len(kinds) != 1 {
+		return append(allErrs, field.Invalid(stepPath, kinds, "exactly one of dataLoad, dataProcess, dataMigrate and dataBackup must be set"))
+	}
+
+	var runAfter *datav1alpha1.OperationRef
+	var policy datav1alpha1.Policy
+	var retryPolicy *datav1alpha1.RetryPolicy
+	var templatePath *field.Path
+	switch kinds[0] {
+	case string(dataoperation.DataLoadType):
+		runAfter, policy, retryPolicy, templatePath = step.DataLoad.RunAfter, step.DataLoad.Policy, step.DataLoad.RetryPolicy, stepPath.Child("dataLoad")
+	case string(dataoperation.DataProcessType):
+		runAfter, policy, retryPolicy, templatePath = step.DataProcess.RunAfter, step.DataProcess.Policy, step.DataProcess.RetryPolicy, stepPath.Child("dataProcess")
+	case string(dataoperation.DataMigrateType):
+		runAfter, policy, retryPolicy, templatePath = step.DataMigrate.RunAfter, step.DataMigrate.Policy, step.DataMigrate.RetryPolicy, stepPath.Child("dataMigrate")
+	default:
+		runAfter, templatePath = step.DataBackup.RunAfter, stepPath.Child("dataBackup")
+	}
+	if runAfter != nil {
+		allErrs = append(allErrs, field.Forbidden(templatePath.Child("runAfter"), "the steps are run after the steps they depend on"))
+	}
+	if policy != "" && policy != datav1alpha1.Once {
+		allErrs = append(allErrs, field.Forbidden(templatePath.Child("policy"), "the steps run once in every run of the DataFlow"))
+	} else {
+		// a failed step is retried by the retry policy of its operation
+		allErrs = append(allErrs, dataoperation.ValidateRetryPolicy(policy, retryPolicy, templatePath.Child("retryPolicy"))...)
+	}
+
+	raw, err := json.Marshal(stepTemplate(step))
+	if err == nil {
+		_, err = substituteVariables(raw, params, map[string]string{"name": "", "run": "0"})
+	}
+	if err != nil {
+		allErrs = append(allErrs, field.Invalid(templatePath, "", err.Error()))
+	}
+	return allErrs
+}
+
+func stepTemplate(step *datav1alpha1.DataFlowStep) interface{} {
+	switch GetStepKind(step) {
+	case string(dataoperation.DataLoadType):
+		return step.DataLoad
+	case string(dataoperation.DataProcessType):
+		return step.DataProcess
+	case string(dataoperation.DataMigrateType):
+		return step.DataMigrate
+	default:
+		return step.DataBackup
+	}
+}
+
+// substituteVariables replaces the variables in the JSON of a template with the values escaped for JSON strings
+func substituteVariables(raw []byte, params, flowVars map[string]string) ([]byte, error) {
+	var err error
+	substituted := templateVariableRegex.ReplaceAllFunc(raw, func(variable []byte) []byte {
+		match := templateVariableRegex.FindSubmatch(variable)
+		vars := params
+		if string(match[1]) == "flow" {
+			vars = flowVars
+		}
+		value, found := vars[string(match[2])]
+		if !found {
+			if err == nil {
+				err = fmt.Errorf("%s is not defined", string(variable))
+			}
+			return variable
+		}
+		escaped, _ := json.Marshal(value)
+		return escaped[1 : len(escaped)-1]
+	})
+	return substituted, err
+}
+
+// BuildStepOperation builds the data operation for the step in the run of the DataFlow from the template of the step,
+// which runs after the given operation. The operation is retried by the retry policy in the template if it fails.
+func BuildStepOperation(flow *datav1alpha1.DataFlow, step *datav1alpha1.DataFlowStep,
+	runAfter *datav1alpha1.OperationRef) (client.Object, error) {
+	params := make(map[string]string, len(flow.Spec.Parameters))
+	for _, param := range flow.Spec.Parameters {
+		params[param.Name] = param.Value
+	}
+	run := flow.Status.Run
+	raw, err := json.Marshal(stepTemplate(step))
+	if err != nil {
+		return nil, err
+	}
+	raw, err = substituteVariables(raw, params, map[string]string{"name": flow.Name, "run": strconv.Itoa(int(run))})
+	if err != nil {
+		return nil, err
+	}
+
+	objectMeta := metav1.ObjectMeta{
+		Name:      GetStepOperationName(flow.Name, step.Name, run),
+		Namespace: flow.Namespace,
+		Labels: map[string]string{
+			common.LabelDataFlowName: flow.Name,
+			common.LabelDataFlowStep: step.Name,
+			common.LabelDataFlowRun:  strconv.Itoa(int(run)),
+		},
+		OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(flow, datav1alpha1.GroupVersion.WithKind("DataFlow"))},
+		Finalizers:      []string{StepOperationFinalizer},
+	}
+
+	switch GetStepKind(step) {
+	case string(dataoperation.DataLoadType):
+		dataLoad := &datav1alpha1.DataLoad{ObjectMeta: objectMeta}
+		err = json.Unmarshal(raw, &dataLoad.Spec)
+		dataLoad.Spec.RunAfter = runAfter
+		return dataLoad, err
+	case string(dataoperation.DataProcessType):
+		dataProcess := &datav1alpha1.DataProcess{ObjectMeta: objectMeta}
+		err = json.Unmarshal(raw, &dataProcess.Spec)
+		dataProcess.Spec.RunAfter = runAfter
+		return dataProcess, err
+	case string(dataoperation.DataMigrateType):
+		dataMigrate := &datav1alpha1.DataMigrate{ObjectMeta: objectMeta}
+		err = json.Unmarshal(raw, &dataMigrate.Spec)
+		dataMigrate.Spec.RunAfter = runAfter
+		return dataMigrate, err
+	case string(dataoperation.DataBackupType):
+		dataBackup := &datav1alpha1.DataBackup{ObjectMeta: objectMeta}
+		err = json.Unmarshal(raw, &dataBackup.Spec)
+		dataBackup.Spec.RunAfter = runAfter
+		return dataBackup, err
+	}
+	return nil, fmt.Errorf("exactly one template must be set for step %s", step.Name)
+}
+
+// GetStepRunAfter returns the operation the operation of the step runs after, which is the operation of the step
+// in the affinity or the first step it depends on. The affinity strategy is inherited through RunAfter so that the
+// pods are scheduled the same as the chained data operations.
+func GetStepRunAfter(step *datav1alpha1.DataFlowStep, operations map[string]*datav1alpha1.ObjectRef) *datav1alpha1.OperationRef {
+	if len(step.DependsOn) == 0 {
+		return nil
+	}
+
+	target := step.DependsOn[0]
+	strategy := datav1alpha1.AffinityStrategy{}
+	if step.Affinity != nil {
+		if step.Affinity.Step != "" {
+			target = step.Affinity.Step
+		}
+		strategy.Policy = step.Affinity.Policy
+		strategy.Prefers = step.Affinity.Prefers
+		strategy.Requires = step.Affinity.Requires
+	}
+
+	ref := operations[target]
+	if ref == nil {
+		return nil
+	}
+	return &datav1alpha1.OperationRef{ObjectRef: *ref, AffinityStrategy: strategy}
+}
@@ -0,0 +1,314 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dataflow
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+)
+
+func loadStep(name string, dependsOn ...string) datav1alpha1.DataFlowStep {
+	return datav1alpha1.DataFlowStep{
+		Name:      name,
+		DependsOn: dependsOn,
+		DataLoad: &datav1alpha1.DataLoadSpec{
+			Dataset: datav1alpha1.TargetDataset{Name: "hbase"},
+		},
+	}
+}
+
+func stepNames(steps []datav1alpha1.DataFlowStep) []string {
+	names := []string{}
+	for _, step := range steps {
+		names = append(names, step.Name)
+	}
+	return names
+}
+
+func TestSortSteps(t *testing.T) {
+	tests := []struct {
+		name    string
+		steps   []datav1alpha1.DataFlowStep
+		want    []string
+		wantErr string
+	}{
+		{
+			name:  "keep the order of independent steps",
+			steps: []datav1alpha1.DataFlowStep{loadStep("a"), loadStep("b")},
+			want:  []string{"a", "b"},
+		},
+		{
+			name:  "fan out and fan in",
+			steps: []datav1alpha1.DataFlowStep{loadStep("merge", "left", "right"), loadStep("left", "load"), loadStep("right", "load"), loadStep("load")},
+			want:  []string{"load", "left", "right", "merge"},
+		},
+		{
+			name:    "unknown dependency",
+			steps:   []datav1alpha1.DataFlowStep{loadStep("a", "b")},
+			wantErr: "unknown step b",
+		},
+		{
+			name:    "cycle",
+			steps:   []datav1alpha1.DataFlowStep{loadStep("a", "c"), loadStep("b", "a"), loadStep("c", "b")},
+			wantErr: "cycle",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := SortSteps(tt.steps)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("SortSteps() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("SortSteps() error = %v", err)
+			}
+			if names := stepNames(got); !reflect.DeepEqual(names, tt.want) {
+				t.Errorf("SortSteps() = %v, want %v", names, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateFlowSpec(t *testing.T) {
+	withTemplate := func(step datav1alpha1.DataFlowStep, mutate func(step *datav1alpha1.DataFlowStep)) datav1alpha1.DataFlowStep {
+		mutate(&step)
+		return step
+	}
+
+	tests := []struct {
+		name      string
+		spec      datav1alpha1.DataFlowSpec
+		wantField string
+	}{
+		{
+			name: "valid",
+			spec: datav1alpha1.DataFlowSpec{
+				Parameters: []datav1alpha1.DataFlowParameter{{Name: "path", Value: "/train"}},
+				Steps: []datav1alpha1.DataFlowStep{
+					withTemplate(loadStep("load"), func(step *datav1alpha1.DataFlowStep) {
+						step.DataLoad.Target = []datav1alpha1.TargetPath{{Path: "$(params.path)"}}
+					}),
+					withTemplate(loadStep("warmup", "load"), func(step *datav1alpha1.DataFlowStep) {
+						step.Affinity = &datav1alpha1.DataFlowStepAffinity{Policy: datav1alpha1.RequireAffinityStrategy}
+					}),
+				},
+				Schedule: "0 2 * * *",
+			},
+		},
+		{
+			name:      "duplicate step",
+			spec:      datav1alpha1.DataFlowSpec{Steps: []datav1alpha1.DataFlowStep{loadStep("load"), loadStep("load")}},
+			wantField: "spec.steps[1].name",
+		},
+		{
+			name:      "invalid step name",
+			spec:      datav1alpha1.DataFlowSpec{Steps: []datav1alpha1.DataFlowStep{loadStep("Load")}},
+			wantField: "spec.steps[0].name",
+		},
+		{
+			name:      "no template",
+			spec:      datav1alpha1.DataFlowSpec{Steps: []datav1alpha1.DataFlowStep{{Name: "load"}}},
+			wantField: "spec.steps[0]",
+		},
+		{
+			name: "more than one template",
+			spec: datav1alpha1.DataFlowSpec{Steps: []datav1alpha1.DataFlowStep{
+				withTemplate(loadStep("load"), func(step *datav1alpha1.DataFlowStep) {
+					step.DataBackup = &datav1alpha1.DataBackupSpec{Dataset: "hbase"}
+				}),
+			}},
+			wantField: "spec.steps[0]",
+		},
+		{
+			name:      "depend on itself",
+			spec:      datav1alpha1.DataFlowSpec{Steps: []datav1alpha1.DataFlowStep{loadStep("load", "load")}},
+			wantField: "spec.steps[0].dependsOn[0]",
+		},
+		{
+			name:      "cycle",
+			spec:      datav1alpha1.DataFlowSpec{Steps: []datav1alpha1.DataFlowStep{loadStep("a", "b"), loadStep("b", "a")}},
+			wantField: "spec.steps",
+		},
+		{
+			name: "affinity without dependency",
+			spec: datav1alpha1.DataFlowSpec{Steps: []datav1alpha1.DataFlowStep{
+				withTemplate(loadStep("load"), func(step *datav1alpha1.DataFlowStep) {
+					step.Affinity = &datav1alpha1.DataFlowStepAffinity{Policy: datav1alpha1.PreferAffinityStrategy}
+				}),
+			}},
+			wantField: "spec.steps[0].affinity",
+		},
+		{
+			name: "affinity with a step not depended on",
+			spec: datav1alpha1.DataFlowSpec{Steps: []datav1alpha1.DataFlowStep{
+				loadStep("a"), loadStep("b"),
+				withTemplate(loadStep("c", "a"), func(step *datav1alpha1.DataFlowStep) {
+					step.Affinity = &datav1alpha1.DataFlowStepAffinity{Step: "b"}
+				}),
+			}},
+			wantField: "spec.steps[2].affinity.step",
+		},
+		{
+			name: "runAfter set in template",
+			spec: datav1alpha1.DataFlowSpec{Steps: []datav1alpha1.DataFlowStep{
+				withTemplate(loadStep("load"), func(step *datav1alpha1.DataFlowStep) {
+					step.DataLoad.RunAfter = &datav1alpha1.OperationRef{ObjectRef: datav1alpha1.ObjectRef{Kind: "DataLoad", Name: "other"}}
+				}),
+			}},
+			wantField: "spec.steps[0].dataLoad.runAfter",
+		},
+		{
+			name: "cron policy in template",
+			spec: datav1alpha1.DataFlowSpec{Steps: []datav1alpha1.DataFlowStep{
+				withTemplate(loadStep("load"), func(step *datav1alpha1.DataFlowStep) {
+					step.DataLoad.Policy = datav1alpha1.Cron
+				}),
+			}},
+			wantField: "spec.steps[0].dataLoad.policy",
+		},
+		{
+			name: "undefined parameter",
+			spec: datav1alpha1.DataFlowSpec{Steps: []datav1alpha1.DataFlowStep{
+				withTemplate(loadStep("load"), func(step *datav1alpha1.DataFlowStep) {
+					step.DataLoad.Target = []datav1alpha1.TargetPath{{Path: "$(params.path)"}}
+				}),
+			}},
+			wantField: "spec.steps[0].dataLoad",
+		},
+		{
+			name: "invalid retry policy in template",
+			spec: datav1alpha1.DataFlowSpec{Steps: []datav1alpha1.DataFlowStep{
+				withTemplate(loadStep("load"), func(step *datav1alpha1.DataFlowStep) {
+					step.DataLoad.RetryPolicy = &datav1alpha1.RetryPolicy{MaxAttempts: 0}
+				}),
+			}},
+			wantField: "spec.steps[0].dataLoad.retryPolicy.maxAttempts",
+		},
+		{
+			name:      "step name making the helm release name too long",
+			spec:      datav1alpha1.DataFlowSpec{Steps: []datav1alpha1.DataFlowStep{loadStep("load-the-training-data-into-cache")}},
+			wantField: "spec.steps[0].name",
+		},
+		{
+			name:      "invalid schedule",
+			spec:      datav1alpha1.DataFlowSpec{Steps: []datav1alpha1.DataFlowStep{loadStep("load")}, Schedule: "every day"},
+			wantField: "spec.schedule",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			errs := ValidateFlowSpec("preprocess", &tt.spec, field.NewPath("spec"))
+			if tt.wantField == "" {
+				if len(errs) > 0 {
+					t.Fatalf("ValidateFlowSpec() = %v, want no error", errs)
+				}
+				return
+			}
+			if len(errs) != 1 || errs[0].Field != tt.wantField {
+				t.Errorf("ValidateFlowSpec() = %v, want an error of %s", errs, tt.wantField)
+			}
+		})
+	}
+}
+
+func TestBuildStepOperation(t *testing.T) {
+	flow := &datav1alpha1.DataFlow{
+		ObjectMeta: metav1.ObjectMeta{Name: "preprocess", Namespace: "default", UID: "uid"},
+		Spec: datav1alpha1.DataFlowSpec{
+			Parameters: []datav1alpha1.DataFlowParameter{{Name: "path", Value: `/data/"quoted"`}},
+		},
+		Status: datav1alpha1.DataFlowStatus{Run: 3},
+	}
+	step := loadStep("load")
+	step.DataLoad.Target = []datav1alpha1.TargetPath{{Path: "$(params.path)/$(flow.name)-$(flow.run)"}}
+	runAfter := &datav1alpha1.OperationRef{ObjectRef: datav1alpha1.ObjectRef{Kind: "DataProcess", Name: "preprocess-clean-3"}}
+
+	obj, err := BuildStepOperation(flow, &step, runAfter)
+	if err != nil {
+		t.Fatalf("BuildStepOperation() error = %v", err)
+	}
+	dataLoad, ok := obj.(*datav1alpha1.DataLoad)
+	if !ok {
+		t.Fatalf("BuildStepOperation() = %T, want DataLoad", obj)
+	}
+	if dataLoad.Name != "preprocess-load-3" || dataLoad.Namespace != "default" {
+		t.Errorf("BuildStepOperation() name = %s/%s", dataLoad.Namespace, dataLoad.Name)
+	}
+	if want := `/data/"quoted"/preprocess-3`; dataLoad.Spec.Target[0].Path != want {
+		t.Errorf("BuildStepOperation() target path = %s, want %s", dataLoad.Spec.Target[0].Path, want)
+	}
+	if dataLoad.Spec.RunAfter != runAfter {
+		t.Errorf("BuildStepOperation() runAfter = %v, want %v", dataLoad.Spec.RunAfter, runAfter)
+	}
+	if dataLoad.Labels[common.LabelDataFlowName] != "preprocess" || dataLoad.Labels[common.LabelDataFlowRun] != "3" {
+		t.Errorf("BuildStepOperation() labels = %v", dataLoad.Labels)
+	}
+	if len(dataLoad.OwnerReferences) != 1 || dataLoad.OwnerReferences[0].Kind != "DataFlow" {
+		t.Errorf("BuildStepOperation() ownerReferences = %v", dataLoad.OwnerReferences)
+	}
+	if !reflect.DeepEqual(dataLoad.Finalizers, []string{StepOperationFinalizer}) {
+		t.Errorf("BuildStepOperation() finalizers = %v", dataLoad.Finalizers)
+	}
+	if step.DataLoad.Target[0].Path != "$(params.path)/$(flow.name)-$(flow.run)" {
+		t.Errorf("BuildStepOperation() changed the template of the step")
+	}
+}
+
+func TestGetStepRunAfter(t *testing.T) {
+	operations := map[string]*datav1alpha1.ObjectRef{
+		"load":  {Kind: "DataLoad", Name: "flow-load-1"},
+		"clean": {Kind: "DataProcess", Name: "flow-clean-1"},
+	}
+
+	if got := GetStepRunAfter(&datav1alpha1.DataFlowStep{Name: "load"}, operations); got != nil {
+		t.Errorf("GetStepRunAfter() = %v for a step without dependencies, want nil", got)
+	}
+
+	step := loadStep("train", "load", "clean")
+	got := GetStepRunAfter(&step, operations)
+	want := &datav1alpha1.OperationRef{ObjectRef: *operations["load"]}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("GetStepRunAfter() = %v, want %v", got, want)
+	}
+
+	step.Affinity = &datav1alpha1.DataFlowStepAffinity{
+		Step:     "clean",
+		Policy:   datav1alpha1.RequireAffinityStrategy,
+		Requires: []datav1alpha1.Require{{Name: common.K8sNodeNameLabelKey}},
+	}
+	got = GetStepRunAfter(&step, operations)
+	want = &datav1alpha1.OperationRef{
+		ObjectRef: *operations["clean"],
+		AffinityStrategy: datav1alpha1.AffinityStrategy{
+			Policy:   datav1alpha1.RequireAffinityStrategy,
+			Requires: []datav1alpha1.Require{{Name: common.K8sNodeNameLabelKey}},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("GetStepRunAfter() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,231 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dataflow
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+)
+
+// UFSChangedPath is the http path of the event receiver to deliver UFS change notifications
+const UFSChangedPath = "/events/ufs-changed"
+
+// UFSChangeNotification is a UFS change notification delivered to the event receiver
+type UFSChangeNotification struct {
+	// Namespace of the dataset
+	Namespace string `json:"namespace"`
+	// Dataset whose UFS is changed
+	Dataset string `json:"dataset"`
+	// Path of the changed file or directory in the dataset
+	Path string `json:"path,omitempty"`
+}
+
+// UFSChangeResponse is the response of the event receiver
+type UFSChangeResponse struct {
+	// Triggered lists the data operations triggered by the notification, in the form of kind/namespace/name
+	Triggered []string `json:"triggered"`
+}
+
+// EventReceiver receives UFS change notifications over http, and triggers the data operations
+// with OnEvent policy which subscribe UFSChanged events on the dataset. Every notification must carry
+// the bearer token stored in TokenFile, which is read on each request so that the token can be rotated.
+type EventReceiver struct {
+	Client      client.Client
+	Log         logr.Logger
+	BindAddress string
+	TokenFile   string
+}
+
+var _ manager.Runnable = &EventReceiver{}
+var _ manager.LeaderElectionRunnable = &EventReceiver{}
+
+// NewEventReceiver creates the EventReceiver
+func NewEventReceiver(client client.Client, log logr.Logger, bindAddress string, tokenFile string) *EventReceiver {
+	return &EventReceiver{
+		Client:      client,
+		Log:         log,
+		BindAddress: bindAddress,
+		TokenFile:   tokenFile,
+	}
+}
+
+// Start starts the http server and blocks until the context is done.
+func (r *EventReceiver) Start(ctx context.Context) error {
+	if _, err := r.readToken(); err != nil {
+		return err
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle(UFSChangedPath, r)
+	server := &http.Server{
+		Addr:              r.BindAddress,
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if err := server.Shutdown(shutdownCtx); err != nil {
+			r.Log.Error(err, "failed to shutdown event receiver")
+		}
+	}()
+
+	r.Log.Info("starting event receiver", "address", r.BindAddress)
+	if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable, every replica is able to receive events.
+func (r *EventReceiver) NeedLeaderElection() bool {
+	return false
+}
+
+// ServeHTTP handles the UFS change notification.
+func (r *EventReceiver) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		http.Error(w, "only POST is allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if err := r.authenticate(req); err != nil {
+		r.Log.Info("reject unauthenticated ufs change notification", "remoteAddr", req.RemoteAddr, "reason", err.Error())
+		w.Header().Set("WWW-Authenticate", "Bearer")
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	var notification UFSChangeNotification
+	if err := json.NewDecoder(req.Body).Decode(&notification); err != nil {
+		http.Error(w, fmt.Sprintf("invalid notification: %v", err), http.StatusBadRequest)
+		return
+	}
+	if notification.Namespace == "" || notification.Dataset == "" {
+		http.Error(w, "namespace and dataset must be specified", http.StatusBadRequest)
+		return
+	}
+
+	triggered, err := r.Notify(req.Context(), notification)
+	if err != nil {
+		r.Log.Error(err, "failed to handle ufs change notification", "notification", notification)
+		http.Error(w, "failed to handle the notification", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusAccepted)
+	_ = json.NewEncoder(w).Encode(UFSChangeResponse{Triggered: triggered})
+}
+
+// authenticate checks the bearer token of the request against the token of the receiver.
+func (r *EventReceiver) authenticate(req *http.Request) error {
+	token, err := r.readToken()
+	if err != nil {
+		return err
+	}
+	provided, found := strings.CutPrefix(req.Header.Get("Authorization"), "Bearer ")
+	if !found {
+		return fmt.Errorf("no bearer token")
+	}
+	if subtle.ConstantTimeCompare([]byte(strings.TrimSpace(provided)), []byte(token)) != 1 {
+		return fmt.Errorf("invalid bearer token")
+	}
+	return nil
+}
+
+func (r *EventReceiver) readToken() (string, error) {
+	if r.TokenFile == "" {
+		return "", fmt.Errorf("token file of the event receiver is not specified")
+	}
+	content, err := os.ReadFile(r.TokenFile)
+	if err != nil {
+		return "", fmt.Errorf("failed to read the token of the event receiver: %v", err)
+	}
+	token := strings.TrimSpace(string(content))
+	if token == "" {
+		return "", fmt.Errorf("token of the event receiver is empty")
+	}
+	return token, nil
+}
+
+// Notify marks the data operations subscribing the UFS change, the marked operations run again once their current run finishes.
+func (r *EventReceiver) Notify(ctx context.Context, notification UFSChangeNotification) (triggered []string, err error) {
+	triggered = []string{}
+	revision := time.Now().UTC().Format(time.RFC3339Nano)
+
+	var dataLoads datav1alpha1.DataLoadList
+	if err = r.Client.List(ctx, &dataLoads, client.InNamespace(notification.Namespace)); err != nil {
+		return nil, err
+	}
+	for i := range dataLoads.Items {
+		dataLoad := &dataLoads.Items[i]
+		if dataLoad.Spec.Policy != datav1alpha1.OnEvent || dataLoad.Spec.Dataset.Name != notification.Dataset ||
+			!MatchUFSChangedEvent(dataLoad.Spec.Events, notification.Path) {
+			continue
+		}
+		if err = r.markUFSChanged(ctx, dataLoad, revision); err != nil {
+			return nil, err
+		}
+		triggered = append(triggered, fmt.Sprintf("DataLoad/%s/%s", dataLoad.Namespace, dataLoad.Name))
+	}
+
+	var dataProcesses datav1alpha1.DataProcessList
+	if err = r.Client.List(ctx, &dataProcesses, client.InNamespace(notification.Namespace)); err != nil {
+		return nil, err
+	}
+	for i := range dataProcesses.Items {
+		dataProcess := &dataProcesses.Items[i]
+		if dataProcess.Spec.Policy != datav1alpha1.OnEvent || dataProcess.Spec.Dataset.Name != notification.Dataset ||
+			!MatchUFSChangedEvent(dataProcess.Spec.Events, notification.Path) {
+			continue
+		}
+		if err = r.markUFSChanged(ctx, dataProcess, revision); err != nil {
+			return nil, err
+		}
+		triggered = append(triggered, fmt.Sprintf("DataProcess/%s/%s", dataProcess.Namespace, dataProcess.Name))
+	}
+
+	r.Log.Info("ufs change notification handled", "notification", notification, "triggered", triggered)
+	return triggered, nil
+}
+
+func (r *EventReceiver) markUFSChanged(ctx context.Context, object client.Object, revision string) error {
+	patch := client.MergeFrom(object.DeepCopyObject().(client.Object))
+	annotations := object.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[common.AnnotationDataFlowUFSChanged] = revision
+	object.SetAnnotations(annotations)
+	return r.Client.Patch(ctx, object, patch)
+}
@@ -0,0 +1,189 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dataflow
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+func TestEventReceiverServeHTTP(t *testing.T) {
+	objects := []runtime.Object{
+		newEventTestDataLoad(nil, datav1alpha1.EventSource{Type: datav1alpha1.UFSChangedEvent, PathPrefixes: []string{"/train"}}),
+		&datav1alpha1.DataLoad{
+			ObjectMeta: metav1.ObjectMeta{Name: "once-load", Namespace: "default"},
+			Spec: datav1alpha1.DataLoadSpec{
+				Dataset: datav1alpha1.TargetDataset{Name: "test-dataset", Namespace: "default"},
+				Policy:  datav1alpha1.Once,
+			},
+		},
+		&datav1alpha1.DataProcess{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-process", Namespace: "default"},
+			Spec: datav1alpha1.DataProcessSpec{
+				Dataset: datav1alpha1.TargetDatasetWithMountPath{
+					TargetDataset: datav1alpha1.TargetDataset{Name: "test-dataset", Namespace: "default"},
+				},
+				Policy: datav1alpha1.OnEvent,
+				Events: []datav1alpha1.EventSource{{Type: datav1alpha1.UFSChangedEvent, PathPrefixes: []string{"/eval"}}},
+			},
+		},
+	}
+	testScheme := runtime.NewScheme()
+	_ = datav1alpha1.AddToScheme(testScheme)
+	c := fake.NewFakeClientWithScheme(testScheme, objects...)
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(tokenFile, []byte("test-token\n"), 0600); err != nil {
+		t.Fatalf("failed to write token file: %v", err)
+	}
+	receiver := NewEventReceiver(c, ctrl.Log.WithName("test"), ":0", tokenFile)
+
+	tests := []struct {
+		name       string
+		method     string
+		token      string
+		body       string
+		wantCode   int
+		wantResult []string
+	}{
+		{
+			name:     "method not allowed",
+			method:   http.MethodGet,
+			token:    "test-token",
+			wantCode: http.StatusMethodNotAllowed,
+		},
+		{
+			name:     "no token",
+			method:   http.MethodPost,
+			body:     `{"namespace":"default","dataset":"test-dataset","path":"/train/part-0001"}`,
+			wantCode: http.StatusUnauthorized,
+		},
+		{
+			name:     "invalid token",
+			method:   http.MethodPost,
+			token:    "other-token",
+			body:     `{"namespace":"default","dataset":"test-dataset","path":"/train/part-0001"}`,
+			wantCode: http.StatusUnauthorized,
+		},
+		{
+			name:     "invalid body",
+			token:    "test-token",
+			method:   http.MethodPost,
+			body:     "{",
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "dataset not specified",
+			method:   http.MethodPost,
+			token:    "test-token",
+			body:     `{"namespace":"default"}`,
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:       "trigger dataload",
+			method:     http.MethodPost,
+			token:      "test-token",
+			body:       `{"namespace":"default","dataset":"test-dataset","path":"/train/part-0001"}`,
+			wantCode:   http.StatusAccepted,
+			wantResult: []string{"DataLoad/default/test-load"},
+		},
+		{
+			name:       "trigger dataprocess",
+			method:     http.MethodPost,
+			token:      "test-token",
+			body:       `{"namespace":"default","dataset":"test-dataset","path":"/eval/part-0001"}`,
+			wantCode:   http.StatusAccepted,
+			wantResult: []string{"DataProcess/default/test-process"},
+		},
+		{
+			name:       "no operation matched",
+			method:     http.MethodPost,
+			token:      "test-token",
+			body:       `{"namespace":"default","dataset":"other-dataset","path":"/train"}`,
+			wantCode:   http.StatusAccepted,
+			wantResult: []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, UFSChangedPath, strings.NewReader(tt.body))
+			if tt.token != "" {
+				req.Header.Set("Authorization", "Bearer "+tt.token)
+			}
+			recorder := httptest.NewRecorder()
+			receiver.ServeHTTP(recorder, req)
+			if recorder.Code != tt.wantCode {
+				t.Fatalf("expect status code %d, got %d: %s", tt.wantCode, recorder.Code, recorder.Body.String())
+			}
+			if tt.wantCode != http.StatusAccepted {
+				return
+			}
+			var resp UFSChangeResponse
+			if err := json.Unmarshal(recorder.Body.Bytes(), &resp); err != nil {
+				t.Fatalf("failed to decode response: %v", err)
+			}
+			if strings.Join(resp.Triggered, ",") != strings.Join(tt.wantResult, ",") {
+				t.Errorf("expect triggered %v, got %v", tt.wantResult, resp.Triggered)
+			}
+		})
+	}
+
+	var dataLoad datav1alpha1.DataLoad
+	if err := c.Get(context.TODO(), types.NamespacedName{Namespace: "default", Name: "test-load"}, &dataLoad); err != nil {
+		t.Fatalf("failed to get dataload: %v", err)
+	}
+	if dataLoad.Annotations[common.AnnotationDataFlowUFSChanged] == "" {
+		t.Errorf("expect dataload to be annotated with ufs change")
+	}
+	var onceLoad datav1alpha1.DataLoad
+	if err := c.Get(context.TODO(), types.NamespacedName{Namespace: "default", Name: "once-load"}, &onceLoad); err != nil {
+		t.Fatalf("failed to get dataload: %v", err)
+	}
+	if _, found := onceLoad.Annotations[common.AnnotationDataFlowUFSChanged]; found {
+		t.Errorf("expect dataload with Once policy not to be annotated")
+	}
+}
+
+func TestEventReceiverStartWithoutToken(t *testing.T) {
+	testScheme := runtime.NewScheme()
+	_ = datav1alpha1.AddToScheme(testScheme)
+	c := fake.NewFakeClientWithScheme(testScheme)
+
+	emptyTokenFile := filepath.Join(t.TempDir(), "token")
+	if err := os.WriteFile(emptyTokenFile, []byte("\n"), 0600); err != nil {
+		t.Fatalf("failed to write token file: %v", err)
+	}
+	for _, tokenFile := range []string{"", emptyTokenFile, filepath.Join(t.TempDir(), "not-exist")} {
+		receiver := NewEventReceiver(c, ctrl.Log.WithName("test"), ":0", tokenFile)
+		if err := receiver.Start(context.TODO()); err == nil {
+			t.Errorf("expect event receiver with token file %q to fail to start", tokenFile)
+		}
+	}
+}
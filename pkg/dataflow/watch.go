@@ -0,0 +1,190 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dataflow
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/dataoperation"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/discovery"
+)
+
+var log = ctrl.Log.WithName("dataflow")
+
+// OnEventOperation is a data operation with OnEvent policy and the event sources it subscribes
+type OnEventOperation struct {
+	types.NamespacedName
+	// Dataset is the target dataset of the data operation, in the namespace of the data operation if the namespace is empty
+	Dataset types.NamespacedName
+	Events  []datav1alpha1.EventSource
+}
+
+// ListOnEventOperationsFunc lists the data operations with OnEvent policy in the namespace, or in all namespaces if it's empty
+type ListOnEventOperationsFunc func(ctx context.Context, c client.Client, namespace string) ([]OnEventOperation, error)
+
+// eventSourceOperationKinds are the data operations which can be referred to by OperationCompleted event sources
+var eventSourceOperationKinds = map[string]client.Object{
+	"databackup":  &datav1alpha1.DataBackup{},
+	"dataload":    &datav1alpha1.DataLoad{},
+	"datamigrate": &datav1alpha1.DataMigrate{},
+	"dataprocess": &datav1alpha1.DataProcess{},
+}
+
+// SetupEventSourceWatches makes the controller watch the event sources of its data operations with OnEvent policy,
+// i.e. the mounts of the Datasets and the completion of the data operations. The data operations subscribing an event
+// are enqueued once it happens, so that they don't poll the event sources. UFSChanged events are delivered by the
+// event receiver, which annotates the data operation itself.
+func SetupEventSourceWatches(bld *builder.Builder, c client.Client, list ListOnEventOperationsFunc) *builder.Builder {
+	eventHandler := handler.EnqueueRequestsFromMapFunc(mapEventSourceToOperations(c, list))
+
+	bld.Watches(&datav1alpha1.Dataset{}, eventHandler, builder.WithPredicates(predicate.Funcs{
+		CreateFunc:  func(event.CreateEvent) bool { return false },
+		DeleteFunc:  func(event.DeleteEvent) bool { return false },
+		GenericFunc: func(event.GenericEvent) bool { return false },
+		UpdateFunc:  onDatasetMountsChanged,
+	}))
+
+	for kind, obj := range eventSourceOperationKinds {
+		if !discovery.GetFluidDiscovery().ResourceEnabled(kind) {
+			continue
+		}
+		bld.Watches(obj, eventHandler, builder.WithPredicates(predicate.Funcs{
+			CreateFunc:  func(event.CreateEvent) bool { return false },
+			DeleteFunc:  func(event.DeleteEvent) bool { return false },
+			GenericFunc: func(event.GenericEvent) bool { return false },
+			UpdateFunc:  onOperationCompleted,
+		}))
+	}
+
+	return bld
+}
+
+func onDatasetMountsChanged(e event.UpdateEvent) bool {
+	datasetOld, ok := e.ObjectOld.(*datav1alpha1.Dataset)
+	if !ok {
+		return false
+	}
+	datasetNew, ok := e.ObjectNew.(*datav1alpha1.Dataset)
+	if !ok {
+		return false
+	}
+
+	revisionOld, err := hashMounts(datasetOld.Spec.Mounts)
+	if err != nil {
+		return false
+	}
+	revisionNew, err := hashMounts(datasetNew.Spec.Mounts)
+	if err != nil {
+		return false
+	}
+	return revisionOld != revisionNew
+}
+
+func onOperationCompleted(e event.UpdateEvent) bool {
+	opStatusOld, err := utils.GetOperationStatus(e.ObjectOld)
+	if err != nil || opStatusOld == nil {
+		return false
+	}
+	opStatusNew, err := utils.GetOperationStatus(e.ObjectNew)
+	if err != nil || opStatusNew == nil {
+		return false
+	}
+
+	revisionNew := getOperationCompletedRevision(opStatusNew)
+	return revisionNew != "" && revisionNew != getOperationCompletedRevision(opStatusOld)
+}
+
+func mapEventSourceToOperations(c client.Client, list ListOnEventOperationsFunc) handler.MapFunc {
+	return func(ctx context.Context, obj client.Object) []reconcile.Request {
+		// data operations subscribing the dataset are in the same namespace, while OperationCompleted
+		// event sources may refer to data operations in other namespaces
+		namespace := ""
+		if _, ok := obj.(*datav1alpha1.Dataset); ok {
+			namespace = obj.GetNamespace()
+		}
+
+		operations, err := list(ctx, c, namespace)
+		if err != nil {
+			log.Error(err, "failed to list data operations with OnEvent policy", "namespace", namespace)
+			return nil
+		}
+
+		var requests []reconcile.Request
+		for _, operation := range operations {
+			if subscribesEventOf(operation, obj) {
+				requests = append(requests, reconcile.Request{NamespacedName: operation.NamespacedName})
+			}
+		}
+		return requests
+	}
+}
+
+// subscribesEventOf checks if the data operation subscribes the event of the object
+func subscribesEventOf(operation OnEventOperation, obj client.Object) bool {
+	objKind := getOperationKind(obj)
+	dataset := operation.Dataset
+	if dataset.Namespace == "" {
+		dataset.Namespace = operation.Namespace
+	}
+	for _, source := range operation.Events {
+		switch source.Type {
+		case datav1alpha1.DatasetMountsChangedEvent:
+			if _, ok := obj.(*datav1alpha1.Dataset); ok &&
+				dataset == (types.NamespacedName{Namespace: obj.GetNamespace(), Name: obj.GetName()}) {
+				return true
+			}
+		case datav1alpha1.OperationCompletedEvent:
+			if source.Operation == nil || objKind == "" {
+				continue
+			}
+			opNamespace := source.Operation.Namespace
+			if opNamespace == "" {
+				opNamespace = operation.Namespace
+			}
+			if source.Operation.Kind == objKind && source.Operation.Name == obj.GetName() && opNamespace == obj.GetNamespace() {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func getOperationKind(obj client.Object) string {
+	switch obj.(type) {
+	case *datav1alpha1.DataBackup:
+		return string(dataoperation.DataBackupType)
+	case *datav1alpha1.DataLoad:
+		return string(dataoperation.DataLoadType)
+	case *datav1alpha1.DataMigrate:
+		return string(dataoperation.DataMigrateType)
+	case *datav1alpha1.DataProcess:
+		return string(dataoperation.DataProcessType)
+	default:
+		return ""
+	}
+}
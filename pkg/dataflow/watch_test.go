@@ -0,0 +1,145 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dataflow
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+)
+
+func TestOnDatasetMountsChanged(t *testing.T) {
+	datasetV1 := newEventTestDataset("s3://bucket/v1")
+	datasetV1Updated := datasetV1.DeepCopy()
+	datasetV1Updated.Status.Phase = datav1alpha1.BoundDatasetPhase
+
+	if onDatasetMountsChanged(event.UpdateEvent{ObjectOld: datasetV1, ObjectNew: datasetV1Updated}) {
+		t.Errorf("expect status update of the dataset to be ignored")
+	}
+	if !onDatasetMountsChanged(event.UpdateEvent{ObjectOld: datasetV1, ObjectNew: newEventTestDataset("s3://bucket/v2")}) {
+		t.Errorf("expect mounts change of the dataset to be handled")
+	}
+}
+
+func TestOnOperationCompleted(t *testing.T) {
+	executing := &datav1alpha1.DataProcess{
+		ObjectMeta: metav1.ObjectMeta{Name: "preprocess", Namespace: "default"},
+		Status:     datav1alpha1.OperationStatus{Phase: common.PhaseExecuting},
+	}
+	complete := executing.DeepCopy()
+	complete.Status.Phase = common.PhaseComplete
+	complete.Status.Conditions = []datav1alpha1.Condition{{
+		Type:               common.Complete,
+		LastTransitionTime: metav1.NewTime(time.Date(2026, 10, 18, 2, 0, 41, 0, time.UTC)),
+	}}
+	completeUpdated := complete.DeepCopy()
+	completeUpdated.Status.Duration = "1m"
+
+	tests := []struct {
+		name     string
+		old, new client.Object
+		want     bool
+	}{
+		{name: "operation completes", old: executing, new: complete, want: true},
+		{name: "completed operation updated", old: complete, new: completeUpdated, want: false},
+		{name: "operation still executing", old: executing, new: executing.DeepCopy(), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := onOperationCompleted(event.UpdateEvent{ObjectOld: tt.old, ObjectNew: tt.new}); got != tt.want {
+				t.Errorf("expect %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestMapEventSourceToOperations(t *testing.T) {
+	operations := []OnEventOperation{
+		{
+			NamespacedName: types.NamespacedName{Namespace: "default", Name: "on-mounts"},
+			Dataset:        types.NamespacedName{Namespace: "default", Name: "test-dataset"},
+			Events:         []datav1alpha1.EventSource{{Type: datav1alpha1.DatasetMountsChangedEvent}},
+		},
+		{
+			NamespacedName: types.NamespacedName{Namespace: "default", Name: "on-process"},
+			Dataset:        types.NamespacedName{Name: "test-dataset"},
+			Events: []datav1alpha1.EventSource{{
+				Type:      datav1alpha1.OperationCompletedEvent,
+				Operation: &datav1alpha1.ObjectRef{Kind: "DataProcess", Name: "preprocess"},
+			}},
+		},
+		{
+			NamespacedName: types.NamespacedName{Namespace: "other", Name: "on-ufs"},
+			Dataset:        types.NamespacedName{Namespace: "other", Name: "test-dataset"},
+			Events:         []datav1alpha1.EventSource{{Type: datav1alpha1.UFSChangedEvent}},
+		},
+	}
+	var listedNamespace string
+	list := func(ctx context.Context, c client.Client, namespace string) ([]OnEventOperation, error) {
+		listedNamespace = namespace
+		return operations, nil
+	}
+	mapFunc := mapEventSourceToOperations(nil, list)
+
+	tests := []struct {
+		name          string
+		obj           client.Object
+		wantNamespace string
+		want          []string
+	}{
+		{
+			name:          "dataset mounts changed",
+			obj:           newEventTestDataset("s3://bucket/v2"),
+			wantNamespace: "default",
+			want:          []string{"default/on-mounts"},
+		},
+		{
+			name:          "dataprocess completed",
+			obj:           &datav1alpha1.DataProcess{ObjectMeta: metav1.ObjectMeta{Name: "preprocess", Namespace: "default"}},
+			wantNamespace: "",
+			want:          []string{"default/on-process"},
+		},
+		{
+			name:          "dataload with the same name completed",
+			obj:           &datav1alpha1.DataLoad{ObjectMeta: metav1.ObjectMeta{Name: "preprocess", Namespace: "default"}},
+			wantNamespace: "",
+			want:          nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requests := mapFunc(context.TODO(), tt.obj)
+			if listedNamespace != tt.wantNamespace {
+				t.Errorf("expect to list operations in namespace %q, got %q", tt.wantNamespace, listedNamespace)
+			}
+			var got []string
+			for _, request := range requests {
+				got = append(got, request.String())
+			}
+			if len(got) != len(tt.want) || (len(got) > 0 && got[0] != tt.want[0]) {
+				t.Errorf("expect requests %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dataload
+
+import (
+	"context"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
+)
+
+// TargetNodesSupported checks if the runtime is able to cache the data on the workers of the chosen nodes
+func TargetNodesSupported(runtimeType string) bool {
+	return runtimeType == common.AlluxioRuntime
+}
+
+// ResolveTargetNodes gets the names of the target nodes of the DataLoad, sorted and deduplicated. The pods selected
+// by the pod selector are not scheduled yet when they're gang scheduled, so their nominated nodes are used.
+func ResolveTargetNodes(c client.Reader, dataLoad *datav1alpha1.DataLoad) ([]string, error) {
+	targetNodes := dataLoad.Spec.TargetNodes
+	if targetNodes == nil {
+		return nil, nil
+	}
+
+	nodeNames := append([]string{}, targetNodes.NodeNames...)
+	if targetNodes.PodSelector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(targetNodes.PodSelector)
+		if err != nil {
+			return nil, err
+		}
+		podList := &corev1.PodList{}
+		err = c.List(context.TODO(), podList, client.InNamespace(dataLoad.Namespace), client.MatchingLabelsSelector{Selector: selector})
+		if err != nil {
+			return nil, err
+		}
+		for _, pod := range podList.Items {
+			if kubeclient.IsCompletePod(&pod) {
+				continue
+			}
+			if pod.Spec.NodeName != "" {
+				nodeNames = append(nodeNames, pod.Spec.NodeName)
+			} else if pod.Status.NominatedNodeName != "" {
+				nodeNames = append(nodeNames, pod.Status.NominatedNodeName)
+			}
+		}
+	}
+
+	nodeNames = utils.RemoveDuplicateStr(nodeNames)
+	sort.Strings(nodeNames)
+	return nodeNames, nil
+}
+
+// GetCacheNodes gets the target nodes where the workers of the runtime run, which are labeled with the runtime label
+// by lifecycle.SyncScheduleInfoToCacheNodes. The nodes not found are skipped.
+func GetCacheNodes(c client.Reader, nodeNames []string, runtimeLabel string) ([]corev1.Node, error) {
+	var nodes []corev1.Node
+	for _, name := range nodeNames {
+		node, err := kubeclient.GetNode(c, name)
+		if err != nil {
+			if utils.IgnoreNotFound(err) == nil {
+				continue
+			}
+			return nil, err
+		}
+		if node.Labels[runtimeLabel] == "true" {
+			nodes = append(nodes, *node)
+		}
+	}
+	return nodes, nil
+}
+
+// GetTargetHosts gets the internal IPs of the target nodes where the workers of the runtime run
+func GetTargetHosts(c client.Reader, nodeNames []string, runtimeLabel string) ([]string, error) {
+	nodes, err := GetCacheNodes(c, nodeNames, runtimeLabel)
+	if err != nil {
+		return nil, err
+	}
+
+	var hosts []string
+	for _, node := range nodes {
+		for _, addr := range node.Status.Addresses {
+			if addr.Type == corev1.NodeInternalIP {
+				hosts = append(hosts, addr.Address)
+				break
+			}
+		}
+	}
+	return hosts, nil
+}
+
+// VerifyTargetNodes gets the phases of the target nodes while the data is loaded. The data can only be cached on the
+// nodes where a worker of the runtime runs, which are marked as loaded when the load completes.
+func VerifyTargetNodes(c client.Reader, nodeNames []string, runtimeLabel string) ([]datav1alpha1.TargetNodeStatus, error) {
+	cacheNodes, err := GetCacheNodes(c, nodeNames, runtimeLabel)
+	if err != nil {
+		return nil, err
+	}
+	cached := map[string]bool{}
+	for _, node := range cacheNodes {
+		cached[node.Name] = true
+	}
+
+	statuses := make([]datav1alpha1.TargetNodeStatus, 0, len(nodeNames))
+	for _, name := range nodeNames {
+		phase := datav1alpha1.TargetNodePending
+		if !cached[name] {
+			phase = datav1alpha1.TargetNodeNoCacheWorker
+		}
+		statuses = append(statuses, datav1alpha1.TargetNodeStatus{NodeName: name, Phase: phase})
+	}
+	return statuses, nil
+}
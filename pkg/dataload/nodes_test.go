@@ -0,0 +1,133 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dataload
+
+import (
+	"reflect"
+	"testing"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const testRuntimeLabel = "fluid.io/s-alluxio-default-hbase"
+
+func cacheNode(name, ip string, labeled bool) *corev1.Node {
+	node := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Status: corev1.NodeStatus{
+			Addresses: []corev1.NodeAddress{
+				{Type: corev1.NodeHostName, Address: name},
+				{Type: corev1.NodeInternalIP, Address: ip},
+			},
+		},
+	}
+	if labeled {
+		node.Labels = map[string]string{testRuntimeLabel: "true"}
+	}
+	return node
+}
+
+func trainingPod(name, nodeName, nominatedNodeName string, phase corev1.PodPhase) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default", Labels: map[string]string{"job": "train"}},
+		Spec:       corev1.PodSpec{NodeName: nodeName},
+		Status:     corev1.PodStatus{Phase: phase, NominatedNodeName: nominatedNodeName},
+	}
+}
+
+func TestResolveTargetNodes(t *testing.T) {
+	s := runtime.NewScheme()
+	_ = corev1.AddToScheme(s)
+	c := fake.NewFakeClientWithScheme(s,
+		trainingPod("train-0", "node-b", "", corev1.PodRunning),
+		trainingPod("train-1", "", "node-c", corev1.PodPending),
+		trainingPod("train-2", "", "", corev1.PodPending),
+		trainingPod("train-3", "node-d", "", corev1.PodSucceeded),
+	)
+
+	testCases := map[string]struct {
+		targetNodes *datav1alpha1.DataLoadTargetNodes
+		want        []string
+	}{
+		"no target nodes": {
+			targetNodes: nil,
+			want:        nil,
+		},
+		"node names": {
+			targetNodes: &datav1alpha1.DataLoadTargetNodes{NodeNames: []string{"node-b", "node-a", "node-b"}},
+			want:        []string{"node-a", "node-b"},
+		},
+		"pod selector": {
+			targetNodes: &datav1alpha1.DataLoadTargetNodes{
+				NodeNames:   []string{"node-a"},
+				PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"job": "train"}},
+			},
+			want: []string{"node-a", "node-b", "node-c"},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			dataLoad := &datav1alpha1.DataLoad{
+				ObjectMeta: metav1.ObjectMeta{Name: "warmup", Namespace: "default"},
+				Spec:       datav1alpha1.DataLoadSpec{TargetNodes: tc.targetNodes},
+			}
+			got, err := ResolveTargetNodes(c, dataLoad)
+			if err != nil {
+				t.Fatalf("ResolveTargetNodes() got unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("ResolveTargetNodes() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestGetTargetHosts(t *testing.T) {
+	s := runtime.NewScheme()
+	_ = corev1.AddToScheme(s)
+	c := fake.NewFakeClientWithScheme(s,
+		cacheNode("node-a", "192.168.0.1", true),
+		cacheNode("node-b", "192.168.0.2", false),
+		cacheNode("node-c", "192.168.0.3", true),
+	)
+
+	hosts, err := GetTargetHosts(c, []string{"node-a", "node-b", "node-c", "node-d"}, testRuntimeLabel)
+	if err != nil {
+		t.Fatalf("GetTargetHosts() got unexpected error: %v", err)
+	}
+	if want := []string{"192.168.0.1", "192.168.0.3"}; !reflect.DeepEqual(hosts, want) {
+		t.Errorf("GetTargetHosts() = %v, want %v", hosts, want)
+	}
+
+	statuses, err := VerifyTargetNodes(c, []string{"node-a", "node-b", "node-d"}, testRuntimeLabel)
+	if err != nil {
+		t.Fatalf("VerifyTargetNodes() got unexpected error: %v", err)
+	}
+	want := []datav1alpha1.TargetNodeStatus{
+		{NodeName: "node-a", Phase: datav1alpha1.TargetNodePending},
+		{NodeName: "node-b", Phase: datav1alpha1.TargetNodeNoCacheWorker},
+		{NodeName: "node-d", Phase: datav1alpha1.TargetNodeNoCacheWorker},
+	}
+	if !reflect.DeepEqual(statuses, want) {
+		t.Errorf("VerifyTargetNodes() = %v, want %v", statuses, want)
+	}
+}
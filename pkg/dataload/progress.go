@@ -0,0 +1,233 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dataload
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/cache"
+
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
+)
+
+const (
+	// DataloaderContainerName is the name of the loader container in the DataLoad job pod
+	DataloaderContainerName = "dataloader"
+
+	// DefaultProgressFile is the file where the loader container reports its progress
+	DefaultProgressFile = "/tmp/fluid-dataload-progress.json"
+
+	readProgressTimeout = 5 * time.Second
+
+	// readProgressInterval is the min interval to read the progress from the same loader pod
+	readProgressInterval = 30 * time.Second
+	// maxProgressReaders is the max number of the loader pods whose last read is remembered
+	maxProgressReaders = 1024
+)
+
+// progressReads remembers the loader pods whose progress is read recently, to rate limit the execs into them
+var progressReads = cache.NewLRUExpireCache(maxProgressReaders)
+
+// Keys of the progress reported in DataLoad's OperationStatus.Infos
+const (
+	InfoFilesLoaded         = "FilesLoaded"
+	InfoFilesTotal          = "FilesTotal"
+	InfoBytesLoaded         = "BytesLoaded"
+	InfoBytesTotal          = "BytesTotal"
+	InfoProgress            = "Progress"
+	InfoTargetsCompleted    = "TargetsCompleted"
+	InfoTargetProgress      = "TargetProgress"
+	InfoLastProgressTime    = "LastProgressTime"
+	InfoEstimatedFinishTime = "EstimatedFinishTime"
+)
+
+// Progress is the progress reported by the loader container, which is written as json into the progress file.
+// Timestamps are unix seconds. The totals are optional, and are 0 if the loader can't count them before loading.
+type Progress struct {
+	FilesLoaded int64            `json:"filesLoaded"`
+	FilesTotal  int64            `json:"filesTotal,omitempty"`
+	BytesLoaded int64            `json:"bytesLoaded"`
+	BytesTotal  int64            `json:"bytesTotal,omitempty"`
+	Targets     []TargetProgress `json:"targets,omitempty"`
+	StartTime   int64            `json:"startTime,omitempty"`
+	UpdateTime  int64            `json:"updateTime,omitempty"`
+}
+
+// TargetProgress is the progress of a single target path of the DataLoad
+type TargetProgress struct {
+	Path        string `json:"path"`
+	FilesLoaded int64  `json:"filesLoaded"`
+	FilesTotal  int64  `json:"filesTotal,omitempty"`
+	BytesLoaded int64  `json:"bytesLoaded"`
+	BytesTotal  int64  `json:"bytesTotal,omitempty"`
+	Completed   bool   `json:"completed,omitempty"`
+}
+
+// ParseProgress parses the content of the progress file
+func ParseProgress(data []byte) (*Progress, error) {
+	progress := &Progress{}
+	if err := json.Unmarshal(data, progress); err != nil {
+		return nil, errors.Wrap(err, "failed to parse dataload progress")
+	}
+	return progress, nil
+}
+
+// SupportsProgress checks if the loader of the runtime reports the progress
+func SupportsProgress(runtimeType string) bool {
+	return runtimeType == common.AlluxioRuntime || runtimeType == common.JuiceFSRuntime
+}
+
+// AllowReadProgress checks if the progress of the loader pod can be read now, at most once every readProgressInterval.
+func AllowReadProgress(podUID types.UID) bool {
+	if _, found := progressReads.Get(podUID); found {
+		return false
+	}
+	progressReads.Add(podUID, struct{}{}, readProgressInterval)
+	return true
+}
+
+// ReadProgress reads the progress file from the loader container of the given pod.
+// It returns nil with no error if the loader has not reported any progress yet.
+func ReadProgress(podName, namespace string) (*Progress, error) {
+	// "|| true" tolerates the progress file not being written yet
+	cmd := []string{"sh", "-c", fmt.Sprintf("cat %s 2>/dev/null || true", DefaultProgressFile)}
+	stdout, stderr, err := kubeclient.ExecCommandInContainerWithTimeout(podName, DataloaderContainerName, namespace, cmd, readProgressTimeout)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read dataload progress from pod %s/%s, stderr: %s", namespace, podName, stderr)
+	}
+	if len(strings.TrimSpace(stdout)) == 0 {
+		return nil, nil
+	}
+	return ParseProgress([]byte(stdout))
+}
+
+// Ratio returns the completed ratio between 0 and 1 by bytes, or by files if the total bytes are unknown.
+// It returns false if neither of the totals is known.
+func (p *Progress) Ratio() (float64, bool) {
+	var ratio float64
+	switch {
+	case p.BytesTotal > 0:
+		ratio = float64(p.BytesLoaded) / float64(p.BytesTotal)
+	case p.FilesTotal > 0:
+		ratio = float64(p.FilesLoaded) / float64(p.FilesTotal)
+	default:
+		return 0, false
+	}
+	return min(ratio, 1), true
+}
+
+// EstimatedFinishTime estimates when the loading finishes from the average loading rate since it started.
+// It returns false if there is no progress to estimate with.
+func (p *Progress) EstimatedFinishTime() (time.Time, bool) {
+	ratio, ok := p.Ratio()
+	if !ok || ratio <= 0 || p.StartTime <= 0 || p.UpdateTime <= p.StartTime {
+		return time.Time{}, false
+	}
+	elapsed := float64(p.UpdateTime - p.StartTime)
+	remaining := elapsed * (1 - ratio) / ratio
+	return time.Unix(p.UpdateTime, 0).Add(time.Duration(remaining * float64(time.Second))), true
+}
+
+// CompletedTargets returns the number of the target paths which are completely loaded
+func (p *Progress) CompletedTargets() (completed int) {
+	for _, target := range p.Targets {
+		if target.Completed {
+			completed++
+		}
+	}
+	return
+}
+
+// UpdateInfos sets the progress into the infos of the OperationStatus
+func (p *Progress) UpdateInfos(infos map[string]string) {
+	infos[InfoFilesLoaded] = strconv.FormatInt(p.FilesLoaded, 10)
+	infos[InfoBytesLoaded] = strconv.FormatInt(p.BytesLoaded, 10)
+	if p.FilesTotal > 0 {
+		infos[InfoFilesTotal] = strconv.FormatInt(p.FilesTotal, 10)
+	}
+	if p.BytesTotal > 0 {
+		infos[InfoBytesTotal] = strconv.FormatInt(p.BytesTotal, 10)
+	}
+	if ratio, ok := p.Ratio(); ok {
+		infos[InfoProgress] = formatRatio(ratio)
+	}
+
+	if len(p.Targets) > 0 {
+		infos[InfoTargetsCompleted] = fmt.Sprintf("%d/%d", p.CompletedTargets(), len(p.Targets))
+		targets := make([]string, 0, len(p.Targets))
+		for _, target := range p.Targets {
+			targets = append(targets, fmt.Sprintf("%s=%s", target.Path, target.progress()))
+		}
+		infos[InfoTargetProgress] = strings.Join(targets, ",")
+	}
+
+	if p.UpdateTime > 0 {
+		infos[InfoLastProgressTime] = time.Unix(p.UpdateTime, 0).UTC().Format(time.RFC3339)
+	}
+	if finishTime, ok := p.EstimatedFinishTime(); ok {
+		infos[InfoEstimatedFinishTime] = finishTime.UTC().Format(time.RFC3339)
+	}
+}
+
+// ResetInfos removes the progress of the previous run from the infos of the OperationStatus
+func ResetInfos(infos map[string]string) {
+	for _, key := range []string{InfoFilesLoaded, InfoFilesTotal, InfoBytesLoaded, InfoBytesTotal, InfoProgress,
+		InfoTargetsCompleted, InfoTargetProgress, InfoLastProgressTime, InfoEstimatedFinishTime} {
+		delete(infos, key)
+	}
+}
+
+// CompleteInfos marks the progress in the infos of the OperationStatus as completed
+func CompleteInfos(infos map[string]string) {
+	if _, found := infos[InfoProgress]; found {
+		infos[InfoProgress] = formatRatio(1)
+	}
+	if total, found := infos[InfoFilesTotal]; found {
+		infos[InfoFilesLoaded] = total
+	}
+	if total, found := infos[InfoBytesTotal]; found {
+		infos[InfoBytesLoaded] = total
+	}
+	delete(infos, InfoEstimatedFinishTime)
+}
+
+func (t TargetProgress) progress() string {
+	switch {
+	case t.Completed:
+		return formatRatio(1)
+	case t.BytesTotal > 0:
+		return formatRatio(float64(t.BytesLoaded) / float64(t.BytesTotal))
+	case t.FilesTotal > 0:
+		return formatRatio(float64(t.FilesLoaded) / float64(t.FilesTotal))
+	default:
+		return "unknown"
+	}
+}
+
+func formatRatio(ratio float64) string {
+	if ratio > 1 {
+		ratio = 1
+	}
+	return strconv.FormatFloat(ratio*100, 'f', 2, 64) + "%"
+}
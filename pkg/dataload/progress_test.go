@@ -0,0 +1,164 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dataload
+
+import (
+	"testing"
+	"time"
+
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestParseProgress(t *testing.T) {
+	data := `{"filesLoaded":10,"filesTotal":40,"bytesLoaded":1000,"bytesTotal":4000,
+		"targets":[{"path":"/a","filesLoaded":10,"filesTotal":10,"bytesLoaded":1000,"bytesTotal":1000,"completed":true},
+		{"path":"/b","filesLoaded":0,"filesTotal":30,"bytesLoaded":0,"bytesTotal":3000}],
+		"startTime":1700000000,"updateTime":1700000100}`
+
+	progress, err := ParseProgress([]byte(data))
+	if err != nil {
+		t.Fatalf("failed to parse progress: %v", err)
+	}
+	if ratio, ok := progress.Ratio(); !ok || ratio != 0.25 {
+		t.Errorf("expect ratio 0.25, got %v, %v", ratio, ok)
+	}
+	if completed := progress.CompletedTargets(); completed != 1 {
+		t.Errorf("expect 1 completed target, got %d", completed)
+	}
+	// a quarter is loaded in 100s, so the left is expected to be loaded in 300s
+	finishTime, ok := progress.EstimatedFinishTime()
+	if !ok || !finishTime.Equal(time.Unix(1700000400, 0)) {
+		t.Errorf("expect estimated finish time %v, got %v, %v", time.Unix(1700000400, 0), finishTime, ok)
+	}
+
+	if _, err = ParseProgress([]byte("not json")); err == nil {
+		t.Errorf("expect error when parsing invalid progress")
+	}
+}
+
+func TestRatio(t *testing.T) {
+	tests := []struct {
+		name     string
+		progress Progress
+		want     float64
+		wantOk   bool
+	}{
+		{name: "by bytes", progress: Progress{BytesLoaded: 1, BytesTotal: 2, FilesLoaded: 1, FilesTotal: 4}, want: 0.5, wantOk: true},
+		{name: "by files", progress: Progress{FilesLoaded: 1, FilesTotal: 4}, want: 0.25, wantOk: true},
+		{name: "unknown totals", progress: Progress{FilesLoaded: 1, BytesLoaded: 1}, want: 0, wantOk: false},
+		{name: "more than total", progress: Progress{BytesLoaded: 3, BytesTotal: 2}, want: 1, wantOk: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := tt.progress.Ratio()
+			if got != tt.want || ok != tt.wantOk {
+				t.Errorf("Ratio() = %v, %v, want %v, %v", got, ok, tt.want, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestUpdateInfos(t *testing.T) {
+	progress := &Progress{
+		FilesLoaded: 10,
+		FilesTotal:  40,
+		BytesLoaded: 1000,
+		BytesTotal:  4000,
+		Targets: []TargetProgress{
+			{Path: "/a", Completed: true},
+			{Path: "/b", BytesLoaded: 0, BytesTotal: 3000},
+			{Path: "/c"},
+		},
+		StartTime:  1700000000,
+		UpdateTime: 1700000100,
+	}
+
+	infos := map[string]string{}
+	progress.UpdateInfos(infos)
+	expected := map[string]string{
+		InfoFilesLoaded:         "10",
+		InfoFilesTotal:          "40",
+		InfoBytesLoaded:         "1000",
+		InfoBytesTotal:          "4000",
+		InfoProgress:            "25.00%",
+		InfoTargetsCompleted:    "1/3",
+		InfoTargetProgress:      "/a=100.00%,/b=0.00%,/c=unknown",
+		InfoLastProgressTime:    "2023-11-14T22:15:00Z",
+		InfoEstimatedFinishTime: "2023-11-14T22:20:00Z",
+	}
+	for key, value := range expected {
+		if infos[key] != value {
+			t.Errorf("expect infos[%s] = %s, got %s", key, value, infos[key])
+		}
+	}
+
+	CompleteInfos(infos)
+	if infos[InfoProgress] != "100.00%" || infos[InfoFilesLoaded] != "40" || infos[InfoBytesLoaded] != "4000" {
+		t.Errorf("expect progress to be completed, got %v", infos)
+	}
+	if _, found := infos[InfoEstimatedFinishTime]; found {
+		t.Errorf("expect estimated finish time to be removed, got %v", infos)
+	}
+
+	// loaders that report no totals
+	infos = map[string]string{}
+	(&Progress{FilesLoaded: 3}).UpdateInfos(infos)
+	if _, found := infos[InfoProgress]; found {
+		t.Errorf("expect no progress without totals, got %v", infos)
+	}
+	CompleteInfos(infos)
+	if _, found := infos[InfoProgress]; found || infos[InfoFilesLoaded] != "3" {
+		t.Errorf("expect infos unchanged when completed without totals, got %v", infos)
+	}
+}
+
+func TestResetInfos(t *testing.T) {
+	infos := map[string]string{"custom": "value"}
+	(&Progress{FilesLoaded: 10, FilesTotal: 40, Targets: []TargetProgress{{Path: "/a"}}, UpdateTime: 1700000100}).UpdateInfos(infos)
+
+	ResetInfos(infos)
+	if len(infos) != 1 || infos["custom"] != "value" {
+		t.Errorf("expect only the progress to be removed, got %v", infos)
+	}
+}
+
+func TestSupportsProgress(t *testing.T) {
+	for runtimeType, want := range map[string]bool{
+		common.AlluxioRuntime: true,
+		common.JuiceFSRuntime: true,
+		common.JindoRuntime:   false,
+		"":                    false,
+	} {
+		if got := SupportsProgress(runtimeType); got != want {
+			t.Errorf("expect SupportsProgress(%q) = %v, got %v", runtimeType, want, got)
+		}
+	}
+}
+
+func TestAllowReadProgress(t *testing.T) {
+	podUID := types.UID("test-allow-read-progress")
+	if !AllowReadProgress(podUID) {
+		t.Errorf("expect the first read to be allowed")
+	}
+	if AllowReadProgress(podUID) {
+		t.Errorf("expect the read within the interval to be rejected")
+	}
+	if !AllowReadProgress(types.UID("test-allow-read-progress-other")) {
+		t.Errorf("expect the read from another pod to be allowed")
+	}
+}
@@ -49,6 +49,9 @@ type DataLoadInfo struct {
 	// TargetPaths specifies which paths should the DataLoad load
 	TargetPaths []TargetPath `json:"targetPaths,omitempty"`
 
+	// TargetHosts specifies the hosts of the workers where the target paths should be cached
+	TargetHosts []string `json:"targetHosts,omitempty"`
+
 	// Image specifies the image that the DataLoad job uses
 	Image string `json:"image,omitempty"`
 
@@ -93,6 +93,23 @@ type DataMigrateInfo struct {
 
 	// ParallelOptions used when Parallelism is greater than 1.
 	ParallelOptions ParallelOptions `json:"parallelOptions,omitempty"`
+
+	// Resume specifies how to skip the files migrated by the previous attempts.
+	Resume ResumeOptions `json:"resume,omitempty"`
+}
+
+type ResumeOptions struct {
+	// Enabled specifies whether to skip the files already migrated and verified by the previous attempts
+	Enabled bool `json:"enabled,omitempty"`
+
+	// VerifyMode specifies how the files already migrated are verified, including SizeAndMtime and Checksum
+	VerifyMode string `json:"verifyMode,omitempty"`
+
+	// ManifestName specifies the name of the manifest recording the files migrated and verified
+	ManifestName string `json:"manifestName,omitempty"`
+
+	// ManifestVolumeClaim specifies the PersistentVolumeClaim keeping the manifest
+	ManifestVolumeClaim string `json:"manifestVolumeClaim,omitempty"`
 }
 
 type ParallelOptions struct {
@@ -73,6 +73,9 @@ type OperationInterface interface {
 	// GetTTL gets timeToLive
 	GetTTL() (ttl *int32, err error)
 
+	// GetRetryPolicy gets the retry policy of the data operation, nil means the failed data operation is not retried
+	GetRetryPolicy() *datav1alpha1.RetryPolicy
+
 	// GetParallelTaskNumber get the parallel tasks for data operations.
 	GetParallelTaskNumber() int32
 }
@@ -74,6 +74,11 @@ func (m mockDataloadOperationReconciler) GetTTL() (ttl *int32, err error) {
 	return m.TTLSecondsAfterFinished, err
 }
 
+// GetRetryPolicy implements OperationInterface.
+func (mockDataloadOperationReconciler) GetRetryPolicy() *datav1alpha1.RetryPolicy {
+	return nil
+}
+
 func (r *mockDataloadOperationReconciler) GetParallelTaskNumber() int32 {
 	return 1
 }
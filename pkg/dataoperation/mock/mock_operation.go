@@ -205,6 +205,20 @@ func (mr *MockOperationInterfaceMockRecorder) GetTTL() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTTL", reflect.TypeOf((*MockOperationInterface)(nil).GetTTL))
 }
 
+// GetRetryPolicy mocks base method.
+func (m *MockOperationInterface) GetRetryPolicy() *v1alpha1.RetryPolicy {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRetryPolicy")
+	ret0, _ := ret[0].(*v1alpha1.RetryPolicy)
+	return ret0
+}
+
+// GetRetryPolicy indicates an expected call of GetRetryPolicy.
+func (mr *MockOperationInterfaceMockRecorder) GetRetryPolicy() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRetryPolicy", reflect.TypeOf((*MockOperationInterface)(nil).GetRetryPolicy))
+}
+
 // GetTargetDataset mocks base method.
 func (m *MockOperationInterface) GetTargetDataset() (*v1alpha1.Dataset, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,75 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dataoperation
+
+import (
+	"slices"
+	"time"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+)
+
+const (
+	defaultRetryInitialDelaySeconds int32 = 10
+	defaultRetryFactor              int32 = 2
+	defaultRetryMaxDelaySeconds     int32 = 300
+)
+
+// GetRetryDelay returns the delay before retrying the failed attempt, the delay grows exponentially with the
+// number of the failed attempts and is capped by the max delay of the backoff.
+func GetRetryDelay(policy *datav1alpha1.RetryPolicy, failedAttempts int32) time.Duration {
+	initialDelay, factor, maxDelay := defaultRetryInitialDelaySeconds, defaultRetryFactor, defaultRetryMaxDelaySeconds
+	if backoff := policy.Backoff; backoff != nil {
+		if backoff.InitialDelaySeconds != nil {
+			initialDelay = *backoff.InitialDelaySeconds
+		}
+		if backoff.Factor != nil && *backoff.Factor >= 1 {
+			factor = *backoff.Factor
+		}
+		if backoff.MaxDelaySeconds != nil {
+			maxDelay = *backoff.MaxDelaySeconds
+		}
+	}
+
+	delay := time.Duration(initialDelay) * time.Second
+	limit := time.Duration(maxDelay) * time.Second
+	for i := int32(1); i < failedAttempts && delay < limit; i++ {
+		delay *= time.Duration(factor)
+	}
+	if delay > limit {
+		delay = limit
+	}
+	return delay
+}
+
+// IsRetryable checks if a failed attempt with the exit codes and the reasons should be retried by the retry policy.
+func IsRetryable(policy *datav1alpha1.RetryPolicy, exitCodes []int32, reasons []string) bool {
+	if len(policy.RetryOnExitCodes) == 0 && len(policy.RetryOnReasons) == 0 {
+		return true
+	}
+	for _, exitCode := range exitCodes {
+		if slices.Contains(policy.RetryOnExitCodes, exitCode) {
+			return true
+		}
+	}
+	for _, reason := range reasons {
+		if slices.Contains(policy.RetryOnReasons, reason) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dataoperation
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+)
+
+var _ = Describe("GetRetryDelay", func() {
+	DescribeTable("getting the delay before retrying",
+		func(backoff *datav1alpha1.RetryBackoff, failedAttempts int32, expected time.Duration) {
+			policy := &datav1alpha1.RetryPolicy{MaxAttempts: 10, Backoff: backoff}
+			Expect(GetRetryDelay(policy, failedAttempts)).To(Equal(expected))
+		},
+		Entry("default initial delay", nil, int32(1), 10*time.Second),
+		Entry("default factor", nil, int32(3), 40*time.Second),
+		Entry("default max delay", nil, int32(10), 300*time.Second),
+		Entry("custom backoff",
+			&datav1alpha1.RetryBackoff{InitialDelaySeconds: ptr.To[int32](5), Factor: ptr.To[int32](3), MaxDelaySeconds: ptr.To[int32](60)},
+			int32(3), 45*time.Second),
+		Entry("custom max delay",
+			&datav1alpha1.RetryBackoff{InitialDelaySeconds: ptr.To[int32](5), Factor: ptr.To[int32](3), MaxDelaySeconds: ptr.To[int32](60)},
+			int32(4), 60*time.Second),
+		Entry("constant delay", &datav1alpha1.RetryBackoff{Factor: ptr.To[int32](1)}, int32(5), 10*time.Second),
+		Entry("no delay", &datav1alpha1.RetryBackoff{InitialDelaySeconds: ptr.To[int32](0)}, int32(5), time.Duration(0)),
+	)
+})
+
+var _ = Describe("IsRetryable", func() {
+	DescribeTable("checking if a failed attempt is retried",
+		func(exitCodes []int32, reasons []string, failedExitCodes []int32, failedReasons []string, expected bool) {
+			policy := &datav1alpha1.RetryPolicy{MaxAttempts: 3, RetryOnExitCodes: exitCodes, RetryOnReasons: reasons}
+			Expect(IsRetryable(policy, failedExitCodes, failedReasons)).To(Equal(expected))
+		},
+		Entry("retry all failures", nil, nil, []int32{1}, []string{"BackoffLimitExceeded"}, true),
+		Entry("matching exit code", []int32{1, 137}, nil, []int32{137}, []string{"OOMKilled"}, true),
+		Entry("matching reason", []int32{2}, []string{"DeadlineExceeded"}, []int32{1}, []string{"DeadlineExceeded"}, true),
+		Entry("not matching", []int32{2}, []string{"DeadlineExceeded"}, []int32{1}, []string{"BackoffLimitExceeded", "Error"}, false),
+		Entry("no exit code", []int32{1}, nil, nil, []string{"BackoffLimitExceeded"}, false),
+	)
+})
@@ -0,0 +1,56 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dataoperation
+
+import (
+	"github.com/robfig/cron/v3"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+)
+
+// ValidateSchedule checks that the schedule of a data operation with the Cron policy is set and is in the standard
+// cron format, which is the one accepted by the CronJobs running the operation.
+func ValidateSchedule(policy datav1alpha1.Policy, schedule string, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if policy != datav1alpha1.Cron {
+		return allErrs
+	}
+	if len(schedule) == 0 {
+		return append(allErrs, field.Required(fldPath, "schedule must be set when policy is Cron"))
+	}
+	if _, err := cron.ParseStandard(schedule); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath, schedule, err.Error()))
+	}
+	return allErrs
+}
+
+// ValidateRetryPolicy checks that the retry policy is only set on a data operation with the Once policy, the
+// operations run by CronJobs are retried at the next schedule instead.
+func ValidateRetryPolicy(policy datav1alpha1.Policy, retryPolicy *datav1alpha1.RetryPolicy, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if retryPolicy == nil {
+		return allErrs
+	}
+	if policy != "" && policy != datav1alpha1.Once {
+		return append(allErrs, field.Forbidden(fldPath, "retryPolicy can only be set when policy is Once"))
+	}
+	if retryPolicy.MaxAttempts < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxAttempts"), retryPolicy.MaxAttempts, "must be greater than or equal to 1"))
+	}
+	return allErrs
+}
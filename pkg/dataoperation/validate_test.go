@@ -0,0 +1,71 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dataoperation
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+)
+
+var _ = Describe("ValidateSchedule", func() {
+	fldPath := field.NewPath("spec").Child("schedule")
+
+	DescribeTable("validating schedule",
+		func(policy datav1alpha1.Policy, schedule string, expectedType field.ErrorType) {
+			errs := ValidateSchedule(policy, schedule, fldPath)
+			if expectedType == "" {
+				Expect(errs).To(BeEmpty())
+				return
+			}
+			Expect(errs).To(HaveLen(1))
+			Expect(errs[0].Type).To(Equal(expectedType))
+			Expect(errs[0].Field).To(Equal("spec.schedule"))
+		},
+		Entry("once policy ignores schedule", datav1alpha1.Once, "not a cron", field.ErrorType("")),
+		Entry("valid cron schedule", datav1alpha1.Cron, "*/5 * * * *", field.ErrorType("")),
+		Entry("cron descriptor", datav1alpha1.Cron, "@hourly", field.ErrorType("")),
+		Entry("missing schedule", datav1alpha1.Cron, "", field.ErrorTypeRequired),
+		Entry("invalid schedule", datav1alpha1.Cron, "61 * * * *", field.ErrorTypeInvalid),
+		Entry("schedule with seconds", datav1alpha1.Cron, "0 */5 * * * *", field.ErrorTypeInvalid),
+	)
+})
+
+var _ = Describe("ValidateRetryPolicy", func() {
+	fldPath := field.NewPath("spec").Child("retryPolicy")
+
+	DescribeTable("validating retry policy",
+		func(policy datav1alpha1.Policy, retryPolicy *datav1alpha1.RetryPolicy, expectedType field.ErrorType, expectedField string) {
+			errs := ValidateRetryPolicy(policy, retryPolicy, fldPath)
+			if expectedType == "" {
+				Expect(errs).To(BeEmpty())
+				return
+			}
+			Expect(errs).To(HaveLen(1))
+			Expect(errs[0].Type).To(Equal(expectedType))
+			Expect(errs[0].Field).To(Equal(expectedField))
+		},
+		Entry("no retry policy", datav1alpha1.Cron, nil, field.ErrorType(""), ""),
+		Entry("once policy", datav1alpha1.Once, &datav1alpha1.RetryPolicy{MaxAttempts: 3}, field.ErrorType(""), ""),
+		Entry("default policy", datav1alpha1.Policy(""), &datav1alpha1.RetryPolicy{MaxAttempts: 3}, field.ErrorType(""), ""),
+		Entry("cron policy", datav1alpha1.Cron, &datav1alpha1.RetryPolicy{MaxAttempts: 3}, field.ErrorTypeForbidden, "spec.retryPolicy"),
+		Entry("on event policy", datav1alpha1.OnEvent, &datav1alpha1.RetryPolicy{MaxAttempts: 3}, field.ErrorTypeForbidden, "spec.retryPolicy"),
+		Entry("no attempts", datav1alpha1.Once, &datav1alpha1.RetryPolicy{}, field.ErrorTypeInvalid, "spec.retryPolicy.maxAttempts"),
+	)
+})
@@ -0,0 +1,224 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datasetquota
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// TieredStoreCapacity returns the cache capacity of a single worker with the given tiered store,
+// which is the sum of the quotas of all the levels.
+func TieredStoreCapacity(tieredStore datav1alpha1.TieredStore) (capacity int64, err error) {
+	for _, level := range tieredStore.Levels {
+		if level.Quota != nil {
+			capacity += level.Quota.Value()
+			continue
+		}
+		if len(level.QuotaList) == 0 {
+			continue
+		}
+		for _, str := range strings.Split(level.QuotaList, ",") {
+			quota, err := resource.ParseQuantity(strings.TrimSpace(str))
+			if err != nil {
+				return 0, fmt.Errorf("failed to parse quota %q in quotaList %q: %v", str, level.QuotaList, err)
+			}
+			capacity += quota.Value()
+		}
+	}
+	return
+}
+
+// CacheSpec returns the worker replicas and the tiered store of the runtime object. supported is false if
+// the runtime does not declare its cache capacity in a tiered store (e.g. CacheRuntime).
+func CacheSpec(runtime client.Object) (replicas int32, tieredStore datav1alpha1.TieredStore, supported bool) {
+	switch r := runtime.(type) {
+	case *datav1alpha1.AlluxioRuntime:
+		return r.Replicas(), r.Spec.TieredStore, true
+	case *datav1alpha1.JindoRuntime:
+		return r.Replicas(), r.Spec.TieredStore, true
+	case *datav1alpha1.JuiceFSRuntime:
+		return r.Replicas(), r.Spec.TieredStore, true
+	case *datav1alpha1.ThinRuntime:
+		return r.Replicas(), r.Spec.TieredStore, true
+	case *datav1alpha1.EFCRuntime:
+		return r.Replicas(), r.Spec.TieredStore, true
+	case *datav1alpha1.VineyardRuntime:
+		return r.Replicas(), r.Spec.TieredStore, true
+	}
+	return 0, datav1alpha1.TieredStore{}, false
+}
+
+// RuntimeTieredStoreCapacity returns the cache capacity of a single worker of CacheRuntime with the given
+// tiered store, which is the sum of the quotas of all the levels.
+func RuntimeTieredStoreCapacity(tieredStore datav1alpha1.RuntimeTieredStore) (capacity int64) {
+	for _, level := range tieredStore.Levels {
+		switch {
+		case level.ProcessMemory != nil:
+			capacity += level.ProcessMemory.Quota.Value()
+		case level.EmptyDir != nil:
+			capacity += level.EmptyDir.Quota.Value()
+		case level.HostPath != nil:
+			for _, quota := range level.HostPath.Quotas {
+				capacity += quota.Value()
+			}
+		}
+	}
+	return
+}
+
+// WorkerCapacity returns the worker replicas of the runtime object and the cache capacity of a single worker.
+// supported is false if the runtime type is unknown.
+func WorkerCapacity(runtime client.Object) (replicas int32, capacity int64, supported bool, err error) {
+	if cacheRuntime, ok := runtime.(*datav1alpha1.CacheRuntime); ok {
+		return cacheRuntime.Spec.Worker.Replicas, RuntimeTieredStoreCapacity(cacheRuntime.Spec.Worker.TieredStore), true, nil
+	}
+
+	replicas, tieredStore, supported := CacheSpec(runtime)
+	if !supported {
+		return 0, 0, false, nil
+	}
+	capacity, err = TieredStoreCapacity(tieredStore)
+	return replicas, capacity, true, err
+}
+
+// RequestedCapacity returns the cache capacity requested by the runtime object, which is the worker replicas
+// multiplied by the capacity of a single worker.
+func RequestedCapacity(runtime client.Object) (requested int64, supported bool, err error) {
+	replicas, capacity, supported, err := WorkerCapacity(runtime)
+	if !supported || err != nil {
+		return 0, supported, err
+	}
+	return int64(replicas) * capacity, true, nil
+}
+
+// runtimeKinds are the runtimes whose cache capacity is limited by quotas, by the runtime types.
+var runtimeKinds = []struct {
+	runtimeType string
+	newObject   func() client.Object
+	newList     func() client.ObjectList
+}{
+	{common.AlluxioRuntime, func() client.Object { return &datav1alpha1.AlluxioRuntime{} }, func() client.ObjectList { return &datav1alpha1.AlluxioRuntimeList{} }},
+	{common.JindoRuntime, func() client.Object { return &datav1alpha1.JindoRuntime{} }, func() client.ObjectList { return &datav1alpha1.JindoRuntimeList{} }},
+	{common.JuiceFSRuntime, func() client.Object { return &datav1alpha1.JuiceFSRuntime{} }, func() client.ObjectList { return &datav1alpha1.JuiceFSRuntimeList{} }},
+	{common.ThinRuntime, func() client.Object { return &datav1alpha1.ThinRuntime{} }, func() client.ObjectList { return &datav1alpha1.ThinRuntimeList{} }},
+	{common.EFCRuntime, func() client.Object { return &datav1alpha1.EFCRuntime{} }, func() client.ObjectList { return &datav1alpha1.EFCRuntimeList{} }},
+	{common.VineyardRuntime, func() client.Object { return &datav1alpha1.VineyardRuntime{} }, func() client.ObjectList { return &datav1alpha1.VineyardRuntimeList{} }},
+	{common.CacheRuntime, func() client.Object { return &datav1alpha1.CacheRuntime{} }, func() client.ObjectList { return &datav1alpha1.CacheRuntimeList{} }},
+}
+
+// GetRuntime gets the runtime object of the dataset, i.e. the runtime with the same name and namespace. The runtime
+// requests its cache capacity once it's created, so it's found by its name even if the dataset is not bound yet.
+// It returns a nil object if no runtime is found.
+func GetRuntime(reader client.Reader, dataset *datav1alpha1.Dataset) (runtime client.Object, runtimeType string, err error) {
+	boundType := ""
+	if len(dataset.Status.Runtimes) > 0 {
+		boundType = dataset.Status.Runtimes[0].Type
+	}
+
+	key := types.NamespacedName{Name: dataset.Name, Namespace: dataset.Namespace}
+	for _, kind := range runtimeKinds {
+		if len(boundType) > 0 && kind.runtimeType != boundType {
+			continue
+		}
+		runtime = kind.newObject()
+		if err = reader.Get(context.TODO(), key, runtime); err != nil {
+			// the CRDs of some runtimes may not be installed
+			if utils.IgnoreNotFound(err) != nil && !apimeta.IsNoMatchError(err) {
+				return nil, kind.runtimeType, err
+			}
+			continue
+		}
+		return runtime, kind.runtimeType, nil
+	}
+	return nil, boundType, nil
+}
+
+// ListRuntimes lists the runtimes of all the types in the namespace, whether their datasets exist or not.
+func ListRuntimes(reader client.Reader, namespace string) (runtimes []client.Object, err error) {
+	for _, kind := range runtimeKinds {
+		list := kind.newList()
+		if err = reader.List(context.TODO(), list, client.InNamespace(namespace)); err != nil {
+			if apimeta.IsNoMatchError(err) {
+				continue
+			}
+			return nil, err
+		}
+		objs, err := apimeta.ExtractList(list)
+		if err != nil {
+			return nil, err
+		}
+		for _, obj := range objs {
+			if runtime, ok := obj.(client.Object); ok {
+				runtimes = append(runtimes, runtime)
+			}
+		}
+	}
+	return runtimes, nil
+}
+
+// Selects checks if the quota applies to a dataset with the given labels.
+func Selects(quota *datav1alpha1.DatasetQuota, datasetLabels map[string]string) (bool, error) {
+	if quota.Spec.Selector == nil {
+		return true, nil
+	}
+	selector, err := metav1.LabelSelectorAsSelector(quota.Spec.Selector)
+	if err != nil {
+		return false, err
+	}
+	return selector.Matches(labels.Set(datasetLabels)), nil
+}
+
+// ListSelectedDatasets lists the datasets in the quota's namespace that the quota applies to.
+func ListSelectedDatasets(reader client.Reader, quota *datav1alpha1.DatasetQuota) ([]datav1alpha1.Dataset, error) {
+	selector := labels.Everything()
+	if quota.Spec.Selector != nil {
+		var err error
+		selector, err = metav1.LabelSelectorAsSelector(quota.Spec.Selector)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	datasetList := &datav1alpha1.DatasetList{}
+	if err := reader.List(context.TODO(), datasetList,
+		client.InNamespace(quota.Namespace),
+		client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return nil, err
+	}
+	return datasetList.Items, nil
+}
+
+// ListQuotas lists all the dataset quotas in the namespace.
+func ListQuotas(reader client.Reader, namespace string) ([]datav1alpha1.DatasetQuota, error) {
+	quotaList := &datav1alpha1.DatasetQuotaList{}
+	if err := reader.List(context.TODO(), quotaList, client.InNamespace(namespace)); err != nil {
+		return nil, err
+	}
+	return quotaList.Items, nil
+}
@@ -0,0 +1,249 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datasetquota
+
+import (
+	"testing"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const gi = int64(1) << 30
+
+func tieredStore(quota string, quotaList string) datav1alpha1.TieredStore {
+	level := datav1alpha1.Level{MediumType: common.SSD, QuotaList: quotaList}
+	if len(quota) > 0 {
+		q := resource.MustParse(quota)
+		level.Quota = &q
+	}
+	return datav1alpha1.TieredStore{Levels: []datav1alpha1.Level{level}}
+}
+
+func boundDataset(name, runtimeType string, labels map[string]string, cacheStates common.CacheStateList) *datav1alpha1.Dataset {
+	return &datav1alpha1.Dataset{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "fluid", Labels: labels},
+		Status: datav1alpha1.DatasetStatus{
+			Runtimes:    []datav1alpha1.Runtime{{Name: name, Namespace: "fluid", Type: runtimeType}},
+			CacheStates: cacheStates,
+		},
+	}
+}
+
+func TestTieredStoreCapacity(t *testing.T) {
+	testCases := map[string]struct {
+		tieredStore datav1alpha1.TieredStore
+		want        int64
+		wantErr     bool
+	}{
+		"empty": {
+			tieredStore: datav1alpha1.TieredStore{},
+			want:        0,
+		},
+		"quota": {
+			tieredStore: tieredStore("10Gi", ""),
+			want:        10 * gi,
+		},
+		"quota list": {
+			tieredStore: tieredStore("", "10Gi, 5Gi"),
+			want:        15 * gi,
+		},
+		"quota takes precedence": {
+			tieredStore: tieredStore("1Gi", "10Gi,5Gi"),
+			want:        gi,
+		},
+		"invalid quota list": {
+			tieredStore: tieredStore("", "10Gi,abc"),
+			wantErr:     true,
+		},
+	}
+
+	for name, tc := range testCases {
+		got, err := TieredStoreCapacity(tc.tieredStore)
+		if (err != nil) != tc.wantErr {
+			t.Errorf("%s: expect error %v, got %v", name, tc.wantErr, err)
+		}
+		if got != tc.want {
+			t.Errorf("%s: expect capacity %d, got %d", name, tc.want, got)
+		}
+	}
+}
+
+func TestRequestedCapacity(t *testing.T) {
+	testCases := map[string]struct {
+		runtime       client.Object
+		want          int64
+		wantSupported bool
+	}{
+		"alluxio": {
+			runtime: &datav1alpha1.AlluxioRuntime{Spec: datav1alpha1.AlluxioRuntimeSpec{
+				Replicas: 3, TieredStore: tieredStore("2Gi", "")}},
+			want:          6 * gi,
+			wantSupported: true,
+		},
+		"juicefs with zero replicas": {
+			runtime: &datav1alpha1.JuiceFSRuntime{Spec: datav1alpha1.JuiceFSRuntimeSpec{
+				TieredStore: tieredStore("2Gi", "")}},
+			want:          0,
+			wantSupported: true,
+		},
+		"thin": {
+			runtime: &datav1alpha1.ThinRuntime{Spec: datav1alpha1.ThinRuntimeSpec{
+				Replicas: 2, TieredStore: tieredStore("", "1Gi,2Gi")}},
+			want:          6 * gi,
+			wantSupported: true,
+		},
+		"cache runtime": {
+			runtime: &datav1alpha1.CacheRuntime{Spec: datav1alpha1.CacheRuntimeSpec{Worker: datav1alpha1.CacheRuntimeWorkerSpec{
+				Replicas: 2,
+				TieredStore: datav1alpha1.RuntimeTieredStore{Levels: []datav1alpha1.RuntimeTieredStoreLevel{
+					{ProcessMemory: &datav1alpha1.ProcessMemoryMediumSource{Quota: resource.MustParse("1Gi")}},
+					{HostPath: &datav1alpha1.HostPathMediumSource{Paths: []string{"/a", "/b"},
+						Quotas: []resource.Quantity{resource.MustParse("1Gi"), resource.MustParse("2Gi")}}},
+				}},
+			}}},
+			want:          8 * gi,
+			wantSupported: true,
+		},
+		"unknown runtime": {
+			runtime:       &datav1alpha1.Dataset{},
+			want:          0,
+			wantSupported: false,
+		},
+	}
+
+	for name, tc := range testCases {
+		got, supported, err := RequestedCapacity(tc.runtime)
+		if err != nil {
+			t.Errorf("%s: unexpected error %v", name, err)
+		}
+		if supported != tc.wantSupported || got != tc.want {
+			t.Errorf("%s: expect (%d, %v), got (%d, %v)", name, tc.want, tc.wantSupported, got, supported)
+		}
+	}
+}
+
+func TestSelects(t *testing.T) {
+	quota := &datav1alpha1.DatasetQuota{}
+	if selected, _ := Selects(quota, nil); !selected {
+		t.Errorf("expect quota without selector selects all datasets")
+	}
+
+	quota.Spec.Selector = &metav1.LabelSelector{MatchLabels: map[string]string{"team": "a"}}
+	if selected, _ := Selects(quota, map[string]string{"team": "a"}); !selected {
+		t.Errorf("expect dataset with matching labels to be selected")
+	}
+	if selected, _ := Selects(quota, map[string]string{"team": "b"}); selected {
+		t.Errorf("expect dataset with different labels not to be selected")
+	}
+	if selected, _ := Selects(quota, nil); selected {
+		t.Errorf("expect dataset without labels not to be selected")
+	}
+}
+
+func TestCalculateStatus(t *testing.T) {
+	objs := []runtime.Object{
+		boundDataset("a", common.AlluxioRuntime, map[string]string{"team": "a"}, common.CacheStateList{
+			common.CacheCapacity: "4.00GiB",
+			common.Cached:        "1.00GiB",
+		}),
+		&datav1alpha1.AlluxioRuntime{
+			ObjectMeta: metav1.ObjectMeta{Name: "a", Namespace: "fluid"},
+			Spec:       datav1alpha1.AlluxioRuntimeSpec{Replicas: 2, TieredStore: tieredStore("2Gi", "")},
+		},
+		boundDataset("b", common.JuiceFSRuntime, map[string]string{"team": "a"}, common.CacheStateList{
+			common.CacheCapacity: "3.00GiB",
+			common.Cached:        "",
+		}),
+		&datav1alpha1.JuiceFSRuntime{
+			ObjectMeta: metav1.ObjectMeta{Name: "b", Namespace: "fluid"},
+			Spec:       datav1alpha1.JuiceFSRuntimeSpec{Replicas: 1, TieredStore: tieredStore("", "1Gi,2Gi")},
+		},
+		boundDataset("c", common.CacheRuntime, map[string]string{"team": "a"}, common.CacheStateList{
+			common.CacheCapacity: "1.00GiB",
+		}),
+		// not bound yet
+		&datav1alpha1.Dataset{ObjectMeta: metav1.ObjectMeta{Name: "d", Namespace: "fluid", Labels: map[string]string{"team": "a"}}},
+		&datav1alpha1.ThinRuntime{
+			ObjectMeta: metav1.ObjectMeta{Name: "d", Namespace: "fluid"},
+			Spec:       datav1alpha1.ThinRuntimeSpec{Replicas: 1, TieredStore: tieredStore("1Gi", "")},
+		},
+		// without a dataset, not selected
+		&datav1alpha1.JindoRuntime{
+			ObjectMeta: metav1.ObjectMeta{Name: "g", Namespace: "fluid"},
+			Spec:       datav1alpha1.JindoRuntimeSpec{Replicas: 1, TieredStore: tieredStore("5Gi", "")},
+		},
+		// not selected
+		boundDataset("e", common.AlluxioRuntime, map[string]string{"team": "b"}, common.CacheStateList{
+			common.CacheCapacity: "100.00GiB",
+		}),
+		// in another namespace
+		&datav1alpha1.Dataset{ObjectMeta: metav1.ObjectMeta{Name: "f", Namespace: "other"}},
+	}
+
+	s := runtime.NewScheme()
+	_ = datav1alpha1.AddToScheme(s)
+	c := fake.NewFakeClientWithScheme(s, objs...)
+
+	quota := &datav1alpha1.DatasetQuota{
+		ObjectMeta: metav1.ObjectMeta{Name: "quota", Namespace: "fluid"},
+		Spec: datav1alpha1.DatasetQuotaSpec{
+			CacheCapacity: resource.MustParse("10Gi"),
+			Selector:      &metav1.LabelSelector{MatchLabels: map[string]string{"team": "a"}},
+		},
+	}
+
+	status, err := CalculateStatus(c, quota)
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if status.Requested.Value() != 8*gi {
+		t.Errorf("expect requested %d, got %d", 8*gi, status.Requested.Value())
+	}
+	if status.Used.Value() != 8*gi {
+		t.Errorf("expect used %d, got %d", 8*gi, status.Used.Value())
+	}
+	if status.Cached.Value() != gi {
+		t.Errorf("expect cached %d, got %d", gi, status.Cached.Value())
+	}
+	if len(status.Datasets) != 4 || status.Datasets[0].Name != "a" || status.Datasets[2].RuntimeType != common.CacheRuntime {
+		t.Errorf("unexpected dataset usages %v", status.Datasets)
+	}
+
+	others, err := RequestedByOthers(c, quota, "a")
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if others != 4*gi {
+		t.Errorf("expect requested by others %d, got %d", 4*gi, others)
+	}
+
+	quota.Spec.Selector = nil
+	others, err = RequestedByOthers(c, quota, "a")
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+	// the runtimes of all the datasets and the one without a dataset
+	if others != 9*gi {
+		t.Errorf("expect requested by others %d, got %d", 9*gi, others)
+	}
+}
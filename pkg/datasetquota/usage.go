@@ -0,0 +1,123 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datasetquota
+
+import (
+	"context"
+	"sort"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/base"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// DatasetUsage calculates the cache usage of a single dataset.
+func DatasetUsage(reader client.Reader, dataset *datav1alpha1.Dataset) (usage datav1alpha1.DatasetCacheUsage, err error) {
+	// the sizes missing or malformed in the cache states are regarded as zero
+	used, _ := base.ParseCacheStateBytes(dataset.Status.CacheStates, common.CacheCapacity)
+	cached, _ := base.ParseCacheStateBytes(dataset.Status.CacheStates, common.Cached)
+	usage = datav1alpha1.DatasetCacheUsage{
+		Name:      dataset.Name,
+		Requested: *resource.NewQuantity(0, resource.BinarySI),
+		Used:      *resource.NewQuantity(used, resource.BinarySI),
+		Cached:    *resource.NewQuantity(cached, resource.BinarySI),
+	}
+
+	runtime, runtimeType, err := GetRuntime(reader, dataset)
+	if err != nil {
+		return usage, err
+	}
+	usage.RuntimeType = runtimeType
+	if runtime == nil {
+		return usage, nil
+	}
+
+	requested, _, err := RequestedCapacity(runtime)
+	if err != nil {
+		return usage, err
+	}
+	usage.Requested = *resource.NewQuantity(requested, resource.BinarySI)
+	return usage, nil
+}
+
+// CalculateStatus sums up the cache usages of the datasets selected by the quota.
+func CalculateStatus(reader client.Reader, quota *datav1alpha1.DatasetQuota) (status datav1alpha1.DatasetQuotaStatus, err error) {
+	datasets, err := ListSelectedDatasets(reader, quota)
+	if err != nil {
+		return status, err
+	}
+
+	var requested, used, cached int64
+	status.Datasets = make([]datav1alpha1.DatasetCacheUsage, 0, len(datasets))
+	for i := range datasets {
+		usage, err := DatasetUsage(reader, &datasets[i])
+		if err != nil {
+			return status, err
+		}
+		requested += usage.Requested.Value()
+		used += usage.Used.Value()
+		cached += usage.Cached.Value()
+		status.Datasets = append(status.Datasets, usage)
+	}
+	sort.Slice(status.Datasets, func(i, j int) bool {
+		return status.Datasets[i].Name < status.Datasets[j].Name
+	})
+
+	status.Requested = *resource.NewQuantity(requested, resource.BinarySI)
+	status.Used = *resource.NewQuantity(used, resource.BinarySI)
+	status.Cached = *resource.NewQuantity(cached, resource.BinarySI)
+	return status, nil
+}
+
+// RequestedByOthers returns the cache capacity requested by the runtimes selected by the quota, excluding the
+// runtime of the dataset with the given name. A runtime is selected by the labels of its dataset, and the runtime
+// without a dataset is selected only by the quotas selecting all datasets, the same as it's admitted.
+func RequestedByOthers(reader client.Reader, quota *datav1alpha1.DatasetQuota, excludedDataset string) (requested int64, err error) {
+	datasetList := &datav1alpha1.DatasetList{}
+	if err = reader.List(context.TODO(), datasetList, client.InNamespace(quota.Namespace)); err != nil {
+		return 0, err
+	}
+	datasetLabels := make(map[string]map[string]string, len(datasetList.Items))
+	for _, dataset := range datasetList.Items {
+		datasetLabels[dataset.Name] = dataset.Labels
+	}
+
+	runtimes, err := ListRuntimes(reader, quota.Namespace)
+	if err != nil {
+		return 0, err
+	}
+	for _, runtime := range runtimes {
+		if runtime.GetName() == excludedDataset {
+			continue
+		}
+		selected, err := Selects(quota, datasetLabels[runtime.GetName()])
+		if err != nil {
+			return 0, err
+		}
+		if !selected {
+			continue
+		}
+		runtimeRequested, _, err := RequestedCapacity(runtime)
+		if err != nil {
+			return 0, err
+		}
+		requested += runtimeRequested
+	}
+	return requested, nil
+}
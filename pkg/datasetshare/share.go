@@ -0,0 +1,157 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datasetshare
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+)
+
+// allNamespaces in the namespaces of a grant allows the datasets in all the namespaces
+const allNamespaces = "*"
+
+// RequestsReadWrite checks if the referring dataset is mounted with an access mode other than ReadOnlyMany.
+// A dataset without access modes is mounted read-only.
+func RequestsReadWrite(dataset *datav1alpha1.Dataset) bool {
+	for _, mode := range dataset.Spec.AccessModes {
+		if mode != corev1.ReadOnlyMany {
+			return true
+		}
+	}
+	return false
+}
+
+// ListShares lists the DatasetShares of the shared dataset, sorted by their names.
+func ListShares(reader client.Reader, shared types.NamespacedName) ([]datav1alpha1.DatasetShare, error) {
+	shareList := &datav1alpha1.DatasetShareList{}
+	if err := reader.List(context.TODO(), shareList, client.InNamespace(shared.Namespace)); err != nil {
+		return nil, err
+	}
+
+	var shares []datav1alpha1.DatasetShare
+	for _, share := range shareList.Items {
+		if share.Spec.Dataset == shared.Name {
+			shares = append(shares, share)
+		}
+	}
+	sort.Slice(shares, func(i, j int) bool {
+		return shares[i].Name < shares[j].Name
+	})
+	return shares, nil
+}
+
+// FindGrant finds the DatasetShare allowing the referring dataset to mount the shared dataset in another namespace,
+// and returns nil if there's none. The service accounts in the grants are checked against the creator of the referring
+// dataset, so they never match a dataset whose creator is unknown.
+func FindGrant(reader client.Reader,
+	shared types.NamespacedName,
+	referring *datav1alpha1.Dataset,
+	creator string) (*datav1alpha1.DatasetRefGrant, error) {
+	shares, err := ListShares(reader, shared)
+	if err != nil {
+		return nil, err
+	}
+
+	readWrite := RequestsReadWrite(referring)
+	for _, share := range shares {
+		for _, grant := range share.Spec.Grants {
+			accessMode := grant.AccessMode
+			if accessMode == "" {
+				accessMode = datav1alpha1.DatasetShareReadOnly
+			}
+			if readWrite && accessMode != datav1alpha1.DatasetShareReadWrite {
+				continue
+			}
+			if allows(grant, referring.Namespace, creator) {
+				return &datav1alpha1.DatasetRefGrant{
+					DatasetRef:   fmt.Sprintf("%s/%s", referring.Namespace, referring.Name),
+					DatasetShare: share.Name,
+					AccessMode:   accessMode,
+				}, nil
+			}
+		}
+	}
+	return nil, nil
+}
+
+// allows checks if the grant allows the datasets in the namespace created by the creator
+func allows(grant datav1alpha1.DatasetShareGrant, namespace string, creator string) bool {
+	if utils.ContainsString(grant.Namespaces, namespace) || utils.ContainsString(grant.Namespaces, allNamespaces) {
+		return true
+	}
+	for _, sa := range grant.ServiceAccounts {
+		if sa.Namespace != namespace {
+			continue
+		}
+		if creator != "" && creator == fmt.Sprintf("system:serviceaccount:%s:%s", sa.Namespace, sa.Name) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetCreator returns the user creating the dataset, which is recorded by the webhook at admission
+func GetCreator(dataset *datav1alpha1.Dataset) string {
+	return dataset.GetAnnotations()[common.AnnotationDatasetCreator]
+}
+
+// DeniedMessage explains why the referring dataset is not allowed to mount the shared dataset
+func DeniedMessage(shared types.NamespacedName, referring *datav1alpha1.Dataset) string {
+	accessMode := datav1alpha1.DatasetShareReadOnly
+	if RequestsReadWrite(referring) {
+		accessMode = datav1alpha1.DatasetShareReadWrite
+	}
+	return fmt.Sprintf("dataset %s/%s is not shared with dataset %s/%s by any DatasetShare granting %s access",
+		shared.Namespace, shared.Name, referring.Namespace, referring.Name, accessMode)
+}
+
+// SetRefGrant adds or replaces the grant of the same referring dataset.
+func SetRefGrant(grants []datav1alpha1.DatasetRefGrant, grant datav1alpha1.DatasetRefGrant) []datav1alpha1.DatasetRefGrant {
+	result := RemoveRefGrant(grants, grant.DatasetRef)
+	return append(result, grant)
+}
+
+// RemoveRefGrant removes the grant of the referring dataset.
+func RemoveRefGrant(grants []datav1alpha1.DatasetRefGrant, datasetRef string) []datav1alpha1.DatasetRefGrant {
+	var result []datav1alpha1.DatasetRefGrant
+	for _, grant := range grants {
+		if grant.DatasetRef != datasetRef {
+			result = append(result, grant)
+		}
+	}
+	return result
+}
+
+// RetainRefGrants keeps the grants of the referring datasets still in datasetRefs.
+func RetainRefGrants(grants []datav1alpha1.DatasetRefGrant, datasetRefs []string) []datav1alpha1.DatasetRefGrant {
+	var result []datav1alpha1.DatasetRefGrant
+	for _, grant := range grants {
+		if utils.ContainsString(datasetRefs, grant.DatasetRef) {
+			result = append(result, grant)
+		}
+	}
+	return result
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datasetshare
+
+import (
+	"reflect"
+	"testing"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func referringDataset(namespace string, accessModes ...corev1.PersistentVolumeAccessMode) *datav1alpha1.Dataset {
+	return &datav1alpha1.Dataset{
+		ObjectMeta: metav1.ObjectMeta{Name: "ref", Namespace: namespace},
+		Spec: datav1alpha1.DatasetSpec{
+			Mounts:      []datav1alpha1.Mount{{MountPoint: "dataset://shared/hbase"}},
+			AccessModes: accessModes,
+		},
+	}
+}
+
+func TestFindGrant(t *testing.T) {
+	s := runtime.NewScheme()
+	_ = datav1alpha1.AddToScheme(s)
+	shared := types.NamespacedName{Namespace: "shared", Name: "hbase"}
+	shares := []runtime.Object{
+		&datav1alpha1.DatasetShare{
+			ObjectMeta: metav1.ObjectMeta{Name: "b-read", Namespace: "shared"},
+			Spec: datav1alpha1.DatasetShareSpec{
+				Dataset: "hbase",
+				Grants: []datav1alpha1.DatasetShareGrant{
+					{Namespaces: []string{"team-a", "team-b"}},
+					{ServiceAccounts: []datav1alpha1.DatasetShareServiceAccount{{Namespace: "team-c", Name: "deployer"}}},
+				},
+			},
+		},
+		&datav1alpha1.DatasetShare{
+			ObjectMeta: metav1.ObjectMeta{Name: "a-write", Namespace: "shared"},
+			Spec: datav1alpha1.DatasetShareSpec{
+				Dataset: "hbase",
+				Grants: []datav1alpha1.DatasetShareGrant{
+					{Namespaces: []string{"team-b"}, AccessMode: datav1alpha1.DatasetShareReadWrite},
+				},
+			},
+		},
+		&datav1alpha1.DatasetShare{
+			ObjectMeta: metav1.ObjectMeta{Name: "others", Namespace: "shared"},
+			Spec: datav1alpha1.DatasetShareSpec{
+				Dataset: "spark",
+				Grants:  []datav1alpha1.DatasetShareGrant{{Namespaces: []string{"*"}}},
+			},
+		},
+	}
+	c := fake.NewFakeClientWithScheme(s, shares...)
+
+	testCases := map[string]struct {
+		referring *datav1alpha1.Dataset
+		creator   string
+		want      *datav1alpha1.DatasetRefGrant
+	}{
+		"read-only by namespace": {
+			referring: referringDataset("team-a"),
+			creator:   "alice",
+			want:      &datav1alpha1.DatasetRefGrant{DatasetRef: "team-a/ref", DatasetShare: "b-read", AccessMode: datav1alpha1.DatasetShareReadOnly},
+		},
+		"read-write not granted": {
+			referring: referringDataset("team-a", corev1.ReadWriteMany),
+			creator:   "alice",
+		},
+		"read-write by namespace": {
+			referring: referringDataset("team-b", corev1.ReadOnlyMany, corev1.ReadWriteMany),
+			want:      &datav1alpha1.DatasetRefGrant{DatasetRef: "team-b/ref", DatasetShare: "a-write", AccessMode: datav1alpha1.DatasetShareReadWrite},
+		},
+		"the first share in name order": {
+			referring: referringDataset("team-b"),
+			want:      &datav1alpha1.DatasetRefGrant{DatasetRef: "team-b/ref", DatasetShare: "a-write", AccessMode: datav1alpha1.DatasetShareReadWrite},
+		},
+		"service account matches the creator": {
+			referring: referringDataset("team-c"),
+			creator:   "system:serviceaccount:team-c:deployer",
+			want:      &datav1alpha1.DatasetRefGrant{DatasetRef: "team-c/ref", DatasetShare: "b-read", AccessMode: datav1alpha1.DatasetShareReadOnly},
+		},
+		"service account does not match the creator": {
+			referring: referringDataset("team-c"),
+			creator:   "system:serviceaccount:team-c:default",
+		},
+		"service account not matched without creator": {
+			referring: referringDataset("team-c"),
+		},
+		"namespace not granted": {
+			referring: referringDataset("team-d"),
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			got, err := FindGrant(c, shared, tc.referring, tc.creator)
+			if err != nil {
+				t.Fatalf("FindGrant() got unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("FindGrant() = %+v, want %+v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestRefGrants(t *testing.T) {
+	grants := SetRefGrant(nil, datav1alpha1.DatasetRefGrant{DatasetRef: "team-a/ref", DatasetShare: "a", AccessMode: datav1alpha1.DatasetShareReadOnly})
+	grants = SetRefGrant(grants, datav1alpha1.DatasetRefGrant{DatasetRef: "team-b/ref", DatasetShare: "b", AccessMode: datav1alpha1.DatasetShareReadOnly})
+	grants = SetRefGrant(grants, datav1alpha1.DatasetRefGrant{DatasetRef: "team-a/ref", DatasetShare: "c", AccessMode: datav1alpha1.DatasetShareReadWrite})
+	if len(grants) != 2 || grants[1].DatasetShare != "c" {
+		t.Fatalf("SetRefGrant() = %+v, want the grant of team-a/ref replaced", grants)
+	}
+
+	retained := RetainRefGrants(grants, []string{"team-b/ref"})
+	if len(retained) != 1 || retained[0].DatasetRef != "team-b/ref" {
+		t.Errorf("RetainRefGrants() = %+v, want only the grant of team-b/ref", retained)
+	}
+
+	removed := RemoveRefGrant(grants, "team-b/ref")
+	if len(removed) != 1 || removed[0].DatasetRef != "team-a/ref" {
+		t.Errorf("RemoveRefGrant() = %+v, want only the grant of team-a/ref", removed)
+	}
+}
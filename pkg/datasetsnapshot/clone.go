@@ -0,0 +1,116 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datasetsnapshot
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	podutil "k8s.io/kubernetes/pkg/api/v1/pod"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/juicefs"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/juicefs/operations"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
+)
+
+// SnapshotDir is the directory under the root of the JuiceFS volume holding the clones of the snapshots, which is
+// out of the sub directories mounted by the datasets
+const SnapshotDir = ".fluid-snapshots"
+
+// Cloner clones the data of a JuiceFS dataset in a ready worker pod of its runtime
+type Cloner struct {
+	fileUtils  operations.JuiceFileUtils
+	source     string
+	subPath    string
+	enterprise bool
+}
+
+// NewCloner finds a ready worker pod of the JuiceFSRuntime and the sub directory of the volume the dataset is
+// mounted from. The first ready worker by name is chosen, so that a running clone is polled in the same pod.
+func NewCloner(c client.Client, name, namespace string, log logr.Logger) (*Cloner, error) {
+	workerInfo, err := juicefs.GetWorkerInfoFromConfigmap(c, name, namespace)
+	if err != nil {
+		return nil, err
+	}
+	source := workerInfo[juicefs.Source]
+	if source == "" {
+		return nil, fmt.Errorf("the source of the volume of JuiceFSRuntime %s/%s is not found", namespace, name)
+	}
+	subPath := strings.Trim(workerInfo[juicefs.SubPath], "/")
+	if subPath == "" {
+		return nil, fmt.Errorf("the dataset of JuiceFSRuntime %s/%s mounts the root of the volume, which can't be cloned", namespace, name)
+	}
+
+	sts, err := kubeclient.GetStatefulSet(c, name+"-worker", namespace)
+	if err != nil {
+		return nil, err
+	}
+	selector, err := metav1.LabelSelectorAsSelector(sts.Spec.Selector)
+	if err != nil {
+		return nil, err
+	}
+	pods, err := kubeclient.GetPodsForStatefulSet(c, sts, selector)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(pods, func(i, j int) bool { return pods[i].Name < pods[j].Name })
+	for _, pod := range pods {
+		if podutil.IsPodReady(&pod) {
+			return &Cloner{
+				fileUtils:  operations.NewJuiceFileUtils(pod.Name, common.JuiceFSWorkerContainer, namespace, log),
+				source:     source,
+				subPath:    subPath,
+				enterprise: workerInfo[juicefs.Edition] == juicefs.EnterpriseEdition,
+			}, nil
+		}
+	}
+	return nil, fmt.Errorf("no worker of JuiceFSRuntime %s/%s is ready", namespace, name)
+}
+
+// Clone starts or polls the clone of the sub directory of the dataset to the clone path of the snapshot, which is
+// made without copying the data in the background of the worker. It returns true once the clone is done. The clone
+// fails if the data is modified during it, and an existing clone of a previous attempt is kept.
+func (c *Cloner) Clone(snapshot *datav1alpha1.DatasetSnapshot) (done bool, err error) {
+	return c.fileUtils.CloneDir(c.source, c.subPath, GetClonePath(snapshot), c.enterprise)
+}
+
+// Delete removes the clone of the snapshot if it exists.
+func (c *Cloner) Delete(snapshot *datav1alpha1.DatasetSnapshot) error {
+	return c.fileUtils.RemoveDir(c.source, GetClonePath(snapshot), c.enterprise)
+}
+
+// GetClonePath returns the path of the clone of the snapshot relative to the root of the volume
+func GetClonePath(snapshot *datav1alpha1.DatasetSnapshot) string {
+	return path.Join(SnapshotDir, snapshot.Namespace, snapshot.Spec.Dataset, snapshot.Name)
+}
+
+// CloneMounts returns the read-only mounts pointing to the clone of the snapshot, the dataset has a single mount
+// which is checked when the method is resolved.
+func CloneMounts(mounts []datav1alpha1.Mount, snapshot *datav1alpha1.DatasetSnapshot) []datav1alpha1.Mount {
+	cloneMounts := ReadOnlyMounts(mounts)
+	for i := range cloneMounts {
+		cloneMounts[i].MountPoint = "juicefs:///" + GetClonePath(snapshot)
+	}
+	return cloneMounts
+}
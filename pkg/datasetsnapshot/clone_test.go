@@ -0,0 +1,139 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datasetsnapshot
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/agiledragon/gomonkey/v2"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/juicefs/operations"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+)
+
+func TestNewCloner(t *testing.T) {
+	values := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "jfsdemo-juicefs-values", Namespace: "default"},
+		Data: map[string]string{
+			"data": "edition: enterprise\nsource: jfsdemo\nfuse:\n  subPath: /demo\nworker:\n  mountPath: /runtime-mnt/juicefs/default/jfsdemo/juicefs-fuse\n",
+		},
+	}
+	sts := &appsv1.StatefulSet{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "StatefulSet"},
+		ObjectMeta: metav1.ObjectMeta{Name: "jfsdemo-worker", Namespace: "default", UID: "sts-uid"},
+		Spec: appsv1.StatefulSetSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "juicefs", "role": "juicefs-worker"}},
+		},
+	}
+	newWorker := func(name string, ready corev1.ConditionStatus) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "default",
+				Labels:    map[string]string{"app": "juicefs", "role": "juicefs-worker"},
+				OwnerReferences: []metav1.OwnerReference{{
+					APIVersion: "apps/v1", Kind: "StatefulSet", Name: "jfsdemo-worker", UID: "sts-uid", Controller: ptr.To(true),
+				}},
+			},
+			Status: corev1.PodStatus{Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: ready}}},
+		}
+	}
+
+	s := runtime.NewScheme()
+	_ = corev1.AddToScheme(s)
+	_ = appsv1.AddToScheme(s)
+	c := fake.NewFakeClientWithScheme(s, values, sts, newWorker("jfsdemo-worker-0", corev1.ConditionFalse), newWorker("jfsdemo-worker-1", corev1.ConditionTrue))
+	log := fake.NullLogger()
+	cloner, err := NewCloner(c, "jfsdemo", "default", log)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if cloner.source != "jfsdemo" || cloner.subPath != "demo" || !cloner.enterprise {
+		t.Errorf("unexpected cloner %+v", cloner)
+	}
+	if !reflect.DeepEqual(cloner.fileUtils, operations.NewJuiceFileUtils("jfsdemo-worker-1", "juicefs-worker", "default", log)) {
+		t.Errorf("expect the clone run in the ready worker, got %+v", cloner.fileUtils)
+	}
+
+	c = fake.NewFakeClientWithScheme(s, values, sts, newWorker("jfsdemo-worker-0", corev1.ConditionFalse))
+	if _, err = NewCloner(c, "jfsdemo", "default", fake.NullLogger()); err == nil {
+		t.Errorf("expect an error without ready workers")
+	}
+
+	rootValues := values.DeepCopy()
+	rootValues.Data["data"] = "edition: community\nsource: ${METAURL}\nworker:\n  mountPath: /runtime-mnt/juicefs/default/jfsdemo/juicefs-fuse\n"
+	c = fake.NewFakeClientWithScheme(s, rootValues, sts, newWorker("jfsdemo-worker-1", corev1.ConditionTrue))
+	if _, err = NewCloner(c, "jfsdemo", "default", fake.NullLogger()); err == nil {
+		t.Errorf("expect an error for the dataset mounting the root of the volume")
+	}
+}
+
+func TestClonerClone(t *testing.T) {
+	var commands [][]string
+	patches := gomonkey.ApplyPrivateMethod(operations.JuiceFileUtils{}, "exec",
+		func(_ operations.JuiceFileUtils, command []string, verbose bool) (string, string, error) {
+			commands = append(commands, command)
+			return "", "", nil
+		})
+	defer patches.Reset()
+
+	snapshot := &datav1alpha1.DatasetSnapshot{
+		ObjectMeta: metav1.ObjectMeta{Name: "hbase-v1", Namespace: "default"},
+		Spec:       datav1alpha1.DatasetSnapshotSpec{Dataset: "hbase"},
+	}
+	cloner := &Cloner{fileUtils: operations.NewJuiceFileUtils("jfsdemo-worker-0", "juicefs-worker", "default", fake.NullLogger()), source: "${METAURL}", subPath: "demo"}
+	if _, err := cloner.Clone(snapshot); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if err := cloner.Delete(snapshot); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if len(commands) != 2 {
+		t.Fatalf("expect a command to clone and a command to delete, got %v", commands)
+	}
+	if !reflect.DeepEqual(commands[0][4:6], []string{"demo", ".fluid-snapshots/default/hbase/hbase-v1"}) {
+		t.Errorf("expect the sub directory cloned out of it, got %v", commands[0])
+	}
+	if !reflect.DeepEqual(commands[1][4:], []string{".fluid-snapshots/default/hbase/hbase-v1"}) {
+		t.Errorf("expect the clone deleted, got %v", commands[1])
+	}
+}
+
+func TestCloneMounts(t *testing.T) {
+	snapshot := &datav1alpha1.DatasetSnapshot{
+		ObjectMeta: metav1.ObjectMeta{Name: "hbase-v1", Namespace: "default"},
+		Spec:       datav1alpha1.DatasetSnapshotSpec{Dataset: "hbase"},
+	}
+	mounts := []datav1alpha1.Mount{{MountPoint: "juicefs:///demo", Name: "demo", Options: map[string]string{"bucket": "http://minio:9000/jfs"}}}
+	got := CloneMounts(mounts, snapshot)
+	want := []datav1alpha1.Mount{
+		{MountPoint: "juicefs:///.fluid-snapshots/default/hbase/hbase-v1", Name: "demo", Options: map[string]string{"bucket": "http://minio:9000/jfs"}, ReadOnly: true},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expect mounts %v, got %v", want, got)
+	}
+	if mounts[0].ReadOnly {
+		t.Errorf("expect the mounts of the dataset unchanged")
+	}
+}
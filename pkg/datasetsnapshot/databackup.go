@@ -0,0 +1,60 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datasetsnapshot
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	cdatabackup "github.com/fluid-cloudnative/fluid/pkg/databackup"
+)
+
+// GetDataBackupName returns the name of the DataBackup backing up the metadata of the dataset for the snapshot
+func GetDataBackupName(snapshotName string) string {
+	return snapshotName + "-backup"
+}
+
+// BuildDataBackup builds the DataBackup backing up the metadata of the dataset for the snapshot
+func BuildDataBackup(snapshot *datav1alpha1.DatasetSnapshot) *datav1alpha1.DataBackup {
+	return &datav1alpha1.DataBackup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            GetDataBackupName(snapshot.Name),
+			Namespace:       snapshot.Namespace,
+			OwnerReferences: []metav1.OwnerReference{ownerReference(snapshot)},
+		},
+		Spec: datav1alpha1.DataBackupSpec{
+			Dataset:    snapshot.Spec.Dataset,
+			BackupPath: snapshot.Spec.BackupPath,
+		},
+	}
+}
+
+// GetRestoreLocation returns the location to restore the metadata backed up by the complete DataBackup from
+func GetRestoreLocation(backup *datav1alpha1.DataBackup) *datav1alpha1.DataRestoreLocation {
+	location := &datav1alpha1.DataRestoreLocation{
+		Path:    backup.Spec.BackupPath,
+		Dataset: backup.Spec.Dataset,
+	}
+	if path := backup.Status.Infos[cdatabackup.BackupLocationPath]; path != "" {
+		location.Path = path
+	}
+	// the node name is only recorded for the local path
+	if nodeName := backup.Status.Infos[cdatabackup.BackupLocationNodeName]; nodeName != "NA" {
+		location.NodeName = nodeName
+	}
+	return location
+}
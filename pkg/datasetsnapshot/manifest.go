@@ -0,0 +1,253 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datasetsnapshot
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/docker"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
+)
+
+const (
+	// ManifestKey is the key of the gzipped manifest in the binary data of the ConfigMap
+	ManifestKey = "manifest.gz"
+
+	// MaxManifestSize is the maximum size of the gzipped manifest stored in a ConfigMap, which is limited to 1MiB
+	MaxManifestSize = 1000 * 1024
+
+	manifestContainerName = "manifest"
+	manifestMountPath     = "/data"
+)
+
+var execCommand = kubeclient.ExecCommandInContainerWithFullOutput
+
+// ErrManifestTooLarge means the manifest can't be stored in a ConfigMap, so that the snapshot can't be verified
+var ErrManifestTooLarge = errors.New("the manifest is too large to store in a ConfigMap")
+
+// GetManifestPodName returns the name of the pod listing the files of the dataset for the snapshot
+func GetManifestPodName(snapshotName string) string {
+	return snapshotName + "-manifest"
+}
+
+// GetManifestConfigMapName returns the name of the ConfigMap holding the manifest of the snapshot
+func GetManifestConfigMapName(snapshotName string) string {
+	return snapshotName + "-manifest"
+}
+
+// GetVerifyPodName returns the name of the pod listing the files of the dataset a snapshot is taken from, to verify
+// the data before the dataset is mounted from the snapshot
+func GetVerifyPodName(datasetName string) string {
+	return datasetName + "-snapshot-verify"
+}
+
+// BuildManifestPod builds the pod mounting the PVC of the dataset read-only, the files of the dataset
+// are listed in it once it's running.
+func BuildManifestPod(snapshot *datav1alpha1.DatasetSnapshot) *corev1.Pod {
+	return buildListPod(GetManifestPodName(snapshot.Name), snapshot, ownerReference(snapshot))
+}
+
+// BuildVerifyPod builds the pod mounting the PVC of the dataset the snapshot is taken from read-only for the
+// dataset mounted from the snapshot, the files are listed in it and compared with the manifest once it's running.
+func BuildVerifyPod(dataset *datav1alpha1.Dataset, snapshot *datav1alpha1.DatasetSnapshot) *corev1.Pod {
+	return buildListPod(GetVerifyPodName(dataset.Name), snapshot, *metav1.NewControllerRef(dataset, datav1alpha1.GroupVersion.WithKind(datav1alpha1.Datasetkind)))
+}
+
+func buildListPod(name string, snapshot *datav1alpha1.DatasetSnapshot, owner metav1.OwnerReference) *corev1.Pod {
+	image, tag, imagePullPolicy := docker.ParseInitImage("", "", "", common.DefaultInitImageEnv)
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            name,
+			Namespace:       snapshot.Namespace,
+			Labels:          map[string]string{common.LabelDatasetSnapshotName: snapshot.Name},
+			OwnerReferences: []metav1.OwnerReference{owner},
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{
+				Name:            manifestContainerName,
+				Image:           image + ":" + tag,
+				ImagePullPolicy: corev1.PullPolicy(imagePullPolicy),
+				Command:         []string{"tail", "-f", "/dev/null"},
+				VolumeMounts: []corev1.VolumeMount{{
+					Name:      "data",
+					MountPath: manifestMountPath,
+					ReadOnly:  true,
+				}},
+			}},
+			Volumes: []corev1.Volume{{
+				Name: "data",
+				VolumeSource: corev1.VolumeSource{
+					PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+						ClaimName: snapshot.Spec.Dataset,
+						ReadOnly:  true,
+					},
+				},
+			}},
+			TerminationGracePeriodSeconds: ptr.To[int64](0),
+		},
+	}
+}
+
+// ListManifest lists the files of the dataset in the running manifest pod, and returns the manifest
+// with a line of the path, size and modification time of each file sorted by the path.
+func ListManifest(ctx context.Context, pod *corev1.Pod) (string, *datav1alpha1.DatasetSnapshotManifest, error) {
+	command := []string{"sh", "-c", fmt.Sprintf("cd %s && find . -type f -exec stat -c '%%s %%Y %%n' {} +", manifestMountPath)}
+	stdout, stderr, err := execCommand(ctx, pod.Name, manifestContainerName, pod.Namespace, command)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to list the files of the dataset: %v, stderr: %s", err, stderr)
+	}
+	return ParseManifest(stdout)
+}
+
+// ParseManifest parses the output of stat of the files into the manifest.
+func ParseManifest(output string) (string, *datav1alpha1.DatasetSnapshotManifest, error) {
+	type file struct {
+		path  string
+		size  int64
+		mtime int64
+	}
+	var files []file
+	for _, line := range strings.Split(output, "\n") {
+		if line == "" {
+			continue
+		}
+		fields := strings.SplitN(line, " ", 3)
+		if len(fields) != 3 {
+			return "", nil, fmt.Errorf("failed to parse the file %q", line)
+		}
+		size, err := strconv.ParseInt(fields[0], 10, 64)
+		if err != nil {
+			return "", nil, fmt.Errorf("failed to parse the size of the file %q: %v", line, err)
+		}
+		mtime, err := strconv.ParseInt(fields[1], 10, 64)
+		if err != nil {
+			return "", nil, fmt.Errorf("failed to parse the modification time of the file %q: %v", line, err)
+		}
+		files = append(files, file{path: strings.TrimPrefix(fields[2], "./"), size: size, mtime: mtime})
+	}
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].path < files[j].path
+	})
+
+	builder := strings.Builder{}
+	manifest := &datav1alpha1.DatasetSnapshotManifest{}
+	for _, f := range files {
+		fmt.Fprintf(&builder, "%s\t%d\t%d\n", f.path, f.size, f.mtime)
+		manifest.FileCount++
+		manifest.TotalSize += f.size
+	}
+	content := builder.String()
+	digest := sha256.Sum256([]byte(content))
+	manifest.Digest = "sha256:" + hex.EncodeToString(digest[:])
+	return content, manifest, nil
+}
+
+// BuildManifestConfigMap builds the ConfigMap holding the gzipped manifest of the snapshot, it returns
+// ErrManifestTooLarge if the gzipped manifest is larger than MaxManifestSize.
+func BuildManifestConfigMap(snapshot *datav1alpha1.DatasetSnapshot, content string) (*corev1.ConfigMap, error) {
+	var buf bytes.Buffer
+	writer := gzip.NewWriter(&buf)
+	if _, err := writer.Write([]byte(content)); err != nil {
+		return nil, err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+	if buf.Len() > MaxManifestSize {
+		return nil, fmt.Errorf("%w: %d bytes gzipped, the limit is %d bytes", ErrManifestTooLarge, buf.Len(), MaxManifestSize)
+	}
+
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            GetManifestConfigMapName(snapshot.Name),
+			Namespace:       snapshot.Namespace,
+			Labels:          map[string]string{common.LabelDatasetSnapshotName: snapshot.Name},
+			OwnerReferences: []metav1.OwnerReference{ownerReference(snapshot)},
+		},
+		BinaryData: map[string][]byte{ManifestKey: buf.Bytes()},
+	}, nil
+}
+
+// ReadManifest reads the manifest from the ConfigMap built by BuildManifestConfigMap
+func ReadManifest(configMap *corev1.ConfigMap) (string, error) {
+	data, found := configMap.BinaryData[ManifestKey]
+	if !found {
+		return "", fmt.Errorf("the manifest is not found in ConfigMap %s/%s", configMap.Namespace, configMap.Name)
+	}
+	reader, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return "", err
+	}
+	defer reader.Close()
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return "", err
+	}
+	return string(content), nil
+}
+
+// DiffManifest returns the sorted paths of the files added, removed or changed in the actual manifest compared
+// with the expected one, at most limit paths are returned.
+func DiffManifest(expected, actual string, limit int) []string {
+	parse := func(content string) map[string]string {
+		files := map[string]string{}
+		for _, line := range strings.Split(content, "\n") {
+			if line != "" {
+				files[strings.SplitN(line, "\t", 2)[0]] = line
+			}
+		}
+		return files
+	}
+	expectedFiles, actualFiles := parse(expected), parse(actual)
+
+	var paths []string
+	for path, line := range expectedFiles {
+		if actualFiles[path] != line {
+			paths = append(paths, path)
+		}
+	}
+	for path := range actualFiles {
+		if _, found := expectedFiles[path]; !found {
+			paths = append(paths, path)
+		}
+	}
+	sort.Strings(paths)
+	if len(paths) > limit {
+		paths = paths[:limit]
+	}
+	return paths
+}
+
+func ownerReference(snapshot *datav1alpha1.DatasetSnapshot) metav1.OwnerReference {
+	return *metav1.NewControllerRef(snapshot, datav1alpha1.GroupVersion.WithKind("DatasetSnapshot"))
+}
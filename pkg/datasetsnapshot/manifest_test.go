@@ -0,0 +1,175 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datasetsnapshot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/rand"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+)
+
+func TestParseManifest(t *testing.T) {
+	output := "1024 1700000000 ./train/b.csv\n" +
+		"10 1700000100 ./a file.txt\n" +
+		"0 1700000200 ./train/a.csv\n"
+	content, manifest, err := ParseManifest(output)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	wantContent := "a file.txt\t10\t1700000100\n" +
+		"train/a.csv\t0\t1700000200\n" +
+		"train/b.csv\t1024\t1700000000\n"
+	if content != wantContent {
+		t.Errorf("expect manifest\n%s\ngot\n%s", wantContent, content)
+	}
+	if manifest.FileCount != 3 || manifest.TotalSize != 1034 {
+		t.Errorf("expect 3 files of 1034 bytes, got %d files of %d bytes", manifest.FileCount, manifest.TotalSize)
+	}
+	if !strings.HasPrefix(manifest.Digest, "sha256:") {
+		t.Errorf("expect a sha256 digest, got %s", manifest.Digest)
+	}
+
+	// the digest doesn't depend on the order the files are listed in
+	_, reordered, err := ParseManifest("0 1700000200 ./train/a.csv\n10 1700000100 ./a file.txt\n1024 1700000000 ./train/b.csv\n")
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if reordered.Digest != manifest.Digest {
+		t.Errorf("expect the same digest, got %s and %s", manifest.Digest, reordered.Digest)
+	}
+
+	if _, _, err = ParseManifest("abc 1700000200 ./train/a.csv\n"); err == nil {
+		t.Errorf("expect an error for the invalid size")
+	}
+}
+
+func TestListManifest(t *testing.T) {
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "hbase-v1-manifest", Namespace: "default"}}
+	defer func(exec func(ctx context.Context, podName string, containerName string, namespace string, cmd []string) (string, string, error)) {
+		execCommand = exec
+	}(execCommand)
+
+	execCommand = func(ctx context.Context, podName string, containerName string, namespace string, cmd []string) (string, string, error) {
+		if podName != pod.Name || containerName != manifestContainerName || namespace != pod.Namespace {
+			t.Errorf("unexpected container %s/%s/%s", namespace, podName, containerName)
+		}
+		return "10 1700000100 ./a.txt\n", "", nil
+	}
+	content, manifest, err := ListManifest(context.TODO(), pod)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if content != "a.txt\t10\t1700000100\n" || manifest.FileCount != 1 {
+		t.Errorf("unexpected manifest %q", content)
+	}
+
+	execCommand = func(ctx context.Context, podName string, containerName string, namespace string, cmd []string) (string, string, error) {
+		return "", "find: permission denied", errors.New("command terminated with exit code 1")
+	}
+	if _, _, err = ListManifest(context.TODO(), pod); err == nil {
+		t.Errorf("expect an error")
+	}
+}
+
+func TestBuildManifestPod(t *testing.T) {
+	snapshot := &datav1alpha1.DatasetSnapshot{
+		ObjectMeta: metav1.ObjectMeta{Name: "hbase-v1", Namespace: "default", UID: "snapshot-uid"},
+		Spec:       datav1alpha1.DatasetSnapshotSpec{Dataset: "hbase"},
+	}
+	pod := BuildManifestPod(snapshot)
+	if pod.Name != "hbase-v1-manifest" || pod.Labels[common.LabelDatasetSnapshotName] != "hbase-v1" {
+		t.Errorf("unexpected pod %s with labels %v", pod.Name, pod.Labels)
+	}
+	if len(pod.OwnerReferences) != 1 || pod.OwnerReferences[0].UID != "snapshot-uid" || pod.OwnerReferences[0].Kind != "DatasetSnapshot" {
+		t.Errorf("expect the pod owned by the snapshot, got %v", pod.OwnerReferences)
+	}
+	claim := pod.Spec.Volumes[0].PersistentVolumeClaim
+	if claim == nil || claim.ClaimName != "hbase" || !claim.ReadOnly || !pod.Spec.Containers[0].VolumeMounts[0].ReadOnly {
+		t.Errorf("expect the pvc of the dataset mounted read-only, got %v", pod.Spec.Volumes)
+	}
+}
+
+func TestBuildVerifyPod(t *testing.T) {
+	snapshot := &datav1alpha1.DatasetSnapshot{
+		ObjectMeta: metav1.ObjectMeta{Name: "hbase-v1", Namespace: "default", UID: "snapshot-uid"},
+		Spec:       datav1alpha1.DatasetSnapshotSpec{Dataset: "hbase"},
+	}
+	dataset := &datav1alpha1.Dataset{ObjectMeta: metav1.ObjectMeta{Name: "hbase-restored", Namespace: "default", UID: "dataset-uid"}}
+	pod := BuildVerifyPod(dataset, snapshot)
+	if pod.Name != "hbase-restored-snapshot-verify" {
+		t.Errorf("unexpected pod %s", pod.Name)
+	}
+	if len(pod.OwnerReferences) != 1 || pod.OwnerReferences[0].UID != "dataset-uid" || pod.OwnerReferences[0].Kind != "Dataset" {
+		t.Errorf("expect the pod owned by the dataset mounted from the snapshot, got %v", pod.OwnerReferences)
+	}
+	if claim := pod.Spec.Volumes[0].PersistentVolumeClaim; claim == nil || claim.ClaimName != "hbase" || !claim.ReadOnly {
+		t.Errorf("expect the pvc of the dataset the snapshot is taken from mounted read-only, got %v", pod.Spec.Volumes)
+	}
+}
+
+func TestBuildManifestConfigMap(t *testing.T) {
+	snapshot := &datav1alpha1.DatasetSnapshot{ObjectMeta: metav1.ObjectMeta{Name: "hbase-v1", Namespace: "default", UID: "snapshot-uid"}}
+	content := strings.Repeat("train/a.csv\t10\t1700000000\n", 100000)
+	configMap, err := BuildManifestConfigMap(snapshot, content)
+	if err != nil {
+		t.Fatalf("expect the compressible manifest saved, got %v", err)
+	}
+	if len(configMap.BinaryData[ManifestKey]) >= len(content) {
+		t.Errorf("expect the manifest gzipped, got %d bytes", len(configMap.BinaryData[ManifestKey]))
+	}
+	got, err := ReadManifest(configMap)
+	if err != nil || got != content {
+		t.Errorf("expect the manifest read back, got error %v", err)
+	}
+
+	if _, err = ReadManifest(&corev1.ConfigMap{}); err == nil {
+		t.Errorf("expect an error without the manifest")
+	}
+
+	random := strings.Builder{}
+	for random.Len() <= MaxManifestSize*2 {
+		fmt.Fprintf(&random, "%s\t1\t1700000000\n", rand.String(64))
+	}
+	if _, err = BuildManifestConfigMap(snapshot, random.String()); !errors.Is(err, ErrManifestTooLarge) {
+		t.Errorf("expect ErrManifestTooLarge, got %v", err)
+	}
+}
+
+func TestDiffManifest(t *testing.T) {
+	expected := "a.txt\t10\t1700000000\nb.txt\t10\t1700000000\nc.txt\t10\t1700000000\n"
+	if paths := DiffManifest(expected, expected, 10); len(paths) != 0 {
+		t.Errorf("expect no difference, got %v", paths)
+	}
+
+	actual := "a.txt\t10\t1700000000\nb.txt\t20\t1700000100\nd.txt\t10\t1700000000\n"
+	if paths := DiffManifest(expected, actual, 10); !reflect.DeepEqual(paths, []string{"b.txt", "c.txt", "d.txt"}) {
+		t.Errorf("expect the changed, removed and added files, got %v", paths)
+	}
+	if paths := DiffManifest(expected, actual, 2); !reflect.DeepEqual(paths, []string{"b.txt", "c.txt"}) {
+		t.Errorf("expect at most 2 files, got %v", paths)
+	}
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datasetsnapshot
+
+import (
+	"fmt"
+	"strings"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/juicefs"
+)
+
+// ResolveMethod returns the method to snapshot a dataset bound to the runtime type. The method defaults to
+// Clone for JuiceFS datasets mounting a sub directory of the volume, DataBackup for Alluxio and Manifest for the
+// others, a method set explicitly must be supported by the runtime.
+func ResolveMethod(snapshot *datav1alpha1.DatasetSnapshot, dataset *datav1alpha1.Dataset, runtimeType string) (datav1alpha1.DatasetSnapshotMethod, error) {
+	method := snapshot.Spec.Method
+	if method == "" {
+		switch {
+		case runtimeType == common.JuiceFSRuntime && isClonable(dataset):
+			method = datav1alpha1.DatasetSnapshotMethodClone
+		case runtimeType == common.AlluxioRuntime:
+			method = datav1alpha1.DatasetSnapshotMethodDataBackup
+		default:
+			method = datav1alpha1.DatasetSnapshotMethodManifest
+		}
+	}
+
+	switch method {
+	case datav1alpha1.DatasetSnapshotMethodClone:
+		if runtimeType != common.JuiceFSRuntime {
+			return "", fmt.Errorf("the Clone method is only supported by JuiceFSRuntime, but the dataset is bound to a %s runtime", runtimeType)
+		}
+		if !isClonable(dataset) {
+			return "", fmt.Errorf("the Clone method requires the dataset to mount a single sub directory of the volume, e.g. juicefs:///demo, since the clone is made out of it")
+		}
+	case datav1alpha1.DatasetSnapshotMethodDataBackup:
+		if runtimeType != common.AlluxioRuntime {
+			return "", fmt.Errorf("the DataBackup method is only supported by AlluxioRuntime, but the dataset is bound to a %s runtime", runtimeType)
+		}
+		if !strings.HasPrefix(snapshot.Spec.BackupPath, common.PathScheme.String()) && !strings.HasPrefix(snapshot.Spec.BackupPath, common.VolumeScheme.String()) {
+			return "", fmt.Errorf("the DataBackup method requires backupPath in the form of pvc://<pvcName>/subpath or local://subpath, got %q", snapshot.Spec.BackupPath)
+		}
+	case datav1alpha1.DatasetSnapshotMethodManifest:
+		if runtimeType == common.VineyardRuntime {
+			return "", fmt.Errorf("the Manifest method is not supported by VineyardRuntime")
+		}
+	default:
+		return "", fmt.Errorf("unknown snapshot method %q", method)
+	}
+	return method, nil
+}
+
+// isClonable checks if the dataset mounts a single sub directory of the JuiceFS volume, the clone is made out of
+// the sub directory so that it's not visible in the dataset.
+func isClonable(dataset *datav1alpha1.Dataset) bool {
+	if len(dataset.Spec.Mounts) != 1 {
+		return false
+	}
+	subPath, err := juicefs.ParseSubPathFromMountPoint(dataset.Spec.Mounts[0].MountPoint)
+	return err == nil && strings.Trim(subPath, "/") != ""
+}
@@ -0,0 +1,59 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datasetsnapshot
+
+import (
+	"fmt"
+	"reflect"
+
+	corev1 "k8s.io/api/core/v1"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+)
+
+// ReadOnlyMounts returns a read-only copy of the mounts
+func ReadOnlyMounts(mounts []datav1alpha1.Mount) []datav1alpha1.Mount {
+	readOnlyMounts := make([]datav1alpha1.Mount, 0, len(mounts))
+	for _, mount := range mounts {
+		readOnlyMount := *mount.DeepCopy()
+		readOnlyMount.ReadOnly = true
+		readOnlyMounts = append(readOnlyMounts, readOnlyMount)
+	}
+	return readOnlyMounts
+}
+
+// MountFromSnapshot fills in the mounts of the dataset from the complete snapshot, the data of a snapshot not
+// cloned must be verified against its manifest first. The access modes default to ReadOnlyMany, and the metadata
+// is restored from the backup taken by the DataBackup method.
+func MountFromSnapshot(dataset *datav1alpha1.Dataset, snapshot *datav1alpha1.DatasetSnapshot) {
+	dataset.Spec.Mounts = ReadOnlyMounts(snapshot.Status.Mounts)
+	if len(dataset.Spec.AccessModes) == 0 {
+		dataset.Spec.AccessModes = []corev1.PersistentVolumeAccessMode{corev1.ReadOnlyMany}
+	}
+	if dataset.Spec.DataRestoreLocation == nil && snapshot.Status.DataRestoreLocation != nil {
+		dataset.Spec.DataRestoreLocation = snapshot.Status.DataRestoreLocation.DeepCopy()
+	}
+}
+
+// VerifySourceMounts checks the dataset the snapshot is taken from still mounts the same paths, since the data
+// of a snapshot not cloned is read from them.
+func VerifySourceMounts(source *datav1alpha1.Dataset, snapshot *datav1alpha1.DatasetSnapshot) error {
+	if !reflect.DeepEqual(ReadOnlyMounts(source.Spec.Mounts), snapshot.Status.Mounts) {
+		return fmt.Errorf("the mounts of dataset %s are changed since the snapshot is taken", source.Name)
+	}
+	return nil
+}
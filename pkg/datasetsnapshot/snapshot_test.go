@@ -0,0 +1,193 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package datasetsnapshot
+
+import (
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+)
+
+func TestResolveMethod(t *testing.T) {
+	testcases := map[string]struct {
+		spec        datav1alpha1.DatasetSnapshotSpec
+		mountPoint  string
+		runtimeType string
+		want        datav1alpha1.DatasetSnapshotMethod
+		wantErr     bool
+	}{
+		"juicefs defaults to clone": {
+			mountPoint:  "juicefs:///demo",
+			runtimeType: common.JuiceFSRuntime,
+			want:        datav1alpha1.DatasetSnapshotMethodClone,
+		},
+		"juicefs mounting the root defaults to manifest": {
+			mountPoint:  "juicefs:///",
+			runtimeType: common.JuiceFSRuntime,
+			want:        datav1alpha1.DatasetSnapshotMethodManifest,
+		},
+		"clone for juicefs mounting the root": {
+			spec:        datav1alpha1.DatasetSnapshotSpec{Method: datav1alpha1.DatasetSnapshotMethodClone},
+			mountPoint:  "juicefs:///",
+			runtimeType: common.JuiceFSRuntime,
+			wantErr:     true,
+		},
+		"alluxio defaults to databackup": {
+			spec:        datav1alpha1.DatasetSnapshotSpec{BackupPath: "pvc://backup/hbase/"},
+			runtimeType: common.AlluxioRuntime,
+			want:        datav1alpha1.DatasetSnapshotMethodDataBackup,
+		},
+		"databackup without backup path": {
+			runtimeType: common.AlluxioRuntime,
+			wantErr:     true,
+		},
+		"thin defaults to manifest": {
+			runtimeType: common.ThinRuntime,
+			want:        datav1alpha1.DatasetSnapshotMethodManifest,
+		},
+		"manifest for juicefs": {
+			spec:        datav1alpha1.DatasetSnapshotSpec{Method: datav1alpha1.DatasetSnapshotMethodManifest},
+			runtimeType: common.JuiceFSRuntime,
+			want:        datav1alpha1.DatasetSnapshotMethodManifest,
+		},
+		"clone for alluxio": {
+			spec:        datav1alpha1.DatasetSnapshotSpec{Method: datav1alpha1.DatasetSnapshotMethodClone},
+			runtimeType: common.AlluxioRuntime,
+			wantErr:     true,
+		},
+		"manifest for vineyard": {
+			runtimeType: common.VineyardRuntime,
+			wantErr:     true,
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			snapshot := &datav1alpha1.DatasetSnapshot{Spec: tc.spec}
+			dataset := &datav1alpha1.Dataset{}
+			if tc.mountPoint != "" {
+				dataset.Spec.Mounts = []datav1alpha1.Mount{{MountPoint: tc.mountPoint}}
+			}
+			got, err := ResolveMethod(snapshot, dataset, tc.runtimeType)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("expect error %v, got %v", tc.wantErr, err)
+			}
+			if got != tc.want {
+				t.Errorf("expect method %s, got %s", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestGetRestoreLocation(t *testing.T) {
+	testcases := map[string]struct {
+		backup *datav1alpha1.DataBackup
+		want   *datav1alpha1.DataRestoreLocation
+	}{
+		"pvc": {
+			backup: &datav1alpha1.DataBackup{
+				Spec: datav1alpha1.DataBackupSpec{Dataset: "hbase", BackupPath: "pvc://backup/hbase/"},
+				Status: datav1alpha1.OperationStatus{Infos: map[string]string{
+					"BackupLocationPath": "pvc://backup/hbase/", "BackupLocationNodeName": "NA",
+				}},
+			},
+			want: &datav1alpha1.DataRestoreLocation{Path: "pvc://backup/hbase/", Dataset: "hbase"},
+		},
+		"local": {
+			backup: &datav1alpha1.DataBackup{
+				Spec: datav1alpha1.DataBackupSpec{Dataset: "hbase", BackupPath: "local:///data/backup/"},
+				Status: datav1alpha1.OperationStatus{Infos: map[string]string{
+					"BackupLocationPath": "local:///data/backup/", "BackupLocationNodeName": "node-1",
+				}},
+			},
+			want: &datav1alpha1.DataRestoreLocation{Path: "local:///data/backup/", NodeName: "node-1", Dataset: "hbase"},
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			if got := GetRestoreLocation(tc.backup); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("expect restore location %v, got %v", tc.want, got)
+			}
+		})
+	}
+}
+
+func TestMountFromSnapshot(t *testing.T) {
+	snapshot := &datav1alpha1.DatasetSnapshot{
+		ObjectMeta: metav1.ObjectMeta{Name: "hbase-v1", Namespace: "default"},
+		Status: datav1alpha1.DatasetSnapshotStatus{
+			Mounts:              []datav1alpha1.Mount{{MountPoint: "oss://bucket/hbase", Name: "hbase", ReadOnly: true}},
+			DataRestoreLocation: &datav1alpha1.DataRestoreLocation{Path: "pvc://backup/hbase/", Dataset: "hbase"},
+		},
+	}
+
+	dataset := &datav1alpha1.Dataset{Spec: datav1alpha1.DatasetSpec{SnapshotRef: &datav1alpha1.DatasetSnapshotRef{Name: "hbase-v1"}}}
+	if !dataset.IsWaitingForSnapshot() {
+		t.Fatalf("expect the dataset to wait for the snapshot")
+	}
+	MountFromSnapshot(dataset, snapshot)
+	if dataset.IsWaitingForSnapshot() {
+		t.Errorf("expect the dataset not to wait for the snapshot once mounted")
+	}
+	if !reflect.DeepEqual(dataset.Spec.Mounts, snapshot.Status.Mounts) {
+		t.Errorf("expect mounts %v, got %v", snapshot.Status.Mounts, dataset.Spec.Mounts)
+	}
+	if !reflect.DeepEqual(dataset.Spec.AccessModes, []corev1.PersistentVolumeAccessMode{corev1.ReadOnlyMany}) {
+		t.Errorf("expect access modes ReadOnlyMany, got %v", dataset.Spec.AccessModes)
+	}
+	if !reflect.DeepEqual(dataset.Spec.DataRestoreLocation, snapshot.Status.DataRestoreLocation) {
+		t.Errorf("expect restore location %v, got %v", snapshot.Status.DataRestoreLocation, dataset.Spec.DataRestoreLocation)
+	}
+
+	dataset = &datav1alpha1.Dataset{Spec: datav1alpha1.DatasetSpec{
+		SnapshotRef: &datav1alpha1.DatasetSnapshotRef{Name: "hbase-v1"},
+		AccessModes: []corev1.PersistentVolumeAccessMode{corev1.ReadWriteMany},
+	}}
+	MountFromSnapshot(dataset, snapshot)
+	if !reflect.DeepEqual(dataset.Spec.AccessModes, []corev1.PersistentVolumeAccessMode{corev1.ReadWriteMany}) {
+		t.Errorf("expect the access modes set in the dataset kept, got %v", dataset.Spec.AccessModes)
+	}
+	if !dataset.Spec.Mounts[0].ReadOnly {
+		t.Errorf("expect the mounts read-only")
+	}
+}
+
+func TestVerifySourceMounts(t *testing.T) {
+	snapshot := &datav1alpha1.DatasetSnapshot{
+		Status: datav1alpha1.DatasetSnapshotStatus{
+			Mounts: []datav1alpha1.Mount{{MountPoint: "oss://bucket/hbase", Name: "hbase", ReadOnly: true}},
+		},
+	}
+	source := &datav1alpha1.Dataset{
+		ObjectMeta: metav1.ObjectMeta{Name: "hbase"},
+		Spec:       datav1alpha1.DatasetSpec{Mounts: []datav1alpha1.Mount{{MountPoint: "oss://bucket/hbase", Name: "hbase"}}},
+	}
+	if err := VerifySourceMounts(source, snapshot); err != nil {
+		t.Errorf("expect no error, got %v", err)
+	}
+
+	source.Spec.Mounts[0].MountPoint = "oss://bucket/hbase-v2"
+	if err := VerifySourceMounts(source, snapshot); err == nil {
+		t.Errorf("expect an error for the changed mounts")
+	}
+}
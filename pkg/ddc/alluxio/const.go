@@ -53,6 +53,18 @@ const (
 
 	wokrerPodRole = "alluxio-worker"
 
+	workerContainerName = "alluxio-worker"
+
+	// handoffLoadTimeoutSeconds is the time spent on loading the blocks of the decommissioning worker into
+	// the target worker in a single sync. The blocks already cached by the target worker are skipped,
+	// so the handoff resumes in the next sync.
+	handoffLoadTimeoutSeconds int32 = 60
+
+	// workerDataFolderKey is the property of the folder storing the blocks in each tiered store directory
+	workerDataFolderKey = "alluxio.worker.data.folder"
+
+	defaultWorkerDataFolder = "alluxioworker"
+
 	// defaultGracefulShutdownLimits is the limit for the system to forcibly clean up.
 	defaultGracefulShutdownLimits       int32 = 3
 	defaultCleanCacheGracePeriodSeconds int32 = 60
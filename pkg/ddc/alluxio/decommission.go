@@ -0,0 +1,159 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package alluxio
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/fluid-cloudnative/fluid/pkg/ctrl"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/alluxio/operations"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// blockSequenceNumberMask masks the sequence number of a block in its id, the rest of which is the container id
+// shared by the blocks of the same file.
+const blockSequenceNumberMask int64 = 1<<24 - 1
+
+var _ ctrl.WorkerCacheHandoff = &AlluxioEngine{}
+
+// decommissionWorkers hands the cache of the workers to be removed by scale-in off to the remaining workers.
+// It runs before the retry on conflict of SyncReplicas, so that the handoff is not repeated on conflicts.
+func (e *AlluxioEngine) decommissionWorkers(ctx cruntime.ReconcileRequestContext) (ready bool, err error) {
+	workers, err := ctrl.GetWorkersAsStatefulset(e.Client,
+		types.NamespacedName{Namespace: e.namespace, Name: e.getWorkerName()})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			// the missing workers are reported by SyncReplicas
+			return true, nil
+		}
+		return false, err
+	}
+
+	runtime, err := e.getRuntime()
+	if err != nil {
+		return false, err
+	}
+
+	return e.Helper.DecommissionWorkers(ctx, runtime, workers, e)
+}
+
+// GetWorkersCachedBytes gets the cached bytes of the worker pods from the capacity report of Alluxio,
+// in which the workers are named by the IP of their nodes.
+func (e *AlluxioEngine) GetWorkersCachedBytes(workers []corev1.Pod) (map[string]int64, error) {
+	usedCapacityMap, err := e.GetWorkerUsedCapacity()
+	if err != nil {
+		return nil, err
+	}
+
+	cachedBytes := map[string]int64{}
+	for _, pod := range workers {
+		if usedCapacity, found := usedCapacityMap[pod.Status.HostIP]; found {
+			cachedBytes[pod.Name] = usedCapacity
+		}
+	}
+	return cachedBytes, nil
+}
+
+// HandoffWorkerCache replicates the blocks cached by the decommissioning worker to the target worker.
+// The blocks are listed from the tiered store of both workers, and the files of the blocks missing in the target
+// worker are loaded into it with `alluxio fs load --local`, so only the cache of the decommissioning worker is copied.
+func (e *AlluxioEngine) HandoffWorkerCache(worker corev1.Pod, target corev1.Pod) (done bool, err error) {
+	blockDirs, err := e.getWorkerBlockDirs()
+	if err != nil {
+		return false, err
+	}
+
+	workerFileUtils := operations.NewAlluxioFileUtils(worker.Name, workerContainerName, e.namespace, e.Log)
+	workerBlocks, err := workerFileUtils.ListBlockIds(blockDirs)
+	if err != nil {
+		return false, err
+	}
+	targetFileUtils := operations.NewAlluxioFileUtils(target.Name, workerContainerName, e.namespace, e.Log)
+	targetBlocks, err := targetFileUtils.ListBlockIds(blockDirs)
+	if err != nil {
+		return false, err
+	}
+
+	cachedBlocks := map[int64]bool{}
+	for _, blockId := range targetBlocks {
+		cachedBlocks[blockId] = true
+	}
+
+	podName, containerName := e.getMasterPodInfo()
+	masterFileUtils := operations.NewAlluxioFileUtils(podName, containerName, e.namespace, e.Log)
+	loadedFiles := map[int64]bool{}
+	deadline := time.Now().Add(time.Duration(handoffLoadTimeoutSeconds) * time.Second)
+	for _, blockId := range workerBlocks {
+		// the blocks of a file share the same container id, and the whole file is loaded at once
+		fileId := blockId | blockSequenceNumberMask
+		if cachedBlocks[blockId] || loadedFiles[fileId] {
+			continue
+		}
+		if time.Now().After(deadline) {
+			e.Log.Info("The handoff of the worker cache continues in the next sync", "worker", worker.Name, "target", target.Name)
+			return false, nil
+		}
+		loadedFiles[fileId] = true
+
+		path, err := masterFileUtils.GetFilePathOfBlock(blockId)
+		if err != nil {
+			// the file may be deleted or freed after the blocks are listed
+			e.Log.Info("Skip the block of which the file is not found", "worker", worker.Name, "block", blockId, "error", err.Error())
+			continue
+		}
+		err = targetFileUtils.LoadLocal(path, handoffLoadTimeoutSeconds)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+// getWorkerBlockDirs gets the directories storing the blocks in the tiered store of the workers,
+// which are mounted at the same paths in the worker containers.
+func (e *AlluxioEngine) getWorkerBlockDirs() (blockDirs []string, err error) {
+	runtime, err := e.getRuntime()
+	if err != nil {
+		return nil, err
+	}
+	runtimeInfo, err := e.getRuntimeInfo()
+	if err != nil {
+		return nil, err
+	}
+
+	dataFolder := defaultWorkerDataFolder
+	if folder := strings.Trim(runtime.Spec.Properties[workerDataFolderKey], "/"); folder != "" {
+		dataFolder = folder
+	}
+
+	for _, level := range runtimeInfo.GetTieredStoreInfo().Levels {
+		for _, cachePath := range level.CachePaths {
+			blockDirs = append(blockDirs, filepath.Join(cachePath.Path, e.namespace, e.name, dataFolder))
+		}
+	}
+	if len(blockDirs) == 0 {
+		return nil, fmt.Errorf("no tiered store is found in runtime %s/%s", e.namespace, e.name)
+	}
+	return blockDirs, nil
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package alluxio
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/alluxio/operations"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestGetWorkersCachedBytes(t *testing.T) {
+	report := `Capacity information for all workers:
+    Total Capacity: 4096.00MB
+        Tier: MEM  Size: 4096.00MB
+    Used Capacity: 443.89MB
+        Tier: MEM  Size: 443.89MB
+    Used Percentage: 10%
+    Free Percentage: 90%
+
+Worker Name      Last Heartbeat   Storage       MEM
+192.168.1.147    0                capacity      2048.00MB
+                                  used          443.89MB (21%)
+192.168.1.146    0                capacity      2048.00MB
+                                  used          0B (0%)
+`
+	engine := &AlluxioEngine{Log: fake.NullLogger()}
+	patches := gomonkey.ApplyPrivateMethod(engine, "reportCapacity", func() (string, error) {
+		return report, nil
+	})
+	defer patches.Reset()
+
+	workers := []corev1.Pod{
+		{ObjectMeta: metav1.ObjectMeta{Name: "hbase-worker-0"}, Status: corev1.PodStatus{HostIP: "192.168.1.147"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "hbase-worker-1"}, Status: corev1.PodStatus{HostIP: "192.168.1.146"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "hbase-worker-2"}, Status: corev1.PodStatus{HostIP: "192.168.1.145"}},
+	}
+	cachedBytes, err := engine.GetWorkersCachedBytes(workers)
+	if err != nil {
+		t.Fatalf("GetWorkersCachedBytes() error = %v", err)
+	}
+
+	if len(cachedBytes) != 2 {
+		t.Errorf("expect the cached bytes of 2 workers, got %v", cachedBytes)
+	}
+	if cachedBytes["hbase-worker-0"] <= 0 {
+		t.Errorf("expect hbase-worker-0 to have cache, got %d", cachedBytes["hbase-worker-0"])
+	}
+	if cached, found := cachedBytes["hbase-worker-1"]; !found || cached != 0 {
+		t.Errorf("expect hbase-worker-1 to have no cache, got %d", cached)
+	}
+	if _, found := cachedBytes["hbase-worker-2"]; found {
+		t.Errorf("expect the cached bytes of hbase-worker-2 to be unknown")
+	}
+}
+
+func TestHandoffWorkerCache(t *testing.T) {
+	engine := &AlluxioEngine{name: "hbase", namespace: "fluid", Log: fake.NullLogger()}
+	patches := gomonkey.ApplyPrivateMethod(engine, "getWorkerBlockDirs", func() ([]string, error) {
+		return []string{"/dev/shm/fluid/hbase/alluxioworker"}, nil
+	})
+	defer patches.Reset()
+
+	// the blocks 16777216 and 16777217 belong to the same file, and the block 50331648 is cached by the target
+	listed := 0
+	patches.ApplyMethod(reflect.TypeOf(operations.AlluxioFileUtils{}), "ListBlockIds",
+		func(_ operations.AlluxioFileUtils, blockDirs []string) ([]int64, error) {
+			listed++
+			if listed == 1 {
+				return []int64{16777216, 16777217, 33554432, 50331648, 67108864}, nil
+			}
+			return []int64{50331648}, nil
+		})
+	paths := map[int64]string{16777216: "/hbase/a", 33554432: "/hbase/b", 50331648: "/hbase/c"}
+	patches.ApplyMethod(reflect.TypeOf(operations.AlluxioFileUtils{}), "GetFilePathOfBlock",
+		func(_ operations.AlluxioFileUtils, blockId int64) (string, error) {
+			if path, found := paths[blockId]; found {
+				return path, nil
+			}
+			return "", errors.New("file not found")
+		})
+	var loaded []string
+	patches.ApplyMethod(reflect.TypeOf(operations.AlluxioFileUtils{}), "LoadLocal",
+		func(_ operations.AlluxioFileUtils, path string, timeout int32) error {
+			loaded = append(loaded, path)
+			return nil
+		})
+
+	worker := corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "hbase-worker-2"}}
+	target := corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "hbase-worker-0"}}
+	done, err := engine.HandoffWorkerCache(worker, target)
+	if err != nil {
+		t.Fatalf("HandoffWorkerCache() error = %v", err)
+	}
+	if !done {
+		t.Errorf("expect the handoff to be done")
+	}
+	if !reflect.DeepEqual(loaded, []string{"/hbase/a", "/hbase/b"}) {
+		t.Errorf("expect only the files of the blocks missing in the target to be loaded, got %v", loaded)
+	}
+}
@@ -165,6 +165,22 @@ func (e *AlluxioEngine) genDataLoadValue(image string, targetDataset *datav1alph
 		})
 	}
 	dataloadInfo.TargetPaths = targetPaths
+
+	// cache the data only on the workers co-located with the target nodes
+	if dataload.Spec.TargetNodes != nil {
+		nodeNames, err := cdataload.ResolveTargetNodes(e.Client, dataload)
+		if err != nil {
+			return nil, err
+		}
+		dataloadInfo.TargetHosts, err = cdataload.GetTargetHosts(e.Client, nodeNames, e.runtimeInfo.GetRuntimeLabelName())
+		if err != nil {
+			return nil, err
+		}
+		if len(dataloadInfo.TargetHosts) == 0 {
+			return nil, fmt.Errorf("no worker of the runtime runs on the target nodes %v of dataload %s", nodeNames, dataload.Name)
+		}
+	}
+
 	dataLoadValue := &cdataload.DataLoadValue{
 		Name:           dataload.Name,
 		OwnerDatasetId: utils.GetDatasetId(targetDataset.Namespace, targetDataset.Name, string(targetDataset.UID)),
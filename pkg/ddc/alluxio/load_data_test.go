@@ -26,6 +26,7 @@ import (
 	. "github.com/agiledragon/gomonkey/v2"
 	"github.com/fluid-cloudnative/fluid/pkg/common"
 	"github.com/fluid-cloudnative/fluid/pkg/ddc/alluxio/operations"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/base"
 
 	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
 	cdataload "github.com/fluid-cloudnative/fluid/pkg/dataload"
@@ -475,6 +476,56 @@ func Test_genDataLoadValue(t *testing.T) {
 	}
 }
 
+func Test_genDataLoadValueWithTargetNodes(t *testing.T) {
+	runtimeInfo, err := base.BuildRuntimeInfo("test-dataset", "fluid", common.AlluxioRuntime)
+	if err != nil {
+		t.Fatalf("failed to build runtime info: %v", err)
+	}
+	newNode := func(name, ip string, labeled bool) *corev1.Node {
+		node := &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Status:     corev1.NodeStatus{Addresses: []corev1.NodeAddress{{Type: corev1.NodeInternalIP, Address: ip}}},
+		}
+		if labeled {
+			node.Labels = map[string]string{runtimeInfo.GetRuntimeLabelName(): "true"}
+		}
+		return node
+	}
+	s := runtime.NewScheme()
+	_ = corev1.AddToScheme(s)
+	client := fake.NewFakeClientWithScheme(s, newNode("node-a", "192.168.0.1", true), newNode("node-b", "192.168.0.2", false))
+
+	engine := AlluxioEngine{
+		namespace:   "fluid",
+		name:        "test-dataset",
+		Client:      client,
+		runtimeInfo: runtimeInfo,
+		Log:         fake.NullLogger(),
+	}
+	targetDataset := &datav1alpha1.Dataset{ObjectMeta: metav1.ObjectMeta{Name: "test-dataset", Namespace: "fluid"}}
+	newDataLoad := func(nodeNames ...string) *datav1alpha1.DataLoad {
+		return &datav1alpha1.DataLoad{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-dataload", Namespace: "fluid"},
+			Spec: datav1alpha1.DataLoadSpec{
+				Dataset:     datav1alpha1.TargetDataset{Name: "test-dataset", Namespace: "fluid"},
+				TargetNodes: &datav1alpha1.DataLoadTargetNodes{NodeNames: nodeNames},
+			},
+		}
+	}
+
+	got, err := engine.genDataLoadValue("fluid:v0.0.1", targetDataset, newDataLoad("node-a", "node-b"))
+	if err != nil {
+		t.Fatalf("genDataLoadValue() got unexpected error: %v", err)
+	}
+	if want := []string{"192.168.0.1"}; !reflect.DeepEqual(got.DataLoadInfo.TargetHosts, want) {
+		t.Errorf("genDataLoadValue() got target hosts %v, want %v", got.DataLoadInfo.TargetHosts, want)
+	}
+
+	if _, err = engine.genDataLoadValue("fluid:v0.0.1", targetDataset, newDataLoad("node-b")); err == nil {
+		t.Errorf("genDataLoadValue() expect error when no worker runs on the target nodes")
+	}
+}
+
 // TestCheckRuntimeReady tests the CheckRuntimeReady function of the AlluxioEngine.
 // This function verifies whether the Alluxio runtime is ready by mocking the execution of container commands.
 // It uses two mock functions:
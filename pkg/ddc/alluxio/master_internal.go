@@ -22,6 +22,7 @@ import (
 
 	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
 	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/base"
 	"github.com/fluid-cloudnative/fluid/pkg/utils"
 	"github.com/fluid-cloudnative/fluid/pkg/utils/helm"
 	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
@@ -54,7 +55,7 @@ func (e *AlluxioEngine) setupMasterInternal() (err error) {
 		return
 	}
 
-	return helm.InstallRelease(e.name, e.namespace, valueFileName, chartName)
+	return base.InstallRelease(e.Client, runtime, e.runtimeType, e.name, e.namespace, valueFileName, chartName)
 }
 
 // generate alluxio struct
@@ -136,9 +136,9 @@ func (e *AlluxioEngine) RestoreMetadataInternal() (err error) {
 		return
 	} else {
 		if pvcName != "" {
-			metadataInfoRestoreFile = "/pvc" + path + e.GetMetadataInfoFileName()
+			metadataInfoRestoreFile = "/pvc" + path + e.getRestoreMetadataInfoFileName(dataset.Spec.DataRestoreLocation)
 		} else {
-			metadataInfoRestoreFile = "/host/" + e.GetMetadataInfoFileName()
+			metadataInfoRestoreFile = "/host/" + e.getRestoreMetadataInfoFileName(dataset.Spec.DataRestoreLocation)
 		}
 	}
 
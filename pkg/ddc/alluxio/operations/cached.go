@@ -91,3 +91,84 @@ func (a AlluxioFileUtils) CleanCache(path string, timeout int32) (err error) {
 
 	return
 }
+
+// LoadLocal loads the data into the local worker with a timeout in seconds, even if the data is
+// already cached by other workers.
+func (a AlluxioFileUtils) LoadLocal(path string, timeout int32) (err error) {
+	var (
+		// command = []string{"timeout", "60", "alluxio", "fs", "load", "--local", path}
+		command = []string{"timeout", strconv.FormatInt(int64(timeout), 10),
+			"alluxio", "fs", "load", "--local", path}
+		stdout string
+		stderr string
+	)
+
+	stdout, stderr, err = a.exec(command, false)
+	if err != nil {
+		err = fmt.Errorf("execute command %v with expectedErr: %v stdout %s and stderr %s", command, err, stdout, stderr)
+		return
+	}
+
+	return
+}
+
+// ListBlockIds lists the ids of the blocks stored in the block directories of the worker
+func (a AlluxioFileUtils) ListBlockIds(blockDirs []string) (blockIds []int64, err error) {
+	var (
+		// command = []string{"find", "/dev/shm/default/hbase/alluxioworker", "-maxdepth", "1", "-type", "f"}
+		command = append(append([]string{"find"}, blockDirs...), "-maxdepth", "1", "-type", "f")
+		stdout  string
+		stderr  string
+	)
+
+	stdout, stderr, err = a.exec(command, false)
+	if err != nil {
+		err = fmt.Errorf("execute command %v with expectedErr: %v stdout %s and stderr %s", command, err, stdout, stderr)
+		return
+	}
+
+	for _, line := range strings.Split(stdout, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		// the blocks are stored in the files named by their ids, other files are skipped
+		blockId, parseErr := strconv.ParseInt(line[strings.LastIndex(line, "/")+1:], 10, 64)
+		if parseErr != nil {
+			continue
+		}
+		blockIds = append(blockIds, blockId)
+	}
+
+	return
+}
+
+// GetFilePathOfBlock gets the path of the file which the block belongs to with `alluxio fsadmin getBlockInfo`
+func (a AlluxioFileUtils) GetFilePathOfBlock(blockId int64) (path string, err error) {
+	var (
+		command = []string{"alluxio", "fsadmin", "getBlockInfo", strconv.FormatInt(blockId, 10)}
+		stdout  string
+		stderr  string
+	)
+
+	stdout, stderr, err = a.exec(command, false)
+	if err != nil {
+		err = fmt.Errorf("execute command %v with expectedErr: %v stdout %s and stderr %s", command, err, stdout, stderr)
+		return
+	}
+
+	// e.g. This block belongs to file {id=16777215, path=/hbase/hbase-1.2.6-bin.tar.gz}
+	for _, line := range strings.Split(stdout, "\n") {
+		if !strings.HasPrefix(line, "This block belongs to file") {
+			continue
+		}
+		start := strings.Index(line, "path=")
+		end := strings.LastIndex(line, "}")
+		if start < 0 || end < start {
+			break
+		}
+		return line[start+len("path=") : end], nil
+	}
+
+	return "", fmt.Errorf("failed to find the file of block %d in output %v", blockId, stdout)
+}
@@ -18,6 +18,7 @@ package operations
 
 import (
 	"errors"
+	"reflect"
 	"testing"
 
 	"github.com/agiledragon/gomonkey/v2"
@@ -108,3 +109,66 @@ func TestAlluxioFIlUtils_CleanCache(t *testing.T) {
 		t.Errorf("check failure, want nil, got err: %v", err)
 	}
 }
+
+func TestAlluxioFileUtils_ListBlockIds(t *testing.T) {
+	ExecCommon := func(a AlluxioFileUtils, command []string, verbose bool) (stdout string, stderr string, err error) {
+		return "/dev/shm/default/hbase/alluxioworker/16777216\n/dev/shm/default/hbase/alluxioworker/33554432\n/dev/shm/default/hbase/alluxioworker/.tmp\n", "", nil
+	}
+	ExecErr := func(a AlluxioFileUtils, command []string, verbose bool) (stdout string, stderr string, err error) {
+		return "", "", errors.New("fail to run the command")
+	}
+
+	patches := gomonkey.ApplyFunc(AlluxioFileUtils.exec, ExecErr)
+	defer patches.Reset()
+
+	a := &AlluxioFileUtils{log: fake.NullLogger()}
+	_, err := a.ListBlockIds([]string{"/dev/shm/default/hbase/alluxioworker"})
+	if err == nil {
+		t.Error("check failure, want err, got nil")
+	}
+
+	patches.ApplyFunc(AlluxioFileUtils.exec, ExecCommon)
+	blockIds, err := a.ListBlockIds([]string{"/dev/shm/default/hbase/alluxioworker"})
+	if err != nil {
+		t.Errorf("check failure, want nil, got err: %v", err)
+	}
+	if !reflect.DeepEqual(blockIds, []int64{16777216, 33554432}) {
+		t.Errorf("check failure, want [16777216 33554432], got %v", blockIds)
+	}
+}
+
+func TestAlluxioFileUtils_GetFilePathOfBlock(t *testing.T) {
+	ExecCommon := func(a AlluxioFileUtils, command []string, verbose bool) (stdout string, stderr string, err error) {
+		return "BlockInfo{id=16777216, length=1024, locations=[]}\nThis block belongs to file {id=33554431, path=/hbase/hbase-1.2.6-bin.tar.gz}\n", "", nil
+	}
+	ExecNotFound := func(a AlluxioFileUtils, command []string, verbose bool) (stdout string, stderr string, err error) {
+		return "BlockInfo{id=16777216, length=1024, locations=[]}\n", "", nil
+	}
+	ExecErr := func(a AlluxioFileUtils, command []string, verbose bool) (stdout string, stderr string, err error) {
+		return "", "", errors.New("fail to run the command")
+	}
+
+	patches := gomonkey.ApplyFunc(AlluxioFileUtils.exec, ExecErr)
+	defer patches.Reset()
+
+	a := &AlluxioFileUtils{log: fake.NullLogger()}
+	_, err := a.GetFilePathOfBlock(16777216)
+	if err == nil {
+		t.Error("check failure, want err, got nil")
+	}
+
+	patches.ApplyFunc(AlluxioFileUtils.exec, ExecNotFound)
+	_, err = a.GetFilePathOfBlock(16777216)
+	if err == nil {
+		t.Error("check failure, want err, got nil")
+	}
+
+	patches.ApplyFunc(AlluxioFileUtils.exec, ExecCommon)
+	path, err := a.GetFilePathOfBlock(16777216)
+	if err != nil {
+		t.Errorf("check failure, want nil, got err: %v", err)
+	}
+	if path != "/hbase/hbase-1.2.6-bin.tar.gz" {
+		t.Errorf("check failure, want /hbase/hbase-1.2.6-bin.tar.gz, got %s", path)
+	}
+}
@@ -33,6 +33,16 @@ import (
 
 // SyncReplicas syncs the replicas
 func (e *AlluxioEngine) SyncReplicas(ctx cruntime.ReconcileRequestContext) (err error) {
+	ready, err := e.decommissionWorkers(ctx)
+	if err != nil {
+		_ = utils.LoggingErrorExceptConflict(e.Log, err, "Failed to decommission workers", types.NamespacedName{Namespace: e.namespace, Name: e.name})
+		return
+	}
+	if !ready {
+		e.Log.Info("Hold the scale-in until the cache of the decommissioning workers is handed off")
+		return
+	}
+
 	err = retry.RetryOnConflict(retry.DefaultBackoff, func() error {
 		workers, err := ctrl.GetWorkersAsStatefulset(e.Client,
 			types.NamespacedName{Namespace: e.namespace, Name: e.getWorkerName()})
@@ -22,6 +22,7 @@ import (
 	"strings"
 
 	"github.com/fluid-cloudnative/fluid/pkg/ddc/alluxio/operations"
+
 	ddctypes "github.com/fluid-cloudnative/fluid/pkg/ddc/types"
 	"github.com/fluid-cloudnative/fluid/pkg/utils"
 )
@@ -333,7 +333,7 @@ func (e *AlluxioEngine) transformMasters(runtime *datav1alpha1.AlluxioRuntime,
 				value.Master.Restore.Enabled = true
 				value.Master.Restore.PVCName = pvcName
 				value.Master.Restore.Path = path
-				value.Master.Env["JOURNAL_BACKUP"] = "/pvc" + path + e.GetMetadataFileName()
+				value.Master.Env["JOURNAL_BACKUP"] = "/pvc" + path + e.getRestoreMetadataFileName(dataset.Spec.DataRestoreLocation)
 			} else if dataset.Spec.DataRestoreLocation.NodeName != "" {
 				// RestorePath is in the form of local://subpath
 				value.Master.Restore.Enabled = true
@@ -341,7 +341,7 @@ func (e *AlluxioEngine) transformMasters(runtime *datav1alpha1.AlluxioRuntime,
 					value.Master.NodeSelector = map[string]string{}
 				}
 				value.Master.NodeSelector["kubernetes.io/hostname"] = dataset.Spec.DataRestoreLocation.NodeName
-				value.Master.Env["JOURNAL_BACKUP"] = "/host/" + e.GetMetadataFileName()
+				value.Master.Env["JOURNAL_BACKUP"] = "/host/" + e.getRestoreMetadataFileName(dataset.Spec.DataRestoreLocation)
 				value.Master.Restore.Path = path
 			} else {
 				// RestorePath in Dataset cannot analyse
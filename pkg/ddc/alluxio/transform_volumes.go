@@ -18,9 +18,10 @@ package alluxio
 
 import (
 	"fmt"
+
 	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/credentials"
 	"github.com/fluid-cloudnative/fluid/pkg/utils"
-	"path/filepath"
 
 	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
 	corev1 "k8s.io/api/core/v1"
@@ -64,31 +65,25 @@ func (e *AlluxioEngine) transformEncryptOptionsToMasterVolumes(dataset *datav1al
 		}
 		options = make(map[string]string)
 		for _, encryptOpt := range append(dataset.Spec.SharedEncryptOptions, m.EncryptOptions...) {
-			secretName := encryptOpt.ValueFrom.SecretKeyRef.Name
-			secretMountPath := fmt.Sprintf("/etc/fluid/secrets/%s", secretName)
-
-			volName := fmt.Sprintf("alluxio-mount-secret-%s", secretName)
-			volumeToAdd := corev1.Volume{
-				Name: volName,
-				VolumeSource: corev1.VolumeSource{
-					Secret: &corev1.SecretVolumeSource{
-						SecretName: secretName,
-					},
-				},
-			}
-			value.Master.Volumes = utils.AppendOrOverrideVolume(value.Master.Volumes, volumeToAdd)
+			file := credentials.GetFile(dataset.Name, encryptOpt, getEncryptOptionVolumeName)
+			value.Master.Volumes = utils.AppendOrOverrideVolume(value.Master.Volumes, file.Volume)
 			volumeMountToAdd := corev1.VolumeMount{
-				Name:      volName,
+				Name:      file.Volume.Name,
 				ReadOnly:  true,
-				MountPath: secretMountPath,
+				MountPath: file.MountPath,
 			}
 			value.Master.VolumeMounts = utils.AppendOrOverrideVolumeMounts(value.Master.VolumeMounts, volumeMountToAdd)
-			options[encryptOpt.Name] = filepath.Join(secretMountPath, encryptOpt.ValueFrom.SecretKeyRef.Key)
+			options[encryptOpt.Name] = file.Path
 		}
 	}
 	return options
 }
 
+// getEncryptOptionVolumeName names the volume of the secret or the SecretProviderClass holding encrypt options
+func getEncryptOptionVolumeName(source string) string {
+	return fmt.Sprintf("alluxio-mount-secret-%s", source)
+}
+
 // transform worker volumes
 func (e *AlluxioEngine) transformWorkerVolumes(runtime *datav1alpha1.AlluxioRuntime, value *Alluxio) (err error) {
 	if len(runtime.Spec.Worker.VolumeMounts) > 0 {
@@ -19,7 +19,6 @@ package alluxio
 import (
 	"context"
 	"fmt"
-	"path/filepath"
 	"reflect"
 	"strings"
 	"time"
@@ -28,6 +27,7 @@ import (
 
 	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
 	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/credentials"
 	"github.com/fluid-cloudnative/fluid/pkg/ddc/alluxio/operations"
 	"github.com/fluid-cloudnative/fluid/pkg/utils"
 	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
@@ -434,9 +434,8 @@ func (e *AlluxioEngine) genUFSMountOptions(m datav1alpha1.Mount, SharedOptions m
 	} else {
 		// using mount file
 		for _, encryptOpt := range append(SharedEncryptOptions, m.EncryptOptions...) {
-			secretName := encryptOpt.ValueFrom.SecretKeyRef.Name
-			secretMountPath := fmt.Sprintf("/etc/fluid/secrets/%s", secretName)
-			mOptions[encryptOpt.Name] = filepath.Join(secretMountPath, encryptOpt.ValueFrom.SecretKeyRef.Key)
+			file := credentials.GetFile(e.name, encryptOpt, getEncryptOptionVolumeName)
+			mOptions[encryptOpt.Name] = file.Path
 		}
 	}
 	return mOptions, nil
@@ -452,7 +451,10 @@ func (e *AlluxioEngine) genEncryptOptions(EncryptOptions []datav1alpha1.EncryptO
 		}
 
 		securityutil.UpdateSensitiveKey(item.Name)
-		sRef := item.ValueFrom.SecretKeyRef
+		sRef, err := credentials.GetSecretKeyRef(e.name, item)
+		if err != nil {
+			return mOptions, err
+		}
 		secret, err := kubeclient.GetSecret(e.Client, sRef.Name, e.namespace)
 		if err != nil {
 			e.Log.Error(err, "get secret by mount encrypt options failed", "name", item.Name)
@@ -284,6 +284,23 @@ func (e *AlluxioEngine) GetMetadataInfoFileName() string {
 	return e.name + "-" + e.namespace + ".yaml"
 }
 
+// getRestoreMetadataFileName returns the name of the metadata backup file to restore from,
+// which is named after the dataset the backup was taken from
+func (e *AlluxioEngine) getRestoreMetadataFileName(location *datav1alpha1.DataRestoreLocation) string {
+	if location.Dataset == "" {
+		return e.GetMetadataFileName()
+	}
+	return "metadata-backup-" + location.Dataset + "-" + e.namespace + ".gz"
+}
+
+// getRestoreMetadataInfoFileName returns the name of the metadata info file to restore from
+func (e *AlluxioEngine) getRestoreMetadataInfoFileName(location *datav1alpha1.DataRestoreLocation) string {
+	if location.Dataset == "" {
+		return e.GetMetadataInfoFileName()
+	}
+	return location.Dataset + "-" + e.namespace + ".yaml"
+}
+
 // GetWorkerUsedCapacity gets cache capacity usage for each worker as a map.
 // It parses result from stdout when executing `alluxio fsadmin report capacity` command
 // and extracts worker name(IP or hostname) along with used capacity for that worker
@@ -325,9 +342,12 @@ func (e *AlluxioEngine) GetWorkerUsedCapacity() (map[string]int64, error) {
 
 	worker2UsedCapacityMap := make(map[string]int64)
 	lenLines := len(lines)
-	for lineIdx := startIdx; lineIdx < lenLines; lineIdx += 2 {
+	for lineIdx := startIdx; lineIdx+1 < lenLines; lineIdx += 2 {
 		// e.g. ["192.168.1.147", "0", "capacity", "2048.00MB", "used", "443.89MB", "(21%)"]
 		workerInfoFields := append(strings.Fields(lines[lineIdx]), strings.Fields(lines[lineIdx+1])...)
+		if len(workerInfoFields) < 6 {
+			continue
+		}
 		workerName := workerInfoFields[0]
 		usedCapacity, _ := utils.FromHumanSize(workerInfoFields[5])
 		worker2UsedCapacityMap[workerName] = usedCapacity
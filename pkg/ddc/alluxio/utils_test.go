@@ -1241,6 +1241,42 @@ func TestGetMetadataInfoFileName(t *testing.T) {
 	}
 }
 
+func TestGetRestoreMetadataFileName(t *testing.T) {
+	tests := []struct {
+		name         string
+		location     *datav1alpha1.DataRestoreLocation
+		wantMetadata string
+		wantInfo     string
+	}{
+		{
+			name:         "restore the backup of the dataset itself",
+			location:     &datav1alpha1.DataRestoreLocation{Path: "pvc://backup/"},
+			wantMetadata: "metadata-backup-spark-default.gz",
+			wantInfo:     "spark-default.yaml",
+		},
+		{
+			name:         "restore the backup of another dataset",
+			location:     &datav1alpha1.DataRestoreLocation{Path: "pvc://backup/", Dataset: "hbase"},
+			wantMetadata: "metadata-backup-hbase-default.gz",
+			wantInfo:     "hbase-default.yaml",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := &AlluxioEngine{
+				name:      "spark",
+				namespace: "default",
+			}
+			if got := e.getRestoreMetadataFileName(tt.location); got != tt.wantMetadata {
+				t.Errorf("AlluxioEngine.getRestoreMetadataFileName() = %v, want %v", got, tt.wantMetadata)
+			}
+			if got := e.getRestoreMetadataInfoFileName(tt.location); got != tt.wantInfo {
+				t.Errorf("AlluxioEngine.getRestoreMetadataInfoFileName() = %v, want %v", got, tt.wantInfo)
+			}
+		})
+	}
+}
+
 func TestGetWorkerUsedCapacity(t *testing.T) {
 	type fields struct {
 		runtime   *datav1alpha1.AlluxioRuntime
@@ -0,0 +1,84 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package base
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/metrics"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+)
+
+// RecordCacheMetrics exports the cache states of the dataset as Prometheus metrics. The states which are
+// missing or not parsable (e.g. "N/A") are not exported.
+func RecordCacheMetrics(datasetNamespace, datasetName string, states common.CacheStateList) {
+	metrics.GetOrCreateDatasetMetrics(datasetNamespace, datasetName).SetCacheStates(metrics.DatasetCacheStates{
+		CacheCapacity:        bytesMetric(ParseCacheStateBytes(states, common.CacheCapacity)),
+		Cached:               bytesMetric(ParseCacheStateBytes(states, common.Cached)),
+		CachedRatio:          ParseCacheStateRatio(states, common.CachedPercentage),
+		CacheHitRatio:        ParseCacheStateRatio(states, common.CacheHitRatio),
+		CacheThroughputRatio: ParseCacheStateRatio(states, common.CacheThroughputRatio),
+	})
+}
+
+// ParseCacheStateBytes parses a human readable size (e.g. 2.00GiB) in the cache states into bytes. found is false
+// if the size is missing or not parsable (e.g. "N/A").
+func ParseCacheStateBytes(states common.CacheStateList, name common.CacheStateName) (bytes int64, found bool) {
+	return ParseSizeBytes(states[name])
+}
+
+// ParseSizeBytes parses a human readable size reported by the runtimes in the dataset status into bytes, the sizes
+// are reported in best effort so found is false if the size is missing or not parsable.
+func ParseSizeBytes(size string) (bytes int64, found bool) {
+	size = strings.TrimSpace(size)
+	if len(size) == 0 {
+		return 0, false
+	}
+	bytes, err := utils.FromHumanSize(size)
+	if err != nil {
+		return 0, false
+	}
+	return bytes, true
+}
+
+func bytesMetric(bytes int64, found bool) *float64 {
+	if !found {
+		return nil
+	}
+	ret := float64(bytes)
+	return &ret
+}
+
+// ParseCacheStateRatio parses a percentage (e.g. 85.5%) or a ratio (e.g. 0.855) into a ratio from 0 to 1.
+func ParseCacheStateRatio(states common.CacheStateList, name common.CacheStateName) *float64 {
+	value := strings.TrimSpace(states[name])
+	if len(value) == 0 {
+		return nil
+	}
+
+	percentage := strings.HasSuffix(value, "%")
+	ratio, err := strconv.ParseFloat(strings.TrimSpace(strings.TrimSuffix(value, "%")), 64)
+	if err != nil {
+		return nil
+	}
+	if percentage {
+		ratio = ratio / 100.0
+	}
+	return &ratio
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package base_test
+
+import (
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/base"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+// gatherDatasetGauge returns the value of the gauge with the dataset label, and whether it's found.
+func gatherDatasetGauge(name, dataset string) (float64, bool) {
+	families, err := ctrlmetrics.Registry.Gather()
+	Expect(err).NotTo(HaveOccurred())
+	for _, family := range families {
+		if family.GetName() != name {
+			continue
+		}
+		for _, m := range family.GetMetric() {
+			for _, label := range m.GetLabel() {
+				if label.GetName() == "dataset" && label.GetValue() == dataset {
+					return m.GetGauge().GetValue(), true
+				}
+			}
+		}
+	}
+	return 0, false
+}
+
+var _ = Describe("RecordCacheMetrics", func() {
+	const dataset = "cache-metrics/demo"
+
+	It("should export parsable cache states as gauges", func() {
+		base.RecordCacheMetrics("cache-metrics", "demo", common.CacheStateList{
+			common.CacheCapacity:        "2.00GiB",
+			common.Cached:               "512.00MiB",
+			common.CachedPercentage:     "25.0%",
+			common.CacheHitRatio:        "0.5",
+			common.CacheThroughputRatio: "N/A",
+		})
+
+		value, found := gatherDatasetGauge("dataset_cache_capacity_bytes", dataset)
+		Expect(found).To(BeTrue())
+		Expect(value).To(Equal(float64(2 << 30)))
+
+		value, found = gatherDatasetGauge("dataset_cached_bytes", dataset)
+		Expect(found).To(BeTrue())
+		Expect(value).To(Equal(float64(512 << 20)))
+
+		value, found = gatherDatasetGauge("dataset_cached_ratio", dataset)
+		Expect(found).To(BeTrue())
+		Expect(value).To(BeNumerically("~", 0.25))
+
+		value, found = gatherDatasetGauge("dataset_cache_hit_ratio", dataset)
+		Expect(found).To(BeTrue())
+		Expect(value).To(BeNumerically("~", 0.5))
+
+		_, found = gatherDatasetGauge("dataset_cache_throughput_ratio", dataset)
+		Expect(found).To(BeFalse())
+	})
+
+	It("should delete the gauges whose states become unavailable", func() {
+		base.RecordCacheMetrics("cache-metrics", "demo", common.CacheStateList{
+			common.CacheHitRatio: "80%",
+		})
+		base.RecordCacheMetrics("cache-metrics", "demo", common.CacheStateList{})
+
+		_, found := gatherDatasetGauge("dataset_cache_hit_ratio", dataset)
+		Expect(found).To(BeFalse())
+	})
+})
+
+var _ = Describe("ParseCacheStateBytes", func() {
+	It("should parse the human readable sizes and reject the missing or malformed ones", func() {
+		states := common.CacheStateList{
+			common.CacheCapacity: " 2.00GiB ",
+			common.Cached:        "N/A",
+		}
+
+		bytes, found := base.ParseCacheStateBytes(states, common.CacheCapacity)
+		Expect(found).To(BeTrue())
+		Expect(bytes).To(Equal(int64(2 << 30)))
+
+		_, found = base.ParseCacheStateBytes(states, common.Cached)
+		Expect(found).To(BeFalse())
+
+		_, found = base.ParseCacheStateBytes(states, common.CachedPercentage)
+		Expect(found).To(BeFalse())
+
+		bytes, found = base.ParseSizeBytes("512.00MiB")
+		Expect(found).To(BeTrue())
+		Expect(bytes).To(Equal(int64(512 << 20)))
+	})
+})
@@ -119,14 +119,25 @@ func (e *EngineOperationReconciler) reconcilePending(ctx cruntime.ReconcileReque
 		return utils.NoRequeue()
 	}
 
-	// 2. set current data operation to dataset
-	err := SetDataOperationInTargetDataset(ctx, operation, e.Engine)
+	// 2. wait in the operation queue if the runtime limits the number of concurrent data operations
+	position, err := getQueuePosition(ctx, e.Client, operation)
+	if err != nil {
+		log.Error(err, "failed to get the position in the operation queue")
+		return utils.RequeueIfError(err)
+	}
+	if position > 0 {
+		return e.waitInQueue(ctx, opStatus, operation, position)
+	}
+
+	// 3. set current data operation to dataset
+	err = SetDataOperationInTargetDataset(ctx, operation, e.Engine)
 	if err != nil {
 		return utils.RequeueAfterInterval(20 * time.Second)
 	}
 
 	log.Info("Set data operation on target dataset, try to update phase")
 	opStatus.Phase = common.PhaseExecuting
+	opStatus.WaitingFor.QueuePosition = nil
 	if err = operation.UpdateOperationApiStatus(opStatus); err != nil {
 		log.Error(err, fmt.Sprintf("failed to update %s status to Executing, will retry", operation.GetOperationType()))
 		return utils.RequeueIfError(err)
@@ -140,6 +151,11 @@ func (e *EngineOperationReconciler) reconcileExecuting(ctx cruntime.ReconcileReq
 	operation dataoperation.OperationInterface) (ctrl.Result, error) {
 	log := ctx.Log.WithName("reconcileExecuting")
 
+	// 0. retry the failed attempt after the backoff
+	if opStatus.NextRetryTime != nil {
+		return e.reconcileRetry(ctx, opStatus, operation)
+	}
+
 	// 1. Install the helm chart if not exists
 	err := InstallDataOperationHelmIfNotExist(ctx, operation, e.Engine)
 	if err != nil {
@@ -175,6 +191,21 @@ func (e *EngineOperationReconciler) reconcileExecuting(ctx cruntime.ReconcileReq
 		log.Error(err, "failed to update status")
 		return utils.RequeueIfError(err)
 	}
+	// 3. record the attempts and retry the failed attempt if the data operation has a retry policy
+	if retryPolicy := operation.GetRetryPolicy(); retryPolicy != nil {
+		if err = updateAttempts(ctx, e.Client, operation, retryPolicy, opStatus, opStatusToUpdate); err != nil {
+			log.Error(err, "failed to update the attempts")
+			return utils.RequeueIfError(err)
+		}
+	}
+	// 4. finish the run once the data operation leaves Executing
+	finished := isFinishedPhase(opStatusToUpdate.Phase)
+	if finished {
+		if err = e.finishRun(ctx, operation, opStatusToUpdate); err != nil {
+			log.Error(err, "failed to finish the run")
+			return utils.RequeueIfError(err)
+		}
+	}
 	if !reflect.DeepEqual(opStatus, opStatusToUpdate) {
 		if err = operation.UpdateOperationApiStatus(opStatusToUpdate); err != nil {
 			log.Error(err, "failed to update api status")
@@ -182,10 +213,80 @@ func (e *EngineOperationReconciler) reconcileExecuting(ctx cruntime.ReconcileReq
 		}
 		log.V(1).Info(fmt.Sprintf("update operation status to %s successfully", opStatusToUpdate.Phase), "opstatus", opStatusToUpdate)
 	}
+	if finished {
+		recordFinishedRun(ctx, operation, opStatusToUpdate.Phase)
+		// update operation status would trigger requeue, no need to requeue here
+		return utils.NoRequeue()
+	}
 
 	return utils.RequeueAfterInterval(20 * time.Second)
 }
 
+// finishRun is called when a run of the data operation finishes, i.e. the data operation turns Complete or Failed,
+// or the status handler observes another finished run, e.g. the next job of a cron data operation. It fills in the
+// infos of the completed run and scales in the workers of the parallel data operation before the status is updated.
+func (e *EngineOperationReconciler) finishRun(ctx cruntime.ReconcileRequestContext, operation dataoperation.OperationInterface,
+	opStatusToUpdate *datav1alpha1.OperationStatus) error {
+	if opStatusToUpdate.Phase == common.PhaseComplete {
+		if opStatusToUpdate.Infos == nil {
+			opStatusToUpdate.Infos = map[string]string{}
+		}
+		// different data operation may set different key-value
+		if err := operation.UpdateStatusInfoForCompleted(opStatusToUpdate.Infos); err != nil {
+			return err
+		}
+		completeTargetNodes(ctx, operation, opStatusToUpdate)
+	}
+
+	// scale the statefulset replicas to 0 for parallel data operation
+	if operation.GetParallelTaskNumber() > 1 {
+		releaseNameSpacedName := operation.GetReleaseNameSpacedName()
+		return kubeclient.ScaleStatefulSet(e.Client, utils.GetParallelOperationWorkersName(releaseNameSpacedName.Name), releaseNameSpacedName.Namespace, 0)
+	}
+	return nil
+}
+
+// completeTargetNodes marks the target nodes with a worker of the runtime as loaded when the run completes.
+// The files are spread over the workers on the target nodes by their replicas, so it's not verified which of them
+// each worker caches.
+func completeTargetNodes(ctx cruntime.ReconcileRequestContext, operation dataoperation.OperationInterface,
+	opStatusToUpdate *datav1alpha1.OperationStatus) {
+	var notLoaded []string
+	for i := range opStatusToUpdate.TargetNodes {
+		node := &opStatusToUpdate.TargetNodes[i]
+		if node.Phase == datav1alpha1.TargetNodePending {
+			node.Phase = datav1alpha1.TargetNodeLoaded
+		}
+		if node.Phase != datav1alpha1.TargetNodeLoaded {
+			notLoaded = append(notLoaded, fmt.Sprintf("%s(%s)", node.NodeName, node.Phase))
+		}
+	}
+	if len(notLoaded) > 0 && ctx.Recorder != nil {
+		ctx.Recorder.Eventf(operation.GetOperationObject(), v1.EventTypeWarning, common.DataLoadTargetNodesNotCached,
+			"The data is not loaded to the target nodes %v", notLoaded)
+	}
+}
+
+// recordFinishedRun records the event of the finished run after the status is updated
+func recordFinishedRun(ctx cruntime.ReconcileRequestContext, operation dataoperation.OperationInterface, phase common.Phase) {
+	if ctx.Recorder == nil {
+		return
+	}
+	object := operation.GetOperationObject()
+	if phase == common.PhaseComplete {
+		ctx.Recorder.Eventf(object, v1.EventTypeNormal, common.DataOperationSucceed,
+			"%s %s succeeded", operation.GetOperationType(), object.GetName())
+	} else {
+		ctx.Recorder.Eventf(object, v1.EventTypeWarning, common.DataOperationFailed, "%s %s failed", operation.GetOperationType(), object.GetName())
+	}
+}
+
+// observeFinishedRun checks if the status handler observes a finished run which the data operation hasn't recorded yet
+func observeFinishedRun(opStatus, opStatusToUpdate *datav1alpha1.OperationStatus) bool {
+	return isFinishedPhase(opStatusToUpdate.Phase) &&
+		(opStatus.Phase != opStatusToUpdate.Phase || !reflect.DeepEqual(opStatus.Conditions, opStatusToUpdate.Conditions))
+}
+
 func (e *EngineOperationReconciler) reconcileComplete(ctx cruntime.ReconcileRequestContext, opStatus *datav1alpha1.OperationStatus,
 	operation dataoperation.OperationInterface) (ctrl.Result, error) {
 	log := ctx.Log.WithName("reconcileComplete")
@@ -205,28 +306,13 @@ func (e *EngineOperationReconciler) reconcileComplete(ctx cruntime.ReconcileRequ
 		}
 	}
 
-	// 1. Update the infos field if complete
-	if opStatus.Infos == nil {
-		opStatus.Infos = map[string]string{}
-	}
-	// different data operation may set different key-value
-	err := operation.UpdateStatusInfoForCompleted(opStatus.Infos)
-	if err != nil {
-		return utils.RequeueIfError(err)
-	}
-
-	if err = operation.UpdateOperationApiStatus(opStatus); err != nil {
-		log.Error(err, fmt.Sprintf("failed to update the %s status", operation.GetOperationType()))
-		return utils.RequeueIfError(err)
-	}
-
-	// 2. remove current data operation on target dataset if complete
-	err = ReleaseTargetDataset(ctx, operation)
+	// 1. remove current data operation on target dataset if complete
+	err := ReleaseTargetDataset(ctx, operation)
 	if err != nil {
 		return utils.RequeueIfError(err)
 	}
 
-	// 3. check and update data operation's status by helm status
+	// 2. check and update data operation's status by helm status
 	statusHandler := operation.GetStatusHandler()
 	if statusHandler == nil {
 		err := fmt.Errorf("fail to get status handler")
@@ -238,6 +324,14 @@ func (e *EngineOperationReconciler) reconcileComplete(ctx cruntime.ReconcileRequ
 		log.Error(err, "failed to update status")
 		return utils.RequeueIfError(err)
 	}
+	// For cron operations, the phase may be updated to pending here, and another finished run may be observed
+	finished := observeFinishedRun(opStatus, opStatusToUpdate)
+	if finished {
+		if err = e.finishRun(ctx, operation, opStatusToUpdate); err != nil {
+			log.Error(err, "failed to finish the run")
+			return utils.RequeueIfError(err)
+		}
+	}
 	if !reflect.DeepEqual(opStatus, opStatusToUpdate) {
 		if err = operation.UpdateOperationApiStatus(opStatusToUpdate); err != nil {
 			log.Error(err, fmt.Sprintf("failed to update the %s status", operation.GetOperationType()))
@@ -246,25 +340,12 @@ func (e *EngineOperationReconciler) reconcileComplete(ctx cruntime.ReconcileRequ
 		log.V(1).Info(fmt.Sprintf("update operation status to %s successfully", opStatusToUpdate.Phase), "opstatus", opStatusToUpdate)
 	}
 
-	// 4. record and no requeue
-	// For cron operations, the phase may be updated to pending here, and we only log bellow messages in complete phase
-	if opStatusToUpdate.Phase == common.PhaseComplete {
-		object := operation.GetOperationObject()
-		ctx.Recorder.Eventf(object, v1.EventTypeNormal, common.DataOperationSucceed,
-			"%s %s succeeded", operation.GetOperationType(), object.GetName())
-
-		// scale the statefulset replicas to 0 for parallel data operation
-		// if the status not Complete, there is a new starting job, not scale the statefulset to zero.
-		if operation.GetParallelTaskNumber() > 1 {
-			releaseNameSpacedName := operation.GetReleaseNameSpacedName()
-			err = kubeclient.ScaleStatefulSet(client, utils.GetParallelOperationWorkersName(releaseNameSpacedName.Name), releaseNameSpacedName.Namespace, 0)
-			if err != nil {
-				return utils.RequeueIfError(err)
-			}
-		}
+	// 3. record the finished run only once
+	if finished {
+		recordFinishedRun(ctx, operation, opStatusToUpdate.Phase)
 	}
 
-	// 5. Requeue if data operation set ttl after finished and has not expired
+	// 4. Requeue if data operation set ttl after finished and has not expired
 	if ttl != nil && *ttl > 0 {
 		log.V(1).Info("requeue after remaining time to clean up data operation", "timeToLive", ttl)
 		return utils.RequeueAfterInterval(*ttl)
@@ -329,6 +410,14 @@ func (e *EngineOperationReconciler) reconcileFailed(ctx cruntime.ReconcileReques
 		log.Error(err, "failed to update status")
 		return utils.RequeueIfError(err)
 	}
+	// For cron operations, the phase may be updated to pending here, and another finished run may be observed
+	finished := observeFinishedRun(opStatus, opStatusToUpdate)
+	if finished {
+		if err = e.finishRun(ctx, operation, opStatusToUpdate); err != nil {
+			log.Error(err, "failed to finish the run")
+			return utils.RequeueIfError(err)
+		}
+	}
 	if !reflect.DeepEqual(opStatus, opStatusToUpdate) {
 		if err = operation.UpdateOperationApiStatus(opStatusToUpdate); err != nil {
 			log.Error(err, fmt.Sprintf("failed to update the %s status", operation.GetOperationType()))
@@ -337,24 +426,12 @@ func (e *EngineOperationReconciler) reconcileFailed(ctx cruntime.ReconcileReques
 		log.V(1).Info(fmt.Sprintf("update operation status to %s successfully", opStatusToUpdate.Phase), "opstatus", opStatusToUpdate)
 	}
 
-	// 2. record and no requeue
-	// For cron operations, the phase may be updated to pending here, and we only log bellow messages in failed phase
-	if opStatusToUpdate.Phase == common.PhaseFailed {
-		object := operation.GetOperationObject()
-		ctx.Recorder.Eventf(object, v1.EventTypeWarning, common.DataOperationFailed, "%s %s failed", operation.GetOperationType(), object.GetName())
-
-		// scale the statefulset replicas to 0 for parallel data operation
-		// if the status not PhaseFailed, there is a new starting job, not scale the statefulset to zero.
-		if operation.GetParallelTaskNumber() > 1 {
-			releaseNameSpacedName := operation.GetReleaseNameSpacedName()
-			err = kubeclient.ScaleStatefulSet(client, utils.GetParallelOperationWorkersName(releaseNameSpacedName.Name), releaseNameSpacedName.Namespace, 0)
-			if err != nil {
-				return utils.RequeueIfError(err)
-			}
-		}
+	// 3. record the finished run only once
+	if finished {
+		recordFinishedRun(ctx, operation, opStatusToUpdate.Phase)
 	}
 
-	// 3. Requeue if data operation set ttl after finished and has not expired
+	// 4. Requeue if data operation set ttl after finished and has not expired
 	if ttl != nil && *ttl > 0 {
 		log.V(1).Info("get remaining time to clean up data operation", "timeToLive", ttl)
 		return utils.RequeueAfterInterval(*ttl)
@@ -22,6 +22,7 @@ import (
 	"github.com/fluid-cloudnative/fluid/pkg/dataoperation"
 	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
 	"github.com/fluid-cloudnative/fluid/pkg/utils/helm"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/tracing"
 )
 
 func InstallDataOperationHelmIfNotExist(ctx cruntime.ReconcileRequestContext, operation dataoperation.OperationInterface,
@@ -56,7 +57,11 @@ func InstallDataOperationHelmIfNotExist(ctx cruntime.ReconcileRequestContext, op
 			chartName = operation.GetChartsDirectory() + "/" + ctx.EngineImpl
 		}
 
-		err = helm.InstallRelease(releaseNamespacedName.Name, releaseNamespacedName.Namespace, valueFileName, chartName)
+		_, span := tracing.StartSpan(ctx.Context, "helm.Install",
+			tracing.ReleaseAttributes(releaseNamespacedName.Namespace, releaseNamespacedName.Name)...)
+		err = InstallRelease(ctx.Client, operation.GetOperationObject(), operationTypeName,
+			releaseNamespacedName.Name, releaseNamespacedName.Namespace, valueFileName, chartName)
+		tracing.EndSpan(span, err)
 		if err != nil {
 			log.Error(err, "failed to install chart")
 			return err
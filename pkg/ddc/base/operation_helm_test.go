@@ -19,6 +19,7 @@ package base_test
 import (
 	"errors"
 
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
 	"github.com/fluid-cloudnative/fluid/pkg/dataoperation"
 	opmock "github.com/fluid-cloudnative/fluid/pkg/dataoperation/mock"
 	"github.com/fluid-cloudnative/fluid/pkg/ddc/base"
@@ -114,6 +115,7 @@ var _ = Describe("InstallDataOperationHelmIfNotExist", func() {
 			mockOp.EXPECT().GetOperationType().Return(dataoperation.DataLoadType).Times(2)
 			mockOp.EXPECT().GetReleaseNameSpacedName().Return(types.NamespacedName{Name: "release", Namespace: "ns"})
 			mockOp.EXPECT().GetChartsDirectory().Return("/charts")
+			mockOp.EXPECT().GetOperationObject().Return(&datav1alpha1.DataLoad{})
 			patches = gomonkey.ApplyFunc(helm.CheckRelease, func(name, namespace string) (bool, error) {
 				return false, nil
 			})
@@ -132,6 +134,7 @@ var _ = Describe("InstallDataOperationHelmIfNotExist", func() {
 			mockOp.EXPECT().GetOperationType().Return(dataoperation.DataLoadType).Times(2)
 			mockOp.EXPECT().GetReleaseNameSpacedName().Return(types.NamespacedName{Name: "release", Namespace: "ns"})
 			mockOp.EXPECT().GetChartsDirectory().Return("/charts")
+			mockOp.EXPECT().GetOperationObject().Return(&datav1alpha1.DataLoad{})
 			patches = gomonkey.ApplyFunc(helm.CheckRelease, func(name, namespace string) (bool, error) {
 				return false, nil
 			})
@@ -151,6 +154,7 @@ var _ = Describe("InstallDataOperationHelmIfNotExist", func() {
 			mockOp.EXPECT().GetOperationType().Return(dataoperation.DataProcessType).Times(2)
 			mockOp.EXPECT().GetReleaseNameSpacedName().Return(types.NamespacedName{Name: "release", Namespace: "ns"})
 			mockOp.EXPECT().GetChartsDirectory().Return("/charts")
+			mockOp.EXPECT().GetOperationObject().Return(&datav1alpha1.DataLoad{})
 			patches = gomonkey.ApplyFunc(helm.CheckRelease, func(name, namespace string) (bool, error) {
 				return false, nil
 			})
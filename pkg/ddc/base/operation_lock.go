@@ -63,6 +63,7 @@ func SetDataOperationInTargetDataset(ctx cruntime.ReconcileRequestContext, opera
 
 	operationTypeName := string(operation.GetOperationType())
 	dataOpKey := getDataOperationKey(object)
+	concurrency := GetDataOperationConcurrency(ctx.Runtime)
 
 	// set current data operation in target dataset
 	err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
@@ -71,6 +72,23 @@ func SetDataOperationInTargetDataset(ctx cruntime.ReconcileRequestContext, opera
 			return err
 		}
 
+		// the operation queue is ordered by the cached data operations, so check the limit with the latest dataset again
+		if concurrency > 0 {
+			datasets, err := getDatasetsOfRuntime(ctx.Client, dataset)
+			if err != nil {
+				return err
+			}
+			inProgress, err := countDataOperationsInProgress(ctx.Client, dataset, datasets, operationTypeName, dataOpKey)
+			if err != nil {
+				return err
+			}
+			if inProgress >= concurrency {
+				ctx.Recorder.Eventf(object, v1.EventTypeNormal, common.DataOperationCollision,
+					"The runtime already runs %d data operations, at most %d data operations at the same time", inProgress, concurrency)
+				return fmt.Errorf("the runtime already runs %d data operations", inProgress)
+			}
+		}
+
 		// set current data operation in the target dataset
 		datasetToUpdate := dataset.DeepCopy()
 		datasetToUpdate.SetDataOperationInProgress(operationTypeName, dataOpKey)
@@ -0,0 +1,355 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package base
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/dataoperation"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+)
+
+// queueCheckInterval is the interval to check the position of the data operations waiting in the operation queue
+const queueCheckInterval = 10 * time.Second
+
+// DataOperationTargetDatasetIndex is the field index of the data operations by the namespaced names of the datasets
+// they may target, which finds the data operations in the operation queue of a runtime
+const DataOperationTargetDatasetIndex = "spec.targetDataset"
+
+// queuedOperation is a data operation in the operation queue of a runtime
+type queuedOperation struct {
+	kind   string
+	object client.Object
+	status *datav1alpha1.OperationStatus
+	// rank orders the data operations with the same priority, so that the namespaces take turns to run data operations
+	rank int
+}
+
+func (q queuedOperation) key() string {
+	return fmt.Sprintf("%s/%s/%s", q.kind, q.object.GetNamespace(), q.object.GetName())
+}
+
+// SetupDataOperationIndexer registers DataOperationTargetDatasetIndex for the kind of the data operation
+func SetupDataOperationIndexer(ctx context.Context, indexer client.FieldIndexer, object client.Object) error {
+	return indexer.IndexField(ctx, object, DataOperationTargetDatasetIndex, IndexDataOperationTargetDatasets)
+}
+
+// IndexDataOperationTargetDatasets returns the namespaced names of the datasets the data operation may target.
+// A DataMigrate is indexed by both of its datasets, because its target depends on the runtimes bound to them.
+func IndexDataOperationTargetDatasets(object client.Object) []string {
+	var targets []string
+	for _, target := range getCandidateTargetDatasets(object) {
+		targets = append(targets, target.String())
+	}
+	return targets
+}
+
+func getCandidateTargetDatasets(object client.Object) (targets []types.NamespacedName) {
+	switch op := object.(type) {
+	case *datav1alpha1.DataLoad:
+		targets = append(targets, types.NamespacedName{Namespace: op.Spec.Dataset.Namespace, Name: op.Spec.Dataset.Name})
+	case *datav1alpha1.DataProcess:
+		targets = append(targets, types.NamespacedName{Namespace: op.Spec.Dataset.Namespace, Name: op.Spec.Dataset.Name})
+	case *datav1alpha1.DataBackup:
+		targets = append(targets, types.NamespacedName{Name: op.Spec.Dataset})
+	case *datav1alpha1.DataMigrate:
+		for _, dataset := range []*datav1alpha1.DatasetToMigrate{op.Spec.To.DataSet, op.Spec.From.DataSet} {
+			if dataset != nil && len(dataset.Name) > 0 {
+				targets = append(targets, types.NamespacedName{Namespace: dataset.Namespace, Name: dataset.Name})
+			}
+		}
+	}
+	for i := range targets {
+		if len(targets[i].Namespace) == 0 {
+			targets[i].Namespace = object.GetNamespace()
+		}
+	}
+	return targets
+}
+
+// GetDataOperationPolicy returns the policy of running the data operations on the runtime
+func GetDataOperationPolicy(runtime client.Object) datav1alpha1.DataOperationPolicy {
+	switch r := runtime.(type) {
+	case *datav1alpha1.AlluxioRuntime:
+		return r.Spec.DataOperationPolicy
+	case *datav1alpha1.JindoRuntime:
+		return r.Spec.DataOperationPolicy
+	case *datav1alpha1.JuiceFSRuntime:
+		return r.Spec.DataOperationPolicy
+	case *datav1alpha1.ThinRuntime:
+		return r.Spec.DataOperationPolicy
+	case *datav1alpha1.EFCRuntime:
+		return r.Spec.DataOperationPolicy
+	case *datav1alpha1.VineyardRuntime:
+		return r.Spec.DataOperationPolicy
+	case *datav1alpha1.CacheRuntime:
+		return r.Spec.DataOperationPolicy
+	}
+	return datav1alpha1.DataOperationPolicy{}
+}
+
+// GetDataOperationConcurrency returns the maximum number of data operations running on the runtime at the same time,
+// 0 means there's no limit.
+func GetDataOperationConcurrency(runtime client.Object) int {
+	return int(max(GetDataOperationPolicy(runtime).MaxConcurrency, 0))
+}
+
+// getDatasetsOfRuntime returns the datasets served by the runtime bound to the physical dataset of the given dataset,
+// i.e. the physical dataset and the reference datasets mounting it, which share the operation queue of the runtime.
+func getDatasetsOfRuntime(c client.Client, dataset *datav1alpha1.Dataset) ([]types.NamespacedName, error) {
+	physicalDataset := dataset
+	if physicalDatasets := GetPhysicalDatasetFromMounts(dataset.Spec.Mounts); len(physicalDatasets) > 0 {
+		var err error
+		physicalDataset, err = utils.GetDataset(c, physicalDatasets[0].Name, physicalDatasets[0].Namespace)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	datasets := []types.NamespacedName{{Namespace: physicalDataset.Namespace, Name: physicalDataset.Name}}
+	for _, ref := range physicalDataset.Status.DatasetRef {
+		namespace, name, found := strings.Cut(ref, "/")
+		if !found {
+			continue
+		}
+		datasets = append(datasets, types.NamespacedName{Namespace: namespace, Name: name})
+	}
+	return datasets, nil
+}
+
+// getQueuePosition returns the position of the pending data operation in the operation queue of the runtime, starting from 1.
+// 0 means the data operation can run now. The data operations with the Cron policy are queued as well, because the jobs
+// started by their cronjobs are suspended until they leave the queue.
+//
+// The data operations waiting in the queue are ordered by the priority. The data operations with the same priority
+// are ordered by their ranks in the namespaces, i.e. the number of data operations running in the namespace and
+// the data operations created before it in the namespace, then the creation time.
+func getQueuePosition(ctx cruntime.ReconcileRequestContext, c client.Client, operation dataoperation.OperationInterface) (int32, error) {
+	concurrency := GetDataOperationConcurrency(ctx.Runtime)
+	if concurrency == 0 {
+		return 0, nil
+	}
+
+	datasets, err := getDatasetsOfRuntime(c, ctx.Dataset)
+	if err != nil {
+		return 0, err
+	}
+	operations, err := listDataOperationsOfRuntime(ctx, c, datasets)
+	if err != nil {
+		return 0, err
+	}
+
+	self := queuedOperation{kind: string(operation.GetOperationType()), object: operation.GetOperationObject()}
+	running := 0
+	runningInNamespace := map[string]int{}
+	waiting := []queuedOperation{self}
+	for _, op := range operations {
+		switch {
+		case op.key() == self.key():
+			// the listed data operation may be staler than the one being reconciled
+			continue
+		case op.status.Phase == common.PhaseExecuting:
+			running++
+			runningInNamespace[op.object.GetNamespace()]++
+		case op.status.Phase == common.PhasePending &&
+			(op.status.WaitingFor.OperationComplete == nil || !*op.status.WaitingFor.OperationComplete):
+			waiting = append(waiting, op)
+		}
+	}
+
+	sortQueuedOperations(waiting, runningInNamespace)
+
+	free := concurrency - running
+	for i, op := range waiting {
+		if op.key() != self.key() {
+			continue
+		}
+		if i < free {
+			return 0, nil
+		}
+		return int32(i - max(free, 0) + 1), nil
+	}
+	return 0, nil
+}
+
+// sortQueuedOperations sorts the data operations waiting in the operation queue by the priority, the rank in the namespace,
+// and the creation time.
+func sortQueuedOperations(waiting []queuedOperation, runningInNamespace map[string]int) {
+	sort.SliceStable(waiting, func(i, j int) bool {
+		return createdBefore(waiting[i], waiting[j])
+	})
+
+	type group struct {
+		priority  int32
+		namespace string
+	}
+	queuedInGroup := map[group]int{}
+	for i := range waiting {
+		g := group{priority: utils.GetOperationPriority(waiting[i].object), namespace: waiting[i].object.GetNamespace()}
+		waiting[i].rank = runningInNamespace[g.namespace] + queuedInGroup[g]
+		queuedInGroup[g]++
+	}
+
+	sort.SliceStable(waiting, func(i, j int) bool {
+		pi, pj := utils.GetOperationPriority(waiting[i].object), utils.GetOperationPriority(waiting[j].object)
+		if pi != pj {
+			return pi > pj
+		}
+		if waiting[i].rank != waiting[j].rank {
+			return waiting[i].rank < waiting[j].rank
+		}
+		return createdBefore(waiting[i], waiting[j])
+	})
+}
+
+func createdBefore(a, b queuedOperation) bool {
+	ta, tb := a.object.GetCreationTimestamp(), b.object.GetCreationTimestamp()
+	if !ta.Equal(&tb) {
+		return ta.Before(&tb)
+	}
+	return a.key() < b.key()
+}
+
+// listDataOperationsOfRuntime lists the data operations of all kinds targeting the datasets of the runtime
+func listDataOperationsOfRuntime(ctx cruntime.ReconcileRequestContext, c client.Client, datasets []types.NamespacedName) ([]queuedOperation, error) {
+	lists := []struct {
+		kind    dataoperation.OperationType
+		newList func() client.ObjectList
+	}{
+		{kind: dataoperation.DataLoadType, newList: func() client.ObjectList { return &datav1alpha1.DataLoadList{} }},
+		{kind: dataoperation.DataProcessType, newList: func() client.ObjectList { return &datav1alpha1.DataProcessList{} }},
+		{kind: dataoperation.DataMigrateType, newList: func() client.ObjectList { return &datav1alpha1.DataMigrateList{} }},
+		{kind: dataoperation.DataBackupType, newList: func() client.ObjectList { return &datav1alpha1.DataBackupList{} }},
+	}
+
+	var operations []queuedOperation
+	listed := map[string]bool{}
+	for _, l := range lists {
+		for _, dataset := range datasets {
+			list := l.newList()
+			if err := c.List(context.TODO(), list, client.MatchingFields{DataOperationTargetDatasetIndex: dataset.String()}); err != nil {
+				// the kind may be disabled
+				if utils.IgnoreNoKindMatchError(err) == nil {
+					break
+				}
+				return nil, err
+			}
+			items, err := apimeta.ExtractList(list)
+			if err != nil {
+				return nil, err
+			}
+			for _, item := range items {
+				op := queuedOperation{kind: string(l.kind), object: item.(client.Object)}
+				if listed[op.key()] {
+					continue
+				}
+				listed[op.key()] = true
+				if !targetsDatasets(ctx, c, op.object, datasets) {
+					continue
+				}
+				if op.status, err = utils.GetOperationStatus(op.object); err != nil {
+					return nil, err
+				}
+				operations = append(operations, op)
+			}
+		}
+	}
+	return operations, nil
+}
+
+// targetsDatasets checks if the data operation targets one of the datasets. Only the DataMigrate indexed by two datasets
+// needs the runtimes bound to them to know its target.
+func targetsDatasets(ctx cruntime.ReconcileRequestContext, c client.Client, object client.Object, datasets []types.NamespacedName) bool {
+	candidates := getCandidateTargetDatasets(object)
+	target := candidates[0]
+	if len(candidates) > 1 {
+		var err error
+		if target, err = utils.GetTargetDatasetNamespacedNameOfMigrate(c, object.(*datav1alpha1.DataMigrate)); err != nil {
+			ctx.Log.V(1).Info("can't get the target dataset of the data operation, skip it",
+				"namespace", object.GetNamespace(), "name", object.GetName(), "error", err.Error())
+			return false
+		}
+	}
+	return slices.Contains(datasets, target)
+}
+
+// countDataOperationsInProgress counts the data operations in progress on the datasets of the runtime, except the given one.
+// The target dataset is the latest one, and the others are read from the cache.
+func countDataOperationsInProgress(c client.Client, target *datav1alpha1.Dataset, datasets []types.NamespacedName,
+	operationType string, name string) (count int, err error) {
+	for _, namespacedName := range datasets {
+		dataset := target
+		if namespacedName != (types.NamespacedName{Namespace: target.Namespace, Name: target.Name}) {
+			if dataset, err = utils.GetDataset(c, namespacedName.Name, namespacedName.Namespace); err != nil {
+				if utils.IgnoreNotFound(err) == nil {
+					err = nil
+					continue
+				}
+				return
+			}
+		}
+		for opType, opRefs := range dataset.Status.OperationRef {
+			for _, opRef := range strings.Split(opRefs, ",") {
+				if len(opRef) == 0 || (dataset == target && opType == operationType && opRef == name) {
+					continue
+				}
+				count++
+			}
+		}
+	}
+	return
+}
+
+// waitInQueue records the position of the data operation in the operation queue of the runtime, and requeues it
+// to check the position later.
+func (e *EngineOperationReconciler) waitInQueue(ctx cruntime.ReconcileRequestContext, opStatus *datav1alpha1.OperationStatus,
+	operation dataoperation.OperationInterface, position int32) (ctrl.Result, error) {
+	log := ctx.Log.WithName("waitInQueue")
+
+	if opStatus.WaitingFor.QueuePosition != nil && *opStatus.WaitingFor.QueuePosition == position {
+		return utils.RequeueAfterInterval(queueCheckInterval)
+	}
+
+	if opStatus.WaitingFor.QueuePosition == nil {
+		object := operation.GetOperationObject()
+		ctx.Recorder.Eventf(object, v1.EventTypeNormal, common.DataOperationQueued,
+			"%s %s is queued at position %d, the runtime runs at most %d data operations at the same time",
+			operation.GetOperationType(), object.GetName(), position, GetDataOperationConcurrency(ctx.Runtime))
+	}
+	opStatus.WaitingFor.QueuePosition = ptr.To(position)
+	if err := operation.UpdateOperationApiStatus(opStatus); err != nil {
+		log.Error(err, fmt.Sprintf("failed to update the queue position of the %s", operation.GetOperationType()))
+		return utils.RequeueIfError(err)
+	}
+	log.V(1).Info("data operation is waiting in the operation queue", "position", position)
+	return utils.RequeueAfterInterval(queueCheckInterval)
+}
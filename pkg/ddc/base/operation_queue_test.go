@@ -0,0 +1,298 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package base_test
+
+import (
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apimachineryRuntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/base"
+	enginemock "github.com/fluid-cloudnative/fluid/pkg/ddc/base/mock"
+	"github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+)
+
+var _ = Describe("GetDataOperationConcurrency", func() {
+	DescribeTable("reads the concurrency from the data operation policy of the runtime",
+		func(runtime client.Object, expected int) {
+			Expect(base.GetDataOperationConcurrency(runtime)).To(Equal(expected))
+		},
+		Entry("not set", &datav1alpha1.AlluxioRuntime{}, 0),
+		Entry("alluxio", &datav1alpha1.AlluxioRuntime{Spec: datav1alpha1.AlluxioRuntimeSpec{
+			DataOperationPolicy: datav1alpha1.DataOperationPolicy{MaxConcurrency: 2}}}, 2),
+		Entry("cache runtime", &datav1alpha1.CacheRuntime{Spec: datav1alpha1.CacheRuntimeSpec{
+			DataOperationPolicy: datav1alpha1.DataOperationPolicy{MaxConcurrency: 3}}}, 3),
+		Entry("negative", &datav1alpha1.JuiceFSRuntime{Spec: datav1alpha1.JuiceFSRuntimeSpec{
+			DataOperationPolicy: datav1alpha1.DataOperationPolicy{MaxConcurrency: -1}}}, 0),
+		Entry("no runtime", nil, 0),
+	)
+})
+
+var _ = Describe("IndexDataOperationTargetDatasets", func() {
+	DescribeTable("indexes the data operations by the datasets they may target",
+		func(object client.Object, expected []string) {
+			Expect(base.IndexDataOperationTargetDatasets(object)).To(Equal(expected))
+		},
+		Entry("dataload in its namespace", &datav1alpha1.DataLoad{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "team-a"},
+			Spec:       datav1alpha1.DataLoadSpec{Dataset: datav1alpha1.TargetDataset{Name: "hbase"}},
+		}, []string{"team-a/hbase"}),
+		Entry("databackup", &datav1alpha1.DataBackup{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "team-a"},
+			Spec:       datav1alpha1.DataBackupSpec{Dataset: "hbase"},
+		}, []string{"team-a/hbase"}),
+		Entry("datamigrate between two datasets", &datav1alpha1.DataMigrate{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "team-a"},
+			Spec: datav1alpha1.DataMigrateSpec{
+				To:   datav1alpha1.DataToMigrate{DataSet: &datav1alpha1.DatasetToMigrate{Name: "hbase"}},
+				From: datav1alpha1.DataToMigrate{DataSet: &datav1alpha1.DatasetToMigrate{Name: "spark", Namespace: "team-b"}},
+			},
+		}, []string{"team-a/hbase", "team-b/spark"}),
+		Entry("not a data operation", &datav1alpha1.Dataset{}, nil),
+	)
+})
+
+var _ = Describe("Operate with operation queue", func() {
+	var (
+		ctrl           *gomock.Controller
+		impl           *enginemock.MockImplement
+		dataset        *datav1alpha1.Dataset
+		alluxioRuntime *datav1alpha1.AlluxioRuntime
+		operation      *mockOperation
+		opStatus       *datav1alpha1.OperationStatus
+		objects        []apimachineryRuntime.Object
+		created        time.Time
+	)
+
+	newDataLoad := func(namespace, name string, phase common.Phase, priority int32, age time.Duration) *datav1alpha1.DataLoad {
+		return &datav1alpha1.DataLoad{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Namespace:         namespace,
+				CreationTimestamp: metav1.NewTime(created.Add(-age)),
+			},
+			Spec: datav1alpha1.DataLoadSpec{
+				Dataset:  datav1alpha1.TargetDataset{Name: mockDatasetName, Namespace: mockNamespace},
+				Priority: priority,
+			},
+			Status: datav1alpha1.OperationStatus{Phase: phase},
+		}
+	}
+
+	newDataMigrate := func(namespace, name string, phase common.Phase, age time.Duration) *datav1alpha1.DataMigrate {
+		return &datav1alpha1.DataMigrate{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Namespace:         namespace,
+				CreationTimestamp: metav1.NewTime(created.Add(-age)),
+			},
+			Spec: datav1alpha1.DataMigrateSpec{
+				To: datav1alpha1.DataToMigrate{
+					DataSet: &datav1alpha1.DatasetToMigrate{Name: mockDatasetName, Namespace: mockNamespace},
+				},
+			},
+			Status: datav1alpha1.OperationStatus{Phase: phase},
+		}
+	}
+
+	operate := func() (time.Duration, error) {
+		s := apimachineryRuntime.NewScheme()
+		Expect(datav1alpha1.AddToScheme(s)).To(Succeed())
+		initObjects := append(objects, dataset, alluxioRuntime, operation.object)
+		var statusObjects []client.Object
+		for _, object := range initObjects {
+			statusObjects = append(statusObjects, object.(client.Object))
+		}
+		builder := fakeclient.NewClientBuilder().WithScheme(s).WithRuntimeObjects(initObjects...).WithStatusSubresource(statusObjects...)
+		for _, object := range []client.Object{&datav1alpha1.DataLoad{}, &datav1alpha1.DataProcess{}, &datav1alpha1.DataMigrate{}, &datav1alpha1.DataBackup{}} {
+			builder = builder.WithIndex(object, base.DataOperationTargetDatasetIndex, base.IndexDataOperationTargetDatasets)
+		}
+		fakeClient := builder.Build()
+		ctx := newQueueContext(fakeClient, dataset, alluxioRuntime)
+		engine := base.NewTemplateEngine(impl, "test-engine", ctx)
+		result, err := engine.Operate(ctx, opStatus, operation)
+		return result.RequeueAfter, err
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		impl = enginemock.NewMockImplement(ctrl)
+		impl.EXPECT().CheckRuntimeReady().Return(true).AnyTimes()
+
+		created = time.Now().Add(-time.Hour)
+		dataset = &datav1alpha1.Dataset{
+			ObjectMeta: metav1.ObjectMeta{Name: mockDatasetName, Namespace: mockNamespace},
+		}
+		alluxioRuntime = &datav1alpha1.AlluxioRuntime{
+			ObjectMeta: metav1.ObjectMeta{Name: mockDatasetName, Namespace: mockNamespace},
+			Spec: datav1alpha1.AlluxioRuntimeSpec{
+				DataOperationPolicy: datav1alpha1.DataOperationPolicy{MaxConcurrency: 1},
+			},
+		}
+		operation = newMockOperation()
+		operation.object = newDataLoad(mockNamespace, "self", common.PhasePending, 0, 0)
+		opStatus = &datav1alpha1.OperationStatus{Phase: common.PhasePending}
+		objects = nil
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	It("should run the data operation without the concurrency limit", func() {
+		alluxioRuntime.Spec.DataOperationPolicy.MaxConcurrency = 0
+		objects = append(objects, newDataLoad(mockNamespace, "running", common.PhaseExecuting, 0, time.Minute))
+
+		_, err := operate()
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(operation.updatedStatus.Phase).To(Equal(common.PhaseExecuting))
+	})
+
+	It("should queue the data operation when the runtime runs enough data operations", func() {
+		objects = append(objects, newDataLoad("other", "running", common.PhaseExecuting, 0, time.Minute))
+
+		requeueAfter, err := operate()
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(requeueAfter).To(BeNumerically(">", 0))
+		Expect(operation.updatedStatus.Phase).To(Equal(common.PhasePending))
+		Expect(operation.updatedStatus.WaitingFor.QueuePosition).To(Equal(ptr.To[int32](1)))
+	})
+
+	It("should run the data operation with a higher priority first", func() {
+		objects = append(objects, newDataLoad(mockNamespace, "dry-run", common.PhasePending, -10, time.Minute))
+		operation.object = newDataLoad(mockNamespace, "self", common.PhasePending, 10, 0)
+		opStatus.WaitingFor.QueuePosition = ptr.To[int32](2)
+
+		_, err := operate()
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(operation.updatedStatus.Phase).To(Equal(common.PhaseExecuting))
+		Expect(operation.updatedStatus.WaitingFor.QueuePosition).To(BeNil())
+	})
+
+	It("should wait for the older data operation with the same priority", func() {
+		objects = append(objects,
+			newDataLoad(mockNamespace, "older", common.PhasePending, 0, time.Minute),
+			newDataLoad(mockNamespace, "waiting-for-preceding", common.PhasePending, 0, 2*time.Minute))
+		objects[1].(*datav1alpha1.DataLoad).Status.WaitingFor.OperationComplete = ptr.To(true)
+
+		_, err := operate()
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(operation.updatedStatus.Phase).To(Equal(common.PhasePending))
+		Expect(operation.updatedStatus.WaitingFor.QueuePosition).To(Equal(ptr.To[int32](1)))
+	})
+
+	It("should take turns between namespaces", func() {
+		alluxioRuntime.Spec.DataOperationPolicy.MaxConcurrency = 2
+		// the data migrates in another namespace already run a data operation
+		objects = append(objects,
+			newDataMigrate("team-a", "running", common.PhaseExecuting, 3*time.Minute),
+			newDataMigrate("team-a", "older", common.PhasePending, 2*time.Minute))
+
+		_, err := operate()
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(operation.updatedStatus.Phase).To(Equal(common.PhaseExecuting))
+	})
+
+	It("should queue the data operation with the Cron policy", func() {
+		objects = append(objects, newDataLoad(mockNamespace, "running", common.PhaseExecuting, 0, time.Minute))
+		operation.object.(*datav1alpha1.DataLoad).Spec.Policy = datav1alpha1.Cron
+
+		_, err := operate()
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(operation.updatedStatus.Phase).To(Equal(common.PhasePending))
+		Expect(operation.updatedStatus.WaitingFor.QueuePosition).To(Equal(ptr.To[int32](1)))
+	})
+
+	It("should count the data operations on the reference datasets of the runtime", func() {
+		dataset.Status.DatasetRef = []string{"team-a/ref"}
+		running := newDataLoad("team-a", "running", common.PhaseExecuting, 0, time.Minute)
+		running.Spec.Dataset = datav1alpha1.TargetDataset{Name: "ref"}
+		objects = append(objects, running)
+
+		_, err := operate()
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(operation.updatedStatus.Phase).To(Equal(common.PhasePending))
+		Expect(operation.updatedStatus.WaitingFor.QueuePosition).To(Equal(ptr.To[int32](1)))
+	})
+
+	It("should skip the data migrate targeting the dataset of another runtime", func() {
+		running := newDataMigrate(mockNamespace, "running", common.PhaseExecuting, time.Minute)
+		running.Spec.To.DataSet = &datav1alpha1.DatasetToMigrate{Name: "other", Namespace: mockNamespace}
+		running.Spec.From.DataSet = &datav1alpha1.DatasetToMigrate{Name: mockDatasetName, Namespace: mockNamespace}
+		objects = append(objects, running)
+
+		_, err := operate()
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(operation.updatedStatus.Phase).To(Equal(common.PhaseExecuting))
+	})
+
+	It("should check the data operations in progress on the reference datasets", func() {
+		dataset.Status.DatasetRef = []string{"team-a/ref"}
+		objects = append(objects, &datav1alpha1.Dataset{
+			ObjectMeta: metav1.ObjectMeta{Name: "ref", Namespace: "team-a"},
+			Status:     datav1alpha1.DatasetStatus{OperationRef: map[string]string{"DataLoad": "running"}},
+		})
+
+		requeueAfter, err := operate()
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(requeueAfter).To(Equal(20 * time.Second))
+		Expect(operation.updatedStatus).To(BeNil())
+	})
+
+	It("should check the data operations in progress on the latest dataset", func() {
+		dataset.Status.OperationRef = map[string]string{"DataProcess": "running"}
+
+		requeueAfter, err := operate()
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(requeueAfter).To(Equal(20 * time.Second))
+		Expect(operation.updatedStatus).To(BeNil())
+	})
+})
+
+func newQueueContext(c client.Client, dataset *datav1alpha1.Dataset, rt client.Object) runtime.ReconcileRequestContext {
+	return runtime.ReconcileRequestContext{
+		NamespacedName: types.NamespacedName{Namespace: dataset.Namespace, Name: dataset.Name},
+		Client:         c,
+		Log:            fake.NullLogger(),
+		RuntimeType:    common.AlluxioRuntime,
+		Runtime:        rt,
+		Dataset:        dataset,
+		Recorder:       record.NewFakeRecorder(10),
+	}
+}
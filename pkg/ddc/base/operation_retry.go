@@ -0,0 +1,174 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package base
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/dataoperation"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/helm"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/tracing"
+)
+
+// reconcileRetry waits for the backoff of the failed attempt, then deletes its helm release so that the release is
+// re-created for the next attempt.
+func (e *EngineOperationReconciler) reconcileRetry(ctx cruntime.ReconcileRequestContext, opStatus *datav1alpha1.OperationStatus,
+	operation dataoperation.OperationInterface) (ctrl.Result, error) {
+	log := ctx.Log.WithName("reconcileRetry")
+
+	if remaining := time.Until(opStatus.NextRetryTime.Time); remaining > 0 {
+		log.V(1).Info("wait for the backoff to retry the failed attempt", "remaining", remaining)
+		return utils.RequeueAfterInterval(remaining)
+	}
+
+	releaseNamespacedName := operation.GetReleaseNameSpacedName()
+	_, span := tracing.StartSpan(ctx.Context, "helm.Delete",
+		tracing.ReleaseAttributes(releaseNamespacedName.Namespace, releaseNamespacedName.Name)...)
+	err := helm.DeleteReleaseIfExists(releaseNamespacedName.Name, releaseNamespacedName.Namespace)
+	tracing.EndSpan(span, err)
+	if err != nil {
+		log.Error(err, "failed to delete the helm release of the failed attempt", "releaseName", releaseNamespacedName.Name,
+			"namespace", releaseNamespacedName.Namespace)
+		return utils.RequeueIfError(err)
+	}
+
+	opStatus.NextRetryTime = nil
+	if err := operation.UpdateOperationApiStatus(opStatus); err != nil {
+		log.Error(err, fmt.Sprintf("failed to update the %s status", operation.GetOperationType()))
+		return utils.RequeueIfError(err)
+	}
+	// update operation status would trigger requeue, no need to requeue here
+	return utils.NoRequeue()
+}
+
+// updateAttempts records the attempts of the data operation with a retry policy in the status to update. If the
+// current attempt fails and can be retried, the phase is kept Executing and the time to retry it is set.
+func updateAttempts(ctx cruntime.ReconcileRequestContext, c client.Client, operation dataoperation.OperationInterface,
+	retryPolicy *datav1alpha1.RetryPolicy, opStatus *datav1alpha1.OperationStatus, result *datav1alpha1.OperationStatus) error {
+	now := metav1.Now()
+
+	// a new attempt starts once the helm release of the last finished attempt is re-created
+	if len(result.Attempts) == 0 || isFinishedPhase(result.Attempts[len(result.Attempts)-1].Phase) {
+		result.Attempts = append(result.Attempts, datav1alpha1.OperationAttempt{
+			Attempt:   int32(len(result.Attempts)) + 1,
+			Phase:     common.PhaseExecuting,
+			StartTime: now,
+		})
+	}
+	attempt := &result.Attempts[len(result.Attempts)-1]
+
+	if !isFinishedPhase(result.Phase) {
+		return nil
+	}
+
+	completionTime := now
+	if len(result.Conditions) > 0 && !result.Conditions[0].LastTransitionTime.IsZero() {
+		completionTime = result.Conditions[0].LastTransitionTime
+	}
+	if completionTime.Before(&attempt.StartTime) {
+		// the job of the last attempt is still in the cache after its helm release is deleted, it's not the result
+		// of the current attempt
+		result.Phase = opStatus.Phase
+		result.Conditions = opStatus.Conditions
+		result.Duration = opStatus.Duration
+		result.NodeAffinity = opStatus.NodeAffinity
+		return nil
+	}
+
+	attempt.Phase = result.Phase
+	attempt.Conditions = result.Conditions
+	attempt.CompletionTime = &completionTime
+	attempt.Duration = result.Duration
+	if result.Phase == common.PhaseComplete {
+		return nil
+	}
+
+	exitCodes, reasons, err := getAttemptFailure(c, operation.GetReleaseNameSpacedName().Namespace,
+		operation.GetReleaseNameSpacedName().Name, attempt)
+	if err != nil {
+		return err
+	}
+	attempt.ExitCodes = exitCodes
+	if attempt.Attempt >= retryPolicy.MaxAttempts || !dataoperation.IsRetryable(retryPolicy, exitCodes, reasons) {
+		return nil
+	}
+
+	delay := dataoperation.GetRetryDelay(retryPolicy, attempt.Attempt)
+	result.Phase = opStatus.Phase
+	result.Conditions = opStatus.Conditions
+	result.Duration = opStatus.Duration
+	result.NextRetryTime = &metav1.Time{Time: now.Add(delay)}
+
+	object := operation.GetOperationObject()
+	ctx.Recorder.Eventf(object, corev1.EventTypeWarning, common.DataOperationRetrying,
+		"%s %s attempt %d failed, will retry in %v", operation.GetOperationType(), object.GetName(), attempt.Attempt, delay)
+	return nil
+}
+
+// getAttemptFailure gets the non-zero exit codes and the reasons of the terminated containers of the attempt, and
+// the reasons of its conditions. The pods of the data operation are labeled with the name of its helm release.
+func getAttemptFailure(c client.Client, namespace, releaseName string, attempt *datav1alpha1.OperationAttempt) (exitCodes []int32, reasons []string, err error) {
+	for _, condition := range attempt.Conditions {
+		if len(condition.Reason) > 0 {
+			reasons = append(reasons, condition.Reason)
+		}
+	}
+
+	pods := &corev1.PodList{}
+	if err = c.List(context.TODO(), pods, client.InNamespace(namespace), client.MatchingLabels{"release": releaseName}); err != nil {
+		return nil, nil, err
+	}
+	for _, pod := range pods.Items {
+		// skip the pods left by the previous attempts
+		if pod.CreationTimestamp.Before(&attempt.StartTime) {
+			continue
+		}
+		statuses := append(append([]corev1.ContainerStatus{}, pod.Status.InitContainerStatuses...), pod.Status.ContainerStatuses...)
+		for _, status := range statuses {
+			terminated := status.State.Terminated
+			if terminated == nil {
+				terminated = status.LastTerminationState.Terminated
+			}
+			if terminated == nil || terminated.ExitCode == 0 {
+				continue
+			}
+			if !slices.Contains(exitCodes, terminated.ExitCode) {
+				exitCodes = append(exitCodes, terminated.ExitCode)
+			}
+			if len(terminated.Reason) > 0 && !slices.Contains(reasons, terminated.Reason) {
+				reasons = append(reasons, terminated.Reason)
+			}
+		}
+	}
+	return
+}
+
+func isFinishedPhase(phase common.Phase) bool {
+	return phase == common.PhaseComplete || phase == common.PhaseFailed
+}
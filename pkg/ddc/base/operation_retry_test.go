@@ -0,0 +1,252 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package base_test
+
+import (
+	"time"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	apimachineryRuntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/base"
+	enginemock "github.com/fluid-cloudnative/fluid/pkg/ddc/base/mock"
+	"github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/helm"
+)
+
+// mockStatusHandler returns the fixed status of the job of the data operation
+type mockStatusHandler struct {
+	phase     common.Phase
+	condition *datav1alpha1.Condition
+}
+
+func (h *mockStatusHandler) GetOperationStatus(ctx runtime.ReconcileRequestContext, opStatus *datav1alpha1.OperationStatus) (*datav1alpha1.OperationStatus, error) {
+	result := opStatus.DeepCopy()
+	if h.phase != common.PhaseComplete && h.phase != common.PhaseFailed {
+		return result, nil
+	}
+	result.Phase = h.phase
+	result.Conditions = []datav1alpha1.Condition{*h.condition}
+	result.Duration = "1m"
+	return result, nil
+}
+
+var _ = Describe("Operate with retry policy", func() {
+	var (
+		ctrl           *gomock.Controller
+		engine         *base.TemplateEngine
+		operation      *mockOperation
+		handler        *mockStatusHandler
+		opStatus       *datav1alpha1.OperationStatus
+		patches        *gomonkey.Patches
+		deletedRelease string
+		now            time.Time
+	)
+
+	newPod := func(name string, created time.Time, exitCode int32, reason string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Namespace:         "default",
+				Labels:            map[string]string{"release": "test-release"},
+				CreationTimestamp: metav1.NewTime(created),
+			},
+			Status: corev1.PodStatus{
+				ContainerStatuses: []corev1.ContainerStatus{{
+					Name:  "loader",
+					State: corev1.ContainerState{Terminated: &corev1.ContainerStateTerminated{ExitCode: exitCode, Reason: reason}},
+				}},
+			},
+		}
+	}
+
+	setup := func(objects ...apimachineryRuntime.Object) {
+		s := apimachineryRuntime.NewScheme()
+		Expect(corev1.AddToScheme(s)).To(Succeed())
+		Expect(datav1alpha1.AddToScheme(s)).To(Succeed())
+		ctx := runtime.ReconcileRequestContext{
+			NamespacedName: types.NamespacedName{Namespace: mockNamespace, Name: mockDatasetName},
+			Client:         fake.NewFakeClientWithScheme(s, objects...),
+			Log:            fake.NullLogger(),
+			RuntimeType:    "test-runtime",
+			Recorder:       record.NewFakeRecorder(10),
+		}
+		engine = base.NewTemplateEngine(enginemock.NewMockImplement(ctrl), "test-engine", ctx)
+	}
+
+	operate := func() (time.Duration, error) {
+		result, err := engine.Operate(engine.Context, opStatus, operation)
+		return result.RequeueAfter, err
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		now = time.Now()
+		deletedRelease = ""
+		handler = &mockStatusHandler{phase: common.PhaseExecuting}
+		operation = newMockOperation()
+		operation.statusHandler = handler
+		operation.retryPolicy = &datav1alpha1.RetryPolicy{MaxAttempts: 3}
+		opStatus = &datav1alpha1.OperationStatus{
+			Phase:      common.PhaseExecuting,
+			Duration:   "Unfinished",
+			Conditions: []datav1alpha1.Condition{},
+		}
+
+		patches = gomonkey.ApplyFunc(helm.CheckRelease, func(name, namespace string) (bool, error) {
+			return true, nil
+		})
+		patches.ApplyFunc(helm.DeleteReleaseIfExists, func(name, namespace string) error {
+			deletedRelease = name
+			return nil
+		})
+	})
+
+	AfterEach(func() {
+		patches.Reset()
+		ctrl.Finish()
+	})
+
+	failJob := func(reason string, finished time.Time) {
+		handler.phase = common.PhaseFailed
+		handler.condition = &datav1alpha1.Condition{
+			Type:               common.ConditionType(batchv1.JobFailed),
+			Status:             corev1.ConditionTrue,
+			Reason:             reason,
+			LastTransitionTime: metav1.NewTime(finished),
+		}
+	}
+
+	It("should record the first attempt of the running data operation", func() {
+		setup()
+		_, err := operate()
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(operation.updatedStatus.Phase).To(Equal(common.PhaseExecuting))
+		Expect(operation.updatedStatus.Attempts).To(HaveLen(1))
+		Expect(operation.updatedStatus.Attempts[0].Attempt).To(BeEquivalentTo(1))
+		Expect(operation.updatedStatus.Attempts[0].Phase).To(Equal(common.PhaseExecuting))
+	})
+
+	It("should keep executing and set the time to retry when the attempt fails", func() {
+		started := now.Add(-time.Minute)
+		opStatus.Attempts = []datav1alpha1.OperationAttempt{{Attempt: 1, Phase: common.PhaseExecuting, StartTime: metav1.NewTime(started)}}
+		failJob("BackoffLimitExceeded", now)
+		setup(newPod("test-release-loader-abcde", now, 1, "Error"),
+			newPod("test-release-loader-old", started.Add(-time.Hour), 2, "Error"))
+
+		_, err := operate()
+		Expect(err).NotTo(HaveOccurred())
+
+		updated := operation.updatedStatus
+		Expect(updated.Phase).To(Equal(common.PhaseExecuting))
+		Expect(updated.Conditions).To(BeEmpty())
+		Expect(updated.Duration).To(Equal("Unfinished"))
+		Expect(updated.NextRetryTime).NotTo(BeNil())
+		Expect(updated.NextRetryTime.Time).To(BeTemporally("~", time.Now().Add(10*time.Second), time.Second))
+		Expect(updated.Attempts).To(HaveLen(1))
+		Expect(updated.Attempts[0].Phase).To(Equal(common.PhaseFailed))
+		Expect(updated.Attempts[0].Conditions).To(HaveLen(1))
+		Expect(updated.Attempts[0].ExitCodes).To(Equal([]int32{1}))
+		Expect(updated.Attempts[0].Duration).To(Equal("1m"))
+		Expect(updated.Attempts[0].CompletionTime).NotTo(BeNil())
+	})
+
+	It("should fail when the attempts are used up", func() {
+		opStatus.Attempts = []datav1alpha1.OperationAttempt{
+			{Attempt: 1, Phase: common.PhaseFailed, StartTime: metav1.NewTime(now.Add(-time.Hour))},
+			{Attempt: 2, Phase: common.PhaseFailed, StartTime: metav1.NewTime(now.Add(-30 * time.Minute))},
+			{Attempt: 3, Phase: common.PhaseExecuting, StartTime: metav1.NewTime(now.Add(-time.Minute))},
+		}
+		failJob("BackoffLimitExceeded", now)
+		setup()
+
+		_, err := operate()
+		Expect(err).NotTo(HaveOccurred())
+
+		updated := operation.updatedStatus
+		Expect(updated.Phase).To(Equal(common.PhaseFailed))
+		Expect(updated.NextRetryTime).To(BeNil())
+		Expect(updated.Attempts).To(HaveLen(3))
+		Expect(updated.Attempts[2].Phase).To(Equal(common.PhaseFailed))
+	})
+
+	It("should fail when the failure is not retryable", func() {
+		operation.retryPolicy.RetryOnExitCodes = []int32{137}
+		operation.retryPolicy.RetryOnReasons = []string{"DeadlineExceeded"}
+		opStatus.Attempts = []datav1alpha1.OperationAttempt{{Attempt: 1, Phase: common.PhaseExecuting, StartTime: metav1.NewTime(now.Add(-time.Minute))}}
+		failJob("BackoffLimitExceeded", now)
+		setup(newPod("test-release-loader-abcde", now, 1, "Error"))
+
+		_, err := operate()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(operation.updatedStatus.Phase).To(Equal(common.PhaseFailed))
+		Expect(operation.updatedStatus.NextRetryTime).To(BeNil())
+	})
+
+	It("should ignore the finished job of the previous attempt", func() {
+		opStatus.Attempts = []datav1alpha1.OperationAttempt{{
+			Attempt:   1,
+			Phase:     common.PhaseFailed,
+			StartTime: metav1.NewTime(now.Add(-time.Hour)),
+		}}
+		failJob("BackoffLimitExceeded", now.Add(-time.Minute))
+		setup()
+
+		_, err := operate()
+		Expect(err).NotTo(HaveOccurred())
+
+		updated := operation.updatedStatus
+		Expect(updated.Phase).To(Equal(common.PhaseExecuting))
+		Expect(updated.Attempts).To(HaveLen(2))
+		Expect(updated.Attempts[1].Attempt).To(BeEquivalentTo(2))
+		Expect(updated.Attempts[1].Phase).To(Equal(common.PhaseExecuting))
+	})
+
+	It("should wait for the backoff before retrying", func() {
+		opStatus.NextRetryTime = &metav1.Time{Time: now.Add(time.Minute)}
+		setup()
+
+		requeueAfter, err := operate()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(requeueAfter).To(BeNumerically("~", time.Minute, time.Second))
+		Expect(deletedRelease).To(BeEmpty())
+		Expect(operation.updatedStatus).To(BeNil())
+	})
+
+	It("should delete the helm release to retry after the backoff", func() {
+		opStatus.NextRetryTime = &metav1.Time{Time: now.Add(-time.Second)}
+		setup()
+
+		_, err := operate()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(deletedRelease).To(Equal("test-release"))
+		Expect(operation.updatedStatus.NextRetryTime).To(BeNil())
+		Expect(operation.updatedStatus.Phase).To(Equal(common.PhaseExecuting))
+	})
+})
@@ -19,7 +19,9 @@ package base_test
 import (
 	"errors"
 	"os"
+	"time"
 
+	"github.com/agiledragon/gomonkey/v2"
 	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
 	"github.com/fluid-cloudnative/fluid/pkg/common"
 	"github.com/fluid-cloudnative/fluid/pkg/dataoperation"
@@ -27,9 +29,11 @@ import (
 	enginemock "github.com/fluid-cloudnative/fluid/pkg/ddc/base/mock"
 	"github.com/fluid-cloudnative/fluid/pkg/runtime"
 	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/helm"
 	"github.com/golang/mock/gomock"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	apimachineryRuntime "k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -144,6 +148,99 @@ var _ = Describe("Operate", func() {
 
 		})
 	})
+
+	Describe("Finished runs", func() {
+		var (
+			recorder  *record.FakeRecorder
+			patches   *gomonkey.Patches
+			handler   *mockStatusHandler
+			completed datav1alpha1.Condition
+		)
+
+		BeforeEach(func() {
+			recorder = record.NewFakeRecorder(10)
+			fakeCtx.Recorder = recorder
+			t = base.NewTemplateEngine(impl, "test-engine", fakeCtx)
+
+			completed = datav1alpha1.Condition{
+				Type:               common.Complete,
+				Status:             corev1.ConditionTrue,
+				LastTransitionTime: metav1.NewTime(time.Now().Add(-time.Minute)),
+			}
+			handler = &mockStatusHandler{phase: common.PhaseComplete, condition: &completed}
+			operation.statusHandler = handler
+
+			patches = gomonkey.ApplyFunc(helm.CheckRelease, func(name, namespace string) (bool, error) {
+				return true, nil
+			})
+		})
+
+		AfterEach(func() {
+			patches.Reset()
+		})
+
+		It("should record the run once when the data operation completes", func() {
+			opStatus.Phase = common.PhaseExecuting
+
+			result, err := t.Operate(fakeCtx, opStatus, operation)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(BeZero())
+			Expect(operation.updatedStatus.Phase).To(Equal(common.PhaseComplete))
+			Expect(operation.completedRuns).To(Equal(1))
+			Expect(recorder.Events).To(HaveLen(1))
+			Expect(<-recorder.Events).To(ContainSubstring(common.DataOperationSucceed))
+		})
+
+		It("should not record the run again when reconciling the complete data operation", func() {
+			opStatus.Phase = common.PhaseComplete
+			opStatus.Duration = "1m"
+			opStatus.Conditions = []datav1alpha1.Condition{completed}
+
+			result, err := t.Operate(fakeCtx, opStatus, operation)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.RequeueAfter).To(BeZero())
+			Expect(operation.updatedStatus).To(BeNil())
+			Expect(operation.completedRuns).To(BeZero())
+			Expect(recorder.Events).To(BeEmpty())
+		})
+
+		It("should record another finished run observed by the status handler", func() {
+			opStatus.Phase = common.PhaseComplete
+			opStatus.Duration = "1m"
+			previous := completed.DeepCopy()
+			previous.LastTransitionTime = metav1.NewTime(time.Now().Add(-time.Hour))
+			opStatus.Conditions = []datav1alpha1.Condition{*previous}
+
+			_, err := t.Operate(fakeCtx, opStatus, operation)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(operation.updatedStatus.Conditions).To(Equal([]datav1alpha1.Condition{completed}))
+			Expect(operation.completedRuns).To(Equal(1))
+			Expect(recorder.Events).To(HaveLen(1))
+		})
+
+		It("should mark the target nodes with a worker as loaded when the data operation completes", func() {
+			opStatus.Phase = common.PhaseExecuting
+			opStatus.TargetNodes = []datav1alpha1.TargetNodeStatus{
+				{NodeName: "node-a", Phase: datav1alpha1.TargetNodePending},
+				{NodeName: "node-b", Phase: datav1alpha1.TargetNodeNoCacheWorker},
+			}
+
+			_, err := t.Operate(fakeCtx, opStatus, operation)
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(operation.updatedStatus.TargetNodes).To(Equal([]datav1alpha1.TargetNodeStatus{
+				{NodeName: "node-a", Phase: datav1alpha1.TargetNodeLoaded},
+				{NodeName: "node-b", Phase: datav1alpha1.TargetNodeNoCacheWorker},
+			}))
+			Expect(recorder.Events).To(HaveLen(2))
+			event := <-recorder.Events
+			Expect(event).To(ContainSubstring(common.DataLoadTargetNodesNotCached))
+			Expect(event).To(ContainSubstring("node-b(NoCacheWorker)"))
+		})
+	})
 })
 
 // mockOperation implements dataoperation.OperationInterface for testing
@@ -152,6 +249,12 @@ type mockOperation struct {
 	updateStatusErr error
 	hasPreceding    bool
 	parallelTasks   int32
+	retryPolicy     *datav1alpha1.RetryPolicy
+	statusHandler   dataoperation.StatusHandler
+	updatedStatus   *datav1alpha1.OperationStatus
+	object          client.Object
+	// completedRuns counts the calls of UpdateStatusInfoForCompleted
+	completedRuns int
 }
 
 func newMockOperation() *mockOperation {
@@ -167,6 +270,9 @@ func (m *mockOperation) HasPrecedingOperation() bool {
 }
 
 func (m *mockOperation) GetOperationObject() client.Object {
+	if m.object != nil {
+		return m.object
+	}
 	return &datav1alpha1.DataLoad{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      mockDatasetName,
@@ -201,6 +307,7 @@ func (m *mockOperation) GetOperationType() dataoperation.OperationType {
 }
 
 func (m *mockOperation) UpdateOperationApiStatus(opStatus *datav1alpha1.OperationStatus) error {
+	m.updatedStatus = opStatus.DeepCopy()
 	return m.updateStatusErr
 }
 
@@ -212,6 +319,7 @@ func (m *mockOperation) Validate(ctx runtime.ReconcileRequestContext) ([]datav1a
 }
 
 func (m *mockOperation) UpdateStatusInfoForCompleted(infos map[string]string) error {
+	m.completedRuns++
 	return nil
 }
 
@@ -224,13 +332,17 @@ func (m *mockOperation) RemoveTargetDatasetStatusInProgress(dataset *datav1alpha
 }
 
 func (m *mockOperation) GetStatusHandler() dataoperation.StatusHandler {
-	return nil
+	return m.statusHandler
 }
 
 func (m *mockOperation) GetTTL() (ttl *int32, err error) {
 	return nil, nil
 }
 
+func (m *mockOperation) GetRetryPolicy() *datav1alpha1.RetryPolicy {
+	return m.retryPolicy
+}
+
 func (m *mockOperation) GetParallelTaskNumber() int32 {
 	return m.parallelTasks
 }
@@ -0,0 +1,133 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package base
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/applier"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/helm"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
+)
+
+const (
+	// RenderModeHelm deploys the charts as helm releases
+	RenderModeHelm = "helm"
+	// RenderModeApply renders the same charts in process and applies the objects with server-side apply
+	// instead of installing helm releases, the objects are not built in Go
+	RenderModeApply = "apply"
+
+	// maxFieldManagerLength is the max length of a field manager allowed by the api server
+	maxFieldManagerLength = 128
+)
+
+var defaultRenderMode = RenderModeHelm
+
+// SetupRenderMode sets the default render mode of the controller, and makes the releases deployed in
+// apply render mode checked and deleted by the package level functions of helm as well.
+func SetupRenderMode(c client.Client, mode string) error {
+	if mode != RenderModeHelm && mode != RenderModeApply {
+		return fmt.Errorf("unknown render mode %q, only %s and %s are supported", mode, RenderModeHelm, RenderModeApply)
+	}
+	defaultRenderMode = mode
+	helm.SetReleaseManager(applier.NewFallbackReleaseManager(c, helm.GetReleaseManager()))
+	return nil
+}
+
+// GetRenderMode returns the render mode of the runtime or data operation, which can be
+// overridden by the annotation render.fluid.io/mode.
+func GetRenderMode(obj metav1.Object) string {
+	if obj != nil {
+		mode := obj.GetAnnotations()[common.AnnotationRenderMode]
+		if mode == RenderModeHelm || mode == RenderModeApply {
+			return mode
+		}
+	}
+	return defaultRenderMode
+}
+
+// FieldManagerOf returns the field manager applying the objects of the runtime or data operation
+func FieldManagerOf(ownerType, ownerName string) string {
+	fieldManager := fmt.Sprintf("fluid-%s-%s", strings.ToLower(ownerType), ownerName)
+	if len(fieldManager) > maxFieldManagerLength {
+		fieldManager = fieldManager[:maxFieldManagerLength]
+	}
+	return fieldManager
+}
+
+// InstallRelease installs the chart for the runtime or data operation according to its render mode.
+func InstallRelease(c client.Client, owner metav1.Object, ownerType, name, namespace, valueFile, chartName string) error {
+	if GetRenderMode(owner) == RenderModeApply {
+		return applier.NewReleaseManager(c, FieldManagerOf(ownerType, owner.GetName())).Install(name, namespace, valueFile, chartName)
+	}
+	return helm.InstallRelease(name, namespace, valueFile, chartName)
+}
+
+// correctDrift corrects the drift of the objects deployed for the runtime in apply render mode. The objects are
+// rendered with the values kept in the values ConfigMap of the runtime, since the inventory doesn't keep the values.
+func (t *TemplateEngine) correctDrift(ctx cruntime.ReconcileRequestContext) error {
+	runtimeStatus, err := GetDDCRuntimeStatus(t.Client, ctx.RuntimeType, ctx.Name, ctx.Namespace)
+	if err != nil {
+		return err
+	}
+	if runtimeStatus.ValueFileConfigmap == "" {
+		t.Log.V(1).Info("No values configmap found, skip correcting drift", "name", ctx.Name, "namespace", ctx.Namespace)
+		return nil
+	}
+	valuesConfigMap, err := kubeclient.GetConfigmapByName(t.Client, runtimeStatus.ValueFileConfigmap, ctx.Namespace)
+	if err != nil {
+		return err
+	}
+	if valuesConfigMap == nil {
+		t.Log.V(1).Info("Values configmap not found, skip correcting drift", "configmap", runtimeStatus.ValueFileConfigmap)
+		return nil
+	}
+
+	drifted, err := applier.NewApplier(t.Client).Reconcile(ctx.Name, ctx.Namespace, []byte(valuesConfigMap.Data["data"]))
+	if apierrs.IsNotFound(err) {
+		// deployed as a helm release before switching to apply render mode
+		t.Log.V(1).Info("No applied release found, skip correcting drift", "name", ctx.Name, "namespace", ctx.Namespace)
+		return nil
+	}
+	if errors.Is(err, applier.ErrValuesChanged) {
+		// the values configmap is not updated with the release, the desired state is unknown
+		t.Log.Info("Values configmap differs from the applied values, skip correcting drift", "configmap", runtimeStatus.ValueFileConfigmap)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if len(drifted) > 0 && ctx.Recorder != nil && ctx.Runtime != nil {
+		objects := make([]string, 0, len(drifted))
+		for _, ref := range drifted {
+			objects = append(objects, ref.String())
+		}
+		ctx.Recorder.Eventf(ctx.Runtime, v1.EventTypeNormal, common.RuntimeDriftCorrected,
+			"Corrected drifted objects: %s", strings.Join(objects, ", "))
+	}
+	return nil
+}
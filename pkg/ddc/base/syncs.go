@@ -25,6 +25,8 @@ import (
 	"github.com/fluid-cloudnative/fluid/pkg/metrics"
 	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
 	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/tracing"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // SyncReplicas syncs the replicas
@@ -37,22 +39,30 @@ func (t *TemplateEngine) Sync(ctx cruntime.ReconcileRequestContext) (err error)
 
 	defer utils.TimeTrack(time.Now(), "base.Sync", "ctx", ctx)
 
+	var span trace.Span
+	ctx.Context, span = tracing.StartSpan(ctx.Context, "TemplateEngine.Sync",
+		tracing.RuntimeAttributes(ctx.RuntimeType, ctx.Namespace, ctx.Name)...)
+	defer func() { tracing.EndSpan(span, err) }()
+
 	if permitSyncEngineStatus {
-		err = t.Implement.SyncMetadata()
+		err = traceStep(ctx, "SyncMetadata", withoutContext(t.Implement.SyncMetadata))
 		if err != nil {
 			return
 		}
 	}
 
 	// 1. Sync replicas
-	err = t.Implement.SyncReplicas(ctx)
+	err = traceStep(ctx, "SyncReplicas", t.Implement.SyncReplicas)
 	if err != nil {
 		return
 	}
 
 	// 2. Sync Runtime Spec
 	var updated bool
-	updated, err = t.Implement.SyncRuntime(ctx)
+	err = traceStep(ctx, "SyncRuntime", func(stepCtx cruntime.ReconcileRequestContext) (err error) {
+		updated, err = t.Implement.SyncRuntime(stepCtx)
+		return
+	})
 	if err != nil {
 		return
 	}
@@ -60,8 +70,16 @@ func (t *TemplateEngine) Sync(ctx cruntime.ReconcileRequestContext) (err error)
 		return
 	}
 
+	// 2.1 Correct drift of the objects deployed in apply render mode
+	if permitSyncEngineStatus && ctx.Runtime != nil && GetRenderMode(ctx.Runtime) == RenderModeApply {
+		err = traceStep(ctx, "CorrectDrift", t.correctDrift)
+		if err != nil {
+			return
+		}
+	}
+
 	// 3. Check healthy
-	err = t.Implement.CheckRuntimeHealthy()
+	err = traceStep(ctx, "CheckRuntimeHealthy", withoutContext(t.Implement.CheckRuntimeHealthy))
 	if err != nil {
 		metrics.GetOrCreateRuntimeMetrics(ctx.Runtime.GetObjectKind().GroupVersionKind().Kind, ctx.Namespace, ctx.Name).HealthCheckErrorInc()
 		return
@@ -69,17 +87,21 @@ func (t *TemplateEngine) Sync(ctx cruntime.ReconcileRequestContext) (err error)
 
 	// 4. Update runtime status
 	if permitSyncEngineStatus {
-		_, err = t.Implement.CheckAndUpdateRuntimeStatus()
+		err = traceStep(ctx, "CheckAndUpdateRuntimeStatus", func(cruntime.ReconcileRequestContext) (err error) {
+			_, err = t.Implement.CheckAndUpdateRuntimeStatus()
+			return
+		})
 		if err != nil {
 			return
 		}
 	}
 
 	// 5. Update the cached of dataset
-	err = t.Implement.UpdateCacheOfDataset()
+	err = traceStep(ctx, "UpdateCacheOfDataset", withoutContext(t.Implement.UpdateCacheOfDataset))
 	if err != nil {
 		return
 	}
+	t.recordCacheMetrics(ctx)
 
 	// 6. Sync dataset mounts
 	// TODO: SyncDatasetMounts() and UpdateUFS() should be merged in future refactoring as they describe a similar workflow
@@ -89,7 +111,7 @@ func (t *TemplateEngine) Sync(ctx cruntime.ReconcileRequestContext) (err error)
 		return
 	}
 	if shouldSyncDatasetMounts {
-		err = t.Implement.SyncDatasetMounts()
+		err = traceStep(ctx, "SyncDatasetMounts", withoutContext(t.Implement.SyncDatasetMounts))
 		if err != nil {
 			return
 		}
@@ -101,7 +123,10 @@ func (t *TemplateEngine) Sync(ctx cruntime.ReconcileRequestContext) (err error)
 		if ufsToUpdate != nil {
 			if ufsToUpdate.ShouldUpdate() {
 				var updateReady bool
-				updateReady, err = t.Implement.UpdateOnUFSChange(ufsToUpdate)
+				err = traceStep(ctx, "UpdateOnUFSChange", func(cruntime.ReconcileRequestContext) (err error) {
+					updateReady, err = t.Implement.UpdateOnUFSChange(ufsToUpdate)
+					return
+				})
 				if err != nil {
 					return
 				}
@@ -115,7 +140,24 @@ func (t *TemplateEngine) Sync(ctx cruntime.ReconcileRequestContext) (err error)
 		}
 	}
 
-	return t.Implement.SyncScheduleInfoToCacheNodes()
+	return traceStep(ctx, "SyncScheduleInfoToCacheNodes", withoutContext(t.Implement.SyncScheduleInfoToCacheNodes))
+}
+
+// traceStep runs the step of Sync in its own span, the ctx passed to the step carries the span so that
+// the calls made with it are traced as children of the step.
+func traceStep(ctx cruntime.ReconcileRequestContext, step string, run func(ctx cruntime.ReconcileRequestContext) error) (err error) {
+	var span trace.Span
+	ctx.Context, span = tracing.StartSpan(ctx.Context, "TemplateEngine."+step)
+	defer func() { tracing.EndSpan(span, err) }()
+
+	return run(ctx)
+}
+
+// withoutContext adapts the step not taking the ctx to traceStep
+func withoutContext(run func() error) func(cruntime.ReconcileRequestContext) error {
+	return func(cruntime.ReconcileRequestContext) error {
+		return run()
+	}
 }
 
 // setTimeOfLastSync updates the synchronization timestamp for the TemplateEngine.
@@ -125,3 +167,12 @@ func (t *TemplateEngine) setTimeOfLastSync() {
 	t.timeOfLastSync = time.Now()
 	t.Log.V(1).Info("Set timeOfLastSync", "timeOfLastSync", t.timeOfLastSync)
 }
+
+// recordCacheMetrics exports the cache states of the dataset in the reconcile context as Prometheus metrics,
+// so the cache states updated by this sync are exported in the next one.
+func (t *TemplateEngine) recordCacheMetrics(ctx cruntime.ReconcileRequestContext) {
+	if ctx.Dataset == nil {
+		return
+	}
+	RecordCacheMetrics(ctx.Dataset.Namespace, ctx.Dataset.Name, ctx.Dataset.Status.CacheStates)
+}
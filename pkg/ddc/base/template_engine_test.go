@@ -18,6 +18,7 @@ package base_test
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"reflect"
 	"testing"
@@ -127,8 +128,8 @@ var _ = Describe("TemplateEngine", func() {
 					impl.EXPECT().SyncMetadata().Return(nil).Times(1),
 					// impl.EXPECT().CheckAndUpdateRuntimeStatus().Return(true, nil).Times(1),
 					// impl.EXPECT().UpdateCacheOfDataset().Return(nil).Times(1),
-					impl.EXPECT().SyncReplicas(gomock.Eq(fakeCtx)).Return(nil).Times(1),
-					impl.EXPECT().SyncRuntime(gomock.Eq(fakeCtx)).Return(false, nil).Times(1),
+					impl.EXPECT().SyncReplicas(stepContextOf(fakeCtx)).Return(nil).Times(1),
+					impl.EXPECT().SyncRuntime(stepContextOf(fakeCtx)).Return(false, nil).Times(1),
 					impl.EXPECT().CheckRuntimeHealthy().Return(nil).Times(1),
 					impl.EXPECT().CheckAndUpdateRuntimeStatus().Return(true, nil).Times(1),
 					impl.EXPECT().UpdateCacheOfDataset().Return(nil).Times(1),
@@ -163,8 +164,8 @@ var _ = Describe("TemplateEngine", func() {
 					impl.EXPECT().SyncMetadata().Return(nil).Times(1),
 					// impl.EXPECT().CheckAndUpdateRuntimeStatus().Return(true, nil).Times(1),
 					// impl.EXPECT().UpdateCacheOfDataset().Return(nil).Times(1),
-					impl.EXPECT().SyncReplicas(gomock.Eq(fakeCtx)).Return(nil).Times(1),
-					impl.EXPECT().SyncRuntime(gomock.Eq(fakeCtx)).Return(false, nil).Times(1),
+					impl.EXPECT().SyncReplicas(stepContextOf(fakeCtx)).Return(nil).Times(1),
+					impl.EXPECT().SyncRuntime(stepContextOf(fakeCtx)).Return(false, nil).Times(1),
 					impl.EXPECT().CheckRuntimeHealthy().Return(nil).Times(1),
 					impl.EXPECT().CheckAndUpdateRuntimeStatus().Return(true, nil).Times(1),
 					impl.EXPECT().UpdateCacheOfDataset().Return(nil).Times(1),
@@ -276,3 +277,26 @@ func TestID(t *testing.T) {
 		t.Errorf("expected %s, get %s", templateEngine.Id, templateEngine.ID())
 	}
 }
+
+// stepContextMatcher matches the ctx passed to a step of Sync, which only differs from the ctx of Sync
+// in the context carrying the span of the step
+type stepContextMatcher struct {
+	ctx runtime.ReconcileRequestContext
+}
+
+func stepContextOf(ctx runtime.ReconcileRequestContext) gomock.Matcher {
+	return stepContextMatcher{ctx: ctx}
+}
+
+func (m stepContextMatcher) Matches(x interface{}) bool {
+	stepCtx, ok := x.(runtime.ReconcileRequestContext)
+	if !ok || stepCtx.Context == nil {
+		return false
+	}
+	stepCtx.Context = m.ctx.Context
+	return reflect.DeepEqual(stepCtx, m.ctx)
+}
+
+func (m stepContextMatcher) String() string {
+	return fmt.Sprintf("is the step context of %v", m.ctx)
+}
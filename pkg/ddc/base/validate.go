@@ -1,9 +1,20 @@
 package base
 
 import (
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
 	fluiderrs "github.com/fluid-cloudnative/fluid/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
+// mountSchemeRegexp matches the scheme of a mount point, e.g. "s3://" and "local://"
+var mountSchemeRegexp = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9+.-]*://`)
+
 func ValidateRuntimeInfo(runtimeInfo RuntimeInfoInterface) (err error) {
 	if len(runtimeInfo.GetOwnerDatasetUID()) == 0 {
 		return fluiderrs.NewTemporaryValidationFailed("OwnerDatasetUID is not set in runtime info, this is usually a temporary state, retrying")
@@ -15,3 +26,63 @@ func ValidateRuntimeInfo(runtimeInfo RuntimeInfoInterface) (err error) {
 
 	return nil
 }
+
+// ValidateTieredStore validates the levels of the tiered store in the same way they are converted for the engines.
+func ValidateTieredStore(tieredStore datav1alpha1.TieredStore, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	for i, level := range tieredStore.Levels {
+		_, err := convertToTieredstoreInfo(datav1alpha1.TieredStore{Levels: []datav1alpha1.Level{level}})
+		if err == nil {
+			continue
+		}
+		levelPath := fldPath.Child("levels").Index(i)
+		if len(level.QuotaList) > 0 {
+			allErrs = append(allErrs, field.Invalid(levelPath.Child("quotaList"), level.QuotaList, err.Error()))
+		} else {
+			allErrs = append(allErrs, field.Required(levelPath.Child("quota"), err.Error()))
+		}
+	}
+	return allErrs
+}
+
+// ValidateMounts validates the mount points of a dataset. A mount point must be in the form of <scheme>://<path>,
+// the ones with the Fluid native schemes must refer to an absolute local path or a PVC, and a dataset mounting
+// another dataset can't have any other mounts.
+func ValidateMounts(mounts []datav1alpha1.Mount, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	for i, mount := range mounts {
+		mountPointPath := fldPath.Index(i).Child("mountPoint")
+		switch {
+		case !mountSchemeRegexp.MatchString(mount.MountPoint):
+			allErrs = append(allErrs, field.Invalid(mountPointPath, mount.MountPoint, "must be in the form of <scheme>://<path>"))
+		case strings.HasPrefix(mount.MountPoint, common.PathScheme.String()):
+			if !filepath.IsAbs(strings.TrimPrefix(mount.MountPoint, common.PathScheme.String())) {
+				allErrs = append(allErrs, field.Invalid(mountPointPath, mount.MountPoint, "must be in the form of local://<absolute path>"))
+			}
+		case strings.HasPrefix(mount.MountPoint, common.VolumeScheme.String()):
+			pvcName := strings.SplitN(strings.TrimPrefix(mount.MountPoint, common.VolumeScheme.String()), "/", 2)[0]
+			for _, msg := range validation.IsDNS1123Subdomain(pvcName) {
+				allErrs = append(allErrs, field.Invalid(mountPointPath, mount.MountPoint, "invalid PVC name: "+msg))
+			}
+		case common.IsFluidRefSchema(mount.MountPoint):
+			namespaceAndName := strings.SplitN(strings.TrimPrefix(mount.MountPoint, common.RefSchema.String()), "/", 3)
+			if len(namespaceAndName) < 2 || len(namespaceAndName[0]) == 0 || len(namespaceAndName[1]) == 0 {
+				allErrs = append(allErrs, field.Invalid(mountPointPath, mount.MountPoint, "must be in the form of dataset://<namespace>/<name>[/<subpath>]"))
+			}
+		}
+	}
+
+	if _, err := CheckReferenceDataset(&datav1alpha1.Dataset{Spec: datav1alpha1.DatasetSpec{Mounts: mounts}}); err != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath, err.Error()))
+	}
+	return allErrs
+}
+
+// ValidateDataOperationPolicy validates the policy of running the data operations on the runtime
+func ValidateDataOperationPolicy(policy datav1alpha1.DataOperationPolicy, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if policy.MaxConcurrency < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxConcurrency"), policy.MaxConcurrency, "must be greater than or equal to 0"))
+	}
+	return allErrs
+}
@@ -23,7 +23,9 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -70,6 +72,91 @@ var _ = Describe("ValidateRuntimeInfo", func() {
 	)
 })
 
+var _ = Describe("ValidateTieredStore", func() {
+	fldPath := field.NewPath("spec").Child("tieredstore")
+
+	DescribeTable("validating tiered store",
+		func(level datav1alpha1.Level, expectedField string) {
+			errs := ValidateTieredStore(datav1alpha1.TieredStore{Levels: []datav1alpha1.Level{level}}, fldPath)
+			if expectedField == "" {
+				Expect(errs).To(BeEmpty())
+				return
+			}
+			Expect(errs).To(HaveLen(1))
+			Expect(errs[0].Field).To(Equal(expectedField))
+		},
+		Entry("quota is set",
+			datav1alpha1.Level{Path: "/dev/shm", Quota: resource.NewQuantity(1024, resource.BinarySI)}, "",
+		),
+		Entry("quotaList matches the paths",
+			datav1alpha1.Level{Path: "/mnt/cache1,/mnt/cache2", QuotaList: "1Gi,2Gi"}, "",
+		),
+		Entry("neither quota nor quotaList is set",
+			datav1alpha1.Level{Path: "/dev/shm"}, "spec.tieredstore.levels[0].quota",
+		),
+		Entry("quotaList is malformed",
+			datav1alpha1.Level{Path: "/mnt/cache1,/mnt/cache2", QuotaList: "1Gi,2G!"}, "spec.tieredstore.levels[0].quotaList",
+		),
+		Entry("quotaList doesn't match the paths",
+			datav1alpha1.Level{Path: "/mnt/cache1", QuotaList: "1Gi,2Gi"}, "spec.tieredstore.levels[0].quotaList",
+		),
+	)
+})
+
+var _ = Describe("ValidateMounts", func() {
+	fldPath := field.NewPath("spec").Child("mounts")
+
+	DescribeTable("validating mounts",
+		func(mountPoints []string, expectedFields []string) {
+			var mounts []datav1alpha1.Mount
+			for _, mountPoint := range mountPoints {
+				mounts = append(mounts, datav1alpha1.Mount{MountPoint: mountPoint})
+			}
+			var fields []string
+			for _, err := range ValidateMounts(mounts, fldPath) {
+				fields = append(fields, err.Field)
+			}
+			if len(expectedFields) == 0 {
+				Expect(fields).To(BeEmpty())
+			} else {
+				Expect(fields).To(Equal(expectedFields))
+			}
+		},
+		Entry("valid mount points",
+			[]string{"s3://bucket/path", "local:///mnt/data", "pvc://data-pvc/subpath", "demofs://remote"}, nil,
+		),
+		Entry("mount point without scheme",
+			[]string{"/mnt/data"}, []string{"spec.mounts[0].mountPoint"},
+		),
+		Entry("relative local path",
+			[]string{"s3://bucket", "local://mnt/data"}, []string{"spec.mounts[1].mountPoint"},
+		),
+		Entry("invalid PVC name",
+			[]string{"pvc://Data_PVC"}, []string{"spec.mounts[0].mountPoint"},
+		),
+		Entry("dataset without name",
+			[]string{"dataset://default"}, []string{"spec.mounts[0].mountPoint"},
+		),
+		Entry("dataset mounted with other mounts",
+			[]string{"dataset://default/hbase", "s3://bucket"}, []string{"spec.mounts"},
+		),
+	)
+})
+
+var _ = Describe("ValidateDataOperationPolicy", func() {
+	fldPath := field.NewPath("spec").Child("dataOperationPolicy")
+
+	It("should accept no limit", func() {
+		Expect(ValidateDataOperationPolicy(datav1alpha1.DataOperationPolicy{}, fldPath)).To(BeEmpty())
+	})
+
+	It("should reject a negative max concurrency", func() {
+		errs := ValidateDataOperationPolicy(datav1alpha1.DataOperationPolicy{MaxConcurrency: -1}, fldPath)
+		Expect(errs).To(HaveLen(1))
+		Expect(errs[0].Field).To(Equal("spec.dataOperationPolicy.maxConcurrency"))
+	})
+})
+
 // mockRuntimeInfoForValidate implements RuntimeInfoInterface for testing ValidateRuntimeInfo
 type mockRuntimeInfoForValidate struct {
 	ownerDatasetUID  string
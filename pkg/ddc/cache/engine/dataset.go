@@ -25,6 +25,8 @@ import (
 
 	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
 	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/credentials"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/base"
 	"github.com/fluid-cloudnative/fluid/pkg/utils"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -110,6 +112,41 @@ func (e *CacheEngine) UpdateDatasetStatus(phase datav1alpha1.DatasetPhase, runti
 	return
 }
 
+// UpdateCacheOfDataset refreshes the cache states of the dataset with the ReportSummary of the runtime,
+// and exports them as metrics of the dataset.
+func (e *CacheEngine) UpdateCacheOfDataset(runtime *datav1alpha1.CacheRuntime, runtimeClass *datav1alpha1.CacheRuntimeClass) (err error) {
+	executionEntries := resolveArchitectureApi(e.name, e.namespace, runtime, runtimeClass).GetExecutionEntries()
+	if executionEntries == nil || executionEntries.ReportSummary == nil {
+		return nil
+	}
+
+	cacheStates, err := e.GetCacheStates(runtime, runtimeClass)
+	if err != nil {
+		e.Log.Error(err, "Failed to get cache states, keeping previous cache states in dataset status")
+		return nil
+	}
+
+	err = retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		dataset, err := utils.GetDataset(e.Client, e.name, e.namespace)
+		if err != nil {
+			return err
+		}
+		if reflect.DeepEqual(dataset.Status.CacheStates, cacheStates) {
+			return nil
+		}
+		datasetToUpdate := dataset.DeepCopy()
+		datasetToUpdate.Status.CacheStates = cacheStates
+		return e.Client.Status().Update(context.TODO(), datasetToUpdate)
+	})
+	if err != nil {
+		return utils.LoggingErrorExceptConflict(e.Log, err, "Failed to update the cache states of dataset",
+			types.NamespacedName{Namespace: e.namespace, Name: e.name})
+	}
+
+	base.RecordCacheMetrics(e.namespace, e.name, cacheStates)
+	return nil
+}
+
 func (e *CacheEngine) GetCacheStates(runtime *datav1alpha1.CacheRuntime, runtimeClass *datav1alpha1.CacheRuntimeClass) (common.CacheStateList, error) {
 
 	handler := resolveArchitectureApi(e.name, e.namespace, runtime, runtimeClass)
@@ -189,14 +226,15 @@ func (e *CacheEngine) generateDatasetMountOptions(m *datav1alpha1.Mount, sharedE
 func (e *CacheEngine) collectEncryptOptions(encryptOpts []datav1alpha1.EncryptOption, existingEncryptOpts map[string]string) error {
 
 	for _, item := range encryptOpts {
-		sRef := item.ValueFrom.SecretKeyRef
-		if sRef.Name == "" || sRef.Key == "" {
-			return fmt.Errorf("encryptOption %s has empty secretKeyRef name or key", item.Name)
+		if item.ValueFrom.CredentialProvider == nil && item.ValueFrom.CSISecretStore == nil {
+			sRef := item.ValueFrom.SecretKeyRef
+			if sRef.Name == "" || sRef.Key == "" {
+				return fmt.Errorf("encryptOption %s has empty secretKeyRef name or key", item.Name)
+			}
 		}
 
-		secretPath := getSecretFilePath(sRef.Name, sRef.Key)
-
-		existingEncryptOpts[item.Name] = secretPath
+		file := credentials.GetFile(e.name, item, getSecretVolumeName)
+		existingEncryptOpts[item.Name] = file.Path
 	}
 	return nil
 }
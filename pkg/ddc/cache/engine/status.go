@@ -149,7 +149,10 @@ func (e *CacheEngine) CheckAndUpdateRuntimeStatus(value *common.CacheRuntimeStat
 			runtimeToUpdate.Status.SetupDuration = utils.CalculateDuration(runtimeToUpdate.CreationTimestamp.Time, time.Now())
 		}
 
-		// TODO(cache runtime): set the CacheRuntime Status left fields:  Selector
+		// the selector of the workers is required by the scale subresource
+		if value.Worker.Enabled {
+			runtimeToUpdate.Status.Selector = e.getWorkerSelectors()
+		}
 		runtimeToUpdate.Status.ValueFile = common.GetCacheRuntimeConfigConfigMapName(e.name)
 
 		if !reflect.DeepEqual(runtime.Status, runtimeToUpdate.Status) {
@@ -53,7 +53,11 @@ func (e *CacheEngine) Sync(ctx cruntime.ReconcileRequestContext) (err error) {
 		return err
 	}
 
-	// TODO: implement other logic like inplace update and replica scaling
+	// record the scaling of workers before the replicas are synced to the worker workload
+	err = e.SyncReplicas(ctx, runtime, runtimeClass)
+	if err != nil {
+		return err
+	}
 
 	// Use lightweight getRuntimeStatusValue instead of full transform for status update
 	statusValue, err := e.getRuntimeStatusValue(runtime, runtimeClass)
@@ -73,6 +77,12 @@ func (e *CacheEngine) Sync(ctx cruntime.ReconcileRequestContext) (err error) {
 		return err
 	}
 
+	// sync the cache states of dataset
+	err = e.UpdateCacheOfDataset(runtime, runtimeClass)
+	if err != nil {
+		return err
+	}
+
 	// handle runtime spec change
 
 	// sync metadata
@@ -21,6 +21,7 @@ import (
 
 	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
 	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/credentials"
 	"github.com/fluid-cloudnative/fluid/pkg/utils"
 	corev1 "k8s.io/api/core/v1"
 )
@@ -138,27 +139,19 @@ func (e *CacheEngine) transformExtraConfigMapVolumes(
 // transformEncryptOptionsToComponentVolumes transforms encrypt options from dataset spec to component pod volumes
 // This function can be reused for both Master and Worker components
 func (e *CacheEngine) transformEncryptOptionsToComponentVolumes(dataset *datav1alpha1.Dataset, podSpec *corev1.PodSpec) {
-	// Helper to add secret volume and mount to the component
-	addSecret := func(secretName string) {
-		if secretName == "" {
+	// Helper to add the volume of the secret or the SecretProviderClass holding the encrypt option to the component
+	addVolume := func(encryptOpt datav1alpha1.EncryptOption) {
+		file := credentials.GetFile(dataset.Name, encryptOpt, getSecretVolumeName)
+		if file.Volume.Secret != nil && file.Volume.Secret.SecretName == "" {
 			return
 		}
-		volName := getSecretVolumeName(secretName)
-		volumeToAdd := corev1.Volume{
-			Name: volName,
-			VolumeSource: corev1.VolumeSource{
-				Secret: &corev1.SecretVolumeSource{
-					SecretName: secretName,
-				},
-			},
-		}
 		podSpec.Volumes = utils.AppendOrOverrideVolume(
-			podSpec.Volumes, volumeToAdd)
+			podSpec.Volumes, file.Volume)
 
 		volumeMountToAdd := corev1.VolumeMount{
-			Name:      volName,
+			Name:      file.Volume.Name,
 			ReadOnly:  true,
-			MountPath: getSecretMountPath(secretName),
+			MountPath: file.MountPath,
 		}
 		podSpec.Containers[0].VolumeMounts = utils.AppendOrOverrideVolumeMounts(
 			podSpec.Containers[0].VolumeMounts, volumeMountToAdd)
@@ -166,7 +159,7 @@ func (e *CacheEngine) transformEncryptOptionsToComponentVolumes(dataset *datav1a
 
 	// 1. Process shared encrypt options once
 	for _, encryptOpt := range dataset.Spec.SharedEncryptOptions {
-		addSecret(encryptOpt.ValueFrom.SecretKeyRef.Name)
+		addVolume(encryptOpt)
 	}
 
 	// 2. Process mount-specific encrypt options, override shared options
@@ -175,7 +168,7 @@ func (e *CacheEngine) transformEncryptOptionsToComponentVolumes(dataset *datav1a
 			continue
 		}
 		for _, encryptOpt := range m.EncryptOptions {
-			addSecret(encryptOpt.ValueFrom.SecretKeyRef.Name)
+			addVolume(encryptOpt)
 		}
 	}
 }
@@ -103,16 +103,6 @@ func getSecretVolumeName(name string) string {
 	return volumeName
 }
 
-// getSecretMountPath generates the base mount path for a secret in the container
-func getSecretMountPath(secretName string) string {
-	return fmt.Sprintf("/etc/fluid/secrets/%s", secretName)
-}
-
-// getSecretFilePath generates the full file path for a secret key in the container
-func getSecretFilePath(secretName, secretKey string) string {
-	return fmt.Sprintf("%s/%s", getSecretMountPath(secretName), secretKey)
-}
-
 func GetMemoryTieredStoreMountPath(_ int) string {
 	return "/dev/shm"
 }
@@ -18,13 +18,17 @@ package engine
 
 import (
 	"context"
+	"fmt"
 	"reflect"
 
 	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
 	"github.com/fluid-cloudnative/fluid/pkg/common"
 	"github.com/fluid-cloudnative/fluid/pkg/ddc/cache/component"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
 	"github.com/fluid-cloudnative/fluid/pkg/utils"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/util/retry"
 )
 
@@ -105,3 +109,76 @@ func (e *CacheEngine) SetupWorkerInternal(workerValue *common.CacheRuntimeCompon
 
 	return nil
 }
+
+// getWorkerSelectors gets the selector of the worker pods, which is required by the scale subresource.
+// The labels are the same as the selector of the worker workload.
+func (e *CacheEngine) getWorkerSelectors() string {
+	return labels.SelectorFromSet(labels.Set{
+		common.LabelCacheRuntimeName:          e.name,
+		common.LabelCacheRuntimeComponentName: common.GetCacheComponentName(e.name, common.ComponentTypeWorker),
+	}).String()
+}
+
+// SyncReplicas records the scaling of the workers when the worker replicas in the runtime spec, which may be
+// changed through the scale subresource (e.g. by kubectl scale or HPA), differ from the replicas of the worker
+// workload. The replicas in the spec always win, and are applied to the workload by syncRuntimeSpec.
+func (e *CacheEngine) SyncReplicas(ctx cruntime.ReconcileRequestContext, runtime *datav1alpha1.CacheRuntime, runtimeClass *datav1alpha1.CacheRuntimeClass) error {
+	if runtimeClass.Topology.Worker == nil || runtime.Spec.Worker.Disabled {
+		return nil
+	}
+
+	identity := &common.ComponentIdentity{
+		Name:      common.GetCacheComponentName(e.name, common.ComponentTypeWorker),
+		Namespace: e.namespace,
+	}
+	manager := component.NewComponentHelper(common.ComponentTypeWorker, e.Client)
+	workerStatus, err := manager.ConstructComponentStatus(ctx.Context, identity)
+	if err != nil {
+		return err
+	}
+
+	desiredReplicas := runtime.Spec.Worker.Replicas
+	currentReplicas := workerStatus.DesiredReplicas
+	if desiredReplicas == currentReplicas {
+		e.Log.V(1).Info("Nothing to do for syncing replicas")
+		return nil
+	}
+
+	err = retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		runtime, err := e.getRuntime()
+		if err != nil {
+			return err
+		}
+
+		var cond datav1alpha1.RuntimeCondition
+		if desiredReplicas < currentReplicas {
+			cond = utils.NewRuntimeCondition(datav1alpha1.RuntimeWorkerScaledIn, datav1alpha1.RuntimeWorkersScaledInReason,
+				fmt.Sprintf("Workers scaled in from %d replicas to %d replicas.", currentReplicas, desiredReplicas), corev1.ConditionTrue)
+		} else {
+			cond = utils.NewRuntimeCondition(datav1alpha1.RuntimeWorkerScaledOut, datav1alpha1.RuntimeWorkersScaledOutReason,
+				fmt.Sprintf("Workers scaled out from %d replicas to %d replicas.", currentReplicas, desiredReplicas), corev1.ConditionTrue)
+		}
+
+		runtimeToUpdate := runtime.DeepCopy()
+		if len(runtimeToUpdate.Status.Conditions) == 0 {
+			runtimeToUpdate.Status.Conditions = []datav1alpha1.RuntimeCondition{}
+		}
+		runtimeToUpdate.Status.Conditions = utils.UpdateRuntimeCondition(runtimeToUpdate.Status.Conditions, cond)
+		runtimeToUpdate.Status.Selector = e.getWorkerSelectors()
+		if !reflect.DeepEqual(runtime.Status, runtimeToUpdate.Status) {
+			return e.Client.Status().Update(context.TODO(), runtimeToUpdate)
+		}
+		return nil
+	})
+	if err != nil {
+		return utils.LoggingErrorExceptConflict(e.Log, err, "Failed to sync the replicas",
+			types.NamespacedName{Namespace: e.namespace, Name: e.name})
+	}
+
+	if desiredReplicas < currentReplicas {
+		ctx.Recorder.Eventf(runtime, corev1.EventTypeNormal, common.Succeed, "Runtime scaled in")
+	} else {
+		ctx.Recorder.Eventf(runtime, corev1.EventTypeNormal, common.Succeed, "Runtime scaled out")
+	}
+	return nil
+}
@@ -22,11 +22,15 @@ import (
 	"github.com/fluid-cloudnative/advanced-statefulset/api/workload/v1alpha1"
 	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
 	"github.com/fluid-cloudnative/fluid/pkg/common"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
 	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -153,3 +157,130 @@ var _ = Describe("CacheEngine Worker Component Tests", Label("pkg.ddc.cache.engi
 		})
 	})
 })
+
+var _ = Describe("CacheEngine SyncReplicas", Label("pkg.ddc.cache.engine.worker_test.go"), func() {
+	var (
+		engine       *CacheEngine
+		runtimeObj   *datav1alpha1.CacheRuntime
+		runtimeClass *datav1alpha1.CacheRuntimeClass
+		workers      *v1alpha1.AdvancedStatefulSet
+		recorder     *record.FakeRecorder
+	)
+
+	BeforeEach(func() {
+		runtimeObj = &datav1alpha1.CacheRuntime{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-runtime",
+				Namespace: "default",
+			},
+			Spec: datav1alpha1.CacheRuntimeSpec{
+				RuntimeClassName: "test-class",
+				Worker: datav1alpha1.CacheRuntimeWorkerSpec{
+					Replicas: 3,
+				},
+			},
+		}
+		runtimeClass = &datav1alpha1.CacheRuntimeClass{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-class"},
+			Topology: &datav1alpha1.RuntimeTopology{
+				Worker: &datav1alpha1.RuntimeComponentDefinition{},
+			},
+		}
+		workers = &v1alpha1.AdvancedStatefulSet{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-runtime-worker",
+				Namespace: "default",
+			},
+			Spec: v1alpha1.AdvancedStatefulSetSpec{
+				Replicas: ptr.To[int32](1),
+			},
+		}
+		recorder = record.NewFakeRecorder(10)
+	})
+
+	JustBeforeEach(func() {
+		fakeClient := fake.NewFakeClientWithScheme(CacheEngineTestScheme, runtimeObj, workers)
+		engine = &CacheEngine{
+			name:      "test-runtime",
+			namespace: "default",
+			Client:    fakeClient,
+			Log:       ctrl.Log.WithName("test"),
+		}
+	})
+
+	syncReplicas := func() error {
+		return engine.SyncReplicas(cruntime.ReconcileRequestContext{
+			Context:  context.TODO(),
+			Recorder: recorder,
+		}, runtimeObj, runtimeClass)
+	}
+
+	It("should record the scale out of workers", func() {
+		Expect(syncReplicas()).To(Succeed())
+
+		runtime, err := engine.getRuntime()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(runtime.Status.Conditions).To(HaveLen(1))
+		Expect(runtime.Status.Conditions[0].Type).To(Equal(datav1alpha1.RuntimeWorkerScaledOut))
+		Expect(runtime.Status.Selector).To(Equal(engine.getWorkerSelectors()))
+		Expect(recorder.Events).To(Receive(ContainSubstring("Runtime scaled out")))
+	})
+
+	Context("when the replicas are decreased", func() {
+		BeforeEach(func() {
+			runtimeObj.Spec.Worker.Replicas = 0
+		})
+
+		It("should record the scale in of workers", func() {
+			Expect(syncReplicas()).To(Succeed())
+
+			runtime, err := engine.getRuntime()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(runtime.Status.Conditions).To(HaveLen(1))
+			Expect(runtime.Status.Conditions[0].Type).To(Equal(datav1alpha1.RuntimeWorkerScaledIn))
+			Expect(recorder.Events).To(Receive(ContainSubstring("Runtime scaled in")))
+		})
+	})
+
+	Context("when the replicas are not changed", func() {
+		BeforeEach(func() {
+			runtimeObj.Spec.Worker.Replicas = 1
+		})
+
+		It("should do nothing", func() {
+			Expect(syncReplicas()).To(Succeed())
+
+			runtime, err := engine.getRuntime()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(runtime.Status.Conditions).To(BeEmpty())
+			Expect(recorder.Events).NotTo(Receive())
+		})
+	})
+
+	Context("when the worker is disabled", func() {
+		BeforeEach(func() {
+			runtimeObj.Spec.Worker.Disabled = true
+		})
+
+		It("should do nothing", func() {
+			Expect(syncReplicas()).To(Succeed())
+			Expect(recorder.Events).NotTo(Receive())
+		})
+	})
+})
+
+var _ = Describe("CacheEngine getWorkerSelectors", Label("pkg.ddc.cache.engine.worker_test.go"), func() {
+	It("should select the pods of the worker workload", func() {
+		engine := &CacheEngine{name: "test-runtime", namespace: "default"}
+		selector, err := labels.Parse(engine.getWorkerSelectors())
+		Expect(err).NotTo(HaveOccurred())
+		Expect(selector.Matches(labels.Set{
+			common.LabelCacheRuntimeName:          "test-runtime",
+			common.LabelCacheRuntimeComponentName: "test-runtime-worker",
+		})).To(BeTrue())
+		Expect(selector.Matches(labels.Set{
+			common.LabelCacheRuntimeName:          "test-runtime",
+			common.LabelCacheRuntimeComponentName: "test-runtime-master",
+		})).To(BeFalse())
+	})
+})
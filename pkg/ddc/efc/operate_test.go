@@ -72,6 +72,8 @@ func (m *mockOperation) GetStatusHandler() dataoperation.StatusHandler { return
 
 func (m *mockOperation) GetTTL() (*int32, error) { return nil, nil }
 
+func (m *mockOperation) GetRetryPolicy() *datav1alpha1.RetryPolicy { return nil }
+
 func (m *mockOperation) GetParallelTaskNumber() int32 { return 1 }
 
 var _ = Describe("EFCEngine operate", func() {
@@ -177,33 +177,16 @@ func parseCacheDirFromConfigMap(configMap *v1.ConfigMap) (cacheDir string, cache
 // The function performs the following operations:
 //  1. Fetches the Dataset resource using the controller's client
 //  2. Validates the existence of mount configuration
-//  3. Normalizes the mount path format (trims whitespace, ensures trailing slash)
-//  4. Parses filesystem-specific metadata based on protocol prefix:
-//     - NAS (nfs://): Extracts filesystem ID, service endpoint, and directory path
-//     - CPFS (cpfs://): Extracts filesystem ID, cluster address, and share path
+//  3. Parses the FIRST mount entry in Dataset.Spec.Mounts with ParseMountInfo
 //
 // Returns:
-//  - MountInfo struct containing parsed mount details:
-//      MountPoint        : Cleaned mount path without protocol prefix
-//      MountPointPrefix  : Protocol identifier (e.g., "nfs://" or "cpfs://")
-//      FileSystemId      : Unique filesystem identifier (NAS IDs are truncated to prefix)
-//      ServiceAddr       : Regional service endpoint (e.g., "cn-hangzhou.nas.aliyuncs.com")
-//      DirPath           : Absolute path within the filesystem
+//  - MountInfo struct containing parsed mount details, see ParseMountInfo
 //  - error in these cases:
 //      * Dataset retrieval failure
 //      * Missing mount configuration in Dataset
-//      * Invalid mount path format (doesn't match expected patterns)
-//      * Unsupported protocol prefix
-//      * Regex parsing failures
+//      * Parsing failures of ParseMountInfo, with the Runtime name/namespace for diagnostics
 //
 // Notes:
-//  - Only processes the FIRST mount entry in Dataset.Spec.Mounts
-//  - Enforces strict path normalization: trims whitespace and appends trailing slash
-//  - NAS filesystem IDs are truncated at first hyphen (e.g., "fs-12345" becomes "fs")
-//  - Supported prefixes:
-//      NasMountPointPrefix = "nfs://"
-//      CpfsMountPointPrefix = "cpfs://"
-//  - All errors include contextual identifiers (Runtime name/namespace/mountpoint) for diagnostics
 //  - Successful parsing emits structured log with all extracted mount parameters
 
 func (e *EFCEngine) getMountInfo() (info MountInfo, err error) {
@@ -215,49 +198,62 @@ func (e *EFCEngine) getMountInfo() (info MountInfo, err error) {
 		return info, fmt.Errorf("empty mount point for EFCRuntime name:%s, namespace:%s", e.name, e.namespace)
 	}
 
-	mount := dataset.Spec.Mounts[0]
-	mount.MountPoint = strings.TrimSpace(mount.MountPoint)
-	if !strings.HasSuffix(mount.MountPoint, "/") {
-		mount.MountPoint = mount.MountPoint + "/"
+	info, err = ParseMountInfo(dataset.Spec.Mounts[0].MountPoint)
+	if err != nil {
+		return info, fmt.Errorf("failed to parse the mount point for EFCRuntime name:%s, namespace:%s: %w", e.name, e.namespace, err)
+	}
+
+	e.Log.Info("EFCRuntime MountInfo", "mountPoint", info.MountPoint, "mountPointPrefix", info.MountPointPrefix, "ServiceAddr", info.ServiceAddr, "FileSystemId", info.FileSystemId, "DirPath", info.DirPath)
+
+	return info, nil
+}
+
+// ParseMountInfo parses the mount point of a Dataset mounted by EFCRuntime, i.e. nfs://<NAS address>:<path>
+// or cpfs://<CPFS address>:/share<path>, after trimming whitespace and appending a trailing slash. It returns
+// the mount point without the protocol prefix, the prefix, the filesystem ID (NAS IDs are truncated at the
+// first hyphen), the service address and the directory path, or an error for an invalid mount point format
+// or an unsupported protocol prefix.
+func ParseMountInfo(mountPoint string) (info MountInfo, err error) {
+	mountPoint = strings.TrimSpace(mountPoint)
+	if !strings.HasSuffix(mountPoint, "/") {
+		mountPoint = mountPoint + "/"
 	}
 
-	if strings.HasPrefix(mount.MountPoint, NasMountPointPrefix) {
+	if strings.HasPrefix(mountPoint, NasMountPointPrefix) {
 		reg, err := regexp.Compile(`^(nfs://)([a-z0-9-]+)\.([a-z0-9-]+)\.nas\.aliyuncs\.com:`)
 		if err != nil {
-			return info, fmt.Errorf("error regexp nas mount point for EFCRuntime name:%s, namespace:%s, mountpoint:%s", e.name, e.namespace, mount.MountPoint)
+			return info, fmt.Errorf("error regexp nas mount point, mountpoint:%s", mountPoint)
 		}
 
-		result := reg.FindAllStringSubmatch(mount.MountPoint, -1)
+		result := reg.FindAllStringSubmatch(mountPoint, -1)
 		if len(result) == 0 || len(result[0]) != 4 {
-			return info, fmt.Errorf("error nas mount point format for EFCRuntime name:%s, namespace:%s, mountpoint:%s", e.name, e.namespace, mount.MountPoint)
+			return info, fmt.Errorf("error nas mount point format, mountpoint:%s", mountPoint)
 		}
 
-		info.MountPoint = strings.TrimPrefix(mount.MountPoint, NasMountPointPrefix)
+		info.MountPoint = strings.TrimPrefix(mountPoint, NasMountPointPrefix)
 		info.MountPointPrefix = result[0][1]
 		info.FileSystemId = strings.Split(result[0][2], "-")[0]
 		info.ServiceAddr = result[0][3]
-		info.DirPath = strings.TrimPrefix(mount.MountPoint, result[0][0])
-	} else if strings.HasPrefix(mount.MountPoint, CpfsMountPointPrefix) {
+		info.DirPath = strings.TrimPrefix(mountPoint, result[0][0])
+	} else if strings.HasPrefix(mountPoint, CpfsMountPointPrefix) {
 		reg, err := regexp.Compile(`^(cpfs://)([a-z0-9-]+)\.([a-z0-9-]+)\.cpfs\.aliyuncs\.com:/share`)
 		if err != nil {
-			return info, fmt.Errorf("error regexp cpfs mount point for EFCRuntime name:%s, namespace:%s, mountpoint:%s", e.name, e.namespace, mount.MountPoint)
+			return info, fmt.Errorf("error regexp cpfs mount point, mountpoint:%s", mountPoint)
 		}
 
-		result := reg.FindAllStringSubmatch(mount.MountPoint, -1)
+		result := reg.FindAllStringSubmatch(mountPoint, -1)
 		if len(result) == 0 || len(result[0]) != 4 {
-			return info, fmt.Errorf("error cpfs mount point format for EFCRuntime name:%s, namespace:%s, mountpoint:%s", e.name, e.namespace, mount.MountPoint)
+			return info, fmt.Errorf("error cpfs mount point format, mountpoint:%s", mountPoint)
 		}
 
-		info.MountPoint = strings.TrimPrefix(mount.MountPoint, CpfsMountPointPrefix)
+		info.MountPoint = strings.TrimPrefix(mountPoint, CpfsMountPointPrefix)
 		info.MountPointPrefix = result[0][1]
 		info.FileSystemId = result[0][2]
 		info.ServiceAddr = result[0][3]
-		info.DirPath = strings.TrimPrefix(mount.MountPoint, result[0][0])
+		info.DirPath = strings.TrimPrefix(mountPoint, result[0][0])
 	} else {
-		return info, fmt.Errorf("invalid mountpoint format for EFCRuntime name:%s, namespace:%s, mountpoint:%s", e.name, e.namespace, mount.MountPoint)
+		return info, fmt.Errorf("invalid mountpoint format, mountpoint:%s", mountPoint)
 	}
 
-	e.Log.Info("EFCRuntime MountInfo", "mountPoint", info.MountPoint, "mountPointPrefix", info.MountPointPrefix, "ServiceAddr", info.ServiceAddr, "FileSystemId", info.FileSystemId, "DirPath", info.DirPath)
-
 	return info, nil
 }
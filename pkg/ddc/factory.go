@@ -29,6 +29,7 @@ import (
 	"github.com/fluid-cloudnative/fluid/pkg/ddc/vineyard"
 	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	"fmt"
 )
@@ -37,6 +38,20 @@ type buildFunc func(id string, ctx cruntime.ReconcileRequestContext) (engine bas
 
 var buildFuncMap map[string]buildFunc
 
+// parseMountPointFuncMap contains the funcs the engines parse the first mount point of a dataset with, by the
+// runtime types. The runtimes not listed here, e.g. AlluxioRuntime and JindoRuntime passing the mount points to
+// the UFS as they are, accept any mount point.
+var parseMountPointFuncMap = map[string]func(mountPoint string) error{
+	common.JuiceFSRuntime: func(mountPoint string) error {
+		_, err := juicefs.ParseSubPathFromMountPoint(mountPoint)
+		return err
+	},
+	common.EFCRuntime: func(mountPoint string) error {
+		_, err := efc.ParseMountInfo(mountPoint)
+		return err
+	},
+}
+
 func init() {
 	buildFuncMap = map[string]buildFunc{
 		common.AlluxioEngineImpl:    alluxio.Build,
@@ -90,3 +105,18 @@ func InferEngineImpl(runtimeStatus fluidv1alpha1.RuntimeStatus, defaultImpl stri
 
 	return defaultImpl
 }
+
+// ValidateMountPoints validates the mount points of a dataset in the same way as the engine of the runtime type
+// parses them when the dataset is bound to the runtime.
+func ValidateMountPoints(runtimeType string, mounts []fluidv1alpha1.Mount, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	parse, found := parseMountPointFuncMap[runtimeType]
+	if !found || len(mounts) == 0 {
+		return allErrs
+	}
+	if err := parse(mounts[0].MountPoint); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Index(0).Child("mountPoint"), mounts[0].MountPoint,
+			fmt.Sprintf("not supported by %s: %v", runtimeType, err)))
+	}
+	return allErrs
+}
@@ -28,6 +28,7 @@ import (
 
 	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
 	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/credentials"
 	"github.com/fluid-cloudnative/fluid/pkg/ddc/base/portallocator"
 	"github.com/fluid-cloudnative/fluid/pkg/utils"
 	"github.com/fluid-cloudnative/fluid/pkg/utils/docker"
@@ -251,7 +252,10 @@ func (e *JindoEngine) transformMaster(runtime *datav1alpha1.JindoRuntime, metaPa
 		// to check whether encryptOptions exist
 		for _, encryptOption := range mount.EncryptOptions {
 			key := encryptOption.Name
-			secretKeyRef := encryptOption.ValueFrom.SecretKeyRef
+			secretKeyRef, err := credentials.GetSecretKeyRef(e.name, encryptOption)
+			if err != nil {
+				return err
+			}
 			secret, err := kubeclient.GetSecret(e.Client, secretKeyRef.Name, e.namespace)
 			if err != nil {
 				e.Log.Info("can't get the secret")
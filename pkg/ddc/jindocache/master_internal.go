@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/base"
 	"github.com/fluid-cloudnative/fluid/pkg/utils"
 	"github.com/fluid-cloudnative/fluid/pkg/utils/helm"
 	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
@@ -43,7 +44,7 @@ func (e *JindoCacheEngine) setupMasterInernal() (err error) {
 		return
 	}
 
-	return helm.InstallRelease(e.name, e.namespace, valueFileName, chartName)
+	return base.InstallRelease(e.Client, e.runtime, e.runtimeType, e.name, e.namespace, valueFileName, chartName)
 }
 
 func (e *JindoCacheEngine) generateJindoValueFile() (valueFileName string, err error) {
@@ -31,6 +31,7 @@ import (
 
 	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
 	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/credentials"
 	"github.com/fluid-cloudnative/fluid/pkg/ddc/base/portallocator"
 	jindocommon "github.com/fluid-cloudnative/fluid/pkg/ddc/jindo"
 	"github.com/fluid-cloudnative/fluid/pkg/utils"
@@ -506,7 +507,11 @@ func (e *JindoCacheEngine) transformMaster(runtime *datav1alpha1.JindoRuntime, m
 		// to check whether encryptOptions exist
 		for _, encryptOption := range mount.EncryptOptions {
 			key := encryptOption.Name
-			secretKeyRef := encryptOption.ValueFrom.SecretKeyRef
+			var secretKeyRef datav1alpha1.SecretKeySelector
+			if secretKeyRef, err = credentials.GetSecretKeyRef(e.name, encryptOption); err != nil {
+				e.Log.Error(err, "unsupported encryptOption source", "key", key, "mountPoint", mount.MountPoint)
+				return err
+			}
 			if err = jindocommon.ValidateSecretKeyRef(secretKeyRef, key, mount.MountPoint); err != nil {
 				e.Log.Error(err, "invalid encryptOption secret reference", "key", key, "mountPoint", mount.MountPoint)
 				return err
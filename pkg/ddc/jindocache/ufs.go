@@ -22,6 +22,7 @@ import (
 	"reflect"
 
 	"github.com/fluid-cloudnative/fluid/pkg/ddc/jindocache/operations"
+
 	"github.com/fluid-cloudnative/fluid/pkg/utils"
 	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
 	"github.com/pkg/errors"
@@ -22,6 +22,7 @@ import (
 	"github.com/fluid-cloudnative/fluid/pkg/common"
 
 	"github.com/fluid-cloudnative/fluid/pkg/ddc/jindocache/operations"
+
 	"github.com/fluid-cloudnative/fluid/pkg/utils"
 )
 
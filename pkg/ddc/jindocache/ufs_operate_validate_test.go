@@ -44,6 +44,7 @@ func (f fakeOperation) SetTargetDatasetStatusInProgress(*datav1alpha1.Dataset)
 func (f fakeOperation) RemoveTargetDatasetStatusInProgress(*datav1alpha1.Dataset) {}
 func (f fakeOperation) GetStatusHandler() dataoperation.StatusHandler             { return nil }
 func (f fakeOperation) GetTTL() (*int32, error)                                   { return nil, nil }
+func (f fakeOperation) GetRetryPolicy() *datav1alpha1.RetryPolicy                 { return nil }
 func (f fakeOperation) GetParallelTaskNumber() int32                              { return 1 }
 
 var _ = Describe("JindoCacheEngine UFS, operation and validate helpers", func() {
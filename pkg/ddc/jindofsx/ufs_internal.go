@@ -23,6 +23,7 @@ import (
 	"github.com/fluid-cloudnative/fluid/pkg/common"
 
 	"github.com/fluid-cloudnative/fluid/pkg/ddc/jindofsx/operations"
+
 	"github.com/fluid-cloudnative/fluid/pkg/utils"
 )
 
@@ -49,6 +49,8 @@ const (
 
 	MountPath                 = "mountpath"
 	Edition                   = "edition"
+	Source                    = "source"
+	SubPath                   = "subpath"
 	MetaurlSecret             = "metaurlSecret"
 	MetaurlSecretKey          = "metaurlSecretKey"
 	TokenSecret               = "tokenSecret"
@@ -65,6 +67,10 @@ const (
 	DefaultDataMigrateTimeout = "30m"
 
 	NativeVolumeMigratePath = "/mnt/fluid-native/"
+
+	// handoffWarmupTimeoutSeconds is the time spent on warming up the target worker in a single sync.
+	// The data already cached by the target worker is skipped, so the handoff resumes in the next sync.
+	handoffWarmupTimeoutSeconds int32 = 60
 )
 
 const (
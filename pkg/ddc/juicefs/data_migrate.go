@@ -121,6 +121,11 @@ func (j *JuiceFSEngine) generateDataMigrateValueFile(r cruntime.ReconcileRequest
 		addWorkerPodPreferredAntiAffinity(&dataMigrateInfo, dataMigrate)
 	}
 
+	// set options to resume from the previous attempts
+	if dataMigrate.Spec.Resume {
+		dataMigrateInfo.Resume = genResumeOptions(dataMigrate)
+	}
+
 	// inject the node affinity by previous operation pod.
 	dataMigrateInfo.Affinity, err = dataflow.InjectAffinityByRunAfterOp(j.Client, dataMigrate.Spec.RunAfter, dataMigrate.Namespace, dataMigrateInfo.Affinity)
 	if err != nil {
@@ -228,6 +233,20 @@ func addWorkerPodPreferredAntiAffinity(dataMigrateInfo *cdatamigrate.DataMigrate
 		append(dataMigrateInfo.Affinity.PodAntiAffinity.PreferredDuringSchedulingIgnoredDuringExecution, podAffinityTerm)
 }
 
+func genResumeOptions(dataMigrate *datav1alpha1.DataMigrate) cdatamigrate.ResumeOptions {
+	verifyMode := dataMigrate.Spec.VerifyMode
+	if verifyMode == "" {
+		verifyMode = datav1alpha1.SizeAndMtimeVerifyMode
+	}
+	return cdatamigrate.ResumeOptions{
+		Enabled:    true,
+		VerifyMode: string(verifyMode),
+		// the manifest is named after the DataMigrate, so that a re-created DataMigrate resumes from it
+		ManifestName:        fmt.Sprintf("%s-%s", dataMigrate.Namespace, dataMigrate.Name),
+		ManifestVolumeClaim: dataMigrate.Spec.ManifestVolumeClaim,
+	}
+}
+
 func (j *JuiceFSEngine) setParallelMigrateOptions(dataMigrateInfo *cdatamigrate.DataMigrateInfo, dataMigrate *datav1alpha1.DataMigrate) error {
 	var err error
 	dataMigrateInfo.ParallelOptions = cdatamigrate.ParallelOptions{
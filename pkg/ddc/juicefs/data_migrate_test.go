@@ -19,6 +19,7 @@ package juicefs
 import (
 	"encoding/base64"
 	"fmt"
+	"os"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -33,6 +34,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
 )
 
 // TestJuiceFSEngine_generateDataMigrateValueFile tests the generateDataMigrateValueFile method of JuiceFSEngine.
@@ -209,6 +211,55 @@ var _ = Describe("JuiceFSEngine_generateDataMigrateValueFile", func() {
 		Expect(err).NotTo(HaveOccurred())
 		Expect(fileName).To(ContainSubstring("fluid-test-para-datamigrate-migrate-values.yaml"))
 	})
+
+	It("should generate value file with resume options for resumable data migration", func() {
+		runtimeInfo, err := base.BuildRuntimeInfo("juicefs", "fluid", "juicefs")
+		Expect(err).NotTo(HaveOccurred())
+
+		engine := JuiceFSEngine{
+			name:        "juicefs",
+			namespace:   "fluid",
+			Client:      context.Client,
+			Log:         fake.NullLogger(),
+			runtimeInfo: runtimeInfo,
+		}
+
+		dataMigrateWithTarget.Spec.Resume = true
+		dataMigrateWithTarget.Spec.ManifestVolumeClaim = "migrate-manifest"
+		fileName, err := engine.generateDataMigrateValueFile(context, &dataMigrateWithTarget)
+		Expect(err).NotTo(HaveOccurred())
+
+		data, err := os.ReadFile(fileName)
+		Expect(err).NotTo(HaveOccurred())
+		value := cdatamigrate.DataMigrateValue{}
+		Expect(yaml.Unmarshal(data, &value)).To(Succeed())
+		Expect(value.DataMigrateInfo.Resume).To(Equal(cdatamigrate.ResumeOptions{
+			Enabled:             true,
+			VerifyMode:          string(v1alpha1.SizeAndMtimeVerifyMode),
+			ManifestName:        "fluid-test-datamigrate",
+			ManifestVolumeClaim: "migrate-manifest",
+		}))
+	})
+})
+
+var _ = Describe("genResumeOptions", func() {
+	It("should use the verify mode of the DataMigrate", func() {
+		dataMigrate := &v1alpha1.DataMigrate{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-datamigrate", Namespace: "default"},
+			Spec: v1alpha1.DataMigrateSpec{
+				Resume:              true,
+				VerifyMode:          v1alpha1.ChecksumVerifyMode,
+				ManifestVolumeClaim: "migrate-manifest",
+			},
+		}
+
+		Expect(genResumeOptions(dataMigrate)).To(Equal(cdatamigrate.ResumeOptions{
+			Enabled:             true,
+			VerifyMode:          string(v1alpha1.ChecksumVerifyMode),
+			ManifestName:        "default-test-datamigrate",
+			ManifestVolumeClaim: "migrate-manifest",
+		}))
+	})
 })
 
 var _ = Describe("JuiceFSEngine_genDataUrl_PVC", func() {
@@ -58,6 +58,38 @@ func parseCacheInfoFromConfigMap(configMap *v1.ConfigMap) (cacheinfo map[string]
 	return configmapinfo, nil
 }
 
+// GetWorkerInfoFromConfigmap gets the mount path of the worker, the edition, the source and the sub path of the volume
+// from the values configmap
+func GetWorkerInfoFromConfigmap(client client.Client, name string, namespace string) (workerinfo map[string]string, err error) {
+	configMapName := fmt.Sprintf("%s-juicefs-values", name)
+	configMap, err := kubeclient.GetConfigmapByName(client, configMapName, namespace)
+	if err != nil {
+		return nil, errors.Wrap(err, "GetConfigMapByName error when GetWorkerInfoFromConfigmap")
+	}
+	if configMap == nil {
+		return nil, fmt.Errorf("configmap %s/%s is not found", namespace, configMapName)
+	}
+
+	return parseWorkerInfoFromConfigMap(configMap)
+}
+
+// parseWorkerInfoFromConfigMap extracts the mount path of the worker, the edition, the source and the sub path of the
+// volume given a configMap
+func parseWorkerInfoFromConfigMap(configMap *v1.ConfigMap) (workerinfo map[string]string, err error) {
+	var value JuiceFS
+	workerinfo = map[string]string{}
+	if v, ok := configMap.Data["data"]; ok {
+		if err = yaml.Unmarshal([]byte(v), &value); err != nil {
+			return nil, err
+		}
+		workerinfo[MountPath] = value.Worker.MountPath
+		workerinfo[Edition] = value.Edition
+		workerinfo[Source] = value.Source
+		workerinfo[SubPath] = value.Fuse.SubPath
+	}
+	return workerinfo, nil
+}
+
 // GetFSInfoFromConfigMap retrieves file system information from a specified ConfigMap.
 // Parameters:
 //   - client: A Kubernetes client used to interact with the cluster.
@@ -139,6 +139,46 @@ func Test_parseCacheInfoFromConfigMap(t *testing.T) {
 	}
 }
 
+func Test_parseWorkerInfoFromConfigMap(t *testing.T) {
+	tests := []struct {
+		name           string
+		configMap      *v1.ConfigMap
+		wantWorkerInfo map[string]string
+		wantErr        bool
+	}{
+		{
+			name: "parseWorkerInfoFromConfigMap",
+			configMap: &v1.ConfigMap{
+				Data: map[string]string{
+					"data": "edition: community\nsource: ${METAURL}\nfuse:\n  subPath: /demo\nworker:\n  mountPath: /runtime-mnt/juicefs/default/jfsdemo/juicefs-fuse\n",
+				},
+			},
+			wantWorkerInfo: map[string]string{"mountpath": "/runtime-mnt/juicefs/default/jfsdemo/juicefs-fuse", "edition": "community", "source": "${METAURL}", "subpath": "/demo"},
+		},
+		{
+			name: "parseWorkerInfoFromConfigMap-err",
+			configMap: &v1.ConfigMap{
+				Data: map[string]string{
+					"data": `test`,
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotWorkerInfo, err := parseWorkerInfoFromConfigMap(tt.configMap)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("parseWorkerInfoFromConfigMap() error = %v, wantErr %v", err, tt.wantErr)
+				return
+			}
+			if !tt.wantErr && !reflect.DeepEqual(gotWorkerInfo, tt.wantWorkerInfo) {
+				t.Errorf("parseWorkerInfoFromConfigMap() gotWorkerInfo = %v, want %v", gotWorkerInfo, tt.wantWorkerInfo)
+			}
+		})
+	}
+}
+
 // TestGetFSInfoFromConfigMap is a unit test for the GetFSInfoFromConfigMap function.
 // It verifies that the function correctly retrieves file system information from a ConfigMap.
 //
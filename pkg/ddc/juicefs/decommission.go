@@ -0,0 +1,84 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package juicefs
+
+import (
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/ctrl"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/juicefs/operations"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var _ ctrl.WorkerCacheHandoff = &JuiceFSEngine{}
+
+// decommissionWorkers hands the cache of the workers to be removed by scale-in off to the remaining workers.
+// It runs before the retry on conflict of SyncReplicas, so that the handoff is not repeated on conflicts.
+func (j *JuiceFSEngine) decommissionWorkers(ctx cruntime.ReconcileRequestContext) (ready bool, err error) {
+	workers, err := ctrl.GetWorkersAsStatefulset(j.Client,
+		types.NamespacedName{Namespace: j.namespace, Name: j.getWorkerName()})
+	if err != nil {
+		if errors.IsNotFound(err) {
+			// the missing workers are reported by SyncReplicas
+			return true, nil
+		}
+		return false, err
+	}
+
+	runtime, err := j.getRuntime()
+	if err != nil {
+		return false, err
+	}
+
+	return j.Helper.DecommissionWorkers(ctx, runtime, workers, j)
+}
+
+// GetWorkersCachedBytes gets the cached bytes of the running worker pods from the block cache metrics of their mounts
+func (j *JuiceFSEngine) GetWorkersCachedBytes(workers []corev1.Pod) (map[string]int64, error) {
+	edition := j.GetEdition()
+	cachedBytes := map[string]int64{}
+	for _, pod := range workers {
+		if pod.Status.Phase != corev1.PodRunning {
+			continue
+		}
+		metrics, err := j.GetPodMetrics(pod.Name, common.JuiceFSWorkerContainer)
+		if err != nil {
+			return nil, err
+		}
+		cachedBytes[pod.Name] = j.parseMetric(metrics, edition).blockCacheBytes
+	}
+	return cachedBytes, nil
+}
+
+// HandoffWorkerCache warms up the target worker with `juicefs warmup` on the mount point of the dataset.
+// The blocks in the cache of JuiceFS are named by the slices of the files, which can't be mapped back to the files
+// without scanning the metadata, so the whole dataset is warmed up instead of the files cached by the decommissioning
+// worker, bounded by the cache capacity of the target worker. The warmup is limited in each sync and skips
+// the data already cached by the target worker, so it resumes in the next sync until it finishes.
+func (j *JuiceFSEngine) HandoffWorkerCache(worker corev1.Pod, target corev1.Pod) (done bool, err error) {
+	fileUtils := operations.NewJuiceFileUtils(target.Name, common.JuiceFSWorkerContainer, j.namespace, j.Log)
+	done, err = fileUtils.Warmup(j.getMountPoint(), handoffWarmupTimeoutSeconds)
+	if err != nil {
+		return false, err
+	}
+	if !done {
+		j.Log.Info("The warmup of the target worker continues in the next sync", "worker", worker.Name, "target", target.Name)
+	}
+	return done, nil
+}
@@ -0,0 +1,83 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package juicefs
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/agiledragon/gomonkey/v2"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/juicefs/operations"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestGetWorkersCachedBytes(t *testing.T) {
+	engine := &JuiceFSEngine{name: "test", namespace: "fluid", Log: fake.NullLogger()}
+	patches := gomonkey.ApplyMethod(reflect.TypeOf(engine), "GetEdition", func(_ *JuiceFSEngine) string {
+		return EnterpriseEdition
+	})
+	defer patches.Reset()
+	patches.ApplyMethod(reflect.TypeOf(operations.JuiceFileUtils{}), "GetMetric",
+		func(_ operations.JuiceFileUtils, juicefsPath string) (string, error) {
+			return mockJuiceFSMetric(), nil
+		})
+
+	workers := []corev1.Pod{
+		{ObjectMeta: metav1.ObjectMeta{Name: "test-worker-0"}, Status: corev1.PodStatus{Phase: corev1.PodRunning}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "test-worker-1"}, Status: corev1.PodStatus{Phase: corev1.PodPending}},
+	}
+	cachedBytes, err := engine.GetWorkersCachedBytes(workers)
+	if err != nil {
+		t.Fatalf("GetWorkersCachedBytes() error = %v", err)
+	}
+	if cachedBytes["test-worker-0"] != 40757435762 {
+		t.Errorf("expect the cached bytes of test-worker-0 to be 40757435762, got %d", cachedBytes["test-worker-0"])
+	}
+	if _, found := cachedBytes["test-worker-1"]; found {
+		t.Errorf("expect the cached bytes of test-worker-1 to be unknown")
+	}
+}
+
+func TestHandoffWorkerCache(t *testing.T) {
+	engine := &JuiceFSEngine{name: "test", namespace: "fluid", Log: fake.NullLogger()}
+	var warmedUp []string
+	finished := false
+	patches := gomonkey.ApplyMethod(reflect.TypeOf(operations.JuiceFileUtils{}), "Warmup",
+		func(_ operations.JuiceFileUtils, juicefsPath string, timeout int32) (bool, error) {
+			warmedUp = append(warmedUp, juicefsPath)
+			return finished, nil
+		})
+	defer patches.Reset()
+
+	worker := corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "test-worker-1"}}
+	target := corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "test-worker-0"}}
+	done, err := engine.HandoffWorkerCache(worker, target)
+	if err != nil || done {
+		t.Errorf("expect the handoff to continue while the warmup is unfinished, got done %v and error %v", done, err)
+	}
+
+	finished = true
+	done, err = engine.HandoffWorkerCache(worker, target)
+	if err != nil || !done {
+		t.Errorf("expect the handoff to be done once the warmup finishes, got done %v and error %v", done, err)
+	}
+	if len(warmedUp) != 2 || warmedUp[0] != engine.getMountPoint() {
+		t.Errorf("expect the mount point to be warmed up, got %v", warmedUp)
+	}
+}
@@ -24,6 +24,7 @@ import (
 
 	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
 	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/base"
 	"github.com/fluid-cloudnative/fluid/pkg/utils"
 	"github.com/fluid-cloudnative/fluid/pkg/utils/helm"
 	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
@@ -54,7 +55,7 @@ func (j *JuiceFSEngine) installJuiceFS() (err error) {
 		return
 	}
 
-	return helm.InstallRelease(j.name, j.namespace, valueFileName, chartName)
+	return base.InstallRelease(j.Client, runtime, j.runtimeType, j.name, j.namespace, valueFileName, chartName)
 }
 
 // generate juicefs struct
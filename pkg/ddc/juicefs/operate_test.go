@@ -97,6 +97,10 @@ func (m *mockOperation) GetTTL() (ttl *int32, err error) {
 	return nil, nil
 }
 
+func (m *mockOperation) GetRetryPolicy() *datav1alpha1.RetryPolicy {
+	return nil
+}
+
 func (m *mockOperation) GetParallelTaskNumber() int32 {
 	return 1
 }
@@ -358,3 +358,119 @@ func (j JuiceFileUtils) DeleteCacheDir(dir string) (err error) {
 	}
 	return
 }
+
+// warmupScript warms up the path $2 for at most $1 seconds, and prints "unfinished" if it times out
+const warmupScript = `timeout "$1" juicefs warmup "$2" && exit 0
+rc=$?
+if [ $rc -eq 124 ]; then echo unfinished; exit 0; fi
+exit $rc`
+
+// Warmup warms up the cache of the pod with the data under the path for at most timeout seconds. The data already
+// cached by the pod is skipped by `juicefs warmup`, so an unfinished warmup resumes in the next call.
+// It returns true if the warmup finishes within the timeout.
+func (j JuiceFileUtils) Warmup(juicefsPath string, timeout int32) (done bool, err error) {
+	var (
+		command = []string{"bash", "-c", warmupScript, "warmup", strconv.FormatInt(int64(timeout), 10), juicefsPath}
+		stdout  string
+		stderr  string
+	)
+
+	stdout, stderr, err = j.exec(command, false)
+	if err != nil {
+		j.log.Error(err, "JuiceFileUtils.Warmup() failed", "stdout", stdout, "stderr", stderr)
+		return
+	}
+	return !strings.Contains(stdout, "unfinished"), nil
+}
+
+// withRootMounted wraps the script to run with the root of the volume mounted at $root, the volume is unmounted
+// after the script exits
+func withRootMounted(source string, enterprise bool, script string) string {
+	mount := fmt.Sprintf("juicefs mount -d %s \"$root\"", source)
+	if enterprise {
+		mount = fmt.Sprintf("juicefs mount %s \"$root\"", source)
+	}
+	return fmt.Sprintf(`set -e
+root=$(mktemp -d /tmp/fluid-snapshot.XXXXXX)
+trap 'juicefs umount "$root" 2>/dev/null; rmdir "$root"' EXIT
+%s
+%s`, mount, script)
+}
+
+// cloneScript clones the directory $1 to $2 under the root of the volume. The clone is made at $2.tmp and renamed to
+// $2 only if it has the same files, sizes and mtimes as the source after cloning, since cloning a directory is not atomic.
+const cloneScript = `src="$root/$1"
+dst="$root/$2"
+if [ -e "$dst" ]; then exit 0; fi
+mkdir -p "$(dirname "$dst")"
+if [ -e "$dst.tmp" ]; then juicefs rmr "$dst.tmp"; fi
+juicefs %s "$src" "$dst.tmp"
+list() { (cd "$1" && find . -exec stat -c '%%F %%s %%Y %%n' {} + | sort); }
+if [ "$(list "$src")" != "$(list "$dst.tmp")" ]; then
+  juicefs rmr "$dst.tmp"
+  echo "the data is modified during the clone" >&2
+  exit 1
+fi
+mv "$dst.tmp" "$dst"`
+
+// cloneInBackgroundScript runs the clone script $4 of $1 to $2 in the background, tracked by the state files prefixed
+// with $3. It prints "done" once the clone finishes and "running" while it runs. If the clone fails, it exits with the
+// output of the clone, and the clone is started again by the next run.
+const cloneInBackgroundScript = `state="$3"
+if [ -e "$state.done" ]; then rm -f "$state.done" "$state.pid"; echo done; exit 0; fi
+if [ -e "$state.failed" ]; then cat "$state.failed" >&2; rm -f "$state.failed" "$state.pid"; exit 1; fi
+if [ -e "$state.pid" ] && kill -0 "$(cat "$state.pid")" 2>/dev/null; then echo running; exit 0; fi
+nohup bash -c 'if bash -c "$1" clone "$2" "$3" >"$4.log" 2>&1; then mv "$4.log" "$4.done"; else mv "$4.log" "$4.failed"; fi' \
+  clone "$4" "$1" "$2" "$state" </dev/null >/dev/null 2>&1 &
+echo $! >"$state.pid"
+echo running`
+
+// removeCloneScript removes the clone $1 under the root of the volume if it exists
+const removeCloneScript = `if [ -e "$root/$1" ]; then juicefs rmr "$root/$1"; fi`
+
+// CloneDir clones the directory src to dst, both relative to the root of the volume, without copying the data,
+// with `juicefs clone` of the community edition or `juicefs snapshot` of the enterprise edition. The root of the
+// volume is mounted in the pod for the clone, so that dst can be out of the sub directory mounted by the dataset.
+// The clone is only kept if the source is not modified during it, and an existing clone is kept as it is.
+// The clone runs in the background of the pod since it takes long for large directories, CloneDir starts it and
+// returns true once it's done, so it's called until then. The failed clone is started again by the next call.
+func (j JuiceFileUtils) CloneDir(source string, src string, dst string, enterprise bool) (done bool, err error) {
+	var (
+		cloneCommand = "clone"
+		stdout       string
+		stderr       string
+	)
+	if enterprise {
+		cloneCommand = "snapshot"
+	}
+	script := withRootMounted(source, enterprise, fmt.Sprintf(cloneScript, cloneCommand))
+	command := []string{"bash", "-c", cloneInBackgroundScript, "clone", src, dst, cloneStatePath(dst), script}
+
+	stdout, stderr, err = j.exec(command, false)
+	if err != nil {
+		j.log.Error(err, "JuiceFileUtils.CloneDir() failed", "stdout", stdout, "stderr", stderr)
+		return
+	}
+	return strings.TrimSpace(stdout) == "done", nil
+}
+
+// cloneStatePath returns the prefix of the state files in the pod tracking the clone to dst
+func cloneStatePath(dst string) string {
+	return "/tmp/fluid-clone-" + strings.ReplaceAll(strings.Trim(dst, "/"), "/", "_")
+}
+
+// RemoveDir removes the directory relative to the root of the volume with `juicefs rmr` if it exists
+func (j JuiceFileUtils) RemoveDir(source string, dir string, enterprise bool) (err error) {
+	var (
+		command = []string{"bash", "-c", withRootMounted(source, enterprise, removeCloneScript), "remove", dir}
+		stdout  string
+		stderr  string
+	)
+
+	stdout, stderr, err = j.exec(command, false)
+	if err != nil {
+		j.log.Error(err, "JuiceFileUtils.RemoveDir() failed", "stdout", stdout, "stderr", stderr)
+		return
+	}
+	return
+}
@@ -18,6 +18,7 @@ package operations
 import (
 	"errors"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/agiledragon/gomonkey/v2"
@@ -465,3 +466,112 @@ func TestValidDir(t *testing.T) {
 		})
 	}
 }
+
+func TestJuiceFileUtils_CloneDir(t *testing.T) {
+	var executed []string
+	stdout := ""
+	ExecCommon := func(a JuiceFileUtils, command []string, verbose bool) (string, string, error) {
+		executed = command
+		return stdout, "", nil
+	}
+	ExecErr := func(a JuiceFileUtils, command []string, verbose bool) (stdout string, stderr string, err error) {
+		return "", "the data is modified during the clone", errors.New("fail to run the command")
+	}
+
+	patches := gomonkey.ApplyPrivateMethod(JuiceFileUtils{}, "exec", ExecErr)
+	defer patches.Reset()
+	a := JuiceFileUtils{}
+	_, err := a.CloneDir("${METAURL}", "/data", ".fluid-snapshots/default/demo/v1", false)
+	if err == nil {
+		t.Error("check failure, want err, got nil")
+	}
+
+	patches.ApplyPrivateMethod(JuiceFileUtils{}, "exec", ExecCommon)
+	stdout = "running\n"
+	done, err := a.CloneDir("${METAURL}", "/data", ".fluid-snapshots/default/demo/v1", false)
+	if err != nil || done {
+		t.Errorf("check failure, want the running clone not done, got done %v, err %v", done, err)
+	}
+	want := []string{"/data", ".fluid-snapshots/default/demo/v1", "/tmp/fluid-clone-.fluid-snapshots_default_demo_v1"}
+	if len(executed) != 8 || executed[0] != "bash" || executed[2] != cloneInBackgroundScript || !reflect.DeepEqual(executed[4:7], want) {
+		t.Fatalf("check failure, want the clone run in the background with the paths passed as arguments, got %v", executed)
+	}
+	for _, want := range []string{"juicefs mount -d ${METAURL} \"$root\"", "juicefs clone \"$src\" \"$dst.tmp\"",
+		"stat -c '%F %s %Y %n'", "mv \"$dst.tmp\" \"$dst\""} {
+		if !strings.Contains(executed[7], want) {
+			t.Errorf("check failure, want the script to contain %q, got %s", want, executed[7])
+		}
+	}
+
+	stdout = "done\n"
+	done, err = a.CloneDir("jfsdemo", "/data", ".fluid-snapshots/default/demo/v1", true)
+	if err != nil || !done {
+		t.Errorf("check failure, want the clone done, got done %v, err %v", done, err)
+	}
+	for _, want := range []string{"juicefs mount jfsdemo \"$root\"", "juicefs snapshot \"$src\" \"$dst.tmp\""} {
+		if !strings.Contains(executed[7], want) {
+			t.Errorf("check failure, want the script to contain %q, got %s", want, executed[7])
+		}
+	}
+}
+
+func TestJuiceFileUtils_RemoveDir(t *testing.T) {
+	var executed []string
+	ExecCommon := func(a JuiceFileUtils, command []string, verbose bool) (stdout string, stderr string, err error) {
+		executed = command
+		return "", "", nil
+	}
+	ExecErr := func(a JuiceFileUtils, command []string, verbose bool) (stdout string, stderr string, err error) {
+		return "", "", errors.New("fail to run the command")
+	}
+
+	patches := gomonkey.ApplyPrivateMethod(JuiceFileUtils{}, "exec", ExecErr)
+	defer patches.Reset()
+	a := JuiceFileUtils{}
+	err := a.RemoveDir("${METAURL}", ".fluid-snapshots/default/demo/v1", false)
+	if err == nil {
+		t.Error("check failure, want err, got nil")
+	}
+
+	patches.ApplyPrivateMethod(JuiceFileUtils{}, "exec", ExecCommon)
+	if err = a.RemoveDir("${METAURL}", ".fluid-snapshots/default/demo/v1", false); err != nil {
+		t.Errorf("check failure, want nil, got err: %v", err)
+	}
+	if len(executed) != 5 || executed[4] != ".fluid-snapshots/default/demo/v1" || !strings.Contains(executed[2], "then juicefs rmr \"$root/$1\"") {
+		t.Errorf("check failure, want juicefs rmr of the clone, got %v", executed)
+	}
+}
+
+func TestJuiceFileUtils_Warmup(t *testing.T) {
+	var executed []string
+	stdout := ""
+	ExecCommon := func(a JuiceFileUtils, command []string, verbose bool) (string, string, error) {
+		executed = command
+		return stdout, "", nil
+	}
+	ExecErr := func(a JuiceFileUtils, command []string, verbose bool) (string, string, error) {
+		return "", "", errors.New("fail to run the command")
+	}
+
+	patches := gomonkey.ApplyPrivateMethod(JuiceFileUtils{}, "exec", ExecErr)
+	defer patches.Reset()
+	a := JuiceFileUtils{}
+	if _, err := a.Warmup("/runtime-mnt/juicefs/fluid/test/juicefs-fuse", 60); err == nil {
+		t.Error("check failure, want err, got nil")
+	}
+
+	patches.ApplyPrivateMethod(JuiceFileUtils{}, "exec", ExecCommon)
+	done, err := a.Warmup("/runtime-mnt/juicefs/fluid/test/juicefs-fuse", 60)
+	if err != nil || !done {
+		t.Errorf("check failure, want done, got done %v and err %v", done, err)
+	}
+	if len(executed) != 6 || !reflect.DeepEqual(executed[4:], []string{"60", "/runtime-mnt/juicefs/fluid/test/juicefs-fuse"}) {
+		t.Errorf("check failure, want the timeout and path passed as arguments, got %v", executed)
+	}
+
+	stdout = "unfinished\n"
+	done, err = a.Warmup("/runtime-mnt/juicefs/fluid/test/juicefs-fuse", 60)
+	if err != nil || done {
+		t.Errorf("check failure, want unfinished, got done %v and err %v", done, err)
+	}
+}
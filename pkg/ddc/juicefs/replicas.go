@@ -32,6 +32,16 @@ func (j *JuiceFSEngine) SyncReplicas(ctx cruntime.ReconcileRequestContext) (err
 		namespace  string = j.namespace
 	)
 
+	ready, err := j.decommissionWorkers(ctx)
+	if err != nil {
+		_ = utils.LoggingErrorExceptConflict(j.Log, err, "Failed to decommission workers", types.NamespacedName{Namespace: j.namespace, Name: j.name})
+		return
+	}
+	if !ready {
+		j.Log.Info("Hold the scale-in until the cache of the decommissioning workers is handed off")
+		return
+	}
+
 	workers, err := kubeclient.GetStatefulSet(j.Client, workerName, namespace)
 	if err != nil {
 		return err
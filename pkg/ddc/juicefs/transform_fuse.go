@@ -27,6 +27,7 @@ import (
 
 	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
 	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/credentials"
 	"github.com/fluid-cloudnative/fluid/pkg/utils"
 	"github.com/fluid-cloudnative/fluid/pkg/utils/security"
 )
@@ -174,7 +175,10 @@ func (j *JuiceFSEngine) genValue(mount datav1alpha1.Mount, tiredStoreLevel *data
 
 	for _, encryptOption := range sharedEncryptOptions {
 		key := encryptOption.Name
-		secretKeyRef := encryptOption.ValueFrom.SecretKeyRef
+		secretKeyRef, err := credentials.GetSecretKeyRef(j.name, encryptOption)
+		if err != nil {
+			return options, err
+		}
 
 		switch key {
 		case JuiceMetaUrl:
@@ -211,7 +215,10 @@ func (j *JuiceFSEngine) genValue(mount datav1alpha1.Mount, tiredStoreLevel *data
 
 	for _, encryptOption := range mount.EncryptOptions {
 		key := encryptOption.Name
-		secretKeyRef := encryptOption.ValueFrom.SecretKeyRef
+		secretKeyRef, err := credentials.GetSecretKeyRef(j.name, encryptOption)
+		if err != nil {
+			return options, err
+		}
 
 		switch key {
 		case JuiceMetaUrl:
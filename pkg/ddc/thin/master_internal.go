@@ -26,6 +26,7 @@ import (
 
 	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
 	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/base"
 	"github.com/fluid-cloudnative/fluid/pkg/utils"
 	"github.com/fluid-cloudnative/fluid/pkg/utils/helm"
 	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
@@ -61,7 +62,7 @@ func (t *ThinEngine) setupMasterInternal() (err error) {
 		return
 	}
 
-	return helm.InstallRelease(t.name, t.namespace, valueFileName, chartName)
+	return base.InstallRelease(t.Client, runtime, t.runtimeType, t.name, t.namespace, valueFileName, chartName)
 }
 
 func (t *ThinEngine) generateThinValueFile(runtime *datav1alpha1.ThinRuntime, profile *datav1alpha1.ThinRuntimeProfile) (valueFileName string, err error) {
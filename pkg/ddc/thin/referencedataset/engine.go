@@ -19,11 +19,13 @@ package referencedataset
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"time"
 
 	"github.com/fluid-cloudnative/fluid/api/v1alpha1"
 	"github.com/fluid-cloudnative/fluid/pkg/common"
 	"github.com/fluid-cloudnative/fluid/pkg/dataoperation"
+	"github.com/fluid-cloudnative/fluid/pkg/datasetshare"
 	"github.com/fluid-cloudnative/fluid/pkg/errors"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -153,6 +155,18 @@ func (e *ReferenceDatasetEngine) Setup(ctx cruntime.ReconcileRequestContext) (re
 	}
 	namespacedName := physicalDatasetNameSpacedNames[0]
 
+	// the dataset in another namespace must be shared with this dataset by a DatasetShare
+	var grant *v1alpha1.DatasetRefGrant
+	if namespacedName.Namespace != dataset.Namespace {
+		grant, err = e.checkGrant(ctx, dataset, namespacedName)
+		if err != nil {
+			return false, err
+		}
+		if grant == nil {
+			return false, fmt.Errorf("%s", datasetshare.DeniedMessage(namespacedName, dataset))
+		}
+	}
+
 	err = retry.RetryOnConflict(retry.DefaultBackoff, func() error {
 		physicalDataset, err := utils.GetDataset(ctx.Client, namespacedName.Name, namespacedName.Namespace)
 		if err != nil {
@@ -167,9 +181,15 @@ func (e *ReferenceDatasetEngine) Setup(ctx cruntime.ReconcileRequestContext) (re
 
 		// 3. add this dataset to physical dataset DatasetRef field
 		datasetRefName := base.GetDatasetRefName(dataset.Name, dataset.Namespace)
-		if !utils.ContainsString(physicalDataset.Status.DatasetRef, datasetRefName) {
-			newDataset := physicalDataset.DeepCopy()
+		newDataset := physicalDataset.DeepCopy()
+		if !utils.ContainsString(newDataset.Status.DatasetRef, datasetRefName) {
 			newDataset.Status.DatasetRef = append(newDataset.Status.DatasetRef, datasetRefName)
+		}
+		// record the grant allowing this dataset to mount the physical dataset in another namespace
+		if grant != nil {
+			newDataset.Status.DatasetRefGrants = datasetshare.SetRefGrant(newDataset.Status.DatasetRefGrants, *grant)
+		}
+		if !reflect.DeepEqual(newDataset.Status, physicalDataset.Status) {
 			err := e.Client.Status().Update(context.TODO(), newDataset)
 			if err != nil {
 				return err
@@ -219,6 +239,7 @@ func (e *ReferenceDatasetEngine) Shutdown() (err error) {
 		if utils.ContainsString(physicalDataset.Status.DatasetRef, datasetRefName) {
 			newDataset := physicalDataset.DeepCopy()
 			newDataset.Status.DatasetRef = utils.RemoveString(newDataset.Status.DatasetRef, datasetRefName)
+			newDataset.Status.DatasetRefGrants = datasetshare.RemoveRefGrant(newDataset.Status.DatasetRefGrants, datasetRefName)
 			err := e.Client.Status().Update(context.TODO(), newDataset)
 			if err != nil {
 				return err
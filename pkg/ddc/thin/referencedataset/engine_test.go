@@ -32,6 +32,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -136,8 +137,15 @@ var _ = Describe("ReferenceDatasetEngine", func() {
 			}
 			configCM := v1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: alluxioRuntime.Name + "-config", Namespace: alluxioRuntime.Namespace}, Data: map[string]string{"check.sh": "/bin/sh check"}}
 			fuseDs := appsv1.DaemonSet{ObjectMeta: metav1.ObjectMeta{Name: "done-fuse", Namespace: "big-data"}}
+			share := datav1alpha1.DatasetShare{
+				ObjectMeta: metav1.ObjectMeta{Name: "done", Namespace: "big-data"},
+				Spec: datav1alpha1.DatasetShareSpec{
+					Dataset: "done",
+					Grants:  []datav1alpha1.DatasetShareGrant{{Namespaces: []string{"fluid"}}},
+				},
+			}
 
-			fakeClient := fake.NewFakeClientWithScheme(testScheme, &dataset, &refDataset, &configCM, &alluxioRuntime, &refRuntime, &fuseDs)
+			fakeClient := fake.NewFakeClientWithScheme(testScheme, &dataset, &refDataset, &configCM, &alluxioRuntime, &refRuntime, &fuseDs, &share)
 
 			e := &ReferenceDatasetEngine{Client: fakeClient, name: refRuntime.Name, namespace: refRuntime.Namespace}
 			gotReady, err := e.Setup(cruntime.ReconcileRequestContext{Dataset: &refDataset, Client: fakeClient})
@@ -148,12 +156,85 @@ var _ = Describe("ReferenceDatasetEngine", func() {
 			err = fakeClient.Get(context.TODO(), types.NamespacedName{Namespace: alluxioRuntime.Namespace, Name: alluxioRuntime.Name}, updatedDataset)
 			Expect(err).NotTo(HaveOccurred())
 			Expect(utils.ContainsString(updatedDataset.Status.DatasetRef, base.GetDatasetRefName(e.name, e.namespace))).To(BeTrue())
+			Expect(updatedDataset.Status.DatasetRefGrants).To(ConsistOf(datav1alpha1.DatasetRefGrant{
+				DatasetRef:   base.GetDatasetRefName(e.name, e.namespace),
+				DatasetShare: "done",
+				AccessMode:   datav1alpha1.DatasetShareReadOnly,
+			}))
 
 			cmList := &v1.ConfigMapList{}
 			err = fakeClient.List(context.TODO(), cmList, &client.ListOptions{Namespace: e.namespace})
 			Expect(err).NotTo(HaveOccurred())
 			Expect(len(cmList.Items)).To(Equal(1))
 		})
+
+		It("should not setup if the service account granted is not the creator", func() {
+			testScheme := runtime.NewScheme()
+			_ = v1.AddToScheme(testScheme)
+			_ = datav1alpha1.AddToScheme(testScheme)
+
+			dataset := datav1alpha1.Dataset{
+				ObjectMeta: metav1.ObjectMeta{Name: "done", Namespace: "big-data"},
+				Status: datav1alpha1.DatasetStatus{
+					Runtimes: []datav1alpha1.Runtime{{Name: "done", Namespace: "big-data", Type: common.AlluxioRuntime}},
+				},
+			}
+			refDataset := datav1alpha1.Dataset{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "hbase",
+					Namespace:   "fluid",
+					Annotations: map[string]string{common.AnnotationDatasetCreator: "alice"},
+				},
+				Spec: datav1alpha1.DatasetSpec{Mounts: []datav1alpha1.Mount{{MountPoint: "dataset://big-data/done"}}},
+			}
+			share := datav1alpha1.DatasetShare{
+				ObjectMeta: metav1.ObjectMeta{Name: "done", Namespace: "big-data"},
+				Spec: datav1alpha1.DatasetShareSpec{
+					Dataset: "done",
+					Grants: []datav1alpha1.DatasetShareGrant{{
+						ServiceAccounts: []datav1alpha1.DatasetShareServiceAccount{{Namespace: "fluid", Name: "deployer"}},
+					}},
+				},
+			}
+
+			fakeClient := fake.NewFakeClientWithScheme(testScheme, &dataset, &refDataset, &share)
+			recorder := record.NewFakeRecorder(1)
+			e := &ReferenceDatasetEngine{Client: fakeClient, name: "hbase", namespace: "fluid"}
+			gotReady, err := e.Setup(cruntime.ReconcileRequestContext{Dataset: &refDataset, Client: fakeClient, Recorder: recorder})
+			Expect(err).To(HaveOccurred())
+			Expect(gotReady).To(BeFalse())
+			Expect(<-recorder.Events).To(ContainSubstring(common.DatasetShareNotGranted))
+		})
+
+		It("should not setup if the physical dataset is not shared", func() {
+			testScheme := runtime.NewScheme()
+			_ = v1.AddToScheme(testScheme)
+			_ = datav1alpha1.AddToScheme(testScheme)
+
+			dataset := datav1alpha1.Dataset{
+				ObjectMeta: metav1.ObjectMeta{Name: "done", Namespace: "big-data"},
+				Status: datav1alpha1.DatasetStatus{
+					Runtimes: []datav1alpha1.Runtime{{Name: "done", Namespace: "big-data", Type: common.AlluxioRuntime}},
+				},
+			}
+			refDataset := datav1alpha1.Dataset{
+				ObjectMeta: metav1.ObjectMeta{Name: "hbase", Namespace: "fluid"},
+				Spec:       datav1alpha1.DatasetSpec{Mounts: []datav1alpha1.Mount{{MountPoint: "dataset://big-data/done"}}},
+			}
+
+			fakeClient := fake.NewFakeClientWithScheme(testScheme, &dataset, &refDataset)
+			recorder := record.NewFakeRecorder(1)
+			e := &ReferenceDatasetEngine{Client: fakeClient, name: "hbase", namespace: "fluid"}
+			gotReady, err := e.Setup(cruntime.ReconcileRequestContext{Dataset: &refDataset, Client: fakeClient, Recorder: recorder})
+			Expect(err).To(HaveOccurred())
+			Expect(gotReady).To(BeFalse())
+			Expect(<-recorder.Events).To(ContainSubstring(common.DatasetShareNotGranted))
+
+			updatedDataset := &datav1alpha1.Dataset{}
+			err = fakeClient.Get(context.TODO(), types.NamespacedName{Namespace: "big-data", Name: "done"}, updatedDataset)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updatedDataset.Status.DatasetRef).To(BeEmpty())
+		})
 	})
 
 	Describe("Shutdown", func() {
@@ -0,0 +1,101 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package referencedataset
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/datasetshare"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/base"
+	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+)
+
+// checkGrant finds the DatasetShare allowing the dataset to mount the physical dataset in another namespace.
+// The service accounts in the grants are checked against the creator recorded at admission. It returns nil
+// without error if the access is not granted, and a warning event is recorded.
+func (e *ReferenceDatasetEngine) checkGrant(ctx cruntime.ReconcileRequestContext,
+	dataset *v1alpha1.Dataset,
+	physical types.NamespacedName) (*v1alpha1.DatasetRefGrant, error) {
+	grant, err := datasetshare.FindGrant(e.Client, physical, dataset, datasetshare.GetCreator(dataset))
+	if err != nil {
+		return nil, err
+	}
+	if grant == nil {
+		ctx.Recorder.Event(dataset, v1.EventTypeWarning, common.DatasetShareNotGranted, datasetshare.DeniedMessage(physical, dataset))
+	}
+	return grant, nil
+}
+
+// syncGrant checks the DatasetShares again on each sync, and updates the grant recorded in the physical dataset.
+// The grant is removed from the physical dataset and an error is returned once it's revoked.
+func (e *ReferenceDatasetEngine) syncGrant(ctx cruntime.ReconcileRequestContext,
+	dataset *v1alpha1.Dataset,
+	physical types.NamespacedName) error {
+	if physical.Namespace == dataset.Namespace {
+		return nil
+	}
+
+	grant, err := e.checkGrant(ctx, dataset, physical)
+	if err != nil {
+		return err
+	}
+
+	datasetRefName := base.GetDatasetRefName(dataset.Name, dataset.Namespace)
+	err = retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		physicalDataset, err := utils.GetDataset(e.Client, physical.Name, physical.Namespace)
+		if err != nil {
+			return err
+		}
+		newDataset := physicalDataset.DeepCopy()
+		if grant != nil {
+			newDataset.Status.DatasetRefGrants = datasetshare.SetRefGrant(newDataset.Status.DatasetRefGrants, *grant)
+		} else {
+			newDataset.Status.DatasetRefGrants = datasetshare.RemoveRefGrant(newDataset.Status.DatasetRefGrants, datasetRefName)
+		}
+		if reflect.DeepEqual(findRefGrant(physicalDataset.Status.DatasetRefGrants, datasetRefName),
+			findRefGrant(newDataset.Status.DatasetRefGrants, datasetRefName)) {
+			return nil
+		}
+		return e.Client.Status().Update(context.TODO(), newDataset)
+	})
+	if err != nil {
+		return err
+	}
+
+	if grant == nil {
+		return fmt.Errorf("%s", datasetshare.DeniedMessage(physical, dataset))
+	}
+	return nil
+}
+
+// findRefGrant finds the grant recorded for the referring dataset
+func findRefGrant(grants []v1alpha1.DatasetRefGrant, datasetRef string) *v1alpha1.DatasetRefGrant {
+	for i := range grants {
+		if grants[i].DatasetRef == datasetRef {
+			return &grants[i]
+		}
+	}
+	return nil
+}
@@ -47,6 +47,11 @@ func (e *ReferenceDatasetEngine) Sync(ctx cruntime.ReconcileRequestContext) (err
 	if err != nil {
 		return err
 	}
+	// the dataset in another namespace stops syncing once the DatasetShare doesn't grant the access any more
+	err = e.syncGrant(ctx, virtualDataset, types.NamespacedName{Name: physicalRuntimeInfo.GetName(), Namespace: physicalRuntimeInfo.GetNamespace()})
+	if err != nil {
+		return err
+	}
 	physicalDataset, err := utils.GetDataset(e.Client, physicalRuntimeInfo.GetName(), physicalRuntimeInfo.GetNamespace())
 	if err != nil {
 		return err
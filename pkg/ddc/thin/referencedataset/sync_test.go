@@ -32,6 +32,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -101,10 +102,59 @@ var _ = Describe("ReferenceDatasetEngine Sync Tests", Label("pkg.ddc.thin.refere
 				},
 			}
 
-			testObjs := []runtime.Object{&datasetObj, &refDatasetObj, &alluxioRt, &refRuntimeObj}
+			shareObj := datav1alpha1.DatasetShare{
+				ObjectMeta: metav1.ObjectMeta{Name: "done", Namespace: "big-data"},
+				Spec: datav1alpha1.DatasetShareSpec{
+					Dataset: "done",
+					Grants:  []datav1alpha1.DatasetShareGrant{{Namespaces: []string{"fluid"}}},
+				},
+			}
+
+			testObjs := []runtime.Object{&datasetObj, &refDatasetObj, &alluxioRt, &refRuntimeObj, &shareObj}
 			fakeClient = fake.NewFakeClientWithScheme(testScheme, testObjs...)
 		})
 
+		Context("when the dataset share is revoked", func() {
+			It("should stop syncing and remove the recorded grant", func() {
+				datasetToUpdate := datasetObj.DeepCopy()
+				datasetToUpdate.Status.DatasetRefGrants = []datav1alpha1.DatasetRefGrant{
+					{DatasetRef: "fluid/hbase", DatasetShare: "done", AccessMode: datav1alpha1.DatasetShareReadOnly},
+					{DatasetRef: "fluid/test", DatasetShare: "done", AccessMode: datav1alpha1.DatasetShareReadOnly},
+				}
+				Expect(fakeClient.Status().Update(context.TODO(), datasetToUpdate)).To(Succeed())
+				Expect(fakeClient.Delete(context.TODO(), &datav1alpha1.DatasetShare{
+					ObjectMeta: metav1.ObjectMeta{Name: "done", Namespace: "big-data"},
+				})).To(Succeed())
+
+				e := &ReferenceDatasetEngine{
+					Id:                "reference-engine",
+					Client:            fakeClient,
+					Log:               fake.NullLogger(),
+					name:              refRuntimeObj.GetName(),
+					namespace:         refRuntimeObj.GetNamespace(),
+					timeOfLastSync:    time.Now().Add(-defaultSyncRetryDuration),
+					syncRetryDuration: defaultSyncRetryDuration,
+				}
+
+				recorder := record.NewFakeRecorder(1)
+				err := e.Sync(cruntime.ReconcileRequestContext{Recorder: recorder})
+				Expect(err).To(HaveOccurred())
+				Expect(<-recorder.Events).To(ContainSubstring(common.DatasetShareNotGranted))
+
+				updatedDataset := &datav1alpha1.Dataset{}
+				err = fakeClient.Get(context.TODO(), types.NamespacedName{Namespace: "big-data", Name: "done"}, updatedDataset)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(updatedDataset.Status.DatasetRefGrants).To(ConsistOf(datav1alpha1.DatasetRefGrant{
+					DatasetRef: "fluid/test", DatasetShare: "done", AccessMode: datav1alpha1.DatasetShareReadOnly,
+				}))
+
+				updatedRefDataset := &datav1alpha1.Dataset{}
+				err = fakeClient.Get(context.TODO(), types.NamespacedName{Namespace: "fluid", Name: "hbase"}, updatedRefDataset)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(updatedRefDataset.Status.UfsTotal).To(BeEmpty())
+			})
+		})
+
 		Context("when syncing reference dataset", func() {
 			It("should sync successfully and update dataset status", func() {
 				e := &ReferenceDatasetEngine{
@@ -255,7 +305,15 @@ var _ = Describe("ReferenceDatasetEngine Sync Tests", Label("pkg.ddc.thin.refere
 				},
 			}
 
-			testObjs := []runtime.Object{&datasetObj, &cacheRuntimeObj, &refDatasetObj, &refRuntimeObj}
+			shareObj := datav1alpha1.DatasetShare{
+				ObjectMeta: metav1.ObjectMeta{Name: "cache-runtime", Namespace: "cache-ns"},
+				Spec: datav1alpha1.DatasetShareSpec{
+					Dataset: "cache-runtime",
+					Grants:  []datav1alpha1.DatasetShareGrant{{Namespaces: []string{"ref-ns"}}},
+				},
+			}
+
+			testObjs := []runtime.Object{&datasetObj, &cacheRuntimeObj, &refDatasetObj, &refRuntimeObj, &shareObj}
 			fakeClient = fake.NewFakeClientWithScheme(testScheme, testObjs...)
 		})
 
@@ -18,8 +18,36 @@ package thin
 
 import (
 	cruntime "github.com/fluid-cloudnative/fluid/pkg/runtime"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
 )
 
+// SyncReplicas syncs the replicas of the workers with the replicas in the runtime spec, which may be changed
+// through the scale subresource (e.g. by kubectl scale or HPA). It does nothing in fuse only mode.
 func (t ThinEngine) SyncReplicas(ctx cruntime.ReconcileRequestContext) (err error) {
-	return nil
+	if !t.isWorkerEnable() {
+		return nil
+	}
+
+	workers, err := kubeclient.GetStatefulSet(t.Client, t.getWorkerName(), t.namespace)
+	if err != nil {
+		return err
+	}
+
+	err = retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		runtime, err := t.getRuntime()
+		if err != nil {
+			return err
+		}
+		runtimeToUpdate := runtime.DeepCopy()
+		err = t.Helper.SyncReplicas(ctx, runtimeToUpdate, runtimeToUpdate.Status, workers)
+		return err
+	})
+	if err != nil {
+		return utils.LoggingErrorExceptConflict(t.Log, err, "Failed to sync the replicas",
+			types.NamespacedName{Namespace: t.namespace, Name: t.name})
+	}
+	return
 }
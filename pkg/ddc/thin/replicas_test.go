@@ -17,6 +17,7 @@
 package thin
 
 import (
+	"context"
 	"testing"
 
 	"github.com/fluid-cloudnative/fluid/api/v1alpha1"
@@ -30,6 +31,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -271,3 +273,74 @@ func TestSyncReplicas(t *testing.T) {
 		}
 	}
 }
+
+func TestSyncReplicasWithWorkerEnabled(t *testing.T) {
+	var replicas int32 = 1
+	runtimeInput := &v1alpha1.ThinRuntime{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "spark",
+			Namespace: "fluid",
+		},
+		Spec: v1alpha1.ThinRuntimeSpec{
+			Replicas: 3,
+			Worker: v1alpha1.ThinCompTemplateSpec{
+				Enabled: true,
+			},
+		},
+		Status: v1alpha1.RuntimeStatus{
+			DesiredWorkerNumberScheduled: 1,
+		},
+	}
+	workersInput := &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "spark-worker",
+			Namespace: "fluid",
+		},
+		Spec: appsv1.StatefulSetSpec{
+			Replicas: &replicas,
+		},
+	}
+
+	datasetInput := &v1alpha1.Dataset{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "spark",
+			Namespace: "fluid",
+		},
+	}
+
+	fakeClient := fake.NewFakeClientWithScheme(testScheme, runtimeInput.DeepCopy(), workersInput.DeepCopy(), datasetInput)
+	engine := newThinEngineREP(fakeClient, "spark", "fluid")
+	engine.runtime = runtimeInput
+	engine.Helper = ctrlhelper.BuildHelper(engine.runtimeInfo, fakeClient, engine.Log)
+
+	err := engine.SyncReplicas(cruntime.ReconcileRequestContext{
+		Log:      fake.NullLogger(),
+		Recorder: record.NewFakeRecorder(300),
+	})
+	if err != nil {
+		t.Fatalf("sync replicas failed, err: %v", err)
+	}
+
+	workers := &appsv1.StatefulSet{}
+	err = fakeClient.Get(context.TODO(), types.NamespacedName{Namespace: "fluid", Name: "spark-worker"}, workers)
+	if err != nil {
+		t.Fatalf("failed to get workers, err: %v", err)
+	}
+	if workers.Spec.Replicas == nil || *workers.Spec.Replicas != 3 {
+		t.Errorf("expect the replicas of workers to be 3, got %v", workers.Spec.Replicas)
+	}
+
+	rt, err := engine.getRuntime()
+	if err != nil {
+		t.Fatalf("failed to get runtime, err: %v", err)
+	}
+	found := false
+	for _, cond := range rt.Status.Conditions {
+		if cond.Type == v1alpha1.RuntimeWorkerScaledOut {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("expect condition %s, got %v", v1alpha1.RuntimeWorkerScaledOut, rt.Status.Conditions)
+	}
+}
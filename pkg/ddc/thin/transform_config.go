@@ -20,11 +20,11 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
-	"path/filepath"
 	"strings"
 
 	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
 	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/credentials"
 	"github.com/fluid-cloudnative/fluid/pkg/utils"
 	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
 	"github.com/pkg/errors"
@@ -101,28 +101,19 @@ func (t *ThinEngine) transformFuseConfig(runtime *datav1alpha1.ThinRuntime, data
 func (t *ThinEngine) transformEncryptOptionsWithSecretVolumes(m datav1alpha1.Mount, sharedEncryptOptions []datav1alpha1.EncryptOption, value *ThinValue) (options map[string]string) {
 	options = make(map[string]string)
 	for _, encryptOpt := range append(sharedEncryptOptions, m.EncryptOptions...) {
-		secretName := encryptOpt.ValueFrom.SecretKeyRef.Name
-		secretMountPath := fmt.Sprintf("/etc/fluid/secrets/%s", secretName)
-		volName := fmt.Sprintf("thin-fuseconfig-%s", secretName)
-
-		volumeToAdd := corev1.Volume{
-			Name: volName,
-			VolumeSource: corev1.VolumeSource{
-				Secret: &corev1.SecretVolumeSource{
-					SecretName: secretName,
-				},
-			},
-		}
-		value.Fuse.Volumes = utils.AppendOrOverrideVolume(value.Fuse.Volumes, volumeToAdd)
+		file := credentials.GetFile(t.name, encryptOpt, func(source string) string {
+			return fmt.Sprintf("thin-fuseconfig-%s", source)
+		})
+		value.Fuse.Volumes = utils.AppendOrOverrideVolume(value.Fuse.Volumes, file.Volume)
 
 		volumeMountToAdd := corev1.VolumeMount{
-			Name:      volName,
+			Name:      file.Volume.Name,
 			ReadOnly:  true,
-			MountPath: secretMountPath,
+			MountPath: file.MountPath,
 		}
 
 		value.Fuse.VolumeMounts = utils.AppendOrOverrideVolumeMounts(value.Fuse.VolumeMounts, volumeMountToAdd)
-		options[encryptOpt.Name] = filepath.Join(secretMountPath, encryptOpt.ValueFrom.SecretKeyRef.Key)
+		options[encryptOpt.Name] = file.Path
 	}
 
 	return options
@@ -235,4 +235,53 @@ var _ = Describe("ThinEngine transformFuseConfig", func() {
 		Expect(config.Mounts[0].Options).To(HaveKeyWithValue("access-key-id", "test-ak"))
 		Expect(config.Mounts[0].Options).To(HaveKeyWithValue("access-key-secret", "test-sk"))
 	})
+
+	It("mounts the files of credential providers and secret stores when fuse config storage is configmap", func() {
+		GinkgoTB().Setenv(EnvFuseConfigStorage, "configmap")
+		dataset.Spec.Mounts = []datav1alpha1.Mount{{
+			MountPoint: "s3://bucket/data",
+			EncryptOptions: []datav1alpha1.EncryptOption{{
+				Name: "access-key-id",
+				ValueFrom: datav1alpha1.EncryptOptionSource{
+					CredentialProvider: &datav1alpha1.CredentialProviderSelector{URL: "http://provider", Key: "accessKeyId"},
+				},
+			}, {
+				Name: "access-key-secret",
+				ValueFrom: datav1alpha1.EncryptOptionSource{
+					CSISecretStore: &datav1alpha1.CSISecretStoreSelector{SecretProviderClass: "vault", ObjectName: "sk"},
+				},
+			}},
+		}}
+
+		err := engine.transformFuseConfig(runtime, dataset, value)
+
+		Expect(err).NotTo(HaveOccurred())
+		Expect(value.Fuse.Volumes).To(HaveLen(2))
+		Expect(value.Fuse.Volumes[0].Name).To(Equal("thin-fuseconfig-thin-test-fluid-credentials"))
+		Expect(value.Fuse.Volumes[0].Secret.SecretName).To(Equal("thin-test-fluid-credentials"))
+		Expect(value.Fuse.Volumes[1].Name).To(Equal("thin-fuseconfig-spc-vault"))
+		Expect(value.Fuse.Volumes[1].CSI.VolumeAttributes).To(HaveKeyWithValue("secretProviderClass", "vault"))
+
+		config := &Config{}
+		Expect(json.Unmarshal([]byte(value.Fuse.ConfigValue), config)).To(Succeed())
+		Expect(config.Mounts[0].Options).To(HaveKeyWithValue("access-key-id", "/etc/fluid/secrets/thin-test-fluid-credentials/access-key-id"))
+		Expect(config.Mounts[0].Options).To(HaveKeyWithValue("access-key-secret", "/etc/fluid/secrets/spc-vault/sk"))
+	})
+
+	It("returns an error for the secret store when fuse config storage is secret", func() {
+		GinkgoTB().Setenv(EnvFuseConfigStorage, "secret")
+		dataset.Spec.Mounts = []datav1alpha1.Mount{{
+			MountPoint: "s3://bucket/data",
+			EncryptOptions: []datav1alpha1.EncryptOption{{
+				Name: "access-key-secret",
+				ValueFrom: datav1alpha1.EncryptOptionSource{
+					CSISecretStore: &datav1alpha1.CSISecretStoreSelector{SecretProviderClass: "vault", ObjectName: "sk"},
+				},
+			}},
+		}}
+
+		err := engine.transformFuseConfig(runtime, dataset, value)
+
+		Expect(err).To(MatchError(ContainSubstring("is read from the SecretProviderClass vault")))
+	})
 })
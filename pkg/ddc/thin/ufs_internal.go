@@ -21,6 +21,7 @@ import (
 
 	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
 	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/credentials"
 	"github.com/fluid-cloudnative/fluid/pkg/ddc/thin/operations"
 	"github.com/fluid-cloudnative/fluid/pkg/utils"
 	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
@@ -108,7 +109,10 @@ func (t *ThinEngine) genEncryptOptions(EncryptOptions []datav1alpha1.EncryptOpti
 		}
 
 		securityutil.UpdateSensitiveKey(item.Name)
-		sRef := item.ValueFrom.SecretKeyRef
+		sRef, err := credentials.GetSecretKeyRef(t.name, item)
+		if err != nil {
+			return mOptions, err
+		}
 		secret, err := kubeclient.GetSecret(t.Client, sRef.Name, t.namespace)
 		if err != nil {
 			t.Log.Error(err, "get secret by mount encrypt options failed", "name", item.Name)
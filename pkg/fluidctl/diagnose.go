@@ -0,0 +1,229 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fluidctl
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/yaml"
+
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+)
+
+// LogGetter gets the logs of the container in the pod.
+type LogGetter func(ctx context.Context, namespace, pod, container string) ([]byte, error)
+
+// NewLogGetter returns the LogGetter getting the last tailLines lines of the logs with the clientset.
+func NewLogGetter(clientset kubernetes.Interface, tailLines int64) LogGetter {
+	return func(ctx context.Context, namespace, pod, container string) ([]byte, error) {
+		return clientset.CoreV1().Pods(namespace).GetLogs(pod, &corev1.PodLogOptions{
+			Container: container,
+			TailLines: &tailLines,
+		}).DoRaw(ctx)
+	}
+}
+
+// Diagnoser collects the objects, events, logs and values of a Dataset and its runtime into a tarball.
+type Diagnoser struct {
+	Reader  client.Reader
+	Scheme  *runtime.Scheme
+	GetLogs LogGetter
+	// FluidNamespace is the namespace where Fluid is installed.
+	FluidNamespace string
+
+	tarWriter *tar.Writer
+	dir       string
+	errs      []string
+}
+
+// Collect writes the gzipped tarball to w, with the following files in the directory diagnose-<namespace>-<name>:
+//   - dataset.yaml, <runtime kind>.yaml, pvc.yaml, pv.yaml: the Dataset, the runtime, and the PVC and PV of the Dataset.
+//   - values.yaml: the ConfigMap of the values rendering the runtime.
+//   - pods.yaml: the pods of the runtime.
+//   - events.yaml: the events of the Dataset, the runtime, and the objects named after them in the namespace.
+//   - logs/<namespace>/<pod>/<container>.log: the logs of the runtime pods, the controllers of the Dataset and
+//     the runtime, and the CSI plugins on the nodes of the runtime pods.
+//   - errors.txt: the errors collecting the files above, which don't stop collecting the others.
+func (d *Diagnoser) Collect(ctx context.Context, target *Target, w io.Writer) (err error) {
+	gzipWriter := gzip.NewWriter(w)
+	d.tarWriter = tar.NewWriter(gzipWriter)
+	d.dir = fmt.Sprintf("diagnose-%s-%s", target.Namespace, target.Name)
+	d.errs = nil
+
+	if target.Dataset != nil {
+		d.writeObjects("dataset.yaml", target.Dataset)
+	}
+	if target.Runtime != nil {
+		d.writeObjects(strings.ToLower(target.RuntimeKind())+".yaml", target.Runtime)
+		if values := GetRuntimeStatus(target.Runtime).ValuesConfigMap; values != "" {
+			configMap := &corev1.ConfigMap{}
+			d.collect("values.yaml", d.Reader.Get(ctx, types.NamespacedName{Name: values, Namespace: target.Namespace}, configMap), configMap)
+		}
+	}
+
+	pvc := &corev1.PersistentVolumeClaim{}
+	if err := d.Reader.Get(ctx, types.NamespacedName{Name: target.Name, Namespace: target.Namespace}, pvc); err == nil {
+		d.writeObjects("pvc.yaml", pvc)
+		if pvc.Spec.VolumeName != "" {
+			pv := &corev1.PersistentVolume{}
+			d.collect("pv.yaml", d.Reader.Get(ctx, types.NamespacedName{Name: pvc.Spec.VolumeName}, pv), pv)
+		}
+	} else {
+		d.addError("pvc.yaml", err)
+	}
+
+	pods := &corev1.PodList{}
+	err = d.Reader.List(ctx, pods, client.InNamespace(target.Namespace), client.MatchingLabels(runtimePodLabels(target)))
+	nodes := map[string]bool{}
+	if d.addError("pods.yaml", err) {
+		var objects []client.Object
+		for i := range pods.Items {
+			objects = append(objects, &pods.Items[i])
+			nodes[pods.Items[i].Spec.NodeName] = true
+			d.writeLogs(ctx, &pods.Items[i])
+		}
+		d.writeObjects("pods.yaml", objects...)
+	}
+
+	d.writeEvents(ctx, target)
+	d.writeControllerLogs(ctx, target, nodes)
+
+	if len(d.errs) > 0 {
+		d.writeFile("errors.txt", []byte(strings.Join(d.errs, "\n")+"\n"))
+	}
+	if err = d.tarWriter.Close(); err != nil {
+		return err
+	}
+	return gzipWriter.Close()
+}
+
+// runtimePodLabels returns the labels of the pods of the runtime.
+func runtimePodLabels(target *Target) map[string]string {
+	if target.RuntimeType == common.CacheRuntime {
+		return map[string]string{common.LabelCacheRuntimeName: target.Name}
+	}
+	return map[string]string{"release": target.Name}
+}
+
+func (d *Diagnoser) writeEvents(ctx context.Context, target *Target) {
+	events := &corev1.EventList{}
+	if !d.addError("events.yaml", d.Reader.List(ctx, events, client.InNamespace(target.Namespace))) {
+		return
+	}
+	var objects []client.Object
+	for i, event := range events.Items {
+		name := event.InvolvedObject.Name
+		if name == target.Name || strings.HasPrefix(name, target.Name+"-") {
+			objects = append(objects, &events.Items[i])
+		}
+	}
+	d.writeObjects("events.yaml", objects...)
+}
+
+// writeControllerLogs writes the logs of the dataset controller, the runtime controller, and the CSI plugins on the nodes.
+func (d *Diagnoser) writeControllerLogs(ctx context.Context, target *Target, nodes map[string]bool) {
+	selectors := []map[string]string{{"control-plane": "dataset-controller"}}
+	if target.RuntimeType != "" {
+		selectors = append(selectors, map[string]string{"control-plane": target.RuntimeType + "runtime-controller"})
+	}
+	selectors = append(selectors, map[string]string{"app": "csi-nodeplugin-fluid"})
+
+	for _, selector := range selectors {
+		pods := &corev1.PodList{}
+		err := d.Reader.List(ctx, pods, client.InNamespace(d.FluidNamespace), client.MatchingLabels(selector))
+		if !d.addError(fmt.Sprintf("logs of pods %v", selector), err) {
+			continue
+		}
+		for i := range pods.Items {
+			pod := &pods.Items[i]
+			if selector["app"] == "csi-nodeplugin-fluid" && !nodes[pod.Spec.NodeName] {
+				continue
+			}
+			d.writeLogs(ctx, pod)
+		}
+	}
+}
+
+func (d *Diagnoser) writeLogs(ctx context.Context, pod *corev1.Pod) {
+	for _, container := range pod.Spec.Containers {
+		name := path.Join("logs", pod.Namespace, pod.Name, container.Name+".log")
+		logs, err := d.GetLogs(ctx, pod.Namespace, pod.Name, container.Name)
+		if d.addError(name, err) {
+			d.writeFile(name, logs)
+		}
+	}
+}
+
+// collect writes the object if it's got without error.
+func (d *Diagnoser) collect(name string, err error, object client.Object) {
+	if d.addError(name, err) {
+		d.writeObjects(name, object)
+	}
+}
+
+// writeObjects writes the objects as a YAML stream without their managed fields.
+func (d *Diagnoser) writeObjects(name string, objects ...client.Object) {
+	var documents []string
+	for _, object := range objects {
+		object = object.DeepCopyObject().(client.Object)
+		object.SetManagedFields(nil)
+		if gvk, err := apiutil.GVKForObject(object, d.Scheme); err == nil {
+			object.GetObjectKind().SetGroupVersionKind(gvk)
+		}
+		data, err := yaml.Marshal(object)
+		if !d.addError(name, err) {
+			return
+		}
+		documents = append(documents, string(data))
+	}
+	d.writeFile(name, []byte(strings.Join(documents, "---\n")))
+}
+
+func (d *Diagnoser) writeFile(name string, data []byte) {
+	err := d.tarWriter.WriteHeader(&tar.Header{
+		Name:    path.Join(d.dir, name),
+		Mode:    0644,
+		Size:    int64(len(data)),
+		ModTime: time.Now(),
+	})
+	if err == nil {
+		_, err = d.tarWriter.Write(data)
+	}
+	d.addError(name, err)
+}
+
+// addError records the error collecting the item, and returns whether err is nil.
+func (d *Diagnoser) addError(item string, err error) bool {
+	if err == nil {
+		return true
+	}
+	d.errs = append(d.errs, fmt.Sprintf("%s: %v", item, err))
+	return false
+}
@@ -0,0 +1,141 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fluidctl
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+)
+
+// maxExplainedEvents is the number of the latest warning events to explain.
+const maxExplainedEvents = 5
+
+// Explain returns the reasons why the Dataset is not Bound, read from the status and conditions of the Dataset and
+// the runtime, the runtime controller in the fluidNamespace, and the warning events.
+func Explain(ctx context.Context, reader client.Reader, target *Target, fluidNamespace string) (reasons []string, err error) {
+	dataset, runtime := target.Dataset, target.Runtime
+	if dataset == nil {
+		return []string{fmt.Sprintf("Dataset %s/%s is not found. The %s is bound to the Dataset with the same name in its namespace, create the Dataset.",
+			target.Namespace, target.Name, target.RuntimeKind())}, nil
+	}
+	if runtime == nil {
+		return []string{fmt.Sprintf("No runtime named %s is found in namespace %s. The Dataset is bound to the runtime with the same name in its namespace, create a runtime, e.g. an AlluxioRuntime or a ThinRuntime.",
+			target.Name, target.Namespace)}, nil
+	}
+
+	if dataset.Status.Phase == datav1alpha1.BoundDatasetPhase {
+		reasons = append(reasons, fmt.Sprintf("Dataset %s/%s is Bound to %s %s/%s.",
+			dataset.Namespace, dataset.Name, target.RuntimeKind(), target.Namespace, target.Name))
+	} else {
+		controllerReason, err := explainController(ctx, reader, target.RuntimeType, fluidNamespace)
+		if err != nil {
+			return nil, err
+		}
+		if controllerReason != "" {
+			reasons = append(reasons, controllerReason)
+		}
+	}
+
+	status := GetRuntimeStatus(runtime)
+	for _, component := range status.Components {
+		if component.Phase == datav1alpha1.RuntimePhaseNotReady {
+			reasons = append(reasons, fmt.Sprintf("The %s of the runtime is NotReady, %d/%d ready%s.",
+				component.Role, component.Ready, component.Desired, reasonAndMessage(component.Reason, "")))
+		}
+	}
+	for _, condition := range status.Conditions {
+		if condition.Status == corev1.ConditionFalse {
+			reasons = append(reasons, fmt.Sprintf("Runtime condition %s is False%s.", condition.Type, reasonAndMessage(condition.Reason, condition.Message)))
+		}
+	}
+	for _, condition := range dataset.Status.Conditions {
+		if condition.Status == corev1.ConditionFalse ||
+			(condition.Type == datav1alpha1.DatasetNotReady && condition.Status == corev1.ConditionTrue) {
+			reasons = append(reasons, fmt.Sprintf("Dataset condition %s is %s%s.", condition.Type, condition.Status, reasonAndMessage(condition.Reason, condition.Message)))
+		}
+	}
+
+	events, err := getWarningEvents(ctx, reader, target)
+	if err != nil {
+		return nil, err
+	}
+	for _, event := range events {
+		reasons = append(reasons, fmt.Sprintf("Warning event %s on %s %s: %s", event.Reason, event.InvolvedObject.Kind, event.InvolvedObject.Name, event.Message))
+	}
+
+	if dataset.Status.Phase != datav1alpha1.BoundDatasetPhase && len(reasons) == 0 {
+		reasons = append(reasons, "No failure is found, the runtime may be still setting up. The Dataset becomes Bound once the runtime is ready and the storage is mounted.")
+	}
+	return reasons, nil
+}
+
+// explainController checks the controller of the runtime type, which is scaled up once a runtime of its type is created.
+func explainController(ctx context.Context, reader client.Reader, runtimeType, fluidNamespace string) (string, error) {
+	name := runtimeType + "runtime-controller"
+	deployment := &appsv1.Deployment{}
+	err := reader.Get(ctx, types.NamespacedName{Name: name, Namespace: fluidNamespace}, deployment)
+	if utils.IgnoreNotFound(err) != nil {
+		return "", err
+	}
+	if err != nil {
+		return fmt.Sprintf("The runtime controller %s/%s is not found, check the installation of Fluid.", fluidNamespace, name), nil
+	}
+	if deployment.Status.ReadyReplicas == 0 {
+		return fmt.Sprintf("The runtime controller %s/%s has no ready replica, check its pods.", fluidNamespace, name), nil
+	}
+	return "", nil
+}
+
+// getWarningEvents returns the latest warning events of the Dataset and the runtime.
+func getWarningEvents(ctx context.Context, reader client.Reader, target *Target) ([]corev1.Event, error) {
+	events := &corev1.EventList{}
+	if err := reader.List(ctx, events, client.InNamespace(target.Namespace)); err != nil {
+		return nil, err
+	}
+
+	var warnings []corev1.Event
+	for _, event := range events.Items {
+		if event.Type == corev1.EventTypeWarning && event.InvolvedObject.Name == target.Name &&
+			(event.InvolvedObject.Kind == datav1alpha1.Datasetkind || event.InvolvedObject.Kind == target.RuntimeKind()) {
+			warnings = append(warnings, event)
+		}
+	}
+	sort.SliceStable(warnings, func(i, j int) bool {
+		return eventTime(warnings[i]).After(eventTime(warnings[j]))
+	})
+	if len(warnings) > maxExplainedEvents {
+		warnings = warnings[:maxExplainedEvents]
+	}
+	return warnings, nil
+}
+
+func eventTime(event corev1.Event) time.Time {
+	if !event.LastTimestamp.IsZero() {
+		return event.LastTimestamp.Time
+	}
+	return event.EventTime.Time
+}
@@ -0,0 +1,330 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fluidctl
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+)
+
+func newTestClient(objs ...runtime.Object) (client.Client, *runtime.Scheme) {
+	s := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(s)
+	_ = datav1alpha1.AddToScheme(s)
+	return fake.NewFakeClientWithScheme(s, objs...), s
+}
+
+func newDataset(phase datav1alpha1.DatasetPhase, runtimeType string) *datav1alpha1.Dataset {
+	dataset := &datav1alpha1.Dataset{
+		ObjectMeta: metav1.ObjectMeta{Name: "hbase", Namespace: "default"},
+		Status:     datav1alpha1.DatasetStatus{Phase: phase},
+	}
+	if runtimeType != "" {
+		dataset.Status.Runtimes = []datav1alpha1.Runtime{{Name: "hbase", Namespace: "default", Type: runtimeType}}
+	}
+	return dataset
+}
+
+func TestGetTarget(t *testing.T) {
+	testcases := map[string]struct {
+		objs        []runtime.Object
+		wantType    string
+		wantDataset bool
+		wantErr     bool
+	}{
+		"bound to alluxio": {
+			objs: []runtime.Object{
+				newDataset(datav1alpha1.BoundDatasetPhase, common.AlluxioRuntime),
+				&datav1alpha1.AlluxioRuntime{ObjectMeta: metav1.ObjectMeta{Name: "hbase", Namespace: "default"}},
+			},
+			wantType:    common.AlluxioRuntime,
+			wantDataset: true,
+		},
+		"cache runtime not bound yet": {
+			objs: []runtime.Object{
+				newDataset(datav1alpha1.NotBoundDatasetPhase, ""),
+				&datav1alpha1.CacheRuntime{ObjectMeta: metav1.ObjectMeta{Name: "hbase", Namespace: "default"}},
+			},
+			wantType:    common.CacheRuntime,
+			wantDataset: true,
+		},
+		"runtime without dataset": {
+			objs: []runtime.Object{
+				&datav1alpha1.ThinRuntime{ObjectMeta: metav1.ObjectMeta{Name: "hbase", Namespace: "default"}},
+			},
+			wantType: common.ThinRuntime,
+		},
+		"nothing found": {
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			c, _ := newTestClient(tc.objs...)
+			target, err := GetTarget(context.TODO(), c, "hbase", "default")
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("expect an error, got target %v", target)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			if target.RuntimeType != tc.wantType || target.Runtime == nil {
+				t.Errorf("expect runtime type %s, got %s", tc.wantType, target.RuntimeType)
+			}
+			if (target.Dataset != nil) != tc.wantDataset {
+				t.Errorf("expect dataset found %v, got %v", tc.wantDataset, target.Dataset)
+			}
+		})
+	}
+}
+
+func TestGetStatusTree(t *testing.T) {
+	dataset := newDataset(datav1alpha1.BoundDatasetPhase, common.AlluxioRuntime)
+	dataset.Status.Conditions = []datav1alpha1.DatasetCondition{{Type: datav1alpha1.DatasetReady, Status: corev1.ConditionTrue, Reason: "DatasetReady"}}
+	alluxioRuntime := &datav1alpha1.AlluxioRuntime{
+		ObjectMeta: metav1.ObjectMeta{Name: "hbase", Namespace: "default"},
+		Status: datav1alpha1.RuntimeStatus{
+			MasterPhase: datav1alpha1.RuntimePhaseReady, MasterNumberReady: 1, DesiredMasterNumberScheduled: 1,
+			WorkerPhase: datav1alpha1.RuntimePhasePartialReady, WorkerNumberReady: 1, DesiredWorkerNumberScheduled: 2,
+		},
+	}
+	pvc := &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{Name: "hbase", Namespace: "default"},
+		Spec:       corev1.PersistentVolumeClaimSpec{VolumeName: "default-hbase"},
+		Status:     corev1.PersistentVolumeClaimStatus{Phase: corev1.ClaimBound},
+	}
+	pv := &corev1.PersistentVolume{
+		ObjectMeta: metav1.ObjectMeta{Name: "default-hbase"},
+		Status:     corev1.PersistentVolumeStatus{Phase: corev1.VolumeBound},
+	}
+	c, _ := newTestClient(dataset, alluxioRuntime, pvc, pv)
+	target, err := GetTarget(context.TODO(), c, "hbase", "default")
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	tree, err := GetStatusTree(context.TODO(), c, target)
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	out := &bytes.Buffer{}
+	tree.Print(out)
+
+	want := `Dataset default/hbase: Bound
+├── Condition Ready: True (DatasetReady)
+├── AlluxioRuntime default/hbase
+│   ├── master: Ready, 1/1 ready
+│   ├── worker: PartialReady, 1/2 ready
+│   └── fuse: Unknown, 0/0 ready
+└── PersistentVolumeClaim default/hbase: Bound
+    └── PersistentVolume default-hbase: Bound
+`
+	if out.String() != want {
+		t.Errorf("expect the tree\n%s\ngot\n%s", want, out.String())
+	}
+}
+
+func TestExplain(t *testing.T) {
+	controller := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "alluxioruntime-controller", Namespace: "fluid-system"},
+		Status:     appsv1.DeploymentStatus{ReadyReplicas: 1},
+	}
+	notReadyRuntime := &datav1alpha1.AlluxioRuntime{
+		ObjectMeta: metav1.ObjectMeta{Name: "hbase", Namespace: "default"},
+		Status: datav1alpha1.RuntimeStatus{
+			MasterPhase:  datav1alpha1.RuntimePhaseNotReady,
+			MasterReason: "Failed to deploy master",
+		},
+	}
+	event := &corev1.Event{
+		ObjectMeta:     metav1.ObjectMeta{Name: "hbase.1", Namespace: "default"},
+		InvolvedObject: corev1.ObjectReference{Kind: datav1alpha1.AlluxioRuntimeKind, Name: "hbase", Namespace: "default"},
+		Type:           corev1.EventTypeWarning,
+		Reason:         "ErrorProcessRuntime",
+		Message:        "failed to install the helm release",
+	}
+
+	testcases := map[string]struct {
+		objs []runtime.Object
+		want []string
+	}{
+		"no runtime": {
+			objs: []runtime.Object{newDataset(datav1alpha1.NotBoundDatasetPhase, "")},
+			want: []string{"No runtime named hbase is found in namespace default"},
+		},
+		"runtime not ready": {
+			objs: []runtime.Object{newDataset(datav1alpha1.NotBoundDatasetPhase, ""), notReadyRuntime, controller, event},
+			want: []string{
+				"The master of the runtime is NotReady, 0/0 ready (Failed to deploy master).",
+				"Warning event ErrorProcessRuntime on AlluxioRuntime hbase: failed to install the helm release",
+			},
+		},
+		"controller not found": {
+			objs: []runtime.Object{newDataset(datav1alpha1.NotBoundDatasetPhase, ""),
+				&datav1alpha1.AlluxioRuntime{ObjectMeta: metav1.ObjectMeta{Name: "hbase", Namespace: "default"}}},
+			want: []string{"The runtime controller fluid-system/alluxioruntime-controller is not found"},
+		},
+		"setting up": {
+			objs: []runtime.Object{newDataset(datav1alpha1.NotBoundDatasetPhase, ""), controller,
+				&datav1alpha1.AlluxioRuntime{ObjectMeta: metav1.ObjectMeta{Name: "hbase", Namespace: "default"}}},
+			want: []string{"No failure is found, the runtime may be still setting up."},
+		},
+		"bound": {
+			objs: []runtime.Object{newDataset(datav1alpha1.BoundDatasetPhase, common.AlluxioRuntime),
+				&datav1alpha1.AlluxioRuntime{ObjectMeta: metav1.ObjectMeta{Name: "hbase", Namespace: "default"}}},
+			want: []string{"Dataset default/hbase is Bound to AlluxioRuntime default/hbase."},
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			c, _ := newTestClient(tc.objs...)
+			target, err := GetTarget(context.TODO(), c, "hbase", "default")
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			reasons, err := Explain(context.TODO(), c, target, "fluid-system")
+			if err != nil {
+				t.Fatalf("expect no error, got %v", err)
+			}
+			if len(reasons) != len(tc.want) {
+				t.Fatalf("expect reasons %v, got %v", tc.want, reasons)
+			}
+			for i, want := range tc.want {
+				if !strings.HasPrefix(reasons[i], want) {
+					t.Errorf("expect reason %d to start with %q, got %q", i, want, reasons[i])
+				}
+			}
+		})
+	}
+}
+
+func TestDiagnoserCollect(t *testing.T) {
+	cacheRuntime := &datav1alpha1.CacheRuntime{
+		ObjectMeta: metav1.ObjectMeta{Name: "hbase", Namespace: "default"},
+		Status:     datav1alpha1.CacheRuntimeStatus{ValueFile: "hbase-cache-config"},
+	}
+	values := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "hbase-cache-config", Namespace: "default"},
+		Data:       map[string]string{"config.json": "{}"},
+	}
+	workerPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "hbase-worker-0", Namespace: "default",
+			Labels: map[string]string{common.LabelCacheRuntimeName: "hbase"}},
+		Spec: corev1.PodSpec{NodeName: "node-1", Containers: []corev1.Container{{Name: "worker"}}},
+	}
+	controllerPod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "cacheruntime-controller-0", Namespace: "fluid-system",
+			Labels: map[string]string{"control-plane": "cacheruntime-controller"}},
+		Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "manager"}}},
+	}
+	csiPods := []runtime.Object{}
+	for _, node := range []string{"node-1", "node-2"} {
+		csiPods = append(csiPods, &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "csi-" + node, Namespace: "fluid-system",
+				Labels: map[string]string{"app": "csi-nodeplugin-fluid"}},
+			Spec: corev1.PodSpec{NodeName: node, Containers: []corev1.Container{{Name: "plugins"}}},
+		})
+	}
+	event := &corev1.Event{
+		ObjectMeta:     metav1.ObjectMeta{Name: "hbase-worker-0.1", Namespace: "default"},
+		InvolvedObject: corev1.ObjectReference{Kind: "Pod", Name: "hbase-worker-0", Namespace: "default"},
+		Reason:         "Scheduled",
+	}
+	objs := append([]runtime.Object{newDataset(datav1alpha1.NotBoundDatasetPhase, ""), cacheRuntime, values, workerPod, controllerPod, event}, csiPods...)
+	c, s := newTestClient(objs...)
+	target, err := GetTarget(context.TODO(), c, "hbase", "default")
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	diagnoser := &Diagnoser{
+		Reader: c,
+		Scheme: s,
+		GetLogs: func(ctx context.Context, namespace, pod, container string) ([]byte, error) {
+			if pod == "cacheruntime-controller-0" {
+				return nil, errors.New("container is restarting")
+			}
+			return []byte("logs of " + pod), nil
+		},
+		FluidNamespace: "fluid-system",
+	}
+	out := &bytes.Buffer{}
+	if err = diagnoser.Collect(context.TODO(), target, out); err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+
+	files := map[string]string{}
+	gzipReader, err := gzip.NewReader(out)
+	if err != nil {
+		t.Fatalf("expect a gzipped tarball, got %v", err)
+	}
+	tarReader := tar.NewReader(gzipReader)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("expect a valid tarball, got %v", err)
+		}
+		data, _ := io.ReadAll(tarReader)
+		files[strings.TrimPrefix(header.Name, "diagnose-default-hbase/")] = string(data)
+	}
+
+	for name, want := range map[string]string{
+		"dataset.yaml":                           "kind: Dataset",
+		"cacheruntime.yaml":                      "kind: CacheRuntime",
+		"values.yaml":                            "config.json",
+		"pods.yaml":                              "hbase-worker-0",
+		"events.yaml":                            "Scheduled",
+		"logs/default/hbase-worker-0/worker.log": "logs of hbase-worker-0",
+		"logs/fluid-system/csi-node-1/plugins.log": "logs of csi-node-1",
+		"errors.txt": "container is restarting",
+	} {
+		if !strings.Contains(files[name], want) {
+			t.Errorf("expect %s to contain %q, got %q", name, want, files[name])
+		}
+	}
+	if _, found := files["logs/fluid-system/csi-node-2/plugins.log"]; found {
+		t.Errorf("expect the logs of the CSI plugins on the other nodes not to be collected")
+	}
+	if !strings.Contains(files["errors.txt"], "pvc.yaml") {
+		t.Errorf("expect the missing PVC to be reported, got %q", files["errors.txt"])
+	}
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fluidctl
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+)
+
+// Component is the status of a component of the runtime, i.e. master, worker or fuse.
+type Component struct {
+	Role    string
+	Phase   datav1alpha1.RuntimePhase
+	Reason  string
+	Ready   int32
+	Desired int32
+}
+
+// RuntimeStatus is the status of the runtime shared by all the types.
+type RuntimeStatus struct {
+	Components []Component
+	Conditions []datav1alpha1.RuntimeCondition
+	// ValuesConfigMap is the ConfigMap keeping the values rendering the runtime.
+	ValuesConfigMap string
+}
+
+// GetRuntimeStatus returns the status of the runtime of any type.
+func GetRuntimeStatus(runtime client.Object) (status RuntimeStatus) {
+	switch runtime := runtime.(type) {
+	case *datav1alpha1.CacheRuntime:
+		components := runtime.Status.RuntimeComponentStatusCollection
+		for _, component := range []struct {
+			role   string
+			status datav1alpha1.RuntimeComponentStatus
+		}{
+			{"master", components.Master},
+			{"worker", components.Worker},
+			{"client", components.Client},
+		} {
+			status.Components = append(status.Components, Component{
+				Role:    component.role,
+				Phase:   component.status.Phase,
+				Reason:  component.status.Reason,
+				Ready:   component.status.ReadyReplicas,
+				Desired: component.status.DesiredReplicas,
+			})
+		}
+		status.Conditions = runtime.Status.Conditions
+		status.ValuesConfigMap = runtime.Status.ValueFile
+	case interface {
+		GetStatus() *datav1alpha1.RuntimeStatus
+	}:
+		runtimeStatus := runtime.GetStatus()
+		status.Components = []Component{
+			{Role: "master", Phase: runtimeStatus.MasterPhase, Reason: runtimeStatus.MasterReason,
+				Ready: runtimeStatus.MasterNumberReady, Desired: runtimeStatus.DesiredMasterNumberScheduled},
+			{Role: "worker", Phase: runtimeStatus.WorkerPhase, Reason: runtimeStatus.WorkerReason,
+				Ready: runtimeStatus.WorkerNumberReady, Desired: runtimeStatus.DesiredWorkerNumberScheduled},
+			{Role: "fuse", Phase: runtimeStatus.FusePhase, Reason: runtimeStatus.FuseReason,
+				Ready: runtimeStatus.FuseNumberReady, Desired: runtimeStatus.DesiredFuseNumberScheduled},
+		}
+		status.Conditions = runtimeStatus.Conditions
+		status.ValuesConfigMap = runtimeStatus.ValueFileConfigmap
+	}
+	return
+}
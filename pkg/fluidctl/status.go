@@ -0,0 +1,134 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fluidctl
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+)
+
+// Node is a node of the status tree.
+type Node struct {
+	Text     string
+	Children []*Node
+}
+
+func (n *Node) add(format string, args ...interface{}) *Node {
+	child := &Node{Text: fmt.Sprintf(format, args...)}
+	n.Children = append(n.Children, child)
+	return child
+}
+
+// Print prints the tree, e.g.
+//
+//	Dataset default/hbase: Bound
+//	├── Condition Ready: True
+//	└── AlluxioRuntime default/hbase
+func (n *Node) Print(w io.Writer) {
+	fmt.Fprintln(w, n.Text)
+	n.printChildren(w, "")
+}
+
+func (n *Node) printChildren(w io.Writer, prefix string) {
+	for i, child := range n.Children {
+		branch, indent := "├── ", "│   "
+		if i == len(n.Children)-1 {
+			branch, indent = "└── ", "    "
+		}
+		fmt.Fprintln(w, prefix+branch+child.Text)
+		child.printChildren(w, prefix+indent)
+	}
+}
+
+// GetStatusTree returns the tree of the Dataset, the runtime with its components, and the PVC and PV of the Dataset
+// with their phases and conditions.
+func GetStatusTree(ctx context.Context, reader client.Reader, target *Target) (*Node, error) {
+	root := &Node{Text: fmt.Sprintf("Dataset %s/%s: not found", target.Namespace, target.Name)}
+	if dataset := target.Dataset; dataset != nil {
+		root.Text = fmt.Sprintf("Dataset %s/%s: %s", dataset.Namespace, dataset.Name, phaseOrUnknown(string(dataset.Status.Phase)))
+		for _, condition := range dataset.Status.Conditions {
+			root.add("Condition %s: %s%s", condition.Type, condition.Status, reasonAndMessage(condition.Reason, condition.Message))
+		}
+	}
+
+	if target.Runtime == nil {
+		root.add("Runtime %s/%s: not found", target.Namespace, target.Name)
+	} else {
+		runtimeNode := root.add("%s %s/%s", target.RuntimeKind(), target.Namespace, target.Name)
+		status := GetRuntimeStatus(target.Runtime)
+		for _, component := range status.Components {
+			runtimeNode.add("%s: %s, %d/%d ready%s", component.Role, phaseOrUnknown(string(component.Phase)),
+				component.Ready, component.Desired, reasonAndMessage(component.Reason, ""))
+		}
+		for _, condition := range status.Conditions {
+			runtimeNode.add("Condition %s: %s%s", condition.Type, condition.Status, reasonAndMessage(condition.Reason, condition.Message))
+		}
+	}
+
+	pvc := &corev1.PersistentVolumeClaim{}
+	err := reader.Get(ctx, types.NamespacedName{Name: target.Name, Namespace: target.Namespace}, pvc)
+	if utils.IgnoreNotFound(err) != nil {
+		return nil, err
+	}
+	if err != nil {
+		root.add("PersistentVolumeClaim %s/%s: not found", target.Namespace, target.Name)
+		return root, nil
+	}
+	pvcNode := root.add("PersistentVolumeClaim %s/%s: %s", pvc.Namespace, pvc.Name, pvc.Status.Phase)
+	if pvc.Spec.VolumeName != "" {
+		pv := &corev1.PersistentVolume{}
+		err = reader.Get(ctx, types.NamespacedName{Name: pvc.Spec.VolumeName}, pv)
+		if utils.IgnoreNotFound(err) != nil {
+			return nil, err
+		}
+		if err != nil {
+			pvcNode.add("PersistentVolume %s: not found", pvc.Spec.VolumeName)
+		} else {
+			pvcNode.add("PersistentVolume %s: %s", pv.Name, pv.Status.Phase)
+		}
+	}
+
+	return root, nil
+}
+
+func phaseOrUnknown(phase string) string {
+	if phase == "" {
+		return "Unknown"
+	}
+	return phase
+}
+
+func reasonAndMessage(reason, message string) string {
+	var parts []string
+	for _, part := range []string{reason, message} {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+	if len(parts) == 0 {
+		return ""
+	}
+	return " (" + strings.Join(parts, ": ") + ")"
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package fluidctl implements the subcommands of fluidctl, which inspect a Dataset and the runtime bound to it
+// with the API server only, whatever the type of the runtime is.
+package fluidctl
+
+import (
+	"context"
+	"fmt"
+
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+)
+
+// runtimeTypes are the types of the runtimes to look up a runtime not bound to its Dataset yet.
+var runtimeTypes = []string{
+	common.AlluxioRuntime,
+	common.JindoRuntime,
+	common.JuiceFSRuntime,
+	common.ThinRuntime,
+	common.EFCRuntime,
+	common.VineyardRuntime,
+	common.CacheRuntime,
+}
+
+// Target is a Dataset and the runtime with the same name.
+type Target struct {
+	Name      string
+	Namespace string
+
+	// Dataset is nil if the Dataset is not found.
+	Dataset *datav1alpha1.Dataset
+
+	// RuntimeType is the type of the runtime, e.g. alluxio.
+	RuntimeType string
+	// Runtime is nil if no runtime is found.
+	Runtime client.Object
+}
+
+// GetTarget gets the Dataset and the runtime bound to it. The runtime of any type with the same name is looked up
+// if the Dataset is not bound yet.
+func GetTarget(ctx context.Context, reader client.Reader, name, namespace string) (target *Target, err error) {
+	target = &Target{Name: name, Namespace: namespace}
+
+	dataset := &datav1alpha1.Dataset{}
+	err = reader.Get(ctx, types.NamespacedName{Name: name, Namespace: namespace}, dataset)
+	if err != nil && !apierrs.IsNotFound(err) {
+		return nil, err
+	}
+	if err == nil {
+		target.Dataset = dataset
+		for _, runtime := range dataset.Status.Runtimes {
+			if runtime.Name == name && runtime.Namespace == namespace {
+				target.RuntimeType = runtime.Type
+			}
+		}
+	}
+
+	candidates := runtimeTypes
+	if target.RuntimeType != "" {
+		candidates = []string{target.RuntimeType}
+	}
+	for _, runtimeType := range candidates {
+		runtime, err := GetRuntime(reader, runtimeType, name, namespace)
+		if err == nil {
+			target.RuntimeType, target.Runtime = runtimeType, runtime
+			break
+		}
+		// the CRDs of some runtimes may not be installed
+		if utils.IgnoreNoKindMatchError(utils.IgnoreNotFound(err)) != nil {
+			return nil, err
+		}
+	}
+
+	if target.Dataset == nil && target.Runtime == nil {
+		return nil, fmt.Errorf("neither dataset nor runtime %s/%s is found", namespace, name)
+	}
+	return target, nil
+}
+
+// RuntimeKind returns the kind of the runtime, e.g. AlluxioRuntime.
+func (t *Target) RuntimeKind() string {
+	return RuntimeKind(t.RuntimeType)
+}
+
+// GetRuntime gets the runtime of the type.
+func GetRuntime(reader client.Reader, runtimeType, name, namespace string) (client.Object, error) {
+	switch runtimeType {
+	case common.AlluxioRuntime:
+		return utils.GetAlluxioRuntime(reader, name, namespace)
+	case common.JindoRuntime:
+		return utils.GetJindoRuntime(reader, name, namespace)
+	case common.JuiceFSRuntime:
+		return utils.GetJuiceFSRuntime(reader, name, namespace)
+	case common.ThinRuntime:
+		return utils.GetThinRuntime(reader, name, namespace)
+	case common.EFCRuntime:
+		return utils.GetEFCRuntime(reader, name, namespace)
+	case common.VineyardRuntime:
+		return utils.GetVineyardRuntime(reader, name, namespace)
+	case common.CacheRuntime:
+		return utils.GetCacheRuntime(reader, name, namespace)
+	}
+	return nil, fmt.Errorf("runtime type %q is not supported", runtimeType)
+}
+
+// RuntimeKind returns the kind of the runtime type, e.g. AlluxioRuntime for alluxio.
+func RuntimeKind(runtimeType string) string {
+	switch runtimeType {
+	case common.AlluxioRuntime:
+		return datav1alpha1.AlluxioRuntimeKind
+	case common.JindoRuntime:
+		return datav1alpha1.JindoRuntimeKind
+	case common.JuiceFSRuntime:
+		return datav1alpha1.JuiceFSRuntimeKind
+	case common.ThinRuntime:
+		return datav1alpha1.ThinRuntimeKind
+	case common.EFCRuntime:
+		return datav1alpha1.EFCRuntimeKind
+	case common.VineyardRuntime:
+		return datav1alpha1.VineyardRuntimeKind
+	case common.CacheRuntime:
+		return datav1alpha1.CacheRuntimeKind
+	}
+	return runtimeType
+}
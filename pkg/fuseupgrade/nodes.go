@@ -0,0 +1,197 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fuseupgrade
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	podutil "k8s.io/kubernetes/pkg/api/v1/pod"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/base"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/kubeclient"
+)
+
+// Node is the state of the fuse upgrade on a node
+type Node struct {
+	Name string
+
+	// FusePod is the fuse pod on the node, nil if the node is drained but runs no fuse pod any more
+	FusePod *corev1.Pod
+
+	// Outdated means the fuse pod is created from an old template of the fuse DaemonSet
+	Outdated bool
+
+	// Draining means the node is labeled with the draining label of the runtime
+	Draining bool
+
+	// MountingPods is the number of the pods on the node mounting the Dataset
+	MountingPods int32
+}
+
+// Upgraded returns true if the node runs an up-to-date and ready fuse pod
+func (n Node) Upgraded() bool {
+	return n.FusePod != nil && !n.Outdated && !utils.HasDeletionTimestamp(n.FusePod.ObjectMeta) && podutil.IsPodReady(n.FusePod)
+}
+
+// Upgrading returns true if the outdated fuse pod on the node is deleted and the up-to-date one is not ready yet
+func (n Node) Upgrading() bool {
+	return n.FusePod != nil && !n.Upgraded() && (!n.Outdated || utils.HasDeletionTimestamp(n.FusePod.ObjectMeta))
+}
+
+// GetUpdateRevisionHash gets the hash of the latest ControllerRevision of the fuse DaemonSet, which is labeled
+// on the fuse pods created from the latest template. It returns an empty hash if no revision is found.
+func GetUpdateRevisionHash(c client.Client, fuseDs *appsv1.DaemonSet) (string, error) {
+	selector, err := metav1.LabelSelectorAsSelector(fuseDs.Spec.Selector)
+	if err != nil {
+		return "", err
+	}
+	revisionList := &appsv1.ControllerRevisionList{}
+	err = c.List(context.TODO(), revisionList, client.InNamespace(fuseDs.Namespace), client.MatchingLabelsSelector{Selector: selector})
+	if err != nil {
+		return "", err
+	}
+
+	var latest *appsv1.ControllerRevision
+	for i := range revisionList.Items {
+		revision := &revisionList.Items[i]
+		if !metav1.IsControlledBy(revision, fuseDs) {
+			continue
+		}
+		if latest == nil || revision.Revision > latest.Revision {
+			latest = revision
+		}
+	}
+	if latest == nil {
+		return "", nil
+	}
+	return latest.Labels[appsv1.DefaultDaemonSetUniqueLabelKey], nil
+}
+
+// IsFusePodOutdated checks if the fuse pod is created from an old template of the fuse DaemonSet, i.e. its revision hash
+// differs from the one of the latest revision. The fuse DaemonSets are updated with the OnDelete strategy, so the pods
+// are not replaced until they are deleted.
+func IsFusePodOutdated(updateRevisionHash string, pod *corev1.Pod) bool {
+	if updateRevisionHash == "" {
+		return false
+	}
+	return pod.Labels[appsv1.DefaultDaemonSetUniqueLabelKey] != updateRevisionHash
+}
+
+// CollectNodes collects the state of the fuse upgrade on the nodes running the fuse pods of the runtime
+// and the nodes drained for it, sorted by the node names.
+func CollectNodes(c client.Client, runtimeInfo base.RuntimeInfoInterface, dataset *datav1alpha1.Dataset) ([]Node, error) {
+	fuseDs, err := kubeclient.GetDaemonset(c, runtimeInfo.GetFuseName(), runtimeInfo.GetNamespace())
+	if err != nil {
+		return nil, err
+	}
+	selector, err := metav1.LabelSelectorAsSelector(fuseDs.Spec.Selector)
+	if err != nil {
+		return nil, err
+	}
+	podList := &corev1.PodList{}
+	err = c.List(context.TODO(), podList, client.InNamespace(fuseDs.Namespace), client.MatchingLabelsSelector{Selector: selector})
+	if err != nil {
+		return nil, err
+	}
+	updateRevisionHash, err := GetUpdateRevisionHash(c, fuseDs)
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := map[string]*Node{}
+	for i := range podList.Items {
+		pod := &podList.Items[i]
+		if pod.Spec.NodeName == "" || !metav1.IsControlledBy(pod, fuseDs) {
+			continue
+		}
+		// the up-to-date pod wins if the outdated one is still terminating on the node
+		if node, found := nodes[pod.Spec.NodeName]; found && !node.Outdated {
+			continue
+		}
+		nodes[pod.Spec.NodeName] = &Node{
+			Name:     pod.Spec.NodeName,
+			FusePod:  pod,
+			Outdated: IsFusePodOutdated(updateRevisionHash, pod),
+		}
+	}
+
+	drainingLabel := utils.GetFuseUpgradeDrainingLabelName(runtimeInfo.GetNamespace(), runtimeInfo.GetName(), runtimeInfo.GetOwnerDatasetUID())
+	nodeList := &corev1.NodeList{}
+	err = c.List(context.TODO(), nodeList, client.HasLabels{drainingLabel})
+	if err != nil {
+		return nil, err
+	}
+	for _, n := range nodeList.Items {
+		node, found := nodes[n.Name]
+		if !found {
+			node = &Node{Name: n.Name}
+			nodes[n.Name] = node
+		}
+		node.Draining = true
+	}
+
+	mountingPods, err := countMountingPodsByNode(c, dataset)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]Node, 0, len(nodes))
+	for name, node := range nodes {
+		node.MountingPods = mountingPods[name]
+		result = append(result, *node)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+	return result, nil
+}
+
+// countMountingPodsByNode counts the pods mounting the PVC of the dataset and the PVCs of the datasets referring to it
+// on each node.
+func countMountingPodsByNode(c client.Client, dataset *datav1alpha1.Dataset) (map[string]int32, error) {
+	pvcs := []types.NamespacedName{{Namespace: dataset.Namespace, Name: dataset.Name}}
+	for _, ref := range dataset.Status.DatasetRef {
+		namespace, name, found := strings.Cut(ref, "/")
+		if !found {
+			continue
+		}
+		pvcs = append(pvcs, types.NamespacedName{Namespace: namespace, Name: name})
+	}
+
+	counts := map[string]int32{}
+	for _, pvc := range pvcs {
+		pods, err := kubeclient.GetPvcMountPods(c, pvc.Name, pvc.Namespace)
+		if err != nil {
+			return nil, err
+		}
+		for i := range pods {
+			if pods[i].Spec.NodeName != "" && !kubeclient.IsCompletePod(&pods[i]) {
+				counts[pods[i].Spec.NodeName]++
+			}
+		}
+	}
+	return counts, nil
+}
@@ -0,0 +1,146 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fuseupgrade
+
+import (
+	"fmt"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/base"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+)
+
+func TestCollectNodes(t *testing.T) {
+	s := runtime.NewScheme()
+	_ = datav1alpha1.AddToScheme(s)
+	_ = corev1.AddToScheme(s)
+	_ = appsv1.AddToScheme(s)
+
+	fuseDs := &appsv1.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "demo-fuse",
+			Namespace: "fluid",
+			UID:       "ds-uid",
+		},
+		Spec: appsv1.DaemonSetSpec{
+			Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "demo-fuse"}},
+		},
+	}
+	newRevision := func(generation int64) *appsv1.ControllerRevision {
+		return &appsv1.ControllerRevision{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      fmt.Sprintf("demo-fuse-rev-%d", generation),
+				Namespace: "fluid",
+				Labels:    map[string]string{"app": "demo-fuse", appsv1.DefaultDaemonSetUniqueLabelKey: fmt.Sprintf("rev-%d", generation)},
+				OwnerReferences: []metav1.OwnerReference{{
+					APIVersion: "apps/v1",
+					Kind:       "DaemonSet",
+					Name:       fuseDs.Name,
+					UID:        fuseDs.UID,
+					Controller: ptr.To(true),
+				}},
+			},
+			Revision: generation,
+		}
+	}
+	newFusePod := func(name, nodeName, generation string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "fluid",
+				Labels:    map[string]string{"app": "demo-fuse", appsv1.DefaultDaemonSetUniqueLabelKey: "rev-" + generation},
+				OwnerReferences: []metav1.OwnerReference{{
+					APIVersion: "apps/v1",
+					Kind:       "DaemonSet",
+					Name:       fuseDs.Name,
+					UID:        fuseDs.UID,
+					Controller: ptr.To(true),
+				}},
+			},
+			Spec: corev1.PodSpec{NodeName: nodeName},
+		}
+	}
+	mountingPod := func(name, nodeName string) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "fluid"},
+			Spec: corev1.PodSpec{
+				NodeName: nodeName,
+				Volumes: []corev1.Volume{{
+					Name: "data",
+					VolumeSource: corev1.VolumeSource{
+						PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "demo"},
+					},
+				}},
+			},
+			Status: corev1.PodStatus{Phase: corev1.PodRunning},
+		}
+	}
+	drainedNode := &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "node-3", Labels: map[string]string{common.LabelFuseUpgradeDrainingPrefix + "fluid-demo": "true"}},
+	}
+	dataset := &datav1alpha1.Dataset{ObjectMeta: metav1.ObjectMeta{Name: "demo", Namespace: "fluid"}}
+
+	c := fake.NewFakeClientWithScheme(s, fuseDs, dataset, drainedNode, newRevision(2), newRevision(1),
+		newFusePod("demo-fuse-a", "node-1", "1"),
+		newFusePod("demo-fuse-b", "node-2", "2"),
+		mountingPod("app-1", "node-1"),
+		mountingPod("app-2", "node-1"))
+
+	runtimeInfo, err := base.BuildRuntimeInfo("demo", "fluid", common.AlluxioRuntime)
+	if err != nil {
+		t.Fatalf("failed to build runtime info: %v", err)
+	}
+	runtimeInfo.SetFuseName("demo-fuse")
+
+	nodes, err := CollectNodes(c, runtimeInfo, dataset)
+	if err != nil {
+		t.Fatalf("CollectNodes() got error %v", err)
+	}
+	if len(nodes) != 3 {
+		t.Fatalf("CollectNodes() got %d nodes, want 3", len(nodes))
+	}
+	if nodes[0].Name != "node-1" || !nodes[0].Outdated || nodes[0].MountingPods != 2 || nodes[0].Draining {
+		t.Errorf("unexpected node-1: %+v", nodes[0])
+	}
+	if nodes[1].Name != "node-2" || nodes[1].Outdated || nodes[1].MountingPods != 0 {
+		t.Errorf("unexpected node-2: %+v", nodes[1])
+	}
+	if nodes[2].Name != "node-3" || nodes[2].FusePod != nil || !nodes[2].Draining {
+		t.Errorf("unexpected node-3: %+v", nodes[2])
+	}
+}
+
+func TestIsFusePodOutdated(t *testing.T) {
+	pod := &corev1.Pod{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{appsv1.DefaultDaemonSetUniqueLabelKey: "rev-1"}}}
+	if IsFusePodOutdated("", pod) {
+		t.Errorf("expect the pod not to be outdated without the update revision")
+	}
+	if IsFusePodOutdated("rev-1", pod) {
+		t.Errorf("expect the pod of the update revision not to be outdated")
+	}
+	if !IsFusePodOutdated("rev-2", pod) {
+		t.Errorf("expect the pod of an old revision to be outdated")
+	}
+}
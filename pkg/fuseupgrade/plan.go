@@ -0,0 +1,144 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fuseupgrade
+
+import (
+	"sort"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+)
+
+// Plan is the actions taken in a sync of the fuse upgrade
+type Plan struct {
+	// Upgrade are the nodes whose outdated fuse pods are deleted to be recreated from the latest template
+	Upgrade []Node
+
+	// Drain are the nodes to be labeled as draining
+	Drain []string
+
+	// Undrain are the nodes to remove the draining label from
+	Undrain []string
+
+	// Phases are the phases of the nodes not upgraded yet after the actions are taken
+	Phases map[string]datav1alpha1.FuseNodeUpgradePhase
+}
+
+// MakePlan decides the nodes to drain and upgrade. The nodes being drained or upgraded are unavailable, and at most
+// maxUnavailable nodes are unavailable at the same time. A node is upgraded as soon as no pod on it mounts the Dataset,
+// the nodes with fewer mounting pods are drained first if drainNodes is true. No more nodes are drained or upgraded
+// if paused is true, and the draining labels are removed so that the workloads can go back.
+func MakePlan(nodes []Node, maxUnavailable int, drainNodes, paused bool) (plan Plan) {
+	plan.Phases = map[string]datav1alpha1.FuseNodeUpgradePhase{}
+
+	unavailable := 0
+	var pending []Node
+	for _, node := range nodes {
+		switch {
+		case node.FusePod == nil:
+			// the fuse pod is gone with the node selector or the node itself, nothing to wait for
+			plan.Undrain = append(plan.Undrain, node.Name)
+		case node.Upgraded():
+			if node.Draining {
+				plan.Undrain = append(plan.Undrain, node.Name)
+			}
+		case node.Upgrading():
+			plan.Phases[node.Name] = datav1alpha1.FuseNodeUpgradeUpgrading
+			unavailable++
+		case node.Draining && drainNodes && !paused:
+			if node.MountingPods == 0 {
+				plan.Upgrade = append(plan.Upgrade, node)
+				plan.Phases[node.Name] = datav1alpha1.FuseNodeUpgradeUpgrading
+			} else {
+				plan.Phases[node.Name] = datav1alpha1.FuseNodeUpgradeDraining
+			}
+			unavailable++
+		default:
+			if node.Draining {
+				plan.Undrain = append(plan.Undrain, node.Name)
+			}
+			plan.Phases[node.Name] = datav1alpha1.FuseNodeUpgradePending
+			pending = append(pending, node)
+		}
+	}
+	if paused {
+		return
+	}
+
+	sort.SliceStable(pending, func(i, j int) bool {
+		return pending[i].MountingPods < pending[j].MountingPods
+	})
+	budget := maxUnavailable - unavailable
+	for _, node := range pending {
+		if budget <= 0 {
+			break
+		}
+		if node.MountingPods == 0 {
+			plan.Upgrade = append(plan.Upgrade, node)
+			plan.Phases[node.Name] = datav1alpha1.FuseNodeUpgradeUpgrading
+		} else if drainNodes {
+			plan.Drain = append(plan.Drain, node.Name)
+			plan.Phases[node.Name] = datav1alpha1.FuseNodeUpgradeDraining
+		} else {
+			// wait for the pods on the node to go away by themselves
+			continue
+		}
+		budget--
+	}
+	return
+}
+
+// BuildStatus builds the progress of the fuse upgrade in the runtime's status after the plan is carried out.
+// The transition time of a node is kept if its phase doesn't change.
+func BuildStatus(previous *datav1alpha1.RuntimeFuseUpgradeStatus,
+	nodes []Node,
+	plan Plan,
+	paused bool,
+	now metav1.Time) *datav1alpha1.RuntimeFuseUpgradeStatus {
+	previousNodes := map[string]datav1alpha1.FuseNodeUpgradeStatus{}
+	if previous != nil {
+		for _, node := range previous.Nodes {
+			previousNodes[node.NodeName] = node
+		}
+	}
+
+	status := &datav1alpha1.RuntimeFuseUpgradeStatus{Paused: paused}
+	for _, node := range nodes {
+		if node.FusePod == nil {
+			continue
+		}
+		status.TotalNodes++
+		phase, found := plan.Phases[node.Name]
+		if !found {
+			status.UpgradedNodes++
+			continue
+		}
+
+		transitionTime := now
+		if previousNode, found := previousNodes[node.Name]; found && previousNode.Phase == phase {
+			transitionTime = previousNode.LastTransitionTime
+		}
+		status.Nodes = append(status.Nodes, datav1alpha1.FuseNodeUpgradeStatus{
+			NodeName:           node.Name,
+			Phase:              phase,
+			MountingPods:       node.MountingPods,
+			LastTransitionTime: transitionTime,
+		})
+	}
+	return status
+}
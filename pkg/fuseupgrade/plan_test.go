@@ -0,0 +1,201 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fuseupgrade
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+)
+
+func fusePod(ready bool) *corev1.Pod {
+	status := corev1.ConditionFalse
+	if ready {
+		status = corev1.ConditionTrue
+	}
+	return &corev1.Pod{
+		Status: corev1.PodStatus{
+			Conditions: []corev1.PodCondition{{Type: corev1.PodReady, Status: status}},
+		},
+	}
+}
+
+func nodeNames(nodes []Node) []string {
+	var names []string
+	for _, node := range nodes {
+		names = append(names, node.Name)
+	}
+	return names
+}
+
+func TestMakePlan(t *testing.T) {
+	testCases := map[string]struct {
+		nodes          []Node
+		maxUnavailable int
+		drainNodes     bool
+		paused         bool
+		wantUpgrade    []string
+		wantDrain      []string
+		wantUndrain    []string
+		wantPhases     map[string]datav1alpha1.FuseNodeUpgradePhase
+	}{
+		"upgrade idle nodes within budget": {
+			nodes: []Node{
+				{Name: "node-1", FusePod: fusePod(true), Outdated: true, MountingPods: 0},
+				{Name: "node-2", FusePod: fusePod(true), Outdated: true, MountingPods: 0},
+				{Name: "node-3", FusePod: fusePod(true)},
+			},
+			maxUnavailable: 1,
+			wantUpgrade:    []string{"node-1"},
+			wantPhases: map[string]datav1alpha1.FuseNodeUpgradePhase{
+				"node-1": datav1alpha1.FuseNodeUpgradeUpgrading,
+				"node-2": datav1alpha1.FuseNodeUpgradePending,
+			},
+		},
+		"wait for busy nodes without draining": {
+			nodes: []Node{
+				{Name: "node-1", FusePod: fusePod(true), Outdated: true, MountingPods: 2},
+				{Name: "node-2", FusePod: fusePod(true), Outdated: true, MountingPods: 0},
+			},
+			maxUnavailable: 2,
+			wantUpgrade:    []string{"node-2"},
+			wantPhases: map[string]datav1alpha1.FuseNodeUpgradePhase{
+				"node-1": datav1alpha1.FuseNodeUpgradePending,
+				"node-2": datav1alpha1.FuseNodeUpgradeUpgrading,
+			},
+		},
+		"drain the node with fewest mounting pods": {
+			nodes: []Node{
+				{Name: "node-1", FusePod: fusePod(true), Outdated: true, MountingPods: 5},
+				{Name: "node-2", FusePod: fusePod(true), Outdated: true, MountingPods: 1},
+			},
+			maxUnavailable: 1,
+			drainNodes:     true,
+			wantDrain:      []string{"node-2"},
+			wantPhases: map[string]datav1alpha1.FuseNodeUpgradePhase{
+				"node-1": datav1alpha1.FuseNodeUpgradePending,
+				"node-2": datav1alpha1.FuseNodeUpgradeDraining,
+			},
+		},
+		"upgrade the drained node once its pods go away": {
+			nodes: []Node{
+				{Name: "node-1", FusePod: fusePod(true), Outdated: true, Draining: true, MountingPods: 0},
+				{Name: "node-2", FusePod: fusePod(true), Outdated: true, MountingPods: 1},
+			},
+			maxUnavailable: 1,
+			drainNodes:     true,
+			wantUpgrade:    []string{"node-1"},
+			wantPhases: map[string]datav1alpha1.FuseNodeUpgradePhase{
+				"node-1": datav1alpha1.FuseNodeUpgradeUpgrading,
+				"node-2": datav1alpha1.FuseNodeUpgradePending,
+			},
+		},
+		"upgrading nodes take the budget": {
+			nodes: []Node{
+				{Name: "node-1", FusePod: fusePod(false), Draining: true},
+				{Name: "node-2", FusePod: fusePod(true), Outdated: true},
+			},
+			maxUnavailable: 1,
+			drainNodes:     true,
+			wantPhases: map[string]datav1alpha1.FuseNodeUpgradePhase{
+				"node-1": datav1alpha1.FuseNodeUpgradeUpgrading,
+				"node-2": datav1alpha1.FuseNodeUpgradePending,
+			},
+		},
+		"undrain upgraded and gone nodes": {
+			nodes: []Node{
+				{Name: "node-1", FusePod: fusePod(true), Draining: true},
+				{Name: "node-2", Draining: true},
+			},
+			maxUnavailable: 1,
+			drainNodes:     true,
+			wantUndrain:    []string{"node-1", "node-2"},
+			wantPhases:     map[string]datav1alpha1.FuseNodeUpgradePhase{},
+		},
+		"paused": {
+			nodes: []Node{
+				{Name: "node-1", FusePod: fusePod(true), Outdated: true, Draining: true, MountingPods: 1},
+				{Name: "node-2", FusePod: fusePod(true), Outdated: true},
+			},
+			maxUnavailable: 2,
+			drainNodes:     true,
+			paused:         true,
+			wantUndrain:    []string{"node-1"},
+			wantPhases: map[string]datav1alpha1.FuseNodeUpgradePhase{
+				"node-1": datav1alpha1.FuseNodeUpgradePending,
+				"node-2": datav1alpha1.FuseNodeUpgradePending,
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			plan := MakePlan(tc.nodes, tc.maxUnavailable, tc.drainNodes, tc.paused)
+			if got := nodeNames(plan.Upgrade); !reflect.DeepEqual(got, tc.wantUpgrade) {
+				t.Errorf("upgrade = %v, want %v", got, tc.wantUpgrade)
+			}
+			if !reflect.DeepEqual(plan.Drain, tc.wantDrain) {
+				t.Errorf("drain = %v, want %v", plan.Drain, tc.wantDrain)
+			}
+			if !reflect.DeepEqual(plan.Undrain, tc.wantUndrain) {
+				t.Errorf("undrain = %v, want %v", plan.Undrain, tc.wantUndrain)
+			}
+			if !reflect.DeepEqual(plan.Phases, tc.wantPhases) {
+				t.Errorf("phases = %v, want %v", plan.Phases, tc.wantPhases)
+			}
+		})
+	}
+}
+
+func TestBuildStatus(t *testing.T) {
+	before := metav1.NewTime(time.Now().Add(-time.Hour).Truncate(time.Second))
+	now := metav1.NewTime(time.Now().Truncate(time.Second))
+	previous := &datav1alpha1.RuntimeFuseUpgradeStatus{
+		Nodes: []datav1alpha1.FuseNodeUpgradeStatus{
+			{NodeName: "node-1", Phase: datav1alpha1.FuseNodeUpgradeDraining, LastTransitionTime: before},
+			{NodeName: "node-2", Phase: datav1alpha1.FuseNodeUpgradePending, LastTransitionTime: before},
+		},
+	}
+	nodes := []Node{
+		{Name: "node-1", FusePod: fusePod(true), Outdated: true, Draining: true, MountingPods: 3},
+		{Name: "node-2", FusePod: fusePod(true), Outdated: true},
+		{Name: "node-3", FusePod: fusePod(true)},
+		{Name: "node-4", Draining: true},
+	}
+	plan := Plan{Phases: map[string]datav1alpha1.FuseNodeUpgradePhase{
+		"node-1": datav1alpha1.FuseNodeUpgradeDraining,
+		"node-2": datav1alpha1.FuseNodeUpgradeUpgrading,
+	}}
+
+	status := BuildStatus(previous, nodes, plan, false, now)
+	want := &datav1alpha1.RuntimeFuseUpgradeStatus{
+		UpgradedNodes: 1,
+		TotalNodes:    3,
+		Nodes: []datav1alpha1.FuseNodeUpgradeStatus{
+			{NodeName: "node-1", Phase: datav1alpha1.FuseNodeUpgradeDraining, MountingPods: 3, LastTransitionTime: before},
+			{NodeName: "node-2", Phase: datav1alpha1.FuseNodeUpgradeUpgrading, LastTransitionTime: now},
+		},
+	}
+	if !reflect.DeepEqual(status, want) {
+		t.Errorf("status = %+v, want %+v", status, want)
+	}
+}
@@ -0,0 +1,63 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fuseupgrade
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+)
+
+// GetTargetRuntime gets the runtime referred by the FuseUpgrade in the same namespace.
+func GetTargetRuntime(reader client.Reader, upgrade *datav1alpha1.FuseUpgrade) (runtime client.Object, err error) {
+	name, namespace := upgrade.Spec.RuntimeRef.Name, upgrade.Namespace
+	switch upgrade.Spec.RuntimeRef.Kind {
+	case datav1alpha1.AlluxioRuntimeKind:
+		return utils.GetAlluxioRuntime(reader, name, namespace)
+	case datav1alpha1.JindoRuntimeKind:
+		return utils.GetJindoRuntime(reader, name, namespace)
+	case datav1alpha1.JuiceFSRuntimeKind:
+		return utils.GetJuiceFSRuntime(reader, name, namespace)
+	case datav1alpha1.VineyardRuntimeKind:
+		return utils.GetVineyardRuntime(reader, name, namespace)
+	case datav1alpha1.EFCRuntimeKind:
+		return utils.GetEFCRuntime(reader, name, namespace)
+	case datav1alpha1.ThinRuntimeKind:
+		return utils.GetThinRuntime(reader, name, namespace)
+	}
+	return nil, fmt.Errorf("runtime kind %q is not supported by FuseUpgrade", upgrade.Spec.RuntimeRef.Kind)
+}
+
+// UpdateRuntimeStatus sets the progress of the fuse upgrade in the runtime's status, or removes it if status is nil.
+// The status is merge-patched so that it doesn't conflict with the runtime controller updating other fields.
+func UpdateRuntimeStatus(c client.Client, runtime client.Object, status *datav1alpha1.RuntimeFuseUpgradeStatus) error {
+	data, err := json.Marshal(map[string]interface{}{
+		"status": map[string]interface{}{
+			"fuseUpgrade": status,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	return c.Status().Patch(context.TODO(), runtime, client.RawPatch(types.MergePatchType, data))
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+var (
+	dataLoadFilesLoaded = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "dataload_files_loaded",
+		Help: "Num of files loaded by a specific dataload",
+	}, []string{"dataload", "dataset"})
+
+	dataLoadBytesLoaded = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "dataload_bytes_loaded",
+		Help: "Size of data loaded by a specific dataload",
+	}, []string{"dataload", "dataset"})
+
+	dataLoadProgressRatio = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "dataload_progress_ratio",
+		Help: "Completed ratio between 0 and 1 of a specific dataload",
+	}, []string{"dataload", "dataset"})
+
+	dataLoadLastProgressTime = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "dataload_last_progress_timestamp_seconds",
+		Help: "Unix time when a specific dataload last reported its progress",
+	}, []string{"dataload", "dataset"})
+
+	dataLoadEstimatedFinishTime = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "dataload_estimated_finish_timestamp_seconds",
+		Help: "Estimated unix time when a specific dataload finishes",
+	}, []string{"dataload", "dataset"})
+)
+
+var dataLoadMetricsMap sync.Map // race condition protection for dataLoadMetricsMap's concurrent writes
+
+type dataLoadMetrics struct {
+	dataLoadKey string
+	labels      prometheus.Labels
+}
+
+func GetOrCreateDataLoadMetrics(namespace, name, datasetName string) *dataLoadMetrics {
+	key := labelKeyFunc(namespace, name)
+	m := &dataLoadMetrics{
+		dataLoadKey: key,
+		labels:      prometheus.Labels{"dataload": key, "dataset": labelKeyFunc(namespace, datasetName)},
+	}
+
+	ret, _ := dataLoadMetricsMap.LoadOrStore(key, m)
+
+	return ret.(*dataLoadMetrics)
+}
+
+func (m *dataLoadMetrics) SetFilesLoaded(num float64) {
+	dataLoadFilesLoaded.With(m.labels).Set(num)
+}
+
+func (m *dataLoadMetrics) SetBytesLoaded(size float64) {
+	dataLoadBytesLoaded.With(m.labels).Set(size)
+}
+
+func (m *dataLoadMetrics) SetProgressRatio(ratio float64) {
+	dataLoadProgressRatio.With(m.labels).Set(ratio)
+}
+
+func (m *dataLoadMetrics) SetLastProgressTime(timestamp float64) {
+	dataLoadLastProgressTime.With(m.labels).Set(timestamp)
+}
+
+func (m *dataLoadMetrics) SetEstimatedFinishTime(timestamp float64) {
+	dataLoadEstimatedFinishTime.With(m.labels).Set(timestamp)
+}
+
+func (m *dataLoadMetrics) Forget() {
+	dataLoadFilesLoaded.Delete(m.labels)
+	dataLoadBytesLoaded.Delete(m.labels)
+	dataLoadProgressRatio.Delete(m.labels)
+	dataLoadLastProgressTime.Delete(m.labels)
+	dataLoadEstimatedFinishTime.Delete(m.labels)
+
+	dataLoadMetricsMap.Delete(m.dataLoadKey)
+}
+
+func init() {
+	metrics.Registry.MustRegister(dataLoadFilesLoaded, dataLoadBytesLoaded, dataLoadProgressRatio,
+		dataLoadLastProgressTime, dataLoadEstimatedFinishTime)
+	dataLoadMetricsMap = sync.Map{}
+}
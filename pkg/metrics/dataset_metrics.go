@@ -33,6 +33,31 @@ var (
 		Name: "dataset_ufs_total_size",
 		Help: "Total size of files in dataset",
 	}, []string{"dataset"})
+
+	datasetCacheCapacity = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "dataset_cache_capacity_bytes",
+		Help: "Total cache capacity of a specific dataset in bytes",
+	}, []string{"dataset"})
+
+	datasetCached = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "dataset_cached_bytes",
+		Help: "Size of data cached for a specific dataset in bytes",
+	}, []string{"dataset"})
+
+	datasetCachedRatio = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "dataset_cached_ratio",
+		Help: "Ratio of data cached over the total data in the underlying filesystem of a specific dataset, from 0 to 1",
+	}, []string{"dataset"})
+
+	datasetCacheHitRatio = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "dataset_cache_hit_ratio",
+		Help: "Cache hit ratio (both local hit and remote hit) of a specific dataset, from 0 to 1",
+	}, []string{"dataset"})
+
+	datasetCacheThroughputRatio = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "dataset_cache_throughput_ratio",
+		Help: "Cache hit throughput ratio (both local hit and remote hit) of a specific dataset, from 0 to 1",
+	}, []string{"dataset"})
 )
 
 var datasetMetricsMap sync.Map // race condition protection for datasetMetricsMap's concurrent writes
@@ -62,14 +87,43 @@ func (m *datasetMetrics) SetUFSFileNum(num float64) {
 	datasetUFSFileNum.With(m.labels).Set(num)
 }
 
+// DatasetCacheStates holds the values of the cache metrics of a dataset. A nil value means
+// the state is not available and its metric should not be exported.
+type DatasetCacheStates struct {
+	CacheCapacity        *float64
+	Cached               *float64
+	CachedRatio          *float64
+	CacheHitRatio        *float64
+	CacheThroughputRatio *float64
+}
+
+// SetCacheStates exports the cache metrics of the dataset and deletes the ones which are not available.
+func (m *datasetMetrics) SetCacheStates(states DatasetCacheStates) {
+	setOrDelete(datasetCacheCapacity, m.labels, states.CacheCapacity)
+	setOrDelete(datasetCached, m.labels, states.Cached)
+	setOrDelete(datasetCachedRatio, m.labels, states.CachedRatio)
+	setOrDelete(datasetCacheHitRatio, m.labels, states.CacheHitRatio)
+	setOrDelete(datasetCacheThroughputRatio, m.labels, states.CacheThroughputRatio)
+}
+
 func (m *datasetMetrics) Forget() {
 	datasetUFSTotalSize.Delete(m.labels)
 	datasetUFSFileNum.Delete(m.labels)
+	m.SetCacheStates(DatasetCacheStates{})
 
 	datasetMetricsMap.Delete(m.datasetKey)
 }
 
+func setOrDelete(gauge *prometheus.GaugeVec, labels prometheus.Labels, value *float64) {
+	if value == nil {
+		gauge.Delete(labels)
+		return
+	}
+	gauge.With(labels).Set(*value)
+}
+
 func init() {
-	metrics.Registry.MustRegister(datasetUFSFileNum, datasetUFSTotalSize)
+	metrics.Registry.MustRegister(datasetUFSFileNum, datasetUFSTotalSize,
+		datasetCacheCapacity, datasetCached, datasetCachedRatio, datasetCacheHitRatio, datasetCacheThroughputRatio)
 	datasetMetricsMap = sync.Map{}
 }
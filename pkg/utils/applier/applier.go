@@ -0,0 +1,361 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package applier
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+)
+
+const (
+	inventoryKeyChart        = "chart"
+	inventoryKeyValuesDigest = "valuesDigest"
+	inventoryKeyObjects      = "objects"
+	inventoryKeyFieldManager = "fieldManager"
+)
+
+// ErrValuesChanged is returned by Reconcile if the values differ from the ones applied last time
+var ErrValuesChanged = fmt.Errorf("values differ from the ones applied last time")
+
+var log logr.Logger
+
+func init() {
+	log = ctrl.Log.WithName("applier")
+}
+
+// ObjectRef refers to an object applied for a release
+type ObjectRef struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Namespace  string `json:"namespace,omitempty"`
+	Name       string `json:"name"`
+}
+
+func (r ObjectRef) String() string {
+	if r.Namespace == "" {
+		return fmt.Sprintf("%s/%s", r.Kind, r.Name)
+	}
+	return fmt.Sprintf("%s/%s/%s", r.Kind, r.Namespace, r.Name)
+}
+
+// Applier deploys charts by rendering them in process and applying the objects with server-side apply.
+// The references of the applied objects, the chart and the digest of the values of a release are recorded
+// in an inventory ConfigMap so that the release can be checked and deleted without helm release storage.
+// The values are not recorded since they may contain credentials, the callers keep them if needed.
+type Applier struct {
+	client client.Client
+
+	// PreservedManagers are the field managers whose updates are kept when correcting drift,
+	// the controller itself by default, e.g. the replicas scaled by the runtime controller.
+	PreservedManagers []string
+}
+
+// NewApplier creates the Applier
+func NewApplier(c client.Client) *Applier {
+	return &Applier{
+		client:            c,
+		PreservedManagers: []string{filepath.Base(os.Args[0])},
+	}
+}
+
+// InventoryName returns the name of the inventory ConfigMap of the release
+func InventoryName(name string) string {
+	return name + "-apply-inventory"
+}
+
+// Apply renders the chart with the values file and applies the objects with the field manager.
+// The objects applied previously but not rendered any more are deleted.
+func (a *Applier) Apply(name, namespace, fieldManager, valueFile, chartName string) (err error) {
+	defer utils.TimeTrack(time.Now(), "Applier.Apply", "name", name, "namespace", namespace)
+	var valuesData []byte
+	if valueFile != "" {
+		if valuesData, err = os.ReadFile(valueFile); err != nil {
+			return err
+		}
+	}
+
+	objects, err := renderWithValues(name, namespace, valuesData, chartName)
+	if err != nil {
+		return err
+	}
+
+	previous, err := a.getInventory(name, namespace)
+	if err != nil && !apierrs.IsNotFound(err) {
+		return err
+	}
+
+	refs := make([]ObjectRef, 0, len(objects))
+	for _, obj := range objects {
+		if err = a.setNamespace(obj, namespace); err != nil {
+			return err
+		}
+		if err = a.applyObject(obj, fieldManager); err != nil {
+			return err
+		}
+		refs = append(refs, refOf(obj))
+	}
+
+	if err = a.saveInventory(name, namespace, fieldManager, chartName, valuesData, refs); err != nil {
+		return err
+	}
+
+	if previous != nil {
+		previousRefs, err := inventoryObjects(previous)
+		if err != nil {
+			return err
+		}
+		for _, ref := range pruneList(previousRefs, refs) {
+			log.Info("prune object not rendered any more", "release", name, "object", ref.String())
+			if err = a.deleteObject(ref); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Exists checks if the release has been applied
+func (a *Applier) Exists(name, namespace string) (bool, error) {
+	_, err := a.getInventory(name, namespace)
+	if err == nil {
+		return true, nil
+	}
+	if apierrs.IsNotFound(err) {
+		return false, nil
+	}
+	return false, err
+}
+
+// Delete deletes the objects applied for the release and its inventory
+func (a *Applier) Delete(name, namespace string) error {
+	defer utils.TimeTrack(time.Now(), "Applier.Delete", "name", name, "namespace", namespace)
+	inventory, err := a.getInventory(name, namespace)
+	if err != nil {
+		return err
+	}
+	refs, err := inventoryObjects(inventory)
+	if err != nil {
+		return err
+	}
+	for i := len(refs) - 1; i >= 0; i-- {
+		if err = a.deleteObject(refs[i]); err != nil {
+			return err
+		}
+	}
+	return client.IgnoreNotFound(a.client.Delete(context.TODO(), inventory))
+}
+
+// List lists the inventories of the releases applied in the namespace
+func (a *Applier) List(namespace string) ([]corev1.ConfigMap, error) {
+	var inventories corev1.ConfigMapList
+	if err := a.client.List(context.TODO(), &inventories, client.InNamespace(namespace), client.HasLabels{common.LabelAppliedRelease}); err != nil {
+		return nil, err
+	}
+	return inventories.Items, nil
+}
+
+// Reconcile detects the drift of the objects from the state rendered with the values and corrects it,
+// it returns the objects which are recreated or corrected. The values must be the ones applied last time,
+// otherwise ErrValuesChanged is returned.
+func (a *Applier) Reconcile(name, namespace string, valuesData []byte) (drifted []ObjectRef, err error) {
+	defer utils.TimeTrack(time.Now(), "Applier.Reconcile", "name", name, "namespace", namespace)
+	inventory, err := a.getInventory(name, namespace)
+	if err != nil {
+		return nil, err
+	}
+	if inventory.Data[inventoryKeyValuesDigest] != digestOf(valuesData) {
+		return nil, ErrValuesChanged
+	}
+	objects, err := renderWithValues(name, namespace, valuesData, inventory.Data[inventoryKeyChart])
+	if err != nil {
+		return nil, err
+	}
+	fieldManager := inventory.Data[inventoryKeyFieldManager]
+
+	for _, obj := range objects {
+		if err = a.setNamespace(obj, namespace); err != nil {
+			return drifted, err
+		}
+		live, err := a.getLive(obj)
+		if err != nil {
+			return drifted, err
+		}
+		if live != nil {
+			preserveFields(obj, live, a.PreservedManagers)
+			if isSubset(obj.UnstructuredContent(), live.UnstructuredContent()) {
+				continue
+			}
+		}
+
+		ref := refOf(obj)
+		log.Info("correct drifted object", "release", name, "object", ref.String())
+		if err = a.applyObject(obj, fieldManager); err != nil {
+			return drifted, err
+		}
+		drifted = append(drifted, ref)
+	}
+	return drifted, nil
+}
+
+// setNamespace sets the namespace of the namespaced object rendered without namespace
+func (a *Applier) setNamespace(obj *unstructured.Unstructured, namespace string) error {
+	if obj.GetNamespace() != "" {
+		return nil
+	}
+	namespaced, err := a.client.IsObjectNamespaced(obj)
+	if err != nil {
+		return err
+	}
+	if namespaced {
+		obj.SetNamespace(namespace)
+	}
+	return nil
+}
+
+// getLive gets the object from the cluster, nil if not found
+func (a *Applier) getLive(obj *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	live := &unstructured.Unstructured{}
+	live.SetGroupVersionKind(obj.GroupVersionKind())
+	err := a.client.Get(context.TODO(), types.NamespacedName{Namespace: obj.GetNamespace(), Name: obj.GetName()}, live)
+	if apierrs.IsNotFound(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return live, nil
+}
+
+// applyObject applies the object with server-side apply, the object is created if not found
+func (a *Applier) applyObject(obj *unstructured.Unstructured, fieldManager string) error {
+	return a.client.Patch(context.TODO(), obj, client.Apply, client.FieldOwner(fieldManager), client.ForceOwnership)
+}
+
+func (a *Applier) deleteObject(ref ObjectRef) error {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(schema.FromAPIVersionAndKind(ref.APIVersion, ref.Kind))
+	obj.SetNamespace(ref.Namespace)
+	obj.SetName(ref.Name)
+	return client.IgnoreNotFound(a.client.Delete(context.TODO(), obj, client.PropagationPolicy("Background")))
+}
+
+func (a *Applier) getInventory(name, namespace string) (*corev1.ConfigMap, error) {
+	inventory := &corev1.ConfigMap{}
+	err := a.client.Get(context.TODO(), types.NamespacedName{Namespace: namespace, Name: InventoryName(name)}, inventory)
+	if err != nil {
+		return nil, err
+	}
+	return inventory, nil
+}
+
+func (a *Applier) saveInventory(name, namespace, fieldManager, chartName string, values []byte, refs []ObjectRef) error {
+	objects, err := json.Marshal(refs)
+	if err != nil {
+		return err
+	}
+
+	inventory := &corev1.ConfigMap{}
+	inventory.SetName(InventoryName(name))
+	inventory.SetNamespace(namespace)
+	err = a.client.Get(context.TODO(), client.ObjectKeyFromObject(inventory), inventory)
+	if err != nil && !apierrs.IsNotFound(err) {
+		return err
+	}
+	notFound := apierrs.IsNotFound(err)
+
+	labels := inventory.GetLabels()
+	if labels == nil {
+		labels = map[string]string{}
+	}
+	labels[common.LabelAppliedRelease] = name
+	inventory.SetLabels(labels)
+	inventory.Data = map[string]string{
+		inventoryKeyChart:        chartName,
+		inventoryKeyValuesDigest: digestOf(values),
+		inventoryKeyObjects:      string(objects),
+		inventoryKeyFieldManager: fieldManager,
+	}
+	if notFound {
+		return a.client.Create(context.TODO(), inventory)
+	}
+	return a.client.Update(context.TODO(), inventory)
+}
+
+func renderWithValues(name, namespace string, valuesData []byte, chartName string) ([]*unstructured.Unstructured, error) {
+	values := map[string]interface{}{}
+	if len(valuesData) > 0 {
+		if err := yaml.Unmarshal(valuesData, &values); err != nil {
+			return nil, fmt.Errorf("failed to parse values of release %s/%s: %w", namespace, name, err)
+		}
+	}
+	return RenderChart(name, namespace, values, chartName)
+}
+
+// digestOf returns the digest of the values, which tells if the values change without keeping them
+func digestOf(values []byte) string {
+	sum := sha256.Sum256(values)
+	return hex.EncodeToString(sum[:])
+}
+
+func inventoryObjects(inventory *corev1.ConfigMap) (refs []ObjectRef, err error) {
+	if data := inventory.Data[inventoryKeyObjects]; data != "" {
+		err = json.Unmarshal([]byte(data), &refs)
+	}
+	return
+}
+
+func refOf(obj *unstructured.Unstructured) ObjectRef {
+	return ObjectRef{
+		APIVersion: obj.GetAPIVersion(),
+		Kind:       obj.GetKind(),
+		Namespace:  obj.GetNamespace(),
+		Name:       obj.GetName(),
+	}
+}
+
+// pruneList returns the refs in previous but not in current
+func pruneList(previous, current []ObjectRef) (pruned []ObjectRef) {
+	kept := map[ObjectRef]bool{}
+	for _, ref := range current {
+		kept[ref] = true
+	}
+	for _, ref := range previous {
+		if !kept[ref] {
+			pruned = append(pruned, ref)
+		}
+	}
+	return
+}
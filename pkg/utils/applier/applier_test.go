@@ -0,0 +1,312 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package applier
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	"github.com/fluid-cloudnative/fluid/pkg/utils/helm"
+)
+
+const testChart = "testdata/demo"
+
+func newTestClient(t *testing.T) client.Client {
+	s := runtime.NewScheme()
+	if err := corev1.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	if err := appsv1.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	mapper := meta.NewDefaultRESTMapper([]schema.GroupVersion{corev1.SchemeGroupVersion, appsv1.SchemeGroupVersion})
+	mapper.Add(corev1.SchemeGroupVersion.WithKind("ConfigMap"), meta.RESTScopeNamespace)
+	mapper.Add(corev1.SchemeGroupVersion.WithKind("Service"), meta.RESTScopeNamespace)
+	mapper.Add(appsv1.SchemeGroupVersion.WithKind("StatefulSet"), meta.RESTScopeNamespace)
+	// The fake client does not support apply patches, so they are served as creates or updates.
+	return fake.NewClientBuilder().WithScheme(s).WithRESTMapper(mapper).WithInterceptorFuncs(interceptor.Funcs{
+		Patch: func(ctx context.Context, c client.WithWatch, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+			if patch.Type() != types.ApplyPatchType {
+				return c.Patch(ctx, obj, patch, opts...)
+			}
+			live := &unstructured.Unstructured{}
+			live.SetGroupVersionKind(obj.GetObjectKind().GroupVersionKind())
+			err := c.Get(ctx, client.ObjectKeyFromObject(obj), live)
+			if apierrs.IsNotFound(err) {
+				return c.Create(ctx, obj)
+			}
+			if err != nil {
+				return err
+			}
+			obj.SetResourceVersion(live.GetResourceVersion())
+			return c.Update(ctx, obj)
+		},
+	}).Build()
+}
+
+func writeValues(t *testing.T, content string) string {
+	valueFile := filepath.Join(t.TempDir(), "values.yaml")
+	if err := os.WriteFile(valueFile, []byte(content), 0400); err != nil {
+		t.Fatal(err)
+	}
+	return valueFile
+}
+
+func TestRenderChart(t *testing.T) {
+	objects, err := RenderChart("demo", "default", map[string]interface{}{"image": "demo:v2"}, testChart)
+	if err != nil {
+		t.Fatalf("failed to render chart: %v", err)
+	}
+
+	kinds := []string{}
+	for _, obj := range objects {
+		kinds = append(kinds, obj.GetKind())
+	}
+	// the install hook goes first, the test hook is skipped, and the others are in install order
+	want := []string{"ConfigMap", "Service", "StatefulSet"}
+	if len(kinds) != len(want) {
+		t.Fatalf("expect objects %v, got %v", want, kinds)
+	}
+	for i := range want {
+		if kinds[i] != want[i] {
+			t.Fatalf("expect objects %v, got %v", want, kinds)
+		}
+	}
+	if _, found := objects[0].GetAnnotations()["helm.sh/hook"]; found {
+		t.Errorf("expect helm annotations to be removed, got %v", objects[0].GetAnnotations())
+	}
+	if image, _, _ := unstructured.NestedString(objects[0].Object, "data", "image"); image != "demo:v2" {
+		t.Errorf("expect values to be rendered, got image %s", image)
+	}
+
+	if _, err = RenderChart("demo", "default", nil, "testdata/not-exist"); err == nil {
+		t.Errorf("expect error when the chart does not exist")
+	}
+}
+
+func TestApplierLifecycle(t *testing.T) {
+	c := newTestClient(t)
+	applier := NewApplier(c)
+	key := types.NamespacedName{Namespace: "default", Name: "demo-worker"}
+
+	if err := applier.Apply("demo", "default", "fluid-thin-demo", writeValues(t, "replicas: 2\n"), testChart); err != nil {
+		t.Fatalf("failed to apply: %v", err)
+	}
+	exist, err := applier.Exists("demo", "default")
+	if err != nil || !exist {
+		t.Fatalf("expect release to exist, got %v, %v", exist, err)
+	}
+	sts := &appsv1.StatefulSet{}
+	if err = c.Get(context.TODO(), key, sts); err != nil {
+		t.Fatalf("failed to get statefulset: %v", err)
+	}
+	if *sts.Spec.Replicas != 2 {
+		t.Errorf("expect 2 replicas, got %d", *sts.Spec.Replicas)
+	}
+
+	inventory := &corev1.ConfigMap{}
+	if err = c.Get(context.TODO(), types.NamespacedName{Namespace: "default", Name: InventoryName("demo")}, inventory); err != nil {
+		t.Fatalf("failed to get inventory: %v", err)
+	}
+	for key, value := range inventory.Data {
+		if strings.Contains(value, "replicas") {
+			t.Errorf("expect inventory not to keep the values, got %s: %s", key, value)
+		}
+	}
+
+	// values changed since the last apply
+	if _, err = applier.Reconcile("demo", "default", []byte("replicas: 3\n")); !errors.Is(err, ErrValuesChanged) {
+		t.Fatalf("expect values changed error, got %v", err)
+	}
+
+	// no drift
+	drifted, err := applier.Reconcile("demo", "default", []byte("replicas: 2\n"))
+	if err != nil || len(drifted) != 0 {
+		t.Fatalf("expect no drift, got %v, %v", drifted, err)
+	}
+
+	// the image is changed and the service is deleted out of band
+	sts.Spec.Template.Spec.Containers[0].Image = "demo:hacked"
+	if err = c.Update(context.TODO(), sts); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Delete(context.TODO(), &corev1.Service{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "demo-worker"}}); err != nil {
+		t.Fatal(err)
+	}
+	drifted, err = applier.Reconcile("demo", "default", []byte("replicas: 2\n"))
+	if err != nil || len(drifted) != 2 {
+		t.Fatalf("expect 2 drifted objects, got %v, %v", drifted, err)
+	}
+	if err = c.Get(context.TODO(), key, sts); err != nil {
+		t.Fatal(err)
+	}
+	if image := sts.Spec.Template.Spec.Containers[0].Image; image != "demo:v1" {
+		t.Errorf("expect image to be corrected, got %s", image)
+	}
+	if err = c.Get(context.TODO(), key, &corev1.Service{}); err != nil {
+		t.Errorf("expect service to be recreated, got %v", err)
+	}
+
+	// objects not rendered any more are pruned
+	if err = applier.Apply("demo", "default", "fluid-thin-demo", writeValues(t, "withService: false\n"), testChart); err != nil {
+		t.Fatalf("failed to apply: %v", err)
+	}
+	if err = c.Get(context.TODO(), key, &corev1.Service{}); !apierrs.IsNotFound(err) {
+		t.Errorf("expect service to be pruned, got %v", err)
+	}
+
+	if err = applier.Delete("demo", "default"); err != nil {
+		t.Fatalf("failed to delete: %v", err)
+	}
+	if err = c.Get(context.TODO(), key, &appsv1.StatefulSet{}); !apierrs.IsNotFound(err) {
+		t.Errorf("expect statefulset to be deleted, got %v", err)
+	}
+	if exist, _ = applier.Exists("demo", "default"); exist {
+		t.Errorf("expect release not to exist after deleted")
+	}
+}
+
+func TestPreserveFields(t *testing.T) {
+	desired := &unstructured.Unstructured{Object: map[string]interface{}{
+		"metadata": map[string]interface{}{"name": "demo", "labels": map[string]interface{}{"app": "demo"}},
+		"spec":     map[string]interface{}{"replicas": int64(1), "serviceName": "demo"},
+	}}
+	live := &unstructured.Unstructured{Object: map[string]interface{}{
+		"metadata": map[string]interface{}{"name": "demo", "labels": map[string]interface{}{"app": "demo", "scaled": "true"}},
+		"spec":     map[string]interface{}{"replicas": int64(3), "serviceName": "hacked"},
+	}}
+	live.SetManagedFields([]metav1.ManagedFieldsEntry{
+		{
+			Manager:   "thinruntime-controller",
+			Operation: metav1.ManagedFieldsOperationUpdate,
+			FieldsV1:  &metav1.FieldsV1{Raw: []byte(`{"f:metadata":{"f:labels":{"f:scaled":{}}},"f:spec":{"f:replicas":{}}}`)},
+		},
+		{
+			Manager:   "kubectl-edit",
+			Operation: metav1.ManagedFieldsOperationUpdate,
+			FieldsV1:  &metav1.FieldsV1{Raw: []byte(`{"f:spec":{"f:serviceName":{}}}`)},
+		},
+	})
+
+	preserveFields(desired, live, []string{"thinruntime-controller"})
+	if replicas, _, _ := unstructured.NestedInt64(desired.Object, "spec", "replicas"); replicas != 3 {
+		t.Errorf("expect replicas scaled by the controller to be preserved, got %d", replicas)
+	}
+	if desired.GetLabels()["scaled"] != "true" {
+		t.Errorf("expect label added by the controller to be preserved, got %v", desired.GetLabels())
+	}
+	if name, _, _ := unstructured.NestedString(desired.Object, "spec", "serviceName"); name != "demo" {
+		t.Errorf("expect fields changed by others not to be preserved, got %s", name)
+	}
+}
+
+func TestIsSubset(t *testing.T) {
+	tests := []struct {
+		name    string
+		desired interface{}
+		live    interface{}
+		want    bool
+	}{
+		{
+			name:    "defaulted fields are ignored",
+			desired: map[string]interface{}{"replicas": int64(1)},
+			live:    map[string]interface{}{"replicas": float64(1), "revisionHistoryLimit": int64(10)},
+			want:    true,
+		},
+		{
+			name:    "value changed",
+			desired: map[string]interface{}{"image": "demo:v1"},
+			live:    map[string]interface{}{"image": "demo:v2"},
+			want:    false,
+		},
+		{
+			name:    "field removed",
+			desired: map[string]interface{}{"data": map[string]interface{}{"key": "value"}},
+			live:    map[string]interface{}{},
+			want:    false,
+		},
+		{
+			name:    "empty field not set",
+			desired: map[string]interface{}{"labels": map[string]interface{}{}},
+			live:    map[string]interface{}{},
+			want:    true,
+		},
+		{
+			name:    "list length changed",
+			desired: []interface{}{"a"},
+			live:    []interface{}{"a", "b"},
+			want:    false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isSubset(tt.desired, tt.live); got != tt.want {
+				t.Errorf("isSubset() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFallbackReleaseManager(t *testing.T) {
+	c := newTestClient(t)
+	fallback := helm.NewFakeReleaseManager(helm.Release{Name: "helm-release", Namespace: "default"})
+	manager := NewFallbackReleaseManager(c, fallback)
+
+	if err := NewReleaseManager(c, "fluid-thin-demo").Install("demo", "default", writeValues(t, ""), testChart); err != nil {
+		t.Fatalf("failed to install: %v", err)
+	}
+	for _, name := range []string{"demo", "helm-release"} {
+		exist, err := manager.Check(name, "default")
+		if err != nil || !exist {
+			t.Errorf("expect release %s to exist, got %v, %v", name, exist, err)
+		}
+	}
+	releases, err := manager.List("default")
+	if err != nil || len(releases) != 2 {
+		t.Errorf("expect 2 releases, got %v, %v", releases, err)
+	}
+
+	for _, name := range []string{"demo", "helm-release"} {
+		if err = manager.Delete(name, "default"); err != nil {
+			t.Errorf("failed to delete release %s: %v", name, err)
+		}
+		if exist, _ := manager.Check(name, "default"); exist {
+			t.Errorf("expect release %s to be deleted", name)
+		}
+	}
+
+	if err = NewReleaseManager(c, "fluid-thin-demo").Rollback("demo", "default", 1); !helm.IsRollbackFailed(err) {
+		t.Errorf("expect rollback not supported, got %v", err)
+	}
+}
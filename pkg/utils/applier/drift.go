@@ -0,0 +1,167 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package applier
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/utils/strings/slices"
+)
+
+// preserveFields copies the fields updated by the preserved managers from the live object to the desired one,
+// so that these updates are not regarded as drift. The fields are tracked at the granularity of the second
+// level, e.g. spec.replicas and spec.template, or of labels and annotations for metadata.
+func preserveFields(desired, live *unstructured.Unstructured, managers []string) {
+	for _, entry := range live.GetManagedFields() {
+		if entry.Operation != metav1.ManagedFieldsOperationUpdate || !slices.Contains(managers, entry.Manager) || entry.FieldsV1 == nil {
+			continue
+		}
+		fields := map[string]interface{}{}
+		if err := json.Unmarshal(entry.FieldsV1.Raw, &fields); err != nil {
+			log.V(1).Info("skip invalid managed fields", "manager", entry.Manager, "error", err.Error())
+			continue
+		}
+
+		for top, sub := range fields {
+			topField, ok := fieldName(top)
+			if !ok || topField == "status" {
+				continue
+			}
+			subFields, ok := sub.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if topField == "metadata" {
+				for _, metaField := range []string{"labels", "annotations"} {
+					keys, ok := subFields["f:"+metaField].(map[string]interface{})
+					if !ok {
+						continue
+					}
+					for key := range keys {
+						if name, ok := fieldName(key); ok {
+							copyField(desired, live, "metadata", metaField, name)
+						}
+					}
+				}
+				continue
+			}
+			for key := range subFields {
+				if name, ok := fieldName(key); ok {
+					copyField(desired, live, topField, name)
+				}
+			}
+		}
+	}
+}
+
+func fieldName(key string) (string, bool) {
+	if !strings.HasPrefix(key, "f:") {
+		return "", false
+	}
+	return strings.TrimPrefix(key, "f:"), true
+}
+
+func copyField(desired, live *unstructured.Unstructured, fields ...string) {
+	value, found, err := unstructured.NestedFieldCopy(live.Object, fields...)
+	if err != nil {
+		return
+	}
+	if !found {
+		unstructured.RemoveNestedField(desired.Object, fields...)
+		return
+	}
+	_ = unstructured.SetNestedField(desired.Object, value, fields...)
+}
+
+// isSubset checks if all the fields of desired are set to the same values in live,
+// the fields only in live, e.g. the defaulted ones, are ignored.
+func isSubset(desired, live interface{}) bool {
+	switch d := desired.(type) {
+	case nil:
+		return true
+	case map[string]interface{}:
+		l, ok := live.(map[string]interface{})
+		if !ok {
+			return len(d) == 0 && live == nil
+		}
+		for key, value := range d {
+			liveValue, found := l[key]
+			if !found {
+				if isEmpty(value) {
+					continue
+				}
+				return false
+			}
+			if !isSubset(value, liveValue) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		l, ok := live.([]interface{})
+		if !ok {
+			return len(d) == 0 && live == nil
+		}
+		if len(d) != len(l) {
+			return false
+		}
+		for i := range d {
+			if !isSubset(d[i], l[i]) {
+				return false
+			}
+		}
+		return true
+	default:
+		if dn, ok := toFloat(desired); ok {
+			ln, ok := toFloat(live)
+			return ok && dn == ln
+		}
+		return reflect.DeepEqual(desired, live)
+	}
+}
+
+func isEmpty(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case map[string]interface{}:
+		return len(v) == 0
+	case []interface{}:
+		return len(v) == 0
+	default:
+		return false
+	}
+}
+
+func toFloat(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case int64:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case float64:
+		return v, true
+	default:
+		return 0, false
+	}
+}
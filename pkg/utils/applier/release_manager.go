@@ -0,0 +1,179 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package applier
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/helm"
+)
+
+// ReleaseManager deploys releases with the Applier using a dedicated field manager.
+type ReleaseManager struct {
+	*Applier
+	fieldManager string
+}
+
+var _ helm.ReleaseManager = &ReleaseManager{}
+
+// NewReleaseManager creates the ReleaseManager applying objects with the field manager
+func NewReleaseManager(c client.Client, fieldManager string) *ReleaseManager {
+	return &ReleaseManager{
+		Applier:      NewApplier(c),
+		fieldManager: fieldManager,
+	}
+}
+
+func (m *ReleaseManager) Install(name, namespace, valueFile, chartName string) error {
+	if err := m.Apply(name, namespace, m.fieldManager, valueFile, chartName); err != nil {
+		return helm.NewReleaseError(helm.ReasonInstallFailed, name, namespace, err)
+	}
+	return nil
+}
+
+func (m *ReleaseManager) Upgrade(name, namespace, valueFile, chartName string) error {
+	exist, err := m.Exists(name, namespace)
+	if err != nil {
+		return helm.NewReleaseError(helm.ReasonQueryFailed, name, namespace, err)
+	}
+	if !exist {
+		return helm.NewReleaseError(helm.ReasonReleaseNotFound, name, namespace, nil)
+	}
+	if err = m.Apply(name, namespace, m.fieldManager, valueFile, chartName); err != nil {
+		return helm.NewReleaseError(helm.ReasonUpgradeFailed, name, namespace, err)
+	}
+	return nil
+}
+
+// Rollback is not supported because no revision history is kept in apply render mode.
+func (m *ReleaseManager) Rollback(name, namespace string, revision int) error {
+	return helm.NewReleaseError(helm.ReasonRollbackFailed, name, namespace, fmt.Errorf("rollback is not supported in apply render mode"))
+}
+
+func (m *ReleaseManager) Check(name, namespace string) (bool, error) {
+	exist, err := m.Exists(name, namespace)
+	if err != nil {
+		return false, helm.NewReleaseError(helm.ReasonQueryFailed, name, namespace, err)
+	}
+	return exist, nil
+}
+
+func (m *ReleaseManager) Delete(name, namespace string) error {
+	err := m.Applier.Delete(name, namespace)
+	if apierrs.IsNotFound(err) {
+		return helm.NewReleaseError(helm.ReasonReleaseNotFound, name, namespace, err)
+	}
+	if err != nil {
+		return helm.NewReleaseError(helm.ReasonDeleteFailed, name, namespace, err)
+	}
+	return nil
+}
+
+func (m *ReleaseManager) List(namespace string) ([]helm.Release, error) {
+	inventories, err := m.Applier.List(namespace)
+	if err != nil {
+		return nil, helm.NewReleaseError(helm.ReasonQueryFailed, "", namespace, err)
+	}
+	return toReleases(inventories), nil
+}
+
+// FallbackReleaseManager checks and deletes the releases deployed in apply render mode, and hands the
+// others over to the fallback ReleaseManager. It allows the releases deployed in both modes to be
+// managed with the package level functions of helm.
+type FallbackReleaseManager struct {
+	applier  *Applier
+	fallback helm.ReleaseManager
+}
+
+var _ helm.ReleaseManager = &FallbackReleaseManager{}
+
+// NewFallbackReleaseManager creates the FallbackReleaseManager
+func NewFallbackReleaseManager(c client.Client, fallback helm.ReleaseManager) *FallbackReleaseManager {
+	return &FallbackReleaseManager{
+		applier:  NewApplier(c),
+		fallback: fallback,
+	}
+}
+
+func (m *FallbackReleaseManager) Install(name, namespace, valueFile, chartName string) error {
+	return m.fallback.Install(name, namespace, valueFile, chartName)
+}
+
+func (m *FallbackReleaseManager) Upgrade(name, namespace, valueFile, chartName string) error {
+	return m.fallback.Upgrade(name, namespace, valueFile, chartName)
+}
+
+func (m *FallbackReleaseManager) Rollback(name, namespace string, revision int) error {
+	return m.fallback.Rollback(name, namespace, revision)
+}
+
+func (m *FallbackReleaseManager) Check(name, namespace string) (bool, error) {
+	applied, err := m.applier.Exists(name, namespace)
+	if err != nil {
+		return false, helm.NewReleaseError(helm.ReasonQueryFailed, name, namespace, err)
+	}
+	if applied {
+		return true, nil
+	}
+	return m.fallback.Check(name, namespace)
+}
+
+func (m *FallbackReleaseManager) Delete(name, namespace string) error {
+	applied, err := m.applier.Exists(name, namespace)
+	if err != nil {
+		return helm.NewReleaseError(helm.ReasonQueryFailed, name, namespace, err)
+	}
+	if !applied {
+		return m.fallback.Delete(name, namespace)
+	}
+	if err = m.applier.Delete(name, namespace); err != nil {
+		return helm.NewReleaseError(helm.ReasonDeleteFailed, name, namespace, err)
+	}
+	return nil
+}
+
+func (m *FallbackReleaseManager) List(namespace string) ([]helm.Release, error) {
+	releases, err := m.fallback.List(namespace)
+	if err != nil {
+		return nil, err
+	}
+	inventories, err := m.applier.List(namespace)
+	if err != nil {
+		return nil, helm.NewReleaseError(helm.ReasonQueryFailed, "", namespace, err)
+	}
+	return append(releases, toReleases(inventories)...), nil
+}
+
+func toReleases(inventories []corev1.ConfigMap) []helm.Release {
+	releases := make([]helm.Release, 0, len(inventories))
+	for _, inventory := range inventories {
+		releases = append(releases, helm.Release{
+			Name:      inventory.Labels[common.LabelAppliedRelease],
+			Namespace: inventory.Namespace,
+			Revision:  1,
+			Status:    helm.StatusDeployed,
+			Chart:     inventory.Data[inventoryKeyChart],
+			Updated:   inventory.CreationTimestamp.Time,
+		})
+	}
+	return releases
+}
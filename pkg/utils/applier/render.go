@@ -0,0 +1,116 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package applier
+
+import (
+	"fmt"
+	"strings"
+
+	"helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/chartutil"
+	"helm.sh/helm/v3/pkg/engine"
+	"helm.sh/helm/v3/pkg/release"
+	"helm.sh/helm/v3/pkg/releaseutil"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+)
+
+// RenderChart renders the chart with the values into kubernetes objects in install order, without
+// creating a helm release. The chart templates stay the only source of the objects, the same as in
+// helm render mode, only the release storage of helm is replaced by the inventory of the Applier.
+// Hooks running at install time are rendered as ordinary objects ahead of the others, and the other
+// hooks are skipped.
+func RenderChart(name, namespace string, values map[string]interface{}, chartName string) ([]*unstructured.Unstructured, error) {
+	chrt, err := loader.Load(chartName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load chart %s: %w", chartName, err)
+	}
+
+	options := chartutil.ReleaseOptions{
+		Name:      name,
+		Namespace: namespace,
+		Revision:  1,
+		IsInstall: true,
+	}
+	renderValues, err := chartutil.ToRenderValues(chrt, values, options, chartutil.DefaultCapabilities)
+	if err != nil {
+		return nil, err
+	}
+	files, err := engine.Render(chrt, renderValues)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render chart %s: %w", chartName, err)
+	}
+
+	hooks, manifests, err := releaseutil.SortManifests(files, chartutil.DefaultVersionSet, releaseutil.InstallOrder)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse manifests of chart %s: %w", chartName, err)
+	}
+
+	contents := []string{}
+	for _, hook := range hooks {
+		if isInstallHook(hook) {
+			contents = append(contents, hook.Manifest)
+		}
+	}
+	for _, manifest := range manifests {
+		contents = append(contents, manifest.Content)
+	}
+
+	objects := make([]*unstructured.Unstructured, 0, len(contents))
+	for _, content := range contents {
+		obj, err := decodeManifest(content)
+		if err != nil {
+			return nil, err
+		}
+		if obj == nil {
+			continue
+		}
+		annotations := obj.GetAnnotations()
+		for key := range annotations {
+			if strings.HasPrefix(key, "helm.sh/") {
+				delete(annotations, key)
+			}
+		}
+		obj.SetAnnotations(annotations)
+		objects = append(objects, obj)
+	}
+	return objects, nil
+}
+
+func isInstallHook(hook *release.Hook) bool {
+	for _, event := range hook.Events {
+		if event == release.HookPreInstall || event == release.HookPostInstall {
+			return true
+		}
+	}
+	return false
+}
+
+func decodeManifest(content string) (*unstructured.Unstructured, error) {
+	data, err := yaml.YAMLToJSON([]byte(content))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode manifest: %w", err)
+	}
+	if len(data) == 0 || string(data) == "null" {
+		return nil, nil
+	}
+	obj := &unstructured.Unstructured{}
+	if err = obj.UnmarshalJSON(data); err != nil {
+		return nil, fmt.Errorf("failed to decode manifest: %w", err)
+	}
+	return obj, nil
+}
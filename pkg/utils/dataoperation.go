@@ -65,6 +65,21 @@ func GetOperationStatus(obj client.Object) (*datav1alpha1.OperationStatus, error
 	return nil, fmt.Errorf("obj is not of any data operation type")
 }
 
+// GetOperationPriority returns the priority of the data operation in the operation queue of the runtime
+func GetOperationPriority(obj client.Object) int32 {
+	if dataLoad, ok := obj.(*datav1alpha1.DataLoad); ok {
+		return dataLoad.Spec.Priority
+	} else if dataMigrate, ok := obj.(*datav1alpha1.DataMigrate); ok {
+		return dataMigrate.Spec.Priority
+	} else if dataBackup, ok := obj.(*datav1alpha1.DataBackup); ok {
+		return dataBackup.Spec.Priority
+	} else if dataProcess, ok := obj.(*datav1alpha1.DataProcess); ok {
+		return dataProcess.Spec.Priority
+	}
+
+	return 0
+}
+
 func GetPrecedingOperationStatus(client client.Client, opRef *datav1alpha1.ObjectRef, opRefNamespace string) (*datav1alpha1.OperationStatus, error) {
 	if opRef == nil {
 		return nil, nil
@@ -23,6 +23,7 @@ import (
 	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
 	"github.com/fluid-cloudnative/fluid/pkg/common"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/fluid-cloudnative/fluid/pkg/dataoperation"
 )
@@ -253,3 +254,44 @@ func TestGetParallelOperationWorkersName(t *testing.T) {
 		})
 	}
 }
+
+func TestGetOperationPriority(t *testing.T) {
+	tests := []struct {
+		name         string
+		obj          client.Object
+		wantPriority int32
+	}{
+		{
+			name:         "dataload",
+			obj:          &datav1alpha1.DataLoad{Spec: datav1alpha1.DataLoadSpec{Priority: 10}},
+			wantPriority: 10,
+		},
+		{
+			name:         "dataprocess",
+			obj:          &datav1alpha1.DataProcess{Spec: datav1alpha1.DataProcessSpec{Priority: -1}},
+			wantPriority: -1,
+		},
+		{
+			name:         "datamigrate",
+			obj:          &datav1alpha1.DataMigrate{Spec: datav1alpha1.DataMigrateSpec{Priority: 1}},
+			wantPriority: 1,
+		},
+		{
+			name:         "databackup",
+			obj:          &datav1alpha1.DataBackup{Spec: datav1alpha1.DataBackupSpec{Priority: 2}},
+			wantPriority: 2,
+		},
+		{
+			name:         "not a data operation",
+			obj:          &datav1alpha1.Dataset{},
+			wantPriority: 0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := GetOperationPriority(tt.obj); got != tt.wantPriority {
+				t.Errorf("GetOperationPriority() = %v, want %v", got, tt.wantPriority)
+			}
+		})
+	}
+}
@@ -0,0 +1,294 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helm
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/cmdguard"
+)
+
+// CmdReleaseManager manages releases by executing the ddc-helm binary.
+type CmdReleaseManager struct{}
+
+var _ ReleaseManager = &CmdReleaseManager{}
+
+// NewCmdReleaseManager creates the CmdReleaseManager
+func NewCmdReleaseManager() *CmdReleaseManager {
+	return &CmdReleaseManager{}
+}
+
+// Install installs the release with cmd: helm install -f values.yaml chart_name, support helm v3
+func (m *CmdReleaseManager) Install(name, namespace, valueFile, chartName string) error {
+	defer utils.TimeTrack(time.Now(), "Helm.InstallRelease", "name", name, "namespace", namespace)
+	binary, err := exec.LookPath(helmCmd[0])
+	if err != nil {
+		return err
+	}
+
+	// 3. check if the chart file exists, if it's unix path, then check if it's exist
+	if strings.HasPrefix(chartName, "/") {
+		if _, err = os.Stat(chartName); os.IsNotExist(err) {
+			// TODO: the chart will be put inside the binary in future
+			return NewReleaseError(ReasonChartNotFound, name, namespace, err)
+		}
+	}
+
+	// 4. prepare the arguments
+	args := []string{"install", "-f", valueFile, "--namespace", namespace, name, chartName}
+
+	// 5. execute the command
+	cmd, err := cmdguard.Command(binary, args...)
+	if err != nil {
+		return err
+	}
+	log.Info("Exec", "command", cmd.String())
+	out, err := cmd.CombinedOutput()
+	log.Info(string(out))
+
+	if err != nil {
+		log.Error(err, "failed to execute InstallRelease() command", "command", cmd.String())
+		err = NewReleaseError(ReasonInstallFailed, name, namespace,
+			fmt.Errorf("failed to install kubernetes resources of %s: %s", chartName, string(out)))
+
+		rollbackErr := m.deleteIfExists(name, namespace)
+		if rollbackErr != nil {
+			log.Error(err, "failed to rollback installed helm release after InstallRelease() failure", "name", name, "namespace", namespace)
+		}
+		return err
+	}
+
+	return nil
+}
+
+// Upgrade upgrades the release with cmd: helm upgrade -f values.yaml release_name chart_name
+func (m *CmdReleaseManager) Upgrade(name, namespace, valueFile, chartName string) error {
+	defer utils.TimeTrack(time.Now(), "Helm.UpgradeRelease", "name", name, "namespace", namespace)
+	binary, err := exec.LookPath(helmCmd[0])
+	if err != nil {
+		return err
+	}
+
+	if strings.HasPrefix(chartName, "/") {
+		if _, err = os.Stat(chartName); os.IsNotExist(err) {
+			return NewReleaseError(ReasonChartNotFound, name, namespace, err)
+		}
+	}
+
+	cmd, err := cmdguard.Command(binary, "upgrade", "-f", valueFile, "--namespace", namespace, name, chartName)
+	if err != nil {
+		return err
+	}
+	log.Info("Exec", "command", cmd.String())
+	out, err := cmd.CombinedOutput()
+	log.Info(string(out))
+	if err != nil {
+		log.Error(err, "failed to execute UpgradeRelease() command", "command", cmd.String())
+		if strings.Contains(string(out), "has no deployed releases") || strings.Contains(string(out), "not found") {
+			return NewReleaseError(ReasonReleaseNotFound, name, namespace, errors.New(string(out)))
+		}
+		return NewReleaseError(ReasonUpgradeFailed, name, namespace, errors.New(string(out)))
+	}
+	return nil
+}
+
+// Rollback rolls back the release with cmd: helm rollback release_name revision
+func (m *CmdReleaseManager) Rollback(name, namespace string, revision int) error {
+	defer utils.TimeTrack(time.Now(), "Helm.RollbackRelease", "name", name, "namespace", namespace)
+	binary, err := exec.LookPath(helmCmd[0])
+	if err != nil {
+		return err
+	}
+
+	args := []string{"rollback", name}
+	if revision > 0 {
+		args = append(args, strconv.Itoa(revision))
+	}
+	args = append(args, "-n", namespace)
+	cmd, err := cmdguard.Command(binary, args...)
+	if err != nil {
+		return err
+	}
+	log.Info("Exec", "command", cmd.String())
+	out, err := cmd.CombinedOutput()
+	log.Info(string(out))
+	if err != nil {
+		log.Error(err, "failed to execute RollbackRelease() command", "command", cmd.String())
+		if strings.Contains(string(out), "not found") {
+			return NewReleaseError(ReasonReleaseNotFound, name, namespace, errors.New(string(out)))
+		}
+		return NewReleaseError(ReasonRollbackFailed, name, namespace, errors.New(string(out)))
+	}
+	return nil
+}
+
+// Check checks if the release with the given name and namespace exist.
+func (m *CmdReleaseManager) Check(name, namespace string) (exist bool, err error) {
+	defer utils.TimeTrack(time.Now(), "Helm.CheckRelease", "name", name, "namespace", namespace)
+	_, err = exec.LookPath(helmCmd[0])
+	if err != nil {
+		return exist, err
+	}
+
+	cmd, err := cmdguard.Command(helmCmd[0], "status", name, "-n", namespace, "--revision", "1")
+	if err != nil {
+		return exist, err
+	}
+
+	resultBytes, err := cmd.CombinedOutput()
+	if err != nil {
+		if exiterr, ok := err.(*exec.ExitError); ok {
+			if status, ok := exiterr.Sys().(syscall.WaitStatus); ok {
+				exitStatus := status.ExitStatus()
+				log.V(1).Info("Exit", "Status", exitStatus)
+				if exitStatus == 1 {
+					err = nil
+				}
+			}
+		} else {
+			log.Error(err, "failed to execute CheckRelease() command", "command", cmd.String())
+			return exist, err
+		}
+	} else {
+		waitStatus := cmd.ProcessState.Sys().(syscall.WaitStatus)
+		if waitStatus.ExitStatus() == 0 {
+			// ####### helm status output ######
+			// NAME: demo-dataset
+			// LAST DEPLOYED: Thu Mar 16 17:08:06 2023
+			// NAMESPACE: default
+			// STATUS: deployed
+			// REVISION: 1
+			// TEST SUITE: None
+			// ####### END #######
+			resultLines := strings.Split(string(resultBytes), "\n")
+			for _, line := range resultLines {
+				if strings.HasPrefix(line, "STATUS: ") {
+					// the first revision is superseded once the release is upgraded
+					status := strings.Replace(line, "STATUS: ", "", 1)
+					if status == StatusDeployed || status == StatusSuperseded {
+						exist = true
+					} else {
+						rollbackErr := m.Delete(name, namespace)
+						if rollbackErr != nil {
+							err = errors.Wrapf(rollbackErr, "failed to rollback failed release (namespace: %s, name: %s)", namespace, name)
+						}
+					}
+				}
+			}
+		} else {
+			if waitStatus.ExitStatus() != -1 {
+				return exist, fmt.Errorf("unexpected return code %d when exec helm status %s -n %s",
+					waitStatus.ExitStatus(),
+					name,
+					namespace)
+			}
+		}
+	}
+
+	return exist, err
+}
+
+// Delete deletes release with the name and namespace
+func (m *CmdReleaseManager) Delete(name, namespace string) error {
+	defer utils.TimeTrack(time.Now(), "Helm.DeleteRelease", "name", name, "namespace", namespace)
+	binary, err := exec.LookPath(helmCmd[0])
+	if err != nil {
+		return err
+	}
+
+	args := []string{"uninstall", name, "-n", namespace}
+	cmd, err := cmdguard.Command(binary, args...)
+	if err != nil {
+		return err
+	}
+	log.Info("Exec", "command", cmd.String())
+	out, err := cmd.Output()
+	log.Info("delete release", "result", string(out))
+	if err != nil {
+		log.Error(err, "failed to execute DeleteRelease() command", "command", cmd.String())
+		return NewReleaseError(ReasonDeleteFailed, name, namespace, fmt.Errorf("failed to delete engine-related kubernetes resources"))
+	}
+	return nil
+}
+
+// cmdRelease is the release in the json output of helm list
+type cmdRelease struct {
+	Name       string `json:"name"`
+	Namespace  string `json:"namespace"`
+	Revision   string `json:"revision"`
+	Updated    string `json:"updated"`
+	Status     string `json:"status"`
+	Chart      string `json:"chart"`
+	AppVersion string `json:"app_version"`
+}
+
+// List lists all the releases with cmd: helm list --all -o json
+func (m *CmdReleaseManager) List(namespace string) ([]Release, error) {
+	_, err := exec.LookPath(helmCmd[0])
+	if err != nil {
+		return nil, err
+	}
+
+	cmd, err := cmdguard.Command(helmCmd[0], "list", "--all", "-n", namespace, "-o", "json")
+	if err != nil {
+		return nil, err
+	}
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, NewReleaseError(ReasonQueryFailed, "", namespace, err)
+	}
+
+	var cmdReleases []cmdRelease
+	if err = json.Unmarshal(out, &cmdReleases); err != nil {
+		return nil, NewReleaseError(ReasonQueryFailed, "", namespace, err)
+	}
+	releases := make([]Release, 0, len(cmdReleases))
+	for _, r := range cmdReleases {
+		revision, _ := strconv.Atoi(r.Revision)
+		updated, _ := time.Parse(releaseUpdatedLayout, r.Updated)
+		releases = append(releases, Release{
+			Name:       r.Name,
+			Namespace:  r.Namespace,
+			Revision:   revision,
+			Status:     r.Status,
+			Chart:      r.Chart,
+			AppVersion: r.AppVersion,
+			Updated:    updated,
+		})
+	}
+	return releases, nil
+}
+
+func (m *CmdReleaseManager) deleteIfExists(name, namespace string) error {
+	existed, err := m.Check(name, namespace)
+	if err != nil {
+		return err
+	} else if existed {
+		return m.Delete(name, namespace)
+	}
+	return nil
+}
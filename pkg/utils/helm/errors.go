@@ -0,0 +1,107 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helm
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ReleaseErrorReason is the reason why a release operation failed.
+type ReleaseErrorReason string
+
+const (
+	// ReasonReleaseNotFound means the release does not exist.
+	ReasonReleaseNotFound ReleaseErrorReason = "ReleaseNotFound"
+	// ReasonChartNotFound means the chart to install or upgrade does not exist.
+	ReasonChartNotFound ReleaseErrorReason = "ChartNotFound"
+	// ReasonInvalidValues means the values file cannot be loaded.
+	ReasonInvalidValues ReleaseErrorReason = "InvalidValues"
+	// ReasonInstallFailed means the release failed to install.
+	ReasonInstallFailed ReleaseErrorReason = "InstallFailed"
+	// ReasonUpgradeFailed means the release failed to upgrade.
+	ReasonUpgradeFailed ReleaseErrorReason = "UpgradeFailed"
+	// ReasonRollbackFailed means the release failed to roll back.
+	ReasonRollbackFailed ReleaseErrorReason = "RollbackFailed"
+	// ReasonDeleteFailed means the release failed to delete.
+	ReasonDeleteFailed ReleaseErrorReason = "DeleteFailed"
+	// ReasonQueryFailed means the release storage cannot be queried.
+	ReasonQueryFailed ReleaseErrorReason = "QueryFailed"
+)
+
+// ReleaseError is the error returned by a ReleaseManager.
+type ReleaseError struct {
+	Reason    ReleaseErrorReason
+	Name      string
+	Namespace string
+	Err       error
+}
+
+// NewReleaseError creates a ReleaseError of the release with the given reason and cause.
+func NewReleaseError(reason ReleaseErrorReason, name, namespace string, err error) *ReleaseError {
+	return &ReleaseError{
+		Reason:    reason,
+		Name:      name,
+		Namespace: namespace,
+		Err:       err,
+	}
+}
+
+func (e *ReleaseError) Error() string {
+	if e.Err == nil {
+		return fmt.Sprintf("%s: release %s/%s", e.Reason, e.Namespace, e.Name)
+	}
+	return fmt.Sprintf("%s: release %s/%s: %v", e.Reason, e.Namespace, e.Name, e.Err)
+}
+
+func (e *ReleaseError) Unwrap() error {
+	return e.Err
+}
+
+// ReasonForError returns the reason of the ReleaseError in the error chain, or empty if not found.
+func ReasonForError(err error) ReleaseErrorReason {
+	var releaseErr *ReleaseError
+	if errors.As(err, &releaseErr) {
+		return releaseErr.Reason
+	}
+	return ""
+}
+
+// IsReleaseNotFound returns true if the release does not exist.
+func IsReleaseNotFound(err error) bool {
+	return ReasonForError(err) == ReasonReleaseNotFound
+}
+
+// IsChartNotFound returns true if the chart does not exist.
+func IsChartNotFound(err error) bool {
+	return ReasonForError(err) == ReasonChartNotFound
+}
+
+// IsInstallFailed returns true if the release failed to install.
+func IsInstallFailed(err error) bool {
+	return ReasonForError(err) == ReasonInstallFailed
+}
+
+// IsUpgradeFailed returns true if the release failed to upgrade.
+func IsUpgradeFailed(err error) bool {
+	return ReasonForError(err) == ReasonUpgradeFailed
+}
+
+// IsRollbackFailed returns true if the release failed to roll back.
+func IsRollbackFailed(err error) bool {
+	return ReasonForError(err) == ReasonRollbackFailed
+}
@@ -0,0 +1,190 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helm
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// FakeReleaseManager is an in-memory ReleaseManager for unit tests.
+type FakeReleaseManager struct {
+	mu sync.Mutex
+	// releases keeps the revision history of the releases, keyed by namespace/name
+	releases map[string][]Release
+	// errs are returned by the operations instead of performing them, keyed by the operation name, e.g. "Install"
+	errs map[string]error
+}
+
+var _ ReleaseManager = &FakeReleaseManager{}
+
+// NewFakeReleaseManager creates the FakeReleaseManager with deployed releases.
+func NewFakeReleaseManager(releases ...Release) *FakeReleaseManager {
+	m := &FakeReleaseManager{
+		releases: map[string][]Release{},
+		errs:     map[string]error{},
+	}
+	for _, r := range releases {
+		if r.Revision == 0 {
+			r.Revision = 1
+		}
+		if r.Status == "" {
+			r.Status = StatusDeployed
+		}
+		key := releaseKey(r.Name, r.Namespace)
+		m.releases[key] = append(m.releases[key], r)
+	}
+	return m
+}
+
+// SetError makes the operation return the error, nil clears the injected error.
+func (m *FakeReleaseManager) SetError(operation string, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err == nil {
+		delete(m.errs, operation)
+		return
+	}
+	m.errs[operation] = err
+}
+
+// Get returns the latest revision of the release.
+func (m *FakeReleaseManager) Get(name, namespace string) (Release, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	history := m.releases[releaseKey(name, namespace)]
+	if len(history) == 0 {
+		return Release{}, false
+	}
+	return history[len(history)-1], true
+}
+
+func (m *FakeReleaseManager) Install(name, namespace, valueFile, chartName string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := m.errs["Install"]; err != nil {
+		return err
+	}
+	key := releaseKey(name, namespace)
+	if len(m.releases[key]) > 0 {
+		return NewReleaseError(ReasonInstallFailed, name, namespace, fmt.Errorf("cannot re-use a name that is still in use"))
+	}
+	m.releases[key] = []Release{{
+		Name:      name,
+		Namespace: namespace,
+		Revision:  1,
+		Status:    StatusDeployed,
+		Chart:     chartName,
+		Updated:   time.Now(),
+	}}
+	return nil
+}
+
+func (m *FakeReleaseManager) Upgrade(name, namespace, valueFile, chartName string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := m.errs["Upgrade"]; err != nil {
+		return err
+	}
+	key := releaseKey(name, namespace)
+	history := m.releases[key]
+	if len(history) == 0 {
+		return NewReleaseError(ReasonReleaseNotFound, name, namespace, nil)
+	}
+	current := history[len(history)-1]
+	history[len(history)-1].Status = StatusSuperseded
+	current.Revision++
+	current.Status = StatusDeployed
+	current.Chart = chartName
+	current.Updated = time.Now()
+	m.releases[key] = append(history, current)
+	return nil
+}
+
+func (m *FakeReleaseManager) Rollback(name, namespace string, revision int) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := m.errs["Rollback"]; err != nil {
+		return err
+	}
+	key := releaseKey(name, namespace)
+	history := m.releases[key]
+	if len(history) == 0 {
+		return NewReleaseError(ReasonReleaseNotFound, name, namespace, nil)
+	}
+	current := history[len(history)-1]
+	if revision == 0 {
+		revision = current.Revision - 1
+	}
+	if revision < 1 || revision >= current.Revision {
+		return NewReleaseError(ReasonRollbackFailed, name, namespace, fmt.Errorf("release has no %d version", revision))
+	}
+	target := history[revision-1]
+	history[len(history)-1].Status = StatusSuperseded
+	target.Revision = current.Revision + 1
+	target.Status = StatusDeployed
+	target.Updated = time.Now()
+	m.releases[key] = append(history, target)
+	return nil
+}
+
+func (m *FakeReleaseManager) Check(name, namespace string) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := m.errs["Check"]; err != nil {
+		return false, err
+	}
+	return len(m.releases[releaseKey(name, namespace)]) > 0, nil
+}
+
+func (m *FakeReleaseManager) Delete(name, namespace string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := m.errs["Delete"]; err != nil {
+		return err
+	}
+	key := releaseKey(name, namespace)
+	if len(m.releases[key]) == 0 {
+		return NewReleaseError(ReasonReleaseNotFound, name, namespace, nil)
+	}
+	delete(m.releases, key)
+	return nil
+}
+
+func (m *FakeReleaseManager) List(namespace string) ([]Release, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if err := m.errs["List"]; err != nil {
+		return nil, err
+	}
+	releases := []Release{}
+	for _, history := range m.releases {
+		if latest := history[len(history)-1]; latest.Namespace == namespace {
+			releases = append(releases, latest)
+		}
+	}
+	sort.Slice(releases, func(i, j int) bool {
+		return releases[i].Name < releases[j].Name
+	})
+	return releases, nil
+}
+
+func releaseKey(name, namespace string) string {
+	return namespace + "/" + name
+}
@@ -11,3 +11,8 @@ func TestHelm(t *testing.T) {
 	RegisterFailHandler(Fail)
 	RunSpecs(t, "Helm Suite")
 }
+
+var _ = BeforeSuite(func() {
+	// the specs mock the execution of ddc-helm
+	SetReleaseManager(NewCmdReleaseManager())
+})
@@ -0,0 +1,109 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helm
+
+import (
+	"os"
+	"sync"
+	"time"
+)
+
+const (
+	// EnvReleaseManager selects the ReleaseManager used by the package level functions,
+	// "sdk" (default) for the in-process Helm SDK and "cmd" for the ddc-helm binary.
+	EnvReleaseManager = "HELM_RELEASE_MANAGER"
+
+	ReleaseManagerSDK = "sdk"
+	ReleaseManagerCmd = "cmd"
+)
+
+// Release status, consistent with the release status of Helm.
+const (
+	StatusDeployed        = "deployed"
+	StatusFailed          = "failed"
+	StatusSuperseded      = "superseded"
+	StatusUninstalling    = "uninstalling"
+	StatusPendingInstall  = "pending-install"
+	StatusPendingUpgrade  = "pending-upgrade"
+	StatusPendingRollback = "pending-rollback"
+)
+
+// releaseUpdatedLayout is the layout of the updated time printed by helm list, e.g. 2023-03-16 17:08:06.123456789 +0800 CST
+const releaseUpdatedLayout = "2006-01-02 15:04:05.999999999 -0700 MST"
+
+// Release is the summary of a helm release.
+type Release struct {
+	Name       string
+	Namespace  string
+	Revision   int
+	Status     string
+	Chart      string
+	AppVersion string
+	Updated    time.Time
+}
+
+// ReleaseManager manages the lifecycle of helm releases.
+type ReleaseManager interface {
+	// Install installs the chart as a release with the values file.
+	Install(name, namespace, valueFile, chartName string) error
+	// Upgrade upgrades the release with the chart and the values file.
+	Upgrade(name, namespace, valueFile, chartName string) error
+	// Rollback rolls back the release to the revision, 0 means the previous revision.
+	Rollback(name, namespace string, revision int) error
+	// Check checks if the release is deployed. A release failing to install is deleted and reported as not existing.
+	Check(name, namespace string) (exist bool, err error)
+	// Delete deletes the release.
+	Delete(name, namespace string) error
+	// List lists all the releases in the namespace.
+	List(namespace string) ([]Release, error)
+}
+
+var (
+	releaseManager     ReleaseManager
+	releaseManagerLock sync.RWMutex
+)
+
+// GetReleaseManager returns the ReleaseManager used by the package level functions.
+func GetReleaseManager() ReleaseManager {
+	releaseManagerLock.RLock()
+	manager := releaseManager
+	releaseManagerLock.RUnlock()
+	if manager != nil {
+		return manager
+	}
+
+	releaseManagerLock.Lock()
+	defer releaseManagerLock.Unlock()
+	if releaseManager == nil {
+		managerType := os.Getenv(EnvReleaseManager)
+		if managerType == ReleaseManagerCmd {
+			releaseManager = NewCmdReleaseManager()
+		} else {
+			managerType = ReleaseManagerSDK
+			releaseManager = NewSDKReleaseManager()
+		}
+		log.Info("helm release manager initialized", "type", managerType)
+	}
+	return releaseManager
+}
+
+// SetReleaseManager replaces the ReleaseManager used by the package level functions, e.g. with a fake one in unit tests.
+func SetReleaseManager(manager ReleaseManager) {
+	releaseManagerLock.Lock()
+	defer releaseManagerLock.Unlock()
+	releaseManager = manager
+}
@@ -0,0 +1,171 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helm
+
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+
+	"github.com/agiledragon/gomonkey/v2"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"helm.sh/helm/v3/pkg/release"
+)
+
+var _ = Describe("ReleaseManager", func() {
+	Describe("ReleaseError", func() {
+		It("should be recognized through the error chain", func() {
+			err := fmt.Errorf("failed to setup master: %w", NewReleaseError(ReasonReleaseNotFound, "fluid", "default", errors.New("release: not found")))
+			Expect(IsReleaseNotFound(err)).To(BeTrue())
+			Expect(IsInstallFailed(err)).To(BeFalse())
+			Expect(err.Error()).To(ContainSubstring("ReleaseNotFound: release default/fluid"))
+			Expect(ReasonForError(errors.New("other error"))).To(BeEmpty())
+		})
+	})
+
+	Describe("FakeReleaseManager", func() {
+		var manager *FakeReleaseManager
+
+		BeforeEach(func() {
+			manager = NewFakeReleaseManager(Release{Name: "existing", Namespace: "default"})
+		})
+
+		It("should install, upgrade, rollback and delete releases", func() {
+			Expect(manager.Install("fluid", "default", "values.yaml", "/charts/v1")).To(Succeed())
+			Expect(IsInstallFailed(manager.Install("fluid", "default", "values.yaml", "/charts/v1"))).To(BeTrue())
+
+			Expect(manager.Upgrade("fluid", "default", "values.yaml", "/charts/v2")).To(Succeed())
+			rel, found := manager.Get("fluid", "default")
+			Expect(found).To(BeTrue())
+			Expect(rel.Revision).To(Equal(2))
+			Expect(rel.Chart).To(Equal("/charts/v2"))
+
+			Expect(manager.Rollback("fluid", "default", 0)).To(Succeed())
+			rel, _ = manager.Get("fluid", "default")
+			Expect(rel.Revision).To(Equal(3))
+			Expect(rel.Chart).To(Equal("/charts/v1"))
+			Expect(IsRollbackFailed(manager.Rollback("fluid", "default", 5))).To(BeTrue())
+
+			releases, err := manager.List("default")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(releases).To(HaveLen(2))
+
+			Expect(manager.Delete("fluid", "default")).To(Succeed())
+			exist, err := manager.Check("fluid", "default")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(exist).To(BeFalse())
+			Expect(IsReleaseNotFound(manager.Delete("fluid", "default"))).To(BeTrue())
+			Expect(IsReleaseNotFound(manager.Upgrade("fluid", "default", "values.yaml", "/charts/v2"))).To(BeTrue())
+		})
+
+		It("should return the injected errors", func() {
+			manager.SetError("Check", errors.New("unreachable"))
+			_, err := manager.Check("existing", "default")
+			Expect(err).To(HaveOccurred())
+
+			manager.SetError("Check", nil)
+			exist, err := manager.Check("existing", "default")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(exist).To(BeTrue())
+		})
+
+		It("should be used by the package level functions", func() {
+			defer SetReleaseManager(NewCmdReleaseManager())
+			SetReleaseManager(manager)
+
+			Expect(InstallRelease("fluid", "default", "values.yaml", "/charts/v1")).To(Succeed())
+			Expect(UpgradeRelease("fluid", "default", "values.yaml", "/charts/v2")).To(Succeed())
+			Expect(RollbackRelease("fluid", "default", 1)).To(Succeed())
+			Expect(DeleteReleaseIfExists("fluid", "default")).To(Succeed())
+			Expect(DeleteReleaseIfExists("fluid", "default")).To(Succeed())
+		})
+	})
+
+	Describe("CmdReleaseManager", func() {
+		var (
+			lookPathPatch      *gomonkey.Patches
+			combineOutputPatch *gomonkey.Patches
+			outputPatch        *gomonkey.Patches
+			manager            *CmdReleaseManager
+		)
+
+		BeforeEach(func() {
+			manager = NewCmdReleaseManager()
+			lookPathPatch = gomonkey.ApplyFunc(exec.LookPath, func(file string) (string, error) {
+				return "test-path", nil
+			})
+		})
+
+		AfterEach(func() {
+			lookPathPatch.Reset()
+			if combineOutputPatch != nil {
+				combineOutputPatch.Reset()
+			}
+			if outputPatch != nil {
+				outputPatch.Reset()
+			}
+		})
+
+		It("should return typed error when upgrading a release not found", func() {
+			combineOutputPatch = gomonkey.ApplyMethod((*exec.Cmd)(nil), "CombinedOutput", func(cmd *exec.Cmd) ([]byte, error) {
+				return []byte(`Error: UPGRADE FAILED: "fluid" has no deployed releases`), errors.New("exit status 1")
+			})
+
+			err := manager.Upgrade("fluid", "default", "values.yaml", "fluid")
+			Expect(IsReleaseNotFound(err)).To(BeTrue())
+		})
+
+		It("should return typed error when rollback fails", func() {
+			combineOutputPatch = gomonkey.ApplyMethod((*exec.Cmd)(nil), "CombinedOutput", func(cmd *exec.Cmd) ([]byte, error) {
+				return []byte("Error: release has no 3 version"), errors.New("exit status 1")
+			})
+
+			err := manager.Rollback("fluid", "default", 3)
+			Expect(IsRollbackFailed(err)).To(BeTrue())
+		})
+
+		It("should list releases from json output", func() {
+			outputPatch = gomonkey.ApplyMethod((*exec.Cmd)(nil), "Output", func(cmd *exec.Cmd) ([]byte, error) {
+				return []byte(`[{"name":"fluid","namespace":"default","revision":"2","updated":"2023-03-16 17:08:06.123456789 +0800 CST","status":"deployed","chart":"fluid-1.0.0","app_version":"1.0.0"}]`), nil
+			})
+
+			releases, err := manager.List("default")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(releases).To(HaveLen(1))
+			Expect(releases[0].Revision).To(Equal(2))
+			Expect(releases[0].AppVersion).To(Equal("1.0.0"))
+			Expect(releases[0].Updated.IsZero()).To(BeFalse())
+		})
+	})
+
+	Describe("isInstalled", func() {
+		It("should treat the deployed or superseded first revision as installed", func() {
+			Expect(isInstalled(&release.Release{Version: 1, Info: &release.Info{Status: release.StatusDeployed}})).To(BeTrue())
+			Expect(isInstalled(&release.Release{Version: 1, Info: &release.Info{Status: release.StatusSuperseded}})).To(BeTrue())
+			Expect(isInstalled(&release.Release{Version: 1, Info: &release.Info{Status: release.StatusFailed}})).To(BeFalse())
+			Expect(isInstalled(&release.Release{Version: 1, Info: &release.Info{Status: release.StatusPendingInstall}})).To(BeFalse())
+		})
+	})
+
+	Describe("SDKReleaseManager", func() {
+		It("should return typed error when the chart does not exist", func() {
+			err := NewSDKReleaseManager().Install("fluid", "default", "", "/not/exist/chart")
+			Expect(IsChartNotFound(err)).To(BeTrue())
+		})
+	})
+})
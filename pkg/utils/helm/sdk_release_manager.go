@@ -0,0 +1,252 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package helm
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"helm.sh/helm/v3/pkg/action"
+	"helm.sh/helm/v3/pkg/chart"
+	"helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/chartutil"
+	"helm.sh/helm/v3/pkg/release"
+	"helm.sh/helm/v3/pkg/storage/driver"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+)
+
+// SDKReleaseManager manages releases in process with the Helm SDK. The storage driver
+// is configured by the HELM_DRIVER env variable, the same as the helm binary.
+type SDKReleaseManager struct {
+	driver string
+}
+
+var _ ReleaseManager = &SDKReleaseManager{}
+
+// NewSDKReleaseManager creates the SDKReleaseManager
+func NewSDKReleaseManager() *SDKReleaseManager {
+	return &SDKReleaseManager{
+		driver: os.Getenv("HELM_DRIVER"),
+	}
+}
+
+// actionConfig builds the action configuration of the namespace for a single call, because the
+// configuration is mutated by the actions and can't be shared by the concurrent reconciles
+func (m *SDKReleaseManager) actionConfig(namespace string) (*action.Configuration, error) {
+	flags := genericclioptions.NewConfigFlags(true)
+	flags.Namespace = &namespace
+	cfg := new(action.Configuration)
+	debugLog := func(format string, v ...interface{}) {
+		log.V(1).Info(fmt.Sprintf(format, v...), "namespace", namespace)
+	}
+	if err := cfg.Init(flags, namespace, m.driver, debugLog); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// Install installs the chart as a release, the failed release is deleted.
+func (m *SDKReleaseManager) Install(name, namespace, valueFile, chartName string) error {
+	defer utils.TimeTrack(time.Now(), "Helm.SDK.Install", "name", name, "namespace", namespace)
+	chrt, values, err := loadChartAndValues(name, namespace, valueFile, chartName)
+	if err != nil {
+		return err
+	}
+	cfg, err := m.actionConfig(namespace)
+	if err != nil {
+		return NewReleaseError(ReasonQueryFailed, name, namespace, err)
+	}
+
+	install := action.NewInstall(cfg)
+	install.ReleaseName = name
+	install.Namespace = namespace
+	if _, err = install.Run(chrt, values); err != nil {
+		log.Error(err, "failed to install release", "name", name, "namespace", namespace, "chart", chartName)
+		if rollbackErr := m.deleteIfExists(name, namespace); rollbackErr != nil {
+			log.Error(rollbackErr, "failed to rollback installed helm release after Install() failure", "name", name, "namespace", namespace)
+		}
+		return NewReleaseError(ReasonInstallFailed, name, namespace, err)
+	}
+	return nil
+}
+
+// Upgrade upgrades the release with the chart and values file.
+func (m *SDKReleaseManager) Upgrade(name, namespace, valueFile, chartName string) error {
+	defer utils.TimeTrack(time.Now(), "Helm.SDK.Upgrade", "name", name, "namespace", namespace)
+	chrt, values, err := loadChartAndValues(name, namespace, valueFile, chartName)
+	if err != nil {
+		return err
+	}
+	cfg, err := m.actionConfig(namespace)
+	if err != nil {
+		return NewReleaseError(ReasonQueryFailed, name, namespace, err)
+	}
+
+	upgrade := action.NewUpgrade(cfg)
+	upgrade.Namespace = namespace
+	if _, err = upgrade.Run(name, chrt, values); err != nil {
+		if errors.Is(err, driver.ErrReleaseNotFound) || errors.Is(err, driver.ErrNoDeployedReleases) {
+			return NewReleaseError(ReasonReleaseNotFound, name, namespace, err)
+		}
+		return NewReleaseError(ReasonUpgradeFailed, name, namespace, err)
+	}
+	return nil
+}
+
+// Rollback rolls back the release to the revision, 0 means the previous revision.
+func (m *SDKReleaseManager) Rollback(name, namespace string, revision int) error {
+	defer utils.TimeTrack(time.Now(), "Helm.SDK.Rollback", "name", name, "namespace", namespace)
+	cfg, err := m.actionConfig(namespace)
+	if err != nil {
+		return NewReleaseError(ReasonQueryFailed, name, namespace, err)
+	}
+
+	rollback := action.NewRollback(cfg)
+	rollback.Version = revision
+	if err = rollback.Run(name); err != nil {
+		if errors.Is(err, driver.ErrReleaseNotFound) {
+			return NewReleaseError(ReasonReleaseNotFound, name, namespace, err)
+		}
+		return NewReleaseError(ReasonRollbackFailed, name, namespace, err)
+	}
+	return nil
+}
+
+// Check checks if the release is deployed by the status of its first revision, the same as
+// helm status --revision 1. The release which failed to install is deleted, while the release
+// which failed to upgrade or roll back still exists.
+func (m *SDKReleaseManager) Check(name, namespace string) (exist bool, err error) {
+	defer utils.TimeTrack(time.Now(), "Helm.SDK.Check", "name", name, "namespace", namespace)
+	cfg, err := m.actionConfig(namespace)
+	if err != nil {
+		return false, NewReleaseError(ReasonQueryFailed, name, namespace, err)
+	}
+
+	status := action.NewStatus(cfg)
+	status.Version = 1
+	rel, err := status.Run(name)
+	if err != nil {
+		if errors.Is(err, driver.ErrReleaseNotFound) {
+			return false, nil
+		}
+		return false, NewReleaseError(ReasonQueryFailed, name, namespace, err)
+	}
+
+	if rel.Info == nil {
+		return false, nil
+	}
+	if isInstalled(rel) {
+		return true, nil
+	}
+	if err = m.Delete(name, namespace); err != nil {
+		return false, err
+	}
+	return false, nil
+}
+
+// Delete deletes the release.
+func (m *SDKReleaseManager) Delete(name, namespace string) error {
+	defer utils.TimeTrack(time.Now(), "Helm.SDK.Delete", "name", name, "namespace", namespace)
+	cfg, err := m.actionConfig(namespace)
+	if err != nil {
+		return NewReleaseError(ReasonQueryFailed, name, namespace, err)
+	}
+
+	if _, err = action.NewUninstall(cfg).Run(name); err != nil {
+		if errors.Is(err, driver.ErrReleaseNotFound) {
+			return NewReleaseError(ReasonReleaseNotFound, name, namespace, err)
+		}
+		return NewReleaseError(ReasonDeleteFailed, name, namespace, err)
+	}
+	log.Info("release deleted", "name", name, "namespace", namespace)
+	return nil
+}
+
+// List lists all the releases in the namespace.
+func (m *SDKReleaseManager) List(namespace string) ([]Release, error) {
+	cfg, err := m.actionConfig(namespace)
+	if err != nil {
+		return nil, NewReleaseError(ReasonQueryFailed, "", namespace, err)
+	}
+
+	list := action.NewList(cfg)
+	list.All = true
+	list.SetStateMask()
+	rels, err := list.Run()
+	if err != nil {
+		return nil, NewReleaseError(ReasonQueryFailed, "", namespace, err)
+	}
+
+	releases := make([]Release, 0, len(rels))
+	for _, rel := range rels {
+		releases = append(releases, toRelease(rel))
+	}
+	return releases, nil
+}
+
+func (m *SDKReleaseManager) deleteIfExists(name, namespace string) error {
+	err := m.Delete(name, namespace)
+	if IsReleaseNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+func loadChartAndValues(name, namespace, valueFile, chartName string) (*chart.Chart, map[string]interface{}, error) {
+	chrt, err := loader.Load(chartName)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil, NewReleaseError(ReasonChartNotFound, name, namespace, err)
+		}
+		return nil, nil, NewReleaseError(ReasonChartNotFound, name, namespace, fmt.Errorf("failed to load chart %s: %w", chartName, err))
+	}
+
+	values := chartutil.Values{}
+	if valueFile != "" {
+		if values, err = chartutil.ReadValuesFile(valueFile); err != nil {
+			return nil, nil, NewReleaseError(ReasonInvalidValues, name, namespace, err)
+		}
+	}
+	return chrt, values, nil
+}
+
+// isInstalled returns true if the first revision of the release is deployed successfully,
+// it's superseded once the release is upgraded or rolled back
+func isInstalled(rel *release.Release) bool {
+	return rel.Info.Status == release.StatusDeployed || rel.Info.Status == release.StatusSuperseded
+}
+
+func toRelease(rel *release.Release) Release {
+	r := Release{
+		Name:      rel.Name,
+		Namespace: rel.Namespace,
+		Revision:  rel.Version,
+	}
+	if rel.Info != nil {
+		r.Status = rel.Info.Status.String()
+		r.Updated = rel.Info.LastDeployed.Time
+	}
+	if rel.Chart != nil && rel.Chart.Metadata != nil {
+		r.Chart = fmt.Sprintf("%s-%s", rel.Chart.Metadata.Name, rel.Chart.Metadata.Version)
+		r.AppVersion = rel.Chart.Metadata.AppVersion
+	}
+	return r
+}
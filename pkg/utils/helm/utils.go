@@ -17,18 +17,10 @@ limitations under the License.
 package helm
 
 import (
-	"fmt"
-	"time"
-
-	"os"
-	"os/exec"
+	"strconv"
 	"strings"
-	"syscall"
 
-	"github.com/fluid-cloudnative/fluid/pkg/utils"
-	"github.com/fluid-cloudnative/fluid/pkg/utils/cmdguard"
 	"github.com/go-logr/logr"
-	"github.com/pkg/errors"
 	ctrl "sigs.k8s.io/controller-runtime"
 )
 
@@ -40,246 +32,87 @@ func init() {
 
 var helmCmd = []string{"ddc-helm"}
 
-// InstallRelease installs the release with cmd: helm install -f values.yaml chart_name, support helm v3
+// InstallRelease installs the release with the default ReleaseManager, the failed release is deleted.
 func InstallRelease(name string, namespace string, valueFile string, chartName string) error {
-	defer utils.TimeTrack(time.Now(), "Helm.InstallRelease", "name", name, "namespace", namespace)
-	binary, err := exec.LookPath(helmCmd[0])
-	if err != nil {
-		return err
-	}
-
-	// 3. check if the chart file exists, if it's unix path, then check if it's exist
-	if strings.HasPrefix(chartName, "/") {
-		if _, err = os.Stat(chartName); os.IsNotExist(err) {
-			// TODO: the chart will be put inside the binary in future
-			return err
-		}
-	}
-
-	// 4. prepare the arguments
-	args := []string{"install", "-f", valueFile, "--namespace", namespace, name, chartName}
-
-	// env := os.Environ()
-	// if types.KubeConfig != "" {
-	// 	env = append(env, fmt.Sprintf("KUBECONFIG=%s", types.KubeConfig))
-	// }
-
-	// return syscall.Exec(cmd, args, env)
-	// 5. execute the command
-	cmd, err := cmdguard.Command(binary, args...)
-	if err != nil {
-		return err
-	}
-	log.Info("Exec", "command", cmd.String())
-	// cmd.Env = env
-	out, err := cmd.CombinedOutput()
-	log.Info(string(out))
-
-	if err != nil {
-		log.Error(err, "failed to execute InstallRelease() command", "command", cmd.String())
-		err = fmt.Errorf("failed to install kubernetes resources of %s: %s", chartName, string(out))
+	return GetReleaseManager().Install(name, namespace, valueFile, chartName)
+}
 
-		rollbackErr := DeleteReleaseIfExists(name, namespace)
-		if rollbackErr != nil {
-			log.Error(err, "failed to rollback installed helm release after InstallRelease() failure", "name", name, "namespace", namespace)
-		}
-		return err
-	}
+// UpgradeRelease upgrades the release with the default ReleaseManager.
+func UpgradeRelease(name string, namespace string, valueFile string, chartName string) error {
+	return GetReleaseManager().Upgrade(name, namespace, valueFile, chartName)
+}
 
-	return nil
+// RollbackRelease rolls back the release to the revision with the default ReleaseManager, 0 means the previous revision.
+func RollbackRelease(name string, namespace string, revision int) error {
+	return GetReleaseManager().Rollback(name, namespace, revision)
 }
 
 // CheckRelease checks if the release with the given name and namespace exist.
 func CheckRelease(name, namespace string) (exist bool, err error) {
-	defer utils.TimeTrack(time.Now(), "Helm.CheckRelease", "name", name, "namespace", namespace)
-	_, err = exec.LookPath(helmCmd[0])
-	if err != nil {
-		return exist, err
-	}
-
-	cmd, err := cmdguard.Command(helmCmd[0], "status", name, "-n", namespace, "--revision", "1")
-	if err != nil {
-		return exist, err
-	}
-	// support multiple cluster management
-	// if types.KubeConfig != "" {
-	// 	cmd.Env = append(cmd.Env, fmt.Sprintf("KUBECONFIG=%s", types.KubeConfig))
-	// }
-
-	resultBytes, err := cmd.CombinedOutput()
-	if err != nil {
-		if exiterr, ok := err.(*exec.ExitError); ok {
-			if status, ok := exiterr.Sys().(syscall.WaitStatus); ok {
-				exitStatus := status.ExitStatus()
-				log.V(1).Info("Exit", "Status", exitStatus)
-				if exitStatus == 1 {
-					err = nil
-				}
-			}
-		} else {
-			log.Error(err, "failed to execute CheckRelease() command", "command", cmd.String())
-			return exist, err
-		}
-	} else {
-		waitStatus := cmd.ProcessState.Sys().(syscall.WaitStatus)
-		if waitStatus.ExitStatus() == 0 {
-			// ####### helm status output ######
-			// NAME: demo-dataset
-			// LAST DEPLOYED: Thu Mar 16 17:08:06 2023
-			// NAMESPACE: default
-			// STATUS: deployed
-			// REVISION: 1
-			// TEST SUITE: None
-			// ####### END #######
-			resultLines := strings.Split(string(resultBytes), "\n")
-			for _, line := range resultLines {
-				if strings.HasPrefix(line, "STATUS: ") {
-					if strings.Replace(line, "STATUS: ", "", 1) == "deployed" {
-						exist = true
-					} else {
-						rollbackErr := DeleteRelease(name, namespace)
-						if rollbackErr != nil {
-							err = errors.Wrapf(rollbackErr, "failed to rollback failed release (namespace: %s, name: %s)", namespace, name)
-						}
-					}
-				}
-			}
-		} else {
-			if waitStatus.ExitStatus() != -1 {
-				return exist, fmt.Errorf("unexpected return code %d when exec helm status %s -n %s",
-					waitStatus.ExitStatus(),
-					name,
-					namespace)
-			}
-		}
-	}
-
-	return exist, err
+	return GetReleaseManager().Check(name, namespace)
 }
 
 // DeleteRelease deletes release with the name and namespace
 func DeleteRelease(name, namespace string) error {
-	defer utils.TimeTrack(time.Now(), "Helm.DeleteRelease", "name", name, "namespace", namespace)
-	binary, err := exec.LookPath(helmCmd[0])
-	if err != nil {
-		return err
-	}
-
-	args := []string{"uninstall", name, "-n", namespace}
-	cmd, err := cmdguard.Command(binary, args...)
-	if err != nil {
-		return err
-	}
-	log.Info("Exec", "command", cmd.String())
-	// env := os.Environ()
-	// if types.KubeConfig != "" {
-	// 	env = append(env, fmt.Sprintf("KUBECONFIG=%s", types.KubeConfig))
-	// }
-	// return syscall.Exec(cmd, args, env)
-	out, err := cmd.Output()
-	log.Info("delete release", "result", string(out))
-	if err != nil {
-		log.Error(err, "failed to execute DeleteRelease() command", "command", cmd.String())
-		return fmt.Errorf("failed to delete engine-related kubernetes resources")
-	}
-	return nil
+	return GetReleaseManager().Delete(name, namespace)
 }
 
 // ListReleases return an array with all releases' names in a given namespace
 func ListReleases(namespace string) (releases []string, err error) {
 	releases = []string{}
-	_, err = exec.LookPath(helmCmd[0])
+	rels, err := listReleases(namespace, false)
 	if err != nil {
 		return releases, err
 	}
-
-	cmd, err := cmdguard.Command(helmCmd[0], "list", "-q", "-n", namespace)
-	if err != nil {
-		return releases, err
-	}
-	// support multiple cluster management
-	// if types.KubeConfig != "" {
-	// 	cmd.Env = append(cmd.Env, fmt.Sprintf("KUBECONFIG=%s", types.KubeConfig))
-	// }
-	out, err := cmd.Output()
-	if err != nil {
-		return releases, err
+	for _, rel := range rels {
+		releases = append(releases, rel.Name)
 	}
-	return strings.Split(string(out), "\n"), nil
+	return releases, nil
 }
 
 // ListReleaseMap returns a map with all releases' names and app versions in a given namespace.
 func ListReleaseMap(namespace string) (releaseMap map[string]string, err error) {
 	releaseMap = map[string]string{}
-	_, err = exec.LookPath(helmCmd[0])
-	if err != nil {
-		return releaseMap, err
-	}
-
-	cmd, err := cmdguard.Command(helmCmd[0], "list", "-n", namespace)
+	rels, err := listReleases(namespace, false)
 	if err != nil {
 		return releaseMap, err
 	}
-	// // support multiple cluster management
-	// if types.KubeConfig != "" {
-	// 	cmd.Env = append(cmd.Env, fmt.Sprintf("KUBECONFIG=%s", types.KubeConfig))
-	// }
-	out, err := cmd.Output()
-	if err != nil {
-		return releaseMap, err
+	for _, rel := range rels {
+		releaseMap[rel.Name] = rel.AppVersion
 	}
-	lines := strings.Split(string(out), "\n")
-
-	for _, line := range lines {
-		line = strings.Trim(line, " ")
-		if !strings.Contains(line, "NAME") {
-			cols := strings.Fields(line)
-			// log.Debugf("%d cols: %v", len(cols), cols)
-			if len(cols) > 1 {
-				// log.Debugf("releaseMap: %s=%s\n", cols[0], cols[len(cols)-1])
-				releaseMap[cols[0]] = cols[len(cols)-1]
-			}
-		}
-	}
-
 	return releaseMap, nil
 }
 
-// ListAllReleasesWithDetail returns a map with all releases' names and other info in a given namespace
+// ListAllReleasesWithDetail returns a map with all releases' names and other info in a given namespace,
+// the info is in the columns of helm list: NAME NAMESPACE REVISION UPDATED STATUS CHART APP VERSION
 func ListAllReleasesWithDetail(namespace string) (releaseMap map[string][]string, err error) {
 	releaseMap = map[string][]string{}
-	_, err = exec.LookPath(helmCmd[0])
+	rels, err := listReleases(namespace, true)
 	if err != nil {
 		return releaseMap, err
 	}
-
-	cmd, err := cmdguard.Command(helmCmd[0], "list", "--all", "-n", namespace)
-	if err != nil {
-		return releaseMap, err
-	}
-	// support multiple cluster management
-	// if types.KubeConfig != "" {
-	// 	cmd.Env = append(cmd.Env, fmt.Sprintf("KUBECONFIG=%s", types.KubeConfig))
-	// }
-	out, err := cmd.Output()
-	if err != nil {
-		return releaseMap, err
+	for _, rel := range rels {
+		cols := []string{rel.Name, rel.Namespace, strconv.Itoa(rel.Revision)}
+		cols = append(cols, strings.Fields(rel.Updated.Format(releaseUpdatedLayout))...)
+		releaseMap[rel.Name] = append(cols, rel.Status, rel.Chart, rel.AppVersion)
 	}
-	lines := strings.Split(string(out), "\n")
+	return releaseMap, nil
+}
 
-	for _, line := range lines {
-		line = strings.Trim(line, " ")
-		if !strings.Contains(line, "NAME") {
-			cols := strings.Fields(line)
-			// log.Debugf("%d cols: %v", len(cols), cols)
-			if len(cols) > 3 {
-				// log.Debugf("releaseMap: %s=%s\n", cols[0], cols)
-				releaseMap[cols[0]] = cols
-			}
+// listReleases lists the releases in the namespace with the default ReleaseManager. Like helm list,
+// only the deployed and failed releases are listed unless all is true.
+func listReleases(namespace string, all bool) ([]Release, error) {
+	rels, err := GetReleaseManager().List(namespace)
+	if err != nil || all {
+		return rels, err
+	}
+	listed := make([]Release, 0, len(rels))
+	for _, rel := range rels {
+		if rel.Status == StatusDeployed || rel.Status == StatusFailed {
+			listed = append(listed, rel)
 		}
 	}
-
-	return releaseMap, nil
+	return listed, nil
 }
 
 // DeleteReleaseIfExists deletes a release with given name and namespace if it exists.
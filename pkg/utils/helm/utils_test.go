@@ -20,6 +20,7 @@ import (
 	"errors"
 	"os"
 	"os/exec"
+	"time"
 
 	"github.com/agiledragon/gomonkey/v2"
 	. "github.com/onsi/ginkgo/v2"
@@ -263,202 +264,106 @@ var _ = Describe("Helm", func() {
 	})
 
 	Describe("ListReleases", func() {
-		var (
-			lookPathPatch *gomonkey.Patches
-			outputPatch   *gomonkey.Patches
-		)
+		var manager *FakeReleaseManager
 
-		AfterEach(func() {
-			if lookPathPatch != nil {
-				lookPathPatch.Reset()
-			}
-			if outputPatch != nil {
-				outputPatch.Reset()
-			}
+		BeforeEach(func() {
+			manager = NewFakeReleaseManager(
+				Release{Name: "fluid", Namespace: "default", AppVersion: "v0.6.0"},
+				Release{Name: "spark", Namespace: "default", Status: StatusFailed, AppVersion: "v0.5.0"},
+				Release{Name: "hbase", Namespace: "default", Status: StatusPendingInstall},
+				Release{Name: "other", Namespace: "other"},
+			)
+			SetReleaseManager(manager)
 		})
 
-		Context("when LookPath fails", func() {
-			It("should return an error", func() {
-				lookPathPatch = gomonkey.ApplyFunc(exec.LookPath, func(file string) (string, error) {
-					return "", errors.New("fail to run the command")
-				})
-
-				_, err := ListReleases("default")
-				Expect(err).To(HaveOccurred())
-			})
+		AfterEach(func() {
+			SetReleaseManager(NewCmdReleaseManager())
 		})
 
-		Context("when Output fails", func() {
+		Context("when List fails", func() {
 			It("should return an error", func() {
-				lookPathPatch = gomonkey.ApplyFunc(exec.LookPath, func(file string) (string, error) {
-					return "test-path", nil
-				})
-				outputPatch = gomonkey.ApplyMethod((*exec.Cmd)(nil), "Output", func(cmd *exec.Cmd) ([]byte, error) {
-					return nil, errors.New("fail to run the command")
-				})
+				manager.SetError("List", errors.New("fail to list releases"))
 
 				_, err := ListReleases("default")
 				Expect(err).To(HaveOccurred())
 			})
 		})
 
-		Context("when namespace contains invalid characters", func() {
-			It("should return an error", func() {
-				lookPathPatch = gomonkey.ApplyFunc(exec.LookPath, func(file string) (string, error) {
-					return "test-path", nil
-				})
-
-				_, err := ListReleases("def$ault")
-				Expect(err).To(HaveOccurred())
-			})
-		})
-
 		Context("when all conditions are met", func() {
-			It("should list releases successfully", func() {
-				lookPathPatch = gomonkey.ApplyFunc(exec.LookPath, func(file string) (string, error) {
-					return "test-path", nil
-				})
-				outputPatch = gomonkey.ApplyMethod((*exec.Cmd)(nil), "Output", func(cmd *exec.Cmd) ([]byte, error) {
-					return []byte("fluid:v0.6.0\nfluid:v0.5.0"), nil
-				})
-
+			It("should list the deployed and failed releases", func() {
 				release, err := ListReleases("default")
 				Expect(err).ToNot(HaveOccurred())
-				Expect(release).To(HaveLen(2))
+				Expect(release).To(Equal([]string{"fluid", "spark"}))
 			})
 		})
 	})
 
 	Describe("ListReleaseMap", func() {
-		var (
-			lookPathPatch *gomonkey.Patches
-			outputPatch   *gomonkey.Patches
-		)
+		var manager *FakeReleaseManager
 
-		AfterEach(func() {
-			if lookPathPatch != nil {
-				lookPathPatch.Reset()
-			}
-			if outputPatch != nil {
-				outputPatch.Reset()
-			}
+		BeforeEach(func() {
+			manager = NewFakeReleaseManager(
+				Release{Name: "fluid", Namespace: "default", AppVersion: "v0.6.0"},
+				Release{Name: "spark", Namespace: "default", AppVersion: "v0.5.0"},
+			)
+			SetReleaseManager(manager)
 		})
 
-		Context("when LookPath fails", func() {
-			It("should return an error", func() {
-				lookPathPatch = gomonkey.ApplyFunc(exec.LookPath, func(file string) (string, error) {
-					return "", errors.New("fail to run the command")
-				})
-
-				_, err := ListReleaseMap("default")
-				Expect(err).To(HaveOccurred())
-			})
+		AfterEach(func() {
+			SetReleaseManager(NewCmdReleaseManager())
 		})
 
-		Context("when Output fails", func() {
+		Context("when List fails", func() {
 			It("should return an error", func() {
-				lookPathPatch = gomonkey.ApplyFunc(exec.LookPath, func(file string) (string, error) {
-					return "test-path", nil
-				})
-				outputPatch = gomonkey.ApplyMethod((*exec.Cmd)(nil), "Output", func(cmd *exec.Cmd) ([]byte, error) {
-					return nil, errors.New("fail to run the command")
-				})
+				manager.SetError("List", errors.New("fail to list releases"))
 
 				_, err := ListReleaseMap("default")
 				Expect(err).To(HaveOccurred())
 			})
 		})
 
-		Context("when namespace contains invalid characters", func() {
-			It("should return an error", func() {
-				lookPathPatch = gomonkey.ApplyFunc(exec.LookPath, func(file string) (string, error) {
-					return "test-path", nil
-				})
-
-				_, err := ListReleaseMap("def$ault")
-				Expect(err).To(HaveOccurred())
-			})
-		})
-
 		Context("when all conditions are met", func() {
 			It("should list release map successfully", func() {
-				lookPathPatch = gomonkey.ApplyFunc(exec.LookPath, func(file string) (string, error) {
-					return "test-path", nil
-				})
-				outputPatch = gomonkey.ApplyMethod((*exec.Cmd)(nil), "Output", func(cmd *exec.Cmd) ([]byte, error) {
-					return []byte("fluid v0.6.0\nspark v0.5.0"), nil
-				})
-
 				release, err := ListReleaseMap("default")
 				Expect(err).ToNot(HaveOccurred())
-				Expect(release).To(HaveLen(2))
+				Expect(release).To(Equal(map[string]string{"fluid": "v0.6.0", "spark": "v0.5.0"}))
 			})
 		})
 	})
 
 	Describe("ListAllReleasesWithDetail", func() {
-		var (
-			lookPathPatch *gomonkey.Patches
-			outputPatch   *gomonkey.Patches
-		)
+		var manager *FakeReleaseManager
 
-		AfterEach(func() {
-			if lookPathPatch != nil {
-				lookPathPatch.Reset()
-			}
-			if outputPatch != nil {
-				outputPatch.Reset()
-			}
+		BeforeEach(func() {
+			updated, err := time.Parse(releaseUpdatedLayout, "2021-07-19 16:20:16.166658248 +0800 CST")
+			Expect(err).NotTo(HaveOccurred())
+			manager = NewFakeReleaseManager(
+				Release{Name: "fluid", Namespace: "default", Chart: "fluid-0.6.0", AppVersion: "0.6.0-3c06c0e", Updated: updated},
+				Release{Name: "spark", Namespace: "default", Revision: 2, Status: StatusPendingUpgrade, Chart: "spark-0.3.0", AppVersion: "0.3.0-3c06c0e", Updated: updated},
+			)
+			SetReleaseManager(manager)
 		})
 
-		Context("when LookPath fails", func() {
-			It("should return an error", func() {
-				lookPathPatch = gomonkey.ApplyFunc(exec.LookPath, func(file string) (string, error) {
-					return "", errors.New("fail to run the command")
-				})
-
-				_, err := ListAllReleasesWithDetail("default")
-				Expect(err).To(HaveOccurred())
-			})
+		AfterEach(func() {
+			SetReleaseManager(NewCmdReleaseManager())
 		})
 
-		Context("when Output fails", func() {
+		Context("when List fails", func() {
 			It("should return an error", func() {
-				lookPathPatch = gomonkey.ApplyFunc(exec.LookPath, func(file string) (string, error) {
-					return "test-path", nil
-				})
-				outputPatch = gomonkey.ApplyMethod((*exec.Cmd)(nil), "Output", func(cmd *exec.Cmd) ([]byte, error) {
-					return nil, errors.New("fail to run the command")
-				})
+				manager.SetError("List", errors.New("fail to list releases"))
 
 				_, err := ListAllReleasesWithDetail("default")
 				Expect(err).To(HaveOccurred())
 			})
 		})
 
-		Context("when namespace contains invalid characters", func() {
-			It("should return an error", func() {
-				lookPathPatch = gomonkey.ApplyFunc(exec.LookPath, func(file string) (string, error) {
-					return "test-path", nil
-				})
-
-				_, err := ListAllReleasesWithDetail("def$ault")
-				Expect(err).To(HaveOccurred())
-			})
-		})
-
 		Context("when all conditions are met", func() {
 			It("should list all releases with detail successfully", func() {
-				lookPathPatch = gomonkey.ApplyFunc(exec.LookPath, func(file string) (string, error) {
-					return "test-path", nil
-				})
-				outputPatch = gomonkey.ApplyMethod((*exec.Cmd)(nil), "Output", func(cmd *exec.Cmd) ([]byte, error) {
-					return []byte("fluid default 1 2021-07-19 16:20:16.166658248 +0800 CST deployed fluid-0.6.0 0.6.0-3c06c0e\nspark default 2 2021-07-19 16:20:16.166658248 +0800 CST deployed spark-0.3.0 0.3.0-3c06c0e"), nil
-				})
-
 				release, err := ListAllReleasesWithDetail("default")
 				Expect(err).ToNot(HaveOccurred())
 				Expect(release).To(HaveLen(2))
+				Expect(release["spark"]).To(Equal([]string{"spark", "default", "2", "2021-07-19", "16:20:16.166658248", "+0800", "CST",
+					StatusPendingUpgrade, "spark-0.3.0", "0.3.0-3c06c0e"}))
 			})
 		})
 	})
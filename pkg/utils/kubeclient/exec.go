@@ -33,6 +33,8 @@ import (
 	"github.com/fluid-cloudnative/fluid/pkg/utils"
 	"github.com/fluid-cloudnative/fluid/pkg/utils/cmdguard"
 	securityutils "github.com/fluid-cloudnative/fluid/pkg/utils/security"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/tracing"
+	"go.opentelemetry.io/otel/attribute"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/scheme"
@@ -121,8 +123,15 @@ func initClient() error {
 // ExecWithOptions executes a command in the specified container,
 // returning stdout, stderr and error. `options` allowed for
 // additional parameters to be passed.
-func ExecWithOptions(ctx context.Context, options ExecOptions) (string, string, error) {
-	err := cmdguard.ValidateCommandSlice(options.Command)
+func ExecWithOptions(ctx context.Context, options ExecOptions) (_ string, _ string, err error) {
+	ctx, span := tracing.StartSpan(ctx, "kubeclient.Exec",
+		attribute.String("k8s.namespace.name", options.Namespace),
+		attribute.String("k8s.pod.name", options.PodName),
+		attribute.String("k8s.container.name", options.ContainerName),
+		attribute.StringSlice("fluid.exec.command", securityutils.FilterCommand(options.Command)))
+	defer func() { tracing.EndSpan(span, err) }()
+
+	err = cmdguard.ValidateCommandSlice(options.Command)
 	if err != nil {
 		return "", "", err
 	}
@@ -23,6 +23,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -52,6 +53,16 @@ func UpdateJobWithContext(ctx context.Context, c client.Client, job *v1.Job) err
 	return c.Update(ctx, job)
 }
 
+// ResumeJob sets the suspend field of the job to false if the job is suspended
+func ResumeJob(c client.Client, job *v1.Job) error {
+	if job.Spec.Suspend == nil || !*job.Spec.Suspend {
+		return nil
+	}
+	jobToUpdate := job.DeepCopy()
+	jobToUpdate.Spec.Suspend = ptr.To(false)
+	return UpdateJob(c, jobToUpdate)
+}
+
 // GetSucceedPodForJob get the first finished pod for the job, if no succeed pod, return nil with no error.
 func GetSucceedPodForJob(c client.Client, job *v1.Job) (*corev1.Pod, error) {
 	return GetSucceedPodForJobWithContext(context.TODO(), c, job)
@@ -81,6 +92,30 @@ func GetSucceedPodForJobWithContext(ctx context.Context, c client.Client, job *v
 	return nil, nil
 }
 
+// GetRunningPodForJob gets the first running pod for the job, if no running pod, return nil with no error.
+func GetRunningPodForJob(c client.Client, job *v1.Job) (*corev1.Pod, error) {
+	var podList corev1.PodList
+	selector, err := metav1.LabelSelectorAsSelector(job.Spec.Selector)
+	if err != nil {
+		return nil, fmt.Errorf("error converting Job %s in namespace %s selector: %w", job.Name, job.Namespace, err)
+	}
+	err = c.List(context.TODO(), &podList, &client.ListOptions{
+		Namespace:     job.Namespace,
+		LabelSelector: selector,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error listing pods for Job %s in namespace %s: %w", job.Name, job.Namespace, err)
+	}
+
+	for _, pod := range podList.Items {
+		if pod.Status.Phase == corev1.PodRunning {
+			return &pod, nil
+		}
+	}
+	// no running pod, return nil with no error.
+	return nil, nil
+}
+
 // GetFinishedJobCondition get the finished(succeed or failed) condition of the job
 func GetFinishedJobCondition(job *v1.Job) *v1.JobCondition {
 	// find the job final status condition. if job is resumed, the first condition type is 'Suspended'
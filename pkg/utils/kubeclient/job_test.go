@@ -144,6 +144,62 @@ var _ = Describe("Job related unit tests", Label("pkg.utils.kubeclient.job_test.
 		})
 	})
 
+	Describe("Test GetRunningPodForJob()", func() {
+		job := &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-job",
+				Namespace: "test-ns",
+			},
+			Spec: batchv1.JobSpec{
+				Selector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{
+						"job-name": "test-job",
+					},
+				},
+			},
+		}
+
+		newJobPod := func(name string, phase corev1.PodPhase) *corev1.Pod {
+			return &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: "test-ns",
+					Labels: map[string]string{
+						"job-name": "test-job",
+					},
+				},
+				Status: corev1.PodStatus{
+					Phase: phase,
+				},
+			}
+		}
+
+		When("job has a running pod", func() {
+			BeforeEach(func() {
+				resources = []runtime.Object{job, newJobPod("test-job-failed", corev1.PodFailed), newJobPod("test-job-running", corev1.PodRunning)}
+			})
+
+			It("should return the running pod", func() {
+				gotPod, err := GetRunningPodForJob(client, job)
+				Expect(err).To(BeNil())
+				Expect(gotPod).NotTo(BeNil())
+				Expect(gotPod.Name).To(Equal("test-job-running"))
+			})
+		})
+
+		When("job has no running pod", func() {
+			BeforeEach(func() {
+				resources = []runtime.Object{job, newJobPod("test-job-pending", corev1.PodPending)}
+			})
+
+			It("should return nil", func() {
+				gotPod, err := GetRunningPodForJob(client, job)
+				Expect(err).To(BeNil())
+				Expect(gotPod).To(BeNil())
+			})
+		})
+	})
+
 	Describe("Test UpdateJob()", func() {
 		job := &batchv1.Job{
 			ObjectMeta: metav1.ObjectMeta{
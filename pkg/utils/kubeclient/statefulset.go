@@ -126,6 +126,15 @@ func getParentName(pod *v1.Pod) string {
 	return parent
 }
 
+// GetOrdinalOfStatefulSetPod gets the ordinal of the pod created by the StatefulSet, or -1 if it's not.
+func GetOrdinalOfStatefulSetPod(sts client.Object, pod *v1.Pod) int {
+	parent, ordinal := getParentNameAndOrdinal(pod)
+	if parent != sts.GetName() {
+		return -1
+	}
+	return ordinal
+}
+
 // isMemberOf tests if pod has parent name.
 func isMemberOf(obj client.Object, pod *v1.Pod) bool {
 	return getParentName(pod) == obj.GetName()
@@ -155,6 +155,11 @@ func GetFuseLabelName(namespace, name, ownerDatasetUID string) string {
 	return GetNamespacedNameValueWithPrefix(common.LabelAnnotationFusePrefix, namespace, name, ownerDatasetUID)
 }
 
+// GetFuseUpgradeDrainingLabelName gets the label on the nodes drained for the fuse upgrade of the runtime
+func GetFuseUpgradeDrainingLabelName(namespace, name, ownerDatasetUID string) string {
+	return GetNamespacedNameValueWithPrefix(common.LabelFuseUpgradeDrainingPrefix, namespace, name, ownerDatasetUID)
+}
+
 func GetExclusiveKey() string {
 	return common.FluidExclusiveKey
 }
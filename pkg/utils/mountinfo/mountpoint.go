@@ -19,6 +19,7 @@ package mountinfo
 import (
 	"fmt"
 	"path"
+	"sort"
 	"strings"
 
 	"github.com/fluid-cloudnative/fluid/pkg/utils"
@@ -32,6 +33,8 @@ type MountPoint struct {
 	ReadOnly              bool
 	Count                 int
 	NamespacedDatasetName string // <namespace>-<dataset>
+	PodUID                string // uid of the pod that the bind mount belongs to
+	SubPath               bool   // whether the bind mount is a volume subpath of a container
 }
 
 func GetBrokenMountPoints() ([]MountPoint, error) {
@@ -114,6 +117,7 @@ func getBrokenBindMounts(globalMountByName map[string]*Mount, bindMountByName ma
 		for _, bindMount := range bindMounts {
 			// In case of not sharing same peer group in mount info, meaning it a broken mount point
 			if len(utils.IntersectIntegerSets(bindMount.PeerGroups, globalMount.PeerGroups)) == 0 {
+				podUID, subPath := parsePodMountPath(bindMount.MountPath)
 				brokenMounts = append(brokenMounts, MountPoint{
 					SourcePath:            path.Join(globalMount.MountPath, bindMount.Subtree),
 					MountPath:             bindMount.MountPath,
@@ -121,9 +125,40 @@ func getBrokenBindMounts(globalMountByName map[string]*Mount, bindMountByName ma
 					ReadOnly:              bindMount.ReadOnly,
 					Count:                 bindMount.Count,
 					NamespacedDatasetName: name,
+					PodUID:                podUID,
+					SubPath:               subPath,
 				})
 			}
 		}
 	}
+
+	// Repair the propagation chain of a pod in order: the volume path first, then the subpaths of the containers.
+	sort.SliceStable(brokenMounts, func(i, j int) bool {
+		if brokenMounts[i].PodUID != brokenMounts[j].PodUID {
+			return brokenMounts[i].PodUID < brokenMounts[j].PodUID
+		}
+		if brokenMounts[i].SubPath != brokenMounts[j].SubPath {
+			return !brokenMounts[i].SubPath
+		}
+		return brokenMounts[i].MountPath < brokenMounts[j].MountPath
+	})
 	return
 }
+
+// parsePodMountPath parses the pod uid from a bind mount path in the kubelet root dir, i.e.
+// /{kubeletRootDir}/pods/{podUID}/volumes/... or /{kubeletRootDir}/pods/{podUID}/volume-subpaths/...
+func parsePodMountPath(mountPath string) (podUID string, subPath bool) {
+	fields := strings.Split(mountPath, "/")
+	for i := 0; i+2 < len(fields); i++ {
+		if fields[i] != "pods" {
+			continue
+		}
+		switch fields[i+2] {
+		case "volumes":
+			return fields[i+1], false
+		case "volume-subpaths":
+			return fields[i+1], true
+		}
+	}
+	return "", false
+}
@@ -473,6 +473,39 @@ var _ = Describe("MountInfo", func() {
 		})
 	})
 
+	Describe("parsePodMountPath", func() {
+		It("should parse the pod uid of volume and subpath bind mounts", func() {
+			podUID, subPath := parsePodMountPath(mockBindMount.MountPath)
+			Expect(podUID).To(Equal("1140aa96-18c2-4896-a14f-7e3965a51406"))
+			Expect(subPath).To(BeFalse())
+
+			podUID, subPath = parsePodMountPath(mockBindSubPathMount.MountPath)
+			Expect(podUID).To(Equal("6fe8418f-3f78-4adb-9e02-416d8601c1b6"))
+			Expect(subPath).To(BeTrue())
+
+			podUID, _ = parsePodMountPath("/runtime-mnt/juicefs/default/jfsdemo/juicefs-fuse")
+			Expect(podUID).To(BeEmpty())
+		})
+	})
+
+	Describe("getBrokenBindMounts ordering", func() {
+		It("should return the volume path of a pod before its subpaths", func() {
+			podDir := "/var/lib/kubelet/pods/6fe8418f-3f78-4adb-9e02-416d8601c1b6"
+			subPathMount := &Mount{Subtree: "/", MountPath: podDir + "/volume-subpaths/default-jfsdemo/demo/0", PeerGroups: peerGroup1}
+			volumeMount := &Mount{Subtree: "/", MountPath: podDir + "/volumes/kubernetes.io~csi/default-jfsdemo/mount", PeerGroups: peerGroup1}
+
+			brokenMounts := getBrokenBindMounts(map[string]*Mount{"default-jfsdemo": mockGlobalMount},
+				map[string][]*Mount{"default-jfsdemo": {subPathMount, volumeMount}})
+
+			Expect(brokenMounts).To(HaveLen(2))
+			Expect(brokenMounts[0].MountPath).To(Equal(volumeMount.MountPath))
+			Expect(brokenMounts[0].SubPath).To(BeFalse())
+			Expect(brokenMounts[1].MountPath).To(Equal(subPathMount.MountPath))
+			Expect(brokenMounts[1].SubPath).To(BeTrue())
+			Expect(brokenMounts[1].PodUID).To(Equal("6fe8418f-3f78-4adb-9e02-416d8601c1b6"))
+		})
+	})
+
 	Describe("MountPoint struct", func() {
 		It("should properly represent a mount point with all fields", func() {
 			mp := MountPoint{
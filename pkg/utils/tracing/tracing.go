@@ -0,0 +1,126 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package tracing traces the reconciliation of the runtimes with OpenTelemetry. The spans are
+// exported to an OTLP/HTTP endpoint when it's configured, otherwise tracing is a no-op.
+package tracing
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const instrumentationName = "github.com/fluid-cloudnative/fluid"
+
+// The attributes describing the traced runtime, data operation, dataset and helm release.
+const (
+	RuntimeNameKey        = attribute.Key("fluid.runtime.name")
+	RuntimeNamespaceKey   = attribute.Key("fluid.runtime.namespace")
+	RuntimeTypeKey        = attribute.Key("fluid.runtime.type")
+	OperationNameKey      = attribute.Key("fluid.operation.name")
+	OperationNamespaceKey = attribute.Key("fluid.operation.namespace")
+	OperationTypeKey      = attribute.Key("fluid.operation.type")
+	DatasetNameKey        = attribute.Key("fluid.dataset.name")
+	DatasetNamespaceKey   = attribute.Key("fluid.dataset.namespace")
+	ReleaseNameKey        = attribute.Key("helm.release.name")
+	ReleaseNamespaceKey   = attribute.Key("helm.release.namespace")
+)
+
+// Setup exports the spans to the OTLP/HTTP endpoint, e.g. http://otel-collector:4318, sampling the root spans
+// by the ratio. It returns the function flushing and stopping the exporter. Tracing is disabled if the endpoint is empty.
+func Setup(setupLog logr.Logger, endpoint string, samplingRatio float64, serviceName string) (shutdown func(context.Context) error, err error) {
+	shutdown = func(context.Context) error { return nil }
+	if endpoint == "" {
+		return
+	}
+
+	exporter, err := otlptracehttp.New(context.Background(), otlptracehttp.WithEndpointURL(endpoint))
+	if err != nil {
+		return
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(samplingRatio))),
+		sdktrace.WithResource(resource.NewSchemaless(semconv.ServiceName(serviceName))),
+	)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	setupLog.Info("Enabling tracing", "endpoint", endpoint, "samplingRatio", samplingRatio)
+
+	return provider.Shutdown, nil
+}
+
+// StartSpan starts a span as a child of the span in the ctx, if any.
+func StartSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return otel.Tracer(instrumentationName).Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// EndSpan ends the span, recording the err if it's not nil.
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// RuntimeAttributes returns the attributes describing the runtime.
+func RuntimeAttributes(runtimeType, namespace, name string) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		RuntimeTypeKey.String(runtimeType),
+		RuntimeNamespaceKey.String(namespace),
+		RuntimeNameKey.String(name),
+	}
+}
+
+// OperationAttributes returns the attributes describing the data operation.
+func OperationAttributes(operationType, namespace, name string) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		OperationTypeKey.String(operationType),
+		OperationNamespaceKey.String(namespace),
+		OperationNameKey.String(name),
+	}
+}
+
+// DatasetAttributes returns the attributes describing the dataset.
+func DatasetAttributes(namespace, name string) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		DatasetNamespaceKey.String(namespace),
+		DatasetNameKey.String(name),
+	}
+}
+
+// ReleaseAttributes returns the attributes describing the helm release.
+func ReleaseAttributes(namespace, name string) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		ReleaseNamespaceKey.String(namespace),
+		ReleaseNameKey.String(name),
+	}
+}
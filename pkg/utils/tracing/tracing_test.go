@@ -0,0 +1,91 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tracing
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestSetupWithoutEndpoint(t *testing.T) {
+	shutdown, err := Setup(logr.Discard(), "", 1, "test")
+	if err != nil {
+		t.Fatalf("expect no error, got %v", err)
+	}
+	if err = shutdown(context.Background()); err != nil {
+		t.Errorf("expect shutting down the no-op tracing to succeed, got %v", err)
+	}
+}
+
+func TestSpansOfRuntime(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(provider)
+	defer otel.SetTracerProvider(previous)
+
+	reconcileCtx, reconcileSpan := StartSpan(context.Background(), "reconcile", RuntimeAttributes("alluxio", "default", "hbase")...)
+	// the calls taking the context of the reconciliation are traced as its children
+	_, execSpan := StartSpan(reconcileCtx, "exec")
+	EndSpan(execSpan, errors.New("exec failed"))
+	// the calls without the context are not
+	_, otherSpan := StartSpan(context.Background(), "other")
+	EndSpan(otherSpan, nil)
+	EndSpan(reconcileSpan, nil)
+
+	spans := map[string]sdktrace.ReadOnlySpan{}
+	for _, span := range recorder.Ended() {
+		spans[span.Name()] = span
+	}
+	reconcile, child, other := spans["reconcile"], spans["exec"], spans["other"]
+	if reconcile == nil || child == nil || other == nil {
+		t.Fatalf("expect the spans of reconcile, exec and other, got %v", spans)
+	}
+	if child.Parent().SpanID() != reconcile.SpanContext().SpanID() {
+		t.Errorf("expect the exec span to be the child of the reconcile span")
+	}
+	if other.Parent().IsValid() {
+		t.Errorf("expect the span without the context to be a root span")
+	}
+	if child.Status().Code != codes.Error || child.Status().Description != "exec failed" {
+		t.Errorf("expect the error to be recorded, got %v", child.Status())
+	}
+	if len(reconcile.Attributes()) != 3 {
+		t.Errorf("expect the runtime attributes, got %v", reconcile.Attributes())
+	}
+}
+
+func TestOperationAttributes(t *testing.T) {
+	attrs := append(OperationAttributes("DataLoad", "default", "warmup"), DatasetAttributes("default", "hbase")...)
+	if len(attrs) != 5 || attrs[0] != OperationTypeKey.String("DataLoad") || attrs[4] != DatasetNameKey.String("hbase") {
+		t.Errorf("unexpected attributes of the data operation: %v", attrs)
+	}
+}
+
+func TestReleaseAttributes(t *testing.T) {
+	attrs := ReleaseAttributes("default", "warmup-loader")
+	if len(attrs) != 2 || attrs[0] != ReleaseNamespaceKey.String("default") || attrs[1] != ReleaseNameKey.String("warmup-loader") {
+		t.Errorf("unexpected attributes of the helm release: %v", attrs)
+	}
+}
@@ -30,9 +30,17 @@ import (
 	"github.com/go-logr/logr"
 	"github.com/pkg/errors"
 	v1 "k8s.io/api/admissionregistration/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// ConvertibleCRDs are the CRDs served in multiple versions, which are converted by the webhook
+var ConvertibleCRDs = []string{
+	"datasets.data.fluid.io",
+	"dataloads.data.fluid.io",
+	"databackups.data.fluid.io",
+}
+
 type CertificateBuilder struct {
 	log logr.Logger
 	client.Client
@@ -85,7 +93,7 @@ func (c *CertificateBuilder) genCA(ns, svc, certPath string) (*generator.Artifac
 	return certs, nil
 }
 
-// PatchCABundle patch the caBundle to MutatingWebhookConfiguration
+// PatchCABundle patch the caBundle to MutatingWebhookConfiguration and ValidatingWebhookConfiguration
 func (c *CertificateBuilder) PatchCABundle(webhookName string, ca []byte) error {
 
 	var m v1.MutatingWebhookConfiguration
@@ -116,5 +124,101 @@ func (c *CertificateBuilder) PatchCABundle(webhookName string, ca []byte) error
 
 	c.log.Info("finished patch MutatingWebhookConfiguration caBundle", "name", webhookName)
 
+	return c.patchValidatingCABundle(webhookName, ca)
+}
+
+// patchValidatingCABundle patch the caBundle to ValidatingWebhookConfiguration with the same name if it exists
+func (c *CertificateBuilder) patchValidatingCABundle(webhookName string, ca []byte) error {
+
+	var v v1.ValidatingWebhookConfiguration
+
+	ctx := context.Background()
+
+	if err := c.Get(ctx, client.ObjectKey{Name: webhookName}, &v); err != nil {
+		if utils.IgnoreNotFound(err) == nil {
+			c.log.Info("skip patching the ValidatingWebhookConfiguration because it's not found", "name", webhookName)
+			return nil
+		}
+		c.log.Error(err, "fail to get validatingWebHook", "name", webhookName)
+		return err
+	}
+
+	current := v.DeepCopy()
+	for i := range v.Webhooks {
+		v.Webhooks[i].ClientConfig.CABundle = ca
+	}
+
+	if reflect.DeepEqual(v.Webhooks, current.Webhooks) {
+		c.log.Info("no need to patch the ValidatingWebhookConfiguration", "name", webhookName)
+		return nil
+	}
+
+	if err := c.Patch(ctx, &v, client.MergeFrom(current)); err != nil {
+		c.log.Error(err, "fail to patch CABundle to validatingWebHook", "name", webhookName)
+		return err
+	}
+
+	c.log.Info("finished patch ValidatingWebhookConfiguration caBundle", "name", webhookName)
+
+	return nil
+}
+
+// PatchConversionCABundle points the conversion of the convertible CRDs to the webhook with the caBundle, and
+// serves all the versions of them. The CRDs are shipped with only the stored version served, so that the other
+// versions are never served without the conversion of the webhook, e.g. when the webhook is disabled.
+func (c *CertificateBuilder) PatchConversionCABundle(ca []byte) error {
+	ns, err := utils.GetEnvByKey(common.MyPodNamespace)
+	if err != nil {
+		return errors.Wrapf(err, "get namespace from env failed, env key:%s", common.MyPodNamespace)
+	}
+
+	ctx := context.Background()
+	path := "/" + common.WebhookConvertPath
+	port := int32(common.WebhookServicePort)
+
+	for _, name := range ConvertibleCRDs {
+		var crd apiextensionsv1.CustomResourceDefinition
+		if err := c.Get(ctx, client.ObjectKey{Name: name}, &crd); err != nil {
+			if utils.IgnoreNotFound(err) == nil {
+				c.log.Info("skip patching the conversion of the CustomResourceDefinition because it's not found", "name", name)
+				continue
+			}
+			c.log.Error(err, "fail to get CustomResourceDefinition", "name", name)
+			return err
+		}
+
+		current := crd.DeepCopy()
+		crd.Spec.Conversion = &apiextensionsv1.CustomResourceConversion{
+			Strategy: apiextensionsv1.WebhookConverter,
+			Webhook: &apiextensionsv1.WebhookConversion{
+				ClientConfig: &apiextensionsv1.WebhookClientConfig{
+					Service: &apiextensionsv1.ServiceReference{
+						Namespace: ns,
+						Name:      common.WebhookServiceName,
+						Path:      &path,
+						Port:      &port,
+					},
+					CABundle: ca,
+				},
+				ConversionReviewVersions: []string{"v1"},
+			},
+		}
+		// the versions are served along with the conversion in a single patch
+		for i := range crd.Spec.Versions {
+			crd.Spec.Versions[i].Served = true
+		}
+
+		if reflect.DeepEqual(crd.Spec, current.Spec) {
+			c.log.V(1).Info("no need to patch the conversion of the CustomResourceDefinition", "name", name)
+			continue
+		}
+
+		if err := c.Patch(ctx, &crd, client.MergeFrom(current)); err != nil {
+			c.log.Error(err, "fail to patch the conversion of the CustomResourceDefinition", "name", name)
+			return err
+		}
+		c.log.Info("finished patch the conversion of the CustomResourceDefinition", "name", name)
+	}
+
 	return nil
 }
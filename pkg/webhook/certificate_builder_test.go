@@ -13,6 +13,7 @@ import (
 	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
 	v1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -102,6 +103,7 @@ var _ = Describe("CertificateBuilder", func() {
 				},
 			}
 			testScheme.AddKnownTypes(schema.GroupVersion{Group: "admissionregistration.k8s.io", Version: "v1"}, testMutatingWebhookConfiguration)
+			testScheme.AddKnownTypes(schema.GroupVersion{Group: "admissionregistration.k8s.io", Version: "v1"}, &admissionregistrationv1.ValidatingWebhookConfiguration{})
 		})
 
 		testCases := map[string]struct {
@@ -144,6 +146,78 @@ var _ = Describe("CertificateBuilder", func() {
 		}
 	})
 
+	Describe("PatchCABundle with ValidatingWebhookConfiguration", func() {
+		var mockWebhookName = "mockWebhookName"
+
+		BeforeEach(func() {
+			testScheme.AddKnownTypes(schema.GroupVersion{Group: "admissionregistration.k8s.io", Version: "v1"},
+				&admissionregistrationv1.MutatingWebhookConfiguration{}, &admissionregistrationv1.ValidatingWebhookConfiguration{})
+		})
+
+		It("should patch CABundle to both webhook configurations", func() {
+			mutating := &admissionregistrationv1.MutatingWebhookConfiguration{
+				ObjectMeta: metav1.ObjectMeta{Name: mockWebhookName},
+				Webhooks: []admissionregistrationv1.MutatingWebhook{
+					{Name: "webhook1", ClientConfig: admissionregistrationv1.WebhookClientConfig{CABundle: []byte{3, 5, 54, 34}}},
+				},
+			}
+			validating := &admissionregistrationv1.ValidatingWebhookConfiguration{
+				ObjectMeta: metav1.ObjectMeta{Name: mockWebhookName},
+				Webhooks: []admissionregistrationv1.ValidatingWebhook{
+					{Name: "webhook1", ClientConfig: admissionregistrationv1.WebhookClientConfig{CABundle: []byte{3, 8, 54, 4}}},
+					{Name: "webhook2", ClientConfig: admissionregistrationv1.WebhookClientConfig{CABundle: []byte{35, 5, 54, 4}}},
+				},
+			}
+			ca := []byte{1, 2, 3}
+			client := fake.NewFakeClientWithScheme(testScheme, mutating, validating)
+			cb := NewCertificateBuilder(client, log)
+			Expect(cb.PatchCABundle(mockWebhookName, ca)).To(Succeed())
+
+			var vc admissionregistrationv1.ValidatingWebhookConfiguration
+			Expect(client.Get(context.TODO(), types.NamespacedName{Name: mockWebhookName}, &vc)).To(Succeed())
+			for _, wh := range vc.Webhooks {
+				Expect(wh.ClientConfig.CABundle).To(Equal(ca))
+			}
+		})
+	})
+
+	Describe("PatchConversionCABundle", func() {
+		BeforeEach(func() {
+			Expect(apiextensionsv1.AddToScheme(testScheme)).To(Succeed())
+			os.Setenv(common.MyPodNamespace, common.NamespaceFluidSystem)
+		})
+
+		It("should point the conversion of the existing CRDs to the webhook and serve all the versions", func() {
+			crd := &apiextensionsv1.CustomResourceDefinition{
+				ObjectMeta: metav1.ObjectMeta{Name: "datasets.data.fluid.io"},
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+					{Name: "v1alpha1", Served: true, Storage: true},
+					{Name: "v1beta1", Served: false},
+				}},
+			}
+			ca := []byte{1, 2, 3}
+			client := fake.NewFakeClientWithScheme(testScheme, crd)
+			cb := NewCertificateBuilder(client, log)
+			Expect(cb.PatchConversionCABundle(ca)).To(Succeed())
+
+			var patched apiextensionsv1.CustomResourceDefinition
+			Expect(client.Get(context.TODO(), types.NamespacedName{Name: crd.Name}, &patched)).To(Succeed())
+			Expect(patched.Spec.Conversion).NotTo(BeNil())
+			Expect(patched.Spec.Conversion.Strategy).To(Equal(apiextensionsv1.WebhookConverter))
+			clientConfig := patched.Spec.Conversion.Webhook.ClientConfig
+			Expect(clientConfig.CABundle).To(Equal(ca))
+			Expect(clientConfig.Service.Namespace).To(Equal(common.NamespaceFluidSystem))
+			Expect(clientConfig.Service.Name).To(Equal(common.WebhookServiceName))
+			Expect(*clientConfig.Service.Path).To(Equal("/convert"))
+			for _, version := range patched.Spec.Versions {
+				Expect(version.Served).To(BeTrue(), "version %s is not served", version.Name)
+			}
+
+			// patching again changes nothing
+			Expect(cb.PatchConversionCABundle(ca)).To(Succeed())
+		})
+	})
+
 	Describe("Additional edge cases", func() {
 		It("should fail BuildOrSyncCABundle if cert dir is invalid", func() {
 			os.Setenv(common.MyPodNamespace, "default")
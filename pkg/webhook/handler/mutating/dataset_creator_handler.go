@@ -0,0 +1,95 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mutating
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+)
+
+// DatasetCreatorHandler records the user creating a dataset in its annotation, which identifies the creator when
+// the DatasetShares granting service accounts are checked after admission.
+type DatasetCreatorHandler struct {
+	Client client.Client
+	Reader client.Reader
+	// A decoder will be automatically injected
+	decoder *admission.Decoder
+}
+
+func (h *DatasetCreatorHandler) Setup(client client.Client, reader client.Reader, decoder *admission.Decoder) {
+	h.Client = client
+	h.Reader = reader
+	h.decoder = decoder
+}
+
+// Handle sets the creator of the dataset to the requesting user on creation, and keeps it unchanged on update
+func (h *DatasetCreatorHandler) Handle(ctx context.Context, req admission.Request) admission.Response {
+	defer utils.TimeTrack(time.Now(), "DatasetCreatorHandler.Handle",
+		"req.name", req.Name, "req.namespace", req.Namespace)
+
+	var log = ctrl.Log.WithName("mutate-dataset-creator")
+
+	dataset := &datav1alpha1.Dataset{}
+	if err := h.decoder.DecodeRaw(req.Object, dataset); err != nil {
+		log.Error(err, "failed to decode the dataset", "name", req.Name, "namespace", req.Namespace)
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	creator := req.UserInfo.Username
+	switch req.Operation {
+	case admissionv1.Create:
+	case admissionv1.Update:
+		oldDataset := &datav1alpha1.Dataset{}
+		if err := h.decoder.DecodeRaw(req.OldObject, oldDataset); err != nil {
+			log.Error(err, "failed to decode the old dataset", "name", req.Name, "namespace", req.Namespace)
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+		creator = oldDataset.Annotations[common.AnnotationDatasetCreator]
+	default:
+		return admission.Allowed("no need to mutate the operation")
+	}
+
+	if dataset.Annotations[common.AnnotationDatasetCreator] == creator {
+		return admission.Allowed("the creator of the dataset is recorded")
+	}
+	if creator == "" {
+		// the datasets created before the creator is recorded have no creator
+		delete(dataset.Annotations, common.AnnotationDatasetCreator)
+	} else {
+		if dataset.Annotations == nil {
+			dataset.Annotations = map[string]string{}
+		}
+		dataset.Annotations[common.AnnotationDatasetCreator] = creator
+	}
+
+	marshaledDataset, err := json.Marshal(dataset)
+	if err != nil {
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+	return admission.PatchResponseFromRaw(req.Object.Raw, marshaledDataset)
+}
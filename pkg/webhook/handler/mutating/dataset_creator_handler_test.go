@@ -0,0 +1,108 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mutating
+
+import (
+	"context"
+	"encoding/json"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+var _ = Describe("DatasetCreatorHandler", func() {
+	var handler *DatasetCreatorHandler
+
+	newDataset := func(creator string) *datav1alpha1.Dataset {
+		dataset := &datav1alpha1.Dataset{
+			TypeMeta:   metav1.TypeMeta{APIVersion: datav1alpha1.GroupVersion.String(), Kind: "Dataset"},
+			ObjectMeta: metav1.ObjectMeta{Name: "ref", Namespace: "team-a"},
+		}
+		if creator != "" {
+			dataset.Annotations = map[string]string{common.AnnotationDatasetCreator: creator}
+		}
+		return dataset
+	}
+
+	newRequest := func(operation admissionv1.Operation, username string, obj, oldObj runtime.Object) admission.Request {
+		req := admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+			Name:      "ref",
+			Namespace: "team-a",
+			Operation: operation,
+		}}
+		req.UserInfo.Username = username
+		raw, err := json.Marshal(obj)
+		Expect(err).NotTo(HaveOccurred())
+		req.Object = runtime.RawExtension{Raw: raw}
+		if oldObj != nil {
+			raw, err = json.Marshal(oldObj)
+			Expect(err).NotTo(HaveOccurred())
+			req.OldObject = runtime.RawExtension{Raw: raw}
+		}
+		return req
+	}
+
+	BeforeEach(func() {
+		s := runtime.NewScheme()
+		Expect(datav1alpha1.AddToScheme(s)).To(Succeed())
+		c := fake.NewFakeClientWithScheme(s)
+		handler = &DatasetCreatorHandler{}
+		handler.Setup(c, c, admission.NewDecoder(s))
+	})
+
+	It("should record the user creating the dataset", func() {
+		resp := handler.Handle(context.TODO(), newRequest(admissionv1.Create, "alice", newDataset(""), nil))
+		Expect(resp.Allowed).To(BeTrue())
+		Expect(resp.Patches).To(HaveLen(1))
+		Expect(resp.Patches[0].Value).To(HaveKeyWithValue(common.AnnotationDatasetCreator, "alice"))
+	})
+
+	It("should overwrite the creator set by the user on creation", func() {
+		resp := handler.Handle(context.TODO(), newRequest(admissionv1.Create, "alice", newDataset("system:serviceaccount:team-a:deployer"), nil))
+		Expect(resp.Allowed).To(BeTrue())
+		Expect(resp.Patches).To(HaveLen(1))
+		Expect(resp.Patches[0].Operation).To(Equal("replace"))
+		Expect(resp.Patches[0].Value).To(Equal("alice"))
+	})
+
+	It("should keep the recorded creator on update", func() {
+		resp := handler.Handle(context.TODO(), newRequest(admissionv1.Update, "bob", newDataset("bob"), newDataset("alice")))
+		Expect(resp.Allowed).To(BeTrue())
+		Expect(resp.Patches).To(HaveLen(1))
+		Expect(resp.Patches[0].Value).To(Equal("alice"))
+	})
+
+	It("should remove the creator set on update of a dataset without creator", func() {
+		resp := handler.Handle(context.TODO(), newRequest(admissionv1.Update, "bob", newDataset("bob"), newDataset("")))
+		Expect(resp.Allowed).To(BeTrue())
+		Expect(resp.Patches).To(HaveLen(1))
+		Expect(resp.Patches[0].Operation).To(Equal("remove"))
+	})
+
+	It("should not patch the dataset keeping its creator", func() {
+		resp := handler.Handle(context.TODO(), newRequest(admissionv1.Update, "bob", newDataset("alice"), newDataset("alice")))
+		Expect(resp.Allowed).To(BeTrue())
+		Expect(resp.Patches).To(BeEmpty())
+	})
+})
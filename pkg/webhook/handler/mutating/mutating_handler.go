@@ -109,6 +109,8 @@ func (a *FluidMutatingHandler) Handle(ctx context.Context, req admission.Request
 			if err := a.MutatePod(pod, true); err != nil {
 				return admission.Errored(http.StatusInternalServerError, err)
 			}
+		} else if webhookutils.IsFailMutationError(err) {
+			return admission.Errored(http.StatusInternalServerError, err)
 		}
 	}
 
@@ -168,6 +170,14 @@ func (a *FluidMutatingHandler) MutatePod(pod *corev1.Pod, useDirectReader bool)
 		}
 	}
 
+	// append the plugins of the PodMutationPolicies selecting the pod, skip the ones already in the list
+	policyPlugins, err := plugins.GetPolicyHandlers(a.Client, handlerClient, pod)
+	if err != nil {
+		setupLog.Error(err, "failed to get the plugins of PodMutationPolicies")
+		return webhookutils.NewNeedRetryWithApiReaderError(errors.Wrap(err, "failed to get the plugins of PodMutationPolicies"))
+	}
+	pluginsList = appendPolicyPlugins(pluginsList, policyPlugins)
+
 	// call every plugin in the plugins list in the defined order
 	// if a plugin return shouldStop, stop to call other plugins
 	for _, plugin := range pluginsList {
@@ -185,5 +195,27 @@ func (a *FluidMutatingHandler) MutatePod(pod *corev1.Pod, useDirectReader bool)
 	}
 
 	return
+}
+
+// appendPolicyPlugins appends the plugins of PodMutationPolicies to the plugins list, a plugin with the same name
+// as a plugin in the list is skipped because the plugins are not guaranteed to be idempotent.
+func appendPolicyPlugins(pluginsList []api.MutatingHandler, policyPlugins []api.MutatingHandler) []api.MutatingHandler {
+	if len(policyPlugins) == 0 {
+		return pluginsList
+	}
 
+	names := make(map[string]bool, len(pluginsList)+len(policyPlugins))
+	result := make([]api.MutatingHandler, 0, len(pluginsList)+len(policyPlugins))
+	for _, plugin := range pluginsList {
+		names[plugin.GetName()] = true
+		result = append(result, plugin)
+	}
+	for _, plugin := range policyPlugins {
+		if names[plugin.GetName()] {
+			continue
+		}
+		names[plugin.GetName()] = true
+		result = append(result, plugin)
+	}
+	return result
 }
@@ -18,13 +18,21 @@ package mutating
 
 import (
 	"context"
+	"crypto/tls"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"strconv"
 
 	"github.com/agiledragon/gomonkey/v2"
 	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
 	"github.com/fluid-cloudnative/fluid/pkg/common"
 	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/webhook/generator"
 	"github.com/fluid-cloudnative/fluid/pkg/webhook/plugins"
+	"github.com/fluid-cloudnative/fluid/pkg/webhook/plugins/externalmutator"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	admissionv1 "k8s.io/api/admission/v1"
@@ -32,6 +40,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes/scheme"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 )
@@ -927,6 +936,7 @@ var _ = Describe("Handle", func() {
 		decoder = admission.NewDecoder(scheme.Scheme)
 		s = runtime.NewScheme()
 		Expect(corev1.AddToScheme(s)).To(Succeed())
+		Expect(datav1alpha1.AddToScheme(s)).To(Succeed())
 
 		mockReadFile := func(content string) ([]byte, error) {
 			return []byte(pluginsProfile), nil
@@ -1948,3 +1958,132 @@ var _ = Describe("MutatePod - PVC Collection Error", func() {
 		Expect(err).To(HaveOccurred())
 	})
 })
+
+var _ = Describe("PodMutationPolicy", func() {
+	var (
+		decoder *admission.Decoder
+		s       *runtime.Scheme
+		patch   *gomonkey.Patches
+		server  *httptest.Server
+		status  int
+		// caBundle verifies the certificate of the test server, which serves as the team-a service
+		caBundle         []byte
+		defaultTransport http.RoundTripper
+		// a new uid for each policy to avoid using the handlers cached in previous specs
+		uid int
+	)
+
+	BeforeEach(func() {
+		decoder = admission.NewDecoder(scheme.Scheme)
+		s = runtime.NewScheme()
+		Expect(corev1.AddToScheme(s)).To(Succeed())
+		Expect(datav1alpha1.AddToScheme(s)).To(Succeed())
+
+		mockReadFile := func(content string) ([]byte, error) {
+			return []byte(pluginsProfile), nil
+		}
+		patch = gomonkey.ApplyFunc(os.ReadFile, mockReadFile)
+
+		status = http.StatusOK
+		certs, err := (&generator.SelfSignedCertGenerator{}).Generate(generator.ServiceToCommonName("team-a", "team-a"))
+		Expect(err).NotTo(HaveOccurred())
+		cert, err := tls.X509KeyPair(certs.Cert, certs.Key)
+		Expect(err).NotTo(HaveOccurred())
+		caBundle = certs.CACert
+
+		server = httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if status != http.StatusOK {
+				http.Error(w, "mutator is unavailable", status)
+				return
+			}
+			_ = json.NewEncoder(w).Encode(externalmutator.MutationResponse{
+				Patch: json.RawMessage(`[{"op": "add", "path": "/metadata/annotations", "value": {"team-a.io/mutated": "true"}}]`),
+			})
+		}))
+		server.TLS = &tls.Config{Certificates: []tls.Certificate{cert}}
+		server.StartTLS()
+
+		// the external mutators clone the default transport, which resolves the team-a service to the test server
+		defaultTransport = http.DefaultTransport
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.DialContext = func(ctx context.Context, network, addr string) (net.Conn, error) {
+			return (&net.Dialer{}).DialContext(ctx, network, server.Listener.Addr().String())
+		}
+		http.DefaultTransport = transport
+	})
+
+	AfterEach(func() {
+		patch.Reset()
+		server.Close()
+		http.DefaultTransport = defaultTransport
+	})
+
+	newRequest := func() admission.Request {
+		return admission.Request{
+			AdmissionRequest: admissionv1.AdmissionRequest{
+				Namespace: "team-a",
+				Object: runtime.RawExtension{
+					Raw: []byte(`{
+						"apiVersion": "v1",
+						"kind": "Pod",
+						"metadata": {"name": "foo", "labels": {"app": "training"}},
+						"spec": {"containers": [{"image": "bar:v2", "name": "bar"}]}
+					}`),
+				},
+			},
+		}
+	}
+
+	newHandler := func(failurePolicy datav1alpha1.MutatorFailurePolicy) *FluidMutatingHandler {
+		uid++
+		policy := &datav1alpha1.PodMutationPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: "team-a", Namespace: "team-a", UID: types.UID(strconv.Itoa(uid)), Generation: 1},
+			Spec: datav1alpha1.PodMutationPolicySpec{
+				PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "training"}},
+				ExternalMutators: []datav1alpha1.ExternalMutator{
+					{
+						Name:          "team-a",
+						Service:       datav1alpha1.MutatorServiceReference{Name: "team-a"},
+						CABundle:      caBundle,
+						FailurePolicy: failurePolicy,
+					},
+				},
+			},
+		}
+		fakeClient := fake.NewFakeClientWithScheme(s, policy)
+		Expect(plugins.RegisterMutatingHandlers(fakeClient)).To(Succeed())
+
+		handler := &FluidMutatingHandler{}
+		handler.Setup(fakeClient, fakeClient, decoder)
+		return handler
+	}
+
+	It("should mutate the pod with the external mutator of the policy", func() {
+		resp := newHandler(datav1alpha1.MutatorFailurePolicyFail).Handle(context.TODO(), newRequest())
+		Expect(resp.Allowed).To(BeTrue())
+		Expect(resp.Patches).To(ContainElement(HaveField("Path", "/metadata/annotations")))
+	})
+
+	It("should reject the pod if the external mutator fails with Fail policy", func() {
+		status = http.StatusServiceUnavailable
+		resp := newHandler(datav1alpha1.MutatorFailurePolicyFail).Handle(context.TODO(), newRequest())
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Message).To(ContainSubstring("unexpected status code 503"))
+		Expect(resp.Result.Message).NotTo(ContainSubstring("mutator is unavailable"))
+	})
+
+	It("should allow the pod if the external mutator fails with Ignore policy", func() {
+		status = http.StatusServiceUnavailable
+		resp := newHandler(datav1alpha1.MutatorFailurePolicyIgnore).Handle(context.TODO(), newRequest())
+		Expect(resp.Allowed).To(BeTrue())
+		Expect(resp.Patches).NotTo(ContainElement(HaveField("Path", "/metadata/annotations")))
+	})
+
+	It("should skip the policy plugins already in the plugins list", func() {
+		registered := plugins.GetRegistryHandler().GetPodWithDatasetHandler()
+		Expect(registered).NotTo(BeEmpty())
+
+		result := appendPolicyPlugins(registered[:1], registered)
+		Expect(result).To(Equal(registered))
+	})
+})
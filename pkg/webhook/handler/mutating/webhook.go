@@ -21,10 +21,12 @@ import (
 )
 
 // +kubebuilder:webhook:path=/mutate-fluid-io-v1alpha1-schedulepod,mutating=true,failurePolicy=fail,sideEffects=None,admissionReviewVersions=v1;v1beta1,groups="",resources=pods,verbs=create;update,versions=v1,name=schedulepod.fluid.io
+// +kubebuilder:webhook:path=/mutate-fluid-io-v1alpha1-dataset,mutating=true,failurePolicy=fail,sideEffects=None,admissionReviewVersions=v1;v1beta1,groups=data.fluid.io,resources=datasets,verbs=create;update,versions=v1alpha1,name=dataset.mutate.fluid.io
 
 var (
 	// HandlerMap contains admission webhook handlers
 	HandlerMap = map[string]common.AdmissionHandler{
-		common.WebhookSchedulePodPath: &FluidMutatingHandler{},
+		common.WebhookSchedulePodPath:   &FluidMutatingHandler{},
+		common.WebhookMutateDatasetPath: &DatasetCreatorHandler{},
 	}
 )
@@ -19,12 +19,14 @@ package handler
 import (
 	"github.com/fluid-cloudnative/fluid/pkg/common"
 	"github.com/fluid-cloudnative/fluid/pkg/webhook/handler/mutating"
+	"github.com/fluid-cloudnative/fluid/pkg/webhook/handler/validating"
 	"github.com/go-logr/logr"
 	"k8s.io/apimachinery/pkg/util/sets"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/conversion"
 )
 
 type GateFunc func() (enabled bool)
@@ -41,7 +43,7 @@ var (
 
 func init() {
 	addHandlers(mutating.HandlerMap)
-	// addHandlers(validating.HandlerMap)
+	addHandlers(validating.HandlerMap)
 }
 
 // Register registers the handlers to the manager
@@ -54,6 +56,11 @@ func Register(mgr manager.Manager, log logr.Logger) {
 		server.Register(path, &webhook.Admission{Handler: handler})
 		log.Info("Registered webhook handler", "path", path)
 	}
+
+	// the conversion webhook converts the kinds served in multiple versions through their hub versions
+	convertPath := "/" + common.WebhookConvertPath
+	server.Register(convertPath, conversion.NewWebhookHandler(mgr.GetScheme()))
+	log.Info("Registered conversion webhook handler", "path", convertPath)
 }
 
 func addHandlers(m map[string]common.AdmissionHandler) {
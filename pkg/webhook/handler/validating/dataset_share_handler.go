@@ -0,0 +1,130 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validating
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/datasetshare"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/base"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+)
+
+// DatasetShareHandler rejects the datasets referring to a dataset in another namespace with dataset://
+// unless a DatasetShare in that namespace grants the access.
+type DatasetShareHandler struct {
+	Client client.Client
+	Reader client.Reader
+	// A decoder will be automatically injected
+	decoder *admission.Decoder
+}
+
+func (h *DatasetShareHandler) Setup(client client.Client, reader client.Reader, decoder *admission.Decoder) {
+	h.Client = client
+	h.Reader = reader
+	h.decoder = decoder
+}
+
+// Handle is the validating logic of the datasets referring to other datasets
+func (h *DatasetShareHandler) Handle(ctx context.Context, req admission.Request) admission.Response {
+	defer utils.TimeTrack(time.Now(), "DatasetShareHandler.Handle",
+		"req.name", req.Name, "req.namespace", req.Namespace)
+
+	var log = ctrl.Log.WithName("validate-dataset-share")
+
+	if req.Operation != admissionv1.Create && req.Operation != admissionv1.Update {
+		return admission.Allowed("no need to validate the operation")
+	}
+
+	dataset := &datav1alpha1.Dataset{}
+	if err := h.decoder.DecodeRaw(req.Object, dataset); err != nil {
+		log.Error(err, "failed to decode the dataset", "name", req.Name, "namespace", req.Namespace)
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+	// the namespace is not set in the object of a create request sometimes
+	if dataset.Namespace == "" {
+		dataset.Namespace = req.Namespace
+	}
+
+	// the creator is recorded by the mutating webhook on creation and kept on updates
+	requester := req.UserInfo.Username
+	creator := requester
+	var oldDataset *datav1alpha1.Dataset
+	if req.Operation == admissionv1.Update {
+		oldDataset = &datav1alpha1.Dataset{}
+		if err := h.decoder.DecodeRaw(req.OldObject, oldDataset); err != nil {
+			log.Error(err, "failed to decode the old dataset", "name", req.Name, "namespace", req.Namespace)
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+		creator = datasetshare.GetCreator(oldDataset)
+	}
+	if recorded, found := dataset.Annotations[common.AnnotationDatasetCreator]; found && recorded != creator {
+		message := fmt.Sprintf("annotation %s of dataset %s/%s can't be set to %q, it's recorded by fluid",
+			common.AnnotationDatasetCreator, dataset.Namespace, dataset.Name, recorded)
+		log.Info("deny the dataset because of the forged creator", "name", req.Name, "namespace", req.Namespace, "reason", message)
+		return admission.Denied(message)
+	}
+
+	for _, shared := range base.GetPhysicalDatasetFromMounts(dataset.Spec.Mounts) {
+		if shared.Namespace == dataset.Namespace {
+			continue
+		}
+		// the references admitted before are not checked again, so that other changes of the dataset, e.g. its
+		// finalizers, are not blocked after the grant is revoked
+		if oldDataset != nil && refersTo(oldDataset, shared) &&
+			datasetshare.RequestsReadWrite(oldDataset) == datasetshare.RequestsReadWrite(dataset) {
+			continue
+		}
+
+		// the new or changed references are checked against the user making the request rather than the creator,
+		// otherwise a user allowed to update the dataset could refer to the datasets shared with its creator only
+		grant, err := datasetshare.FindGrant(h.Client, shared, dataset, requester)
+		if err != nil {
+			log.Error(err, "failed to check dataset shares", "name", req.Name, "namespace", req.Namespace, "shared", shared)
+			return admission.Errored(http.StatusInternalServerError, err)
+		}
+		if grant == nil {
+			message := datasetshare.DeniedMessage(shared, dataset)
+			log.Info("deny the dataset because of no dataset share", "name", req.Name, "namespace", req.Namespace, "reason", message)
+			return admission.Denied(message)
+		}
+		log.V(1).Info("the dataset is allowed by dataset share", "name", req.Name, "namespace", req.Namespace, "datasetShare", grant.DatasetShare)
+	}
+
+	return admission.Allowed("the referred datasets are shared with the dataset")
+}
+
+// refersTo checks if the dataset refers to the shared dataset
+func refersTo(dataset *datav1alpha1.Dataset, shared types.NamespacedName) bool {
+	for _, namespacedName := range base.GetPhysicalDatasetFromMounts(dataset.Spec.Mounts) {
+		if namespacedName == shared {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,179 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validating
+
+import (
+	"context"
+	"encoding/json"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+var _ = Describe("DatasetShareHandler", func() {
+	var (
+		s       *runtime.Scheme
+		handler *DatasetShareHandler
+	)
+
+	newDataset := func(mountPoint string, accessModes ...corev1.PersistentVolumeAccessMode) *datav1alpha1.Dataset {
+		return &datav1alpha1.Dataset{
+			TypeMeta:   metav1.TypeMeta{APIVersion: datav1alpha1.GroupVersion.String(), Kind: "Dataset"},
+			ObjectMeta: metav1.ObjectMeta{Name: "ref", Namespace: "team-a"},
+			Spec: datav1alpha1.DatasetSpec{
+				Mounts:      []datav1alpha1.Mount{{MountPoint: mountPoint}},
+				AccessModes: accessModes,
+			},
+		}
+	}
+
+	newRequest := func(operation admissionv1.Operation, obj, oldObj runtime.Object) admission.Request {
+		req := admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+			Name:      "ref",
+			Namespace: "team-a",
+			Operation: operation,
+			Resource:  metav1.GroupVersionResource{Group: datav1alpha1.GroupVersion.Group, Version: "v1alpha1", Resource: "datasets"},
+		}}
+		raw, err := json.Marshal(obj)
+		Expect(err).NotTo(HaveOccurred())
+		req.Object = runtime.RawExtension{Raw: raw}
+		if oldObj != nil {
+			raw, err = json.Marshal(oldObj)
+			Expect(err).NotTo(HaveOccurred())
+			req.OldObject = runtime.RawExtension{Raw: raw}
+		}
+		return req
+	}
+
+	BeforeEach(func() {
+		s = runtime.NewScheme()
+		Expect(datav1alpha1.AddToScheme(s)).To(Succeed())
+		c := fake.NewFakeClientWithScheme(s, &datav1alpha1.DatasetShare{
+			ObjectMeta: metav1.ObjectMeta{Name: "hbase", Namespace: "shared"},
+			Spec: datav1alpha1.DatasetShareSpec{
+				Dataset: "hbase",
+				Grants:  []datav1alpha1.DatasetShareGrant{{Namespaces: []string{"team-a"}}},
+			},
+		}, &datav1alpha1.DatasetShare{
+			ObjectMeta: metav1.ObjectMeta{Name: "spark", Namespace: "shared"},
+			Spec: datav1alpha1.DatasetShareSpec{
+				Dataset: "spark",
+				Grants: []datav1alpha1.DatasetShareGrant{{
+					ServiceAccounts: []datav1alpha1.DatasetShareServiceAccount{{Namespace: "team-a", Name: "deployer"}},
+				}},
+			},
+		})
+		handler = &DatasetShareHandler{}
+		handler.Setup(c, c, admission.NewDecoder(s))
+	})
+
+	It("should allow a dataset not referring to other namespaces", func() {
+		resp := handler.Handle(context.TODO(), newRequest(admissionv1.Create, newDataset("dataset://team-a/hbase", corev1.ReadWriteMany), nil))
+		Expect(resp.Allowed).To(BeTrue())
+	})
+
+	It("should allow a read-only reference granted by a DatasetShare", func() {
+		resp := handler.Handle(context.TODO(), newRequest(admissionv1.Create, newDataset("dataset://shared/hbase"), nil))
+		Expect(resp.Allowed).To(BeTrue())
+	})
+
+	It("should deny a reference not granted", func() {
+		resp := handler.Handle(context.TODO(), newRequest(admissionv1.Create, newDataset("dataset://shared/spark"), nil))
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Message).To(ContainSubstring("shared/spark"))
+	})
+
+	It("should deny a read-write reference granted read-only access", func() {
+		resp := handler.Handle(context.TODO(), newRequest(admissionv1.Create, newDataset("dataset://shared/hbase", corev1.ReadWriteMany), nil))
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Message).To(ContainSubstring(string(datav1alpha1.DatasetShareReadWrite)))
+	})
+
+	It("should allow a reference granted to the service account creating the dataset", func() {
+		req := newRequest(admissionv1.Create, newDataset("dataset://shared/spark"), nil)
+		req.UserInfo.Username = "system:serviceaccount:team-a:deployer"
+		resp := handler.Handle(context.TODO(), req)
+		Expect(resp.Allowed).To(BeTrue())
+	})
+
+	It("should check a reference added on update against the user making the update", func() {
+		dataset := newDataset("dataset://shared/hbase")
+		dataset.Annotations = map[string]string{common.AnnotationDatasetCreator: "alice"}
+		updated := newDataset("dataset://shared/spark")
+		updated.Annotations = map[string]string{common.AnnotationDatasetCreator: "alice"}
+		req := newRequest(admissionv1.Update, updated, dataset)
+		req.UserInfo.Username = "system:serviceaccount:team-a:deployer"
+		resp := handler.Handle(context.TODO(), req)
+		Expect(resp.Allowed).To(BeTrue())
+	})
+
+	It("should deny a reference added on update which is granted to the creator only", func() {
+		dataset := newDataset("dataset://shared/hbase")
+		dataset.Annotations = map[string]string{common.AnnotationDatasetCreator: "system:serviceaccount:team-a:deployer"}
+		updated := newDataset("dataset://shared/spark")
+		updated.Annotations = map[string]string{common.AnnotationDatasetCreator: "system:serviceaccount:team-a:deployer"}
+		req := newRequest(admissionv1.Update, updated, dataset)
+		req.UserInfo.Username = "alice"
+		resp := handler.Handle(context.TODO(), req)
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Message).To(ContainSubstring("shared/spark"))
+	})
+
+	It("should not check an unchanged reference against the user making the update", func() {
+		dataset := newDataset("dataset://shared/spark")
+		dataset.Annotations = map[string]string{common.AnnotationDatasetCreator: "system:serviceaccount:team-a:deployer"}
+		updated := dataset.DeepCopy()
+		updated.Labels = map[string]string{"team": "a"}
+		req := newRequest(admissionv1.Update, updated, dataset)
+		req.UserInfo.Username = "alice"
+		resp := handler.Handle(context.TODO(), req)
+		Expect(resp.Allowed).To(BeTrue())
+	})
+
+	It("should deny a forged creator", func() {
+		dataset := newDataset("dataset://shared/spark")
+		dataset.Annotations = map[string]string{common.AnnotationDatasetCreator: "system:serviceaccount:team-a:deployer"}
+		req := newRequest(admissionv1.Create, dataset, nil)
+		req.UserInfo.Username = "alice"
+		resp := handler.Handle(context.TODO(), req)
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Message).To(ContainSubstring(common.AnnotationDatasetCreator))
+	})
+
+	It("should not check the references admitted before on update", func() {
+		dataset := newDataset("dataset://shared/spark")
+		updated := dataset.DeepCopy()
+		updated.Finalizers = []string{"fluid-dataset-controller-finalizer"}
+		resp := handler.Handle(context.TODO(), newRequest(admissionv1.Update, updated, dataset))
+		Expect(resp.Allowed).To(BeTrue())
+	})
+
+	It("should check the references requesting more access on update", func() {
+		dataset := newDataset("dataset://shared/hbase")
+		updated := newDataset("dataset://shared/hbase", corev1.ReadWriteMany)
+		resp := handler.Handle(context.TODO(), newRequest(admissionv1.Update, updated, dataset))
+		Expect(resp.Allowed).To(BeFalse())
+	})
+})
@@ -0,0 +1,212 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validating
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/datasetquota"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+)
+
+const scaleSubResource = "scale"
+
+// runtimeFactories creates empty runtime objects by the resource names of the runtimes whose cache capacity
+// is limited by DatasetQuotas.
+var runtimeFactories = map[string]func() client.Object{
+	"alluxioruntimes":  func() client.Object { return &datav1alpha1.AlluxioRuntime{} },
+	"jindoruntimes":    func() client.Object { return &datav1alpha1.JindoRuntime{} },
+	"juicefsruntimes":  func() client.Object { return &datav1alpha1.JuiceFSRuntime{} },
+	"thinruntimes":     func() client.Object { return &datav1alpha1.ThinRuntime{} },
+	"efcruntimes":      func() client.Object { return &datav1alpha1.EFCRuntime{} },
+	"vineyardruntimes": func() client.Object { return &datav1alpha1.VineyardRuntime{} },
+	"cacheruntimes":    func() client.Object { return &datav1alpha1.CacheRuntime{} },
+}
+
+// RuntimeQuotaHandler rejects the creation or scale-up of runtimes which makes the cache capacity
+// requested in a namespace exceed the DatasetQuotas.
+type RuntimeQuotaHandler struct {
+	Client client.Client
+	Reader client.Reader
+	// A decoder will be automatically injected
+	decoder *admission.Decoder
+}
+
+func (h *RuntimeQuotaHandler) Setup(client client.Client, reader client.Reader, decoder *admission.Decoder) {
+	h.Client = client
+	h.Reader = reader
+	h.decoder = decoder
+}
+
+// Handle is the validating logic of runtime cache capacity
+func (h *RuntimeQuotaHandler) Handle(ctx context.Context, req admission.Request) admission.Response {
+	defer utils.TimeTrack(time.Now(), "RuntimeQuotaHandler.Handle",
+		"req.name", req.Name, "req.namespace", req.Namespace)
+
+	var log = ctrl.Log.WithName("validate-runtime-quota")
+
+	if req.Operation != admissionv1.Create && req.Operation != admissionv1.Update {
+		return admission.Allowed("no need to validate the operation")
+	}
+
+	newRuntime, found := runtimeFactories[req.Resource.Resource]
+	if !found {
+		return admission.Allowed("the runtime is not limited by dataset quotas")
+	}
+
+	requested, previous, err := h.requestedCapacity(req, newRuntime)
+	if err != nil {
+		log.Error(err, "failed to get the requested cache capacity", "name", req.Name, "namespace", req.Namespace)
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	if requested <= previous {
+		return admission.Allowed("the requested cache capacity is not increased")
+	}
+
+	message, err := h.exceededQuota(req.Namespace, req.Name, requested)
+	if err != nil {
+		log.Error(err, "failed to check dataset quotas", "name", req.Name, "namespace", req.Namespace)
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+	if len(message) > 0 {
+		log.Info("deny the runtime because of dataset quota", "name", req.Name, "namespace", req.Namespace, "reason", message)
+		return admission.Denied(message)
+	}
+
+	return admission.Allowed("the requested cache capacity is within dataset quotas")
+}
+
+// requestedCapacity returns the cache capacity requested by the runtime in the request and the one requested
+// before the request.
+func (h *RuntimeQuotaHandler) requestedCapacity(req admission.Request, newRuntime func() client.Object) (requested, previous int64, err error) {
+	if req.SubResource == scaleSubResource {
+		return h.requestedCapacityForScale(req, newRuntime)
+	}
+	if len(req.SubResource) > 0 {
+		return 0, 0, nil
+	}
+
+	runtime := newRuntime()
+	if err = h.decoder.DecodeRaw(req.Object, runtime); err != nil {
+		return
+	}
+	if requested, _, err = datasetquota.RequestedCapacity(runtime); err != nil {
+		return
+	}
+
+	if req.Operation == admissionv1.Update {
+		oldRuntime := newRuntime()
+		if err = h.decoder.DecodeRaw(req.OldObject, oldRuntime); err != nil {
+			return
+		}
+		if previous, _, err = datasetquota.RequestedCapacity(oldRuntime); err != nil {
+			return
+		}
+	}
+	return
+}
+
+// requestedCapacityForScale calculates the cache capacity with the replicas in the Scale object and the worker
+// capacity of the current runtime.
+func (h *RuntimeQuotaHandler) requestedCapacityForScale(req admission.Request, newRuntime func() client.Object) (requested, previous int64, err error) {
+	scale := &autoscalingv1.Scale{}
+	if err = h.decoder.DecodeRaw(req.Object, scale); err != nil {
+		return
+	}
+
+	runtime := newRuntime()
+	if err = h.Client.Get(context.TODO(), types.NamespacedName{Name: req.Name, Namespace: req.Namespace}, runtime); err != nil {
+		return
+	}
+
+	replicas, capacity, _, err := datasetquota.WorkerCapacity(runtime)
+	if err != nil {
+		return
+	}
+	return int64(scale.Spec.Replicas) * capacity, int64(replicas) * capacity, nil
+}
+
+// exceededQuota returns a non-empty message if the requested cache capacity of the runtime makes any
+// DatasetQuota applied to its dataset exceeded.
+func (h *RuntimeQuotaHandler) exceededQuota(namespace, name string, requested int64) (message string, err error) {
+	quotas, err := datasetquota.ListQuotas(h.Client, namespace)
+	if err != nil || len(quotas) == 0 {
+		return
+	}
+
+	// The dataset may be created after the runtime, whose labels are unknown then. The runtime is denied if any quota
+	// selects datasets by labels, otherwise it could escape the quota by labeling the dataset created later.
+	var datasetLabels map[string]string
+	dataset, err := utils.GetDataset(h.Client, name, namespace)
+	if err != nil {
+		if utils.IgnoreNotFound(err) != nil {
+			return
+		}
+		for i := range quotas {
+			if selectsByLabels(&quotas[i]) {
+				return fmt.Sprintf("dataset %s/%s of the runtime is not found, it must be created before the runtime because DatasetQuota %q selects datasets by labels",
+					namespace, name, quotas[i].Name), nil
+			}
+		}
+	} else {
+		datasetLabels = dataset.Labels
+	}
+
+	for i := range quotas {
+		quota := &quotas[i]
+		selected, err := datasetquota.Selects(quota, datasetLabels)
+		if err != nil {
+			return "", err
+		}
+		if !selected {
+			continue
+		}
+
+		others, err := datasetquota.RequestedByOthers(h.Client, quota, name)
+		if err != nil {
+			return "", err
+		}
+		if others+requested > quota.Spec.CacheCapacity.Value() {
+			return fmt.Sprintf("runtime %s/%s requests cache capacity %s, which exceeds DatasetQuota %q: %s is requested by other datasets and %s is allowed in total",
+				namespace, name,
+				resource.NewQuantity(requested, resource.BinarySI).String(),
+				quota.Name,
+				resource.NewQuantity(others, resource.BinarySI).String(),
+				quota.Spec.CacheCapacity.String()), nil
+		}
+	}
+	return "", nil
+}
+
+// selectsByLabels checks if the quota selects datasets by labels instead of selecting all the datasets in the namespace
+func selectsByLabels(quota *datav1alpha1.DatasetQuota) bool {
+	selector := quota.Spec.Selector
+	return selector != nil && (len(selector.MatchLabels) > 0 || len(selector.MatchExpressions) > 0)
+}
@@ -0,0 +1,196 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validating
+
+import (
+	"context"
+	"encoding/json"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	admissionv1 "k8s.io/api/admission/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+var _ = Describe("RuntimeQuotaHandler", func() {
+	var (
+		s       *runtime.Scheme
+		handler *RuntimeQuotaHandler
+		objs    []runtime.Object
+	)
+
+	newAlluxioRuntime := func(name string, replicas int32, quota string) *datav1alpha1.AlluxioRuntime {
+		q := resource.MustParse(quota)
+		return &datav1alpha1.AlluxioRuntime{
+			TypeMeta:   metav1.TypeMeta{APIVersion: datav1alpha1.GroupVersion.String(), Kind: "AlluxioRuntime"},
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "fluid"},
+			Spec: datav1alpha1.AlluxioRuntimeSpec{
+				Replicas: replicas,
+				TieredStore: datav1alpha1.TieredStore{
+					Levels: []datav1alpha1.Level{{MediumType: common.SSD, Quota: &q}},
+				},
+			},
+		}
+	}
+
+	newRequest := func(operation admissionv1.Operation, subResource string, obj, oldObj runtime.Object) admission.Request {
+		req := admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+			Name:        "demo",
+			Namespace:   "fluid",
+			Operation:   operation,
+			Resource:    metav1.GroupVersionResource{Group: datav1alpha1.GroupVersion.Group, Version: "v1alpha1", Resource: "alluxioruntimes"},
+			SubResource: subResource,
+		}}
+		raw, err := json.Marshal(obj)
+		Expect(err).NotTo(HaveOccurred())
+		req.Object = runtime.RawExtension{Raw: raw}
+		if oldObj != nil {
+			raw, err = json.Marshal(oldObj)
+			Expect(err).NotTo(HaveOccurred())
+			req.OldObject = runtime.RawExtension{Raw: raw}
+		}
+		return req
+	}
+
+	BeforeEach(func() {
+		s = runtime.NewScheme()
+		Expect(datav1alpha1.AddToScheme(s)).To(Succeed())
+		Expect(autoscalingv1.AddToScheme(s)).To(Succeed())
+
+		objs = []runtime.Object{
+			&datav1alpha1.DatasetQuota{
+				ObjectMeta: metav1.ObjectMeta{Name: "team-a", Namespace: "fluid"},
+				Spec: datav1alpha1.DatasetQuotaSpec{
+					CacheCapacity: resource.MustParse("10Gi"),
+					Selector:      &metav1.LabelSelector{MatchLabels: map[string]string{"team": "a"}},
+				},
+			},
+			&datav1alpha1.Dataset{
+				ObjectMeta: metav1.ObjectMeta{Name: "other", Namespace: "fluid", Labels: map[string]string{"team": "a"}},
+				Status: datav1alpha1.DatasetStatus{
+					Runtimes: []datav1alpha1.Runtime{{Name: "other", Namespace: "fluid", Type: common.AlluxioRuntime}},
+				},
+			},
+			newAlluxioRuntime("other", 2, "3Gi"),
+			&datav1alpha1.Dataset{
+				ObjectMeta: metav1.ObjectMeta{Name: "demo", Namespace: "fluid", Labels: map[string]string{"team": "a"}},
+			},
+		}
+		handler = &RuntimeQuotaHandler{}
+	})
+
+	setup := func(extra ...runtime.Object) {
+		c := fake.NewFakeClientWithScheme(s, append(objs, extra...)...)
+		handler.Setup(c, c, admission.NewDecoder(s))
+	}
+
+	It("should allow creating a runtime within the quota", func() {
+		setup()
+		resp := handler.Handle(context.TODO(), newRequest(admissionv1.Create, "", newAlluxioRuntime("demo", 2, "2Gi"), nil))
+		Expect(resp.Allowed).To(BeTrue())
+	})
+
+	It("should deny creating a runtime exceeding the quota", func() {
+		setup()
+		resp := handler.Handle(context.TODO(), newRequest(admissionv1.Create, "", newAlluxioRuntime("demo", 2, "3Gi"), nil))
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Message).To(ContainSubstring("team-a"))
+	})
+
+	It("should allow creating a runtime whose dataset is not selected", func() {
+		objs[3] = &datav1alpha1.Dataset{
+			ObjectMeta: metav1.ObjectMeta{Name: "demo", Namespace: "fluid", Labels: map[string]string{"team": "b"}},
+		}
+		setup()
+		resp := handler.Handle(context.TODO(), newRequest(admissionv1.Create, "", newAlluxioRuntime("demo", 10, "10Gi"), nil))
+		Expect(resp.Allowed).To(BeTrue())
+	})
+
+	It("should deny creating a runtime before its dataset if a quota selects datasets by labels", func() {
+		objs = objs[:3]
+		setup()
+		resp := handler.Handle(context.TODO(), newRequest(admissionv1.Create, "", newAlluxioRuntime("demo", 1, "1Gi"), nil))
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Message).To(ContainSubstring("not found"))
+
+		objs[0].(*datav1alpha1.DatasetQuota).Spec.Selector = nil
+		setup()
+		resp = handler.Handle(context.TODO(), newRequest(admissionv1.Create, "", newAlluxioRuntime("demo", 1, "1Gi"), nil))
+		Expect(resp.Allowed).To(BeTrue())
+	})
+
+	It("should allow updates which do not increase the requested capacity", func() {
+		setup()
+		resp := handler.Handle(context.TODO(), newRequest(admissionv1.Update, "",
+			newAlluxioRuntime("demo", 4, "3Gi"), newAlluxioRuntime("demo", 5, "3Gi")))
+		Expect(resp.Allowed).To(BeTrue())
+	})
+
+	It("should deny scaling up a runtime exceeding the quota", func() {
+		setup(newAlluxioRuntime("demo", 1, "2Gi"))
+		scale := &autoscalingv1.Scale{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "autoscaling/v1", Kind: "Scale"},
+			ObjectMeta: metav1.ObjectMeta{Name: "demo", Namespace: "fluid"},
+			Spec:       autoscalingv1.ScaleSpec{Replicas: 3},
+		}
+		resp := handler.Handle(context.TODO(), newRequest(admissionv1.Update, "scale", scale, nil))
+		Expect(resp.Allowed).To(BeFalse())
+
+		scale.Spec.Replicas = 2
+		resp = handler.Handle(context.TODO(), newRequest(admissionv1.Update, "scale", scale, nil))
+		Expect(resp.Allowed).To(BeTrue())
+	})
+
+	It("should count the runtimes whose datasets are not bound yet", func() {
+		objs[1].(*datav1alpha1.Dataset).Status.Runtimes = nil
+		setup()
+		resp := handler.Handle(context.TODO(), newRequest(admissionv1.Create, "", newAlluxioRuntime("demo", 2, "3Gi"), nil))
+		Expect(resp.Allowed).To(BeFalse())
+	})
+
+	It("should deny creating a CacheRuntime exceeding the quota", func() {
+		setup()
+		cacheRuntime := &datav1alpha1.CacheRuntime{
+			ObjectMeta: metav1.ObjectMeta{Name: "demo", Namespace: "fluid"},
+			Spec: datav1alpha1.CacheRuntimeSpec{Worker: datav1alpha1.CacheRuntimeWorkerSpec{
+				Replicas: 2,
+				TieredStore: datav1alpha1.RuntimeTieredStore{Levels: []datav1alpha1.RuntimeTieredStoreLevel{
+					{EmptyDir: &datav1alpha1.EmptyDirMediumSource{Quota: resource.MustParse("3Gi")}},
+				}},
+			}},
+		}
+		req := newRequest(admissionv1.Create, "", cacheRuntime, nil)
+		req.Resource.Resource = "cacheruntimes"
+		resp := handler.Handle(context.TODO(), req)
+		Expect(resp.Allowed).To(BeFalse())
+	})
+
+	It("should skip the resources not limited by quotas", func() {
+		setup()
+		req := newRequest(admissionv1.Create, "", &datav1alpha1.Dataset{}, nil)
+		req.Resource.Resource = "datasets"
+		resp := handler.Handle(context.TODO(), req)
+		Expect(resp.Allowed).To(BeTrue())
+	})
+})
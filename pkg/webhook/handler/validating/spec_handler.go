@@ -0,0 +1,447 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validating
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+	cdatabackup "github.com/fluid-cloudnative/fluid/pkg/databackup"
+	"github.com/fluid-cloudnative/fluid/pkg/dataflow"
+	cdatamigrate "github.com/fluid-cloudnative/fluid/pkg/datamigrate"
+	"github.com/fluid-cloudnative/fluid/pkg/dataoperation"
+	cdataprocess "github.com/fluid-cloudnative/fluid/pkg/dataprocess"
+	"github.com/fluid-cloudnative/fluid/pkg/datasetquota"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/base"
+	"github.com/fluid-cloudnative/fluid/pkg/utils"
+)
+
+// specValidator validates the objects of a resource.
+type specValidator struct {
+	newObject func() client.Object
+	// validate checks the spec of a created object, or an updated object whose spec is changed
+	validate func(obj client.Object) field.ErrorList
+	// validateUpdate checks the immutable fields of an updated object
+	validateUpdate func(obj, oldObj client.Object) field.ErrorList
+	// validateBinding checks the spec of an object against the objects bound to it, e.g. the mount points of
+	// a dataset against the runtime, in the same cases as validate
+	validateBinding func(ctx context.Context, reader client.Reader, obj client.Object) (field.ErrorList, error)
+}
+
+// specValidators contains the validators by the resource names
+var specValidators = map[string]specValidator{
+	"datasets": {
+		newObject:       func() client.Object { return &datav1alpha1.Dataset{} },
+		validate:        validateDataset,
+		validateUpdate:  validateDatasetUpdate,
+		validateBinding: validateDatasetBinding,
+	},
+	"dataloads": {
+		newObject:      func() client.Object { return &datav1alpha1.DataLoad{} },
+		validate:       validateDataLoad,
+		validateUpdate: validateDataLoadUpdate,
+	},
+	"datamigrates": {
+		newObject:      func() client.Object { return &datav1alpha1.DataMigrate{} },
+		validate:       validateDataMigrate,
+		validateUpdate: validateDataMigrateUpdate,
+	},
+	"databackups": {
+		newObject:      func() client.Object { return &datav1alpha1.DataBackup{} },
+		validate:       validateDataBackup,
+		validateUpdate: validateDataBackupUpdate,
+	},
+	"dataprocesses": {
+		newObject:      func() client.Object { return &datav1alpha1.DataProcess{} },
+		validate:       validateDataProcess,
+		validateUpdate: validateDataProcessUpdate,
+	},
+	"dataflows": {
+		newObject: func() client.Object { return &datav1alpha1.DataFlow{} },
+		validate:  validateDataFlow,
+	},
+}
+
+// runtimeTypes contains the runtime types by the resource names of the runtimes
+var runtimeTypes = map[string]string{
+	"alluxioruntimes":  common.AlluxioRuntime,
+	"jindoruntimes":    common.JindoRuntime,
+	"juicefsruntimes":  common.JuiceFSRuntime,
+	"thinruntimes":     common.ThinRuntime,
+	"efcruntimes":      common.EFCRuntime,
+	"vineyardruntimes": common.VineyardRuntime,
+	"cacheruntimes":    common.CacheRuntime,
+}
+
+func init() {
+	for resource, newRuntime := range runtimeFactories {
+		validator := specValidator{newObject: newRuntime, validate: validateRuntime, validateBinding: validateRuntimeBinding(runtimeTypes[resource])}
+		if resource == "juicefsruntimes" {
+			validator.validateUpdate = validateJuiceFSRuntimeUpdate
+		}
+		specValidators[resource] = validator
+	}
+}
+
+// SpecHandler rejects the datasets, runtimes and data operations with invalid specs, which would otherwise fail
+// in the reconciliation, and the updates changing their immutable fields.
+type SpecHandler struct {
+	Client client.Client
+	Reader client.Reader
+	// A decoder will be automatically injected
+	decoder *admission.Decoder
+}
+
+func (h *SpecHandler) Setup(client client.Client, reader client.Reader, decoder *admission.Decoder) {
+	h.Client = client
+	h.Reader = reader
+	h.decoder = decoder
+}
+
+// Handle is the validating logic of the specs
+func (h *SpecHandler) Handle(ctx context.Context, req admission.Request) admission.Response {
+	defer utils.TimeTrack(time.Now(), "SpecHandler.Handle",
+		"req.name", req.Name, "req.namespace", req.Namespace)
+
+	var log = ctrl.Log.WithName("validate-spec")
+
+	if req.Operation != admissionv1.Create && req.Operation != admissionv1.Update {
+		return admission.Allowed("no need to validate the operation")
+	}
+	if len(req.SubResource) > 0 {
+		return admission.Allowed("no need to validate the subresource")
+	}
+
+	validator, found := specValidators[req.Resource.Resource]
+	if !found {
+		return admission.Allowed("no need to validate the resource")
+	}
+
+	obj := validator.newObject()
+	if err := h.decoder.DecodeRaw(req.Object, obj); err != nil {
+		log.Error(err, "failed to decode the object", "name", req.Name, "namespace", req.Namespace)
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+	// the namespace is not set in the object of a create request sometimes
+	if obj.GetNamespace() == "" {
+		obj.SetNamespace(req.Namespace)
+	}
+
+	var allErrs field.ErrorList
+	validateSpec := func() error {
+		allErrs = append(allErrs, validator.validate(obj)...)
+		if validator.validateBinding == nil {
+			return nil
+		}
+		errs, err := validator.validateBinding(ctx, h.Reader, obj)
+		allErrs = append(allErrs, errs...)
+		return err
+	}
+	if req.Operation == admissionv1.Create {
+		if err := validateSpec(); err != nil {
+			log.Error(err, "failed to validate the object against the objects bound to it", "name", req.Name, "namespace", req.Namespace)
+			return admission.Errored(http.StatusInternalServerError, err)
+		}
+	} else {
+		if obj.GetDeletionTimestamp() != nil {
+			return admission.Allowed("the object is being deleted")
+		}
+		oldObj := validator.newObject()
+		if err := h.decoder.DecodeRaw(req.OldObject, oldObj); err != nil {
+			log.Error(err, "failed to decode the old object", "name", req.Name, "namespace", req.Namespace)
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+
+		// the objects admitted before are not validated again unless the spec is changed, so that other changes,
+		// e.g. the finalizers, are not blocked by the validation added later
+		changed, err := specChanged(req)
+		if err != nil {
+			log.Error(err, "failed to compare the specs", "name", req.Name, "namespace", req.Namespace)
+			return admission.Errored(http.StatusBadRequest, err)
+		}
+		if changed {
+			if err := validateSpec(); err != nil {
+				log.Error(err, "failed to validate the object against the objects bound to it", "name", req.Name, "namespace", req.Namespace)
+				return admission.Errored(http.StatusInternalServerError, err)
+			}
+		}
+		if validator.validateUpdate != nil {
+			allErrs = append(allErrs, validator.validateUpdate(obj, oldObj)...)
+		}
+	}
+
+	if len(allErrs) > 0 {
+		invalid := apierrors.NewInvalid(schema.GroupKind{Group: req.Kind.Group, Kind: req.Kind.Kind}, req.Name, allErrs)
+		log.Info("deny the object because of invalid spec", "name", req.Name, "namespace", req.Namespace, "reason", invalid.Error())
+		return admission.Response{AdmissionResponse: admissionv1.AdmissionResponse{
+			Allowed: false,
+			Result:  &invalid.ErrStatus,
+		}}
+	}
+
+	return admission.Allowed("the spec is valid")
+}
+
+// specChanged checks if the spec of the object in the update request is changed
+func specChanged(req admission.Request) (bool, error) {
+	var obj, oldObj struct {
+		Spec interface{} `json:"spec"`
+	}
+	if err := json.Unmarshal(req.Object.Raw, &obj); err != nil {
+		return false, err
+	}
+	if err := json.Unmarshal(req.OldObject.Raw, &oldObj); err != nil {
+		return false, err
+	}
+	return !apiequality.Semantic.DeepEqual(obj.Spec, oldObj.Spec), nil
+}
+
+// validateName checks the name of a dataset or a runtime in the same way as their controllers
+func validateName(obj client.Object) field.ErrorList {
+	var allErrs field.ErrorList
+	name := obj.GetName()
+	if len(name) == 0 {
+		return allErrs
+	}
+	if errs := validation.IsDNS1035Label(name); len(errs) > 0 {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("metadata", "name"), name, strings.Join(errs, ", ")))
+	}
+	return allErrs
+}
+
+// validateDatasetNamespace checks the namespace of the dataset a data operation targets, which must be the
+// namespace of the data operation if set
+func validateDatasetNamespace(obj client.Object, namespace string, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if len(namespace) > 0 && namespace != obj.GetNamespace() {
+		allErrs = append(allErrs, field.Invalid(fldPath, namespace, "must be the same as the namespace of the data operation"))
+	}
+	return allErrs
+}
+
+func validateDataset(obj client.Object) field.ErrorList {
+	dataset := obj.(*datav1alpha1.Dataset)
+	allErrs := validateName(dataset)
+	allErrs = append(allErrs, base.ValidateMounts(dataset.Spec.Mounts, field.NewPath("spec", "mounts"))...)
+	return allErrs
+}
+
+func validateDatasetUpdate(obj, oldObj client.Object) field.ErrorList {
+	dataset, oldDataset := obj.(*datav1alpha1.Dataset), oldObj.(*datav1alpha1.Dataset)
+	return apivalidation.ValidateImmutableField(dataset.Spec.SnapshotRef, oldDataset.Spec.SnapshotRef, field.NewPath("spec", "snapshotRef"))
+}
+
+// validateDatasetBinding checks the mount points of a dataset against the runtime with the same name, which the
+// dataset is going to be bound to, in the same way as the engine of the runtime. A bound dataset isn't checked.
+func validateDatasetBinding(ctx context.Context, reader client.Reader, obj client.Object) (field.ErrorList, error) {
+	dataset := obj.(*datav1alpha1.Dataset)
+	if dataset.Status.Phase == datav1alpha1.BoundDatasetPhase {
+		return nil, nil
+	}
+	for resource, newRuntime := range runtimeFactories {
+		runtime := newRuntime()
+		err := reader.Get(ctx, types.NamespacedName{Namespace: dataset.Namespace, Name: dataset.Name}, runtime)
+		if apierrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		return ddc.ValidateMountPoints(runtimeTypes[resource], dataset.Spec.Mounts, field.NewPath("spec", "mounts")), nil
+	}
+	return nil, nil
+}
+
+// validateRuntimeBinding checks the mount points of the dataset with the same name as a runtime, which is going
+// to be bound to the runtime, against the runtime type in the same way as the engine of the runtime. A bound
+// dataset isn't checked.
+func validateRuntimeBinding(runtimeType string) func(ctx context.Context, reader client.Reader, obj client.Object) (field.ErrorList, error) {
+	return func(ctx context.Context, reader client.Reader, obj client.Object) (field.ErrorList, error) {
+		dataset := &datav1alpha1.Dataset{}
+		err := reader.Get(ctx, types.NamespacedName{Namespace: obj.GetNamespace(), Name: obj.GetName()}, dataset)
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		if err != nil || dataset.Status.Phase == datav1alpha1.BoundDatasetPhase {
+			return nil, err
+		}
+		var allErrs field.ErrorList
+		for _, err := range ddc.ValidateMountPoints(runtimeType, dataset.Spec.Mounts, field.NewPath("spec", "mounts")) {
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("metadata", "name"),
+				"the dataset "+dataset.Name+" bound to the runtime has an invalid mount point: "+err.Error()))
+		}
+		return allErrs, nil
+	}
+}
+
+func validateRuntime(obj client.Object) field.ErrorList {
+	allErrs := validateName(obj)
+	_, tieredStore, _ := datasetquota.CacheSpec(obj)
+	allErrs = append(allErrs, base.ValidateTieredStore(tieredStore, field.NewPath("spec", "tieredstore"))...)
+	allErrs = append(allErrs, base.ValidateDataOperationPolicy(base.GetDataOperationPolicy(obj), field.NewPath("spec", "dataOperationPolicy"))...)
+	return allErrs
+}
+
+func validateJuiceFSRuntimeUpdate(obj, oldObj client.Object) field.ErrorList {
+	runtime, oldRuntime := obj.(*datav1alpha1.JuiceFSRuntime), oldObj.(*datav1alpha1.JuiceFSRuntime)
+	return apivalidation.ValidateImmutableField(runtime.Spec.VolumeClaimTemplates, oldRuntime.Spec.VolumeClaimTemplates, field.NewPath("spec", "volumeClaimTemplates"))
+}
+
+func validateDataLoad(obj client.Object) field.ErrorList {
+	dataLoad := obj.(*datav1alpha1.DataLoad)
+	specPath := field.NewPath("spec")
+	allErrs := validateDatasetNamespace(dataLoad, dataLoad.Spec.Dataset.Namespace, specPath.Child("dataset", "namespace"))
+	allErrs = append(allErrs, dataoperation.ValidateSchedule(dataLoad.Spec.Policy, dataLoad.Spec.Schedule, specPath.Child("schedule"))...)
+	allErrs = append(allErrs, dataoperation.ValidateRetryPolicy(dataLoad.Spec.Policy, dataLoad.Spec.RetryPolicy, specPath.Child("retryPolicy"))...)
+	allErrs = append(allErrs, validateEvents(dataLoad.Spec.Policy, dataLoad.Spec.Events, specPath.Child("events"))...)
+	return allErrs
+}
+
+func validateDataLoadUpdate(obj, oldObj client.Object) field.ErrorList {
+	dataLoad, oldDataLoad := obj.(*datav1alpha1.DataLoad), oldObj.(*datav1alpha1.DataLoad)
+	return apivalidation.ValidateImmutableField(dataLoad.Spec.Dataset, oldDataLoad.Spec.Dataset, field.NewPath("spec", "dataset"))
+}
+
+func validateDataMigrate(obj client.Object) field.ErrorList {
+	dataMigrate := obj.(*datav1alpha1.DataMigrate)
+	specPath := field.NewPath("spec")
+	var allErrs field.ErrorList
+	for _, data := range []struct {
+		path *field.Path
+		data datav1alpha1.DataToMigrate
+	}{
+		{path: specPath.Child("from"), data: dataMigrate.Spec.From},
+		{path: specPath.Child("to"), data: dataMigrate.Spec.To},
+	} {
+		if data.data.DataSet == nil && data.data.ExternalStorage == nil {
+			allErrs = append(allErrs, field.Required(data.path, "either dataset or externalStorage must be set"))
+		} else if data.data.DataSet != nil && data.data.ExternalStorage != nil {
+			allErrs = append(allErrs, field.Forbidden(data.path, "only one of dataset and externalStorage can be set"))
+		}
+	}
+	if dataMigrate.Spec.From.DataSet == nil && dataMigrate.Spec.To.DataSet == nil {
+		allErrs = append(allErrs, field.Required(specPath.Child("to", "dataset"), "either spec.to.dataset or spec.from.dataset must be set"))
+	}
+	allErrs = append(allErrs, dataoperation.ValidateSchedule(dataMigrate.Spec.Policy, dataMigrate.Spec.Schedule, specPath.Child("schedule"))...)
+	allErrs = append(allErrs, dataoperation.ValidateRetryPolicy(dataMigrate.Spec.Policy, dataMigrate.Spec.RetryPolicy, specPath.Child("retryPolicy"))...)
+	if dataMigrate.Spec.Parallelism > 1 && len(dataMigrate.Spec.ParallelOptions[cdatamigrate.SSHSecretName]) == 0 {
+		allErrs = append(allErrs, field.Required(specPath.Child("parallelOptions").Key(cdatamigrate.SSHSecretName), "must be set when parallelism is greater than 1"))
+	}
+	if dataMigrate.Spec.Resume {
+		if dataMigrate.Spec.Parallelism > 1 {
+			allErrs = append(allErrs, field.Forbidden(specPath.Child("resume"), "is not supported when parallelism is greater than 1"))
+		}
+		if len(dataMigrate.Spec.ManifestVolumeClaim) == 0 {
+			allErrs = append(allErrs, field.Required(specPath.Child("manifestVolumeClaim"), "must be set when resume is true"))
+		}
+	}
+	return allErrs
+}
+
+func validateDataMigrateUpdate(obj, oldObj client.Object) field.ErrorList {
+	dataMigrate, oldDataMigrate := obj.(*datav1alpha1.DataMigrate), oldObj.(*datav1alpha1.DataMigrate)
+	specPath := field.NewPath("spec")
+	allErrs := apivalidation.ValidateImmutableField(dataMigrate.Spec.From, oldDataMigrate.Spec.From, specPath.Child("from"))
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(dataMigrate.Spec.To, oldDataMigrate.Spec.To, specPath.Child("to"))...)
+	return allErrs
+}
+
+func validateDataBackup(obj client.Object) field.ErrorList {
+	dataBackup := obj.(*datav1alpha1.DataBackup)
+	var allErrs field.ErrorList
+	if !cdatabackup.IsSupportedBackupPath(dataBackup.Spec.BackupPath) {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "backupPath"), dataBackup.Spec.BackupPath, "must be a local:// or pvc:// path"))
+	}
+	return allErrs
+}
+
+func validateDataBackupUpdate(obj, oldObj client.Object) field.ErrorList {
+	dataBackup, oldDataBackup := obj.(*datav1alpha1.DataBackup), oldObj.(*datav1alpha1.DataBackup)
+	specPath := field.NewPath("spec")
+	allErrs := apivalidation.ValidateImmutableField(dataBackup.Spec.Dataset, oldDataBackup.Spec.Dataset, specPath.Child("dataset"))
+	allErrs = append(allErrs, apivalidation.ValidateImmutableField(dataBackup.Spec.BackupPath, oldDataBackup.Spec.BackupPath, specPath.Child("backupPath"))...)
+	return allErrs
+}
+
+func validateDataProcess(obj client.Object) field.ErrorList {
+	dataProcess := obj.(*datav1alpha1.DataProcess)
+	specPath := field.NewPath("spec")
+	allErrs := validateDatasetNamespace(dataProcess, dataProcess.Spec.Dataset.Namespace, specPath.Child("dataset", "namespace"))
+
+	processorPath := specPath.Child("processor")
+	processor := dataProcess.Spec.Processor
+	switch {
+	case processor.Job == nil && processor.Script == nil:
+		allErrs = append(allErrs, field.Required(processorPath, "either job or script must be set"))
+	case processor.Job != nil && processor.Script != nil:
+		allErrs = append(allErrs, field.Forbidden(processorPath, "only one of job and script can be set"))
+	default:
+		mountPath := dataProcess.Spec.Dataset.MountPath
+		if pass, volName, containerName := cdataprocess.GetProcessorImpl(dataProcess).ValidateDatasetMountPath(mountPath); !pass {
+			allErrs = append(allErrs, field.Invalid(specPath.Child("dataset", "mountPath"), mountPath,
+				"conflicts with the mount path of volume "+volName+" in container "+containerName))
+		}
+	}
+
+	allErrs = append(allErrs, dataoperation.ValidateSchedule(dataProcess.Spec.Policy, dataProcess.Spec.Schedule, specPath.Child("schedule"))...)
+	allErrs = append(allErrs, dataoperation.ValidateRetryPolicy(dataProcess.Spec.Policy, dataProcess.Spec.RetryPolicy, specPath.Child("retryPolicy"))...)
+	allErrs = append(allErrs, validateEvents(dataProcess.Spec.Policy, dataProcess.Spec.Events, specPath.Child("events"))...)
+	return allErrs
+}
+
+func validateDataProcessUpdate(obj, oldObj client.Object) field.ErrorList {
+	dataProcess, oldDataProcess := obj.(*datav1alpha1.DataProcess), oldObj.(*datav1alpha1.DataProcess)
+	return apivalidation.ValidateImmutableField(dataProcess.Spec.Dataset.TargetDataset, oldDataProcess.Spec.Dataset.TargetDataset, field.NewPath("spec", "dataset"))
+}
+
+func validateDataFlow(obj client.Object) field.ErrorList {
+	dataFlow := obj.(*datav1alpha1.DataFlow)
+	return dataflow.ValidateFlowSpec(dataFlow.Name, &dataFlow.Spec, field.NewPath("spec"))
+}
+
+// validateEvents checks the event sources of a data operation with the OnEvent policy in the same way as
+// its controller
+func validateEvents(policy datav1alpha1.Policy, events []datav1alpha1.EventSource, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if policy != datav1alpha1.OnEvent {
+		return allErrs
+	}
+	for i, source := range events {
+		if err := dataflow.ValidateEventSource(source); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i), source.Type, err.Error()))
+		}
+	}
+	return allErrs
+}
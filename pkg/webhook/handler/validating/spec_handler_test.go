@@ -0,0 +1,304 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validating
+
+import (
+	"context"
+	"encoding/json"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+var _ = Describe("SpecHandler", func() {
+	var handler *SpecHandler
+
+	newRequest := func(operation admissionv1.Operation, resource string, obj, oldObj client.Object) admission.Request {
+		req := admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+			Name:      obj.GetName(),
+			Namespace: "default",
+			Operation: operation,
+			Kind:      metav1.GroupVersionKind{Group: datav1alpha1.GroupVersion.Group, Version: "v1alpha1", Kind: "Test"},
+			Resource:  metav1.GroupVersionResource{Group: datav1alpha1.GroupVersion.Group, Version: "v1alpha1", Resource: resource},
+		}}
+		raw, err := json.Marshal(obj)
+		Expect(err).NotTo(HaveOccurred())
+		req.Object = runtime.RawExtension{Raw: raw}
+		if oldObj != nil {
+			raw, err = json.Marshal(oldObj)
+			Expect(err).NotTo(HaveOccurred())
+			req.OldObject = runtime.RawExtension{Raw: raw}
+		}
+		return req
+	}
+
+	causeFields := func(resp admission.Response) []string {
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result).NotTo(BeNil())
+		Expect(resp.Result.Details).NotTo(BeNil())
+		var fields []string
+		for _, cause := range resp.Result.Details.Causes {
+			fields = append(fields, cause.Field)
+		}
+		return fields
+	}
+
+	newDataset := func(mountPoint string) *datav1alpha1.Dataset {
+		return &datav1alpha1.Dataset{
+			ObjectMeta: metav1.ObjectMeta{Name: "hbase", Namespace: "default"},
+			Spec:       datav1alpha1.DatasetSpec{Mounts: []datav1alpha1.Mount{{MountPoint: mountPoint}}},
+		}
+	}
+
+	BeforeEach(func() {
+		s := runtime.NewScheme()
+		Expect(datav1alpha1.AddToScheme(s)).To(Succeed())
+		c := fake.NewFakeClientWithScheme(s)
+		handler = &SpecHandler{}
+		handler.Setup(c, c, admission.NewDecoder(s))
+	})
+
+	It("should allow a valid dataset", func() {
+		resp := handler.Handle(context.TODO(), newRequest(admissionv1.Create, "datasets", newDataset("s3://bucket/hbase"), nil))
+		Expect(resp.Allowed).To(BeTrue())
+	})
+
+	It("should deny a dataset with an invalid name and mount point", func() {
+		dataset := newDataset("/mnt/hbase")
+		dataset.Name = "hbase.v1"
+		resp := handler.Handle(context.TODO(), newRequest(admissionv1.Create, "datasets", dataset, nil))
+		Expect(causeFields(resp)).To(ConsistOf("metadata.name", "spec.mounts[0].mountPoint"))
+	})
+
+	It("should not validate the unchanged spec on update", func() {
+		dataset := newDataset("/mnt/hbase")
+		updated := dataset.DeepCopy()
+		updated.Finalizers = []string{"fluid-dataset-controller-finalizer"}
+		resp := handler.Handle(context.TODO(), newRequest(admissionv1.Update, "datasets", updated, dataset))
+		Expect(resp.Allowed).To(BeTrue())
+	})
+
+	It("should deny the change of an immutable field", func() {
+		dataset := newDataset("s3://bucket/hbase")
+		dataset.Spec.SnapshotRef = &datav1alpha1.DatasetSnapshotRef{Name: "hbase-v1"}
+		updated := dataset.DeepCopy()
+		updated.Spec.SnapshotRef.Name = "hbase-v2"
+		resp := handler.Handle(context.TODO(), newRequest(admissionv1.Update, "datasets", updated, dataset))
+		Expect(causeFields(resp)).To(ConsistOf("spec.snapshotRef"))
+	})
+
+	It("should allow the update of an object being deleted", func() {
+		dataset := newDataset("s3://bucket/hbase")
+		updated := newDataset("/mnt/hbase")
+		now := metav1.Now()
+		updated.DeletionTimestamp = &now
+		resp := handler.Handle(context.TODO(), newRequest(admissionv1.Update, "datasets", updated, dataset))
+		Expect(resp.Allowed).To(BeTrue())
+	})
+
+	It("should deny a runtime with an invalid tiered store", func() {
+		runtime := &datav1alpha1.AlluxioRuntime{
+			ObjectMeta: metav1.ObjectMeta{Name: "hbase", Namespace: "default"},
+			Spec: datav1alpha1.AlluxioRuntimeSpec{
+				TieredStore: datav1alpha1.TieredStore{Levels: []datav1alpha1.Level{
+					{MediumType: "MEM", Path: "/dev/shm,/mnt/cache", QuotaList: "1Gi"},
+				}},
+			},
+		}
+		resp := handler.Handle(context.TODO(), newRequest(admissionv1.Create, "alluxioruntimes", runtime, nil))
+		Expect(causeFields(resp)).To(ConsistOf("spec.tieredstore.levels[0].quotaList"))
+	})
+
+	It("should deny a dataset with a mount point unsupported by its runtime", func() {
+		runtime := &datav1alpha1.JuiceFSRuntime{ObjectMeta: metav1.ObjectMeta{Name: "hbase", Namespace: "default"}}
+		Expect(handler.Client.Create(context.TODO(), runtime)).To(Succeed())
+		resp := handler.Handle(context.TODO(), newRequest(admissionv1.Create, "datasets", newDataset("s3://bucket/hbase"), nil))
+		Expect(causeFields(resp)).To(ConsistOf("spec.mounts[0].mountPoint"))
+
+		resp = handler.Handle(context.TODO(), newRequest(admissionv1.Create, "datasets", newDataset("juicefs:///hbase"), nil))
+		Expect(resp.Allowed).To(BeTrue())
+	})
+
+	It("should not check the mount points of a bound dataset", func() {
+		runtime := &datav1alpha1.JuiceFSRuntime{ObjectMeta: metav1.ObjectMeta{Name: "hbase", Namespace: "default"}}
+		Expect(handler.Client.Create(context.TODO(), runtime)).To(Succeed())
+		dataset := newDataset("s3://bucket/hbase")
+		dataset.Status.Phase = datav1alpha1.BoundDatasetPhase
+		resp := handler.Handle(context.TODO(), newRequest(admissionv1.Create, "datasets", dataset, nil))
+		Expect(resp.Allowed).To(BeTrue())
+	})
+
+	It("should deny a runtime not supporting the mount points of its dataset", func() {
+		Expect(handler.Client.Create(context.TODO(), newDataset("viewfs://cluster/hbase"))).To(Succeed())
+		runtime := &datav1alpha1.EFCRuntime{ObjectMeta: metav1.ObjectMeta{Name: "hbase", Namespace: "default"}}
+		resp := handler.Handle(context.TODO(), newRequest(admissionv1.Create, "efcruntimes", runtime, nil))
+		Expect(causeFields(resp)).To(ConsistOf("metadata.name"))
+
+		alluxioRuntime := &datav1alpha1.AlluxioRuntime{ObjectMeta: metav1.ObjectMeta{Name: "hbase", Namespace: "default"}}
+		resp = handler.Handle(context.TODO(), newRequest(admissionv1.Create, "alluxioruntimes", alluxioRuntime, nil))
+		Expect(resp.Allowed).To(BeTrue())
+	})
+
+	It("should deny the change of the volume claim templates of a JuiceFSRuntime", func() {
+		runtime := &datav1alpha1.JuiceFSRuntime{ObjectMeta: metav1.ObjectMeta{Name: "jfsdemo", Namespace: "default"}}
+		updated := runtime.DeepCopy()
+		updated.Spec.VolumeClaimTemplates = []corev1.PersistentVolumeClaim{{ObjectMeta: metav1.ObjectMeta{Name: "cache"}}}
+		resp := handler.Handle(context.TODO(), newRequest(admissionv1.Update, "juicefsruntimes", updated, runtime))
+		Expect(causeFields(resp)).To(ConsistOf("spec.volumeClaimTemplates"))
+	})
+
+	It("should deny a DataLoad with an invalid schedule and event source", func() {
+		dataLoad := &datav1alpha1.DataLoad{
+			ObjectMeta: metav1.ObjectMeta{Name: "hbase-load", Namespace: "default"},
+			Spec: datav1alpha1.DataLoadSpec{
+				Dataset:  datav1alpha1.TargetDataset{Name: "hbase", Namespace: "other"},
+				Policy:   datav1alpha1.Cron,
+				Schedule: "every minute",
+			},
+		}
+		resp := handler.Handle(context.TODO(), newRequest(admissionv1.Create, "dataloads", dataLoad, nil))
+		Expect(causeFields(resp)).To(ConsistOf("spec.dataset.namespace", "spec.schedule"))
+
+		dataLoad.Spec.Dataset.Namespace = "default"
+		dataLoad.Spec.Policy = datav1alpha1.OnEvent
+		dataLoad.Spec.Events = []datav1alpha1.EventSource{{Type: datav1alpha1.OperationCompletedEvent}}
+		resp = handler.Handle(context.TODO(), newRequest(admissionv1.Create, "dataloads", dataLoad, nil))
+		Expect(causeFields(resp)).To(ConsistOf("spec.events[0]"))
+	})
+
+	It("should deny a retry policy on a DataProcess with the Cron policy", func() {
+		dataProcess := &datav1alpha1.DataProcess{
+			ObjectMeta: metav1.ObjectMeta{Name: "hbase-process", Namespace: "default"},
+			Spec: datav1alpha1.DataProcessSpec{
+				Dataset: datav1alpha1.TargetDatasetWithMountPath{
+					TargetDataset: datav1alpha1.TargetDataset{Name: "hbase", Namespace: "default"},
+					MountPath:     "/data",
+				},
+				Processor:   datav1alpha1.Processor{Script: &datav1alpha1.ScriptProcessor{}},
+				Policy:      datav1alpha1.Cron,
+				Schedule:    "0 * * * *",
+				RetryPolicy: &datav1alpha1.RetryPolicy{MaxAttempts: 3},
+			},
+		}
+		resp := handler.Handle(context.TODO(), newRequest(admissionv1.Create, "dataprocesses", dataProcess, nil))
+		Expect(causeFields(resp)).To(ConsistOf("spec.retryPolicy"))
+
+		dataProcess.Spec.Policy = datav1alpha1.Once
+		resp = handler.Handle(context.TODO(), newRequest(admissionv1.Create, "dataprocesses", dataProcess, nil))
+		Expect(resp.Allowed).To(BeTrue())
+	})
+
+	It("should deny a DataMigrate without dataset", func() {
+		dataMigrate := &datav1alpha1.DataMigrate{
+			ObjectMeta: metav1.ObjectMeta{Name: "hbase-migrate", Namespace: "default"},
+			Spec: datav1alpha1.DataMigrateSpec{
+				From:        datav1alpha1.DataToMigrate{ExternalStorage: &datav1alpha1.ExternalStorage{URI: "s3://bucket/hbase"}},
+				Parallelism: 2,
+			},
+		}
+		resp := handler.Handle(context.TODO(), newRequest(admissionv1.Create, "datamigrates", dataMigrate, nil))
+		Expect(causeFields(resp)).To(ConsistOf("spec.to", "spec.to.dataset", "spec.parallelOptions[sshSecretName]"))
+	})
+
+	It("should deny a resumable DataMigrate with parallelism or without the manifest volume claim", func() {
+		dataMigrate := &datav1alpha1.DataMigrate{
+			ObjectMeta: metav1.ObjectMeta{Name: "hbase-migrate", Namespace: "default"},
+			Spec: datav1alpha1.DataMigrateSpec{
+				From:            datav1alpha1.DataToMigrate{ExternalStorage: &datav1alpha1.ExternalStorage{URI: "s3://bucket/hbase"}},
+				To:              datav1alpha1.DataToMigrate{DataSet: &datav1alpha1.DatasetToMigrate{Name: "hbase", Namespace: "default"}},
+				Parallelism:     2,
+				ParallelOptions: map[string]string{"sshSecretName": "ssh-secret"},
+				Resume:          true,
+			},
+		}
+		resp := handler.Handle(context.TODO(), newRequest(admissionv1.Create, "datamigrates", dataMigrate, nil))
+		Expect(causeFields(resp)).To(ConsistOf("spec.resume", "spec.manifestVolumeClaim"))
+
+		dataMigrate.Spec.Parallelism = 1
+		dataMigrate.Spec.ManifestVolumeClaim = "migrate-manifest"
+		resp = handler.Handle(context.TODO(), newRequest(admissionv1.Create, "datamigrates", dataMigrate, nil))
+		Expect(resp.Allowed).To(BeTrue())
+	})
+
+	It("should deny a DataBackup with an unsupported path and the change of the path", func() {
+		dataBackup := &datav1alpha1.DataBackup{
+			ObjectMeta: metav1.ObjectMeta{Name: "hbase-backup", Namespace: "default"},
+			Spec:       datav1alpha1.DataBackupSpec{Dataset: "hbase", BackupPath: "s3://bucket/backup"},
+		}
+		resp := handler.Handle(context.TODO(), newRequest(admissionv1.Create, "databackups", dataBackup, nil))
+		Expect(causeFields(resp)).To(ConsistOf("spec.backupPath"))
+
+		updated := dataBackup.DeepCopy()
+		dataBackup.Spec.BackupPath = "pvc://backup-pvc"
+		updated.Spec.BackupPath = "local:///backup"
+		resp = handler.Handle(context.TODO(), newRequest(admissionv1.Update, "databackups", updated, dataBackup))
+		Expect(causeFields(resp)).To(ConsistOf("spec.backupPath"))
+	})
+
+	It("should deny a DataProcess with multiple processors", func() {
+		dataProcess := &datav1alpha1.DataProcess{
+			ObjectMeta: metav1.ObjectMeta{Name: "hbase-process", Namespace: "default"},
+			Spec: datav1alpha1.DataProcessSpec{
+				Dataset: datav1alpha1.TargetDatasetWithMountPath{
+					TargetDataset: datav1alpha1.TargetDataset{Name: "hbase", Namespace: "default"},
+					MountPath:     "/data",
+				},
+				Processor: datav1alpha1.Processor{
+					Job:    &datav1alpha1.JobProcessor{},
+					Script: &datav1alpha1.ScriptProcessor{},
+				},
+			},
+		}
+		resp := handler.Handle(context.TODO(), newRequest(admissionv1.Create, "dataprocesses", dataProcess, nil))
+		Expect(causeFields(resp)).To(ConsistOf("spec.processor"))
+	})
+
+	It("should deny a DataFlow whose steps depend on an unknown step", func() {
+		dataFlow := &datav1alpha1.DataFlow{
+			ObjectMeta: metav1.ObjectMeta{Name: "preprocess", Namespace: "default"},
+			Spec: datav1alpha1.DataFlowSpec{
+				Steps: []datav1alpha1.DataFlowStep{
+					{
+						Name:      "load",
+						DependsOn: []string{"clean"},
+						DataLoad:  &datav1alpha1.DataLoadSpec{Dataset: datav1alpha1.TargetDataset{Name: "hbase"}},
+					},
+				},
+			},
+		}
+		resp := handler.Handle(context.TODO(), newRequest(admissionv1.Create, "dataflows", dataFlow, nil))
+		Expect(causeFields(resp)).To(ConsistOf("spec.steps"))
+	})
+
+	It("should allow the subresources and the resources not validated", func() {
+		runtime := &datav1alpha1.AlluxioRuntime{ObjectMeta: metav1.ObjectMeta{Name: "hbase", Namespace: "default"}}
+		req := newRequest(admissionv1.Update, "alluxioruntimes", runtime, runtime)
+		req.SubResource = "scale"
+		Expect(handler.Handle(context.TODO(), req).Allowed).To(BeTrue())
+
+		share := &datav1alpha1.DatasetShare{ObjectMeta: metav1.ObjectMeta{Name: "hbase", Namespace: "default"}}
+		Expect(handler.Handle(context.TODO(), newRequest(admissionv1.Create, "datasetshares", share, nil)).Allowed).To(BeTrue())
+	})
+})
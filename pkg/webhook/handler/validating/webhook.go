@@ -0,0 +1,34 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validating
+
+import (
+	"github.com/fluid-cloudnative/fluid/pkg/common"
+)
+
+// +kubebuilder:webhook:path=/validate-fluid-io-v1alpha1-runtime,mutating=false,failurePolicy=fail,sideEffects=None,admissionReviewVersions=v1;v1beta1,groups=data.fluid.io,resources=alluxioruntimes;jindoruntimes;juicefsruntimes;thinruntimes;efcruntimes;vineyardruntimes;cacheruntimes;alluxioruntimes/scale;jindoruntimes/scale;juicefsruntimes/scale;thinruntimes/scale;efcruntimes/scale;vineyardruntimes/scale;cacheruntimes/scale,verbs=create;update,versions=v1alpha1,name=runtime.validate.fluid.io
+// +kubebuilder:webhook:path=/validate-fluid-io-v1alpha1-dataset,mutating=false,failurePolicy=fail,sideEffects=None,admissionReviewVersions=v1;v1beta1,groups=data.fluid.io,resources=datasets,verbs=create;update,versions=v1alpha1,name=dataset.validate.fluid.io
+// +kubebuilder:webhook:path=/validate-fluid-io-v1alpha1-spec,mutating=false,failurePolicy=fail,sideEffects=None,admissionReviewVersions=v1;v1beta1,groups=data.fluid.io,resources=datasets;alluxioruntimes;jindoruntimes;juicefsruntimes;thinruntimes;efcruntimes;vineyardruntimes;cacheruntimes;dataloads;datamigrates;databackups;dataprocesses;dataflows,verbs=create;update,versions=v1alpha1,name=spec.validate.fluid.io
+
+var (
+	// HandlerMap contains admission webhook handlers
+	HandlerMap = map[string]common.AdmissionHandler{
+		common.WebhookValidateRuntimePath: &RuntimeQuotaHandler{},
+		common.WebhookValidateDatasetPath: &DatasetShareHandler{},
+		common.WebhookValidateSpecPath:    &SpecHandler{},
+	}
+)
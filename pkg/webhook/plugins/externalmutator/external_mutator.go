@@ -0,0 +1,281 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package externalmutator
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/base"
+	"github.com/fluid-cloudnative/fluid/pkg/webhook/plugins/api"
+	webhookutils "github.com/fluid-cloudnative/fluid/pkg/webhook/utils"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// Name is the prefix of the names of external mutators
+const Name = "ExternalMutator"
+
+const (
+	defaultTimeoutSeconds int32 = 10
+	defaultServicePort    int32 = 443
+	// maxResponseBytes limits the size of the response read from an external mutator
+	maxResponseBytes int64 = 3 * 1024 * 1024
+)
+
+// DatasetRef refers to a dataset mounted by the pod
+type DatasetRef struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	// RuntimeType is the type of the runtime bound to the dataset, e.g. alluxio
+	RuntimeType string `json:"runtimeType"`
+}
+
+// MutationRequest is the body posted to an external mutator
+type MutationRequest struct {
+	Pod *corev1.Pod `json:"pod"`
+	// Datasets are keyed by the names of the PVCs mounted by the pod
+	Datasets map[string]DatasetRef `json:"datasets,omitempty"`
+}
+
+// MutationResponse is the body returned by an external mutator. Patch is a JSON patch (RFC 6902) of the pod,
+// and the pod is not changed if it's empty.
+type MutationResponse struct {
+	Patch json.RawMessage `json:"patch,omitempty"`
+}
+
+// ownedField is a field of the pod that Fluid mutates, and so is an external mutator allowed to patch
+type ownedField struct {
+	path string
+	copy func(dst, src *corev1.Pod)
+}
+
+var ownedFields = []ownedField{
+	{path: "/metadata/labels", copy: func(dst, src *corev1.Pod) { dst.Labels = src.Labels }},
+	{path: "/metadata/annotations", copy: func(dst, src *corev1.Pod) { dst.Annotations = src.Annotations }},
+	{path: "/spec/initContainers", copy: func(dst, src *corev1.Pod) { dst.Spec.InitContainers = src.Spec.InitContainers }},
+	{path: "/spec/containers", copy: func(dst, src *corev1.Pod) { dst.Spec.Containers = src.Spec.Containers }},
+	{path: "/spec/volumes", copy: func(dst, src *corev1.Pod) { dst.Spec.Volumes = src.Spec.Volumes }},
+	{path: "/spec/affinity", copy: func(dst, src *corev1.Pod) { dst.Spec.Affinity = src.Spec.Affinity }},
+	{path: "/spec/nodeSelector", copy: func(dst, src *corev1.Pod) { dst.Spec.NodeSelector = src.Spec.NodeSelector }},
+	{path: "/spec/tolerations", copy: func(dst, src *corev1.Pod) { dst.Spec.Tolerations = src.Spec.Tolerations }},
+}
+
+var _ api.MutatingHandler = &ExternalMutator{}
+
+// ExternalMutator calls an external HTTPS service to mutate pods
+type ExternalMutator struct {
+	name          string
+	url           string
+	failurePolicy datav1alpha1.MutatorFailurePolicy
+	httpClient    *http.Client
+	log           logr.Logger
+}
+
+// NewMutator builds an ExternalMutator with the spec defined in a PodMutationPolicy in the namespace. The mutator
+// is only allowed to call the Service in the same namespace.
+func NewMutator(namespace string, spec datav1alpha1.ExternalMutator) (api.MutatingHandler, error) {
+	timeout := defaultTimeoutSeconds
+	if spec.TimeoutSeconds != nil {
+		timeout = *spec.TimeoutSeconds
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+	if len(spec.CABundle) > 0 {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(spec.CABundle) {
+			return nil, fmt.Errorf("failed to parse caBundle of external mutator %s", spec.Name)
+		}
+		transport.TLSClientConfig.RootCAs = pool
+	}
+
+	failurePolicy := spec.FailurePolicy
+	if len(failurePolicy) == 0 {
+		failurePolicy = datav1alpha1.MutatorFailurePolicyFail
+	}
+
+	return &ExternalMutator{
+		name:          fmt.Sprintf("%s/%s", Name, spec.Name),
+		url:           serviceURL(namespace, spec.Service),
+		failurePolicy: failurePolicy,
+		httpClient: &http.Client{
+			Transport: transport,
+			Timeout:   time.Duration(timeout) * time.Second,
+			// never follow redirects out of the Service
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		log: ctrl.Log.WithName(Name).WithValues("mutator", spec.Name),
+	}, nil
+}
+
+func serviceURL(namespace string, service datav1alpha1.MutatorServiceReference) string {
+	port := defaultServicePort
+	if service.Port != nil {
+		port = *service.Port
+	}
+	path := service.Path
+	if len(path) == 0 {
+		path = "/"
+	}
+	u := url.URL{
+		Scheme: "https",
+		Host:   net.JoinHostPort(fmt.Sprintf("%s.%s.svc", service.Name, namespace), strconv.Itoa(int(port))),
+		Path:   path,
+	}
+	return u.String()
+}
+
+func (m *ExternalMutator) GetName() string {
+	return m.name
+}
+
+// Mutate posts the pod to the external mutator and applies the patch in the response. The patch is only allowed
+// to change the fields owned by Fluid, i.e. the labels, annotations, containers, volumes and scheduling constraints.
+func (m *ExternalMutator) Mutate(pod *corev1.Pod, runtimeInfos map[string]base.RuntimeInfoInterface) (shouldStop bool, err error) {
+	patched, err := m.call(pod, runtimeInfos)
+	if err != nil {
+		if m.failurePolicy == datav1alpha1.MutatorFailurePolicyIgnore {
+			m.log.Error(err, "ignore the failure of external mutator", "pod", pod.Name, "namespace", pod.Namespace)
+			return false, nil
+		}
+		return true, webhookutils.NewFailMutationError(fmt.Errorf("external mutator %s failed: %v", m.name, err))
+	}
+
+	if patched != nil {
+		for _, field := range ownedFields {
+			field.copy(pod, patched)
+		}
+	}
+	return false, nil
+}
+
+func (m *ExternalMutator) call(pod *corev1.Pod, runtimeInfos map[string]base.RuntimeInfoInterface) (patched *corev1.Pod, err error) {
+	request := MutationRequest{Pod: pod}
+	if len(runtimeInfos) > 0 {
+		request.Datasets = make(map[string]DatasetRef, len(runtimeInfos))
+		for pvcName, runtimeInfo := range runtimeInfos {
+			if runtimeInfo == nil {
+				continue
+			}
+			request.Datasets[pvcName] = DatasetRef{
+				Name:        runtimeInfo.GetName(),
+				Namespace:   runtimeInfo.GetNamespace(),
+				RuntimeType: runtimeInfo.GetRuntimeType(),
+			}
+		}
+	}
+
+	body, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(context.TODO(), http.MethodPost, m.url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := m.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	// the body of the response is never put into the errors, which are returned to the creator of the pod
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseBytes))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the response")
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	response := MutationResponse{}
+	if err = json.Unmarshal(data, &response); err != nil {
+		return nil, fmt.Errorf("failed to decode the response")
+	}
+	if len(response.Patch) == 0 {
+		return nil, nil
+	}
+	return patchPod(pod, response.Patch)
+}
+
+// patchPod applies the JSON patch to a copy of the pod, after checking all the operations are on the owned fields.
+func patchPod(pod *corev1.Pod, patchData []byte) (*corev1.Pod, error) {
+	patch, err := jsonpatch.DecodePatch(patchData)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode the patch")
+	}
+	for _, operation := range patch {
+		for _, key := range []string{"path", "from"} {
+			if _, found := operation[key]; !found {
+				continue
+			}
+			path, err := operation.Path()
+			if key == "from" {
+				path, err = operation.From()
+			}
+			if err != nil {
+				return nil, fmt.Errorf("failed to decode the patch")
+			}
+			if !isOwnedPath(path) {
+				return nil, fmt.Errorf("the patch changes %s, which is not allowed", path)
+			}
+		}
+	}
+
+	original, err := json.Marshal(pod)
+	if err != nil {
+		return nil, err
+	}
+	data, err := patch.Apply(original)
+	if err != nil {
+		return nil, fmt.Errorf("failed to apply the patch")
+	}
+	patched := &corev1.Pod{}
+	if err = json.Unmarshal(data, patched); err != nil {
+		return nil, fmt.Errorf("failed to decode the patched pod")
+	}
+	return patched, nil
+}
+
+func isOwnedPath(path string) bool {
+	for _, field := range ownedFields {
+		if path == field.path || strings.HasPrefix(path, field.path+"/") {
+			return true
+		}
+	}
+	return false
+}
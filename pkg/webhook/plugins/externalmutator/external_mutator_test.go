@@ -0,0 +1,176 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package externalmutator
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/ddc/base"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/webhook/generator"
+	webhookutils "github.com/fluid-cloudnative/fluid/pkg/webhook/utils"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("ExternalMutator", func() {
+	var (
+		server   *httptest.Server
+		caBundle []byte
+		handle   http.HandlerFunc
+		received MutationRequest
+		pod      *corev1.Pod
+	)
+
+	BeforeEach(func() {
+		received = MutationRequest{}
+		certs, err := (&generator.SelfSignedCertGenerator{}).Generate(generator.ServiceToCommonName("big-data", "team-a"))
+		Expect(err).NotTo(HaveOccurred())
+		cert, err := tls.X509KeyPair(certs.Cert, certs.Key)
+		Expect(err).NotTo(HaveOccurred())
+		caBundle = certs.CACert
+
+		server = httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			handle(w, r)
+		}))
+		server.TLS = &tls.Config{Certificates: []tls.Certificate{cert}}
+		server.StartTLS()
+		pod = &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "big-data"},
+		}
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	newMutator := func(failurePolicy datav1alpha1.MutatorFailurePolicy) *ExternalMutator {
+		handler, err := NewMutator("big-data", datav1alpha1.ExternalMutator{
+			Name:          "team-a",
+			Service:       datav1alpha1.MutatorServiceReference{Name: "team-a", Path: "/mutate"},
+			CABundle:      caBundle,
+			FailurePolicy: failurePolicy,
+		})
+		Expect(err).NotTo(HaveOccurred())
+		mutator := handler.(*ExternalMutator)
+		Expect(mutator.url).To(Equal("https://team-a.big-data.svc:443/mutate"))
+		// resolve the service to the test server
+		mutator.httpClient.Transport.(*http.Transport).DialContext = func(ctx context.Context, network, addr string) (net.Conn, error) {
+			return (&net.Dialer{}).DialContext(ctx, network, server.Listener.Addr().String())
+		}
+		return mutator
+	}
+
+	patchHandler := func(patch string) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			Expect(json.NewDecoder(r.Body).Decode(&received)).To(Succeed())
+			Expect(json.NewEncoder(w).Encode(MutationResponse{Patch: json.RawMessage(patch)})).To(Succeed())
+		}
+	}
+
+	It("should apply the patch returned by the mutator", func() {
+		handle = patchHandler(`[{"op": "add", "path": "/metadata/labels", "value": {"mutated-by": "team-a"}}]`)
+		runtimeInfo, err := base.BuildRuntimeInfo("hbase", "big-data", "alluxio")
+		Expect(err).NotTo(HaveOccurred())
+
+		mutator := newMutator("")
+		Expect(mutator.GetName()).To(Equal("ExternalMutator/team-a"))
+		shouldStop, err := mutator.Mutate(pod, map[string]base.RuntimeInfoInterface{"hbase-pvc": runtimeInfo})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(shouldStop).To(BeFalse())
+		Expect(pod.Labels).To(HaveKeyWithValue("mutated-by", "team-a"))
+		Expect(received.Datasets).To(HaveKeyWithValue("hbase-pvc",
+			DatasetRef{Name: "hbase", Namespace: "big-data", RuntimeType: "alluxio"}))
+	})
+
+	It("should keep the pod if no patch is returned", func() {
+		handle = func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte("{}"))
+		}
+		shouldStop, err := newMutator("").Mutate(pod, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(shouldStop).To(BeFalse())
+		Expect(pod.Labels).To(BeEmpty())
+	})
+
+	It("should fail the mutation without the response of the mutator with Fail policy", func() {
+		handle = func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "internal error", http.StatusInternalServerError)
+		}
+		shouldStop, err := newMutator(datav1alpha1.MutatorFailurePolicyFail).Mutate(pod, nil)
+		Expect(shouldStop).To(BeTrue())
+		Expect(webhookutils.IsFailMutationError(err)).To(BeTrue())
+		Expect(err.Error()).To(ContainSubstring("unexpected status code 500"))
+		Expect(err.Error()).NotTo(ContainSubstring("internal error"))
+	})
+
+	It("should ignore the failure with Ignore policy", func() {
+		handle = func(w http.ResponseWriter, r *http.Request) {
+			http.Error(w, "internal error", http.StatusInternalServerError)
+		}
+		shouldStop, err := newMutator(datav1alpha1.MutatorFailurePolicyIgnore).Mutate(pod, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(shouldStop).To(BeFalse())
+	})
+
+	It("should not follow redirects", func() {
+		handle = func(w http.ResponseWriter, r *http.Request) {
+			http.Redirect(w, r, "http://169.254.169.254/latest/meta-data", http.StatusFound)
+		}
+		_, err := newMutator("").Mutate(pod, nil)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("unexpected status code 302"))
+	})
+
+	It("should not allow patching the fields not owned by fluid", func() {
+		for _, patch := range []string{
+			`[{"op": "replace", "path": "/metadata/namespace", "value": "kube-system"}]`,
+			`[{"op": "add", "path": "/spec/serviceAccountName", "value": "admin"}]`,
+			`[{"op": "copy", "from": "/spec/hostNetwork", "path": "/metadata/labels/host"}]`,
+		} {
+			handle = patchHandler(patch)
+			_, err := newMutator("").Mutate(pod, nil)
+			Expect(err).To(HaveOccurred())
+			Expect(pod.Namespace).To(Equal("big-data"))
+			Expect(pod.Spec.ServiceAccountName).To(BeEmpty())
+			Expect(pod.Labels).To(BeEmpty())
+		}
+	})
+
+	It("should fail to verify the mutator without the caBundle", func() {
+		handle = patchHandler(`[]`)
+		caBundle = nil
+		_, err := newMutator("").Mutate(pod, nil)
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("should fail to build the mutator with an invalid caBundle", func() {
+		_, err := NewMutator("big-data", datav1alpha1.ExternalMutator{
+			Name:     "team-a",
+			Service:  datav1alpha1.MutatorServiceReference{Name: "team-a"},
+			CABundle: []byte("invalid"),
+		})
+		Expect(err).To(HaveOccurred())
+	})
+})
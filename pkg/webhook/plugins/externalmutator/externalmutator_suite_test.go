@@ -0,0 +1,13 @@
+package externalmutator
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestExternalmutator(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Externalmutator Suite")
+}
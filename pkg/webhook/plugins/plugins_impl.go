@@ -44,14 +44,7 @@ var (
 )
 
 func RegisterMutatingHandlers(client client.Client) error {
-	// ignore the register error
-	_ = registry.Register(prefernodeswithoutcache.Name, prefernodeswithoutcache.NewPlugin)
-	_ = registry.Register(mountpropagationinjector.Name, mountpropagationinjector.NewPlugin)
-	_ = registry.Register(requirenodewithfuse.Name, requirenodewithfuse.NewPlugin)
-	_ = registry.Register(nodeaffinitywithcache.Name, nodeaffinitywithcache.NewPlugin)
-	_ = registry.Register(fusesidecar.Name, fusesidecar.NewPlugin)
-	_ = registry.Register(datasetusageinjector.Name, datasetusageinjector.NewPlugin)
-	_ = registry.Register(fileprefetcher.Name, fileprefetcher.NewPlugin)
+	registerPlugins()
 
 	// get the handlers through the config file
 	data, err := os.ReadFile(common.WebhookPluginFilePath)
@@ -73,6 +66,18 @@ func RegisterMutatingHandlers(client client.Client) error {
 	return nil
 }
 
+// registerPlugins registers the built-in plugins which can be enabled by the plugins profile or PodMutationPolicies
+func registerPlugins() {
+	// ignore the register error
+	_ = registry.Register(prefernodeswithoutcache.Name, prefernodeswithoutcache.NewPlugin)
+	_ = registry.Register(mountpropagationinjector.Name, mountpropagationinjector.NewPlugin)
+	_ = registry.Register(requirenodewithfuse.Name, requirenodewithfuse.NewPlugin)
+	_ = registry.Register(nodeaffinitywithcache.Name, nodeaffinitywithcache.NewPlugin)
+	_ = registry.Register(fusesidecar.Name, fusesidecar.NewPlugin)
+	_ = registry.Register(datasetusageinjector.Name, datasetusageinjector.NewPlugin)
+	_ = registry.Register(fileprefetcher.Name, fileprefetcher.NewPlugin)
+}
+
 type Handlers struct {
 	podWithDatasetHandler              []api.MutatingHandler
 	podWithoutDatasetHandler           []api.MutatingHandler
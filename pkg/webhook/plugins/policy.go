@@ -0,0 +1,142 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugins
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/webhook/plugins/api"
+	"github.com/fluid-cloudnative/fluid/pkg/webhook/plugins/externalmutator"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// policyHandlers are the handlers built for a generation of a PodMutationPolicy
+type policyHandlers struct {
+	uid        types.UID
+	generation int64
+	handlers   []api.MutatingHandler
+}
+
+var (
+	policyHandlersLock sync.Mutex
+	// cache handlers of the policies to avoid building the plugins for every pod
+	policyHandlersCache = map[types.NamespacedName]policyHandlers{}
+)
+
+// GetPolicyHandlers returns the handlers of the PodMutationPolicies in the pod's namespace which select the pod.
+// The policies are sorted by name, and the handlers of a policy are the built-in plugins followed by the
+// external mutators. No handler is returned if the PodMutationPolicy CRD is not installed.
+func GetPolicyHandlers(c client.Client, reader client.Reader, pod *corev1.Pod) ([]api.MutatingHandler, error) {
+	policyList := &datav1alpha1.PodMutationPolicyList{}
+	if err := reader.List(context.TODO(), policyList, client.InNamespace(pod.Namespace)); err != nil {
+		if meta.IsNoMatchError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	policies := policyList.Items
+	sort.Slice(policies, func(i, j int) bool {
+		return policies[i].Name < policies[j].Name
+	})
+
+	var handlers []api.MutatingHandler
+	for i := range policies {
+		policy := &policies[i]
+		selected, err := selectsPod(policy, pod)
+		if err != nil {
+			return nil, err
+		}
+		if !selected {
+			continue
+		}
+
+		policyHandlers, err := getOrBuildPolicyHandlers(c, policy)
+		if err != nil {
+			return nil, err
+		}
+		handlers = append(handlers, policyHandlers...)
+	}
+	return handlers, nil
+}
+
+func selectsPod(policy *datav1alpha1.PodMutationPolicy, pod *corev1.Pod) (bool, error) {
+	if policy.Spec.PodSelector == nil {
+		return true, nil
+	}
+	selector, err := metav1.LabelSelectorAsSelector(policy.Spec.PodSelector)
+	if err != nil {
+		return false, fmt.Errorf("invalid podSelector of PodMutationPolicy %s/%s: %v", policy.Namespace, policy.Name, err)
+	}
+	return selector.Matches(labels.Set(pod.Labels)), nil
+}
+
+func getOrBuildPolicyHandlers(c client.Client, policy *datav1alpha1.PodMutationPolicy) ([]api.MutatingHandler, error) {
+	key := types.NamespacedName{Namespace: policy.Namespace, Name: policy.Name}
+
+	policyHandlersLock.Lock()
+	defer policyHandlersLock.Unlock()
+
+	cached, found := policyHandlersCache[key]
+	if found && cached.uid == policy.UID && cached.generation == policy.Generation {
+		return cached.handlers, nil
+	}
+
+	handlers, err := newPolicyHandlers(c, policy)
+	if err != nil {
+		return nil, err
+	}
+	log.Info("build plugins for PodMutationPolicy", "policy", key, "generation", policy.Generation)
+	policyHandlersCache[key] = policyHandlers{
+		uid:        policy.UID,
+		generation: policy.Generation,
+		handlers:   handlers,
+	}
+	return handlers, nil
+}
+
+func newPolicyHandlers(c client.Client, policy *datav1alpha1.PodMutationPolicy) (handlers []api.MutatingHandler, err error) {
+	for _, plugin := range policy.Spec.Plugins {
+		factory, ok := registry[plugin.Name]
+		if !ok {
+			return nil, fmt.Errorf("unknown plugin name [%s] in PodMutationPolicy %s/%s", plugin.Name, policy.Namespace, policy.Name)
+		}
+		handler, err := factory(c, plugin.Args)
+		if err != nil {
+			return nil, fmt.Errorf("failed to new plugin %s in PodMutationPolicy %s/%s: %v", plugin.Name, policy.Namespace, policy.Name, err)
+		}
+		handlers = append(handlers, handler)
+	}
+
+	for _, spec := range policy.Spec.ExternalMutators {
+		handler, err := externalmutator.NewMutator(policy.Namespace, spec)
+		if err != nil {
+			return nil, fmt.Errorf("failed to new external mutator %s in PodMutationPolicy %s/%s: %v", spec.Name, policy.Namespace, policy.Name, err)
+		}
+		handlers = append(handlers, handler)
+	}
+	return handlers, nil
+}
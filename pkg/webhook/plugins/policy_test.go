@@ -0,0 +1,121 @@
+/*
+Copyright 2026 The Fluid Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugins
+
+import (
+	datav1alpha1 "github.com/fluid-cloudnative/fluid/api/v1alpha1"
+	"github.com/fluid-cloudnative/fluid/pkg/utils/fake"
+	"github.com/fluid-cloudnative/fluid/pkg/webhook/plugins/fileprefetcher"
+	"github.com/fluid-cloudnative/fluid/pkg/webhook/plugins/prefernodeswithoutcache"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var _ = Describe("PodMutationPolicy", func() {
+	var (
+		s   *runtime.Scheme
+		pod *corev1.Pod
+	)
+
+	newPolicy := func(name string, generation int64, podSelector *metav1.LabelSelector, spec datav1alpha1.PodMutationPolicySpec) *datav1alpha1.PodMutationPolicy {
+		spec.PodSelector = podSelector
+		return &datav1alpha1.PodMutationPolicy{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "team-a", UID: types.UID("uid-" + name), Generation: generation},
+			Spec:       spec,
+		}
+	}
+
+	BeforeEach(func() {
+		registerPlugins()
+		s = runtime.NewScheme()
+		Expect(corev1.AddToScheme(s)).To(Succeed())
+		Expect(datav1alpha1.AddToScheme(s)).To(Succeed())
+		pod = &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "team-a", Labels: map[string]string{"app": "training"}},
+		}
+	})
+
+	It("should return the handlers of the selected policies sorted by name", func() {
+		c := fake.NewFakeClientWithScheme(s,
+			newPolicy("b", 1, nil, datav1alpha1.PodMutationPolicySpec{
+				Plugins: []datav1alpha1.PodMutationPlugin{{Name: fileprefetcher.Name}},
+				ExternalMutators: []datav1alpha1.ExternalMutator{
+					{Name: "mutator", Service: datav1alpha1.MutatorServiceReference{Name: "mutator"}},
+				},
+			}),
+			newPolicy("a", 1, &metav1.LabelSelector{MatchLabels: map[string]string{"app": "training"}}, datav1alpha1.PodMutationPolicySpec{
+				Plugins: []datav1alpha1.PodMutationPlugin{{Name: prefernodeswithoutcache.Name}},
+			}),
+			newPolicy("c", 1, &metav1.LabelSelector{MatchLabels: map[string]string{"app": "serving"}}, datav1alpha1.PodMutationPolicySpec{
+				Plugins: []datav1alpha1.PodMutationPlugin{{Name: prefernodeswithoutcache.Name}},
+			}),
+		)
+
+		handlers, err := GetPolicyHandlers(c, c, pod)
+		Expect(err).NotTo(HaveOccurred())
+		var names []string
+		for _, handler := range handlers {
+			names = append(names, handler.GetName())
+		}
+		Expect(names).To(Equal([]string{prefernodeswithoutcache.Name, fileprefetcher.Name, "ExternalMutator/mutator"}))
+	})
+
+	It("should rebuild the handlers when the policy is changed", func() {
+		policy := newPolicy("cached", 1, nil, datav1alpha1.PodMutationPolicySpec{
+			Plugins: []datav1alpha1.PodMutationPlugin{{Name: fileprefetcher.Name}},
+		})
+		c := fake.NewFakeClientWithScheme(s, policy)
+
+		first, err := GetPolicyHandlers(c, c, pod)
+		Expect(err).NotTo(HaveOccurred())
+		second, err := GetPolicyHandlers(c, c, pod)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(second[0]).To(BeIdenticalTo(first[0]))
+
+		changed := newPolicy("cached", 2, nil, datav1alpha1.PodMutationPolicySpec{
+			Plugins: []datav1alpha1.PodMutationPlugin{{Name: prefernodeswithoutcache.Name}},
+		})
+		c = fake.NewFakeClientWithScheme(s, changed)
+		third, err := GetPolicyHandlers(c, c, pod)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(third).To(HaveLen(1))
+		Expect(third[0].GetName()).To(Equal(prefernodeswithoutcache.Name))
+	})
+
+	It("should return error for an unknown plugin", func() {
+		c := fake.NewFakeClientWithScheme(s, newPolicy("unknown", 1, nil, datav1alpha1.PodMutationPolicySpec{
+			Plugins: []datav1alpha1.PodMutationPlugin{{Name: "NotExist"}},
+		}))
+		_, err := GetPolicyHandlers(c, c, pod)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("NotExist"))
+	})
+
+	It("should return no handler if there's no policy in the namespace", func() {
+		c := fake.NewFakeClientWithScheme(s, newPolicy("other", 1, nil, datav1alpha1.PodMutationPolicySpec{
+			Plugins: []datav1alpha1.PodMutationPlugin{{Name: fileprefetcher.Name}},
+		}))
+		pod.Namespace = "team-b"
+		handlers, err := GetPolicyHandlers(c, c, pod)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(handlers).To(BeEmpty())
+	})
+})
@@ -28,7 +28,7 @@ import (
 
 /*
    This plugin is for pods with a  dataset.
-   They should require nods with fuse.
+   They should require nods with fuse, and avoid the nodes drained for the fuse upgrade of the runtime.
 */
 
 const Name = "RequireNodeWithFuse"
@@ -98,5 +98,11 @@ func getRequiredSchedulingTerm(runtimeInfo base.RuntimeInfoInterface) (requiredS
 		})
 	}
 
+	// the nodes drained for the fuse upgrade of the runtime are labeled until their fuse pods are upgraded
+	requiredSchedulingTerm.MatchExpressions = append(requiredSchedulingTerm.MatchExpressions, corev1.NodeSelectorRequirement{
+		Key:      utils.GetFuseUpgradeDrainingLabelName(runtimeInfo.GetNamespace(), runtimeInfo.GetName(), runtimeInfo.GetOwnerDatasetUID()),
+		Operator: corev1.NodeSelectorOpDoesNotExist,
+	})
+
 	return
 }
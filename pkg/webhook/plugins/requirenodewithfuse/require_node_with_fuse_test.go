@@ -27,6 +27,11 @@ import (
 )
 
 var _ = Describe("RequireNodeWithFuse Plugin", func() {
+	drainingRequirement := corev1.NodeSelectorRequirement{
+		Key:      "draining.fuse.fluid.io/fluid-test",
+		Operator: corev1.NodeSelectorOpDoesNotExist,
+	}
+
 	Describe("getRequiredSchedulingTerm", func() {
 		It("should return correct NodeSelectorTerm with selector enabled and disabled", func() {
 			runtimeInfo, err := base.BuildRuntimeInfo("test", "fluid", "alluxio")
@@ -43,6 +48,7 @@ var _ = Describe("RequireNodeWithFuse Plugin", func() {
 						Operator: corev1.NodeSelectorOpIn,
 						Values:   []string{"test1"},
 					},
+					drainingRequirement,
 				},
 			}
 			Expect(terms).To(Equal(expectTerms))
@@ -51,7 +57,7 @@ var _ = Describe("RequireNodeWithFuse Plugin", func() {
 			runtimeInfo.SetFuseNodeSelector(map[string]string{})
 			terms, err = getRequiredSchedulingTerm(runtimeInfo)
 			Expect(err).NotTo(HaveOccurred())
-			expectTerms = corev1.NodeSelectorTerm{MatchExpressions: []corev1.NodeSelectorRequirement{}}
+			expectTerms = corev1.NodeSelectorTerm{MatchExpressions: []corev1.NodeSelectorRequirement{drainingRequirement}}
 			Expect(terms).To(Equal(expectTerms))
 
 			// runtimeInfo is nil
@@ -111,11 +117,13 @@ var _ = Describe("RequireNodeWithFuse Plugin", func() {
 			Expect(pod.Spec.Affinity.NodeAffinity).NotTo(BeNil())
 			terms := pod.Spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms
 			Expect(terms).To(HaveLen(1))
-			Expect(terms[0].MatchExpressions).To(HaveLen(1))
-			Expect(terms[0].MatchExpressions[0]).To(Equal(corev1.NodeSelectorRequirement{
-				Key:      fuseKey,
-				Operator: corev1.NodeSelectorOpIn,
-				Values:   []string{"true"},
+			Expect(terms[0].MatchExpressions).To(Equal([]corev1.NodeSelectorRequirement{
+				{
+					Key:      fuseKey,
+					Operator: corev1.NodeSelectorOpIn,
+					Values:   []string{"true"},
+				},
+				drainingRequirement,
 			}))
 		})
 
@@ -161,6 +169,7 @@ var _ = Describe("RequireNodeWithFuse Plugin", func() {
 					Operator: corev1.NodeSelectorOpIn,
 					Values:   []string{"true"},
 				},
+				drainingRequirement,
 			))
 			Expect(terms[1].MatchExpressions).To(ConsistOf(
 				corev1.NodeSelectorRequirement{
@@ -173,6 +182,7 @@ var _ = Describe("RequireNodeWithFuse Plugin", func() {
 					Operator: corev1.NodeSelectorOpIn,
 					Values:   []string{"true"},
 				},
+				drainingRequirement,
 			))
 		})
 
@@ -203,6 +213,7 @@ var _ = Describe("RequireNodeWithFuse Plugin", func() {
 			Expect(terms[0].MatchExpressions).To(ConsistOf(
 				corev1.NodeSelectorRequirement{Key: "region", Operator: corev1.NodeSelectorOpIn, Values: []string{"us-east-1"}},
 				corev1.NodeSelectorRequirement{Key: fuseKey, Operator: corev1.NodeSelectorOpIn, Values: []string{"true"}},
+				drainingRequirement,
 			))
 		})
 	})
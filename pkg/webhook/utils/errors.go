@@ -27,3 +27,32 @@ func NewNeedRetryWithApiReaderError(err error) *NeedRetryWithApiReaderError {
 		ErrMsg: err.Error(),
 	}
 }
+
+// FailMutationError means the pod must not be admitted because a mutation required by a policy failed
+type FailMutationError struct {
+	ErrMsg string
+}
+
+var _ error = &FailMutationError{}
+
+// Error implements the Error interface.
+func (e *FailMutationError) Error() string {
+	return e.ErrMsg
+}
+
+func IsFailMutationError(err error) bool {
+	if _, ok := err.(*FailMutationError); ok {
+		return true
+	}
+
+	return false
+}
+
+func NewFailMutationError(err error) *FailMutationError {
+	if err == nil {
+		return nil
+	}
+	return &FailMutationError{
+		ErrMsg: err.Error(),
+	}
+}